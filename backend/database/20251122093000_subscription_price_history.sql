@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.subscription_price_history
+(
+    subscription_id uuid        NOT NULL
+        CONSTRAINT fk_subscription_price_history_subscription
+            REFERENCES public.subscriptions ON DELETE CASCADE,
+    effective_from  timestamptz NOT NULL,
+    amount          numeric     NOT NULL,
+    currency        varchar(3)  NOT NULL,
+    created_at      timestamptz NOT NULL,
+    PRIMARY KEY (subscription_id, effective_from)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.subscription_price_history;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.charges
+(
+    id                uuid         NOT NULL
+        PRIMARY KEY,
+    subscription_id   uuid         NOT NULL
+        CONSTRAINT fk_charges_subscription
+            REFERENCES public.subscriptions ON DELETE CASCADE,
+    due_date          timestamptz  NOT NULL,
+    status            varchar(20)  NOT NULL,
+    expected_amount   numeric      NOT NULL,
+    expected_currency varchar(3)   NOT NULL,
+    actual_amount     numeric,
+    actual_currency   varchar(3),
+    created_at        timestamptz  NOT NULL,
+    updated_at        timestamptz  NOT NULL,
+    etag              varchar(100) NOT NULL,
+    CONSTRAINT uq_charges_subscription_due_date UNIQUE (subscription_id, due_date)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.charges;
+-- +goose StatementEnd
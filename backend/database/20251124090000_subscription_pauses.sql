@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.subscription_pauses
+(
+    subscription_id uuid        NOT NULL
+        CONSTRAINT fk_subscription_pauses_subscription
+            REFERENCES public.subscriptions ON DELETE CASCADE,
+    start_date      timestamptz NOT NULL,
+    end_date        timestamptz NULL,
+    created_at      timestamptz NOT NULL,
+    PRIMARY KEY (subscription_id, start_date)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.subscription_pauses;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.subscriptions
+    ADD COLUMN contract_minimum_term  integer NULL,
+    ADD COLUMN contract_auto_renew    boolean NULL,
+    ADD COLUMN contract_notice_period integer NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.subscriptions
+    DROP COLUMN contract_minimum_term,
+    DROP COLUMN contract_auto_renew,
+    DROP COLUMN contract_notice_period;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.subscription_promotions
+(
+    subscription_id uuid        NOT NULL
+        CONSTRAINT fk_subscription_promotions_subscription
+            REFERENCES public.subscriptions ON DELETE CASCADE,
+    start_date      timestamptz NOT NULL,
+    end_date        timestamptz NOT NULL,
+    amount          numeric     NOT NULL,
+    currency        varchar(3)  NOT NULL,
+    created_at      timestamptz NOT NULL,
+    PRIMARY KEY (subscription_id, start_date)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.subscription_promotions;
+-- +goose StatementEnd
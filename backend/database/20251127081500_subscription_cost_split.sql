@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.subscriptions
+    ADD cost_split_type varchar(20) NULL;
+
+CREATE TABLE public.subscription_cost_shares
+(
+    subscription_id  uuid    NOT NULL
+        CONSTRAINT fk_subscription_cost_shares_subscription
+            REFERENCES public.subscriptions ON DELETE CASCADE,
+    family_member_id uuid    NOT NULL
+        CONSTRAINT fk_subscription_cost_shares_family_member
+            REFERENCES public.family_members ON DELETE CASCADE,
+    value            numeric NOT NULL,
+    PRIMARY KEY (subscription_id, family_member_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.subscription_cost_shares;
+
+ALTER TABLE public.subscriptions
+    DROP COLUMN cost_split_type;
+-- +goose StatementEnd
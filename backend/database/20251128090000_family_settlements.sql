@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.family_settlements
+(
+    id             uuid         NOT NULL
+        PRIMARY KEY,
+    family_id      uuid         NOT NULL
+        CONSTRAINT fk_family_settlements_family
+            REFERENCES public.families ON DELETE CASCADE,
+    from_member_id uuid         NOT NULL
+        CONSTRAINT fk_family_settlements_from_member
+            REFERENCES public.family_members ON DELETE CASCADE,
+    to_member_id   uuid         NOT NULL
+        CONSTRAINT fk_family_settlements_to_member
+            REFERENCES public.family_members ON DELETE CASCADE,
+    amount         numeric      NOT NULL,
+    currency       varchar(3)   NOT NULL,
+    settled_at     timestamptz  NOT NULL,
+    note           varchar(255),
+    created_at     timestamptz  NOT NULL,
+    updated_at     timestamptz  NOT NULL,
+    etag           varchar(100) NOT NULL
+);
+
+CREATE INDEX idx_family_settlements_family
+    ON public.family_settlements (family_id, settled_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.family_settlements;
+-- +goose StatementEnd
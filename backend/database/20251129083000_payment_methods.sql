@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.payment_methods
+(
+    id              uuid         NOT NULL
+        PRIMARY KEY,
+    owner_type      varchar(20)  NOT NULL,
+    owner_family_id uuid
+        CONSTRAINT fk_payment_methods_owner_family
+            REFERENCES public.families ON DELETE CASCADE,
+    owner_user_id   varchar(50),
+    type            varchar(20)  NOT NULL,
+    label           varchar(100) NOT NULL,
+    last_four       varchar(4),
+    expiry_month    integer,
+    expiry_year     integer,
+    created_at      timestamptz  NOT NULL,
+    updated_at      timestamptz  NOT NULL,
+    etag            varchar(100) NOT NULL
+);
+
+ALTER TABLE public.subscriptions
+    ADD payment_method_id uuid NULL
+        CONSTRAINT fk_subscriptions_payment_method
+            REFERENCES public.payment_methods ON DELETE SET NULL;
+
+CREATE INDEX idx_subscriptions_payment_method
+    ON public.subscriptions (payment_method_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.subscriptions
+    DROP COLUMN payment_method_id;
+
+DROP TABLE public.payment_methods;
+-- +goose StatementEnd
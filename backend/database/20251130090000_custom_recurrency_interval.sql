@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.subscriptions
+    RENAME COLUMN custom_recurrency TO custom_recurrency_count;
+
+ALTER TABLE public.subscriptions
+    ADD custom_recurrency_unit varchar(10) NULL;
+
+-- Custom recurrencies were stored as a number of months
+UPDATE public.subscriptions
+SET custom_recurrency_unit = 'month'
+WHERE custom_recurrency_count IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE public.subscriptions
+SET custom_recurrency_count = CASE custom_recurrency_unit
+    WHEN 'year' THEN custom_recurrency_count * 12
+    WHEN 'week' THEN GREATEST(1, ROUND(custom_recurrency_count * 7 / 30.436875))
+    WHEN 'day' THEN GREATEST(1, ROUND(custom_recurrency_count / 30.436875))
+    ELSE custom_recurrency_count
+    END
+WHERE custom_recurrency_count IS NOT NULL;
+
+ALTER TABLE public.subscriptions
+    DROP COLUMN custom_recurrency_unit;
+
+ALTER TABLE public.subscriptions
+    RENAME COLUMN custom_recurrency_count TO custom_recurrency;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.subscriptions
+    ADD billing_anchor_day integer NULL;
+
+ALTER TABLE public.accounts
+    ADD timezone varchar(64) NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.accounts
+    DROP COLUMN timezone;
+
+ALTER TABLE public.subscriptions
+    DROP COLUMN billing_anchor_day;
+-- +goose StatementEnd
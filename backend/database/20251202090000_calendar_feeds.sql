@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.calendar_feeds
+(
+    id         uuid         NOT NULL
+        PRIMARY KEY,
+    user_id    varchar(50)  NOT NULL
+        CONSTRAINT uq_calendar_feeds_user_id
+            UNIQUE,
+    token_hash varchar(64)  NOT NULL
+        CONSTRAINT uq_calendar_feeds_token_hash
+            UNIQUE,
+    created_at timestamptz  NOT NULL,
+    updated_at timestamptz  NOT NULL,
+    etag       varchar(100) NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.calendar_feeds;
+-- +goose StatementEnd
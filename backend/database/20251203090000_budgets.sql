@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.budgets
+(
+    id              uuid         NOT NULL
+        PRIMARY KEY,
+    owner_type      varchar(20)  NOT NULL,
+    owner_family_id uuid
+        CONSTRAINT fk_budgets_owner_family
+            REFERENCES public.families ON DELETE CASCADE,
+    owner_user_id   varchar(50),
+    name            varchar(100) NOT NULL,
+    scope           varchar(20)  NOT NULL,
+    label_id        uuid
+        CONSTRAINT fk_budgets_label
+            REFERENCES public.labels ON DELETE CASCADE,
+    period          varchar(20)  NOT NULL,
+    limit_amount    numeric      NOT NULL,
+    limit_currency  varchar(3)   NOT NULL,
+    created_at      timestamptz  NOT NULL,
+    updated_at      timestamptz  NOT NULL,
+    etag            varchar(100) NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.budgets;
+-- +goose StatementEnd
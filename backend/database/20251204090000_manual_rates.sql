@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.manual_rates
+(
+    id              uuid         NOT NULL
+        PRIMARY KEY,
+    owner_type      varchar(20)  NOT NULL,
+    owner_family_id uuid
+        CONSTRAINT fk_manual_rates_owner_family
+            REFERENCES public.families ON DELETE CASCADE,
+    owner_user_id   varchar(50),
+    subscription_id uuid
+        CONSTRAINT fk_manual_rates_subscription
+            REFERENCES public.subscriptions ON DELETE CASCADE,
+    from_currency   varchar(3)   NOT NULL,
+    to_currency     varchar(3)   NOT NULL,
+    exchange_rate   numeric      NOT NULL,
+    created_at      timestamptz  NOT NULL,
+    updated_at      timestamptz  NOT NULL,
+    etag            varchar(100) NOT NULL
+);
+
+CREATE INDEX idx_manual_rates_owner_user_id
+    ON public.manual_rates (owner_user_id);
+
+CREATE INDEX idx_manual_rates_owner_family_id
+    ON public.manual_rates (owner_family_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.manual_rates;
+-- +goose StatementEnd
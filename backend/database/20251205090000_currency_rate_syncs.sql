@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.currency_rate_syncs
+(
+    id               uuid         NOT NULL
+        PRIMARY KEY,
+    status           varchar(20)  NOT NULL,
+    start_date       date         NOT NULL,
+    end_date         date         NOT NULL,
+    cursor_date      date,
+    processed_days   integer      NOT NULL,
+    filled_days      integer      NOT NULL,
+    unavailable_days integer      NOT NULL,
+    last_error       text,
+    finished_at      timestamptz,
+    created_at       timestamptz  NOT NULL,
+    updated_at       timestamptz  NOT NULL,
+    etag             varchar(100) NOT NULL
+);
+
+CREATE INDEX idx_currency_rate_syncs_created_at
+    ON public.currency_rate_syncs (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.currency_rate_syncs;
+-- +goose StatementEnd
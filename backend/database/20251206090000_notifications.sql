@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.notification_preferences
+(
+    user_id                         varchar(50)  NOT NULL
+        PRIMARY KEY,
+    enabled                         boolean      NOT NULL,
+    locale                          varchar(10)  NOT NULL,
+    renewal_lead_days               integer      NOT NULL,
+    free_trial_end_lead_days        integer      NOT NULL,
+    cancellation_deadline_lead_days integer      NOT NULL,
+    created_at                      timestamptz  NOT NULL,
+    updated_at                      timestamptz  NOT NULL,
+    etag                            varchar(100) NOT NULL
+);
+
+CREATE INDEX idx_notification_preferences_enabled
+    ON public.notification_preferences (enabled);
+
+CREATE TABLE public.notification_reminders
+(
+    id              uuid         NOT NULL
+        PRIMARY KEY,
+    user_id         varchar(50)  NOT NULL,
+    subscription_id uuid         NOT NULL,
+    kind            varchar(30)  NOT NULL,
+    event_date      date         NOT NULL,
+    status          varchar(20)  NOT NULL,
+    sent_at         timestamptz,
+    created_at      timestamptz  NOT NULL,
+    updated_at      timestamptz  NOT NULL,
+    etag            varchar(100) NOT NULL,
+    CONSTRAINT uq_notification_reminders_event
+        UNIQUE (user_id, subscription_id, kind, event_date)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.notification_reminders;
+DROP TABLE public.notification_preferences;
+-- +goose StatementEnd
@@ -0,0 +1,68 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.webhook_endpoints
+(
+    id         uuid          NOT NULL
+        PRIMARY KEY,
+    user_id    varchar(50)   NOT NULL,
+    url        varchar(2048) NOT NULL,
+    secret     varchar(100)  NOT NULL,
+    enabled    boolean       NOT NULL,
+    created_at timestamptz   NOT NULL,
+    updated_at timestamptz   NOT NULL,
+    etag       varchar(100)  NOT NULL
+);
+
+CREATE INDEX idx_webhook_endpoints_user_id
+    ON public.webhook_endpoints (user_id);
+
+CREATE TABLE public.webhook_endpoint_event_types
+(
+    endpoint_id uuid        NOT NULL
+        REFERENCES public.webhook_endpoints (id)
+            ON DELETE CASCADE,
+    event_type  varchar(50) NOT NULL,
+    PRIMARY KEY (endpoint_id, event_type)
+);
+
+CREATE TABLE public.webhook_deliveries
+(
+    id               uuid         NOT NULL
+        PRIMARY KEY,
+    endpoint_id      uuid         NOT NULL
+        REFERENCES public.webhook_endpoints (id)
+            ON DELETE CASCADE,
+    event_id         varchar(200) NOT NULL,
+    event_type       varchar(50)  NOT NULL,
+    payload          text         NOT NULL,
+    status           varchar(20)  NOT NULL,
+    attempts         integer      NOT NULL,
+    next_attempt_at  timestamptz,
+    last_status_code integer,
+    last_error       text,
+    delivered_at     timestamptz,
+    redelivery_of    uuid,
+    created_at       timestamptz  NOT NULL,
+    updated_at       timestamptz  NOT NULL,
+    etag             varchar(100) NOT NULL
+);
+
+-- An event is delivered once to an endpoint, redeliveries aside
+CREATE UNIQUE INDEX uq_webhook_deliveries_event
+    ON public.webhook_deliveries (endpoint_id, event_id)
+    WHERE redelivery_of IS NULL;
+
+CREATE INDEX idx_webhook_deliveries_due
+    ON public.webhook_deliveries (next_attempt_at)
+    WHERE status = 'pending';
+
+CREATE INDEX idx_webhook_deliveries_endpoint_id
+    ON public.webhook_deliveries (endpoint_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.webhook_deliveries;
+DROP TABLE public.webhook_endpoint_event_types;
+DROP TABLE public.webhook_endpoints;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.outbox_events
+(
+    id           uuid         NOT NULL
+        PRIMARY KEY,
+    event_name   varchar(100) NOT NULL,
+    payload      text         NOT NULL,
+    created_at   timestamptz  NOT NULL,
+    processed_at timestamptz,
+    error        text
+);
+
+-- The relays take the pending events in the order they were recorded
+CREATE INDEX idx_outbox_events_pending
+    ON public.outbox_events (created_at, id)
+    WHERE processed_at IS NULL;
+
+CREATE INDEX idx_outbox_events_processed_at
+    ON public.outbox_events (processed_at)
+    WHERE processed_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.outbox_events;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.outbox_events
+    ADD attempts integer NOT NULL DEFAULT 0;
+
+-- next_attempt_at delays the event after a failed attempt, it is null until then
+ALTER TABLE public.outbox_events
+    ADD next_attempt_at timestamptz NULL;
+
+-- failed_at is set on the events kept aside, they are neither relayed nor purged
+ALTER TABLE public.outbox_events
+    ADD failed_at timestamptz NULL;
+
+-- locked_until is the lease of the relay publishing the event, the other relays skip it until then
+ALTER TABLE public.outbox_events
+    ADD locked_until timestamptz NULL;
+
+DROP INDEX public.idx_outbox_events_pending;
+
+-- The relays take the pending events in the order they were recorded
+CREATE INDEX idx_outbox_events_pending
+    ON public.outbox_events (created_at, id)
+    WHERE processed_at IS NULL AND failed_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX public.idx_outbox_events_pending;
+
+CREATE INDEX idx_outbox_events_pending
+    ON public.outbox_events (created_at, id)
+    WHERE processed_at IS NULL;
+
+ALTER TABLE public.outbox_events
+    DROP COLUMN locked_until;
+
+ALTER TABLE public.outbox_events
+    DROP COLUMN failed_at;
+
+ALTER TABLE public.outbox_events
+    DROP COLUMN next_attempt_at;
+
+ALTER TABLE public.outbox_events
+    DROP COLUMN attempts;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- cancellation_deadline is the last day to cancel before the next renewal the contract allows to stop, it is
+-- computed by the application when the subscription is saved and refreshed once it passed
+ALTER TABLE public.subscriptions
+    ADD cancellation_deadline timestamptz NULL;
+
+CREATE INDEX idx_subscriptions_cancellation_deadline
+    ON public.subscriptions (cancellation_deadline)
+    WHERE cancellation_deadline IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX public.idx_subscriptions_cancellation_deadline;
+
+ALTER TABLE public.subscriptions
+    DROP COLUMN cancellation_deadline;
+-- +goose StatementEnd
@@ -0,0 +1,85 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/repositories"
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/label"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func TestBudgetRepository_CRUD(t *testing.T) {
+	ctx := context.Background()
+	repo := repositories.NewBudgetRepository(GetDBContext())
+	labelRepo := repositories.NewLabelRepository(GetDBContext())
+
+	userID := types.UserID("user-" + uuid.NewString())
+	owner := types.NewPersonalOwner(userID)
+	lbl := label.NewLabel(types.NewLabelID(), owner, "Streaming-"+uuid.NewString()[0:8], nil, "#FF0000",
+		time.Now().UTC(), time.Now().UTC())
+	require.NoError(t, labelRepo.Save(ctx, lbl))
+
+	b := budget.NewBudget(types.NewBudgetID(), owner, "Streaming", budget.LabelScope, x.P(lbl.Id()),
+		budget.MonthlyPeriod, currency.NewAmount(49.99, currency.EUR), time.Now().UTC(), time.Now().UTC())
+	require.NoError(t, repo.Save(ctx, b))
+
+	// GetById / GetByIdForUser
+	stored, err := repo.GetById(ctx, b.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, budget.LabelScope, stored.Scope())
+	assert.Equal(t, lbl.Id(), *stored.LabelId())
+	assert.Equal(t, 49.99, stored.Limit().Value())
+	assert.Equal(t, currency.EUR, stored.Limit().Currency())
+
+	visible, err := repo.GetByIdForUser(ctx, userID, b.Id())
+	require.NoError(t, err)
+	assert.NotNil(t, visible)
+	hidden, err := repo.GetByIdForUser(ctx, types.UserID("someone-else"), b.Id())
+	require.NoError(t, err)
+	assert.Nil(t, hidden)
+
+	// Update
+	stored.SetScope(budget.AccountScope, nil)
+	stored.SetPeriod(budget.YearlyPeriod)
+	stored.SetLimit(currency.NewAmount(600, currency.USD))
+	require.NoError(t, repo.Save(ctx, stored))
+	stored, err = repo.GetById(ctx, b.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, budget.AccountScope, stored.Scope())
+	assert.Nil(t, stored.LabelId())
+	assert.Equal(t, budget.YearlyPeriod, stored.Period())
+	assert.Equal(t, currency.USD, stored.Limit().Currency())
+
+	// GetAll
+	list, total, err := repo.GetAll(ctx, userID, ports.NewBudgetQueryParameters(10, 0))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, list, 1)
+	assert.Equal(t, b.Id(), list[0].Id())
+
+	// Delete
+	deleted, err := repo.Delete(ctx, b.Id())
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	exists, err := repo.Exists(ctx, b.Id())
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// Cleanup
+	_, err = labelRepo.Delete(ctx, lbl.Id())
+	require.NoError(t, err)
+}
@@ -0,0 +1,61 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/repositories"
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func TestCalendarFeedRepository_CRUD(t *testing.T) {
+	ctx := context.Background()
+	repo := repositories.NewCalendarFeedRepository(GetDBContext())
+
+	userID := types.UserID("user-" + uuid.NewString())
+	feed, token, err := calendar.CreateFeed(userID)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, feed))
+
+	// GetByUserId / GetByTokenHash
+	stored, err := repo.GetByUserId(ctx, userID)
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, feed.Id(), stored.Id())
+	assert.Equal(t, calendar.HashToken(token), stored.TokenHash())
+
+	byToken, err := repo.GetByTokenHash(ctx, calendar.HashToken(token))
+	require.NoError(t, err)
+	require.NotNil(t, byToken)
+	assert.Equal(t, userID, byToken.UserId())
+
+	// Rotate
+	rotated, err := stored.Rotate()
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, stored))
+	previous, err := repo.GetByTokenHash(ctx, calendar.HashToken(token))
+	require.NoError(t, err)
+	assert.Nil(t, previous)
+	byToken, err = repo.GetByTokenHash(ctx, calendar.HashToken(rotated))
+	require.NoError(t, err)
+	require.NotNil(t, byToken)
+	assert.Equal(t, feed.Id(), byToken.Id())
+
+	// Delete
+	ok, err := repo.Delete(ctx, feed.Id())
+	require.NoError(t, err)
+	assert.True(t, ok)
+	exists, err := repo.Exists(ctx, feed.Id())
+	require.NoError(t, err)
+	assert.False(t, exists)
+	stored, err = repo.GetByUserId(ctx, userID)
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+}
@@ -18,7 +18,7 @@ import (
 
 func TestFamilyRepository_CRUDAndMembers(t *testing.T) {
 	ctx := context.Background()
-	repo := repositories.NewFamilyRepository(GetDBContext())
+	repo := repositories.NewFamilyRepository(GetDBContext(), GetOutboxRepository())
 
 	ownerUserID := types.UserID("user-" + uuid.NewString())
 	familyID := types.NewFamilyID()
@@ -15,9 +15,13 @@ import (
 
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 
+	"github.com/mistribe/subtracker/internal/adapters/eventbus"
 	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/repositories"
+	"github.com/mistribe/subtracker/internal/ports"
 	"github.com/mistribe/subtracker/pkg/testx"
 )
 
@@ -126,3 +130,29 @@ func GetDBContext() *db.Context {
 	}
 	return testDBContext
 }
+
+// GetOutboxRepository returns the outbox the repositories store their events in
+func GetOutboxRepository() ports.OutboxRepository {
+	return repositories.NewOutboxRepository(GetDBContext())
+}
+
+// GetEventPublisher returns a publisher notifying the given subscribers of the events relayed from the outbox
+func GetEventPublisher(subscribers ...ports.EventSubscriber) ports.EventPublisher {
+	return eventbus.NewBus(eventbus.BusParams{
+		Subscribers: subscribers,
+		Logger:      testx.DiscardLogger(),
+	})
+}
+
+// RelayOutbox publishes every pending event of the outbox to the given subscribers
+func RelayOutbox(ctx context.Context, t *testing.T, subscribers ...ports.EventSubscriber) {
+	t.Helper()
+	publisher := GetEventPublisher(subscribers...)
+	for {
+		report, err := GetOutboxRepository().Relay(ctx, 100, publisher.Publish)
+		require.NoError(t, err)
+		if report.Published == 0 && report.Retried == 0 && report.Failed == 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,79 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/repositories"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+func TestManualRateRepository_CRUD(t *testing.T) {
+	ctx := context.Background()
+	repo := repositories.NewManualRateRepository(GetDBContext())
+
+	userID := types.UserID("user-" + uuid.NewString())
+	owner := types.NewPersonalOwner(userID)
+
+	r := currency.NewManualRate(types.NewManualRateID(), owner, nil, currency.EUR, currency.USD, 1.08,
+		time.Now().UTC(), time.Now().UTC())
+	require.NoError(t, repo.Save(ctx, r))
+
+	// GetById / GetByIdForUser
+	stored, err := repo.GetById(ctx, r.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, currency.EUR, stored.FromCurrency())
+	assert.Equal(t, currency.USD, stored.ToCurrency())
+	assert.Equal(t, 1.08, stored.ExchangeRate())
+	assert.Nil(t, stored.SubscriptionId())
+
+	visible, err := repo.GetByIdForUser(ctx, userID, r.Id())
+	require.NoError(t, err)
+	assert.NotNil(t, visible)
+	hidden, err := repo.GetByIdForUser(ctx, types.UserID("someone-else"), r.Id())
+	require.NoError(t, err)
+	assert.Nil(t, hidden)
+
+	// Update
+	stored.SetPair(currency.USD, currency.EUR)
+	stored.SetExchangeRate(0.92)
+	require.NoError(t, repo.Save(ctx, stored))
+	stored, err = repo.GetById(ctx, r.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, currency.USD, stored.FromCurrency())
+	assert.Equal(t, 0.92, stored.ExchangeRate())
+
+	// GetAll / GetAllForUser
+	list, total, err := repo.GetAll(ctx, userID, ports.NewManualRateQueryParameters(10, 0))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, list, 1)
+	assert.Equal(t, r.Id(), list[0].Id())
+
+	rates, err := repo.GetAllForUser(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, rates, 1)
+	rate, source, ok := rates.RateFor(currency.USD, currency.EUR, nil)
+	assert.True(t, ok)
+	assert.Equal(t, 0.92, rate)
+	assert.Equal(t, currency.ManualRateSource, source)
+
+	// Delete
+	deleted, err := repo.Delete(ctx, r.Id())
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	exists, err := repo.Exists(ctx, r.Id())
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,136 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/repositories"
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func TestNotificationPreferencesRepository_CRUD(t *testing.T) {
+	ctx := context.Background()
+	repo := repositories.NewNotificationPreferencesRepository(GetDBContext())
+
+	userID := types.UserID("user-" + uuid.NewString())
+	stored, err := repo.GetByUserId(ctx, userID)
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+
+	preferences := notification.DefaultPreferences(userID, notification.EnglishLocale)
+	require.NoError(t, repo.Save(ctx, preferences))
+
+	preferences.SetEnabled(true)
+	preferences.SetLocale(notification.FrenchLocale)
+	preferences.SetLeadDays(1, 2, 10)
+	require.NoError(t, repo.Save(ctx, preferences))
+
+	stored, err = repo.GetByUserId(ctx, userID)
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.True(t, stored.Enabled())
+	assert.Equal(t, notification.FrenchLocale, stored.Locale())
+	assert.Equal(t, 10, stored.CancellationDeadlineLeadDays())
+	assert.Equal(t, preferences.ETag(), stored.ETag())
+
+	enabled, err := repo.GetAllEnabled(ctx)
+	require.NoError(t, err)
+	var found bool
+	for _, p := range enabled {
+		found = found || p.UserId() == userID
+	}
+	assert.True(t, found)
+}
+
+func TestReminderRepository_Claim(t *testing.T) {
+	ctx := context.Background()
+	repo := repositories.NewReminderRepository(GetDBContext())
+
+	userID := types.UserID("user-" + uuid.NewString())
+	event := calendar.Event{
+		SubscriptionId: types.NewSubscriptionID(),
+		Kind:           calendar.RenewalEventKind,
+		Date:           time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	reminder := notification.NewPendingReminder(userID, event)
+	claimed, err := repo.Claim(ctx, reminder)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// Another instance claiming the same event gets nothing
+	claimed, err = repo.Claim(ctx, notification.NewPendingReminder(userID, event))
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	reminder.MarkAsSent(time.Now())
+	require.NoError(t, repo.Save(ctx, reminder))
+	stored, err := repo.GetById(ctx, reminder.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, notification.SentReminderStatus, stored.Status())
+	assert.Equal(t, event.Date, stored.EventDate())
+
+	// A released claim can be claimed again
+	ok, err := repo.Delete(ctx, reminder.Id())
+	require.NoError(t, err)
+	assert.True(t, ok)
+	claimed, err = repo.Claim(ctx, notification.NewPendingReminder(userID, event))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+}
+
+func TestReminderRepository_ClaimStale(t *testing.T) {
+	ctx := context.Background()
+	repo := repositories.NewReminderRepository(GetDBContext())
+
+	userID := types.UserID("user-" + uuid.NewString())
+	event := calendar.Event{
+		SubscriptionId: types.NewSubscriptionID(),
+		Kind:           calendar.RenewalEventKind,
+		Date:           time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+	}
+	age := func(reminder notification.Reminder, updatedAt time.Time) {
+		stmt := table.NotificationReminders.UPDATE().
+			SET(table.NotificationReminders.UpdatedAt.SET(postgres.TimestampzT(updatedAt))).
+			WHERE(table.NotificationReminders.ID.EQ(postgres.UUID(reminder.Id())))
+		_, err := GetDBContext().Execute(ctx, stmt)
+		require.NoError(t, err)
+	}
+
+	// The instance that claimed the reminder stopped before sending it
+	stale := notification.NewPendingReminder(userID, event)
+	claimed, err := repo.Claim(ctx, stale)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	age(stale, time.Now().Add(-time.Hour))
+
+	takeover := notification.NewPendingReminder(userID, event)
+	claimed, err = repo.Claim(ctx, takeover)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// The claim now belongs to the new instance only
+	stored, err := repo.GetById(ctx, stale.Id())
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+	takeover.MarkAsSent(time.Now())
+	require.NoError(t, repo.Save(ctx, takeover))
+
+	// A sent reminder is never taken over
+	age(takeover, time.Now().Add(-time.Hour))
+	claimed, err = repo.Claim(ctx, notification.NewPendingReminder(userID, event))
+	require.NoError(t, err)
+	assert.False(t, claimed)
+}
@@ -0,0 +1,305 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+type recordingEventHandler[TEvent entity.Event] struct {
+	events []TEvent
+}
+
+func (h *recordingEventHandler[TEvent]) Handle(_ context.Context, event TEvent) error {
+	h.events = append(h.events, event)
+	return nil
+}
+
+// matching returns the recorded events of the test, the outbox also holds the events of the other tests
+func (h *recordingEventHandler[TEvent]) matching(match func(event TEvent) bool) []TEvent {
+	var events []TEvent
+	for _, event := range h.events {
+		if match(event) {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// failingEventHandler fails to handle the given event
+type failingEventHandler struct {
+	event family.MemberInvited
+}
+
+func (h failingEventHandler) Handle(_ context.Context, event family.MemberInvited) error {
+	if event == h.event {
+		return errors.New("subscriber unavailable")
+	}
+	return nil
+}
+
+type unknownEvent struct{}
+
+func (unknownEvent) EventName() string {
+	return "test.unknown"
+}
+
+func newMemberInvited() family.MemberInvited {
+	return family.MemberInvited{
+		FamilyId: types.NewFamilyID(),
+		MemberId: types.NewFamilyMemberID(),
+	}
+}
+
+// outboxEventOf matches the row of the given event in the outbox
+func outboxEventOf(event family.MemberInvited) postgres.BoolExpression {
+	return table.OutboxEvents.EventName.EQ(postgres.String(family.MemberInvitedEventName)).
+		AND(table.OutboxEvents.Payload.LIKE(postgres.String("%" + event.MemberId.String() + "%")))
+}
+
+func TestOutboxRepository(t *testing.T) {
+	ctx := context.Background()
+	outbox := GetOutboxRepository()
+	invitedOf := func(handler *recordingEventHandler[family.MemberInvited], event family.MemberInvited) int {
+		return len(handler.matching(func(e family.MemberInvited) bool { return e == event }))
+	}
+
+	t.Run("publishes an added event once", func(t *testing.T) {
+		event := newMemberInvited()
+		require.NoError(t, outbox.Add(ctx, event))
+
+		handler := &recordingEventHandler[family.MemberInvited]{}
+		RelayOutbox(ctx, t, ports.NewEventSubscriber[family.MemberInvited](handler))
+		assert.Equal(t, 1, invitedOf(handler, event))
+
+		handler = &recordingEventHandler[family.MemberInvited]{}
+		RelayOutbox(ctx, t, ports.NewEventSubscriber[family.MemberInvited](handler))
+		assert.Zero(t, invitedOf(handler, event))
+	})
+
+	t.Run("events added in a rolled back transaction are not published", func(t *testing.T) {
+		event := newMemberInvited()
+		failure := errors.New("rolled back")
+		err := GetDBContext().WithTransaction(ctx, func(ctx context.Context) error {
+			require.NoError(t, outbox.Add(ctx, event))
+			return failure
+		})
+		require.ErrorIs(t, err, failure)
+
+		handler := &recordingEventHandler[family.MemberInvited]{}
+		RelayOutbox(ctx, t, ports.NewEventSubscriber[family.MemberInvited](handler))
+		assert.Zero(t, invitedOf(handler, event))
+	})
+
+	t.Run("events are published again when the relay is not committed", func(t *testing.T) {
+		event := newMemberInvited()
+		require.NoError(t, outbox.Add(ctx, event))
+
+		failure := errors.New("relay interrupted")
+		interrupted := &recordingEventHandler[family.MemberInvited]{}
+		err := GetDBContext().WithTransaction(ctx, func(ctx context.Context) error {
+			RelayOutbox(ctx, t, ports.NewEventSubscriber[family.MemberInvited](interrupted))
+			return failure
+		})
+		require.ErrorIs(t, err, failure)
+		assert.Equal(t, 1, invitedOf(interrupted, event))
+
+		handler := &recordingEventHandler[family.MemberInvited]{}
+		RelayOutbox(ctx, t, ports.NewEventSubscriber[family.MemberInvited](handler))
+		assert.Equal(t, 1, invitedOf(handler, event))
+	})
+
+	t.Run("concurrent relays skip the leased events", func(t *testing.T) {
+		event := newMemberInvited()
+		require.NoError(t, outbox.Add(ctx, event))
+
+		locked := make(chan struct{})
+		release := make(chan struct{})
+		done := make(chan error, 1)
+		first := &recordingEventHandler[family.MemberInvited]{}
+		publisher := GetEventPublisher(ports.NewEventSubscriber[family.MemberInvited](first))
+		go func() {
+			_, err := outbox.Relay(ctx, 1000, func(ctx context.Context, events ...entity.Event) error {
+				err := publisher.Publish(ctx, events...)
+				for _, e := range events {
+					if e == event {
+						close(locked)
+						<-release
+					}
+				}
+				return err
+			})
+			done <- err
+		}()
+
+		select {
+		case <-locked:
+		case err := <-done:
+			t.Fatalf("the first relay ended before publishing the event: %v", err)
+		case <-time.After(30 * time.Second):
+			t.Fatal("the first relay did not publish the event")
+		}
+		// The first relay holds no lock while its subscribers run
+		err := GetDBContext().WithTransaction(ctx, func(ctx context.Context) error {
+			var rows []model.OutboxEvents
+			stmt := postgres.SELECT(table.OutboxEvents.AllColumns).
+				FROM(table.OutboxEvents).
+				WHERE(outboxEventOf(event)).
+				FOR(postgres.UPDATE().NOWAIT())
+			return GetDBContext().Query(ctx, stmt, &rows)
+		})
+		require.NoError(t, err)
+		second := &recordingEventHandler[family.MemberInvited]{}
+		RelayOutbox(ctx, t, ports.NewEventSubscriber[family.MemberInvited](second))
+		close(release)
+		require.NoError(t, <-done)
+
+		assert.Equal(t, 1, invitedOf(first, event))
+		assert.Zero(t, invitedOf(second, event))
+	})
+
+	t.Run("relays again the events whose lease expired", func(t *testing.T) {
+		event := newMemberInvited()
+		require.NoError(t, outbox.Add(ctx, event))
+
+		// A relay that stopped after claiming the event
+		lease := func(until time.Time) {
+			stmt := table.OutboxEvents.UPDATE().
+				SET(table.OutboxEvents.LockedUntil.SET(postgres.TimestampzT(until))).
+				WHERE(outboxEventOf(event))
+			_, err := GetDBContext().Execute(ctx, stmt)
+			require.NoError(t, err)
+		}
+		lease(time.Now().Add(time.Hour))
+
+		handler := &recordingEventHandler[family.MemberInvited]{}
+		RelayOutbox(ctx, t, ports.NewEventSubscriber[family.MemberInvited](handler))
+		assert.Zero(t, invitedOf(handler, event))
+
+		lease(time.Now().Add(-time.Second))
+		RelayOutbox(ctx, t, ports.NewEventSubscriber[family.MemberInvited](handler))
+		assert.Equal(t, 1, invitedOf(handler, event))
+
+		var rows []model.OutboxEvents
+		query := postgres.SELECT(table.OutboxEvents.AllColumns).
+			FROM(table.OutboxEvents).
+			WHERE(outboxEventOf(event))
+		require.NoError(t, GetDBContext().Query(ctx, query, &rows))
+		require.Len(t, rows, 1)
+		assert.NotNil(t, rows[0].ProcessedAt)
+		assert.Nil(t, rows[0].LockedUntil)
+	})
+
+	t.Run("retries the events whose subscribers failed after a backoff", func(t *testing.T) {
+		event := newMemberInvited()
+		require.NoError(t, outbox.Add(ctx, event))
+
+		RelayOutbox(ctx, t, ports.NewEventSubscriber[family.MemberInvited](failingEventHandler{event: event}))
+
+		var rows []model.OutboxEvents
+		query := postgres.SELECT(table.OutboxEvents.AllColumns).
+			FROM(table.OutboxEvents).
+			WHERE(outboxEventOf(event))
+		require.NoError(t, GetDBContext().Query(ctx, query, &rows))
+		require.Len(t, rows, 1)
+		assert.Nil(t, rows[0].ProcessedAt)
+		assert.Nil(t, rows[0].FailedAt)
+		assert.Equal(t, int32(1), rows[0].Attempts)
+		require.NotNil(t, rows[0].NextAttemptAt)
+		assert.True(t, rows[0].NextAttemptAt.After(time.Now()))
+		assert.Nil(t, rows[0].LockedUntil)
+		require.NotNil(t, rows[0].Error)
+		assert.Contains(t, *rows[0].Error, "subscriber unavailable")
+
+		// The event waits for its next attempt
+		handler := &recordingEventHandler[family.MemberInvited]{}
+		RelayOutbox(ctx, t, ports.NewEventSubscriber[family.MemberInvited](handler))
+		assert.Zero(t, invitedOf(handler, event))
+
+		stmt := table.OutboxEvents.UPDATE().
+			SET(table.OutboxEvents.NextAttemptAt.SET(postgres.TimestampzT(time.Now().Add(-time.Second)))).
+			WHERE(table.OutboxEvents.ID.EQ(postgres.UUID(rows[0].ID)))
+		_, err := GetDBContext().Execute(ctx, stmt)
+		require.NoError(t, err)
+
+		RelayOutbox(ctx, t, ports.NewEventSubscriber[family.MemberInvited](handler))
+		assert.Equal(t, 1, invitedOf(handler, event))
+		rows = nil
+		require.NoError(t, GetDBContext().Query(ctx, query, &rows))
+		require.Len(t, rows, 1)
+		assert.NotNil(t, rows[0].ProcessedAt)
+	})
+
+	t.Run("rejects the events it cannot store", func(t *testing.T) {
+		assert.ErrorIs(t, outbox.Add(ctx, unknownEvent{}), models.ErrUnknownOutboxEvent)
+	})
+
+	t.Run("keeps aside the events it cannot read back", func(t *testing.T) {
+		id := uuid.Must(uuid.NewV7())
+		stmt := table.OutboxEvents.INSERT(
+			table.OutboxEvents.ID,
+			table.OutboxEvents.EventName,
+			table.OutboxEvents.Payload,
+			table.OutboxEvents.CreatedAt,
+			table.OutboxEvents.Attempts,
+		).VALUES(postgres.UUID(id), postgres.String("test.unknown"), postgres.String("{}"),
+			postgres.TimestampzT(time.Now()), postgres.Int32(0))
+		_, err := GetDBContext().Execute(ctx, stmt)
+		require.NoError(t, err)
+
+		report, err := outbox.Relay(ctx, 1000, GetEventPublisher().Publish)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, report.Failed, 1)
+
+		var rows []model.OutboxEvents
+		query := postgres.SELECT(table.OutboxEvents.AllColumns).
+			FROM(table.OutboxEvents).
+			WHERE(table.OutboxEvents.ID.EQ(postgres.UUID(id)))
+		require.NoError(t, GetDBContext().Query(ctx, query, &rows))
+		require.Len(t, rows, 1)
+		assert.Nil(t, rows[0].ProcessedAt)
+		assert.NotNil(t, rows[0].FailedAt)
+		require.NotNil(t, rows[0].Error)
+		assert.Contains(t, *rows[0].Error, "test.unknown")
+
+		// The failed events are not purged with the processed ones
+		_, err = outbox.DeleteProcessedBefore(ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		rows = nil
+		require.NoError(t, GetDBContext().Query(ctx, query, &rows))
+		assert.Len(t, rows, 1)
+	})
+
+	t.Run("deletes the processed events only", func(t *testing.T) {
+		processed := newMemberInvited()
+		require.NoError(t, outbox.Add(ctx, processed))
+		RelayOutbox(ctx, t)
+		pending := newMemberInvited()
+		require.NoError(t, outbox.Add(ctx, pending))
+
+		deleted, err := outbox.DeleteProcessedBefore(ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, deleted, int64(1))
+
+		handler := &recordingEventHandler[family.MemberInvited]{}
+		RelayOutbox(ctx, t, ports.NewEventSubscriber[family.MemberInvited](handler))
+		assert.Equal(t, 1, invitedOf(handler, pending))
+		assert.Zero(t, invitedOf(handler, processed))
+	})
+}
@@ -0,0 +1,98 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/repositories"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	providerDomain "github.com/mistribe/subtracker/internal/domain/provider"
+	subdom "github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func TestPaymentMethodRepository_CRUD(t *testing.T) {
+	ctx := context.Background()
+	repo := repositories.NewPaymentMethodRepository(GetDBContext())
+	provRepo := repositories.NewProviderRepository(GetDBContext())
+	subRepo := repositories.NewSubscriptionRepository(GetDBContext(), GetOutboxRepository())
+
+	userID := types.UserID("user-" + uuid.NewString())
+	card := paymentmethod.NewPaymentMethod(types.NewPaymentMethodID(), types.NewPersonalOwner(userID),
+		paymentmethod.CardType, "Personal Visa", x.P("4242"), x.P(int32(3)), x.P(int32(2027)),
+		time.Now().UTC(), time.Now().UTC())
+	require.NoError(t, repo.Save(ctx, card))
+
+	// GetById / GetByIdForUser
+	stored, err := repo.GetById(ctx, card.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, paymentmethod.CardType, stored.Type())
+	assert.Equal(t, "4242", *stored.LastFour())
+	assert.Equal(t, int32(2027), *stored.ExpiryYear())
+
+	visible, err := repo.GetByIdForUser(ctx, userID, card.Id())
+	require.NoError(t, err)
+	assert.NotNil(t, visible)
+	hidden, err := repo.GetByIdForUser(ctx, types.UserID("someone-else"), card.Id())
+	require.NoError(t, err)
+	assert.Nil(t, hidden)
+
+	// Update
+	stored.SetExpiry(x.P(int32(3)), x.P(int32(2030)))
+	stored.SetLastFour(nil)
+	require.NoError(t, repo.Save(ctx, stored))
+	stored, err = repo.GetById(ctx, card.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, int32(2030), *stored.ExpiryYear())
+	assert.Nil(t, stored.LastFour())
+
+	// GetAll
+	list, total, err := repo.GetAll(ctx, userID, ports.NewPaymentMethodQueryParameters("Visa", 10, 0))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, list, 1)
+	assert.Equal(t, card.Id(), list[0].Id())
+
+	// Subscription reference
+	prov := providerDomain.NewProvider(types.NewProviderID(), "Prov-"+uuid.NewString()[0:8], nil, nil, nil, nil,
+		[]types.LabelID{}, types.SystemOwner, time.Now().UTC(), time.Now().UTC())
+	require.NoError(t, provRepo.Save(ctx, prov))
+	sub := subdom.NewSubscription(types.NewSubscriptionID(), nil, nil, nil, prov.Id(),
+		subdom.NewPrice(currency.NewAmount(9.99, currency.EUR)), nil, nil, types.NewPersonalOwner(userID), nil, nil,
+		x.P(card.Id()), nil, nil, time.Now().AddDate(0, -1, 0).UTC(), nil, nil, subdom.MonthlyRecurrency, nil, nil,
+		time.Now().UTC(), time.Now().UTC())
+	require.NoError(t, subRepo.Save(ctx, sub))
+	storedSub, err := subRepo.GetById(ctx, sub.Id())
+	require.NoError(t, err)
+	require.NotNil(t, storedSub.PaymentMethodId())
+	assert.Equal(t, card.Id(), *storedSub.PaymentMethodId())
+
+	// Delete leaves the subscription without payment method
+	deleted, err := repo.Delete(ctx, card.Id())
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	exists, err := repo.Exists(ctx, card.Id())
+	require.NoError(t, err)
+	assert.False(t, exists)
+	storedSub, err = subRepo.GetById(ctx, sub.Id())
+	require.NoError(t, err)
+	assert.Nil(t, storedSub.PaymentMethodId())
+
+	// Cleanup
+	_, err = subRepo.Delete(ctx, sub.Id())
+	require.NoError(t, err)
+	_, err = provRepo.Delete(ctx, prov.Id())
+	require.NoError(t, err)
+}
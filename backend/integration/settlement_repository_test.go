@@ -0,0 +1,74 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/repositories"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func TestSettlementRepository_CRUD(t *testing.T) {
+	ctx := context.Background()
+	famRepo := repositories.NewFamilyRepository(GetDBContext(), GetOutboxRepository())
+	repo := repositories.NewSettlementRepository(GetDBContext())
+
+	familyID := types.NewFamilyID()
+	alice := family.NewMember(types.NewFamilyMemberID(), familyID, "Alice", family.OwnerMemberType, nil,
+		time.Now().UTC(), time.Now().UTC())
+	bob := family.NewMember(types.NewFamilyMemberID(), familyID, "Bob", family.AdultMemberType, nil,
+		time.Now().UTC(), time.Now().UTC())
+	fam := family.NewFamily(familyID, types.UserID("user-"+uuid.NewString()), "Fam-"+uuid.NewString()[0:8],
+		[]family.Member{alice, bob}, time.Now().UTC(), time.Now().UTC())
+	require.NoError(t, famRepo.Save(ctx, fam))
+
+	note := "Streaming services"
+	first := ledger.NewSettlement(types.NewSettlementID(), familyID, alice.Id(), bob.Id(),
+		currency.NewAmount(30, currency.EUR), time.Now().AddDate(0, 0, -1).UTC(), &note,
+		time.Now().UTC(), time.Now().UTC())
+	second := ledger.NewSettlement(types.NewSettlementID(), familyID, bob.Id(), alice.Id(),
+		currency.NewAmount(5, currency.EUR), time.Now().UTC(), nil, time.Now().UTC(), time.Now().UTC())
+	require.NoError(t, repo.Save(ctx, second, first))
+
+	// GetById
+	stored, err := repo.GetById(ctx, first.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, alice.Id(), stored.From())
+	assert.Equal(t, bob.Id(), stored.To())
+	assert.Equal(t, 30.0, stored.Amount().Value())
+	assert.Equal(t, currency.EUR, stored.Amount().Currency())
+	assert.Equal(t, &note, stored.Note())
+
+	// GetByFamilyId
+	settlements, err := repo.GetByFamilyId(ctx, familyID)
+	require.NoError(t, err)
+	require.Len(t, settlements, 2)
+	assert.Equal(t, first.Id(), settlements[0].Id())
+
+	// Delete
+	deleted, err := repo.Delete(ctx, first.Id())
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	exists, err := repo.Exists(ctx, first.Id())
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// Settlements are deleted with their family
+	famDeleted, err := famRepo.Delete(ctx, familyID)
+	require.NoError(t, err)
+	assert.True(t, famDeleted)
+	exists, err = repo.Exists(ctx, second.Id())
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -9,24 +9,30 @@ import (
 
 	xcur "golang.org/x/text/currency"
 
+	"github.com/go-jet/jet/v2/postgres"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
 	"github.com/mistribe/subtracker/internal/adapters/persistence/repositories"
+	chargedom "github.com/mistribe/subtracker/internal/domain/charge"
 	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/label"
 	providerDomain "github.com/mistribe/subtracker/internal/domain/provider"
 	subdom "github.com/mistribe/subtracker/internal/domain/subscription"
 	"github.com/mistribe/subtracker/internal/domain/types"
 	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/decimal"
+	"github.com/mistribe/subtracker/pkg/x"
 )
 
 func TestSubscriptionRepository_CRUD(t *testing.T) {
 	ctx := context.Background()
 	provRepo := repositories.NewProviderRepository(GetDBContext())
-	subRepo := repositories.NewSubscriptionRepository(GetDBContext())
-	famRepo := repositories.NewFamilyRepository(GetDBContext())
+	subRepo := repositories.NewSubscriptionRepository(GetDBContext(), GetOutboxRepository())
+	famRepo := repositories.NewFamilyRepository(GetDBContext(), GetOutboxRepository())
 	familyId := types.NewFamilyID()
 
 	// create family
@@ -73,21 +79,36 @@ func TestSubscriptionRepository_CRUD(t *testing.T) {
 		types.NewSubscriptionID(),
 		&friendly,
 		nil, // free trial
+		nil, // contract
 		prov.Id(),
 		priceWrapper,
+		nil,                            // price history
+		nil,                            // promotions
 		types.NewFamilyOwner(familyId), // system owned subscription
 		nil,                            // payer
+		nil,                            // cost split
+		nil,                            // payment method
 		[]types.FamilyMemberID{},
 		[]subdom.LabelRef{},
 		time.Now().Add(-24*time.Hour).UTC(), // start yesterday
 		nil,                                 // no end date
+		nil,                                 // pauses
 		subdom.MonthlyRecurrency,
 		nil, // custom recurrency
+		nil, // billing anchor day
 		time.Now().UTC(),
 		time.Now().UTC(),
 	)
 
 	require.NoError(t, subRepo.Save(ctx, sub))
+	assert.Empty(t, sub.Events(), "saved events are cleared")
+	created := &recordingEventHandler[subdom.SubscriptionCreated]{}
+	RelayOutbox(ctx, t, ports.NewEventSubscriber[subdom.SubscriptionCreated](created))
+	createdEvents := created.matching(func(event subdom.SubscriptionCreated) bool {
+		return event.SubscriptionId == sub.Id()
+	})
+	require.Len(t, createdEvents, 1)
+	assert.Equal(t, prov.Id(), createdEvents[0].ProviderId)
 
 	// GetById
 	stored, err := subRepo.GetById(ctx, sub.Id())
@@ -101,6 +122,110 @@ func TestSubscriptionRepository_CRUD(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, exists)
 
+	// Price history
+	require.NoError(t, stored.AddPriceChange(time.Now().Add(-time.Hour).UTC(),
+		currency.NewAmount(12.99, xcur.MustParseISO("USD"))))
+	require.NoError(t, subRepo.Save(ctx, stored))
+	stored, err = subRepo.GetById(ctx, sub.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, 2, stored.PriceHistory().Len())
+	created = &recordingEventHandler[subdom.SubscriptionCreated]{}
+	priceChanged := &recordingEventHandler[subdom.PriceChanged]{}
+	RelayOutbox(ctx, t,
+		ports.NewEventSubscriber[subdom.SubscriptionCreated](created),
+		ports.NewEventSubscriber[subdom.PriceChanged](priceChanged))
+	assert.Empty(t, created.matching(func(event subdom.SubscriptionCreated) bool {
+		return event.SubscriptionId == sub.Id()
+	}), "loading a subscription does not record its creation again")
+	priceChangedEvents := priceChanged.matching(func(event subdom.PriceChanged) bool {
+		return event.SubscriptionId == sub.Id()
+	})
+	require.Len(t, priceChangedEvents, 1)
+	require.NotNil(t, priceChangedEvents[0].Previous)
+	assert.Equal(t, 9.99, priceChangedEvents[0].Previous.Float64())
+	assert.Equal(t, 12.99, priceChangedEvents[0].Amount.Float64())
+	assert.Equal(t, "USD", priceChangedEvents[0].Currency)
+	assert.Equal(t, 12.99, stored.GetPrice().Value())
+	assert.Equal(t, 9.99, stored.PriceAt(sub.StartDate()).Value())
+
+	// Charges
+	chargeRepo := repositories.NewChargeRepository(GetDBContext())
+	rec := chargedom.Reconcile(stored, nil, time.Now())
+	require.Len(t, rec.Created, 1)
+	require.NoError(t, chargeRepo.Save(ctx, rec.Created...))
+	chg := rec.Created[0]
+	require.NoError(t, chg.Record(chargedom.PaidStatus, currency.NewAmount(10.5, xcur.MustParseISO("USD"))))
+	require.NoError(t, chargeRepo.Save(ctx, chg))
+	charges, err := chargeRepo.GetBySubscriptionId(ctx, sub.Id())
+	require.NoError(t, err)
+	require.Len(t, charges, 1)
+	assert.Equal(t, chargedom.PaidStatus, charges[0].Status())
+	assert.Equal(t, 10.5, charges[0].Amount().Value())
+	assert.Empty(t, chargedom.Reconcile(stored, charges, time.Now()).Created)
+	recorded, err := chargeRepo.GetRecordedBySubscriptionIds(ctx, sub.Id())
+	require.NoError(t, err)
+	assert.Len(t, recorded, 1)
+
+	// Pauses
+	require.NoError(t, stored.Pause(time.Now().Add(-30*time.Minute).UTC(), nil))
+	require.NoError(t, subRepo.Save(ctx, stored))
+	stored, err = subRepo.GetById(ctx, sub.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	require.Equal(t, 1, stored.Pauses().Len())
+	assert.True(t, stored.IsPaused())
+	assert.False(t, stored.IsActive())
+	require.NoError(t, stored.Resume(time.Now().Add(-10*time.Minute).UTC()))
+	require.NoError(t, subRepo.Save(ctx, stored))
+	stored, err = subRepo.GetById(ctx, sub.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	require.Equal(t, 1, stored.Pauses().Len())
+	assert.NotNil(t, stored.Pauses().GetAt(0).EndDate)
+	assert.False(t, stored.IsPaused())
+
+	// Contract
+	minimumTerm, noticePeriod := int32(12), int32(30)
+	stored.SetContract(subdom.NewContract(&minimumTerm, true, &noticePeriod))
+	require.NoError(t, subRepo.Save(ctx, stored))
+	stored, err = subRepo.GetById(ctx, sub.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	require.NotNil(t, stored.Contract())
+	assert.True(t, stored.Contract().AutoRenew())
+	assert.Equal(t, &minimumTerm, stored.Contract().MinimumTerm())
+	assert.Equal(t, &noticePeriod, stored.Contract().NoticePeriod())
+	assert.NotNil(t, stored.CancellationDeadline())
+
+	// Promotions
+	promotionEnd := time.Now().AddDate(0, 2, 0).UTC()
+	require.NoError(t, stored.AddPromotion(stored.StartDate(), promotionEnd,
+		currency.NewAmount(4.99, xcur.MustParseISO("USD"))))
+	require.NoError(t, subRepo.Save(ctx, stored))
+	stored, err = subRepo.GetById(ctx, sub.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	require.Equal(t, 1, stored.Promotions().Len())
+	assert.Equal(t, 4.99, stored.GetPrice().Value())
+	assert.Equal(t, 12.99, stored.Price().Amount().Value())
+	require.NotNil(t, stored.PromotionEndDate())
+	assert.Equal(t, promotionEnd.Unix(), stored.PromotionEndDate().Unix())
+
+	// Cost split
+	stored.SetFamilyUsers([]types.FamilyMemberID{m.Id()})
+	require.NoError(t, stored.SetCostSplit(subdom.NewCostSplit(subdom.PercentageCostSplit,
+		[]subdom.CostShare{{MemberId: m.Id(), Value: decimal.NewFromInt(100)}})))
+	require.NoError(t, subRepo.Save(ctx, stored))
+	stored, err = subRepo.GetById(ctx, sub.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	require.NotNil(t, stored.CostSplit())
+	assert.Equal(t, subdom.PercentageCostSplit, stored.CostSplit().Type())
+	require.Len(t, stored.CostSplit().Shares(), 1)
+	assert.Equal(t, m.Id(), stored.CostSplit().Shares()[0].MemberId)
+	assert.Equal(t, 4.99, stored.GetMemberShares(subdom.MonthlyRecurrency)[m.Id()].Value())
+
 	// Pagination (GetAll)
 	params := ports.NewSubscriptionQueryParameters(
 		"",                        // searchText
@@ -109,6 +234,8 @@ func TestSubscriptionRepository_CRUD(t *testing.T) {
 		[]types.UserID{},     // users
 		[]types.ProviderID{}, // providers
 		true,                 // withInactive
+		nil,                  // cancellationDeadlineFrom
+		nil,                  // cancellationDeadlineTo
 		50, 0,                // limit, offset
 	)
 	list, total, err := subRepo.GetAll(ctx, params)
@@ -132,6 +259,10 @@ func TestSubscriptionRepository_CRUD(t *testing.T) {
 	require.NoError(t, err)
 	assert.False(t, exists)
 
+	chargeExists, err := chargeRepo.Exists(ctx, chg.Id())
+	require.NoError(t, err)
+	assert.False(t, chargeExists, "charges are deleted with their subscription")
+
 	// Cleanup provider
 	provDeleted, err := provRepo.Delete(ctx, prov.Id())
 	require.NoError(t, err)
@@ -142,3 +273,426 @@ func TestSubscriptionRepository_CRUD(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, famDeleted)
 }
+
+func TestSubscriptionRepository_Labels(t *testing.T) {
+	ctx := context.Background()
+	provRepo := repositories.NewProviderRepository(GetDBContext())
+	labelRepo := repositories.NewLabelRepository(GetDBContext())
+	subRepo := repositories.NewSubscriptionRepository(GetDBContext(), GetOutboxRepository())
+
+	userId := types.UserID("user-" + uuid.NewString())
+	owner := types.NewPersonalOwner(userId)
+	newLabel := func() label.Label {
+		lbl := label.NewLabel(types.NewLabelID(), owner, "Label-"+uuid.NewString()[0:8], nil, "#FF0000",
+			time.Now().UTC(), time.Now().UTC())
+		require.NoError(t, labelRepo.Save(ctx, lbl))
+		return lbl
+	}
+	first, second := newLabel(), newLabel()
+
+	prov := providerDomain.NewProvider(types.NewProviderID(), "Prov-"+uuid.NewString()[0:8], nil, nil, nil, nil,
+		[]types.LabelID{}, types.SystemOwner, time.Now().UTC(), time.Now().UTC())
+	require.NoError(t, provRepo.Save(ctx, prov))
+
+	sub := subdom.NewSubscription(types.NewSubscriptionID(), nil, nil, nil, prov.Id(),
+		subdom.NewPrice(currency.NewAmount(9.99, xcur.MustParseISO("USD"))), nil, nil, owner, nil, nil, nil,
+		[]types.FamilyMemberID{},
+		[]subdom.LabelRef{{LabelId: first.Id(), Source: subdom.LabelSourceSubscription}},
+		time.Now().Add(-24*time.Hour).UTC(), nil, nil, subdom.MonthlyRecurrency, nil, nil,
+		time.Now().UTC(), time.Now().UTC())
+	load := func() subdom.Subscription {
+		list, _, err := subRepo.GetAllForUser(ctx, userId, ports.NewSubscriptionQueryParameters(
+			"", nil, nil, nil, nil, []types.ProviderID{prov.Id()}, true, nil, nil, 10, 0))
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		byId, err := subRepo.GetById(ctx, sub.Id())
+		require.NoError(t, err)
+		require.NotNil(t, byId)
+		assert.ElementsMatch(t, list[0].Labels().Values(), byId.Labels().Values())
+		return list[0]
+	}
+	labelIds := func(sub subdom.Subscription) []types.LabelID {
+		var ids []types.LabelID
+		for ref := range sub.Labels().It() {
+			ids = append(ids, ref.LabelId)
+		}
+		return ids
+	}
+
+	// Created with its labels
+	require.NoError(t, subRepo.Save(ctx, sub))
+	stored := load()
+	assert.Equal(t, []types.LabelID{first.Id()}, labelIds(stored))
+
+	// Labels added and removed on update
+	stored.Labels().Remove(subdom.LabelRef{LabelId: first.Id(), Source: subdom.LabelSourceSubscription})
+	stored.Labels().Add(subdom.LabelRef{LabelId: second.Id(), Source: subdom.LabelSourceSubscription})
+	require.NoError(t, subRepo.Save(ctx, stored))
+	assert.False(t, stored.Labels().HasChanges())
+	stored = load()
+	assert.Equal(t, []types.LabelID{second.Id()}, labelIds(stored))
+
+	// Cleanup
+	_, err := subRepo.Delete(ctx, sub.Id())
+	require.NoError(t, err)
+	_, err = provRepo.Delete(ctx, prov.Id())
+	require.NoError(t, err)
+}
+
+// TestSubscriptionRepository_ChildCollections checks that every child collection is loaded once per row, whatever
+// the number of rows of the other collections
+func TestSubscriptionRepository_ChildCollections(t *testing.T) {
+	ctx := context.Background()
+	provRepo := repositories.NewProviderRepository(GetDBContext())
+	labelRepo := repositories.NewLabelRepository(GetDBContext())
+	famRepo := repositories.NewFamilyRepository(GetDBContext(), GetOutboxRepository())
+	subRepo := repositories.NewSubscriptionRepository(GetDBContext(), GetOutboxRepository())
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	userId := types.UserID("user-" + uuid.NewString())
+	familyId := types.NewFamilyID()
+	owner := family.NewMember(types.NewFamilyMemberID(), familyId, "Owner", family.OwnerMemberType, nil, now, now)
+	owner.SetUserId(&userId)
+	kid := family.NewMember(types.NewFamilyMemberID(), familyId, "Kid", family.KidMemberType, nil, now, now)
+	fam := family.NewFamily(familyId, userId, "Fam-"+uuid.NewString()[0:8], []family.Member{owner, kid}, now, now)
+	require.NoError(t, famRepo.Save(ctx, fam))
+
+	labelOwner := types.NewFamilyOwner(familyId)
+	newLabel := func() label.Label {
+		lbl := label.NewLabel(types.NewLabelID(), labelOwner, "Label-"+uuid.NewString()[0:8], nil, "#FF0000", now,
+			now)
+		require.NoError(t, labelRepo.Save(ctx, lbl))
+		return lbl
+	}
+	first, second, third := newLabel(), newLabel(), newLabel()
+
+	prov := providerDomain.NewProvider(types.NewProviderID(), "Prov-"+uuid.NewString()[0:8], nil, nil, nil, nil,
+		[]types.LabelID{third.Id()}, types.SystemOwner, now, now)
+	require.NoError(t, provRepo.Save(ctx, prov))
+
+	usd := xcur.MustParseISO("USD")
+	startDate := now.AddDate(0, 0, -100)
+	pauseEnd := startDate.AddDate(0, 0, 30)
+	sub := subdom.NewSubscription(types.NewSubscriptionID(), nil, nil, nil, prov.Id(),
+		subdom.NewPrice(currency.NewAmount(12.99, usd)),
+		[]subdom.PriceChange{
+			{EffectiveFrom: startDate, Amount: currency.NewAmount(9.99, usd)},
+			{EffectiveFrom: startDate.AddDate(0, 0, 50), Amount: currency.NewAmount(12.99, usd)},
+		},
+		[]subdom.Promotion{
+			{StartDate: startDate, EndDate: startDate.AddDate(0, 0, 10), Amount: currency.NewAmount(4.99, usd)},
+			{StartDate: startDate.AddDate(0, 0, 60), EndDate: startDate.AddDate(0, 0, 70),
+				Amount: currency.NewAmount(5.99, usd)},
+		},
+		types.NewFamilyOwner(familyId), nil,
+		subdom.NewCostSplit(subdom.PercentageCostSplit, []subdom.CostShare{
+			{MemberId: owner.Id(), Value: decimal.NewFromInt(60)},
+			{MemberId: kid.Id(), Value: decimal.NewFromInt(40)},
+		}),
+		nil,
+		[]types.FamilyMemberID{owner.Id(), kid.Id()},
+		[]subdom.LabelRef{
+			{LabelId: first.Id(), Source: subdom.LabelSourceSubscription},
+			{LabelId: second.Id(), Source: subdom.LabelSourceSubscription},
+		},
+		startDate, nil,
+		[]subdom.Pause{
+			{StartDate: startDate.AddDate(0, 0, 20), EndDate: &pauseEnd},
+			{StartDate: startDate.AddDate(0, 0, 80), EndDate: x.P(startDate.AddDate(0, 0, 90))},
+		},
+		subdom.MonthlyRecurrency, nil, nil, now, now)
+	require.NoError(t, subRepo.Save(ctx, sub))
+
+	assertChildren := func(t *testing.T, stored subdom.Subscription) {
+		assert.ElementsMatch(t, []types.FamilyMemberID{owner.Id(), kid.Id()}, stored.FamilyUsers().Values())
+		assert.ElementsMatch(t, []subdom.LabelRef{
+			{LabelId: first.Id(), Source: subdom.LabelSourceSubscription},
+			{LabelId: second.Id(), Source: subdom.LabelSourceSubscription},
+			{LabelId: third.Id(), Source: subdom.LabelSourceProvider},
+		}, stored.Labels().Values())
+		assert.Len(t, stored.PriceHistory().Values(), 2)
+		assert.Len(t, stored.Promotions().Values(), 2)
+		assert.Len(t, stored.Pauses().Values(), 2)
+		require.NotNil(t, stored.CostSplit())
+		assert.Len(t, stored.CostSplit().Shares(), 2)
+	}
+
+	t.Run("by id", func(t *testing.T) {
+		stored, err := subRepo.GetById(ctx, sub.Id())
+		require.NoError(t, err)
+		require.NotNil(t, stored)
+		assertChildren(t, stored)
+	})
+
+	t.Run("by id for user", func(t *testing.T) {
+		stored, err := subRepo.GetByIdForUser(ctx, userId, sub.Id())
+		require.NoError(t, err)
+		require.NotNil(t, stored)
+		assertChildren(t, stored)
+	})
+
+	t.Run("paged list", func(t *testing.T) {
+		list, total, err := subRepo.GetAllForUser(ctx, userId, ports.NewSubscriptionQueryParameters(
+			"", nil, nil, nil, nil, []types.ProviderID{prov.Id()}, true, nil, nil, 10, 0))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, list, 1)
+		assertChildren(t, list[0])
+	})
+
+	// Cleanup
+	_, err := subRepo.Delete(ctx, sub.Id())
+	require.NoError(t, err)
+	_, err = provRepo.Delete(ctx, prov.Id())
+	require.NoError(t, err)
+	_, err = famRepo.Delete(ctx, familyId)
+	require.NoError(t, err)
+}
+
+func TestSubscriptionRepository_ActiveFilter(t *testing.T) {
+	ctx := context.Background()
+	provRepo := repositories.NewProviderRepository(GetDBContext())
+	subRepo := repositories.NewSubscriptionRepository(GetDBContext(), GetOutboxRepository())
+	now := time.Now().UTC()
+
+	userId := types.UserID("user-" + uuid.NewString())
+	owner := types.NewPersonalOwner(userId)
+	prov := providerDomain.NewProvider(types.NewProviderID(), "Prov-"+uuid.NewString()[0:8], nil, nil, nil, nil,
+		[]types.LabelID{}, types.SystemOwner, now, now)
+	require.NoError(t, provRepo.Save(ctx, prov))
+
+	newSubscription := func(name string, endDate *time.Time, pauses []subdom.Pause) subdom.Subscription {
+		sub := subdom.NewSubscription(types.NewSubscriptionID(), &name, nil, nil, prov.Id(),
+			subdom.NewPrice(currency.NewAmount(9.99, xcur.MustParseISO("USD"))), nil, nil, owner, nil, nil, nil,
+			[]types.FamilyMemberID{}, []subdom.LabelRef{}, now.AddDate(0, -3, 0), endDate, pauses,
+			subdom.MonthlyRecurrency, nil, nil, now, now)
+		require.NoError(t, subRepo.Save(ctx, sub))
+		return sub
+	}
+	active := newSubscription("Active", nil, nil)
+	resumed := newSubscription("Resumed", nil, []subdom.Pause{
+		{StartDate: now.AddDate(0, -2, 0), EndDate: x.P(now.AddDate(0, -1, 0))},
+	})
+	paused := newSubscription("Paused", nil, []subdom.Pause{{StartDate: now.AddDate(0, 0, -1)}})
+	pausedUntil := newSubscription("Paused until", nil, []subdom.Pause{
+		{StartDate: now.AddDate(0, 0, -1), EndDate: x.P(now.AddDate(0, 0, 7))},
+	})
+	ended := newSubscription("Ended", x.P(now.AddDate(0, 0, -1)), nil)
+
+	list := func(withInactive bool) []types.SubscriptionID {
+		subs, _, err := subRepo.GetAllForUser(ctx, userId, ports.NewSubscriptionQueryParameters(
+			"", nil, nil, nil, nil, []types.ProviderID{prov.Id()}, withInactive, nil, nil, 10, 0))
+		require.NoError(t, err)
+		var ids []types.SubscriptionID
+		for _, sub := range subs {
+			ids = append(ids, sub.Id())
+		}
+		return ids
+	}
+
+	assert.ElementsMatch(t, []types.SubscriptionID{active.Id(), resumed.Id()}, list(false))
+	assert.ElementsMatch(t,
+		[]types.SubscriptionID{active.Id(), resumed.Id(), paused.Id(), pausedUntil.Id(), ended.Id()}, list(true))
+
+	// Cleanup
+	for _, sub := range []subdom.Subscription{active, resumed, paused, pausedUntil, ended} {
+		_, err := subRepo.Delete(ctx, sub.Id())
+		require.NoError(t, err)
+	}
+	_, err := provRepo.Delete(ctx, prov.Id())
+	require.NoError(t, err)
+}
+
+// TestSubscriptionRepository_CancellationDeadline checks that the cancellation deadline stored on save and used by
+// the filter agrees with Subscription.CancellationDeadline
+func TestSubscriptionRepository_CancellationDeadline(t *testing.T) {
+	ctx := context.Background()
+	provRepo := repositories.NewProviderRepository(GetDBContext())
+	subRepo := repositories.NewSubscriptionRepository(GetDBContext(), GetOutboxRepository())
+
+	prov := providerDomain.NewProvider(types.NewProviderID(), "Prov-"+uuid.NewString()[0:8], nil, nil, nil, nil,
+		[]types.LabelID{}, types.SystemOwner, time.Now().UTC(), time.Now().UTC())
+	require.NoError(t, provRepo.Save(ctx, prov))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	monthEnd := time.Date(now.Year()-1, time.January, 31, 9, 30, 0, 0, time.UTC)
+	anchor := func(day int32) *int32 { return &day }
+	months := func(n int32) *int32 { return &n }
+
+	cases := []struct {
+		name             string
+		startDate        time.Time
+		recurrency       subdom.RecurrencyType
+		customRecurrency *subdom.Interval
+		billingAnchorDay *int32
+		contract         subdom.Contract
+		pauses           []subdom.Pause
+	}{
+		{
+			name:       "monthly from the end of a month",
+			startDate:  monthEnd,
+			recurrency: subdom.MonthlyRecurrency,
+			contract:   subdom.NewContract(nil, true, months(3)),
+		},
+		{
+			name:       "monthly within the minimum term",
+			startDate:  now.AddDate(0, -2, 0),
+			recurrency: subdom.MonthlyRecurrency,
+			contract:   subdom.NewContract(months(12), true, months(30)),
+		},
+		{
+			name:             "quarterly anchored to the end of the month",
+			startDate:        now.AddDate(0, -7, -3),
+			recurrency:       subdom.QuarterlyRecurrency,
+			billingAnchorDay: anchor(31),
+			contract:         subdom.NewContract(nil, true, months(5)),
+		},
+		{
+			name:             "monthly anchored to the 15th",
+			startDate:        now.AddDate(0, -5, 0),
+			recurrency:       subdom.MonthlyRecurrency,
+			billingAnchorDay: anchor(15),
+			contract:         subdom.NewContract(nil, true, nil),
+		},
+		{
+			name:             "custom every 2 months anchored to the 30th",
+			startDate:        now.AddDate(-1, 0, 0),
+			recurrency:       subdom.CustomRecurrency,
+			customRecurrency: subdom.NewInterval(subdom.MonthIntervalUnit, 2),
+			billingAnchorDay: anchor(30),
+			contract:         subdom.NewContract(nil, true, months(7)),
+		},
+		{
+			name:             "custom every 10 days",
+			startDate:        now.AddDate(0, 0, -403),
+			recurrency:       subdom.CustomRecurrency,
+			customRecurrency: subdom.NewInterval(subdom.DayIntervalUnit, 10),
+			contract:         subdom.NewContract(nil, true, months(3)),
+		},
+		{
+			name:             "custom every 2 weeks",
+			startDate:        now.AddDate(0, 0, -300),
+			recurrency:       subdom.CustomRecurrency,
+			customRecurrency: subdom.NewInterval(subdom.WeekIntervalUnit, 2),
+			contract:         subdom.NewContract(nil, true, months(2)),
+		},
+		{
+			name:       "yearly",
+			startDate:  now.AddDate(-3, 2, 0),
+			recurrency: subdom.YearlyRecurrency,
+			contract:   subdom.NewContract(nil, true, months(30)),
+		},
+		{
+			name:       "monthly paused over the next renewals",
+			startDate:  now.AddDate(0, -6, 0),
+			recurrency: subdom.MonthlyRecurrency,
+			contract:   subdom.NewContract(nil, true, nil),
+			pauses: []subdom.Pause{{
+				StartDate: now.AddDate(0, 0, -1),
+				EndDate:   x.P(now.AddDate(0, 2, 0)),
+			}},
+		},
+		{
+			name:       "monthly paused indefinitely",
+			startDate:  now.AddDate(0, -6, 0),
+			recurrency: subdom.MonthlyRecurrency,
+			contract:   subdom.NewContract(nil, true, nil),
+			pauses:     []subdom.Pause{{StartDate: now.AddDate(0, 0, -1)}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			userId := types.UserID("user-" + uuid.NewString())
+			sub := subdom.NewSubscription(types.NewSubscriptionID(), nil, nil, tc.contract, prov.Id(),
+				subdom.NewPrice(currency.NewAmount(9.99, xcur.MustParseISO("USD"))), nil, nil,
+				types.NewPersonalOwner(userId), nil, nil, nil, []types.FamilyMemberID{}, []subdom.LabelRef{},
+				tc.startDate, nil, tc.pauses, tc.recurrency, tc.customRecurrency, tc.billingAnchorDay,
+				time.Now().UTC(), time.Now().UTC())
+			require.NoError(t, subRepo.Save(ctx, sub))
+			t.Cleanup(func() {
+				_, err := subRepo.Delete(ctx, sub.Id())
+				require.NoError(t, err)
+			})
+
+			within := func(from, to time.Time) bool {
+				list, _, err := subRepo.GetAllForUser(ctx, userId, ports.NewSubscriptionQueryParameters(
+					"", nil, nil, nil, nil, []types.ProviderID{prov.Id()}, true, &from, &to, 10, 0))
+				require.NoError(t, err)
+				return len(list) == 1
+			}
+
+			expected := sub.CancellationDeadline()
+			if expected == nil {
+				assert.False(t, within(now, now.AddDate(10, 0, 0)), "no deadline is expected")
+				return
+			}
+			assert.True(t, within(expected.Add(-time.Minute), expected.Add(time.Minute)),
+				"the deadline %s is matched", expected)
+			assert.False(t, within(now, expected.Add(-time.Minute)), "no deadline is found before %s", expected)
+		})
+	}
+
+	_, err := provRepo.Delete(ctx, prov.Id())
+	require.NoError(t, err)
+}
+
+func TestSubscriptionRepository_StaleCancellationDeadline(t *testing.T) {
+	ctx := context.Background()
+	provRepo := repositories.NewProviderRepository(GetDBContext())
+	subRepo := repositories.NewSubscriptionRepository(GetDBContext(), GetOutboxRepository())
+	now := time.Now().UTC()
+
+	prov := providerDomain.NewProvider(types.NewProviderID(), "Prov-"+uuid.NewString()[0:8], nil, nil, nil, nil,
+		[]types.LabelID{}, types.SystemOwner, now, now)
+	require.NoError(t, provRepo.Save(ctx, prov))
+
+	sub := subdom.NewSubscription(types.NewSubscriptionID(), nil, nil, subdom.NewContract(nil, true, nil), prov.Id(),
+		subdom.NewPrice(currency.NewAmount(9.99, xcur.MustParseISO("USD"))), nil, nil,
+		types.NewPersonalOwner(types.UserID("user-"+uuid.NewString())), nil, nil, nil, []types.FamilyMemberID{},
+		[]subdom.LabelRef{}, now.AddDate(0, -3, 0), nil, nil, subdom.MonthlyRecurrency, nil, nil, now, now)
+	require.NoError(t, subRepo.Save(ctx, sub))
+	stale := func() bool {
+		for offset := int64(0); ; offset += 100 {
+			subs, err := subRepo.GetAllWithStaleCancellationDeadline(ctx, time.Now(), 100, offset)
+			require.NoError(t, err)
+			for _, candidate := range subs {
+				if candidate.Id() == sub.Id() {
+					return true
+				}
+			}
+			if len(subs) < 100 {
+				return false
+			}
+		}
+	}
+
+	assert.False(t, stale(), "the deadline stored on create is upcoming")
+
+	// The stored deadline passes
+	stmt := table.Subscriptions.UPDATE().
+		SET(table.Subscriptions.CancellationDeadline.SET(postgres.TimestampzT(now.AddDate(0, 0, -1)))).
+		WHERE(table.Subscriptions.ID.EQ(postgres.UUID(sub.Id())))
+	_, err := GetDBContext().Execute(ctx, stmt)
+	require.NoError(t, err)
+	assert.True(t, stale())
+
+	// Saving the subscription stores its upcoming deadline again
+	stored, err := subRepo.GetById(ctx, sub.Id())
+	require.NoError(t, err)
+	require.NoError(t, subRepo.Save(ctx, stored))
+	assert.False(t, stale())
+	deadline := stored.CancellationDeadline()
+	require.NotNil(t, deadline)
+	list, _, err := subRepo.GetAllForUser(ctx, stored.Owner().UserId(), ports.NewSubscriptionQueryParameters(
+		"", nil, nil, nil, nil, []types.ProviderID{prov.Id()}, true, x.P(deadline.Add(-time.Minute)),
+		x.P(deadline.Add(time.Minute)), 10, 0))
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	// Cleanup
+	_, err = subRepo.Delete(ctx, sub.Id())
+	require.NoError(t, err)
+	_, err = provRepo.Delete(ctx, prov.Id())
+	require.NoError(t, err)
+}
@@ -0,0 +1,123 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/repositories"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+func TestWebhookEndpointRepository_CRUD(t *testing.T) {
+	ctx := context.Background()
+	repo := repositories.NewWebhookEndpointRepository(GetDBContext())
+
+	userID := types.UserID("user-" + uuid.NewString())
+	endpoint, err := webhook.CreateEndpoint(userID, "https://example.com/hooks",
+		[]webhook.EventType{webhook.LabelCreatedEventType, webhook.RenewalDueEventType})
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, endpoint))
+
+	stored, err := repo.GetByIdForUser(ctx, userID, endpoint.Id())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, endpoint.Secret(), stored.Secret())
+	assert.ElementsMatch(t, endpoint.EventTypes(), stored.EventTypes())
+	assert.Equal(t, endpoint.ETag(), stored.ETag())
+
+	other, err := repo.GetByIdForUser(ctx, types.UserID("user-"+uuid.NewString()), endpoint.Id())
+	require.NoError(t, err)
+	assert.Nil(t, other)
+
+	endpoint.SetUrl("https://example.com/other")
+	endpoint.SetEventTypes([]webhook.EventType{webhook.SubscriptionDeletedEventType})
+	require.NoError(t, repo.Save(ctx, endpoint))
+
+	endpoints, err := repo.GetAllByUserId(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, endpoints, 1)
+	assert.Equal(t, "https://example.com/other", endpoints[0].Url())
+	assert.Equal(t, []webhook.EventType{webhook.SubscriptionDeletedEventType}, endpoints[0].EventTypes())
+
+	accepting, err := repo.GetAllAccepting(ctx, webhook.SubscriptionDeletedEventType)
+	require.NoError(t, err)
+	var found bool
+	for _, e := range accepting {
+		found = found || e.Id() == endpoint.Id()
+	}
+	assert.True(t, found)
+
+	deleted, err := repo.Delete(ctx, endpoint.Id())
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	stored, err = repo.GetById(ctx, endpoint.Id())
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+}
+
+func TestWebhookDeliveryRepository_Enqueue(t *testing.T) {
+	ctx := context.Background()
+	endpointRepo := repositories.NewWebhookEndpointRepository(GetDBContext())
+	repo := repositories.NewWebhookDeliveryRepository(GetDBContext())
+
+	endpoint, err := webhook.CreateEndpoint(types.UserID("user-"+uuid.NewString()), "https://example.com/hooks", nil)
+	require.NoError(t, err)
+	require.NoError(t, endpointRepo.Save(ctx, endpoint))
+
+	eventID := uuid.NewString()
+	payload := []byte(`{"id":"` + eventID + `","type":"renewal.due"}`)
+	delivery := webhook.NewPendingDelivery(endpoint.Id(), eventID, webhook.RenewalDueEventType, payload)
+	enqueued, err := repo.Enqueue(ctx, delivery)
+	require.NoError(t, err)
+	assert.True(t, enqueued)
+
+	// Publishing the same event again does not deliver it twice
+	enqueued, err = repo.Enqueue(ctx,
+		webhook.NewPendingDelivery(endpoint.Id(), eventID, webhook.RenewalDueEventType, payload))
+	require.NoError(t, err)
+	assert.False(t, enqueued)
+
+	due, err := repo.GetDue(ctx, time.Now(), 1000)
+	require.NoError(t, err)
+	var found bool
+	for _, d := range due {
+		found = found || d.Id() == delivery.Id()
+	}
+	assert.True(t, found)
+
+	// Another instance leasing the same delivery gets nothing
+	now := time.Now()
+	leased, err := repo.Lease(ctx, delivery.Id(), now, now.Add(5*time.Minute))
+	require.NoError(t, err)
+	assert.True(t, leased)
+	leased, err = repo.Lease(ctx, delivery.Id(), now, now.Add(5*time.Minute))
+	require.NoError(t, err)
+	assert.False(t, leased)
+
+	delivery.RecordSuccess(200, time.Now())
+	require.NoError(t, repo.Save(ctx, delivery))
+
+	// A redelivery of the same event is a new entry of the log
+	redelivery, err := delivery.Redeliver()
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, redelivery))
+
+	deliveries, total, err := repo.GetAllByEndpointId(ctx, endpoint.Id(), ports.NewQueryParameters(10, 0))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, redelivery.Id(), deliveries[0].Id())
+	assert.Equal(t, webhook.SucceededDeliveryStatus, deliveries[1].Status())
+	require.NotNil(t, deliveries[1].LastStatusCode())
+	assert.Equal(t, 200, *deliveries[1].LastStatusCode())
+	assert.JSONEq(t, string(payload), string(deliveries[1].Payload()))
+}
@@ -45,6 +45,22 @@ func (c *clerkIdentityProvider) DeleteUser(ctx context.Context, userId types.Use
 	return err
 }
 
+func (c *clerkIdentityProvider) GetUserEmail(ctx context.Context, userId types.UserID) (string, error) {
+	u, err := c.userClient.Get(ctx, userId.String())
+	if err != nil {
+		return "", err
+	}
+	if u.PrimaryEmailAddressID == nil {
+		return "", nil
+	}
+	for _, address := range u.EmailAddresses {
+		if address != nil && address.ID == *u.PrimaryEmailAddressID {
+			return address.EmailAddress, nil
+		}
+	}
+	return "", nil
+}
+
 func (c *clerkIdentityProvider) ReadSessionToken(ctx context.Context, sessionToken string) (ports.Identity, error) {
 	// Decode the session JWT to find the key LabelID
 	unsafeClaims, err := jwt.Decode(ctx, &jwt.DecodeParams{
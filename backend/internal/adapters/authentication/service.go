@@ -21,3 +21,7 @@ func (s authentication) MustGetConnectedAccount(ctx context.Context) account.Con
 	}
 	return acc
 }
+
+func (s authentication) GetConnectedAccount(ctx context.Context) (account.ConnectedAccount, bool) {
+	return GetAccountFromContext(ctx)
+}
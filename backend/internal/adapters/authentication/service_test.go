@@ -19,6 +19,7 @@ func newTestAccount() account.Account {
 	return account.New(
 		"user-123",
 		&cur,
+		nil,
 		types.PlanFree,
 		types.RoleUser,
 		nil,
@@ -61,3 +62,15 @@ func TestAuthentication_MustGetConnectedAccount(t *testing.T) {
 		})
 	})
 }
+
+func TestAuthentication_GetConnectedAccount(t *testing.T) {
+	service := NewAuthentication()
+	acc := newTestAccount()
+
+	got, ok := service.GetConnectedAccount(context.WithValue(context.Background(), ContextConnectedAccountKey, acc))
+	require.True(t, ok)
+	require.Equal(t, acc, got)
+
+	_, ok = service.GetConnectedAccount(context.Background())
+	require.False(t, ok)
+}
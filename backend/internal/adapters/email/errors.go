@@ -0,0 +1,10 @@
+package email
+
+import (
+	"errors"
+)
+
+var (
+	ErrNotConfigured   = errors.New("no SMTP server configured")
+	ErrUnknownTemplate = errors.New("no email template for the event")
+)
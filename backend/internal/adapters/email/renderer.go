@@ -0,0 +1,117 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+//go:embed templates
+var templates embed.FS
+
+var frenchMonths = [...]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août",
+	"septembre", "octobre", "novembre", "décembre"}
+
+// reminderData is what the templates of a reminder are executed with
+type reminderData struct {
+	Locale string
+	Title  string
+	Date   string
+	// Price is empty when the price of the renewal is unknown
+	Price string
+}
+
+// templateRenderer writes the reminders from the templates of each locale, the subject and the plain text body
+// come from <locale>.txt.tmpl and the HTML body from <locale>.html.tmpl. The templates of an event are named
+// after its kind, e.g. renewal.subject, renewal.text and renewal.html.
+type templateRenderer struct {
+	text map[notification.Locale]*texttemplate.Template
+	html map[notification.Locale]*htmltemplate.Template
+}
+
+func NewReminderRenderer() (ports.ReminderRenderer, error) {
+	r := &templateRenderer{
+		text: make(map[notification.Locale]*texttemplate.Template),
+		html: make(map[notification.Locale]*htmltemplate.Template),
+	}
+	for _, locale := range []notification.Locale{notification.EnglishLocale, notification.FrenchLocale} {
+		text, err := texttemplate.ParseFS(templates, fmt.Sprintf("templates/%s.txt.tmpl", locale))
+		if err != nil {
+			return nil, err
+		}
+		html, err := htmltemplate.ParseFS(templates, fmt.Sprintf("templates/%s.html.tmpl", locale))
+		if err != nil {
+			return nil, err
+		}
+		r.text[locale] = text
+		r.html[locale] = html
+	}
+	return r, nil
+}
+
+func (r *templateRenderer) Render(locale notification.Locale, event calendar.Event) (ports.Email, error) {
+	text, ok := r.text[locale]
+	if !ok {
+		locale = notification.DefaultLocale
+		text = r.text[locale]
+	}
+	html := r.html[locale]
+
+	data := reminderData{
+		Locale: locale.String(),
+		Title:  event.Title,
+		Date:   formatDate(locale, event.Date),
+		Price:  formatPrice(locale, event.Price),
+	}
+	kind := event.Kind.String()
+	if text.Lookup(kind+".subject") == nil || text.Lookup(kind+".text") == nil || html.Lookup(kind+".html") == nil {
+		return ports.Email{}, ErrUnknownTemplate
+	}
+
+	var subject, textBody, htmlBody bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, kind+".subject", data); err != nil {
+		return ports.Email{}, err
+	}
+	if err := text.ExecuteTemplate(&textBody, kind+".text", data); err != nil {
+		return ports.Email{}, err
+	}
+	if err := html.ExecuteTemplate(&htmlBody, kind+".html", data); err != nil {
+		return ports.Email{}, err
+	}
+
+	return ports.Email{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(textBody.String()) + "\n",
+		HTML:    htmlBody.String(),
+	}, nil
+}
+
+func formatDate(locale notification.Locale, date time.Time) string {
+	switch locale {
+	case notification.FrenchLocale:
+		return fmt.Sprintf("%d %s %d", date.Day(), frenchMonths[date.Month()-1], date.Year())
+	default:
+		return date.Format("January 2, 2006")
+	}
+}
+
+func formatPrice(locale notification.Locale, price currency.Amount) string {
+	if price == nil || !price.IsValid() {
+		return ""
+	}
+
+	value := price.Round(currency.DefaultRounding).Decimal().String()
+	if locale == notification.FrenchLocale {
+		value = strings.Replace(value, ".", ",", 1)
+	}
+	return fmt.Sprintf("%s %s", value, price.Currency().String())
+}
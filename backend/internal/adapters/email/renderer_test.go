@@ -0,0 +1,63 @@
+package email_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/email"
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/notification"
+)
+
+func TestReminderRenderer_Render(t *testing.T) {
+	renderer, err := email.NewReminderRenderer()
+	require.NoError(t, err)
+
+	renewal := calendar.Event{
+		Kind:  calendar.RenewalEventKind,
+		Date:  time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+		Title: "Tom & Jerry <Plus>",
+		Price: currency.NewAmount(9.99, currency.EUR),
+	}
+
+	t.Run("renewal in english", func(t *testing.T) {
+		message, err := renderer.Render(notification.EnglishLocale, renewal)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Tom & Jerry <Plus> renews on March 10, 2025", message.Subject)
+		assert.Contains(t, message.Text, "renews on March 10, 2025 for 9.99 EUR.")
+		assert.Contains(t, message.HTML, "<strong>Tom &amp; Jerry &lt;Plus&gt;</strong>")
+		assert.Empty(t, message.To)
+	})
+
+	t.Run("renewal in french", func(t *testing.T) {
+		message, err := renderer.Render(notification.FrenchLocale, renewal)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Tom & Jerry <Plus> se renouvelle le 10 mars 2025", message.Subject)
+		assert.Contains(t, message.Text, "pour 9,99 EUR.")
+		assert.Contains(t, message.HTML, `lang="fr"`)
+	})
+
+	t.Run("every kind has templates", func(t *testing.T) {
+		kinds := []calendar.EventKind{calendar.RenewalEventKind, calendar.FreeTrialEndEventKind,
+			calendar.CancellationDeadlineEventKind}
+		for _, locale := range []notification.Locale{notification.EnglishLocale, notification.FrenchLocale} {
+			for _, kind := range kinds {
+				message, err := renderer.Render(locale, calendar.Event{Kind: kind, Date: renewal.Date, Title: "Netflix"})
+				require.NoError(t, err, "%s %s", locale, kind)
+				assert.Contains(t, message.Subject, "Netflix")
+				assert.NotContains(t, message.Text, "<no value>")
+			}
+		}
+	})
+
+	t.Run("unknown kind", func(t *testing.T) {
+		_, err := renderer.Render(notification.EnglishLocale, calendar.Event{Kind: "unknown"})
+		assert.ErrorIs(t, err, email.ErrUnknownTemplate)
+	})
+}
@@ -0,0 +1,124 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/Oleexo/config-go"
+
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+const (
+	// SMTPHostKey is the SMTP server emails are sent through, emails are not sent when it is empty
+	SMTPHostKey     = "SMTP_HOST"
+	SMTPPortKey     = "SMTP_PORT"
+	SMTPUsernameKey = "SMTP_USERNAME"
+	SMTPPasswordKey = "SMTP_PASSWORD"
+	// SMTPFromKey is the sender of the emails, e.g. SubTracker <no-reply@example.com>
+	SMTPFromKey = "SMTP_FROM"
+	// SMTPTimeoutKey bounds the delivery of an email, in nanoseconds
+	SMTPTimeoutKey = "SMTP_TIMEOUT"
+
+	DefaultSMTPPort    = 587
+	DefaultSMTPFrom    = "SubTracker <no-reply@subtracker.mistribe.com>"
+	DefaultSMTPTimeout = 30 * time.Second
+)
+
+type smtpSender struct {
+	host     string
+	address  string
+	username string
+	password string
+	from     *mail.Address
+	timeout  time.Duration
+}
+
+func NewSMTPSender(cfg config.Configuration) (ports.EmailSender, error) {
+	from, err := mail.ParseAddress(cfg.GetStringOrDefault(SMTPFromKey, DefaultSMTPFrom))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", SMTPFromKey, err)
+	}
+	host := cfg.GetStringOrDefault(SMTPHostKey, "")
+	port := cfg.GetIntOrDefault(SMTPPortKey, DefaultSMTPPort)
+	return &smtpSender{
+		host:     host,
+		address:  net.JoinHostPort(host, strconv.FormatInt(port, 10)),
+		username: cfg.GetStringOrDefault(SMTPUsernameKey, ""),
+		password: cfg.GetStringOrDefault(SMTPPasswordKey, ""),
+		from:     from,
+		timeout:  time.Duration(cfg.GetIntOrDefault(SMTPTimeoutKey, int64(DefaultSMTPTimeout))),
+	}, nil
+}
+
+func (s *smtpSender) Send(ctx context.Context, email ports.Email) error {
+	if s.host == "" {
+		return ErrNotConfigured
+	}
+	to, err := mail.ParseAddress(email.To)
+	if err != nil {
+		return err
+	}
+	message, err := buildMessage(s.from, to, email, time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+	if s.username != "" {
+		// PlainAuth refuses to send the credentials over an unencrypted connection, except to localhost
+		if err = client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+
+	if err = client.Mail(s.from.Address); err != nil {
+		return err
+	}
+	if err = client.Rcpt(to.Address); err != nil {
+		return err
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = writer.Write(message); err != nil {
+		return err
+	}
+	if err = writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
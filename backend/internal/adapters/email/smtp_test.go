@@ -0,0 +1,90 @@
+package email_test
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"testing"
+
+	"github.com/Oleexo/config-go"
+	"github.com/Oleexo/config-go/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/email"
+	"github.com/mistribe/subtracker/internal/adapters/email/smtptest"
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+func TestSMTPSender_Send(t *testing.T) {
+	server, err := smtptest.NewServer()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+
+	newConfig := func(host string) config.Configuration {
+		return config.NewConfiguration(mem.WithMemory(map[string]config.Entry{
+			email.SMTPHostKey: config.NewEntryString(host),
+			email.SMTPPortKey: config.NewEntryInt(int64(server.Port())),
+			email.SMTPFromKey: config.NewEntryString("SubTracker <reminders@example.com>"),
+		}))
+	}
+
+	t.Run("sends a text and an html version", func(t *testing.T) {
+		sender, err := email.NewSMTPSender(newConfig(server.Host()))
+		require.NoError(t, err)
+
+		err = sender.Send(t.Context(), ports.Email{
+			To:      "jane@example.com",
+			Subject: "Netflix se renouvelle bientôt",
+			Text:    "Hello Jane\n",
+			HTML:    "<p>Hello Jane</p>",
+		})
+		require.NoError(t, err)
+
+		messages := server.Messages()
+		require.Len(t, messages, 1)
+		assert.Equal(t, "reminders@example.com", messages[0].From)
+		assert.Equal(t, []string{"jane@example.com"}, messages[0].To)
+
+		message, err := mail.ReadMessage(bytes.NewReader(messages[0].Data))
+		require.NoError(t, err)
+		subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, "Netflix se renouvelle bientôt", subject)
+		assert.NotEmpty(t, message.Header.Get("Message-ID"))
+
+		mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/alternative", mediaType)
+
+		var bodies []string
+		reader := multipart.NewReader(message.Body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			body, err := io.ReadAll(quotedprintable.NewReader(part))
+			require.NoError(t, err)
+			bodies = append(bodies, part.Header.Get("Content-Type")+": "+string(body))
+		}
+		assert.Equal(t, []string{
+			"text/plain; charset=utf-8: Hello Jane\n",
+			"text/html; charset=utf-8: <p>Hello Jane</p>",
+		}, bodies)
+	})
+
+	t.Run("fails without a server", func(t *testing.T) {
+		sender, err := email.NewSMTPSender(newConfig(""))
+		require.NoError(t, err)
+
+		err = sender.Send(t.Context(), ports.Email{To: "jane@example.com"})
+		assert.ErrorIs(t, err, email.ErrNotConfigured)
+	})
+}
@@ -0,0 +1,147 @@
+// Package smtptest provides an SMTP server keeping the messages it receives in memory, it stands in for a real
+// SMTP server in tests.
+package smtptest
+
+import (
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const hostname = "smtptest"
+
+// Message is an email received by the server, Data is the raw message with its headers
+type Message struct {
+	From string
+	To   []string
+	Data []byte
+}
+
+type Server struct {
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu       sync.Mutex
+	messages []Message
+}
+
+// NewServer starts a server listening on a random port of the loopback interface
+func NewServer() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{listener: listener}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+func (s *Server) Host() string {
+	host, _, _ := net.SplitHostPort(s.listener.Addr().String())
+	return host
+}
+
+func (s *Server) Port() int {
+	_, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return p
+}
+
+// Messages returns the messages received so far
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// Close stops the server and waits for the connections in progress
+func (s *Server) Close() error {
+	err := s.listener.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer func() {
+				_ = conn.Close()
+			}()
+			_ = s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) error {
+	text := textproto.NewConn(conn)
+	if err := text.PrintfLine("220 %s ESMTP", hostname); err != nil {
+		return err
+	}
+
+	var current Message
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return err
+		}
+		verb, argument, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			err = text.PrintfLine("250-%s\r\n250 8BITMIME", hostname)
+		case "HELO":
+			err = text.PrintfLine("250 %s", hostname)
+		case "MAIL":
+			current = Message{From: address(argument)}
+			err = text.PrintfLine("250 OK")
+		case "RCPT":
+			current.To = append(current.To, address(argument))
+			err = text.PrintfLine("250 OK")
+		case "DATA":
+			if err = text.PrintfLine("354 End data with <CR><LF>.<CR><LF>"); err != nil {
+				return err
+			}
+			data, readErr := text.ReadDotBytes()
+			if readErr != nil {
+				return readErr
+			}
+			current.Data = data
+			s.mu.Lock()
+			s.messages = append(s.messages, current)
+			s.mu.Unlock()
+			current = Message{}
+			err = text.PrintfLine("250 OK")
+		case "RSET":
+			current = Message{}
+			err = text.PrintfLine("250 OK")
+		case "NOOP":
+			err = text.PrintfLine("250 OK")
+		case "QUIT":
+			_ = text.PrintfLine("221 Bye")
+			return nil
+		default:
+			err = text.PrintfLine("502 Command not implemented")
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// address returns the address of a MAIL FROM:<...> or RCPT TO:<...> argument
+func address(argument string) string {
+	_, value, _ := strings.Cut(argument, ":")
+	value, _, _ = strings.Cut(strings.TrimSpace(value), " ")
+	return strings.Trim(value, "<>")
+}
@@ -0,0 +1,69 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/fx"
+
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+// Bus notifies the subscribers of the events in process, in the order they were recorded
+type Bus struct {
+	subscribers []ports.EventSubscriber
+	logger      *slog.Logger
+}
+
+type BusParams struct {
+	fx.In
+
+	Subscribers []ports.EventSubscriber `group:"event_subscribers"`
+	Logger      *slog.Logger
+}
+
+func NewBus(params BusParams) *Bus {
+	return &Bus{
+		subscribers: params.Subscribers,
+		logger:      params.Logger,
+	}
+}
+
+// Publish notifies every subscriber of each event and returns the joined failures of the subscribers. A failing
+// subscriber is logged and does not stop the others, the subscribers run even when the request that saved the
+// events is cancelled.
+func (b *Bus) Publish(ctx context.Context, events ...entity.Event) error {
+	ctx = context.WithoutCancel(ctx)
+	var errs []error
+	for _, event := range events {
+		for _, subscriber := range b.subscribers {
+			if err := b.notify(ctx, subscriber, event); err != nil {
+				b.logger.Error("event subscriber failed",
+					slog.String("event", event.EventName()),
+					slog.String("subscriber", subscriberName(subscriber)),
+					slog.Any("error", err))
+				errs = append(errs, fmt.Errorf("%s on %s: %w", subscriberName(subscriber), event.EventName(), err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (b *Bus) notify(ctx context.Context, subscriber ports.EventSubscriber, event entity.Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return subscriber.Notify(ctx, event)
+}
+
+func subscriberName(subscriber ports.EventSubscriber) string {
+	if stringer, ok := subscriber.(fmt.Stringer); ok {
+		return stringer.String()
+	}
+	return fmt.Sprintf("%T", subscriber)
+}
@@ -0,0 +1,130 @@
+package eventbus_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/mistribe/subtracker/internal/adapters/eventbus"
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/testx"
+)
+
+type firstEvent struct {
+	Value string
+}
+
+func (firstEvent) EventName() string {
+	return "test.first"
+}
+
+type secondEvent struct{}
+
+func (secondEvent) EventName() string {
+	return "test.second"
+}
+
+type recordingHandler[TEvent entity.Event] struct {
+	events []TEvent
+	err    error
+}
+
+func (h *recordingHandler[TEvent]) Handle(ctx context.Context, event TEvent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	h.events = append(h.events, event)
+	return h.err
+}
+
+type panickingHandler struct{}
+
+func (panickingHandler) Handle(context.Context, firstEvent) error {
+	panic("boom")
+}
+
+func newBus(subscribers ...ports.EventSubscriber) *eventbus.Bus {
+	return eventbus.NewBus(eventbus.BusParams{
+		Subscribers: subscribers,
+		Logger:      testx.DiscardLogger(),
+	})
+}
+
+func TestBus_Publish(t *testing.T) {
+	t.Run("notifies the subscribers of the event type only", func(t *testing.T) {
+		first := &recordingHandler[firstEvent]{}
+		second := &recordingHandler[secondEvent]{}
+		bus := newBus(ports.NewEventSubscriber[firstEvent](first), ports.NewEventSubscriber[secondEvent](second))
+
+		require.NoError(t, bus.Publish(t.Context(), firstEvent{Value: "a"}, secondEvent{}, firstEvent{Value: "b"}))
+
+		assert.Equal(t, []firstEvent{{Value: "a"}, {Value: "b"}}, first.events)
+		assert.Len(t, second.events, 1)
+	})
+
+	t.Run("a failing subscriber does not stop the others", func(t *testing.T) {
+		failing := &recordingHandler[firstEvent]{err: errors.New("failed")}
+		other := &recordingHandler[firstEvent]{}
+		bus := newBus(
+			ports.NewEventSubscriber[firstEvent](failing),
+			ports.NewEventSubscriber[firstEvent](panickingHandler{}),
+			ports.NewEventSubscriber[firstEvent](other),
+		)
+
+		err := bus.Publish(t.Context(), firstEvent{Value: "a"}, firstEvent{Value: "b"})
+
+		assert.Len(t, failing.events, 2)
+		assert.Len(t, other.events, 2)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed")
+		assert.ErrorContains(t, err, "panic: boom")
+	})
+
+	t.Run("subscribers run when the context is cancelled", func(t *testing.T) {
+		handler := &recordingHandler[firstEvent]{}
+		bus := newBus(ports.NewEventSubscriber[firstEvent](handler))
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		require.NoError(t, bus.Publish(ctx, firstEvent{}))
+
+		assert.Len(t, handler.events, 1)
+	})
+}
+
+func TestAsEventHandler(t *testing.T) {
+	t.Run("subscribes the handler to the bus", func(t *testing.T) {
+		handler := &recordingHandler[firstEvent]{}
+		var publisher ports.EventPublisher
+		app := fx.New(
+			fx.NopLogger,
+			fx.Supply(testx.DiscardLogger(), handler),
+			fx.Provide(
+				ports.AsEventHandler[firstEvent](func(h *recordingHandler[firstEvent]) *recordingHandler[firstEvent] {
+					return h
+				}),
+				fx.Annotate(eventbus.NewBus, fx.As(new(ports.EventPublisher))),
+			),
+			fx.Populate(&publisher),
+		)
+		require.NoError(t, app.Err())
+
+		require.NoError(t, publisher.Publish(t.Context(), firstEvent{Value: "a"}, secondEvent{}))
+
+		assert.Equal(t, []firstEvent{{Value: "a"}}, handler.events)
+	})
+
+	t.Run("panics when the constructor does not build a handler", func(t *testing.T) {
+		assert.Panics(t, func() {
+			ports.AsEventHandler[firstEvent](func() *recordingHandler[secondEvent] { return nil })
+		})
+		assert.Panics(t, func() {
+			ports.AsEventHandler[firstEvent](&recordingHandler[firstEvent]{})
+		})
+	})
+}
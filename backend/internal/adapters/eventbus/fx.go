@@ -0,0 +1,15 @@
+package eventbus
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+func Module() fx.Option {
+	return fx.Module("eventbus",
+		fx.Provide(
+			fx.Annotate(NewBus, fx.As(new(ports.EventPublisher))),
+		),
+	)
+}
@@ -0,0 +1,203 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/decimal"
+)
+
+// usdRates are the rates from one dollar to other currencies on a given day, keyed by currency code
+type usdRates map[string]float64
+
+// crossRate returns the rate from one currency to another through the dollar
+func (r usdRates) crossRate(from, to currency.Unit) (float64, bool) {
+	fromRate, okFrom := r.get(from)
+	toRate, okTo := r.get(to)
+	if !okFrom || !okTo {
+		return 0, false
+	}
+
+	// from -> USD -> to : (1 / (USD->from)) * (USD->to)
+	return toRate / fromRate, true
+}
+
+func (r usdRates) get(unit currency.Unit) (float64, bool) {
+	if unit == currency.USD {
+		return 1, true
+	}
+	rate, ok := r[unit.String()]
+	return rate, ok && rate != 0
+}
+
+func (r usdRates) covers(units map[currency.Unit]struct{}) bool {
+	for unit := range units {
+		if _, ok := r.get(unit); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func getUSDRatesCacheKey(at time.Time) string {
+	return fmt.Sprintf("%s-*-%s", currency.USD, at.Format("2006-01-02"))
+}
+
+// getUSDRatesFromDatabase returns the rates of the day stored from or to the dollar
+func (e exchange) getUSDRatesFromDatabase(ctx context.Context, at time.Time) (usdRates, error) {
+	rates, err := e.repository.GetRatesByDate(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(usdRates, len(rates))
+	for _, r := range rates {
+		if r.ExchangeRate() == 0 {
+			continue
+		}
+		if r.FromCurrency() == currency.USD {
+			result[r.ToCurrency().String()] = r.ExchangeRate()
+		}
+	}
+	// Rates stored towards the dollar are only used when the direct rate is missing
+	for _, r := range rates {
+		if r.ExchangeRate() == 0 || r.ToCurrency() != currency.USD {
+			continue
+		}
+		if _, ok := result[r.FromCurrency().String()]; !ok {
+			result[r.FromCurrency().String()] = 1 / r.ExchangeRate()
+		}
+	}
+
+	return result, nil
+}
+
+// getUSDRatesAt returns the rates of the day for at least the given currencies when they are known,
+// looking in the cache, then the database and finally the external source
+func (e exchange) getUSDRatesAt(
+	ctx context.Context,
+	at time.Time,
+	units map[currency.Unit]struct{}) (usdRates, error) {
+	key := getUSDRatesCacheKey(at)
+	cache := e.cache.From(ctx, ports.CacheLevelServer)
+	if cached, ok := cache.Get(key).(usdRates); ok && cached.covers(units) {
+		return cached, nil
+	}
+
+	rates, err := e.getUSDRatesFromDatabase(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rates.covers(units) {
+		source, err := e.source.GetRates(ctx, at)
+		if err != nil {
+			return nil, err
+		}
+		if source == nil {
+			e.logger.Warn("missing rates",
+				slog.String("from", currency.USD.String()),
+				slog.Time("at", at))
+			latest, err := e.source.GetRates(ctx, time.Now())
+			if err != nil {
+				return nil, err
+			}
+			// The latest rates stand in for this call only, they do not belong to that day and are neither
+			// stored nor cached under it
+			cache.Set(key, rates, ports.WithDuration(time.Hour*24))
+			fallback := make(usdRates, len(rates)+len(latest))
+			maps.Copy(fallback, latest)
+			maps.Copy(fallback, rates)
+			return fallback, nil
+		}
+		for unit := range units {
+			code := unit.String()
+			rate, ok := source[code]
+			if _, stored := rates[code]; stored || !ok || rate == 0 || unit == currency.USD {
+				continue
+			}
+			if err = e.saveRateToDatabase(ctx, currency.USD, unit, rate, at); err != nil {
+				return nil, err
+			}
+			rates[code] = rate
+		}
+	}
+
+	cache.Set(key, rates, ports.WithDuration(time.Hour*24))
+	return rates, nil
+}
+
+func (e exchange) ToCurrencyBatch(
+	ctx context.Context,
+	conversions []ports.Conversion,
+	target currency.Unit) ([]currency.Amount, error) {
+	results := make([]currency.Amount, len(conversions))
+
+	manualRates, err := e.getManualRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Currencies needed on each day, the target included
+	type day struct {
+		at    time.Time
+		units map[currency.Unit]struct{}
+		rates usdRates
+	}
+	days := make(map[string]*day)
+	var pending []int
+	for index, conversion := range conversions {
+		switch {
+		case !conversion.Amount.IsValid():
+			results[index] = currency.NewInvalidAmount()
+		case conversion.Amount.Currency() == target:
+			results[index] = conversion.Amount
+		default:
+			initial := conversion.Amount
+			if rate, source, ok := manualRates.RateFor(initial.Currency(), target, conversion.SubscriptionId); ok {
+				converted := initial.Decimal().Mul(decimal.NewFromFloat(rate))
+				results[index] = currency.NewAmountWithRateSource(converted, target, initial, source)
+				continue
+			}
+
+			key := conversion.At.Format("2006-01-02")
+			d, ok := days[key]
+			if !ok {
+				d = &day{
+					at:    conversion.At,
+					units: map[currency.Unit]struct{}{target: {}},
+				}
+				days[key] = d
+			}
+			d.units[conversion.Amount.Currency()] = struct{}{}
+			pending = append(pending, index)
+		}
+	}
+
+	for _, d := range days {
+		rates, err := e.getUSDRatesAt(ctx, d.at, d.units)
+		if err != nil {
+			return nil, err
+		}
+		d.rates = rates
+	}
+
+	for _, index := range pending {
+		initial := conversions[index].Amount
+		d := days[conversions[index].At.Format("2006-01-02")]
+		rate, ok := d.rates.crossRate(initial.Currency(), target)
+		if !ok {
+			results[index] = currency.NewInvalidAmount()
+			continue
+		}
+		converted := initial.Decimal().Mul(decimal.NewFromFloat(rate))
+		results[index] = currency.NewAmountWithSource(converted, target, initial)
+	}
+
+	return results, nil
+}
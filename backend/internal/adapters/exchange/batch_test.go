@@ -0,0 +1,320 @@
+package exchange_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/exchange"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/testx"
+)
+
+// memoryCache keeps every value in a single map whatever the level
+type memoryCache struct {
+	values *memoryCacheLevel
+}
+
+type memoryCacheLevel struct {
+	mu     sync.Mutex
+	values map[string]interface{}
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{values: &memoryCacheLevel{values: make(map[string]interface{})}}
+}
+
+func (c *memoryCache) From(context.Context, ports.CacheLevel) ports.CacheLeveled {
+	return c.values
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, value interface{}, options ...func(*ports.CacheOptions)) {
+	c.values.Set(key, value, options...)
+}
+
+func (c *memoryCache) Get(_ context.Context, key string) interface{} {
+	return c.values.Get(key)
+}
+
+func (l *memoryCacheLevel) Set(key string, value interface{}, _ ...func(*ports.CacheOptions)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.values[key] = value
+}
+
+func (l *memoryCacheLevel) Get(key string) interface{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.values[key]
+}
+
+// memoryRateRepository stores the rates from one dollar of every day and answers any currency pair,
+// each query waits for latency to stand for a database round trip
+type memoryRateRepository struct {
+	ports.CurrencyRepository
+
+	usd     map[string]float64
+	err     error
+	latency time.Duration
+	queries int
+	saved   []currency.Rate
+}
+
+func (r *memoryRateRepository) Save(_ context.Context, rates ...currency.Rate) error {
+	r.saved = append(r.saved, rates...)
+	return nil
+}
+
+func (r *memoryRateRepository) query() {
+	r.queries++
+	// Sleeping is too coarse for a few microseconds
+	for start := time.Now(); time.Since(start) < r.latency; {
+	}
+}
+
+func (r *memoryRateRepository) rate(unit currency.Unit) float64 {
+	if unit == currency.USD {
+		return 1
+	}
+	return r.usd[unit.String()]
+}
+
+func (r *memoryRateRepository) GetRateAt(_ context.Context, from, to currency.Unit, at time.Time) (
+	currency.Rate,
+	error) {
+	r.query()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.rate(from) == 0 || r.rate(to) == 0 {
+		return nil, nil
+	}
+	return currency.NewRate(types.NewRateID(), from, to, at, r.rate(to)/r.rate(from), at, at), nil
+}
+
+func (r *memoryRateRepository) GetRatesByDate(_ context.Context, date time.Time) (currency.Rates, error) {
+	r.query()
+	if r.err != nil {
+		return nil, r.err
+	}
+	var rates currency.Rates
+	for code, rate := range r.usd {
+		rates = append(rates, currency.NewRate(types.NewRateID(), currency.USD, currency.MustParseISO(code), date,
+			rate, date, date))
+	}
+	return rates, nil
+}
+
+func newMemoryRateRepository() *memoryRateRepository {
+	// One euro is worth 2 dollars and one pound 4 dollars
+	return &memoryRateRepository{usd: map[string]float64{"EUR": 0.5, "GBP": 0.25, "CHF": 0.8, "JPY": 150}}
+}
+
+var gbp = currency.MustParseISO("GBP")
+
+func TestExchange_ToCurrencyBatch(t *testing.T) {
+	day := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("computes cross rates through the dollar", func(t *testing.T) {
+		repository := newMemoryRateRepository()
+		service := exchange.New(newMemoryCache(), repository, ports.NewMockManualRateRepository(t),
+			newAnonymousAuthentication(t), exchange.NewMockRateSource(t), testx.DiscardLogger())
+
+		out, err := service.ToCurrencyBatch(t.Context(), []ports.Conversion{
+			{Amount: currency.NewAmount(10, currency.EUR), At: day},
+			{Amount: currency.NewAmount(10, gbp), At: day},
+			{Amount: currency.NewAmount(10, currency.USD), At: day.AddDate(0, 1, 0)},
+		}, gbp)
+
+		require.NoError(t, err)
+		require.Len(t, out, 3)
+		assert.InDelta(t, 5.0, out[0].Value(), 1e-9)
+		assert.Equal(t, gbp, out[0].Currency())
+		require.NotNil(t, out[0].Source())
+		assert.True(t, out[0].Source().IsEqual(currency.NewAmount(10, currency.EUR)))
+		assert.Equal(t, 10.0, out[1].Value())
+		assert.Nil(t, out[1].Source())
+		assert.InDelta(t, 2.5, out[2].Value(), 1e-9)
+		// One query per day
+		assert.Equal(t, 2, repository.queries)
+	})
+
+	t.Run("uses the cached rates of the day", func(t *testing.T) {
+		repository := newMemoryRateRepository()
+		service := exchange.New(newMemoryCache(), repository, ports.NewMockManualRateRepository(t),
+			newAnonymousAuthentication(t), exchange.NewMockRateSource(t), testx.DiscardLogger())
+
+		for range 3 {
+			out, err := service.ToCurrencyBatch(t.Context(), []ports.Conversion{
+				{Amount: currency.NewAmount(10, currency.EUR), At: day},
+			}, currency.USD)
+			require.NoError(t, err)
+			assert.InDelta(t, 20.0, out[0].Value(), 1e-9)
+		}
+		assert.Equal(t, 1, repository.queries)
+	})
+
+	t.Run("reads and stores the rates missing from the database", func(t *testing.T) {
+		repository := &memoryRateRepository{usd: map[string]float64{"EUR": 0.5}}
+		source := exchange.NewMockRateSource(t)
+		source.EXPECT().GetRates(mock.Anything, day).Return(map[string]float64{"EUR": 0.6, "GBP": 0.25}, nil).Once()
+		service := exchange.New(newMemoryCache(), repository, ports.NewMockManualRateRepository(t),
+			newAnonymousAuthentication(t), source, testx.DiscardLogger())
+
+		out, err := service.ToCurrencyBatch(t.Context(), []ports.Conversion{
+			{Amount: currency.NewAmount(10, currency.EUR), At: day},
+			{Amount: currency.NewAmount(10, gbp), At: day},
+		}, currency.USD)
+
+		require.NoError(t, err)
+		// The stored rate wins over the source
+		assert.InDelta(t, 20.0, out[0].Value(), 1e-9)
+		assert.InDelta(t, 40.0, out[1].Value(), 1e-9)
+		require.Len(t, repository.saved, 1)
+		assert.Equal(t, currency.USD, repository.saved[0].FromCurrency())
+		assert.Equal(t, gbp, repository.saved[0].ToCurrency())
+		assert.Equal(t, 0.25, repository.saved[0].ExchangeRate())
+	})
+
+	t.Run("uses the latest rates for a day without rates without keeping them for that day", func(t *testing.T) {
+		repository := &memoryRateRepository{usd: map[string]float64{"EUR": 0.5}}
+		source := exchange.NewMockRateSource(t)
+		source.EXPECT().GetRates(mock.Anything, day).Return(nil, nil).Twice()
+		latest := mock.MatchedBy(func(at time.Time) bool { return !at.Equal(day) })
+		source.EXPECT().GetRates(mock.Anything, latest).Return(map[string]float64{"GBP": 0.25}, nil).Once()
+		source.EXPECT().GetRates(mock.Anything, latest).Return(map[string]float64{"GBP": 0.2}, nil).Once()
+		service := exchange.New(newMemoryCache(), repository, ports.NewMockManualRateRepository(t),
+			newAnonymousAuthentication(t), source, testx.DiscardLogger())
+		conversions := []ports.Conversion{
+			{Amount: currency.NewAmount(10, currency.EUR), At: day},
+			{Amount: currency.NewAmount(10, gbp), At: day},
+		}
+
+		out, err := service.ToCurrencyBatch(t.Context(), conversions, currency.USD)
+		require.NoError(t, err)
+		assert.InDelta(t, 20.0, out[0].Value(), 1e-9)
+		assert.InDelta(t, 40.0, out[1].Value(), 1e-9)
+
+		// The latest rates were not cached as the rates of the day
+		out, err = service.ToCurrencyBatch(t.Context(), conversions, currency.USD)
+		require.NoError(t, err)
+		assert.InDelta(t, 20.0, out[0].Value(), 1e-9)
+		assert.InDelta(t, 50.0, out[1].Value(), 1e-9)
+		assert.Empty(t, repository.saved)
+	})
+
+	t.Run("keeps amounts that need no rate", func(t *testing.T) {
+		repository := newMemoryRateRepository()
+		service := exchange.New(newMemoryCache(), repository, ports.NewMockManualRateRepository(t),
+			newAnonymousAuthentication(t), exchange.NewMockRateSource(t), testx.DiscardLogger())
+
+		out, err := service.ToCurrencyBatch(t.Context(), []ports.Conversion{
+			{Amount: currency.NewInvalidAmount(), At: day},
+			{Amount: currency.NewAmount(10, currency.USD), At: day},
+		}, currency.USD)
+
+		require.NoError(t, err)
+		assert.False(t, out[0].IsValid())
+		assert.Equal(t, 10.0, out[1].Value())
+		assert.Zero(t, repository.queries)
+	})
+
+	t.Run("fails when the repository fails", func(t *testing.T) {
+		repository := newMemoryRateRepository()
+		repository.err = errors.New("db error")
+		service := exchange.New(newMemoryCache(), repository, ports.NewMockManualRateRepository(t),
+			newAnonymousAuthentication(t), exchange.NewMockRateSource(t), testx.DiscardLogger())
+
+		_, err := service.ToCurrencyBatch(t.Context(), []ports.Conversion{
+			{Amount: currency.NewAmount(10, currency.EUR), At: day},
+		}, currency.USD)
+
+		assert.EqualError(t, err, "db error")
+	})
+}
+
+// benchmarkConversions returns the monthly charges of a year of an account with the given number of subscriptions
+// spread over the days of the month and over several currencies
+func benchmarkConversions(subscriptions int) []ports.Conversion {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	units := []currency.Unit{currency.EUR, gbp, currency.MustParseISO("CHF"), currency.MustParseISO("JPY")}
+	conversions := make([]ports.Conversion, 0, subscriptions*12)
+	for index := range subscriptions {
+		for month := range 12 {
+			conversions = append(conversions, ports.Conversion{
+				Amount: currency.NewAmount(float64(index%50+1), units[(index/28)%len(units)]),
+				At:     start.AddDate(0, month, index%28),
+			})
+		}
+	}
+	return conversions
+}
+
+// The benchmarks convert a year of charges of 300 subscriptions, each repository query standing for a database
+// round trip. With a cold cache every rate comes from the repository, with a warm cache from the server cache.
+const (
+	benchmarkSubscriptions = 300
+	benchmarkLatency       = 100 * time.Microsecond
+)
+
+func benchmarkExchange(
+	b *testing.B,
+	convert func(service ports.Exchange, conversions []ports.Conversion) error) {
+	conversions := benchmarkConversions(benchmarkSubscriptions)
+
+	b.Run("cold cache", func(b *testing.B) {
+		queries := 0
+		for b.Loop() {
+			repository := newMemoryRateRepository()
+			repository.latency = benchmarkLatency
+			service := exchange.New(newMemoryCache(), repository, ports.NewMockManualRateRepository(b),
+				newAnonymousAuthentication(b), exchange.NewMockRateSource(b), testx.DiscardLogger())
+			if err := convert(service, conversions); err != nil {
+				b.Fatal(err)
+			}
+			queries += repository.queries
+		}
+		b.ReportMetric(float64(queries)/float64(b.N), "queries/op")
+	})
+
+	b.Run("warm cache", func(b *testing.B) {
+		repository := newMemoryRateRepository()
+		repository.latency = benchmarkLatency
+		service := exchange.New(newMemoryCache(), repository, ports.NewMockManualRateRepository(b),
+			newAnonymousAuthentication(b), exchange.NewMockRateSource(b), testx.DiscardLogger())
+		if err := convert(service, conversions); err != nil {
+			b.Fatal(err)
+		}
+		for b.Loop() {
+			if err := convert(service, conversions); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkExchange_ToCurrencyAt(b *testing.B) {
+	benchmarkExchange(b, func(service ports.Exchange, conversions []ports.Conversion) error {
+		for _, conversion := range conversions {
+			if _, err := service.ToCurrencyAt(b.Context(), conversion.Amount, currency.USD, conversion.At); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func BenchmarkExchange_ToCurrencyBatch(b *testing.B) {
+	benchmarkExchange(b, func(service ports.Exchange, conversions []ports.Conversion) error {
+		_, err := service.ToCurrencyBatch(b.Context(), conversions, currency.USD)
+		return err
+	})
+}
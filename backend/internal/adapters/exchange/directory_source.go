@@ -0,0 +1,46 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type directoryRateSource struct {
+	directory string
+}
+
+// NewDirectoryRateSource creates a source reading files of rates laid out like SubTracker-Data,
+// one YYYY/MM/DD.json file per day under directory
+func NewDirectoryRateSource(directory string) RateSource {
+	return directoryRateSource{
+		directory: directory,
+	}
+}
+
+func (s directoryRateSource) String() string {
+	return DirectoryRateSourceName
+}
+
+func (s directoryRateSource) GetRates(_ context.Context, at time.Time) (map[string]float64, error) {
+	path := filepath.Join(s.directory, filepath.FromSlash(ratePath(at)))
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var source externalSourceModel
+	if err = json.Unmarshal(content, &source); err != nil {
+		return nil, fmt.Errorf("failed to read the rates of %s: %w", path, err)
+	}
+
+	return usdRatesFromModel(source)
+}
@@ -0,0 +1,147 @@
+package exchange
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+)
+
+const (
+	// DefaultEcbRateSourceUrl is the history of the euro foreign exchange reference rates of the ECB,
+	// the daily and 90 days files share the same format
+	DefaultEcbRateSourceUrl = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist.xml"
+
+	// ecbRefreshInterval is how long a downloaded document is used before being downloaded again
+	ecbRefreshInterval = 6 * time.Hour
+	// ecbMaxAge is how far back the last publication is looked for, the ECB does not publish on weekends and
+	// TARGET holidays
+	ecbMaxAge = 7 * 24 * time.Hour
+)
+
+type ecbRateModel struct {
+	Currency string  `xml:"currency,attr"`
+	Rate     float64 `xml:"rate,attr"`
+}
+
+type ecbDayModel struct {
+	Time  string         `xml:"time,attr"`
+	Rates []ecbRateModel `xml:"Cube"`
+}
+
+type ecbEnvelopeModel struct {
+	Days []ecbDayModel `xml:"Cube>Cube"`
+}
+
+type ecbRateSource struct {
+	client *http.Client
+	url    string
+
+	mu        sync.Mutex
+	days      []ecbDayModel
+	fetchedAt time.Time
+}
+
+// NewEcbRateSource creates a source reading the euro foreign exchange reference rates published by the ECB at url
+func NewEcbRateSource(client *http.Client, url string) RateSource {
+	return &ecbRateSource{
+		client: client,
+		url:    url,
+	}
+}
+
+func (s *ecbRateSource) String() string {
+	return EcbRateSourceName
+}
+
+func (s *ecbRateSource) GetRates(ctx context.Context, at time.Time) (map[string]float64, error) {
+	days, err := s.getDays(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Days are sorted from the oldest, the rates of a day without publication are the last published ones
+	date := at.Format("2006-01-02")
+	index := sort.Search(len(days), func(i int) bool {
+		return days[i].Time > date
+	}) - 1
+	if index < 0 {
+		return nil, nil
+	}
+	published, err := time.Parse("2006-01-02", days[index].Time)
+	if err != nil {
+		return nil, err
+	}
+	day, _ := time.Parse("2006-01-02", date)
+	if day.Sub(published) > ecbMaxAge {
+		return nil, nil
+	}
+
+	return usdRatesFromEcb(days[index])
+}
+
+// usdRatesFromEcb returns the rates from one dollar of a day of rates published from one euro
+func usdRatesFromEcb(day ecbDayModel) (map[string]float64, error) {
+	eurToUsd := 0.0
+	for _, r := range day.Rates {
+		if r.Currency == currency.USD.String() {
+			eurToUsd = r.Rate
+		}
+	}
+	if eurToUsd == 0 {
+		return nil, fmt.Errorf("missing EUR->USD rate from the ECB on %s", day.Time)
+	}
+
+	rates := make(map[string]float64, len(day.Rates))
+	rates[currency.EUR.String()] = 1 / eurToUsd
+	for _, r := range day.Rates {
+		if r.Rate == 0 || r.Currency == currency.USD.String() {
+			continue
+		}
+		// USD -> EUR -> target : (1 / (EUR->USD)) * (EUR->target)
+		rates[r.Currency] = r.Rate / eurToUsd
+	}
+
+	return rates, nil
+}
+
+// getDays returns the days of the document, downloading it again once it is too old
+func (s *ecbRateSource) getDays(ctx context.Context) ([]ecbDayModel, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.days != nil && time.Since(s.fetchedAt) < ecbRefreshInterval {
+		return s.days, nil
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ECB source returned status code %d", response.StatusCode)
+	}
+
+	var envelope ecbEnvelopeModel
+	if err = xml.NewDecoder(response.Body).Decode(&envelope); err != nil {
+		return nil, err
+	}
+	sort.Slice(envelope.Days, func(i, j int) bool {
+		return envelope.Days[i].Time < envelope.Days[j].Time
+	})
+
+	s.days = envelope.Days
+	s.fetchedAt = time.Now()
+	return s.days, nil
+}
@@ -2,36 +2,52 @@ package exchange
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
-	"net/http"
 	"time"
 
 	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/domain/types"
 	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/decimal"
 )
 
-const (
-	externalSourceUrl = "https://raw.githubusercontent.com/Mistribe/SubTracker-Data/refs/heads/main/exchange/"
-)
-
-type externalSourceModel struct {
-	From string             `json:"from"`
-	To   map[string]float64 `json:"to"`
-}
 type exchange struct {
-	cache      ports.Cache
-	repository ports.CurrencyRepository
-	logger     *slog.Logger
+	cache          ports.Cache
+	repository     ports.CurrencyRepository
+	manualRates    ports.ManualRateRepository
+	authentication ports.Authentication
+	source         RateSource
+	logger         *slog.Logger
 }
 
 func getCacheKey(from, to currency.Unit, at time.Time) string {
 	return fmt.Sprintf("%s-%s-%s", from, to, at.Format("2006-01-02"))
 }
 
+// getManualRates returns the manual rates of the connected account and of their family,
+// there are none outside of an authenticated request
+func (e exchange) getManualRates(ctx context.Context) (currency.ManualRates, error) {
+	connectedAccount, ok := e.authentication.GetConnectedAccount(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	key := fmt.Sprintf("manual-rates-%s", connectedAccount.UserID())
+	cache := e.cache.From(ctx, ports.CacheLevelRequest)
+	if cached, ok := cache.Get(key).(currency.ManualRates); ok {
+		return cached, nil
+	}
+
+	rates, err := e.manualRates.GetAllForUser(ctx, connectedAccount.UserID())
+	if err != nil {
+		return nil, err
+	}
+	cache.Set(key, rates)
+	return rates, nil
+}
+
 func (e exchange) getRateFromCache(ctx context.Context, key string) float64 {
 	rate, ok := e.cache.From(ctx, ports.CacheLevelServer).Get(key).(float64)
 	if rate == 0 || !ok {
@@ -53,35 +69,20 @@ func (e exchange) getRateFromDatabase(ctx context.Context, from, to currency.Uni
 	return rate.ExchangeRate(), nil
 }
 
-func (e exchange) getRateFromExternalSource(from, to currency.Unit, at time.Time) (
+func (e exchange) getRateFromExternalSource(ctx context.Context, from, to currency.Unit, at time.Time) (
 	float64,
 	error) {
-	year := at.Year()
-	month := at.Month()
-	day := at.Day()
-
-	url := fmt.Sprintf("%s/%d/%02d/%02d.json", externalSourceUrl, year, month, day)
-	response, err := http.Get(url)
-	if err != nil {
+	usdTo, err := e.source.GetRates(ctx, at)
+	if err != nil || usdTo == nil {
 		return 0, err
 	}
 
-	var source externalSourceModel
-	defer response.Body.Close()
-
-	if response.StatusCode != http.StatusOK {
-		if response.StatusCode == http.StatusNotFound {
-			return 0, nil
-		}
-		return 0, fmt.Errorf("external source returned status code %d", response.StatusCode)
-	}
-
-	if err = json.NewDecoder(response.Body).Decode(&source); err != nil {
-		return 0, err
-	}
+	return crossRate(usdTo, from, to)
+}
 
-	// The external source contains only USD currencies
-	usdTo := source.To
+// crossRate returns the rate from one currency to another from the rates of the external sources,
+// which are provided from USD
+func crossRate(usdTo map[string]float64, from, to currency.Unit) (float64, error) {
 	fromCode := from.String()
 	toCode := to.String()
 
@@ -134,7 +135,7 @@ func (e exchange) getRateAt(ctx context.Context, from, to currency.Unit, at time
 	}
 	if rate == 0 {
 		var err error
-		rate, err = e.getRateFromExternalSource(from, to, at)
+		rate, err = e.getRateFromExternalSource(ctx, from, to, at)
 		if err != nil {
 			return 0, err
 		}
@@ -143,7 +144,7 @@ func (e exchange) getRateAt(ctx context.Context, from, to currency.Unit, at time
 				slog.String("from", from.String()),
 				slog.String("to", to.String()),
 				slog.Time("at", at))
-			rate, err = e.getRateFromExternalSource(from, to, time.Now())
+			rate, err = e.getRateFromExternalSource(ctx, from, to, time.Now())
 			if err != nil {
 				return 0, err
 			}
@@ -173,11 +174,19 @@ func (e exchange) ToCurrencyAt(
 	if initial.Currency() == target {
 		return initial, nil
 	}
+	manualRates, err := e.getManualRates(ctx)
+	if err != nil {
+		return initial, err
+	}
+	if rate, source, ok := manualRates.RateFor(initial.Currency(), target, nil); ok {
+		converted := initial.Decimal().Mul(decimal.NewFromFloat(rate))
+		return currency.NewAmountWithRateSource(converted, target, initial, source), nil
+	}
 	rate, err := e.getRateAt(ctx, initial.Currency(), target, at)
 	if err != nil {
 		return initial, err
 	}
-	newValue := initial.Value() * rate
+	newValue := initial.Decimal().Mul(decimal.NewFromFloat(rate))
 	return currency.NewAmountWithSource(newValue, target, initial), nil
 }
 
@@ -214,10 +223,16 @@ func (e exchange) saveRateToDatabase(
 func New(
 	cache ports.Cache,
 	repository ports.CurrencyRepository,
+	manualRates ports.ManualRateRepository,
+	authentication ports.Authentication,
+	source RateSource,
 	logger *slog.Logger) ports.Exchange {
 	return exchange{
-		cache:      cache,
-		repository: repository,
-		logger:     logger,
+		cache:          cache,
+		repository:     repository,
+		manualRates:    manualRates,
+		authentication: authentication,
+		source:         source,
+		logger:         logger,
 	}
 }
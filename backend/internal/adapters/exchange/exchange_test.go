@@ -34,10 +34,18 @@ func (m *leveledCacheMock) Set(key string, value interface{}, options ...func(*p
 	}
 }
 
+// newAnonymousAuthentication stands for a conversion outside of a request, without manual rates
+func newAnonymousAuthentication(t testing.TB) *ports.MockAuthentication {
+	authentication := ports.NewMockAuthentication(t)
+	authentication.EXPECT().GetConnectedAccount(mock.Anything).Return(nil, false).Maybe()
+	return authentication
+}
+
 func TestExchange_ToCurrencyAt_InvalidAmount(t *testing.T) {
 	localCacheMock := ports.NewMockCache(t)
 	currencyRepositoryMock := ports.NewMockCurrencyRepository(t)
-	service := exchange.New(localCacheMock, currencyRepositoryMock, testx.DiscardLogger())
+	service := exchange.New(localCacheMock, currencyRepositoryMock, ports.NewMockManualRateRepository(t),
+		newAnonymousAuthentication(t), exchange.NewMockRateSource(t), testx.DiscardLogger())
 
 	invalid := currency.NewInvalidAmount()
 	out, err := service.ToCurrencyAt(context.Background(), invalid, currency.USD, time.Now())
@@ -49,7 +57,8 @@ func TestExchange_ToCurrencyAt_InvalidAmount(t *testing.T) {
 func TestExchange_ToCurrencyAt_SameCurrency(t *testing.T) {
 	localCacheMock := ports.NewMockCache(t)
 	currencyRepositoryMock := ports.NewMockCurrencyRepository(t)
-	service := exchange.New(localCacheMock, currencyRepositoryMock, testx.DiscardLogger())
+	service := exchange.New(localCacheMock, currencyRepositoryMock, ports.NewMockManualRateRepository(t),
+		newAnonymousAuthentication(t), exchange.NewMockRateSource(t), testx.DiscardLogger())
 
 	initial := currency.NewAmount(42.0, currency.USD)
 	out, err := service.ToCurrencyAt(context.Background(), initial, currency.USD, time.Now())
@@ -61,7 +70,8 @@ func TestExchange_ToCurrencyAt_SameCurrency(t *testing.T) {
 func TestExchange_ToCurrencyAt_ErrorFromRepository(t *testing.T) {
 	localCacheMock := ports.NewMockCache(t)
 	currencyRepositoryMock := ports.NewMockCurrencyRepository(t)
-	service := exchange.New(localCacheMock, currencyRepositoryMock, testx.DiscardLogger())
+	service := exchange.New(localCacheMock, currencyRepositoryMock, ports.NewMockManualRateRepository(t),
+		newAnonymousAuthentication(t), exchange.NewMockRateSource(t), testx.DiscardLogger())
 
 	at := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
 
@@ -89,7 +99,8 @@ func TestExchange_ToCurrencyAt_ErrorFromRepository(t *testing.T) {
 func TestExchange_ToCurrencyAt_SuccessFromCache(t *testing.T) {
 	localCacheMock := ports.NewMockCache(t)
 	currencyRepositoryMock := ports.NewMockCurrencyRepository(t)
-	service := exchange.New(localCacheMock, currencyRepositoryMock, testx.DiscardLogger())
+	service := exchange.New(localCacheMock, currencyRepositoryMock, ports.NewMockManualRateRepository(t),
+		newAnonymousAuthentication(t), exchange.NewMockRateSource(t), testx.DiscardLogger())
 
 	at := time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)
 	rate := 1.10
@@ -117,7 +128,8 @@ func TestExchange_ToCurrencyAt_SuccessFromCache(t *testing.T) {
 func TestExchange_ToCurrencyAt_SuccessFromRepository(t *testing.T) {
 	localCacheMock := ports.NewMockCache(t)
 	currencyRepositoryMock := ports.NewMockCurrencyRepository(t)
-	service := exchange.New(localCacheMock, currencyRepositoryMock, testx.DiscardLogger())
+	service := exchange.New(localCacheMock, currencyRepositoryMock, ports.NewMockManualRateRepository(t),
+		newAnonymousAuthentication(t), exchange.NewMockRateSource(t), testx.DiscardLogger())
 
 	at := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
 	rateVal := 1.25
@@ -0,0 +1,15 @@
+package exchange
+
+import (
+	"go.uber.org/fx"
+)
+
+func Module() fx.Option {
+	return fx.Module("exchange",
+		fx.Provide(
+			New,
+			NewRateSource,
+			NewRateProvider,
+		),
+	)
+}
@@ -0,0 +1,78 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+)
+
+// DefaultGithubRateSourceUrl is where SubTracker-Data publishes a file of rates per day
+const DefaultGithubRateSourceUrl = "https://raw.githubusercontent.com/Mistribe/SubTracker-Data/refs/heads/main/exchange/"
+
+// externalSourceModel is a file of rates, the rates from one currency to the others
+type externalSourceModel struct {
+	From string             `json:"from"`
+	To   map[string]float64 `json:"to"`
+}
+
+// usdRatesFromModel returns the rates of a file from one dollar
+func usdRatesFromModel(source externalSourceModel) (map[string]float64, error) {
+	if source.From != "" && !strings.EqualFold(source.From, currency.USD.String()) {
+		return nil, fmt.Errorf("rates from %s are not supported, only rates from USD are", source.From)
+	}
+	return source.To, nil
+}
+
+// ratePath returns the path of the file of rates of a day, relative to the root of the source
+func ratePath(at time.Time) string {
+	return fmt.Sprintf("%d/%02d/%02d.json", at.Year(), at.Month(), at.Day())
+}
+
+type githubRateSource struct {
+	client *http.Client
+	url    string
+}
+
+// NewGithubRateSource creates a source reading the files of rates of SubTracker-Data, or of a mirror, under url
+func NewGithubRateSource(client *http.Client, url string) RateSource {
+	return githubRateSource{
+		client: client,
+		url:    strings.TrimSuffix(url, "/"),
+	}
+}
+
+func (s githubRateSource) String() string {
+	return GithubRateSourceName
+}
+
+func (s githubRateSource) GetRates(ctx context.Context, at time.Time) (map[string]float64, error) {
+	url := fmt.Sprintf("%s/%s", s.url, ratePath(at))
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		if response.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("external source returned status code %d", response.StatusCode)
+	}
+
+	var source externalSourceModel
+	if err = json.NewDecoder(response.Body).Decode(&source); err != nil {
+		return nil, err
+	}
+
+	return usdRatesFromModel(source)
+}
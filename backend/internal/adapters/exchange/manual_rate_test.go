@@ -0,0 +1,84 @@
+package exchange_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/exchange"
+	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/testx"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func TestExchange_ManualRates(t *testing.T) {
+	day := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	userId := types.UserID("user-1")
+	subscriptionId := types.NewSubscriptionID()
+	newService := func(t *testing.T, rates currency.ManualRates) (ports.Exchange, *memoryRateRepository) {
+		connectedAccount := account.NewMockConnectedAccount(t)
+		connectedAccount.EXPECT().UserID().Return(userId)
+		authentication := ports.NewMockAuthentication(t)
+		authentication.EXPECT().GetConnectedAccount(mock.Anything).Return(connectedAccount, true)
+		manualRates := ports.NewMockManualRateRepository(t)
+		// Manual rates are loaded once per request
+		manualRates.EXPECT().GetAllForUser(mock.Anything, userId).Return(rates, nil).Once()
+
+		repository := newMemoryRateRepository()
+		return exchange.New(newMemoryCache(), repository, manualRates, authentication,
+			exchange.NewMockRateSource(t), testx.DiscardLogger()), repository
+	}
+	newRate := func(owner types.Owner, subscriptionId *types.SubscriptionID, from, to currency.Unit,
+		rate float64) currency.ManualRate {
+		return currency.NewManualRate(types.NewManualRateID(), owner, subscriptionId, from, to, rate, day, day)
+	}
+
+	t.Run("converts at the manual rate instead of the market rate", func(t *testing.T) {
+		service, repository := newService(t, currency.ManualRates{
+			newRate(types.NewPersonalOwner(userId), nil, currency.USD, currency.EUR, 0.9),
+		})
+
+		converted, err := service.ToCurrencyAt(context.Background(), currency.NewAmount(10, currency.EUR),
+			currency.USD, day)
+		require.NoError(t, err)
+		assert.InDelta(t, 10/0.9, converted.Value(), 1e-9)
+		assert.Equal(t, currency.ManualRateSource, converted.RateSource())
+
+		converted, err = service.ToCurrencyAt(context.Background(), currency.NewAmount(10, currency.USD),
+			currency.EUR, day)
+		require.NoError(t, err)
+		assert.InDelta(t, 9, converted.Value(), 1e-9)
+		assert.Zero(t, repository.queries)
+	})
+
+	t.Run("prefers the rate of the subscription in a batch", func(t *testing.T) {
+		service, repository := newService(t, currency.ManualRates{
+			newRate(types.NewFamilyOwner(types.NewFamilyID()), nil, currency.EUR, currency.USD, 1.5),
+			newRate(types.NewFamilyOwner(types.NewFamilyID()), x.P(subscriptionId), currency.EUR, currency.USD, 1.2),
+		})
+
+		amounts, err := service.ToCurrencyBatch(context.Background(), []ports.Conversion{
+			{Amount: currency.NewAmount(10, currency.EUR), At: day, SubscriptionId: x.P(subscriptionId)},
+			{Amount: currency.NewAmount(10, currency.EUR), At: day, SubscriptionId: x.P(types.NewSubscriptionID())},
+			{Amount: currency.NewAmount(10, gbp), At: day, SubscriptionId: x.P(subscriptionId)},
+		}, currency.USD)
+		require.NoError(t, err)
+		require.Len(t, amounts, 3)
+
+		assert.InDelta(t, 12, amounts[0].Value(), 1e-9)
+		assert.Equal(t, currency.SubscriptionRateSource, amounts[0].RateSource())
+		assert.InDelta(t, 15, amounts[1].Value(), 1e-9)
+		assert.Equal(t, currency.ManualRateSource, amounts[1].RateSource())
+		// Pairs without a manual rate keep the market rate
+		assert.InDelta(t, 40, amounts[2].Value(), 1e-9)
+		assert.Equal(t, currency.MarketRateSource, amounts[2].RateSource())
+		assert.Equal(t, 1, repository.queries)
+	})
+}
@@ -0,0 +1,46 @@
+package exchange
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+type rateProvider struct {
+	source RateSource
+}
+
+// NewRateProvider creates a rate provider reading the rates of the configured rate sources
+func NewRateProvider(source RateSource) ports.RateProvider {
+	return rateProvider{
+		source: source,
+	}
+}
+
+func (p rateProvider) GetRates(ctx context.Context, at time.Time, units []currency.Unit) (currency.Rates, error) {
+	usdTo, err := p.source.GetRates(ctx, at)
+	if err != nil || len(usdTo) == 0 {
+		return nil, err
+	}
+
+	rateDate := currency.RateDate(at)
+	now := time.Now()
+	rates := make(currency.Rates, 0, len(units)*(len(units)-1))
+	for _, from := range units {
+		for _, to := range units {
+			if from == to {
+				continue
+			}
+			rate, err := crossRate(usdTo, from, to)
+			if err != nil {
+				return nil, err
+			}
+			rates = append(rates, currency.NewRate(types.NewRateID(), from, to, rateDate, rate, now, now))
+		}
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,55 @@
+package exchange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/exchange"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+)
+
+func TestRateProvider_GetRates(t *testing.T) {
+	day := time.Date(2025, time.March, 1, 15, 30, 0, 0, time.UTC)
+	gbp := currency.MustParseISO("GBP")
+	units := []currency.Unit{currency.USD, currency.EUR, gbp}
+
+	t.Run("gives the rate of every pair", func(t *testing.T) {
+		source := exchange.NewMockRateSource(t)
+		source.EXPECT().GetRates(mock.Anything, day).Return(map[string]float64{"EUR": 0.5, "GBP": 0.25}, nil)
+
+		rates, err := exchange.NewRateProvider(source).GetRates(t.Context(), day, units)
+		require.NoError(t, err)
+
+		require.Len(t, rates, 6)
+		for _, rate := range rates {
+			assert.Equal(t, currency.RateDate(day), rate.RateDate())
+		}
+		eurToGbp, ok := rates.FindExchangeRate(currency.EUR, gbp)
+		require.True(t, ok)
+		assert.Equal(t, 0.5, eurToGbp)
+		gbpToUsd, ok := rates.FindExchangeRate(gbp, currency.USD)
+		require.True(t, ok)
+		assert.Equal(t, 4.0, gbpToUsd)
+	})
+
+	t.Run("has nothing for an unpublished day", func(t *testing.T) {
+		source := exchange.NewMockRateSource(t)
+		source.EXPECT().GetRates(mock.Anything, day).Return(nil, nil)
+
+		rates, err := exchange.NewRateProvider(source).GetRates(t.Context(), day, units)
+		require.NoError(t, err)
+		assert.Nil(t, rates)
+	})
+
+	t.Run("fails when a currency is missing from the source", func(t *testing.T) {
+		source := exchange.NewMockRateSource(t)
+		source.EXPECT().GetRates(mock.Anything, day).Return(map[string]float64{"EUR": 0.5}, nil)
+
+		_, err := exchange.NewRateProvider(source).GetRates(t.Context(), day, units)
+		assert.Error(t, err)
+	})
+}
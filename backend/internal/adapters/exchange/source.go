@@ -0,0 +1,119 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Oleexo/config-go"
+)
+
+const (
+	// RateSourcesKey lists the rate sources to query in order, separated by commas
+	RateSourcesKey = "EXCHANGE_RATE_SOURCES"
+	// DefaultRateSources is the rate source used when none is configured
+	DefaultRateSources = GithubRateSourceName
+
+	GithubRateSourceUrlKey = "EXCHANGE_RATE_GITHUB_URL"
+	EcbRateSourceUrlKey    = "EXCHANGE_RATE_ECB_URL"
+	RateDirectoryKey       = "EXCHANGE_RATE_DIRECTORY"
+	// RateSourceTimeoutKey is the timeout of a request to a remote rate source, in nanoseconds
+	RateSourceTimeoutKey     = "EXCHANGE_RATE_HTTP_TIMEOUT"
+	DefaultRateSourceTimeout = 10 * time.Second
+
+	GithubRateSourceName    = "github"
+	EcbRateSourceName       = "ecb"
+	DirectoryRateSourceName = "directory"
+)
+
+// RateSource provides the exchange rates published for a day
+type RateSource interface {
+	// GetRates returns the rates from one dollar to other currencies keyed by currency code,
+	// nil when the source has no rates for that day
+	GetRates(ctx context.Context, at time.Time) (map[string]float64, error)
+	String() string
+}
+
+// NewRateSource creates the chain of rate sources configured by EXCHANGE_RATE_SOURCES
+func NewRateSource(cfg config.Configuration, logger *slog.Logger) (RateSource, error) {
+	client := &http.Client{
+		Timeout: time.Duration(cfg.GetIntOrDefault(RateSourceTimeoutKey, int64(DefaultRateSourceTimeout))),
+	}
+
+	var sources []RateSource
+	for _, name := range strings.Split(cfg.GetStringOrDefault(RateSourcesKey, DefaultRateSources), ",") {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "":
+			continue
+		case GithubRateSourceName:
+			sources = append(sources, NewGithubRateSource(client,
+				cfg.GetStringOrDefault(GithubRateSourceUrlKey, DefaultGithubRateSourceUrl)))
+		case EcbRateSourceName:
+			sources = append(sources, NewEcbRateSource(client,
+				cfg.GetStringOrDefault(EcbRateSourceUrlKey, DefaultEcbRateSourceUrl)))
+		case DirectoryRateSourceName:
+			directory := cfg.GetStringOrDefault(RateDirectoryKey, "")
+			if directory == "" {
+				return nil, fmt.Errorf("%s is required by the %s rate source", RateDirectoryKey,
+					DirectoryRateSourceName)
+			}
+			sources = append(sources, NewDirectoryRateSource(directory))
+		default:
+			return nil, fmt.Errorf("unknown exchange rate source %q", name)
+		}
+	}
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("%s has no rate source", RateSourcesKey)
+	}
+
+	return NewRateSourceChain(logger, sources...), nil
+}
+
+type rateSourceChain struct {
+	sources []RateSource
+	logger  *slog.Logger
+}
+
+// NewRateSourceChain creates a rate source querying each source in order until one has the rates of the day
+func NewRateSourceChain(logger *slog.Logger, sources ...RateSource) RateSource {
+	return rateSourceChain{
+		sources: sources,
+		logger:  logger,
+	}
+}
+
+func (c rateSourceChain) String() string {
+	names := make([]string, len(c.sources))
+	for index, source := range c.sources {
+		names[index] = source.String()
+	}
+	return strings.Join(names, ",")
+}
+
+func (c rateSourceChain) GetRates(ctx context.Context, at time.Time) (map[string]float64, error) {
+	var errs []error
+	for _, source := range c.sources {
+		rates, err := source.GetRates(ctx, at)
+		if err != nil {
+			c.logger.Warn("rate source failed",
+				slog.String("source", source.String()),
+				slog.Time("at", at),
+				slog.Any("error", err))
+			errs = append(errs, err)
+			continue
+		}
+		if len(rates) > 0 {
+			return rates, nil
+		}
+	}
+
+	// The day is missing from every source that answered, a failure only matters when none did
+	if len(errs) == len(c.sources) {
+		return nil, errors.Join(errs...)
+	}
+	return nil, nil
+}
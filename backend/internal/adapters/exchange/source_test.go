@@ -0,0 +1,242 @@
+package exchange_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Oleexo/config-go"
+	"github.com/Oleexo/config-go/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/exchange"
+	"github.com/mistribe/subtracker/pkg/testx"
+)
+
+func TestGithubRateSource_GetRates(t *testing.T) {
+	day := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/exchange/2025/03/01.json":
+			_, _ = w.Write([]byte(`{"from":"USD","to":{"EUR":0.5,"GBP":0.25}}`))
+		case "/exchange/2025/03/02.json":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "/exchange/2025/03/03.json":
+			time.Sleep(100 * time.Millisecond)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := server.Client()
+	client.Timeout = 50 * time.Millisecond
+	source := exchange.NewGithubRateSource(client, server.URL+"/exchange/")
+
+	t.Run("reads the rates of the day", func(t *testing.T) {
+		rates, err := source.GetRates(t.Context(), day)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float64{"EUR": 0.5, "GBP": 0.25}, rates)
+	})
+
+	t.Run("has nothing for an unpublished day", func(t *testing.T) {
+		rates, err := source.GetRates(t.Context(), day.AddDate(0, 1, 0))
+		require.NoError(t, err)
+		assert.Nil(t, rates)
+	})
+
+	t.Run("fails on a server error", func(t *testing.T) {
+		_, err := source.GetRates(t.Context(), day.AddDate(0, 0, 1))
+		assert.Error(t, err)
+	})
+
+	t.Run("gives up after the client timeout", func(t *testing.T) {
+		_, err := source.GetRates(t.Context(), day.AddDate(0, 0, 2))
+		assert.Error(t, err)
+	})
+}
+
+const ecbDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time="2025-03-07">
+			<Cube currency="USD" rate="2"/>
+			<Cube currency="GBP" rate="0.5"/>
+		</Cube>
+		<Cube time="2025-03-06">
+			<Cube currency="USD" rate="1.25"/>
+			<Cube currency="GBP" rate="0.5"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestEcbRateSource_GetRates(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = w.Write([]byte(ecbDocument))
+	}))
+	defer server.Close()
+
+	source := exchange.NewEcbRateSource(server.Client(), server.URL)
+
+	t.Run("converts the rates from the euro to rates from the dollar", func(t *testing.T) {
+		rates, err := source.GetRates(t.Context(), time.Date(2025, time.March, 6, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.InDelta(t, 0.8, rates["EUR"], 1e-9)
+		assert.InDelta(t, 0.4, rates["GBP"], 1e-9)
+		assert.NotContains(t, rates, "USD")
+	})
+
+	t.Run("uses the last publication on a weekend", func(t *testing.T) {
+		rates, err := source.GetRates(t.Context(), time.Date(2025, time.March, 9, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.InDelta(t, 0.5, rates["EUR"], 1e-9)
+		assert.InDelta(t, 0.25, rates["GBP"], 1e-9)
+	})
+
+	t.Run("has nothing outside of the document", func(t *testing.T) {
+		rates, err := source.GetRates(t.Context(), time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Nil(t, rates)
+
+		rates, err = source.GetRates(t.Context(), time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Nil(t, rates)
+	})
+
+	assert.Equal(t, 1, requests)
+}
+
+func TestDirectoryRateSource_GetRates(t *testing.T) {
+	directory := t.TempDir()
+	writeRates := func(path string, content string) {
+		path = filepath.Join(directory, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	writeRates("2025/03/01.json", `{"from":"USD","to":{"EUR":0.5}}`)
+	writeRates("2025/03/02.json", `{"from":"USD",`)
+	writeRates("2025/03/03.json", `{"from":"EUR","to":{"USD":2}}`)
+
+	source := exchange.NewDirectoryRateSource(directory)
+
+	rates, err := source.GetRates(t.Context(), time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"EUR": 0.5}, rates)
+
+	rates, err = source.GetRates(t.Context(), time.Date(2025, time.March, 4, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Nil(t, rates)
+
+	_, err = source.GetRates(t.Context(), time.Date(2025, time.March, 2, 0, 0, 0, 0, time.UTC))
+	assert.Error(t, err)
+
+	// Files must hold rates from the dollar
+	_, err = source.GetRates(t.Context(), time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC))
+	assert.Error(t, err)
+}
+
+func TestRateSourceChain_GetRates(t *testing.T) {
+	day := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	newSource := func(t *testing.T, rates map[string]float64, err error) *exchange.MockRateSource {
+		source := exchange.NewMockRateSource(t)
+		source.EXPECT().GetRates(mock.Anything, day).Return(rates, err).Maybe()
+		source.EXPECT().String().Return("mock").Maybe()
+		return source
+	}
+
+	t.Run("falls back to the next source", func(t *testing.T) {
+		last := newSource(t, map[string]float64{"EUR": 0.5}, nil)
+		chain := exchange.NewRateSourceChain(testx.DiscardLogger(),
+			newSource(t, nil, errors.New("unreachable")),
+			newSource(t, nil, nil),
+			last)
+
+		rates, err := chain.GetRates(t.Context(), day)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float64{"EUR": 0.5}, rates)
+	})
+
+	t.Run("stops at the first source with the rates", func(t *testing.T) {
+		second := exchange.NewMockRateSource(t)
+		chain := exchange.NewRateSourceChain(testx.DiscardLogger(),
+			newSource(t, map[string]float64{"EUR": 0.5}, nil),
+			second)
+
+		rates, err := chain.GetRates(t.Context(), day)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float64{"EUR": 0.5}, rates)
+		second.AssertNotCalled(t, "GetRates", mock.Anything, mock.Anything)
+	})
+
+	t.Run("has nothing when a source has nothing and the others fail", func(t *testing.T) {
+		chain := exchange.NewRateSourceChain(testx.DiscardLogger(),
+			newSource(t, nil, errors.New("unreachable")),
+			newSource(t, nil, nil))
+
+		rates, err := chain.GetRates(t.Context(), day)
+		require.NoError(t, err)
+		assert.Nil(t, rates)
+	})
+
+	t.Run("fails when every source fails", func(t *testing.T) {
+		chain := exchange.NewRateSourceChain(testx.DiscardLogger(),
+			newSource(t, nil, errors.New("unreachable")),
+			newSource(t, nil, errors.New("timeout")))
+
+		_, err := chain.GetRates(t.Context(), day)
+		assert.ErrorContains(t, err, "unreachable")
+		assert.ErrorContains(t, err, "timeout")
+	})
+}
+
+func TestNewRateSource(t *testing.T) {
+	newConfig := func(entries map[string]string) config.Configuration {
+		memConfig := make(map[string]config.Entry)
+		for key, value := range entries {
+			memConfig[key] = config.NewEntryString(value)
+		}
+		return config.NewConfiguration(mem.WithMemory(memConfig))
+	}
+
+	t.Run("defaults to SubTracker-Data", func(t *testing.T) {
+		source, err := exchange.NewRateSource(newConfig(nil), testx.DiscardLogger())
+		require.NoError(t, err)
+		assert.Equal(t, "github", source.String())
+	})
+
+	t.Run("chains the sources in order", func(t *testing.T) {
+		source, err := exchange.NewRateSource(newConfig(map[string]string{
+			exchange.RateSourcesKey:   "directory, ECB,github",
+			exchange.RateDirectoryKey: t.TempDir(),
+		}), testx.DiscardLogger())
+		require.NoError(t, err)
+		assert.Equal(t, "directory,ecb,github", source.String())
+	})
+
+	t.Run("rejects an unknown source", func(t *testing.T) {
+		_, err := exchange.NewRateSource(newConfig(map[string]string{
+			exchange.RateSourcesKey: "github,bank",
+		}), testx.DiscardLogger())
+		assert.Error(t, err)
+	})
+
+	t.Run("requires the directory of the directory source", func(t *testing.T) {
+		_, err := exchange.NewRateSource(newConfig(map[string]string{
+			exchange.RateSourcesKey: "directory",
+		}), testx.DiscardLogger())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/budget"
+)
+
+// BudgetModel represents a spending limit over a period
+// @Description Spending limit over a month or a year for a label, a family or a personal account
+type BudgetModel struct {
+	// @Description Unique identifier for the budget (UUID format)
+	Id string `json:"id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description Display name of the budget
+	Name string `json:"name" binding:"required" example:"Streaming" minLength:"1" maxLength:"100"`
+	// @Description Subscriptions counted against the budget: the ones having a label, the ones of the family or the personal ones
+	Scope string `json:"scope" binding:"required" example:"label" enums:"label,family,account"`
+	// @Description Label whose subscriptions are counted, only set for the label scope
+	LabelId *string `json:"label_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description Period the limit applies to
+	Period string `json:"period" binding:"required" example:"monthly" enums:"monthly,yearly"`
+	// @Description Maximum amount to spend over a period
+	Limit AmountModel `json:"limit" binding:"required"`
+	// @Description Ownership information specifying whether this budget belongs to a user or family
+	Owner OwnerModel `json:"owner" binding:"required"`
+	// @Description ISO 8601 timestamp indicating when the budget was originally created
+	CreatedAt time.Time `json:"created_at" binding:"required" format:"date-time" example:"2023-01-15T10:30:00Z"`
+	// @Description ISO 8601 timestamp indicating when the budget was last modified
+	UpdatedAt time.Time `json:"updated_at" binding:"required" format:"date-time" example:"2023-01-20T14:45:30Z"`
+	// @Description Entity tag used for optimistic concurrency control to prevent conflicting updates
+	Etag string `json:"etag" binding:"required" example:"W/\"123456789\""`
+}
+
+func NewBudgetModel(source budget.Budget) BudgetModel {
+	var labelId *string
+	if source.LabelId() != nil {
+		id := source.LabelId().String()
+		labelId = &id
+	}
+	return BudgetModel{
+		Id:        source.Id().String(),
+		Name:      source.Name(),
+		Scope:     source.Scope().String(),
+		LabelId:   labelId,
+		Period:    source.Period().String(),
+		Limit:     NewAmount(source.Limit()),
+		Owner:     NewOwnerModel(source.Owner()),
+		CreatedAt: source.CreatedAt(),
+		UpdatedAt: source.UpdatedAt(),
+		Etag:      source.ETag(),
+	}
+}
+
+// BudgetStatusModel represents how much of a budget is consumed
+// @Description Cost of the active subscriptions covered by a budget compared with its limit
+type BudgetStatusModel struct {
+	// @Description Budget the status is computed for
+	Budget BudgetModel `json:"budget" binding:"required"`
+	// @Description Cost of the covered subscriptions over a period, in the currency of the limit
+	Consumed AmountModel `json:"consumed" binding:"required"`
+	// @Description Share of the limit consumed, above 100 when the budget is exceeded
+	ConsumedPercentage float64 `json:"consumed_percentage" binding:"required" example:"85.5"`
+	// @Description Amount the covered subscriptions cost above the limit over a period
+	ProjectedOverrun AmountModel `json:"projected_overrun" binding:"required"`
+	// @Description Amount that can still be spent before reaching the limit
+	Remaining AmountModel `json:"remaining" binding:"required"`
+	// @Description Whether the covered subscriptions cost more than the limit
+	Exceeded bool `json:"exceeded" binding:"required" example:"false"`
+}
+
+func NewBudgetStatusModel(source budget.Budget, status budget.Status) BudgetStatusModel {
+	return BudgetStatusModel{
+		Budget:             NewBudgetModel(source),
+		Consumed:           NewAmount(status.Consumed),
+		ConsumedPercentage: status.ConsumedPercentage,
+		ProjectedOverrun:   NewAmount(status.ProjectedOverrun),
+		Remaining:          NewAmount(status.Remaining),
+		Exceeded:           status.IsExceeded(),
+	}
+}
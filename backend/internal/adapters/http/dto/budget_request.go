@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+)
+
+type CreateBudgetRequest struct {
+	Id        *string     `json:"id,omitempty"`
+	Name      string      `json:"name" binding:"required"`
+	Scope     string      `json:"scope" binding:"required" example:"label" enums:"label,family,account"`
+	LabelId   *string     `json:"label_id,omitempty"`
+	Period    string      `json:"period" binding:"required" example:"monthly" enums:"monthly,yearly"`
+	Limit     AmountModel `json:"limit" binding:"required"`
+	Owner     string      `json:"owner" binding:"required" example:"personal" enums:"personal,family"`
+	CreatedAt *time.Time  `json:"created_at,omitempty" format:"date-time"`
+}
+
+type UpdateBudgetRequest struct {
+	Name      string      `json:"name" binding:"required"`
+	Scope     string      `json:"scope" binding:"required" example:"label" enums:"label,family,account"`
+	LabelId   *string     `json:"label_id,omitempty"`
+	Period    string      `json:"period" binding:"required" example:"monthly" enums:"monthly,yearly"`
+	Limit     AmountModel `json:"limit" binding:"required"`
+	UpdatedAt *time.Time  `json:"updated_at,omitempty" format:"date-time"`
+}
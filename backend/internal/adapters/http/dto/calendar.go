@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"fmt"
+)
+
+type CalendarFeedModel struct {
+	// Token is only returned when the feed is created or rotated, keep it secret
+	Token string `json:"token" binding:"required"`
+	// Path of the iCalendar feed to subscribe to, relative to the API base URL
+	Path string `json:"path" binding:"required" example:"/calendar/3q2-7wEj0ZqIzO8TMr5jOv1rOxOQw2dDXy0m5lxq9yY.ics"`
+}
+
+func NewCalendarFeedModel(token string) CalendarFeedModel {
+	return CalendarFeedModel{
+		Token: token,
+		Path:  fmt.Sprintf("/calendar/%s.ics", token),
+	}
+}
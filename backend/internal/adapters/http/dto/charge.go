@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+// ChargeModel represents a single billing occurrence of a subscription
+// @Description Charge of a subscription, the actual amount is set once the charge has been recorded
+type ChargeModel struct {
+	// @Description Unique identifier of the charge
+	Id string `json:"id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description Subscription billed by this charge
+	SubscriptionId string `json:"subscription_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description ISO 8601 timestamp of the billing occurrence
+	DueDate time.Time `json:"due_date" binding:"required" format:"date-time" example:"2024-01-01T00:00:00Z"`
+	// @Description Status of the charge
+	Status string `json:"status" binding:"required" example:"paid" enums:"expected,paid,failed,refunded,adjusted"`
+	// @Description Price of the subscription in effect at the due date
+	ExpectedAmount AmountModel `json:"expected_amount" binding:"required"`
+	// @Description Amount really charged, missing until the charge is recorded
+	ActualAmount *AmountModel `json:"actual_amount,omitempty"`
+	// @Description Actual amount when recorded, expected amount otherwise
+	Amount AmountModel `json:"amount" binding:"required"`
+	// @Description ISO 8601 timestamp when the charge was created
+	CreatedAt time.Time `json:"created_at" binding:"required" format:"date-time"`
+	// @Description ISO 8601 timestamp when the charge was last updated
+	UpdatedAt time.Time `json:"updated_at" binding:"required" format:"date-time"`
+	// @Description Entity tag used for optimistic concurrency control to prevent conflicting updates
+	Etag string `json:"etag" binding:"required" example:"W/\"123456789\""`
+}
+
+func NewChargeModel(source charge.Charge) ChargeModel {
+	var actualAmount *AmountModel
+	if source.ActualAmount() != nil {
+		actualAmount = x.P(NewAmount(source.ActualAmount()))
+	}
+	return ChargeModel{
+		Id:             source.Id().String(),
+		SubscriptionId: source.SubscriptionId().String(),
+		DueDate:        source.DueDate(),
+		Status:         source.Status().String(),
+		ExpectedAmount: NewAmount(source.ExpectedAmount()),
+		ActualAmount:   actualAmount,
+		Amount:         NewAmount(source.Amount()),
+		CreatedAt:      source.CreatedAt(),
+		UpdatedAt:      source.UpdatedAt(),
+		Etag:           source.ETag(),
+	}
+}
@@ -0,0 +1,11 @@
+package dto
+
+import (
+	"time"
+)
+
+type RecordChargeRequest struct {
+	Status       string       `json:"status" binding:"required" enums:"expected,paid,failed,refunded,adjusted"`
+	ActualAmount *AmountModel `json:"actual_amount,omitempty"`
+	UpdatedAt    *time.Time   `json:"updated_at,omitempty" format:"date-time"`
+}
@@ -2,6 +2,8 @@ package dto
 
 import (
 	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
 )
 
 type CurrencyRateModel struct {
@@ -12,3 +14,94 @@ type CurrencyRatesModel struct {
 	Rates     []CurrencyRateModel `json:"rates" binding:"required"`
 	Timestamp time.Time           `json:"timestamp" binding:"required" format:"date-time"`
 }
+
+// ManualRateModel represents a fixed exchange rate
+// @Description Exchange rate defined by an account or a family, used instead of the market rates for a currency pair
+type ManualRateModel struct {
+	// @Description Unique identifier for the manual rate (UUID format)
+	Id string `json:"id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description Only subscription the rate applies to, the rate applies to every subscription when missing
+	SubscriptionId *string `json:"subscription_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description Currency converted from, the rate is also used in reverse
+	FromCurrency string `json:"from_currency" binding:"required" example:"EUR"`
+	// @Description Currency converted to
+	ToCurrency string `json:"to_currency" binding:"required" example:"USD"`
+	// @Description Amount of the target currency for one unit of the source currency
+	Rate float64 `json:"rate" binding:"required" example:"1.08"`
+	// @Description Ownership information specifying whether this rate belongs to a user or family
+	Owner OwnerModel `json:"owner" binding:"required"`
+	// @Description ISO 8601 timestamp indicating when the rate was originally created
+	CreatedAt time.Time `json:"created_at" binding:"required" format:"date-time" example:"2023-01-15T10:30:00Z"`
+	// @Description ISO 8601 timestamp indicating when the rate was last modified
+	UpdatedAt time.Time `json:"updated_at" binding:"required" format:"date-time" example:"2023-01-20T14:45:30Z"`
+	// @Description Entity tag used for optimistic concurrency control to prevent conflicting updates
+	Etag string `json:"etag" binding:"required" example:"W/\"123456789\""`
+}
+
+func NewManualRateModel(source currency.ManualRate) ManualRateModel {
+	var subscriptionId *string
+	if source.SubscriptionId() != nil {
+		id := source.SubscriptionId().String()
+		subscriptionId = &id
+	}
+	return ManualRateModel{
+		Id:             source.Id().String(),
+		SubscriptionId: subscriptionId,
+		FromCurrency:   source.FromCurrency().String(),
+		ToCurrency:     source.ToCurrency().String(),
+		Rate:           source.ExchangeRate(),
+		Owner:          NewOwnerModel(source.Owner()),
+		CreatedAt:      source.CreatedAt(),
+		UpdatedAt:      source.UpdatedAt(),
+		Etag:           source.ETag(),
+	}
+}
+
+// RateSyncModel represents the progress of a synchronization of the market rates
+// @Description Run of the backfill of the market rates missing over a window of days
+type RateSyncModel struct {
+	// @Description Unique identifier for the synchronization (UUID format)
+	Id string `json:"id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description State of the synchronization
+	Status string `json:"status" binding:"required" enums:"running,completed,failed" example:"running"`
+	// @Description First day of the synchronized window
+	StartDate time.Time `json:"start_date" binding:"required" format:"date-time" example:"2024-01-15T00:00:00Z"`
+	// @Description Last day of the synchronized window
+	EndDate time.Time `json:"end_date" binding:"required" format:"date-time" example:"2025-01-15T00:00:00Z"`
+	// @Description Last processed day, a synchronization that did not complete resumes on the day after it
+	Cursor *time.Time `json:"cursor,omitempty" format:"date-time" example:"2024-06-30T00:00:00Z"`
+	// @Description Number of days of the window
+	TotalDays int `json:"total_days" binding:"required" example:"366"`
+	// @Description Number of days already processed
+	ProcessedDays int `json:"processed_days" binding:"required" example:"168"`
+	// @Description Number of processed days whose missing rates were added
+	FilledDays int `json:"filled_days" binding:"required" example:"12"`
+	// @Description Number of processed days no rate source has rates for
+	UnavailableDays int `json:"unavailable_days" binding:"required" example:"2"`
+	// @Description Error the synchronization failed with
+	LastError *string `json:"last_error,omitempty" example:"missing USD->NOK rate from external source"`
+	// @Description ISO 8601 timestamp indicating when the synchronization completed or failed
+	FinishedAt *time.Time `json:"finished_at,omitempty" format:"date-time" example:"2025-01-15T00:05:00Z"`
+	// @Description ISO 8601 timestamp indicating when the synchronization started
+	CreatedAt time.Time `json:"created_at" binding:"required" format:"date-time" example:"2025-01-15T00:00:00Z"`
+	// @Description ISO 8601 timestamp indicating when the progress was last saved
+	UpdatedAt time.Time `json:"updated_at" binding:"required" format:"date-time" example:"2025-01-15T00:02:30Z"`
+}
+
+func NewRateSyncModel(source currency.RateSync) RateSyncModel {
+	return RateSyncModel{
+		Id:              source.Id().String(),
+		Status:          source.Status().String(),
+		StartDate:       source.StartDate(),
+		EndDate:         source.EndDate(),
+		Cursor:          source.Cursor(),
+		TotalDays:       source.TotalDays(),
+		ProcessedDays:   source.ProcessedDays(),
+		FilledDays:      source.FilledDays(),
+		UnavailableDays: source.UnavailableDays(),
+		LastError:       source.LastError(),
+		FinishedAt:      source.FinishedAt(),
+		CreatedAt:       source.CreatedAt(),
+		UpdatedAt:       source.UpdatedAt(),
+	}
+}
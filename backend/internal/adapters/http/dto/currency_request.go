@@ -1 +1,22 @@
 package dto
+
+import (
+	"time"
+)
+
+type CreateManualRateRequest struct {
+	Id             *string    `json:"id,omitempty"`
+	SubscriptionId *string    `json:"subscription_id,omitempty"`
+	FromCurrency   string     `json:"from_currency" binding:"required" example:"EUR"`
+	ToCurrency     string     `json:"to_currency" binding:"required" example:"USD"`
+	Rate           float64    `json:"rate" binding:"required" example:"1.08"`
+	Owner          string     `json:"owner" binding:"required" example:"personal" enums:"personal,family"`
+	CreatedAt      *time.Time `json:"created_at,omitempty" format:"date-time"`
+}
+
+type UpdateManualRateRequest struct {
+	FromCurrency string     `json:"from_currency" binding:"required" example:"EUR"`
+	ToCurrency   string     `json:"to_currency" binding:"required" example:"USD"`
+	Rate         float64    `json:"rate" binding:"required" example:"1.08"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty" format:"date-time"`
+}
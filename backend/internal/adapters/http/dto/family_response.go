@@ -12,3 +12,29 @@ type FamilySeeInvitationResponse struct {
 	// Role of the invited member
 	InvitedInasmuchAs string `json:"invited_inasmuch_as" example:"OWNER"`
 }
+
+// FamilyMemberShareModel represents what a family member pays for the shared subscriptions
+// @Description Share of a family member, converted to the preferred currency of the viewer
+type FamilyMemberShareModel struct {
+	// @Description ID of the family member
+	MemberId string `json:"member_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174001"`
+	// @Description Name of the family member
+	Name string `json:"name" binding:"required" example:"John Doe"`
+	// @Description Monthly share of the member
+	Monthly AmountModel `json:"monthly" binding:"required"`
+	// @Description Yearly share of the member
+	Yearly AmountModel `json:"yearly" binding:"required"`
+}
+
+// FamilySharesModel represents how the family subscriptions are shared between members
+// @Description Monthly and yearly share of each family member for the active family subscriptions
+type FamilySharesModel struct {
+	// @Description ID of the family
+	FamilyId string `json:"family_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description Share of each family member
+	Members []FamilyMemberShareModel `json:"members" binding:"required"`
+	// @Description Monthly cost of the family subscriptions
+	TotalMonthly AmountModel `json:"total_monthly" binding:"required"`
+	// @Description Yearly cost of the family subscriptions
+	TotalYearly AmountModel `json:"total_yearly" binding:"required"`
+}
@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+)
+
+// SettlementModel represents a payment made between two family members
+// @Description Settlement recorded when a member pays another one back outside the application
+type SettlementModel struct {
+	// @Description Unique identifier of the settlement
+	Id string `json:"id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description Family of the members
+	FamilyId string `json:"family_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description Member who paid
+	FromMemberId string `json:"from_member_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174001"`
+	// @Description Member who received the payment
+	ToMemberId string `json:"to_member_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174002"`
+	// @Description Amount paid
+	Amount AmountModel `json:"amount" binding:"required"`
+	// @Description ISO 8601 timestamp of the payment
+	SettledAt time.Time `json:"settled_at" binding:"required" format:"date-time" example:"2024-01-01T00:00:00Z"`
+	// @Description Optional note about the payment
+	Note *string `json:"note,omitempty" example:"Streaming services for March"`
+	// @Description ISO 8601 timestamp when the settlement was created
+	CreatedAt time.Time `json:"created_at" binding:"required" format:"date-time"`
+	// @Description ISO 8601 timestamp when the settlement was last updated
+	UpdatedAt time.Time `json:"updated_at" binding:"required" format:"date-time"`
+	// @Description Entity tag used for optimistic concurrency control to prevent conflicting updates
+	Etag string `json:"etag" binding:"required" example:"W/\"123456789\""`
+}
+
+func NewSettlementModel(source ledger.Settlement) SettlementModel {
+	return SettlementModel{
+		Id:           source.Id().String(),
+		FamilyId:     source.FamilyId().String(),
+		FromMemberId: source.From().String(),
+		ToMemberId:   source.To().String(),
+		Amount:       NewAmount(source.Amount()),
+		SettledAt:    source.SettledAt(),
+		Note:         source.Note(),
+		CreatedAt:    source.CreatedAt(),
+		UpdatedAt:    source.UpdatedAt(),
+		Etag:         source.ETag(),
+	}
+}
+
+// MemberBalanceModel represents the net balance of a family member
+// @Description Net balance of a member, positive when the member is owed money and negative when the member owes money
+type MemberBalanceModel struct {
+	// @Description ID of the family member
+	MemberId string `json:"member_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174001"`
+	// @Description Name of the family member
+	Name string `json:"name" binding:"required" example:"John Doe"`
+	// @Description Net balance in the preferred currency
+	Balance AmountModel `json:"balance" binding:"required"`
+}
+
+// TransferModel represents a payment to make to settle up
+// @Description Payment a member has to make to another one so every balance goes back to zero
+type TransferModel struct {
+	// @Description Member who has to pay
+	FromMemberId string `json:"from_member_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174001"`
+	// @Description Member who has to be paid
+	ToMemberId string `json:"to_member_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174002"`
+	// @Description Amount to pay in the preferred currency
+	Amount AmountModel `json:"amount" binding:"required"`
+}
+
+// FamilyLedgerModel represents who owes whom within a family
+// @Description Balances accrued from the shares of charges paid by another member and from settlements, with the transfers to settle everything
+type FamilyLedgerModel struct {
+	// @Description ID of the family
+	FamilyId string `json:"family_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description Net balance of each family member
+	Balances []MemberBalanceModel `json:"balances" binding:"required"`
+	// @Description Minimal list of transfers that settles every balance
+	Transfers []TransferModel `json:"transfers" binding:"required"`
+	// @Description Settlements recorded for the family
+	Settlements []SettlementModel `json:"settlements" binding:"required"`
+}
+
+func NewTransferModel(source ledger.Transfer) TransferModel {
+	return TransferModel{
+		FromMemberId: source.From.String(),
+		ToMemberId:   source.To.String(),
+		Amount:       NewAmount(source.Amount),
+	}
+}
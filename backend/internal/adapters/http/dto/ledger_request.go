@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"time"
+)
+
+type RecordSettlementRequest struct {
+	FromMemberId string      `json:"from_member_id" binding:"required"`
+	ToMemberId   string      `json:"to_member_id" binding:"required"`
+	Amount       AmountModel `json:"amount" binding:"required"`
+	SettledAt    *time.Time  `json:"settled_at,omitempty" format:"date-time"`
+	Note         *string     `json:"note,omitempty"`
+	CreatedAt    *time.Time  `json:"created_at,omitempty" format:"date-time"`
+}
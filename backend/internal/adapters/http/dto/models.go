@@ -5,6 +5,7 @@ import (
 
 	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/decimal"
 	"github.com/mistribe/subtracker/pkg/x"
 )
 
@@ -69,20 +70,25 @@ func NewOwnerModel(source types.Owner) OwnerModel {
 }
 
 type AmountModel struct {
-	Value    float64      `json:"value" binding:"required" example:"100.00"`
-	Currency string       `json:"currency" binding:"required" example:"USD"`
-	Source   *AmountModel `json:"source,omitempty"`
+	Value    decimal.Decimal `json:"value" binding:"required" example:"100.00" swaggertype:"number"`
+	Currency string          `json:"currency" binding:"required" example:"USD"`
+	Source   *AmountModel    `json:"source,omitempty"`
+	// @Description Kind of rate the amount was converted from its source with, only set for a converted amount
+	RateSource string `json:"rate_source,omitempty" example:"market" enums:"market,manual,subscription"`
 }
 
 func NewAmount(amount currency.Amount) AmountModel {
+	// Amounts are exact, they are only rounded to the minor units of their currency when written
+	amount = amount.Round(currency.DefaultRounding)
 	var source *AmountModel
 	if amount.Source() != nil {
 		source = x.P(NewAmount(amount.Source()))
 	}
 	return AmountModel{
-		Value:    amount.Value(),
-		Currency: amount.Currency().String(),
-		Source:   source,
+		Value:      amount.Decimal(),
+		Currency:   amount.Currency().String(),
+		Source:     source,
+		RateSource: amount.RateSource().String(),
 	}
 }
 
@@ -91,5 +97,5 @@ func (a AmountModel) Amount() (currency.Amount, error) {
 	if err != nil {
 		return nil, err
 	}
-	return currency.NewAmount(a.Value, c), nil
+	return currency.NewAmountFromDecimal(a.Value, c), nil
 }
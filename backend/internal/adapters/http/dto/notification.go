@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/notification"
+)
+
+// NotificationPreferencesModel represents the email reminders of the account
+// @Description Email reminders the account opted in to, sent a number of lead days before each event
+type NotificationPreferencesModel struct {
+	// @Description Whether reminders are emailed, reminders are opt-in
+	Enabled bool `json:"enabled" binding:"required" example:"true"`
+	// @Description Language of the reminders
+	Locale string `json:"locale" binding:"required" enums:"en,fr" example:"en"`
+	// @Description Days before a renewal its reminder is sent, 0 sends it on the day
+	RenewalLeadDays int `json:"renewal_lead_days" binding:"required" example:"3"`
+	// @Description Days before the end of a free trial its reminder is sent
+	FreeTrialEndLeadDays int `json:"free_trial_end_lead_days" binding:"required" example:"3"`
+	// @Description Days before the last day to cancel a subscription its reminder is sent
+	CancellationDeadlineLeadDays int `json:"cancellation_deadline_lead_days" binding:"required" example:"7"`
+	// @Description ISO 8601 timestamp indicating when the preferences were last updated
+	UpdatedAt time.Time `json:"updated_at" binding:"required" format:"date-time" example:"2025-01-15T00:00:00Z"`
+	// @Description Entity tag used for optimistic concurrency control to prevent conflicting updates
+	Etag string `json:"etag" binding:"required" example:"W/\"123456789\""`
+}
+
+func NewNotificationPreferencesModel(source notification.Preferences) NotificationPreferencesModel {
+	return NotificationPreferencesModel{
+		Enabled:                      source.Enabled(),
+		Locale:                       source.Locale().String(),
+		RenewalLeadDays:              source.RenewalLeadDays(),
+		FreeTrialEndLeadDays:         source.FreeTrialEndLeadDays(),
+		CancellationDeadlineLeadDays: source.CancellationDeadlineLeadDays(),
+		UpdatedAt:                    source.UpdatedAt(),
+		Etag:                         source.ETag(),
+	}
+}
@@ -0,0 +1,14 @@
+package dto
+
+type UpdateNotificationPreferencesRequest struct {
+	// Whether reminders are emailed
+	Enabled bool `json:"enabled" example:"true"`
+	// Language of the reminders
+	Locale string `json:"locale" binding:"required" enums:"en,fr" example:"fr"`
+	// Days before a renewal its reminder is sent, 0 sends it on the day
+	RenewalLeadDays int `json:"renewal_lead_days" minimum:"0" maximum:"60" example:"3"`
+	// Days before the end of a free trial its reminder is sent
+	FreeTrialEndLeadDays int `json:"free_trial_end_lead_days" minimum:"0" maximum:"60" example:"3"`
+	// Days before the last day to cancel a subscription its reminder is sent
+	CancellationDeadlineLeadDays int `json:"cancellation_deadline_lead_days" minimum:"0" maximum:"60" example:"7"`
+}
@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+)
+
+// PaymentMethodModel represents a card, bank account or wallet subscriptions are charged to
+// @Description Payment method subscriptions are charged to
+type PaymentMethodModel struct {
+	// @Description Unique identifier for the payment method (UUID format)
+	Id string `json:"id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description Kind of payment method
+	Type string `json:"type" binding:"required" example:"card" enums:"card,bank_account,wallet,other"`
+	// @Description Display name of the payment method
+	Label string `json:"label" binding:"required" example:"Personal Visa" minLength:"1" maxLength:"100"`
+	// @Description Last four digits of the card or account number
+	LastFour *string `json:"last_four,omitempty" example:"4242" pattern:"^[0-9]{4}$"`
+	// @Description Month of expiry (1-12)
+	ExpiryMonth *int32 `json:"expiry_month,omitempty" example:"3" minimum:"1" maximum:"12"`
+	// @Description Year of expiry
+	ExpiryYear *int32 `json:"expiry_year,omitempty" example:"2027"`
+	// @Description Ownership information specifying whether this payment method belongs to a user or family
+	Owner OwnerModel `json:"owner" binding:"required"`
+	// @Description ISO 8601 timestamp indicating when the payment method was originally created
+	CreatedAt time.Time `json:"created_at" binding:"required" format:"date-time" example:"2023-01-15T10:30:00Z"`
+	// @Description ISO 8601 timestamp indicating when the payment method was last modified
+	UpdatedAt time.Time `json:"updated_at" binding:"required" format:"date-time" example:"2023-01-20T14:45:30Z"`
+	// @Description Entity tag used for optimistic concurrency control to prevent conflicting updates
+	Etag string `json:"etag" binding:"required" example:"W/\"123456789\""`
+}
+
+func NewPaymentMethodModel(source paymentmethod.PaymentMethod) PaymentMethodModel {
+	return PaymentMethodModel{
+		Id:          source.Id().String(),
+		Type:        source.Type().String(),
+		Label:       source.Label(),
+		LastFour:    source.LastFour(),
+		ExpiryMonth: source.ExpiryMonth(),
+		ExpiryYear:  source.ExpiryYear(),
+		Owner:       NewOwnerModel(source.Owner()),
+		CreatedAt:   source.CreatedAt(),
+		UpdatedAt:   source.UpdatedAt(),
+		Etag:        source.ETag(),
+	}
+}
+
+// ExpiringPaymentMethodSubscriptionModel represents a subscription whose payment method expires before its next renewal
+// @Description Subscription that will fail to renew because its payment method expires first
+type ExpiringPaymentMethodSubscriptionModel struct {
+	// @Description Subscription affected by the expiry
+	Subscription SubscriptionModel `json:"subscription" binding:"required"`
+	// @Description Payment method the subscription is charged to
+	PaymentMethod PaymentMethodModel `json:"payment_method" binding:"required"`
+	// @Description ISO 8601 timestamp of the next renewal of the subscription
+	NextRenewalDate time.Time `json:"next_renewal_date" binding:"required" format:"date-time" example:"2025-04-01T00:00:00Z"`
+	// @Description ISO 8601 timestamp from which the payment method can no longer be charged
+	PaymentMethodExpiresAt time.Time `json:"payment_method_expires_at" binding:"required" format:"date-time" example:"2025-04-01T00:00:00Z"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+)
+
+type CreatePaymentMethodRequest struct {
+	Id          *string    `json:"id,omitempty"`
+	Type        string     `json:"type" binding:"required" example:"card" enums:"card,bank_account,wallet,other"`
+	Label       string     `json:"label" binding:"required"`
+	LastFour    *string    `json:"last_four,omitempty" example:"4242"`
+	ExpiryMonth *int32     `json:"expiry_month,omitempty" example:"3"`
+	ExpiryYear  *int32     `json:"expiry_year,omitempty" example:"2027"`
+	Owner       string     `json:"owner" binding:"required" example:"personal" enums:"personal,family"`
+	CreatedAt   *time.Time `json:"created_at,omitempty" format:"date-time"`
+}
+
+type UpdatePaymentMethodRequest struct {
+	Type        string     `json:"type" binding:"required" example:"card" enums:"card,bank_account,wallet,other"`
+	Label       string     `json:"label" binding:"required"`
+	LastFour    *string    `json:"last_four,omitempty" example:"4242"`
+	ExpiryMonth *int32     `json:"expiry_month,omitempty" example:"3"`
+	ExpiryYear  *int32     `json:"expiry_year,omitempty" example:"2027"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty" format:"date-time"`
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/domain/subscription"
 	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/decimal"
 	"github.com/mistribe/subtracker/pkg/x"
 	"github.com/mistribe/subtracker/pkg/x/herd"
 )
@@ -34,13 +35,122 @@ func NewSubscriptionFreeTrial(model *SubscriptionFreeTrialModel) subscription.Fr
 	return subscription.NewFreeTrial(model.StartDate, model.EndDate)
 }
 
+// SubscriptionContractModel represents the commitment terms of a subscription
+// @Description Subscription contract defining the minimum commitment, renewal behaviour and cancellation notice
+type SubscriptionContractModel struct {
+	// @Description Minimum commitment in months counted from the start date (null when there is no commitment)
+	MinimumTerm *int32 `json:"minimum_term,omitempty" example:"12" minimum:"1"`
+	// @Description Indicates whether the subscription renews automatically at the end of each period
+	AutoRenew bool `json:"auto_renew" example:"true"`
+	// @Description Number of days before a renewal the cancellation must be requested (null when there is no notice period)
+	NoticePeriod *int32 `json:"notice_period,omitempty" example:"30" minimum:"0"`
+}
+
+func newSubscriptionContractModel(source subscription.Contract) *SubscriptionContractModel {
+	if source == nil {
+		return nil
+	}
+
+	return &SubscriptionContractModel{
+		MinimumTerm:  source.MinimumTerm(),
+		AutoRenew:    source.AutoRenew(),
+		NoticePeriod: source.NoticePeriod(),
+	}
+}
+
+func NewSubscriptionContract(model *SubscriptionContractModel) subscription.Contract {
+	if model == nil {
+		return nil
+	}
+
+	return subscription.NewContract(model.MinimumTerm, model.AutoRenew, model.NoticePeriod)
+}
+
+// SubscriptionCostShareModel represents the part of a subscription cost a family member pays
+// @Description Share of a family member, a percentage or a fixed amount per billing period depending on the split type
+type SubscriptionCostShareModel struct {
+	// @Description ID of the family member (UUID format)
+	MemberId string `json:"member_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174001"`
+	// @Description Percentage for a percentage split, amount in the subscription currency for a fixed split, ignored for an equal split
+	Value decimal.Decimal `json:"value,omitempty" example:"50" swaggertype:"number"`
+}
+
+// SubscriptionCostSplitModel represents how the cost of a subscription is shared between family members
+// @Description Cost split of a family subscription. Percentages must add up to 100 and fixed amounts to the subscription price
+type SubscriptionCostSplitModel struct {
+	// @Description How the cost is shared
+	Type string `json:"type" binding:"required" example:"percentage" enums:"equal,percentage,fixed"`
+	// @Description Members taking part in the split, an equal split without shares uses the family users of the subscription
+	Shares []SubscriptionCostShareModel `json:"shares,omitempty"`
+}
+
+func newSubscriptionCostSplitModel(source subscription.CostSplit) *SubscriptionCostSplitModel {
+	if source == nil {
+		return nil
+	}
+
+	return &SubscriptionCostSplitModel{
+		Type: source.Type().String(),
+		Shares: herd.Select(source.Shares(), func(share subscription.CostShare) SubscriptionCostShareModel {
+			return SubscriptionCostShareModel{
+				MemberId: share.MemberId.String(),
+				Value:    share.Value,
+			}
+		}),
+	}
+}
+
+// NewSubscriptionCustomRecurrency builds the custom recurrency interval, counted in months when no unit is given
+func NewSubscriptionCustomRecurrency(count *int32, unit *string) (*subscription.Interval, error) {
+	if count == nil {
+		return nil, nil
+	}
+
+	intervalUnit := subscription.MonthIntervalUnit
+	if unit != nil {
+		parsedUnit, err := subscription.ParseIntervalUnit(*unit)
+		if err != nil {
+			return nil, err
+		}
+		intervalUnit = parsedUnit
+	}
+
+	return subscription.NewInterval(intervalUnit, *count), nil
+}
+
+func NewSubscriptionCostSplit(model *SubscriptionCostSplitModel) (subscription.CostSplit, error) {
+	if model == nil {
+		return nil, nil
+	}
+
+	splitType, err := subscription.ParseCostSplitType(model.Type)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := herd.SelectErr(model.Shares, func(share SubscriptionCostShareModel) (subscription.CostShare, error) {
+		memberId, err := types.ParseFamilyMemberID(share.MemberId)
+		if err != nil {
+			return subscription.CostShare{}, err
+		}
+		return subscription.CostShare{
+			MemberId: memberId,
+			Value:    share.Value,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription.NewCostSplit(splitType, shares), nil
+}
+
 func NewSubscriptionCustomPrice(model AmountModel) (subscription.Price, error) {
 	cry, err := currency.ParseISO(model.Currency)
 	if err != nil {
 		return nil, err
 	}
 
-	return subscription.NewPrice(currency.NewAmount(model.Value, cry)), nil
+	return subscription.NewPrice(currency.NewAmountFromDecimal(model.Value, cry)), nil
 
 }
 
@@ -73,10 +183,20 @@ type SubscriptionModel struct {
 	FriendlyName *string `json:"friendly_name,omitempty" example:"Netflix Family Account" maxLength:"255"`
 	// @Description Number of free trial days remaining (null if no trial or trial expired)
 	FreeTrial *SubscriptionFreeTrialModel `json:"free_trial,omitempty"`
+	// @Description Contract terms of the subscription (null when the subscription has no commitment)
+	Contract *SubscriptionContractModel `json:"contract,omitempty"`
+	// @Description ISO 8601 timestamp of the last day the subscription can be cancelled before its next renewal
+	CancellationDeadline *time.Time `json:"cancellation_deadline,omitempty" format:"date-time" example:"2024-01-01T00:00:00Z"`
 	// @Description LabelID of the service provider offering this subscription
 	ProviderId string `json:"provider_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174002"`
 	// @Description Custom price for this subscription
 	Price *AmountModel `json:"price,omitempty"`
+	// @Description Price charged today, the promotional price while a promotion applies
+	CurrentPrice *AmountModel `json:"current_price,omitempty"`
+	// @Description Discounted pricing phases ordered by start date
+	Promotions []SubscriptionPromotionModel `json:"promotions,omitempty"`
+	// @Description ISO 8601 timestamp when the promotion in effect ends and the regular price applies (null without promotion)
+	PromotionEndDate *time.Time `json:"promotion_end_date,omitempty" format:"date-time" example:"2024-04-01T00:00:00Z"`
 	// @Description Ownership information specifying whether this subscription belongs to a user or family
 	Owner OwnerModel `json:"owner" binding:"required"`
 	// @Description List of family member IDs who use this service (for shared subscriptions)
@@ -87,10 +207,18 @@ type SubscriptionModel struct {
 	EndDate *time.Time `json:"end_date,omitempty" format:"date-time" example:"2024-01-01T00:00:00Z"`
 	// @Description Billing recurrency pattern (monthly, yearly, custom, etc.)
 	Recurrency string `json:"recurrency" binding:"required" example:"monthly" enums:"unknown,one_time,monthly,quarterly,half_yearly,yearly,custom"`
-	// @Description CustomRecurrency recurrency interval in days (required when recurrency is custom)
-	CustomRecurrency *int32 `json:"custom_recurrency,omitempty" example:"90" minimum:"1" maximum:"3650"`
+	// @Description Number of interval units between two renewals (required when recurrency is custom)
+	CustomRecurrency *int32 `json:"custom_recurrency,omitempty" example:"2" minimum:"1" maximum:"3650"`
+	// @Description Unit of the custom recurrency interval, e.g. 2 weeks or 28 days (set when recurrency is custom)
+	CustomRecurrencyUnit *string `json:"custom_recurrency_unit,omitempty" example:"week" enums:"day,week,month,year"`
+	// @Description Day of the month monthly and yearly renewals fall on, clamped to the last day of shorter months (null to follow the start date)
+	BillingAnchorDay *int32 `json:"billing_anchor_day,omitempty" example:"31" minimum:"1" maximum:"31"`
 	// @Description Information about who pays for this subscription within the family
 	Payer *SubscriptionPayerModel `json:"payer,omitempty"`
+	// @Description How the cost is shared between family members (null when it is not shared)
+	CostSplit *SubscriptionCostSplitModel `json:"cost_split,omitempty"`
+	// @Description Identifier of the payment method the subscription is charged to (null when unknown)
+	PaymentMethodId *string `json:"payment_method_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
 	// @Description ISO 8601 timestamp when the subscription was originally created
 	CreatedAt time.Time `json:"created_at" binding:"required" format:"date-time" example:"2023-01-15T10:30:00Z"`
 	// @Description ISO 8601 timestamp when the subscription was last modified
@@ -99,6 +227,10 @@ type SubscriptionModel struct {
 	Etag string `json:"etag" binding:"required" example:"W/\"123456789\""`
 	// @Description Indicates whether the subscription is currently active or not
 	IsActive bool `json:"is_active" binding:"required" example:"true"`
+	// @Description Indicates whether the subscription is currently paused
+	IsPaused bool `json:"is_paused" binding:"required" example:"false"`
+	// @Description Periods during which the subscription is suspended, ordered by start date
+	Pauses []SubscriptionPauseModel `json:"pauses,omitempty"`
 	// @Description List of labels associated with this subscription
 	LabelRefs []LabelRefModel `json:"label_refs,omitempty"`
 }
@@ -116,6 +248,57 @@ func newSubscriptionPayerModel(source subscription.Payer) SubscriptionPayerModel
 	return model
 }
 
+// SubscriptionPriceChangeModel represents the price of a subscription from a given date
+// @Description Price change of a subscription, the price applies from its effective date until the next change
+type SubscriptionPriceChangeModel struct {
+	// @Description ISO 8601 timestamp from which this price applies
+	EffectiveFrom time.Time `json:"effective_from" binding:"required" format:"date-time" example:"2024-01-01T00:00:00Z"`
+	// @Description Price of the subscription from the effective date
+	Price AmountModel `json:"price" binding:"required"`
+}
+
+func NewSubscriptionPriceChangeModel(source subscription.PriceChange) SubscriptionPriceChangeModel {
+	return SubscriptionPriceChangeModel{
+		EffectiveFrom: source.EffectiveFrom,
+		Price:         NewAmount(source.Amount),
+	}
+}
+
+// SubscriptionPauseModel represents a period during which a subscription is suspended
+// @Description Pause of a subscription, the subscription is neither billed nor active between its start and end dates
+type SubscriptionPauseModel struct {
+	// @Description ISO 8601 timestamp when the pause starts
+	StartDate time.Time `json:"start_date" binding:"required" format:"date-time" example:"2024-01-01T00:00:00Z"`
+	// @Description ISO 8601 timestamp when the subscription resumes (null while the resume date is unknown)
+	EndDate *time.Time `json:"end_date,omitempty" format:"date-time" example:"2024-04-01T00:00:00Z"`
+}
+
+func NewSubscriptionPauseModel(source subscription.Pause) SubscriptionPauseModel {
+	return SubscriptionPauseModel{
+		StartDate: source.StartDate,
+		EndDate:   source.EndDate,
+	}
+}
+
+// SubscriptionPromotionModel represents a discounted pricing phase of a subscription
+// @Description Promotion of a subscription, its price is charged instead of the regular price between its start and end dates
+type SubscriptionPromotionModel struct {
+	// @Description ISO 8601 timestamp when the promotional price starts
+	StartDate time.Time `json:"start_date" binding:"required" format:"date-time" example:"2024-01-01T00:00:00Z"`
+	// @Description ISO 8601 timestamp when the regular price applies again
+	EndDate time.Time `json:"end_date" binding:"required" format:"date-time" example:"2024-04-01T00:00:00Z"`
+	// @Description Promotional price charged each billing period
+	Price AmountModel `json:"price" binding:"required"`
+}
+
+func NewSubscriptionPromotionModel(source subscription.Promotion) SubscriptionPromotionModel {
+	return SubscriptionPromotionModel{
+		StartDate: source.StartDate,
+		EndDate:   source.EndDate,
+		Price:     NewAmount(source.Amount),
+	}
+}
+
 type LabelRefModel struct {
 	LabelId string `json:"label_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
 	Source  string `json:"source" binding:"required" example:"subscription" enums:"subscription,provider"`
@@ -137,24 +320,45 @@ func NewSubscriptionModel(source subscription.Subscription) SubscriptionModel {
 		return in.String()
 	})
 	labelRefs := herd.Select(source.Labels().Values(), newSubscriptionLabelRef)
+	var customRecurrencyCount *int32
+	var customRecurrencyUnit *string
+	if source.CustomRecurrency() != nil {
+		customRecurrencyCount = x.P(source.CustomRecurrency().Count)
+		customRecurrencyUnit = x.P(source.CustomRecurrency().Unit.String())
+	}
 	model := SubscriptionModel{
-		Id:               source.Id().String(),
-		FriendlyName:     source.FriendlyName(),
-		FreeTrial:        newSubscriptionFreeTrialModel(source.FreeTrial()),
-		ProviderId:       source.ProviderId().String(),
-		FamilyUsers:      serviceUsers,
-		LabelRefs:        labelRefs,
-		Owner:            NewOwnerModel(source.Owner()),
-		StartDate:        source.StartDate(),
-		EndDate:          source.EndDate(),
-		Recurrency:       source.Recurrency().String(),
-		CustomRecurrency: source.CustomRecurrency(),
-		Payer:            payerModel,
-		IsActive:         source.IsActive(),
-		CreatedAt:        source.CreatedAt(),
-		UpdatedAt:        source.UpdatedAt(),
-		Etag:             source.ETag(),
-		Price:            x.P(NewAmount(source.Price().Amount())),
+		Id:                   source.Id().String(),
+		FriendlyName:         source.FriendlyName(),
+		FreeTrial:            newSubscriptionFreeTrialModel(source.FreeTrial()),
+		Contract:             newSubscriptionContractModel(source.Contract()),
+		CancellationDeadline: source.CancellationDeadline(),
+		ProviderId:           source.ProviderId().String(),
+		FamilyUsers:          serviceUsers,
+		LabelRefs:            labelRefs,
+		Owner:                NewOwnerModel(source.Owner()),
+		StartDate:            source.StartDate(),
+		EndDate:              source.EndDate(),
+		Recurrency:           source.Recurrency().String(),
+		CustomRecurrency:     customRecurrencyCount,
+		CustomRecurrencyUnit: customRecurrencyUnit,
+		BillingAnchorDay:     source.BillingAnchorDay(),
+		Payer:                payerModel,
+		CostSplit:            newSubscriptionCostSplitModel(source.CostSplit()),
+		IsActive:             source.IsActive(),
+		IsPaused:             source.IsPaused(),
+		Pauses:               herd.Select(source.Pauses().Values(), NewSubscriptionPauseModel),
+		CreatedAt:            source.CreatedAt(),
+		UpdatedAt:            source.UpdatedAt(),
+		Etag:                 source.ETag(),
+		Price:                x.P(NewAmount(source.Price().Amount())),
+		Promotions:           herd.Select(source.Promotions().Values(), NewSubscriptionPromotionModel),
+		PromotionEndDate:     source.PromotionEndDate(),
+	}
+	if source.PaymentMethodId() != nil {
+		model.PaymentMethodId = x.P(source.PaymentMethodId().String())
+	}
+	if currentPrice := source.GetPrice(); currentPrice.IsValid() {
+		model.CurrentPrice = x.P(NewAmount(currentPrice))
 	}
 
 	return model
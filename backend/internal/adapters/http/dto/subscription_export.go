@@ -1,21 +1,78 @@
 package dto
 
+import (
+	"github.com/mistribe/subtracker/pkg/decimal"
+)
+
 // SubscriptionExportModel represents a subscription for export purposes
 type SubscriptionExportModel struct {
-	Id                 string   `json:"id" csv:"id" yaml:"id"`
-	ProviderKey        string   `json:"providerKey" csv:"providerKey" yaml:"providerKey"`
-	FriendlyName       *string  `json:"friendlyName,omitempty" csv:"friendlyName" yaml:"friendlyName,omitempty"`
-	StartDate          string   `json:"startDate" csv:"startDate" yaml:"startDate"`
-	EndDate            *string  `json:"endDate,omitempty" csv:"endDate" yaml:"endDate,omitempty"`
-	Recurrency         string   `json:"recurrency" csv:"recurrency" yaml:"recurrency"`
-	CustomRecurrency   *int32   `json:"customRecurrency,omitempty" csv:"customRecurrency" yaml:"customRecurrency,omitempty"`
-	Amount             float64  `json:"amount" csv:"amount" yaml:"amount"`
-	Currency           string   `json:"currency" csv:"currency" yaml:"currency"`
-	OwnerType          string   `json:"ownerType" csv:"ownerType" yaml:"ownerType"`
-	FreeTrialStartDate *string  `json:"freeTrialStartDate,omitempty" csv:"freeTrialStartDate" yaml:"freeTrialStartDate,omitempty"`
-	FreeTrialEndDate   *string  `json:"freeTrialEndDate,omitempty" csv:"freeTrialEndDate" yaml:"freeTrialEndDate,omitempty"`
-	Payer              *string  `json:"payer,omitempty" csv:"payer" yaml:"payer,omitempty" enums:"family,family_member"`
-	PayerMemberId      *string  `json:"payerMemberId,omitempty" csv:"payerMemberId" yaml:"payerMemberId,omitempty"`
-	FamilyUsers        []string `json:"familyUsers" csv:"familyUsers" yaml:"familyUsers"`
-	Labels             []string `json:"labels" csv:"labels" yaml:"labels"`
+	Id                   string          `json:"id" csv:"id" yaml:"id"`
+	ProviderKey          string          `json:"providerKey" csv:"providerKey" yaml:"providerKey"`
+	FriendlyName         *string         `json:"friendlyName,omitempty" csv:"friendlyName" yaml:"friendlyName,omitempty"`
+	StartDate            string          `json:"startDate" csv:"startDate" yaml:"startDate"`
+	EndDate              *string         `json:"endDate,omitempty" csv:"endDate" yaml:"endDate,omitempty"`
+	Recurrency           string          `json:"recurrency" csv:"recurrency" yaml:"recurrency"`
+	CustomRecurrency     *int32          `json:"customRecurrency,omitempty" csv:"customRecurrency" yaml:"customRecurrency,omitempty"`
+	CustomRecurrencyUnit *string         `json:"customRecurrencyUnit,omitempty" csv:"customRecurrencyUnit" yaml:"customRecurrencyUnit,omitempty" enums:"day,week,month,year"`
+	BillingAnchorDay     *int32          `json:"billingAnchorDay,omitempty" csv:"billingAnchorDay" yaml:"billingAnchorDay,omitempty"`
+	Amount               decimal.Decimal `json:"amount" csv:"amount" yaml:"amount" swaggertype:"number"`
+	Currency             string          `json:"currency" csv:"currency" yaml:"currency"`
+	OwnerType            string          `json:"ownerType" csv:"ownerType" yaml:"ownerType"`
+	FreeTrialStartDate   *string         `json:"freeTrialStartDate,omitempty" csv:"freeTrialStartDate" yaml:"freeTrialStartDate,omitempty"`
+	FreeTrialEndDate     *string         `json:"freeTrialEndDate,omitempty" csv:"freeTrialEndDate" yaml:"freeTrialEndDate,omitempty"`
+	Payer                *string         `json:"payer,omitempty" csv:"payer" yaml:"payer,omitempty" enums:"family,family_member"`
+	PayerMemberId        *string         `json:"payerMemberId,omitempty" csv:"payerMemberId" yaml:"payerMemberId,omitempty"`
+	FamilyUsers          []string        `json:"familyUsers" csv:"familyUsers" yaml:"familyUsers"`
+	Labels               []string        `json:"labels" csv:"labels" yaml:"labels"`
+	// ContractAutoRenew is set when the subscription has a contract, the lists are written as a JSON array in their
+	// csv cell
+	ContractMinimumTerm  *int32                   `json:"contractMinimumTerm,omitempty" csv:"contractMinimumTerm" yaml:"contractMinimumTerm,omitempty"`
+	ContractAutoRenew    *bool                    `json:"contractAutoRenew,omitempty" csv:"contractAutoRenew" yaml:"contractAutoRenew,omitempty"`
+	ContractNoticePeriod *int32                   `json:"contractNoticePeriod,omitempty" csv:"contractNoticePeriod" yaml:"contractNoticePeriod,omitempty"`
+	CostSplitType        *string                  `json:"costSplitType,omitempty" csv:"costSplitType" yaml:"costSplitType,omitempty" enums:"equal,percentage,fixed"`
+	CostShares           []CostShareExportModel   `json:"costShares,omitempty" csv:"costShares" yaml:"costShares,omitempty"`
+	PaymentMethodId      *string                  `json:"paymentMethodId,omitempty" csv:"paymentMethodId" yaml:"paymentMethodId,omitempty"`
+	PriceHistory         []PriceChangeExportModel `json:"priceHistory,omitempty" csv:"priceHistory" yaml:"priceHistory,omitempty"`
+	Promotions           []PromotionExportModel   `json:"promotions,omitempty" csv:"promotions" yaml:"promotions,omitempty"`
+	Pauses               []PauseExportModel       `json:"pauses,omitempty" csv:"pauses" yaml:"pauses,omitempty"`
+	// TotalSpent is informational, it uses the recorded charges and is ignored on import
+	TotalSpent *decimal.Decimal `json:"totalSpent,omitempty" csv:"totalSpent" yaml:"totalSpent,omitempty" swaggertype:"number"`
+	// Charges are the recorded charges of the ledger, they are informational, left out of csv and ignored on import
+	Charges []ChargeExportModel `json:"charges,omitempty" csv:"-" yaml:"charges,omitempty"`
+}
+
+// ChargeExportModel represents a recorded charge of a subscription for export purposes
+type ChargeExportModel struct {
+	DueDate        string          `json:"dueDate" yaml:"dueDate"`
+	Status         string          `json:"status" yaml:"status" enums:"expected,paid,failed,refunded,adjusted"`
+	ExpectedAmount decimal.Decimal `json:"expectedAmount" yaml:"expectedAmount" swaggertype:"number"`
+	ActualAmount   decimal.Decimal `json:"actualAmount" yaml:"actualAmount" swaggertype:"number"`
+	Currency       string          `json:"currency" yaml:"currency"`
+}
+
+// CostShareExportModel represents the share of a family member in the cost split of a subscription for export purposes
+type CostShareExportModel struct {
+	MemberId string          `json:"memberId" yaml:"memberId"`
+	Value    decimal.Decimal `json:"value" yaml:"value" swaggertype:"number"`
+}
+
+// PriceChangeExportModel represents a price of a subscription from a given date for export purposes
+type PriceChangeExportModel struct {
+	EffectiveFrom string          `json:"effectiveFrom" yaml:"effectiveFrom"`
+	Amount        decimal.Decimal `json:"amount" yaml:"amount" swaggertype:"number"`
+	Currency      string          `json:"currency" yaml:"currency"`
+}
+
+// PromotionExportModel represents a discounted pricing phase of a subscription for export purposes
+type PromotionExportModel struct {
+	StartDate string          `json:"startDate" yaml:"startDate"`
+	EndDate   string          `json:"endDate" yaml:"endDate"`
+	Amount    decimal.Decimal `json:"amount" yaml:"amount" swaggertype:"number"`
+	Currency  string          `json:"currency" yaml:"currency"`
+}
+
+// PauseExportModel represents a period during which a subscription is suspended for export purposes
+type PauseExportModel struct {
+	StartDate string  `json:"startDate" yaml:"startDate"`
+	EndDate   *string `json:"endDate,omitempty" yaml:"endDate,omitempty"`
 }
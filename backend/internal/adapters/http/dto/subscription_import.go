@@ -0,0 +1,25 @@
+package dto
+
+// SubscriptionImportRowErrorResponse describes why an imported row has been rejected
+type SubscriptionImportRowErrorResponse struct {
+	Field   *string `json:"field,omitempty" example:"customRecurrency"`
+	Message string  `json:"message" binding:"required" example:"CustomRecurrency is required when Recurrency is CustomRecurrency"`
+}
+
+// SubscriptionImportRowResponse represents the outcome of a single imported row
+type SubscriptionImportRowResponse struct {
+	Index          int                                  `json:"index" binding:"required" example:"0" description:"Zero-based position of the row in the imported file"`
+	SubscriptionId *string                              `json:"subscription_id,omitempty"`
+	Status         string                               `json:"status" binding:"required" enums:"created,valid,failed"`
+	Errors         []SubscriptionImportRowErrorResponse `json:"errors"`
+}
+
+// SubscriptionImportResponse represents the per-row report of a subscription import
+type SubscriptionImportResponse struct {
+	DryRun  bool                            `json:"dry_run" description:"True when nothing has been saved"`
+	Total   int                             `json:"total" example:"10" description:"Number of rows in the file"`
+	Created int                             `json:"created" example:"8" description:"Number of created subscriptions"`
+	Valid   int                             `json:"valid" example:"0" description:"Number of rows that would be created (dry-run)"`
+	Failed  int                             `json:"failed" example:"2" description:"Number of rejected rows"`
+	Rows    []SubscriptionImportRowResponse `json:"rows"`
+}
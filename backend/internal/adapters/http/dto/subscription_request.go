@@ -13,37 +13,85 @@ type SubscriptionSummaryRequest struct {
 	TotalYearly      bool  `json:"total_yearly,omitempty" form:"total_yearly"  example:"true" description:"Include yearly total costs"`
 }
 
+// SubscriptionSpendingRequest represents a request for the spending of the subscriptions over time.
+type SubscriptionSpendingRequest struct {
+	From    *time.Time `json:"from,omitempty" form:"from" description:"First day of the range (RFC3339), twelve buckets before the end by default"`
+	To      *time.Time `json:"to,omitempty" form:"to" description:"Last day of the range (RFC3339), today by default"`
+	Bucket  string     `json:"bucket,omitempty" form:"bucket" example:"month" description:"Size of the buckets: month, quarter or year"`
+	GroupBy string     `json:"group_by,omitempty" form:"group_by" example:"provider" description:"Grouping of the spending: provider, label, payer or owner_type"`
+}
+
+// SubscriptionForecastRequest represents a request for the charges expected in the coming months.
+type SubscriptionForecastRequest struct {
+	Months int `json:"months,omitempty" form:"months" example:"12" description:"Number of months to forecast, the current month included"`
+}
+
 type CreateSubscriptionRequest struct {
-	Id               *string                         `json:"id,omitempty"`
-	FriendlyName     *string                         `json:"friendly_name,omitempty"`
-	FreeTrial        *SubscriptionFreeTrialModel     `json:"free_trial,omitempty"`
-	ProviderId       *string                         `json:"provider_id,omitempty"`
-	ProviderKey      *string                         `json:"provider_key,omitempty"`
-	Price            AmountModel                     `json:"price"`
-	FamilyUsers      []string                        `json:"family_users,omitempty"`
-	Labels           []string                        `json:"labels,omitempty"`
-	StartDate        time.Time                       `json:"start_date" binding:"required" format:"date-time"`
-	EndDate          *time.Time                      `json:"end_date,omitempty" format:"date-time"`
-	Recurrency       string                          `json:"recurrency" binding:"required"`
-	CustomRecurrency *int32                          `json:"custom_recurrency,omitempty"`
-	Payer            *EditableSubscriptionPayerModel `json:"payer,omitempty"`
-	Owner            string                          `json:"owner" binding:"required" example:"personal" enums:"personal,family,system"`
-	CreatedAt        *time.Time                      `json:"created_at,omitempty"`
+	Id                   *string                         `json:"id,omitempty"`
+	FriendlyName         *string                         `json:"friendly_name,omitempty"`
+	FreeTrial            *SubscriptionFreeTrialModel     `json:"free_trial,omitempty"`
+	Contract             *SubscriptionContractModel      `json:"contract,omitempty"`
+	ProviderId           *string                         `json:"provider_id,omitempty"`
+	ProviderKey          *string                         `json:"provider_key,omitempty"`
+	Price                AmountModel                     `json:"price"`
+	FamilyUsers          []string                        `json:"family_users,omitempty"`
+	Labels               []string                        `json:"labels,omitempty"`
+	StartDate            time.Time                       `json:"start_date" binding:"required" format:"date-time"`
+	EndDate              *time.Time                      `json:"end_date,omitempty" format:"date-time"`
+	Recurrency           string                          `json:"recurrency" binding:"required"`
+	CustomRecurrency     *int32                          `json:"custom_recurrency,omitempty"`
+	CustomRecurrencyUnit *string                         `json:"custom_recurrency_unit,omitempty" enums:"day,week,month,year"`
+	BillingAnchorDay     *int32                          `json:"billing_anchor_day,omitempty" minimum:"1" maximum:"31"`
+	Payer                *EditableSubscriptionPayerModel `json:"payer,omitempty"`
+	CostSplit            *SubscriptionCostSplitModel     `json:"cost_split,omitempty"`
+	PaymentMethodId      *string                         `json:"payment_method_id,omitempty"`
+	Owner                string                          `json:"owner" binding:"required" example:"personal" enums:"personal,family,system"`
+	CreatedAt            *time.Time                      `json:"created_at,omitempty"`
 }
 
 type UpdateSubscriptionRequest struct {
-	FriendlyName     *string                         `json:"friendly_name,omitempty"`
-	FreeTrial        *SubscriptionFreeTrialModel     `json:"free_trial,omitempty"`
-	ProviderId       *string                         `json:"provider_id" binding:"required"`
-	ProviderKey      *string                         `json:"provider_key,omitempty"`
-	Price            AmountModel                     `json:"price"`
-	ServiceUsers     []string                        `json:"service_users,omitempty"`
-	Labels           []string                        `json:"labels,omitempty"`
-	StartDate        time.Time                       `json:"start_date" binding:"required" format:"date-time"`
-	EndDate          *time.Time                      `json:"end_date,omitempty" format:"date-time"`
-	Recurrency       string                          `json:"recurrency" binding:"required"`
-	CustomRecurrency *int32                          `json:"custom_recurrency,omitempty"`
-	Payer            *EditableSubscriptionPayerModel `json:"payer,omitempty"`
-	Owner            string                          `json:"owner" binding:"required" example:"personal" enums:"personal,family,system"`
-	UpdatedAt        *time.Time                      `json:"updated_at,omitempty" format:"date-time"`
+	FriendlyName         *string                         `json:"friendly_name,omitempty"`
+	FreeTrial            *SubscriptionFreeTrialModel     `json:"free_trial,omitempty"`
+	Contract             *SubscriptionContractModel      `json:"contract,omitempty"`
+	ProviderId           *string                         `json:"provider_id" binding:"required"`
+	ProviderKey          *string                         `json:"provider_key,omitempty"`
+	Price                AmountModel                     `json:"price"`
+	ServiceUsers         []string                        `json:"service_users,omitempty"`
+	Labels               []string                        `json:"labels,omitempty"`
+	StartDate            time.Time                       `json:"start_date" binding:"required" format:"date-time"`
+	EndDate              *time.Time                      `json:"end_date,omitempty" format:"date-time"`
+	Recurrency           string                          `json:"recurrency" binding:"required"`
+	CustomRecurrency     *int32                          `json:"custom_recurrency,omitempty"`
+	CustomRecurrencyUnit *string                         `json:"custom_recurrency_unit,omitempty" enums:"day,week,month,year"`
+	BillingAnchorDay     *int32                          `json:"billing_anchor_day,omitempty" minimum:"1" maximum:"31"`
+	Payer                *EditableSubscriptionPayerModel `json:"payer,omitempty"`
+	CostSplit            *SubscriptionCostSplitModel     `json:"cost_split,omitempty"`
+	PaymentMethodId      *string                         `json:"payment_method_id,omitempty"`
+	Owner                string                          `json:"owner" binding:"required" example:"personal" enums:"personal,family,system"`
+	UpdatedAt            *time.Time                      `json:"updated_at,omitempty" format:"date-time"`
+}
+
+type PauseSubscriptionRequest struct {
+	StartDate time.Time  `json:"start_date" binding:"required" format:"date-time"`
+	EndDate   *time.Time `json:"end_date,omitempty" format:"date-time"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" format:"date-time"`
+}
+
+type ResumeSubscriptionRequest struct {
+	ResumedAt *time.Time `json:"resumed_at,omitempty" format:"date-time"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" format:"date-time"`
+}
+
+type AddSubscriptionPromotionRequest struct {
+	Price          AmountModel `json:"price" binding:"required"`
+	StartDate      *time.Time  `json:"start_date,omitempty" format:"date-time"`
+	EndDate        *time.Time  `json:"end_date,omitempty" format:"date-time"`
+	DurationMonths *int32      `json:"duration_months,omitempty" example:"3" minimum:"1"`
+	UpdatedAt      *time.Time  `json:"updated_at,omitempty" format:"date-time"`
+}
+
+type AddSubscriptionPriceChangeRequest struct {
+	Price         AmountModel `json:"price" binding:"required"`
+	EffectiveFrom time.Time   `json:"effective_from" binding:"required" format:"date-time"`
+	UpdatedAt     *time.Time  `json:"updated_at,omitempty" format:"date-time"`
 }
@@ -24,6 +24,8 @@ type SubscriptionSummaryUpcomingRenewalResponse struct {
 	At             time.Time    `json:"at" binding:"required" format:"date-time"`
 	Total          AmountModel  `json:"total"`
 	Source         *AmountModel `json:"source"`
+	// Last day to cancel before the subscription renews, null when it does not renew by itself
+	CancellationDeadline *time.Time `json:"cancellation_deadline,omitempty" format:"date-time"`
 }
 
 // SubscriptionSummaryResponse represents a summary of subscription details including costs, providers, labels, and renewals.
@@ -47,3 +49,63 @@ type SubscriptionSummaryResponse struct {
 	UpcomingRenewals  []SubscriptionSummaryUpcomingRenewalResponse `json:"upcoming_renewals" description:"List of upcoming subscription renewals"`
 	TopLabels         []SubscriptionSummaryTopLabelResponse        `json:"top_labels" description:"List of top labels by cost"`
 }
+
+// SubscriptionSpendingGroupResponse represents what was spent by one group within a spending bucket.
+type SubscriptionSpendingGroupResponse struct {
+	// Provider ID, label ID, family member ID, "family" or owner type depending on the grouping,
+	// empty for the spending without a label or a payer
+	Key   string      `json:"key" binding:"required"`
+	Total AmountModel `json:"total" binding:"required"`
+}
+
+// SubscriptionSpendingBucketResponse represents the spending of a period.
+type SubscriptionSpendingBucketResponse struct {
+	Start time.Time `json:"start" binding:"required" format:"date-time"`
+	// First day after the bucket
+	End time.Time `json:"end" binding:"required" format:"date-time"`
+	// What was spent in the bucket, a charge in several groups is only counted once
+	Total AmountModel `json:"total" binding:"required"`
+	// One entry per key of the response, in the same order
+	Groups []SubscriptionSpendingGroupResponse `json:"groups" binding:"required"`
+}
+
+// SubscriptionSpendingResponse represents the spending of the subscriptions over time, in the preferred currency.
+type SubscriptionSpendingResponse struct {
+	Bucket  string `json:"bucket" binding:"required" example:"month"`
+	GroupBy string `json:"group_by" binding:"required" example:"provider"`
+	// Every group found in the range, the most expensive first
+	Keys    []string                             `json:"keys" binding:"required"`
+	Buckets []SubscriptionSpendingBucketResponse `json:"buckets" binding:"required"`
+	Total   AmountModel                          `json:"total" binding:"required"`
+}
+
+// SubscriptionForecastChargeResponse represents a charge expected on a renewal.
+type SubscriptionForecastChargeResponse struct {
+	SubscriptionId string    `json:"subscription_id" binding:"required"`
+	ProviderId     string    `json:"provider_id" binding:"required"`
+	At             time.Time `json:"at" binding:"required" format:"date-time"`
+	// Charge in the preferred currency
+	Total AmountModel `json:"total" binding:"required"`
+	// Charge in the currency of the subscription
+	Source AmountModel `json:"source" binding:"required"`
+	// True when the charge is paid by the family
+	Family bool `json:"family" binding:"required"`
+}
+
+// SubscriptionForecastMonthResponse represents the charges expected in a month.
+type SubscriptionForecastMonthResponse struct {
+	// First day of the month in the account timezone
+	Month    time.Time                            `json:"month" binding:"required" format:"date-time"`
+	Total    AmountModel                          `json:"total" binding:"required"`
+	Personal AmountModel                          `json:"personal" binding:"required"`
+	Family   AmountModel                          `json:"family" binding:"required"`
+	Charges  []SubscriptionForecastChargeResponse `json:"charges" binding:"required"`
+}
+
+// SubscriptionForecastResponse represents the charges expected in the coming months, in the preferred currency.
+type SubscriptionForecastResponse struct {
+	Months   []SubscriptionForecastMonthResponse `json:"months" binding:"required"`
+	Total    AmountModel                         `json:"total" binding:"required"`
+	Personal AmountModel                         `json:"personal" binding:"required"`
+	Family   AmountModel                         `json:"family" binding:"required"`
+}
@@ -3,3 +3,8 @@ package dto
 type UserPreferredCurrencyModel struct {
 	Currency string `json:"currency"`
 }
+
+type UserPreferredTimezoneModel struct {
+	// IANA timezone name, UTC when the user has not configured one
+	Timezone string `json:"timezone" example:"Europe/Paris"`
+}
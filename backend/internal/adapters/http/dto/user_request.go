@@ -3,3 +3,8 @@ package dto
 type UpdatePreferredCurrencyRequest struct {
 	Currency string `json:"currency" binding:"required"`
 }
+
+type UpdatePreferredTimezoneRequest struct {
+	// IANA timezone name
+	Timezone string `json:"timezone" binding:"required" example:"Europe/Paris"`
+}
@@ -0,0 +1,108 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+)
+
+// WebhookEndpointModel represents a URL the events of the account are posted to
+// @Description Endpoint receiving the events of the account, each request is signed with its secret
+type WebhookEndpointModel struct {
+	// @Description Unique identifier of the endpoint (UUID format)
+	Id string `json:"id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description URL the events are posted to
+	Url string `json:"url" binding:"required" example:"https://example.com/hooks/subtracker"`
+	// @Description Secret signing the requests, only returned when the endpoint is created
+	Secret *string `json:"secret,omitempty" example:"whsec_3q2-7wEj0ZqIzO8TMr5jOv1rOxOQw2dDXy0m5lxq9yY"`
+	// @Description Events the endpoint receives, every event when empty
+	EventTypes []string `json:"event_types" binding:"required" example:"subscription.created,renewal.due"`
+	// @Description Whether events are posted to the endpoint
+	Enabled bool `json:"enabled" binding:"required" example:"true"`
+	// @Description ISO 8601 timestamp indicating when the endpoint was created
+	CreatedAt time.Time `json:"created_at" binding:"required" format:"date-time" example:"2025-01-15T10:30:00Z"`
+	// @Description ISO 8601 timestamp indicating when the endpoint was last modified
+	UpdatedAt time.Time `json:"updated_at" binding:"required" format:"date-time" example:"2025-01-20T14:45:30Z"`
+	// @Description Entity tag used for optimistic concurrency control to prevent conflicting updates
+	Etag string `json:"etag" binding:"required" example:"W/\"123456789\""`
+}
+
+func NewWebhookEndpointModel(source webhook.Endpoint) WebhookEndpointModel {
+	return WebhookEndpointModel{
+		Id:         source.Id().String(),
+		Url:        source.Url(),
+		EventTypes: ensureNotNilSlice(herd.Select(source.EventTypes(), webhook.EventType.String)),
+		Enabled:    source.Enabled(),
+		CreatedAt:  source.CreatedAt(),
+		UpdatedAt:  source.UpdatedAt(),
+		Etag:       source.ETag(),
+	}
+}
+
+func NewWebhookEndpointModels(source []webhook.Endpoint) []WebhookEndpointModel {
+	return ensureNotNilSlice(herd.Select(source, NewWebhookEndpointModel))
+}
+
+// NewCreatedWebhookEndpointModel returns the endpoint with its secret, only on creation
+func NewCreatedWebhookEndpointModel(source webhook.Endpoint) WebhookEndpointModel {
+	model := NewWebhookEndpointModel(source)
+	secret := source.Secret()
+	model.Secret = &secret
+	return model
+}
+
+// WebhookDeliveryModel represents an event of the delivery log of an endpoint
+// @Description Delivery of an event to an endpoint, retried with an exponential backoff until it succeeds or is given up
+type WebhookDeliveryModel struct {
+	// @Description Unique identifier of the delivery (UUID format), sent in the X-SubTracker-Delivery header
+	Id string `json:"id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description Identifier of the event, shared by the redeliveries of the event
+	EventId string `json:"event_id" binding:"required" example:"0193b2a4-8c1e-7d3a-9f4b-2c6d8e0a1b3c"`
+	// @Description Type of the event
+	EventType string `json:"event_type" binding:"required" example:"subscription.created"`
+	// @Description JSON body posted to the endpoint
+	Payload json.RawMessage `json:"payload" binding:"required" swaggertype:"object"`
+	// @Description State of the delivery
+	Status string `json:"status" binding:"required" enums:"pending,succeeded,failed" example:"succeeded"`
+	// @Description Number of attempts made so far
+	Attempts int `json:"attempts" binding:"required" example:"1"`
+	// @Description ISO 8601 timestamp of the next attempt of a pending delivery
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty" format:"date-time" example:"2025-01-15T10:31:00Z"`
+	// @Description HTTP status code returned by the endpoint to the last attempt
+	LastStatusCode *int `json:"last_status_code,omitempty" example:"200"`
+	// @Description Reason of the failure of the last attempt
+	LastError *string `json:"last_error,omitempty" example:"unexpected status code 500"`
+	// @Description ISO 8601 timestamp indicating when the endpoint accepted the event
+	DeliveredAt *time.Time `json:"delivered_at,omitempty" format:"date-time" example:"2025-01-15T10:30:01Z"`
+	// @Description Delivery this one sends again, when it is a redelivery
+	RedeliveryOf *string `json:"redelivery_of,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// @Description ISO 8601 timestamp indicating when the event was enqueued
+	CreatedAt time.Time `json:"created_at" binding:"required" format:"date-time" example:"2025-01-15T10:30:00Z"`
+	// @Description ISO 8601 timestamp indicating when the delivery was last attempted
+	UpdatedAt time.Time `json:"updated_at" binding:"required" format:"date-time" example:"2025-01-15T10:30:01Z"`
+}
+
+func NewWebhookDeliveryModel(source webhook.Delivery) WebhookDeliveryModel {
+	var redeliveryOf *string
+	if source.RedeliveryOf() != nil {
+		id := source.RedeliveryOf().String()
+		redeliveryOf = &id
+	}
+	return WebhookDeliveryModel{
+		Id:             source.Id().String(),
+		EventId:        source.EventId(),
+		EventType:      source.EventType().String(),
+		Payload:        source.Payload(),
+		Status:         source.Status().String(),
+		Attempts:       source.Attempts(),
+		NextAttemptAt:  source.NextAttemptAt(),
+		LastStatusCode: source.LastStatusCode(),
+		LastError:      source.LastError(),
+		DeliveredAt:    source.DeliveredAt(),
+		RedeliveryOf:   redeliveryOf,
+		CreatedAt:      source.CreatedAt(),
+		UpdatedAt:      source.UpdatedAt(),
+	}
+}
@@ -0,0 +1,17 @@
+package dto
+
+type CreateWebhookEndpointRequest struct {
+	// Absolute http or https URL the events are posted to
+	Url string `json:"url" binding:"required" example:"https://example.com/hooks/subtracker"`
+	// Events the endpoint receives, every event when empty
+	EventTypes []string `json:"event_types,omitempty" example:"subscription.created,renewal.due"`
+}
+
+type UpdateWebhookEndpointRequest struct {
+	// Absolute http or https URL the events are posted to
+	Url string `json:"url" binding:"required" example:"https://example.com/hooks/subtracker"`
+	// Events the endpoint receives, every event when empty
+	EventTypes []string `json:"event_types,omitempty" example:"subscription.created,renewal.due"`
+	// Whether events are posted to the endpoint
+	Enabled bool `json:"enabled" example:"true"`
+}
@@ -0,0 +1,166 @@
+package export
+
+import (
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DecodeCSV reads CSV produced by EncodeCSV into the slice pointed to by out.
+// Columns are matched to struct fields by their csv tag, so column order does not matter.
+// Empty cells are decoded as nil for pointer fields and as zero values otherwise.
+// String slice fields are split on "," and trimmed, struct slice fields are read from a JSON array.
+// Fields implementing encoding.TextUnmarshaler, like decimals, are read from their text.
+func DecodeCSV(r io.Reader, out interface{}) error {
+	ptrValue := reflect.ValueOf(out)
+	if ptrValue.Kind() != reflect.Ptr || ptrValue.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("out must be a pointer to a slice, got %s", ptrValue.Kind())
+	}
+	sliceValue := ptrValue.Elem()
+	elemType := sliceValue.Type().Elem()
+	if elemType.Kind() != reflect.Struct {
+		return fmt.Errorf("slice element must be a struct, got %s", elemType.Kind())
+	}
+
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	headers, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return fmt.Errorf("failed to read CSV headers: %w", err)
+	}
+
+	// Map each column to the index of the field carrying the matching csv tag
+	fieldIndexes := make(map[string]int)
+	for i := 0; i < elemType.NumField(); i++ {
+		csvTag := elemType.Field(i).Tag.Get("csv")
+		if csvTag != "" && csvTag != "-" {
+			fieldIndexes[csvTag] = i
+		}
+	}
+	columns := make([]int, len(headers))
+	for i, header := range headers {
+		idx, ok := fieldIndexes[strings.TrimSpace(header)]
+		if !ok {
+			columns[i] = -1
+			continue
+		}
+		columns[i] = idx
+	}
+
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read CSV row at index %d: %w", line-1, err)
+		}
+
+		elem := reflect.New(elemType).Elem()
+		for i, cell := range record {
+			if i >= len(columns) || columns[i] < 0 {
+				continue
+			}
+			if err := setFieldFromString(elem.Field(columns[i]), cell); err != nil {
+				return fmt.Errorf("failed to decode column %q at index %d: %w", headers[i], line-1, err)
+			}
+		}
+		sliceValue.Set(reflect.Append(sliceValue, elem))
+	}
+
+	return nil
+}
+
+// setFieldFromString parses raw into the field, allocating pointers when raw is not empty
+func setFieldFromString(field reflect.Value, raw string) error {
+	if field.Kind() == reflect.Ptr {
+		if raw == "" {
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+		value := reflect.New(field.Type().Elem())
+		if err := setFieldFromString(value.Elem(), raw); err != nil {
+			return err
+		}
+		field.Set(value)
+		return nil
+	}
+
+	if field.Kind() == reflect.Slice {
+		if field.Type().Elem().Kind() == reflect.Struct {
+			if strings.TrimSpace(raw) == "" {
+				field.Set(reflect.Zero(field.Type()))
+				return nil
+			}
+			value := reflect.New(field.Type())
+			if err := json.Unmarshal([]byte(raw), value.Interface()); err != nil {
+				return err
+			}
+			field.Set(value.Elem())
+			return nil
+		}
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported slice type %s", field.Type())
+		}
+		values := make([]string, 0)
+		if strings.TrimSpace(raw) != "" {
+			for _, part := range strings.Split(raw, ",") {
+				values = append(values, strings.TrimSpace(part))
+			}
+		}
+		field.Set(reflect.ValueOf(values).Convert(field.Type()))
+		return nil
+	}
+
+	if raw == "" {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+
+	if unmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(raw))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(raw, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(raw, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(raw, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(v)
+	case reflect.Bool:
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		field.SetBool(v)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+
+	return nil
+}
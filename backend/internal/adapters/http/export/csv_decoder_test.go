@@ -0,0 +1,139 @@
+package export_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/adapters/http/export"
+	"github.com/mistribe/subtracker/pkg/decimal"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func TestDecodeCSV_RoundTrip(t *testing.T) {
+	data := []testStruct{
+		{ID: "1", Name: "First", Count: 10, Tags: []string{"tag1", "tag2"}},
+		{ID: "2", Name: "Second", Count: 20, Tags: []string{}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, export.EncodeCSV(&buf, data))
+
+	var result []testStruct
+	err := export.DecodeCSV(&buf, &result)
+	require.NoError(t, err)
+	assert.Equal(t, data, result)
+}
+
+func TestDecodeCSV_NilPointerFields(t *testing.T) {
+	desc := "A description"
+	data := []testStructWithPointers{
+		{ID: "1", Name: "With Description", Description: &desc, URL: nil},
+		{ID: "2", Name: "No Optional Fields", Description: nil, URL: nil},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, export.EncodeCSV(&buf, data))
+
+	var result []testStructWithPointers
+	err := export.DecodeCSV(&buf, &result)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	require.NotNil(t, result[0].Description)
+	assert.Equal(t, desc, *result[0].Description)
+	assert.Nil(t, result[0].URL)
+	assert.Nil(t, result[1].Description)
+}
+
+func TestDecodeCSV_SubscriptionExportModel(t *testing.T) {
+	data := []dto.SubscriptionExportModel{
+		{
+			Id:               "0199a6b0-0000-7000-8000-000000000001",
+			ProviderKey:      "s_netflix",
+			FriendlyName:     x.P("Netflix"),
+			StartDate:        "2025-01-15",
+			Recurrency:       "custom",
+			CustomRecurrency: x.P(int32(3)),
+			Amount:           decimal.MustParse("12.99"),
+			Currency:         "EUR",
+			OwnerType:        "personal",
+			FamilyUsers:      []string{},
+			Labels:           []string{"Streaming", "Video"},
+		},
+		{
+			Id:                   "0199a6b0-0000-7000-8000-000000000002",
+			ProviderKey:          "s_spotify",
+			StartDate:            "2025-01-15",
+			Recurrency:           "monthly",
+			Amount:               decimal.MustParse("17.99"),
+			Currency:             "EUR",
+			OwnerType:            "family",
+			FamilyUsers:          []string{"0199a6b0-0000-7000-8000-000000000010"},
+			Labels:               []string{},
+			ContractMinimumTerm:  x.P(int32(12)),
+			ContractAutoRenew:    x.P(false),
+			ContractNoticePeriod: x.P(int32(30)),
+			CostSplitType:        x.P("fixed"),
+			CostShares: []dto.CostShareExportModel{
+				{MemberId: "0199a6b0-0000-7000-8000-000000000010", Value: decimal.MustParse("17.99")},
+			},
+			PaymentMethodId: x.P("0199a6b0-0000-7000-8000-000000000020"),
+			PriceHistory: []dto.PriceChangeExportModel{
+				{EffectiveFrom: "2025-01-15", Amount: decimal.MustParse("15.99"), Currency: "EUR"},
+				{EffectiveFrom: "2025-06-15", Amount: decimal.MustParse("17.99"), Currency: "EUR"},
+			},
+			Promotions: []dto.PromotionExportModel{
+				{StartDate: "2025-01-15", EndDate: "2025-04-15", Amount: decimal.MustParse("9.99"), Currency: "EUR"},
+			},
+			Pauses: []dto.PauseExportModel{
+				{StartDate: "2025-08-01", EndDate: x.P("2025-09-01")},
+				{StartDate: "2025-11-01"},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, export.EncodeCSV(&buf, data))
+
+	var result []dto.SubscriptionExportModel
+	err := export.DecodeCSV(&buf, &result)
+	require.NoError(t, err)
+	assert.Equal(t, data, result)
+}
+
+func TestDecodeCSV_ColumnOrderAndUnknownColumns(t *testing.T) {
+	input := "name,extra,id\nFirst,ignored,1\n"
+
+	var result []testStruct
+	err := export.DecodeCSV(strings.NewReader(input), &result)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "1", result[0].ID)
+	assert.Equal(t, "First", result[0].Name)
+}
+
+func TestDecodeCSV_InvalidInput(t *testing.T) {
+	t.Run("not a pointer to a slice", func(t *testing.T) {
+		var result []testStruct
+		err := export.DecodeCSV(strings.NewReader("id\n1\n"), result)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "pointer to a slice")
+	})
+
+	t.Run("invalid number", func(t *testing.T) {
+		var result []testStruct
+		err := export.DecodeCSV(strings.NewReader("id,count\n1,abc\n"), &result)
+		assert.Error(t, err)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		var result []testStruct
+		err := export.DecodeCSV(strings.NewReader(""), &result)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
@@ -1,7 +1,9 @@
 package export
 
 import (
+	"encoding"
 	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
@@ -11,7 +13,8 @@ import (
 // EncodeCSV writes a slice of structs as CSV to the provided writer.
 // It uses reflection to read csv struct tags for headers and field order.
 // Nil/optional pointer fields are written as empty strings.
-// String slice fields are joined with ", " separator.
+// String slice fields are joined with ", " separator, struct slice fields are written as a JSON array.
+// Fields implementing encoding.TextMarshaler, like decimals, are written as their text.
 func EncodeCSV(w io.Writer, data interface{}) error {
 	writer := csv.NewWriter(w)
 	defer writer.Flush()
@@ -140,6 +143,14 @@ func fieldValueToString(v reflect.Value) string {
 		v = v.Elem()
 	}
 
+	if marshaler, ok := v.Interface().(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		if err != nil {
+			return ""
+		}
+		return string(text)
+	}
+
 	// Handle string slices - join with ", "
 	if v.Kind() == reflect.Slice {
 		if v.Type().Elem().Kind() == reflect.String {
@@ -149,6 +160,17 @@ func fieldValueToString(v reflect.Value) string {
 			}
 			return strings.Join(strs, ", ")
 		}
+		// Slices of structs do not fit in columns, they are written as a JSON array in their cell
+		if v.Type().Elem().Kind() == reflect.Struct {
+			if v.Len() == 0 {
+				return ""
+			}
+			text, err := json.Marshal(v.Interface())
+			if err != nil {
+				return ""
+			}
+			return string(text)
+		}
 	}
 
 	// Handle basic types
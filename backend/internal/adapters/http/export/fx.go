@@ -10,6 +10,7 @@ func Module() fx.Option {
 			NewExportService,
 			NewLabelResolver,
 			NewProviderResolver,
+			NewSpendingResolver,
 		),
 	)
 }
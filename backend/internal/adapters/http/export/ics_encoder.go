@@ -0,0 +1,90 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+)
+
+const (
+	icsProductID = "-//SubTracker//Subscriptions//EN"
+	// icsLineLength is the maximum length of a content line in octets, longer lines are folded
+	icsLineLength = 75
+)
+
+// EncodeICS writes the events as an iCalendar (RFC 5545) document to the provided writer.
+// Events are written as all-day events on the calendar date of their Date field.
+func EncodeICS(w io.Writer, name string, events []calendar.Event) error {
+	writer := bufio.NewWriter(w)
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:" + icsProductID,
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:" + escapeICSText(name),
+	}
+	for _, event := range events {
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+escapeICSText(event.UID),
+			"DTSTAMP:"+stamp,
+			"DTSTART;VALUE=DATE:"+event.Date.Format("20060102"),
+			"DTEND;VALUE=DATE:"+event.Date.AddDate(0, 0, 1).Format("20060102"),
+			"SUMMARY:"+escapeICSText(event.Summary),
+		)
+		if event.Description != "" {
+			lines = append(lines, "DESCRIPTION:"+escapeICSText(event.Description))
+		}
+		lines = append(lines,
+			"CATEGORIES:"+escapeICSText(event.Kind.String()),
+			"TRANSP:TRANSPARENT",
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := writer.WriteString(foldICSLine(line)); err != nil {
+			return fmt.Errorf("failed to write ICS line: %w", err)
+		}
+	}
+
+	return writer.Flush()
+}
+
+// escapeICSText escapes the characters that have a meaning in iCalendar text values
+func escapeICSText(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	)
+	return replacer.Replace(value)
+}
+
+// foldICSLine terminates a content line with CRLF, splitting it in lines of at most 75 octets
+// without breaking UTF-8 sequences. Continuation lines start with a space.
+func foldICSLine(line string) string {
+	var builder strings.Builder
+	length := 0
+	for _, r := range line {
+		size := len(string(r))
+		if length+size > icsLineLength {
+			builder.WriteString("\r\n ")
+			length = 1
+		}
+		builder.WriteRune(r)
+		length += size
+	}
+	builder.WriteString("\r\n")
+	return builder.String()
+}
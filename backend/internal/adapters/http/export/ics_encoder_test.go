@@ -0,0 +1,114 @@
+package export_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/export"
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func TestEncodeICS_BasicEncoding(t *testing.T) {
+	subscriptionId := types.NewSubscriptionID()
+	events := []calendar.Event{
+		{
+			UID:            subscriptionId.String() + "-renewal-20250315@subtracker",
+			SubscriptionId: subscriptionId,
+			Kind:           calendar.RenewalEventKind,
+			Date:           time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+			Summary:        "Netflix renewal",
+			Description:    "9.99 EUR",
+		},
+		{
+			UID:            subscriptionId.String() + "-free_trial_end@subtracker",
+			SubscriptionId: subscriptionId,
+			Kind:           calendar.FreeTrialEndEventKind,
+			Date:           time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC),
+			Summary:        "Netflix free trial ends",
+		},
+	}
+
+	var buf bytes.Buffer
+	err := export.EncodeICS(&buf, "SubTracker", events)
+	require.NoError(t, err)
+
+	output := buf.String()
+	assert.True(t, strings.HasPrefix(output, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(output, "END:VCALENDAR\r\n"))
+	assert.Equal(t, 2, strings.Count(output, "BEGIN:VEVENT\r\n"))
+	assert.Equal(t, 2, strings.Count(output, "END:VEVENT\r\n"))
+	assert.Contains(t, output, "X-WR-CALNAME:SubTracker\r\n")
+	assert.Contains(t, output, "UID:"+subscriptionId.String()+"-renewal-20250315@subtracker\r\n")
+	assert.Contains(t, output, "DTSTART;VALUE=DATE:20250315\r\n")
+	assert.Contains(t, output, "DTEND;VALUE=DATE:20250316\r\n")
+	assert.Contains(t, output, "SUMMARY:Netflix renewal\r\n")
+	assert.Contains(t, output, "DESCRIPTION:9.99 EUR\r\n")
+	assert.Contains(t, output, "CATEGORIES:free_trial_end\r\n")
+	// The free trial end has no description
+	assert.Equal(t, 1, strings.Count(output, "DESCRIPTION:"))
+	// Every line is terminated by CRLF
+	assert.NotContains(t, strings.ReplaceAll(output, "\r\n", ""), "\n")
+}
+
+func TestEncodeICS_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	err := export.EncodeICS(&buf, "SubTracker", nil)
+	require.NoError(t, err)
+
+	assert.NotContains(t, buf.String(), "BEGIN:VEVENT")
+	assert.Contains(t, buf.String(), "BEGIN:VCALENDAR\r\n")
+	assert.Contains(t, buf.String(), "END:VCALENDAR\r\n")
+}
+
+func TestEncodeICS_EscapesText(t *testing.T) {
+	events := []calendar.Event{
+		{
+			UID:         "uid@subtracker",
+			Kind:        calendar.RenewalEventKind,
+			Date:        time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+			Summary:     "Music; Video, and more",
+			Description: "First line\nC:\\path",
+		},
+	}
+
+	var buf bytes.Buffer
+	err := export.EncodeICS(&buf, "SubTracker", events)
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), `SUMMARY:Music\; Video\, and more`+"\r\n")
+	assert.Contains(t, buf.String(), `DESCRIPTION:First line\nC:\\path`+"\r\n")
+}
+
+func TestEncodeICS_FoldsLongLines(t *testing.T) {
+	summary := strings.Repeat("é", 60) + " renewal"
+	events := []calendar.Event{
+		{
+			UID:     "uid@subtracker",
+			Kind:    calendar.RenewalEventKind,
+			Date:    time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+			Summary: summary,
+		},
+	}
+
+	var buf bytes.Buffer
+	err := export.EncodeICS(&buf, "SubTracker", events)
+	require.NoError(t, err)
+
+	var unfolded []string
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+		assert.True(t, strings.ToValidUTF8(line, "") == line)
+		if strings.HasPrefix(line, " ") {
+			unfolded[len(unfolded)-1] += line[1:]
+		} else {
+			unfolded = append(unfolded, line)
+		}
+	}
+	assert.Contains(t, unfolded, "SUMMARY:"+summary)
+}
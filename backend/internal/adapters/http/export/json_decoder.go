@@ -0,0 +1,18 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// DecodeJSON reads a JSON array produced by EncodeJSON into out.
+func DecodeJSON(r io.Reader, out interface{}) error {
+	decoder := json.NewDecoder(r)
+
+	if err := decoder.Decode(out); err != nil {
+		return fmt.Errorf("failed to decode JSON: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package export_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/export"
+)
+
+func TestDecodeJSON_RoundTrip(t *testing.T) {
+	data := []jsonTestStruct{
+		{ID: "1", Name: "First"},
+		{ID: "2", Name: "Second"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, export.EncodeJSON(&buf, data))
+
+	var result []jsonTestStruct
+	err := export.DecodeJSON(&buf, &result)
+	require.NoError(t, err)
+	assert.Equal(t, data, result)
+}
+
+func TestDecodeJSON_InvalidInput(t *testing.T) {
+	var result []jsonTestStruct
+	err := export.DecodeJSON(strings.NewReader("{not json"), &result)
+	assert.Error(t, err)
+}
@@ -11,6 +11,10 @@ const (
 	FormatCSV  ExportFormat = "csv"
 	FormatJSON ExportFormat = "json"
 	FormatYAML ExportFormat = "yaml"
+	// FormatICS is only supported by the subscription export, as a calendar of the upcoming renewals
+	FormatICS ExportFormat = "ics"
+
+	ContentTypeICS = "text/calendar; charset=utf-8"
 )
 
 var (
@@ -57,6 +61,8 @@ func (s *exportService) GetContentType(format ExportFormat) string {
 		return "application/json; charset=utf-8"
 	case FormatYAML:
 		return "application/x-yaml; charset=utf-8"
+	case FormatICS:
+		return ContentTypeICS
 	default:
 		return "application/octet-stream"
 	}
@@ -70,6 +76,8 @@ func (s *exportService) GetFileExtension(format ExportFormat) string {
 		return "json"
 	case FormatYAML:
 		return "yaml"
+	case FormatICS:
+		return "ics"
 	default:
 		return "bin"
 	}
@@ -0,0 +1,68 @@
+package export
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+)
+
+// Spending is what has been spent on a subscription according to its charge ledger
+type Spending struct {
+	// TotalSpent is the total spent where recorded charges replace the expected amount of their occurrence
+	TotalSpent currency.Amount
+	// Charges are the recorded charges of the subscription ordered by due date
+	Charges []charge.Charge
+}
+
+// SpendingResolver resolves what has been spent on subscriptions from their charge ledger
+type SpendingResolver interface {
+	// ResolveSpending returns the spending of each subscription
+	ResolveSpending(ctx context.Context,
+		subscriptions []subscription.Subscription) (map[types.SubscriptionID]Spending, error)
+}
+
+type spendingResolver struct {
+	chargeRepo ports.ChargeRepository
+}
+
+// NewSpendingResolver creates a new spending resolver
+func NewSpendingResolver(chargeRepo ports.ChargeRepository) SpendingResolver {
+	return &spendingResolver{
+		chargeRepo: chargeRepo,
+	}
+}
+
+func (r *spendingResolver) ResolveSpending(ctx context.Context,
+	subscriptions []subscription.Subscription) (map[types.SubscriptionID]Spending, error) {
+	if len(subscriptions) == 0 {
+		return nil, nil
+	}
+
+	recorded, err := r.chargeRepo.GetRecordedBySubscriptionIds(ctx,
+		herd.Select(subscriptions, func(sub subscription.Subscription) types.SubscriptionID {
+			return sub.Id()
+		})...)
+	if err != nil {
+		return nil, err
+	}
+	chargesBySubscription := make(map[types.SubscriptionID][]charge.Charge)
+	for _, c := range recorded {
+		chargesBySubscription[c.SubscriptionId()] = append(chargesBySubscription[c.SubscriptionId()], c)
+	}
+
+	spending := make(map[types.SubscriptionID]Spending, len(subscriptions))
+	for _, sub := range subscriptions {
+		charges := chargesBySubscription[sub.Id()]
+		spending[sub.Id()] = Spending{
+			TotalSpent: charge.TotalSpent(sub, charges),
+			Charges:    charges,
+		}
+	}
+
+	return spending, nil
+}
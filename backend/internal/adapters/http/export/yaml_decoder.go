@@ -0,0 +1,24 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DecodeYAML reads a YAML sequence produced by EncodeYAML into out.
+// An empty document leaves out untouched.
+func DecodeYAML(r io.Reader, out interface{}) error {
+	decoder := yaml.NewDecoder(r)
+
+	if err := decoder.Decode(out); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return fmt.Errorf("failed to decode YAML: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package export_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/export"
+)
+
+func TestDecodeYAML_RoundTrip(t *testing.T) {
+	data := []yamlTestStruct{
+		{ID: "1", Name: "First"},
+		{ID: "2", Name: "Second"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, export.EncodeYAML(&buf, data))
+
+	var result []yamlTestStruct
+	err := export.DecodeYAML(&buf, &result)
+	require.NoError(t, err)
+	assert.Equal(t, data, result)
+}
+
+func TestDecodeYAML_EmptyDocument(t *testing.T) {
+	var result []yamlTestStruct
+	err := export.DecodeYAML(strings.NewReader(""), &result)
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestDecodeYAML_InvalidInput(t *testing.T) {
+	var result []yamlTestStruct
+	err := export.DecodeYAML(strings.NewReader("- id: [unclosed"), &result)
+	assert.Error(t, err)
+}
@@ -0,0 +1,58 @@
+package account
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/account/query"
+)
+
+type GetPreferredTimezoneEndpoint struct {
+	handler ports.QueryHandler[query.FindPreferredTimezoneQuery, *time.Location]
+}
+
+func NewGetPreferredTimezoneEndpoint(handler ports.QueryHandler[query.FindPreferredTimezoneQuery, *time.Location]) *GetPreferredTimezoneEndpoint {
+	return &GetPreferredTimezoneEndpoint{handler: handler}
+}
+
+// Handle godoc
+//
+//	@Summary		Get user preferred timezone
+//	@Description	Returns the timezone renewal dates are calculated in for the authenticated account
+//	@Tags			accounts
+//	@Produce		json
+//	@Success		200	{object}	dto.UserPreferredTimezoneModel
+//	@Failure		401	{object}	HttpErrorResponse	"Unauthorized"
+//	@Router			/accounts/preferred/timezone [get]
+func (e GetPreferredTimezoneEndpoint) Handle(c *gin.Context) {
+	q := query.NewFindPreferredTimezoneQuery()
+
+	r := e.handler.Handle(c, q)
+	FromResult(c,
+		r,
+		WithMapping[*time.Location](func(loc *time.Location) any {
+			return dto.UserPreferredTimezoneModel{
+				Timezone: loc.String(),
+			}
+		}))
+}
+
+func (e GetPreferredTimezoneEndpoint) Pattern() []string {
+	return []string{
+		"/preferred/timezone",
+	}
+}
+
+func (e GetPreferredTimezoneEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetPreferredTimezoneEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -0,0 +1,70 @@
+package account
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/account"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/account/command"
+)
+
+type UpdatePreferredTimezoneEndpoint struct {
+	handler ports.CommandHandler[command.UpdatePreferredTimezoneCommand, bool]
+}
+
+func NewUpdatePreferredTimezoneEndpoint(handler ports.CommandHandler[command.UpdatePreferredTimezoneCommand, bool]) *UpdatePreferredTimezoneEndpoint {
+	return &UpdatePreferredTimezoneEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Update user preferred timezone
+//	@Description	Updates the IANA timezone renewal dates are calculated in for the authenticated account
+//	@Tags			accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header	string								true	"Bearer token"
+//	@Param			request			body	dto.UpdatePreferredTimezoneRequest	true	"Timezone update parameters"
+//	@Success		204
+//	@Failure		400	{object}	HttpErrorResponse
+//	@Failure		401	{object}	HttpErrorResponse
+//	@Router			/accounts/preferred/timezone [put]
+func (e UpdatePreferredTimezoneEndpoint) Handle(c *gin.Context) {
+	var model dto.UpdatePreferredTimezoneRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	timezone, err := account.ParseTimezone(model.Timezone)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.NewUpdatePreferredTimezoneCommand(timezone)
+
+	r := e.handler.Handle(c, cmd)
+	FromResult(c, r, WithNoContent[bool]())
+}
+
+func (e UpdatePreferredTimezoneEndpoint) Pattern() []string {
+	return []string{
+		"/preferred/timezone",
+	}
+}
+
+func (e UpdatePreferredTimezoneEndpoint) Method() string {
+	return http.MethodPut
+}
+
+func (e UpdatePreferredTimezoneEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
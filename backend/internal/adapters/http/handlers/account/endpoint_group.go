@@ -15,6 +15,8 @@ type EndpointGroup struct {
 func NewEndpointGroup(
 	preferredCurrencyEndpoint *GetPreferredCurrencyEndpoint,
 	updatePreferredCurrencyEndpoint *UpdatePreferredCurrencyEndpoint,
+	preferredTimezoneEndpoint *GetPreferredTimezoneEndpoint,
+	updatePreferredTimezoneEndpoint *UpdatePreferredTimezoneEndpoint,
 	deleteEndpoint *DeleteEndpoint,
 	accountQuotaUsageEndpoint *GetQuotaUsageEndpoint,
 	authenticationMiddleware *middlewares.AuthenticationMiddleware) *EndpointGroup {
@@ -22,6 +24,8 @@ func NewEndpointGroup(
 		routes: []ginfx.Endpoint{
 			preferredCurrencyEndpoint,
 			updatePreferredCurrencyEndpoint,
+			preferredTimezoneEndpoint,
+			updatePreferredTimezoneEndpoint,
 			deleteEndpoint,
 			accountQuotaUsageEndpoint,
 		},
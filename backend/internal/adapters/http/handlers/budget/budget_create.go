@@ -0,0 +1,111 @@
+package budget
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/budget/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+)
+
+type CreateEndpoint struct {
+	handler ports.CommandHandler[command.CreateBudgetCommand, budget.Budget]
+}
+
+func createBudgetRequestToCommand(m dto.CreateBudgetRequest) (command.CreateBudgetCommand, error) {
+	owner, err := types.TryParseOwnerType(m.Owner)
+	if err != nil {
+		return command.CreateBudgetCommand{}, err
+	}
+	scope, err := budget.ParseScope(m.Scope)
+	if err != nil {
+		return command.CreateBudgetCommand{}, err
+	}
+	period, err := budget.ParsePeriod(m.Period)
+	if err != nil {
+		return command.CreateBudgetCommand{}, err
+	}
+	limit, err := m.Limit.Amount()
+	if err != nil {
+		return command.CreateBudgetCommand{}, err
+	}
+	labelID, err := types.ParseLabelIDOrNil(m.LabelId)
+	if err != nil {
+		return command.CreateBudgetCommand{}, err
+	}
+	budgetID, err := types.ParseBudgetIDOrNil(m.Id)
+	if err != nil {
+		return command.CreateBudgetCommand{}, err
+	}
+	return command.CreateBudgetCommand{
+		BudgetID:  option.New(budgetID),
+		Name:      m.Name,
+		Scope:     scope,
+		LabelID:   labelID,
+		Period:    period,
+		Limit:     limit,
+		Owner:     owner,
+		CreatedAt: option.New(m.CreatedAt),
+	}, nil
+}
+
+// Handle godoc
+//
+//	@Summary		Create a new budget
+//	@Description	Create a monthly or yearly spending limit for a label, the family or the personal account
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Param			budget	body		dto.CreateBudgetRequest	true	"Budget creation data"
+//	@Success		201		{object}	dto.BudgetModel			"Successfully created budget"
+//	@Failure		400		{object}	HttpErrorResponse		"Bad Request - Invalid input data"
+//	@Failure		401		{object}	HttpErrorResponse		"Unauthorized - Budgets are not available for the plan"
+//	@Failure		404		{object}	HttpErrorResponse		"Label not found"
+//	@Failure		500		{object}	HttpErrorResponse		"Internal Server Error"
+//	@Router			/budgets [post]
+func (e CreateEndpoint) Handle(c *gin.Context) {
+	var model dto.CreateBudgetRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd, err := createBudgetRequestToCommand(model)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithStatus[budget.Budget](http.StatusCreated),
+		WithMapping[budget.Budget](func(b budget.Budget) any {
+			return dto.NewBudgetModel(b)
+		}))
+}
+
+func (e CreateEndpoint) Pattern() []string {
+	return []string{
+		"",
+	}
+}
+
+func (e CreateEndpoint) Method() string {
+	return http.MethodPost
+}
+
+func (e CreateEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewCreateEndpoint(handler ports.CommandHandler[command.CreateBudgetCommand, budget.Budget]) *CreateEndpoint {
+	return &CreateEndpoint{
+		handler: handler,
+	}
+}
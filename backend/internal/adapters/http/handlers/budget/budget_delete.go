@@ -0,0 +1,61 @@
+package budget
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/budget/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type DeleteEndpoint struct {
+	handler ports.CommandHandler[command.DeleteBudgetCommand, bool]
+}
+
+// Handle godoc
+//
+//	@Summary		Delete budget by ID
+//	@Description	Permanently delete a budget, the subscriptions it covers are left untouched
+//	@Tags			budgets
+//	@Param			budgetId	path	string	true	"Budget ID (UUID format)"
+//	@Success		204			"No Content - Budget successfully deleted"
+//	@Failure		400			{object}	HttpErrorResponse	"Bad Request - Invalid ID format"
+//	@Failure		404			{object}	HttpErrorResponse	"Budget not found"
+//	@Failure		500			{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/budgets/{budgetId} [delete]
+func (e DeleteEndpoint) Handle(c *gin.Context) {
+	budgetID, err := types.ParseBudgetID(c.Param("budgetId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.DeleteBudgetCommand{
+		BudgetID: budgetID,
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c, r, WithNoContent[bool]())
+}
+
+func (e DeleteEndpoint) Pattern() []string {
+	return []string{
+		"/:budgetId",
+	}
+}
+
+func (e DeleteEndpoint) Method() string {
+	return http.MethodDelete
+}
+
+func (e DeleteEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewDeleteEndpoint(handler ports.CommandHandler[command.DeleteBudgetCommand, bool]) *DeleteEndpoint {
+	return &DeleteEndpoint{
+		handler: handler,
+	}
+}
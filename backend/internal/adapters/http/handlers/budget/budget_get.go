@@ -0,0 +1,65 @@
+package budget
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/budget/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type GetEndpoint struct {
+	handler ports.QueryHandler[query.FindOneQuery, budget.Budget]
+}
+
+// Handle godoc
+//
+//	@Summary		Get budget by ID
+//	@Description	Retrieve a single budget by its unique identifier
+//	@Tags			budgets
+//	@Produce		json
+//	@Param			budgetId	path		string	true	"Budget ID (UUID format)"
+//	@Success		200			{object}	dto.BudgetModel
+//	@Failure		400			{object}	HttpErrorResponse	"Bad Request - Invalid ID format"
+//	@Failure		404			{object}	HttpErrorResponse	"Budget not found"
+//	@Failure		500			{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/budgets/{budgetId} [get]
+func (e GetEndpoint) Handle(c *gin.Context) {
+	budgetID, err := types.ParseBudgetID(c.Param("budgetId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	r := e.handler.Handle(c, query.NewFindOneQuery(budgetID))
+	FromResult(c,
+		r,
+		WithMapping[budget.Budget](func(b budget.Budget) any {
+			return dto.NewBudgetModel(b)
+		}))
+}
+
+func (e GetEndpoint) Pattern() []string {
+	return []string{
+		"/:budgetId",
+	}
+}
+
+func (e GetEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewGetEndpoint(handler ports.QueryHandler[query.FindOneQuery, budget.Budget]) *GetEndpoint {
+	return &GetEndpoint{
+		handler: handler,
+	}
+}
@@ -0,0 +1,69 @@
+package budget
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/internal/usecase/budget/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type GetAllEndpoint struct {
+	handler ports.QueryHandler[query.FindAllQuery, shared.PaginatedResponse[budget.Budget]]
+}
+
+func NewGetAllEndpoint(
+	handler ports.QueryHandler[query.FindAllQuery, shared.PaginatedResponse[budget.Budget]]) *GetAllEndpoint {
+	return &GetAllEndpoint{handler: handler}
+}
+
+// Handle godoc
+//
+//	@Summary		Get all budgets
+//	@Description	Retrieve a paginated list of the budgets of the user and their family
+//	@Tags			budgets
+//	@Produce		json
+//	@Param			limit	query		integer										false	"Maximum number of items to return (default: 10)"
+//	@Param			offset	query		integer										false	"Number of items to skip for pagination (default: 0)"
+//	@Success		200		{object}	dto.PaginatedResponseModel[dto.BudgetModel]	"Paginated list of budgets"
+//	@Failure		400		{object}	HttpErrorResponse							"Bad Request - Invalid query parameters"
+//	@Failure		500		{object}	HttpErrorResponse							"Internal Server Error"
+//	@Router			/budgets [get]
+func (e GetAllEndpoint) Handle(c *gin.Context) {
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	offset, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	r := e.handler.Handle(c, query.NewFindAllQuery(limit, offset))
+	FromResult(c,
+		r,
+		WithMapping[shared.PaginatedResponse[budget.Budget]](
+			func(paginatedResult shared.PaginatedResponse[budget.Budget]) any {
+				return dto.NewPaginatedResponseModel(paginatedResult, dto.NewBudgetModel)
+			}))
+}
+
+func (e GetAllEndpoint) Pattern() []string {
+	return []string{
+		"",
+	}
+}
+
+func (e GetAllEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetAllEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -0,0 +1,71 @@
+package budget
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/internal/usecase/budget/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type StatusEndpoint struct {
+	handler ports.QueryHandler[query.StatusQuery, shared.PaginatedResponse[query.StatusResponse]]
+}
+
+func NewStatusEndpoint(
+	handler ports.QueryHandler[query.StatusQuery, shared.PaginatedResponse[query.StatusResponse]]) *StatusEndpoint {
+	return &StatusEndpoint{handler: handler}
+}
+
+// Handle godoc
+//
+//	@Summary		Get the status of the budgets
+//	@Description	Compare each budget with the cost of the active subscriptions it covers, normalized to its period and converted into the currency of its limit
+//	@Tags			budgets
+//	@Produce		json
+//	@Param			limit	query		integer												false	"Maximum number of items to return (default: 10)"
+//	@Param			offset	query		integer												false	"Number of items to skip for pagination (default: 0)"
+//	@Success		200		{object}	dto.PaginatedResponseModel[dto.BudgetStatusModel]	"Paginated list of budget statuses"
+//	@Failure		400		{object}	HttpErrorResponse									"Bad Request - Invalid query parameters"
+//	@Failure		500		{object}	HttpErrorResponse									"Internal Server Error"
+//	@Router			/budgets/status [get]
+func (e StatusEndpoint) Handle(c *gin.Context) {
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	offset, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	r := e.handler.Handle(c, query.NewStatusQuery(limit, offset))
+	FromResult(c,
+		r,
+		WithMapping[shared.PaginatedResponse[query.StatusResponse]](
+			func(paginatedResult shared.PaginatedResponse[query.StatusResponse]) any {
+				return dto.NewPaginatedResponseModel(paginatedResult,
+					func(s query.StatusResponse) dto.BudgetStatusModel {
+						return dto.NewBudgetStatusModel(s.Budget, s.Status)
+					})
+			}))
+}
+
+func (e StatusEndpoint) Pattern() []string {
+	return []string{
+		"/status",
+	}
+}
+
+func (e StatusEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e StatusEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -0,0 +1,114 @@
+package budget
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/budget/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+)
+
+type UpdateEndpoint struct {
+	handler ports.CommandHandler[command.UpdateBudgetCommand, budget.Budget]
+}
+
+func updateBudgetRequestToCommand(
+	m dto.UpdateBudgetRequest,
+	budgetID types.BudgetID) (command.UpdateBudgetCommand, error) {
+	scope, err := budget.ParseScope(m.Scope)
+	if err != nil {
+		return command.UpdateBudgetCommand{}, err
+	}
+	period, err := budget.ParsePeriod(m.Period)
+	if err != nil {
+		return command.UpdateBudgetCommand{}, err
+	}
+	limit, err := m.Limit.Amount()
+	if err != nil {
+		return command.UpdateBudgetCommand{}, err
+	}
+	labelID, err := types.ParseLabelIDOrNil(m.LabelId)
+	if err != nil {
+		return command.UpdateBudgetCommand{}, err
+	}
+	updatedAt := option.None[time.Time]()
+	if m.UpdatedAt != nil {
+		updatedAt = option.Some(*m.UpdatedAt)
+	}
+	return command.UpdateBudgetCommand{
+		BudgetID:  budgetID,
+		Name:      m.Name,
+		Scope:     scope,
+		LabelID:   labelID,
+		Period:    period,
+		Limit:     limit,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+// Handle godoc
+//
+//	@Summary		Update budget by ID
+//	@Description	Update an existing budget by its unique identifier
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Param			budgetId	path		string					true	"Budget ID (UUID format)"
+//	@Param			budget		body		dto.UpdateBudgetRequest	true	"Updated budget data"
+//	@Success		200			{object}	dto.BudgetModel			"Successfully updated budget"
+//	@Failure		400			{object}	HttpErrorResponse		"Bad Request - Invalid ID format or input data"
+//	@Failure		404			{object}	HttpErrorResponse		"Budget not found"
+//	@Failure		500			{object}	HttpErrorResponse		"Internal Server Error"
+//	@Router			/budgets/{budgetId} [put]
+func (e UpdateEndpoint) Handle(c *gin.Context) {
+	budgetID, err := types.ParseBudgetID(c.Param("budgetId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	var model dto.UpdateBudgetRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd, err := updateBudgetRequestToCommand(model, budgetID)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithMapping[budget.Budget](func(b budget.Budget) any {
+			return dto.NewBudgetModel(b)
+		}))
+}
+
+func (e UpdateEndpoint) Pattern() []string {
+	return []string{
+		"/:budgetId",
+	}
+}
+
+func (e UpdateEndpoint) Method() string {
+	return http.MethodPut
+}
+
+func (e UpdateEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewUpdateEndpoint(handler ports.CommandHandler[command.UpdateBudgetCommand, budget.Budget]) *UpdateEndpoint {
+	return &UpdateEndpoint{
+		handler: handler,
+	}
+}
@@ -0,0 +1,56 @@
+package calendar
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/calendar/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type CreateFeedEndpoint struct {
+	handler ports.CommandHandler[command.CreateFeedCommand, string]
+}
+
+func NewCreateFeedEndpoint(handler ports.CommandHandler[command.CreateFeedCommand, string]) *CreateFeedEndpoint {
+	return &CreateFeedEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Create the calendar feed
+//	@Description	Creates the calendar feed of the authenticated account and returns its token
+//	@Tags			calendar
+//	@Produce		json
+//	@Success		201	{object}	dto.CalendarFeedModel
+//	@Failure		401	{object}	HttpErrorResponse	"Unauthorized"
+//	@Failure		409	{object}	HttpErrorResponse	"The account already has a calendar feed"
+//	@Router			/calendar/feed [post]
+func (e CreateFeedEndpoint) Handle(c *gin.Context) {
+	r := e.handler.Handle(c, command.CreateFeedCommand{})
+	FromResult(c,
+		r,
+		WithStatus[string](http.StatusCreated),
+		WithMapping[string](func(token string) any {
+			return dto.NewCalendarFeedModel(token)
+		}))
+}
+
+func (e CreateFeedEndpoint) Pattern() []string {
+	return []string{
+		"",
+	}
+}
+
+func (e CreateFeedEndpoint) Method() string {
+	return http.MethodPost
+}
+
+func (e CreateFeedEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
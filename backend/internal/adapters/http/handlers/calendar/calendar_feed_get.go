@@ -0,0 +1,83 @@
+package calendar
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/export"
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/calendar/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+const icsExtension = ".ics"
+
+type GetFeedEndpoint struct {
+	handler ports.QueryHandler[query.FindFeedEventsQuery, []calendar.Event]
+}
+
+func NewGetFeedEndpoint(handler ports.QueryHandler[query.FindFeedEventsQuery, []calendar.Event]) *GetFeedEndpoint {
+	return &GetFeedEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Get the calendar feed
+//	@Description	iCalendar feed of the upcoming renewals, free trial ends and cancellation deadlines of the account owning the token. No session is required, the token is the credential.
+//	@Tags			calendar
+//	@Produce		text/calendar
+//	@Param			token		path		string				true	"Feed token followed by .ics"
+//	@Param			renewals	query		int					false	"Number of upcoming renewals per subscription"	default(3)	maximum(24)
+//	@Success		200			{file}		file				"iCalendar feed"
+//	@Failure		404			{object}	HttpErrorResponse	"Unknown or revoked token"
+//	@Router			/calendar/{token}.ics [get]
+func (e GetFeedEndpoint) Handle(c *gin.Context) {
+	file := c.Param("file")
+	token, ok := strings.CutSuffix(file, icsExtension)
+	if !ok {
+		FromError(c, calendar.ErrFeedNotFound)
+		return
+	}
+
+	renewals := 0
+	if value := c.Query("renewals"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			FromError(c, err)
+			return
+		}
+		renewals = parsed
+	}
+
+	r := e.handler.Handle(c, query.NewFindFeedEventsQuery(token, renewals))
+	r.IfFailure(func(err error) {
+		FromError(c, err)
+	})
+	r.IfSuccess(func(events []calendar.Event) {
+		c.Header("Content-Type", export.ContentTypeICS)
+		c.Header("Cache-Control", "private, max-age=3600")
+		if err := export.EncodeICS(c.Writer, "SubTracker", events); err != nil {
+			_ = c.Error(err)
+		}
+	})
+}
+
+func (e GetFeedEndpoint) Pattern() []string {
+	return []string{
+		"/:file",
+	}
+}
+
+func (e GetFeedEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetFeedEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
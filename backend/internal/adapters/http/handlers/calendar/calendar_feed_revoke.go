@@ -0,0 +1,49 @@
+package calendar
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/calendar/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type RevokeFeedEndpoint struct {
+	handler ports.CommandHandler[command.RevokeFeedCommand, bool]
+}
+
+func NewRevokeFeedEndpoint(handler ports.CommandHandler[command.RevokeFeedCommand, bool]) *RevokeFeedEndpoint {
+	return &RevokeFeedEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Revoke the calendar feed
+//	@Description	Deletes the calendar feed of the authenticated account, its URL stops working
+//	@Tags			calendar
+//	@Success		204
+//	@Failure		401	{object}	HttpErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	HttpErrorResponse	"The account has no calendar feed"
+//	@Router			/calendar/feed [delete]
+func (e RevokeFeedEndpoint) Handle(c *gin.Context) {
+	r := e.handler.Handle(c, command.RevokeFeedCommand{})
+	FromResult(c, r, WithNoContent[bool]())
+}
+
+func (e RevokeFeedEndpoint) Pattern() []string {
+	return []string{
+		"",
+	}
+}
+
+func (e RevokeFeedEndpoint) Method() string {
+	return http.MethodDelete
+}
+
+func (e RevokeFeedEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
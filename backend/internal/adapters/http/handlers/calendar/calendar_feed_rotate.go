@@ -0,0 +1,55 @@
+package calendar
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/calendar/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type RotateFeedEndpoint struct {
+	handler ports.CommandHandler[command.RotateFeedCommand, string]
+}
+
+func NewRotateFeedEndpoint(handler ports.CommandHandler[command.RotateFeedCommand, string]) *RotateFeedEndpoint {
+	return &RotateFeedEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Rotate the calendar feed token
+//	@Description	Replaces the token of the calendar feed of the authenticated account, the previous URL stops working
+//	@Tags			calendar
+//	@Produce		json
+//	@Success		200	{object}	dto.CalendarFeedModel
+//	@Failure		401	{object}	HttpErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	HttpErrorResponse	"The account has no calendar feed"
+//	@Router			/calendar/feed/rotate [post]
+func (e RotateFeedEndpoint) Handle(c *gin.Context) {
+	r := e.handler.Handle(c, command.RotateFeedCommand{})
+	FromResult(c,
+		r,
+		WithMapping[string](func(token string) any {
+			return dto.NewCalendarFeedModel(token)
+		}))
+}
+
+func (e RotateFeedEndpoint) Pattern() []string {
+	return []string{
+		"/rotate",
+	}
+}
+
+func (e RotateFeedEndpoint) Method() string {
+	return http.MethodPost
+}
+
+func (e RotateFeedEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
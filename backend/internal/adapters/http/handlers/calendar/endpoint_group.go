@@ -0,0 +1,68 @@
+package calendar
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/router/ginfx"
+	"github.com/mistribe/subtracker/internal/adapters/http/router/middlewares"
+)
+
+// EndpointGroup manages the calendar feed of the authenticated account
+type EndpointGroup struct {
+	routes      []ginfx.Endpoint
+	middlewares []gin.HandlerFunc
+}
+
+func NewEndpointGroup(
+	createFeedEndpoint *CreateFeedEndpoint,
+	rotateFeedEndpoint *RotateFeedEndpoint,
+	revokeFeedEndpoint *RevokeFeedEndpoint,
+	authenticationMiddleware *middlewares.AuthenticationMiddleware) *EndpointGroup {
+	return &EndpointGroup{
+		routes: []ginfx.Endpoint{
+			createFeedEndpoint,
+			rotateFeedEndpoint,
+			revokeFeedEndpoint,
+		},
+		middlewares: []gin.HandlerFunc{
+			authenticationMiddleware.Middleware(),
+		},
+	}
+}
+
+func (g EndpointGroup) Prefix() string {
+	return "/calendar/feed"
+}
+
+func (g EndpointGroup) Routes() []ginfx.Endpoint {
+	return g.routes
+}
+
+func (g EndpointGroup) Middlewares() []gin.HandlerFunc {
+	return g.middlewares
+}
+
+// FeedEndpointGroup serves the calendar feeds, calendar applications authenticate with the feed token
+type FeedEndpointGroup struct {
+	routes []ginfx.Endpoint
+}
+
+func NewFeedEndpointGroup(getFeedEndpoint *GetFeedEndpoint) *FeedEndpointGroup {
+	return &FeedEndpointGroup{
+		routes: []ginfx.Endpoint{
+			getFeedEndpoint,
+		},
+	}
+}
+
+func (g FeedEndpointGroup) Prefix() string {
+	return "/calendar"
+}
+
+func (g FeedEndpointGroup) Routes() []ginfx.Endpoint {
+	return g.routes
+}
+
+func (g FeedEndpointGroup) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -15,11 +15,21 @@ type EndpointGroup struct {
 func NewEndpointGroup(
 	supportedEndpoint *SupportedEndpoint,
 	convertEndpoint *GetRateEndpoint,
+	getAllManualRatesEndpoint *GetAllManualRatesEndpoint,
+	createManualRateEndpoint *CreateManualRateEndpoint,
+	updateManualRateEndpoint *UpdateManualRateEndpoint,
+	deleteManualRateEndpoint *DeleteManualRateEndpoint,
+	getRateSyncEndpoint *GetRateSyncEndpoint,
 	authenticationMiddleware *middlewares.AuthenticationMiddleware) *EndpointGroup {
 	return &EndpointGroup{
 		routes: []ginfx.Endpoint{
 			supportedEndpoint,
 			convertEndpoint,
+			getAllManualRatesEndpoint,
+			createManualRateEndpoint,
+			updateManualRateEndpoint,
+			deleteManualRateEndpoint,
+			getRateSyncEndpoint,
 		},
 		middlewares: []gin.HandlerFunc{
 			authenticationMiddleware.Middleware(),
@@ -0,0 +1,107 @@
+package currency
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/currency/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+)
+
+type CreateManualRateEndpoint struct {
+	handler ports.CommandHandler[command.CreateManualRateCommand, currency.ManualRate]
+}
+
+func createManualRateRequestToCommand(m dto.CreateManualRateRequest) (command.CreateManualRateCommand, error) {
+	owner, err := types.TryParseOwnerType(m.Owner)
+	if err != nil {
+		return command.CreateManualRateCommand{}, err
+	}
+	fromCurrency, err := currency.ParseISO(m.FromCurrency)
+	if err != nil {
+		return command.CreateManualRateCommand{}, err
+	}
+	toCurrency, err := currency.ParseISO(m.ToCurrency)
+	if err != nil {
+		return command.CreateManualRateCommand{}, err
+	}
+	subscriptionID, err := types.ParseSubscriptionIDOrNil(m.SubscriptionId)
+	if err != nil {
+		return command.CreateManualRateCommand{}, err
+	}
+	manualRateID, err := types.ParseManualRateIDOrNil(m.Id)
+	if err != nil {
+		return command.CreateManualRateCommand{}, err
+	}
+	return command.CreateManualRateCommand{
+		ManualRateID:   option.New(manualRateID),
+		Owner:          owner,
+		SubscriptionID: subscriptionID,
+		FromCurrency:   fromCurrency,
+		ToCurrency:     toCurrency,
+		Rate:           m.Rate,
+		CreatedAt:      option.New(m.CreatedAt),
+	}, nil
+}
+
+// Handle godoc
+//
+//	@Summary		Create a new manual rate
+//	@Description	Create an exchange rate used instead of the market rates for a currency pair, for every subscription or for a single one
+//	@Tags			currencies
+//	@Accept			json
+//	@Produce		json
+//	@Param			rate	body		dto.CreateManualRateRequest	true	"Manual rate creation data"
+//	@Success		201		{object}	dto.ManualRateModel			"Successfully created manual rate"
+//	@Failure		400		{object}	HttpErrorResponse			"Bad Request - Invalid input data"
+//	@Failure		404		{object}	HttpErrorResponse			"Subscription not found"
+//	@Failure		409		{object}	HttpErrorResponse			"Conflict - The currency pair already has a manual rate"
+//	@Failure		500		{object}	HttpErrorResponse			"Internal Server Error"
+//	@Router			/currencies/manual-rates [post]
+func (e CreateManualRateEndpoint) Handle(c *gin.Context) {
+	var model dto.CreateManualRateRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd, err := createManualRateRequestToCommand(model)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithStatus[currency.ManualRate](http.StatusCreated),
+		WithMapping[currency.ManualRate](func(rate currency.ManualRate) any {
+			return dto.NewManualRateModel(rate)
+		}))
+}
+
+func (e CreateManualRateEndpoint) Pattern() []string {
+	return []string{
+		"/manual-rates",
+	}
+}
+
+func (e CreateManualRateEndpoint) Method() string {
+	return http.MethodPost
+}
+
+func (e CreateManualRateEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewCreateManualRateEndpoint(
+	handler ports.CommandHandler[command.CreateManualRateCommand, currency.ManualRate]) *CreateManualRateEndpoint {
+	return &CreateManualRateEndpoint{
+		handler: handler,
+	}
+}
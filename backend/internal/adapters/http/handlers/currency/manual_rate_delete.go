@@ -0,0 +1,61 @@
+package currency
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/currency/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type DeleteManualRateEndpoint struct {
+	handler ports.CommandHandler[command.DeleteManualRateCommand, bool]
+}
+
+// Handle godoc
+//
+//	@Summary		Delete manual rate by ID
+//	@Description	Permanently delete a manual rate, the market rates are used again for its currency pair
+//	@Tags			currencies
+//	@Param			manualRateId	path	string	true	"Manual rate ID (UUID format)"
+//	@Success		204				"No Content - Manual rate successfully deleted"
+//	@Failure		400				{object}	HttpErrorResponse	"Bad Request - Invalid ID format"
+//	@Failure		404				{object}	HttpErrorResponse	"Manual rate not found"
+//	@Failure		500				{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/currencies/manual-rates/{manualRateId} [delete]
+func (e DeleteManualRateEndpoint) Handle(c *gin.Context) {
+	manualRateID, err := types.ParseManualRateID(c.Param("manualRateId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.DeleteManualRateCommand{
+		ManualRateID: manualRateID,
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c, r, WithNoContent[bool]())
+}
+
+func (e DeleteManualRateEndpoint) Pattern() []string {
+	return []string{
+		"/manual-rates/:manualRateId",
+	}
+}
+
+func (e DeleteManualRateEndpoint) Method() string {
+	return http.MethodDelete
+}
+
+func (e DeleteManualRateEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewDeleteManualRateEndpoint(handler ports.CommandHandler[command.DeleteManualRateCommand, bool]) *DeleteManualRateEndpoint {
+	return &DeleteManualRateEndpoint{
+		handler: handler,
+	}
+}
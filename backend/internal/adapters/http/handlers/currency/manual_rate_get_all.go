@@ -0,0 +1,70 @@
+package currency
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/internal/usecase/currency/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type GetAllManualRatesEndpoint struct {
+	handler ports.QueryHandler[query.FindAllManualRatesQuery, shared.PaginatedResponse[currency.ManualRate]]
+}
+
+func NewGetAllManualRatesEndpoint(
+	handler ports.QueryHandler[query.FindAllManualRatesQuery, shared.PaginatedResponse[currency.ManualRate]],
+) *GetAllManualRatesEndpoint {
+	return &GetAllManualRatesEndpoint{handler: handler}
+}
+
+// Handle godoc
+//
+//	@Summary		Get all manual rates
+//	@Description	Retrieve a paginated list of the manual exchange rates of the user and their family
+//	@Tags			currencies
+//	@Produce		json
+//	@Param			limit	query		integer											false	"Maximum number of items to return (default: 10)"
+//	@Param			offset	query		integer											false	"Number of items to skip for pagination (default: 0)"
+//	@Success		200		{object}	dto.PaginatedResponseModel[dto.ManualRateModel]	"Paginated list of manual rates"
+//	@Failure		400		{object}	HttpErrorResponse								"Bad Request - Invalid query parameters"
+//	@Failure		500		{object}	HttpErrorResponse								"Internal Server Error"
+//	@Router			/currencies/manual-rates [get]
+func (e GetAllManualRatesEndpoint) Handle(c *gin.Context) {
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	offset, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	r := e.handler.Handle(c, query.NewFindAllManualRatesQuery(limit, offset))
+	FromResult(c,
+		r,
+		WithMapping[shared.PaginatedResponse[currency.ManualRate]](
+			func(paginatedResult shared.PaginatedResponse[currency.ManualRate]) any {
+				return dto.NewPaginatedResponseModel(paginatedResult, dto.NewManualRateModel)
+			}))
+}
+
+func (e GetAllManualRatesEndpoint) Pattern() []string {
+	return []string{
+		"/manual-rates",
+	}
+}
+
+func (e GetAllManualRatesEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetAllManualRatesEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
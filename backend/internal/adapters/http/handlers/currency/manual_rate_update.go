@@ -0,0 +1,106 @@
+package currency
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/currency/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+)
+
+type UpdateManualRateEndpoint struct {
+	handler ports.CommandHandler[command.UpdateManualRateCommand, currency.ManualRate]
+}
+
+func updateManualRateRequestToCommand(
+	m dto.UpdateManualRateRequest,
+	manualRateID types.ManualRateID) (command.UpdateManualRateCommand, error) {
+	fromCurrency, err := currency.ParseISO(m.FromCurrency)
+	if err != nil {
+		return command.UpdateManualRateCommand{}, err
+	}
+	toCurrency, err := currency.ParseISO(m.ToCurrency)
+	if err != nil {
+		return command.UpdateManualRateCommand{}, err
+	}
+	updatedAt := option.None[time.Time]()
+	if m.UpdatedAt != nil {
+		updatedAt = option.Some(*m.UpdatedAt)
+	}
+	return command.UpdateManualRateCommand{
+		ManualRateID: manualRateID,
+		FromCurrency: fromCurrency,
+		ToCurrency:   toCurrency,
+		Rate:         m.Rate,
+		UpdatedAt:    updatedAt,
+	}, nil
+}
+
+// Handle godoc
+//
+//	@Summary		Update manual rate by ID
+//	@Description	Update the currency pair or the rate of an existing manual rate
+//	@Tags			currencies
+//	@Accept			json
+//	@Produce		json
+//	@Param			manualRateId	path		string						true	"Manual rate ID (UUID format)"
+//	@Param			rate			body		dto.UpdateManualRateRequest	true	"Updated manual rate data"
+//	@Success		200				{object}	dto.ManualRateModel			"Successfully updated manual rate"
+//	@Failure		400				{object}	HttpErrorResponse			"Bad Request - Invalid ID format or input data"
+//	@Failure		404				{object}	HttpErrorResponse			"Manual rate not found"
+//	@Failure		409				{object}	HttpErrorResponse			"Conflict - The currency pair already has a manual rate"
+//	@Failure		500				{object}	HttpErrorResponse			"Internal Server Error"
+//	@Router			/currencies/manual-rates/{manualRateId} [put]
+func (e UpdateManualRateEndpoint) Handle(c *gin.Context) {
+	manualRateID, err := types.ParseManualRateID(c.Param("manualRateId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	var model dto.UpdateManualRateRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd, err := updateManualRateRequestToCommand(model, manualRateID)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithMapping[currency.ManualRate](func(rate currency.ManualRate) any {
+			return dto.NewManualRateModel(rate)
+		}))
+}
+
+func (e UpdateManualRateEndpoint) Pattern() []string {
+	return []string{
+		"/manual-rates/:manualRateId",
+	}
+}
+
+func (e UpdateManualRateEndpoint) Method() string {
+	return http.MethodPut
+}
+
+func (e UpdateManualRateEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewUpdateManualRateEndpoint(
+	handler ports.CommandHandler[command.UpdateManualRateCommand, currency.ManualRate]) *UpdateManualRateEndpoint {
+	return &UpdateManualRateEndpoint{
+		handler: handler,
+	}
+}
@@ -0,0 +1,55 @@
+package currency
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/currency/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type GetRateSyncEndpoint struct {
+	handler ports.QueryHandler[query.GetRateSyncQuery, currency.RateSync]
+}
+
+func NewGetRateSyncEndpoint(handler ports.QueryHandler[query.GetRateSyncQuery, currency.RateSync]) *GetRateSyncEndpoint {
+	return &GetRateSyncEndpoint{handler: handler}
+}
+
+// Handle godoc
+//
+//	@Summary		Get the rate synchronization progress
+//	@Description	Retrieve the progress of the last backfill of the missing market rates, restricted to administrators
+//	@Tags			currencies
+//	@Produce		json
+//	@Success		200	{object}	dto.RateSyncModel
+//	@Failure		401	{object}	HttpErrorResponse	"Unauthorized - Administrators only"
+//	@Failure		404	{object}	HttpErrorResponse	"No synchronization has run yet"
+//	@Failure		500	{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/currencies/rates/sync [get]
+func (e GetRateSyncEndpoint) Handle(c *gin.Context) {
+	r := e.handler.Handle(c, query.GetRateSyncQuery{})
+	FromResult(c,
+		r,
+		WithMapping[currency.RateSync](func(sync currency.RateSync) any {
+			return dto.NewRateSyncModel(sync)
+		}))
+}
+
+func (e GetRateSyncEndpoint) Pattern() []string {
+	return []string{
+		"/rates/sync",
+	}
+}
+
+func (e GetRateSyncEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetRateSyncEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
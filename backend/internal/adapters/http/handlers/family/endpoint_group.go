@@ -26,6 +26,10 @@ func NewEndpointGroup(
 	familyMemberDeleteEndpoint *MemberDeleteEndpoint,
 	familyGetEndpoint *GetEndpoint,
 	familyQuotaUsageEndpoint *GetQuotaUsageEndpoint,
+	familySharesEndpoint *SharesEndpoint,
+	familyLedgerEndpoint *LedgerEndpoint,
+	familySettlementCreateEndpoint *SettlementCreateEndpoint,
+	familySettlementDeleteEndpoint *SettlementDeleteEndpoint,
 	authenticationMiddleware *middlewares.AuthenticationMiddleware) *EndpointGroup {
 	return &EndpointGroup{
 		routes: []ginfx.Endpoint{
@@ -42,6 +46,10 @@ func NewEndpointGroup(
 			familyMemberDeleteEndpoint,
 			familyGetEndpoint,
 			familyQuotaUsageEndpoint,
+			familySharesEndpoint,
+			familyLedgerEndpoint,
+			familySettlementCreateEndpoint,
+			familySettlementDeleteEndpoint,
 		},
 		middlewares: []gin.HandlerFunc{
 			authenticationMiddleware.Middleware(),
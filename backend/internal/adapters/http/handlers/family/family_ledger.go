@@ -0,0 +1,79 @@
+package family
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/ledger/query"
+)
+
+type LedgerEndpoint struct {
+	handler ports.QueryHandler[query.FamilyLedgerQuery, query.FamilyLedgerQueryResponse]
+}
+
+// Handle godoc
+//
+//	@Summary		Get family ledger
+//	@Description	Retrieve who owes whom within the family, in the preferred currency. Each member owes their share of every charge paid by another member, settlements reduce what is owed
+//	@Tags			family
+//	@Produce		json
+//	@Param			familyId	path		string					true	"Family ID (UUID format)"
+//	@Success		200			{object}	dto.FamilyLedgerModel	"Successfully retrieved family ledger"
+//	@Failure		400			{object}	HttpErrorResponse		"Bad Request - Invalid ID format"
+//	@Failure		401			{object}	HttpErrorResponse		"Unauthorized - Invalid user authentication"
+//	@Failure		404			{object}	HttpErrorResponse		"Family not found"
+//	@Failure		500			{object}	HttpErrorResponse		"Internal Server Error"
+//	@Router			/family/{familyId}/ledger [get]
+func (e LedgerEndpoint) Handle(c *gin.Context) {
+	familyID, err := types.ParseFamilyID(c.Param("familyId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	r := e.handler.Handle(c, query.NewFamilyLedgerQuery(familyID))
+	FromResult(c,
+		r,
+		WithMapping[query.FamilyLedgerQueryResponse](func(r query.FamilyLedgerQueryResponse) any {
+			return dto.FamilyLedgerModel{
+				FamilyId: r.FamilyID.String(),
+				Balances: herd.Select(r.Balances, func(b query.MemberBalanceResponse) dto.MemberBalanceModel {
+					return dto.MemberBalanceModel{
+						MemberId: b.MemberID.String(),
+						Name:     b.Name,
+						Balance:  dto.NewAmount(b.Balance),
+					}
+				}),
+				Transfers:   herd.Select(r.Transfers, dto.NewTransferModel),
+				Settlements: herd.Select(r.Settlements, dto.NewSettlementModel),
+			}
+		}))
+}
+
+func (e LedgerEndpoint) Pattern() []string {
+	return []string{
+		"/:familyId/ledger",
+	}
+}
+
+func (e LedgerEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e LedgerEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewLedgerEndpoint(
+	handler ports.QueryHandler[query.FamilyLedgerQuery, query.FamilyLedgerQueryResponse]) *LedgerEndpoint {
+	return &LedgerEndpoint{
+		handler: handler,
+	}
+}
@@ -0,0 +1,103 @@
+package family
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/ledger/command"
+)
+
+type SettlementCreateEndpoint struct {
+	handler ports.CommandHandler[command.RecordSettlementCommand, ledger.Settlement]
+}
+
+// Handle godoc
+//
+//	@Summary		Record a settlement
+//	@Description	Record that a family member paid another one back, e.g. Alice paid Bob 30 EUR
+//	@Tags			family
+//	@Accept			json
+//	@Produce		json
+//	@Param			familyId	path		string						true	"Family ID (UUID format)"
+//	@Param			settlement	body		dto.RecordSettlementRequest	true	"Settlement data"
+//	@Success		201			{object}	dto.SettlementModel			"Successfully recorded settlement"
+//	@Failure		400			{object}	HttpErrorResponse			"Bad Request - Invalid input data"
+//	@Failure		401			{object}	HttpErrorResponse			"Unauthorized - Invalid user authentication"
+//	@Failure		404			{object}	HttpErrorResponse			"Family or family member not found"
+//	@Failure		500			{object}	HttpErrorResponse			"Internal Server Error"
+//	@Router			/family/{familyId}/settlements [post]
+func (e SettlementCreateEndpoint) Handle(c *gin.Context) {
+	familyID, err := types.ParseFamilyID(c.Param("familyId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	var model dto.RecordSettlementRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	from, err := types.ParseFamilyMemberID(model.FromMemberId)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	to, err := types.ParseFamilyMemberID(model.ToMemberId)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	amount, err := model.Amount.Amount()
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.RecordSettlementCommand{
+		FamilyID:  familyID,
+		From:      from,
+		To:        to,
+		Amount:    amount,
+		SettledAt: model.SettledAt,
+		Note:      model.Note,
+		CreatedAt: option.New(model.CreatedAt),
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithStatus[ledger.Settlement](http.StatusCreated),
+		WithMapping[ledger.Settlement](func(s ledger.Settlement) any {
+			return dto.NewSettlementModel(s)
+		}))
+}
+
+func (e SettlementCreateEndpoint) Pattern() []string {
+	return []string{
+		"/:familyId/settlements",
+	}
+}
+
+func (e SettlementCreateEndpoint) Method() string {
+	return http.MethodPost
+}
+
+func (e SettlementCreateEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewSettlementCreateEndpoint(
+	handler ports.CommandHandler[command.RecordSettlementCommand, ledger.Settlement]) *SettlementCreateEndpoint {
+	return &SettlementCreateEndpoint{
+		handler: handler,
+	}
+}
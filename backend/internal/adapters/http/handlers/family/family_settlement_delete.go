@@ -0,0 +1,71 @@
+package family
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/ledger/command"
+)
+
+type SettlementDeleteEndpoint struct {
+	handler ports.CommandHandler[command.DeleteSettlementCommand, bool]
+}
+
+// Handle godoc
+//
+//	@Summary		Delete a settlement
+//	@Description	Delete a settlement recorded by mistake, what it settled is owed again
+//	@Tags			family
+//	@Param			familyId		path	string	true	"Family ID (UUID format)"
+//	@Param			settlementId	path	string	true	"Settlement ID (UUID format)"
+//	@Success		204				"No Content - Settlement successfully deleted"
+//	@Failure		400				{object}	HttpErrorResponse	"Bad Request - Invalid ID format"
+//	@Failure		401				{object}	HttpErrorResponse	"Unauthorized - Invalid user authentication"
+//	@Failure		404				{object}	HttpErrorResponse	"Settlement not found"
+//	@Failure		500				{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/family/{familyId}/settlements/{settlementId} [delete]
+func (e SettlementDeleteEndpoint) Handle(c *gin.Context) {
+	familyID, err := types.ParseFamilyID(c.Param("familyId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	settlementID, err := types.ParseSettlementID(c.Param("settlementId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.DeleteSettlementCommand{
+		FamilyID:     familyID,
+		SettlementID: settlementID,
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c, r, WithNoContent[bool]())
+}
+
+func (e SettlementDeleteEndpoint) Pattern() []string {
+	return []string{
+		"/:familyId/settlements/:settlementId",
+	}
+}
+
+func (e SettlementDeleteEndpoint) Method() string {
+	return http.MethodDelete
+}
+
+func (e SettlementDeleteEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewSettlementDeleteEndpoint(
+	handler ports.CommandHandler[command.DeleteSettlementCommand, bool]) *SettlementDeleteEndpoint {
+	return &SettlementDeleteEndpoint{
+		handler: handler,
+	}
+}
@@ -0,0 +1,80 @@
+package family
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
+)
+
+type SharesEndpoint struct {
+	handler ports.QueryHandler[query.FamilySharesQuery, query.FamilySharesQueryResponse]
+}
+
+// Handle godoc
+//
+//	@Summary		Get family member shares
+//	@Description	Retrieve the monthly and yearly share of each family member for the active family subscriptions, in the preferred currency
+//	@Tags			family
+//	@Produce		json
+//	@Param			familyId	path		string					true	"Family ID (UUID format)"
+//	@Success		200			{object}	dto.FamilySharesModel	"Successfully retrieved family shares"
+//	@Failure		400			{object}	HttpErrorResponse		"Bad Request - Invalid ID format"
+//	@Failure		401			{object}	HttpErrorResponse		"Unauthorized - Invalid user authentication"
+//	@Failure		404			{object}	HttpErrorResponse		"Family not found"
+//	@Failure		500			{object}	HttpErrorResponse		"Internal Server Error"
+//	@Router			/family/{familyId}/shares [get]
+func (e SharesEndpoint) Handle(c *gin.Context) {
+	familyID, err := types.ParseFamilyID(c.Param("familyId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	r := e.handler.Handle(c, query.NewFamilySharesQuery(familyID))
+	FromResult(c,
+		r,
+		WithMapping[query.FamilySharesQueryResponse](func(r query.FamilySharesQueryResponse) any {
+			return dto.FamilySharesModel{
+				FamilyId: r.FamilyID.String(),
+				Members: herd.Select(r.Members, func(m query.FamilyMemberShareResponse) dto.FamilyMemberShareModel {
+					return dto.FamilyMemberShareModel{
+						MemberId: m.MemberID.String(),
+						Name:     m.Name,
+						Monthly:  dto.NewAmount(m.Monthly),
+						Yearly:   dto.NewAmount(m.Yearly),
+					}
+				}),
+				TotalMonthly: dto.NewAmount(r.TotalMonthly),
+				TotalYearly:  dto.NewAmount(r.TotalYearly),
+			}
+		}))
+}
+
+func (e SharesEndpoint) Pattern() []string {
+	return []string{
+		"/:familyId/shares",
+	}
+}
+
+func (e SharesEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e SharesEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewSharesEndpoint(
+	handler ports.QueryHandler[query.FamilySharesQuery, query.FamilySharesQueryResponse]) *SharesEndpoint {
+	return &SharesEndpoint{
+		handler: handler,
+	}
+}
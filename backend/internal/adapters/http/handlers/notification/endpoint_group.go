@@ -0,0 +1,41 @@
+package notification
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/router/ginfx"
+	"github.com/mistribe/subtracker/internal/adapters/http/router/middlewares"
+)
+
+// EndpointGroup manages the notifications of the authenticated account
+type EndpointGroup struct {
+	routes      []ginfx.Endpoint
+	middlewares []gin.HandlerFunc
+}
+
+func NewEndpointGroup(
+	getPreferencesEndpoint *GetPreferencesEndpoint,
+	updatePreferencesEndpoint *UpdatePreferencesEndpoint,
+	authenticationMiddleware *middlewares.AuthenticationMiddleware) *EndpointGroup {
+	return &EndpointGroup{
+		routes: []ginfx.Endpoint{
+			getPreferencesEndpoint,
+			updatePreferencesEndpoint,
+		},
+		middlewares: []gin.HandlerFunc{
+			authenticationMiddleware.Middleware(),
+		},
+	}
+}
+
+func (g EndpointGroup) Prefix() string {
+	return "/notifications"
+}
+
+func (g EndpointGroup) Routes() []ginfx.Endpoint {
+	return g.routes
+}
+
+func (g EndpointGroup) Middlewares() []gin.HandlerFunc {
+	return g.middlewares
+}
@@ -0,0 +1,55 @@
+package notification
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/notification/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type GetPreferencesEndpoint struct {
+	handler ports.QueryHandler[query.GetPreferencesQuery, notification.Preferences]
+}
+
+func NewGetPreferencesEndpoint(
+	handler ports.QueryHandler[query.GetPreferencesQuery, notification.Preferences]) *GetPreferencesEndpoint {
+	return &GetPreferencesEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Get notification preferences
+//	@Description	Returns the email reminders of the authenticated account, the defaults when it never saved any
+//	@Tags			notifications
+//	@Produce		json
+//	@Success		200	{object}	dto.NotificationPreferencesModel
+//	@Failure		401	{object}	HttpErrorResponse	"Unauthorized - Invalid user authentication"
+//	@Failure		500	{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/notifications/preferences [get]
+func (e GetPreferencesEndpoint) Handle(c *gin.Context) {
+	r := e.handler.Handle(c, query.GetPreferencesQuery{})
+	FromResult(c, r, WithMapping[notification.Preferences](func(preferences notification.Preferences) any {
+		return dto.NewNotificationPreferencesModel(preferences)
+	}))
+}
+
+func (e GetPreferencesEndpoint) Pattern() []string {
+	return []string{
+		"/preferences",
+	}
+}
+
+func (e GetPreferencesEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetPreferencesEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -0,0 +1,78 @@
+package notification
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/notification/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type UpdatePreferencesEndpoint struct {
+	handler ports.CommandHandler[command.UpdatePreferencesCommand, notification.Preferences]
+}
+
+func NewUpdatePreferencesEndpoint(
+	handler ports.CommandHandler[command.UpdatePreferencesCommand, notification.Preferences]) *UpdatePreferencesEndpoint {
+	return &UpdatePreferencesEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Update notification preferences
+//	@Description	Opts the authenticated account in or out of email reminders and sets their language and lead days
+//	@Tags			notifications
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.UpdateNotificationPreferencesRequest	true	"Notification preferences"
+//	@Success		200		{object}	dto.NotificationPreferencesModel
+//	@Failure		400		{object}	HttpErrorResponse	"Bad Request - Invalid locale or lead days"
+//	@Failure		401		{object}	HttpErrorResponse	"Unauthorized - Invalid user authentication"
+//	@Failure		500		{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/notifications/preferences [put]
+func (e UpdatePreferencesEndpoint) Handle(c *gin.Context) {
+	var model dto.UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	locale, err := notification.ParseLocale(model.Locale)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.UpdatePreferencesCommand{
+		Enabled:                      model.Enabled,
+		Locale:                       locale,
+		RenewalLeadDays:              model.RenewalLeadDays,
+		FreeTrialEndLeadDays:         model.FreeTrialEndLeadDays,
+		CancellationDeadlineLeadDays: model.CancellationDeadlineLeadDays,
+	}
+
+	r := e.handler.Handle(c, cmd)
+	FromResult(c, r, WithMapping[notification.Preferences](func(preferences notification.Preferences) any {
+		return dto.NewNotificationPreferencesModel(preferences)
+	}))
+}
+
+func (e UpdatePreferencesEndpoint) Pattern() []string {
+	return []string{
+		"/preferences",
+	}
+}
+
+func (e UpdatePreferencesEndpoint) Method() string {
+	return http.MethodPut
+}
+
+func (e UpdatePreferencesEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -6,10 +6,10 @@ import "github.com/swaggo/swag/v2"
 
 const docTemplate = `{
     "schemes": {{ marshal .Schemes }},
-    "components": {"schemas":{"dto.AmountModel":{"description":"@Description Custom price for this subscription","properties":{"currency":{"example":"USD","type":"string"},"source":{"$ref":"#/components/schemas/dto.AmountModel"},"value":{"example":100,"type":"number"}},"required":["currency","value"],"type":"object"},"dto.CreateFamilyMemberRequest":{"properties":{"created_at":{"format":"date-time","type":"string"},"id":{"type":"string"},"name":{"type":"string"},"type":{"enum":["owner","adult","kid"],"type":"string"}},"required":["name","type"],"type":"object"},"dto.CreateFamilyRequest":{"properties":{"created_at":{"format":"date-time","type":"string"},"creator_name":{"type":"string"},"id":{"type":"string"},"name":{"type":"string"}},"required":["creator_name","name"],"type":"object"},"dto.CreateLabelRequest":{"properties":{"color":{"type":"string"},"created_at":{"format":"date-time","type":"string"},"id":{"type":"string"},"name":{"type":"string"},"owner":{"enum":["personal","family","system"],"example":"personal","type":"string"}},"required":["color","name","owner"],"type":"object"},"dto.CreateProviderRequest":{"properties":{"created_at":{"format":"date-time","type":"string"},"description":{"type":"string"},"icon_url":{"type":"string"},"id":{"type":"string"},"labels":{"items":{"type":"string"},"type":"array","uniqueItems":false},"name":{"type":"string"},"owner":{"enum":["personal","family","system"],"example":"personal","type":"string"},"pricing_page_url":{"type":"string"},"url":{"type":"string"}},"required":["name","owner"],"type":"object"},"dto.CreateSubscriptionRequest":{"properties":{"created_at":{"type":"string"},"custom_recurrency":{"type":"integer"},"end_date":{"format":"date-time","type":"string"},"family_users":{"items":{"type":"string"},"type":"array","uniqueItems":false},"free_trial":{"$ref":"#/components/schemas/dto.SubscriptionFreeTrialModel"},"friendly_name":{"type":"string"},"id":{"type":"string"},"labels":{"items":{"type":"string"},"type":"array","uniqueItems":false},"owner":{"enum":["personal","family","system"],"example":"personal","type":"string"},"payer":{"$ref":"#/components/schemas/dto.EditableSubscriptionPayerModel"},"price":{"$ref":"#/components/schemas/dto.AmountModel"},"provider_id":{"type":"string"},"provider_key":{"type":"string"},"recurrency":{"type":"string"},"start_date":{"format":"date-time","type":"string"}},"required":["owner","recurrency","start_date"],"type":"object"},"dto.CurrencyRateModel":{"properties":{"currency":{"type":"string"},"rate":{"type":"number"}},"required":["currency","rate"],"type":"object"},"dto.CurrencyRatesModel":{"properties":{"rates":{"items":{"$ref":"#/components/schemas/dto.CurrencyRateModel"},"type":"array","uniqueItems":false},"timestamp":{"format":"date-time","type":"string"}},"required":["rates","timestamp"],"type":"object"},"dto.EditableSubscriptionPayerModel":{"description":"Subscription payer object used for updating who pays for a subscription","properties":{"memberId":{"description":"@Description LabelID of the specific family member who pays (required when type is family_member)","example":"123e4567-e89b-12d3-a456-426614174001","type":"string"},"type":{"description":"@Description Type of payer (family or family member)","enum":["family","family_member"],"example":"family_member","type":"string"}},"required":["type"],"type":"object"},"dto.FamilyAcceptInvitationRequest":{"properties":{"family_member_id":{"description":"LabelID of the family member accepting the invitation","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"invitation_code":{"description":"Code received in the invitation","example":"123456","type":"string"}},"required":["family_member_id","invitation_code"],"type":"object"},"dto.FamilyDeclineInvitationRequest":{"properties":{"family_member_id":{"description":"LabelID of the family member accepting the invitation","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"invitation_code":{"description":"Code received in the invitation","example":"123456","type":"string"}},"required":["family_member_id","invitation_code"],"type":"object"},"dto.FamilyInviteRequest":{"properties":{"email":{"description":"Email of the invited member","type":"string"},"family_member_id":{"description":"LabelID of the family member to be invited","type":"string"},"name":{"description":"Name of the invited member","type":"string"},"type":{"description":"Type of the member (adult or kid)","enum":["adult","kid"],"type":"string"}},"required":["family_member_id"],"type":"object"},"dto.FamilyInviteResponse":{"properties":{"code":{"example":"123456","type":"string"},"family_id":{"example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"family_member_id":{"example":"123e4567-e89b-12d3-a456-426614174001","type":"string"}},"required":["code","family_id","family_member_id"],"type":"object"},"dto.FamilyMemberModel":{"description":"Family member object containing member information","properties":{"created_at":{"description":"@Description Timestamp when the member was created","format":"date-time","type":"string"},"etag":{"description":"@Description Entity tag for optimistic concurrency control","example":"W/\"123456789\"","type":"string"},"family_id":{"description":"@Description LabelID of the family this member belongs to","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"has_account":{"description":"@Description Indicates whether this member has an account with the service provider","example":true,"type":"boolean"},"id":{"description":"@Description Unique identifier for the family member","example":"123e4567-e89b-12d3-a456-426614174001","type":"string"},"is_you":{"description":"@Description Indicates whether this member is the current authenticated user","example":false,"type":"boolean"},"name":{"description":"@Description Name of the family member","example":"John Smith","type":"string"},"type":{"description":"@Description Whether this member is a child (affects permissions and features)","enum":["owner","adult","kid"],"type":"string"},"updated_at":{"description":"@Description Timestamp when the member was last updated","format":"date-time","type":"string"}},"required":["created_at","etag","family_id","has_account","id","is_you","name","type","updated_at"],"type":"object"},"dto.FamilyModel":{"description":"Family details","properties":{"created_at":{"description":"@Description ISO 8601 timestamp indicating when the family was originally created","example":"2023-01-15T10:30:00Z","format":"date-time","type":"string"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"id":{"description":"@Description Unique identifier for the family (UUID format)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"is_owner":{"description":"@Description Indicates whether the current authenticated user is the owner of this family","example":true,"type":"boolean"},"members":{"description":"@Description Complete list of all members belonging to this family","items":{"$ref":"#/components/schemas/dto.FamilyMemberModel"},"type":"array","uniqueItems":false},"name":{"description":"@Description Display name of the family","example":"Smith Family","maxLength":255,"minLength":1,"type":"string"},"updated_at":{"description":"@Description ISO 8601 timestamp indicating when the family information was last modified","example":"2023-01-20T14:45:30Z","format":"date-time","type":"string"}},"required":["created_at","etag","id","is_owner","members","name","updated_at"],"type":"object"},"dto.FamilySeeInvitationResponse":{"properties":{"family":{"$ref":"#/components/schemas/dto.FamilyModel"},"invited_inasmuch_as":{"description":"Role of the invited member","example":"OWNER","type":"string"}},"type":"object"},"dto.LabelModel":{"properties":{"color":{"description":"@Description Hexadecimal color code for visual representation of the label","example":"#FF5733","pattern":"^#[0-9A-Fa-f]{6}$","type":"string"},"created_at":{"description":"@Description ISO 8601 timestamp indicating when the label was originally created","example":"2023-01-15T10:30:00Z","format":"date-time","type":"string"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"id":{"description":"@Description Unique identifier for the label (UUID format)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"key":{"type":"string"},"name":{"description":"@Description Display name of the label","example":"Entertainment","maxLength":100,"minLength":1,"type":"string"},"owner":{"$ref":"#/components/schemas/dto.OwnerModel"},"updated_at":{"description":"@Description ISO 8601 timestamp indicating when the label was last modified","example":"2023-01-20T14:45:30Z","format":"date-time","type":"string"}},"required":["color","created_at","etag","id","name","owner","updated_at"],"type":"object"},"dto.LabelRefModel":{"properties":{"label_id":{"example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"source":{"enum":["subscription","provider"],"example":"subscription","type":"string"}},"required":["label_id","source"],"type":"object"},"dto.OwnerModel":{"description":"@Description Ownership information specifying whether this subscription belongs to a user or family","properties":{"etag":{"description":"@Description Entity tag for optimistic concurrency control","example":"W/\"123456789\"","type":"string"},"family_id":{"description":"@Description Family LabelID when an ownership type is family (required for family ownership)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"type":{"description":"@Description Type of ownership (personal, family or system)","enum":["personal","family","system"],"example":"personal","type":"string"},"userId":{"description":"@Description UserProfile LabelID when an ownership type is personal (required for personal ownership)","example":"123e4567-e89b-12d3-a456-426614174001","type":"string"}},"required":["etag","type"],"type":"object"},"dto.PaginatedResponseModel-ProviderModel":{"properties":{"data":{"description":"Data contains the list of items for the current page","items":{"$ref":"#/components/schemas/dto.ProviderModel"},"type":"array","uniqueItems":false},"length":{"description":"Length represents the number of items in the current page","type":"integer"},"total":{"description":"Total represents the total number of items available","type":"integer"}},"required":["data","length","total"],"type":"object"},"dto.PaginatedResponseModel-SubscriptionModel":{"properties":{"data":{"description":"Data contains the list of items for the current page","items":{"$ref":"#/components/schemas/dto.SubscriptionModel"},"type":"array","uniqueItems":false},"length":{"description":"Length represents the number of items in the current page","type":"integer"},"total":{"description":"Total represents the total number of items available","type":"integer"}},"required":["data","length","total"],"type":"object"},"dto.PaginatedResponseModel-dto_LabelModel":{"properties":{"data":{"description":"Data contains the list of items for the current page","items":{"$ref":"#/components/schemas/dto.LabelModel"},"type":"array","uniqueItems":false},"length":{"description":"Length represents the number of items in the current page","type":"integer"},"total":{"description":"Total represents the total number of items available","type":"integer"}},"required":["data","length","total"],"type":"object"},"dto.ProviderModel":{"description":"Provider object containing information about a subscription service provider and their available plans","properties":{"created_at":{"description":"@Description ISO 8601 timestamp when the provider was originally created","example":"2023-01-15T10:30:00Z","format":"date-time","type":"string"},"description":{"description":"@Description Optional detailed description of the provider and their services","example":"Streaming service offering movies and TV shows","type":"string"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"icon_url":{"description":"@Description Optional URL to the provider's icon or logo image","example":"https://example.com/netflix-icon.png","type":"string"},"id":{"description":"@Description Unique identifier for the provider (UUID format)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"key":{"example":"netflix","maxLength":255,"minLength":1,"type":"string"},"labels":{"description":"@Description List of label IDs associated with this provider for categorization","example":["123e4567-e89b-12d3-a456-426614174001","123e4567-e89b-12d3-a456-426614174002"],"items":{"type":"string"},"type":"array","uniqueItems":false},"name":{"description":"@Description Display name of the service provider","example":"Netflix","maxLength":255,"minLength":1,"type":"string"},"owner":{"$ref":"#/components/schemas/dto.OwnerModel"},"pricing_page_url":{"description":"@Description Optional URL to the provider's pricing information page","example":"https://netflix.com/pricing","type":"string"},"updated_at":{"description":"@Description ISO 8601 timestamp when the provider was last modified","example":"2023-01-20T14:45:30Z","format":"date-time","type":"string"},"url":{"description":"@Description Optional URL to the provider's main website","example":"https://netflix.com","type":"string"}},"required":["created_at","etag","id","key","labels","name","owner","updated_at"],"type":"object"},"dto.QuotaUsageModel":{"properties":{"enabled":{"example":true,"type":"boolean"},"feature":{"enum":["unknown","subscriptions","active_subscriptions_count","custom_labels","custom_labels_count","custom_providers","custom_providers_count","family","family_members_count"],"type":"string"},"limit":{"type":"integer"},"remaining":{"type":"integer"},"type":{"enum":["boolean","quota","unknown"],"type":"string"},"used":{"type":"integer"}},"type":"object"},"dto.SubscriptionFreeTrialModel":{"description":"@Description Number of free trial days remaining (null if no trial or trial expired)","properties":{"end_date":{"format":"date-time","type":"string"},"start_date":{"format":"date-time","type":"string"}},"required":["end_date","start_date"],"type":"object"},"dto.SubscriptionModel":{"description":"Subscription object containing all information about an active subscription including billing and usage details","properties":{"created_at":{"description":"@Description ISO 8601 timestamp when the subscription was originally created","example":"2023-01-15T10:30:00Z","format":"date-time","type":"string"},"custom_recurrency":{"description":"@Description CustomRecurrency recurrency interval in days (required when recurrency is custom)","example":90,"maximum":3650,"minimum":1,"type":"integer"},"end_date":{"description":"@Description ISO 8601 timestamp when the subscription expires (null for ongoing subscriptions)","example":"2024-01-01T00:00:00Z","format":"date-time","type":"string"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"family_users":{"description":"@Description List of family member IDs who use this service (for shared subscriptions)","example":["123e4567-e89b-12d3-a456-426614174005","123e4567-e89b-12d3-a456-426614174006"],"items":{"type":"string"},"type":"array","uniqueItems":false},"free_trial":{"$ref":"#/components/schemas/dto.SubscriptionFreeTrialModel"},"friendly_name":{"description":"@Description Optional custom name for easy identification of the subscription","example":"Netflix Family Account","maxLength":255,"type":"string"},"id":{"description":"@Description Unique identifier for the subscription (UUID format)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"is_active":{"description":"@Description Indicates whether the subscription is currently active or not","example":true,"type":"boolean"},"label_refs":{"description":"@Description List of labels associated with this subscription","items":{"$ref":"#/components/schemas/dto.LabelRefModel"},"type":"array","uniqueItems":false},"owner":{"$ref":"#/components/schemas/dto.OwnerModel"},"payer":{"$ref":"#/components/schemas/dto.SubscriptionPayerModel"},"price":{"$ref":"#/components/schemas/dto.AmountModel"},"provider_id":{"description":"@Description LabelID of the service provider offering this subscription","example":"123e4567-e89b-12d3-a456-426614174002","type":"string"},"recurrency":{"description":"@Description Billing recurrency pattern (monthly, yearly, custom, etc.)","enum":["unknown","one_time","monthly","quarterly","half_yearly","yearly","custom"],"example":"monthly","type":"string"},"start_date":{"description":"@Description ISO 8601 timestamp when the subscription becomes active","example":"2023-01-01T00:00:00Z","format":"date-time","type":"string"},"updated_at":{"description":"@Description ISO 8601 timestamp when the subscription was last modified","example":"2023-01-20T14:45:30Z","format":"date-time","type":"string"}},"required":["created_at","etag","id","is_active","owner","provider_id","recurrency","start_date","updated_at"],"type":"object"},"dto.SubscriptionPayerModel":{"description":"@Description Information about who pays for this subscription within the family","properties":{"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"memberId":{"description":"@Description LabelID of the specific family member who pays (required when type is family_member)","example":"123e4567-e89b-12d3-a456-426614174001","type":"string"},"type":{"description":"@Description Type of payer (family or family member)","enum":["family","family_member"],"example":"family_member","type":"string"}},"required":["etag","type"],"type":"object"},"dto.SubscriptionSummaryResponse":{"properties":{"active":{"example":10,"type":"integer"},"active_family":{"example":5,"type":"integer"},"active_personal":{"example":5,"type":"integer"},"family_last_month":{"$ref":"#/components/schemas/dto.AmountModel"},"family_last_year":{"$ref":"#/components/schemas/dto.AmountModel"},"family_monthly":{"$ref":"#/components/schemas/dto.AmountModel"},"family_yearly":{"$ref":"#/components/schemas/dto.AmountModel"},"personal_last_month":{"$ref":"#/components/schemas/dto.AmountModel"},"personal_last_year":{"$ref":"#/components/schemas/dto.AmountModel"},"personal_monthly":{"$ref":"#/components/schemas/dto.AmountModel"},"personal_yearly":{"$ref":"#/components/schemas/dto.AmountModel"},"top_labels":{"items":{"$ref":"#/components/schemas/dto.SubscriptionSummaryTopLabelResponse"},"type":"array","uniqueItems":false},"top_providers":{"items":{"$ref":"#/components/schemas/dto.SubscriptionSummaryTopProviderResponse"},"type":"array","uniqueItems":false},"total_last_month":{"$ref":"#/components/schemas/dto.AmountModel"},"total_last_year":{"$ref":"#/components/schemas/dto.AmountModel"},"total_monthly":{"$ref":"#/components/schemas/dto.AmountModel"},"total_yearly":{"$ref":"#/components/schemas/dto.AmountModel"},"upcoming_renewals":{"items":{"$ref":"#/components/schemas/dto.SubscriptionSummaryUpcomingRenewalResponse"},"type":"array","uniqueItems":false}},"type":"object"},"dto.SubscriptionSummaryTopLabelResponse":{"properties":{"label_id":{"type":"string"},"total":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["label_id"],"type":"object"},"dto.SubscriptionSummaryTopProviderResponse":{"properties":{"duration":{"type":"string"},"provider_id":{"type":"string"},"total":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["provider_id"],"type":"object"},"dto.SubscriptionSummaryUpcomingRenewalResponse":{"properties":{"at":{"format":"date-time","type":"string"},"provider_id":{"type":"string"},"source":{"$ref":"#/components/schemas/dto.AmountModel"},"subscription_id":{"type":"string"},"total":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["at","provider_id","subscription_id"],"type":"object"},"dto.UpdateFamilyMemberRequest":{"properties":{"name":{"type":"string"},"type":{"enum":["owner","adult","kid"],"type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["name","type"],"type":"object"},"dto.UpdateFamilyRequest":{"properties":{"name":{"type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["name"],"type":"object"},"dto.UpdateLabelRequest":{"properties":{"color":{"type":"string"},"name":{"type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["color","name"],"type":"object"},"dto.UpdatePreferredCurrencyRequest":{"properties":{"currency":{"type":"string"}},"required":["currency"],"type":"object"},"dto.UpdateProviderRequest":{"properties":{"description":{"type":"string"},"icon_url":{"type":"string"},"labels":{"items":{"type":"string"},"type":"array","uniqueItems":false},"name":{"type":"string"},"pricing_page_url":{"type":"string"},"updated_at":{"format":"date-time","type":"string"},"url":{"type":"string"}},"required":["labels","name"],"type":"object"},"dto.UpdateSubscriptionRequest":{"properties":{"custom_recurrency":{"type":"integer"},"end_date":{"format":"date-time","type":"string"},"free_trial":{"$ref":"#/components/schemas/dto.SubscriptionFreeTrialModel"},"friendly_name":{"type":"string"},"labels":{"items":{"type":"string"},"type":"array","uniqueItems":false},"owner":{"enum":["personal","family","system"],"example":"personal","type":"string"},"payer":{"$ref":"#/components/schemas/dto.EditableSubscriptionPayerModel"},"price":{"$ref":"#/components/schemas/dto.AmountModel"},"provider_id":{"type":"string"},"provider_key":{"type":"string"},"recurrency":{"type":"string"},"service_users":{"items":{"type":"string"},"type":"array","uniqueItems":false},"start_date":{"format":"date-time","type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["owner","provider_id","recurrency","start_date"],"type":"object"},"dto.UserPreferredCurrencyModel":{"properties":{"currency":{"type":"string"}},"type":"object"},"ginx.HttpErrorResponse":{"description":"RFC7807 Problem Details error response","properties":{"detail":{"example":"Missing required field 'name'","type":"string"},"instance":{"example":"/api/resource/123","type":"string"},"status":{"example":400,"type":"integer"},"title":{"example":"Bad Request","type":"string"},"type":{"example":"about:blank","type":"string"}},"type":"object"}}},
+    "components": {"schemas":{"dto.AddSubscriptionPriceChangeRequest":{"properties":{"effective_from":{"format":"date-time","type":"string"},"price":{"$ref":"#/components/schemas/dto.AmountModel"},"updated_at":{"format":"date-time","type":"string"}},"required":["effective_from","price"],"type":"object"},"dto.AddSubscriptionPromotionRequest":{"properties":{"duration_months":{"example":3,"minimum":1,"type":"integer"},"end_date":{"format":"date-time","type":"string"},"price":{"$ref":"#/components/schemas/dto.AmountModel"},"start_date":{"format":"date-time","type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["price"],"type":"object"},"dto.AmountModel":{"description":"What was spent in the bucket, a charge in several groups is only counted once","properties":{"currency":{"example":"USD","type":"string"},"rate_source":{"description":"@Description Kind of rate the amount was converted from its source with, only set for a converted amount","enum":["market","manual","subscription"],"example":"market","type":"string"},"source":{"$ref":"#/components/schemas/dto.AmountModel"},"value":{"example":100,"type":"number"}},"required":["currency","value"],"type":"object"},"dto.BudgetModel":{"description":"@Description Budget the status is computed for","properties":{"created_at":{"description":"@Description ISO 8601 timestamp indicating when the budget was originally created","example":"2023-01-15T10:30:00Z","format":"date-time","type":"string"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"id":{"description":"@Description Unique identifier for the budget (UUID format)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"label_id":{"description":"@Description Label whose subscriptions are counted, only set for the label scope","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"limit":{"$ref":"#/components/schemas/dto.AmountModel"},"name":{"description":"@Description Display name of the budget","example":"Streaming","maxLength":100,"minLength":1,"type":"string"},"owner":{"$ref":"#/components/schemas/dto.OwnerModel"},"period":{"description":"@Description Period the limit applies to","enum":["monthly","yearly"],"example":"monthly","type":"string"},"scope":{"description":"@Description Subscriptions counted against the budget: the ones having a label, the ones of the family or the personal ones","enum":["label","family","account"],"example":"label","type":"string"},"updated_at":{"description":"@Description ISO 8601 timestamp indicating when the budget was last modified","example":"2023-01-20T14:45:30Z","format":"date-time","type":"string"}},"required":["created_at","etag","id","limit","name","owner","period","scope","updated_at"],"type":"object"},"dto.BudgetStatusModel":{"description":"Cost of the active subscriptions covered by a budget compared with its limit","properties":{"budget":{"$ref":"#/components/schemas/dto.BudgetModel"},"consumed":{"$ref":"#/components/schemas/dto.AmountModel"},"consumed_percentage":{"description":"@Description Share of the limit consumed, above 100 when the budget is exceeded","example":85.5,"type":"number"},"exceeded":{"description":"@Description Whether the covered subscriptions cost more than the limit","example":false,"type":"boolean"},"projected_overrun":{"$ref":"#/components/schemas/dto.AmountModel"},"remaining":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["budget","consumed","consumed_percentage","exceeded","projected_overrun","remaining"],"type":"object"},"dto.CalendarFeedModel":{"properties":{"path":{"description":"Path of the iCalendar feed to subscribe to, relative to the API base URL","example":"/calendar/3q2-7wEj0ZqIzO8TMr5jOv1rOxOQw2dDXy0m5lxq9yY.ics","type":"string"},"token":{"description":"Token is only returned when the feed is created or rotated, keep it secret","type":"string"}},"required":["path","token"],"type":"object"},"dto.ChargeModel":{"description":"Charge of a subscription, the actual amount is set once the charge has been recorded","properties":{"actual_amount":{"$ref":"#/components/schemas/dto.AmountModel"},"amount":{"$ref":"#/components/schemas/dto.AmountModel"},"created_at":{"description":"@Description ISO 8601 timestamp when the charge was created","format":"date-time","type":"string"},"due_date":{"description":"@Description ISO 8601 timestamp of the billing occurrence","example":"2024-01-01T00:00:00Z","format":"date-time","type":"string"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"expected_amount":{"$ref":"#/components/schemas/dto.AmountModel"},"id":{"description":"@Description Unique identifier of the charge","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"status":{"description":"@Description Status of the charge","enum":["expected","paid","failed","refunded","adjusted"],"example":"paid","type":"string"},"subscription_id":{"description":"@Description Subscription billed by this charge","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"updated_at":{"description":"@Description ISO 8601 timestamp when the charge was last updated","format":"date-time","type":"string"}},"required":["amount","created_at","due_date","etag","expected_amount","id","status","subscription_id","updated_at"],"type":"object"},"dto.CreateBudgetRequest":{"properties":{"created_at":{"format":"date-time","type":"string"},"id":{"type":"string"},"label_id":{"type":"string"},"limit":{"$ref":"#/components/schemas/dto.AmountModel"},"name":{"type":"string"},"owner":{"enum":["personal","family"],"example":"personal","type":"string"},"period":{"enum":["monthly","yearly"],"example":"monthly","type":"string"},"scope":{"enum":["label","family","account"],"example":"label","type":"string"}},"required":["limit","name","owner","period","scope"],"type":"object"},"dto.CreateFamilyMemberRequest":{"properties":{"created_at":{"format":"date-time","type":"string"},"id":{"type":"string"},"name":{"type":"string"},"type":{"enum":["owner","adult","kid"],"type":"string"}},"required":["name","type"],"type":"object"},"dto.CreateFamilyRequest":{"properties":{"created_at":{"format":"date-time","type":"string"},"creator_name":{"type":"string"},"id":{"type":"string"},"name":{"type":"string"}},"required":["creator_name","name"],"type":"object"},"dto.CreateLabelRequest":{"properties":{"color":{"type":"string"},"created_at":{"format":"date-time","type":"string"},"id":{"type":"string"},"name":{"type":"string"},"owner":{"enum":["personal","family","system"],"example":"personal","type":"string"}},"required":["color","name","owner"],"type":"object"},"dto.CreateManualRateRequest":{"properties":{"created_at":{"format":"date-time","type":"string"},"from_currency":{"example":"EUR","type":"string"},"id":{"type":"string"},"owner":{"enum":["personal","family"],"example":"personal","type":"string"},"rate":{"example":1.08,"type":"number"},"subscription_id":{"type":"string"},"to_currency":{"example":"USD","type":"string"}},"required":["from_currency","owner","rate","to_currency"],"type":"object"},"dto.CreatePaymentMethodRequest":{"properties":{"created_at":{"format":"date-time","type":"string"},"expiry_month":{"example":3,"type":"integer"},"expiry_year":{"example":2027,"type":"integer"},"id":{"type":"string"},"label":{"type":"string"},"last_four":{"example":"4242","type":"string"},"owner":{"enum":["personal","family"],"example":"personal","type":"string"},"type":{"enum":["card","bank_account","wallet","other"],"example":"card","type":"string"}},"required":["label","owner","type"],"type":"object"},"dto.CreateProviderRequest":{"properties":{"created_at":{"format":"date-time","type":"string"},"description":{"type":"string"},"icon_url":{"type":"string"},"id":{"type":"string"},"labels":{"items":{"type":"string"},"type":"array","uniqueItems":false},"name":{"type":"string"},"owner":{"enum":["personal","family","system"],"example":"personal","type":"string"},"pricing_page_url":{"type":"string"},"url":{"type":"string"}},"required":["name","owner"],"type":"object"},"dto.CreateSubscriptionRequest":{"properties":{"billing_anchor_day":{"maximum":31,"minimum":1,"type":"integer"},"contract":{"$ref":"#/components/schemas/dto.SubscriptionContractModel"},"cost_split":{"$ref":"#/components/schemas/dto.SubscriptionCostSplitModel"},"created_at":{"type":"string"},"custom_recurrency":{"type":"integer"},"custom_recurrency_unit":{"enum":["day","week","month","year"],"type":"string"},"end_date":{"format":"date-time","type":"string"},"family_users":{"items":{"type":"string"},"type":"array","uniqueItems":false},"free_trial":{"$ref":"#/components/schemas/dto.SubscriptionFreeTrialModel"},"friendly_name":{"type":"string"},"id":{"type":"string"},"labels":{"items":{"type":"string"},"type":"array","uniqueItems":false},"owner":{"enum":["personal","family","system"],"example":"personal","type":"string"},"payer":{"$ref":"#/components/schemas/dto.EditableSubscriptionPayerModel"},"payment_method_id":{"type":"string"},"price":{"$ref":"#/components/schemas/dto.AmountModel"},"provider_id":{"type":"string"},"provider_key":{"type":"string"},"recurrency":{"type":"string"},"start_date":{"format":"date-time","type":"string"}},"required":["owner","recurrency","start_date"],"type":"object"},"dto.CreateWebhookEndpointRequest":{"properties":{"event_types":{"description":"Events the endpoint receives, every event when empty","example":["subscription.created","renewal.due"],"items":{"type":"string"},"type":"array","uniqueItems":false},"url":{"description":"Absolute http or https URL the events are posted to","example":"https://example.com/hooks/subtracker","type":"string"}},"required":["url"],"type":"object"},"dto.CurrencyRateModel":{"properties":{"currency":{"type":"string"},"rate":{"type":"number"}},"required":["currency","rate"],"type":"object"},"dto.CurrencyRatesModel":{"properties":{"rates":{"items":{"$ref":"#/components/schemas/dto.CurrencyRateModel"},"type":"array","uniqueItems":false},"timestamp":{"format":"date-time","type":"string"}},"required":["rates","timestamp"],"type":"object"},"dto.EditableSubscriptionPayerModel":{"description":"Subscription payer object used for updating who pays for a subscription","properties":{"memberId":{"description":"@Description LabelID of the specific family member who pays (required when type is family_member)","example":"123e4567-e89b-12d3-a456-426614174001","type":"string"},"type":{"description":"@Description Type of payer (family or family member)","enum":["family","family_member"],"example":"family_member","type":"string"}},"required":["type"],"type":"object"},"dto.ExpiringPaymentMethodSubscriptionModel":{"description":"Subscription that will fail to renew because its payment method expires first","properties":{"next_renewal_date":{"description":"@Description ISO 8601 timestamp of the next renewal of the subscription","example":"2025-04-01T00:00:00Z","format":"date-time","type":"string"},"payment_method":{"$ref":"#/components/schemas/dto.PaymentMethodModel"},"payment_method_expires_at":{"description":"@Description ISO 8601 timestamp from which the payment method can no longer be charged","example":"2025-04-01T00:00:00Z","format":"date-time","type":"string"},"subscription":{"$ref":"#/components/schemas/dto.SubscriptionModel"}},"required":["next_renewal_date","payment_method","payment_method_expires_at","subscription"],"type":"object"},"dto.FamilyAcceptInvitationRequest":{"properties":{"family_member_id":{"description":"LabelID of the family member accepting the invitation","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"invitation_code":{"description":"Code received in the invitation","example":"123456","type":"string"}},"required":["family_member_id","invitation_code"],"type":"object"},"dto.FamilyDeclineInvitationRequest":{"properties":{"family_member_id":{"description":"LabelID of the family member accepting the invitation","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"invitation_code":{"description":"Code received in the invitation","example":"123456","type":"string"}},"required":["family_member_id","invitation_code"],"type":"object"},"dto.FamilyInviteRequest":{"properties":{"email":{"description":"Email of the invited member","type":"string"},"family_member_id":{"description":"LabelID of the family member to be invited","type":"string"},"name":{"description":"Name of the invited member","type":"string"},"type":{"description":"Type of the member (adult or kid)","enum":["adult","kid"],"type":"string"}},"required":["family_member_id"],"type":"object"},"dto.FamilyInviteResponse":{"properties":{"code":{"example":"123456","type":"string"},"family_id":{"example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"family_member_id":{"example":"123e4567-e89b-12d3-a456-426614174001","type":"string"}},"required":["code","family_id","family_member_id"],"type":"object"},"dto.FamilyLedgerModel":{"description":"Balances accrued from the shares of charges paid by another member and from settlements, with the transfers to settle everything","properties":{"balances":{"description":"@Description Net balance of each family member","items":{"$ref":"#/components/schemas/dto.MemberBalanceModel"},"type":"array","uniqueItems":false},"family_id":{"description":"@Description ID of the family","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"settlements":{"description":"@Description Settlements recorded for the family","items":{"$ref":"#/components/schemas/dto.SettlementModel"},"type":"array","uniqueItems":false},"transfers":{"description":"@Description Minimal list of transfers that settles every balance","items":{"$ref":"#/components/schemas/dto.TransferModel"},"type":"array","uniqueItems":false}},"required":["balances","family_id","settlements","transfers"],"type":"object"},"dto.FamilyMemberModel":{"description":"Family member object containing member information","properties":{"created_at":{"description":"@Description Timestamp when the member was created","format":"date-time","type":"string"},"etag":{"description":"@Description Entity tag for optimistic concurrency control","example":"W/\"123456789\"","type":"string"},"family_id":{"description":"@Description LabelID of the family this member belongs to","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"has_account":{"description":"@Description Indicates whether this member has an account with the service provider","example":true,"type":"boolean"},"id":{"description":"@Description Unique identifier for the family member","example":"123e4567-e89b-12d3-a456-426614174001","type":"string"},"is_you":{"description":"@Description Indicates whether this member is the current authenticated user","example":false,"type":"boolean"},"name":{"description":"@Description Name of the family member","example":"John Smith","type":"string"},"type":{"description":"@Description Whether this member is a child (affects permissions and features)","enum":["owner","adult","kid"],"type":"string"},"updated_at":{"description":"@Description Timestamp when the member was last updated","format":"date-time","type":"string"}},"required":["created_at","etag","family_id","has_account","id","is_you","name","type","updated_at"],"type":"object"},"dto.FamilyMemberShareModel":{"description":"Share of a family member, converted to the preferred currency of the viewer","properties":{"member_id":{"description":"@Description ID of the family member","example":"123e4567-e89b-12d3-a456-426614174001","type":"string"},"monthly":{"$ref":"#/components/schemas/dto.AmountModel"},"name":{"description":"@Description Name of the family member","example":"John Doe","type":"string"},"yearly":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["member_id","monthly","name","yearly"],"type":"object"},"dto.FamilyModel":{"description":"Family details","properties":{"created_at":{"description":"@Description ISO 8601 timestamp indicating when the family was originally created","example":"2023-01-15T10:30:00Z","format":"date-time","type":"string"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"id":{"description":"@Description Unique identifier for the family (UUID format)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"is_owner":{"description":"@Description Indicates whether the current authenticated user is the owner of this family","example":true,"type":"boolean"},"members":{"description":"@Description Complete list of all members belonging to this family","items":{"$ref":"#/components/schemas/dto.FamilyMemberModel"},"type":"array","uniqueItems":false},"name":{"description":"@Description Display name of the family","example":"Smith Family","maxLength":255,"minLength":1,"type":"string"},"updated_at":{"description":"@Description ISO 8601 timestamp indicating when the family information was last modified","example":"2023-01-20T14:45:30Z","format":"date-time","type":"string"}},"required":["created_at","etag","id","is_owner","members","name","updated_at"],"type":"object"},"dto.FamilySeeInvitationResponse":{"properties":{"family":{"$ref":"#/components/schemas/dto.FamilyModel"},"invited_inasmuch_as":{"description":"Role of the invited member","example":"OWNER","type":"string"}},"type":"object"},"dto.FamilySharesModel":{"description":"Monthly and yearly share of each family member for the active family subscriptions","properties":{"family_id":{"description":"@Description ID of the family","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"members":{"description":"@Description Share of each family member","items":{"$ref":"#/components/schemas/dto.FamilyMemberShareModel"},"type":"array","uniqueItems":false},"total_monthly":{"$ref":"#/components/schemas/dto.AmountModel"},"total_yearly":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["family_id","members","total_monthly","total_yearly"],"type":"object"},"dto.LabelModel":{"properties":{"color":{"description":"@Description Hexadecimal color code for visual representation of the label","example":"#FF5733","pattern":"^#[0-9A-Fa-f]{6}$","type":"string"},"created_at":{"description":"@Description ISO 8601 timestamp indicating when the label was originally created","example":"2023-01-15T10:30:00Z","format":"date-time","type":"string"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"id":{"description":"@Description Unique identifier for the label (UUID format)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"key":{"type":"string"},"name":{"description":"@Description Display name of the label","example":"Entertainment","maxLength":100,"minLength":1,"type":"string"},"owner":{"$ref":"#/components/schemas/dto.OwnerModel"},"updated_at":{"description":"@Description ISO 8601 timestamp indicating when the label was last modified","example":"2023-01-20T14:45:30Z","format":"date-time","type":"string"}},"required":["color","created_at","etag","id","name","owner","updated_at"],"type":"object"},"dto.LabelRefModel":{"properties":{"label_id":{"example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"source":{"enum":["subscription","provider"],"example":"subscription","type":"string"}},"required":["label_id","source"],"type":"object"},"dto.ManualRateModel":{"description":"Exchange rate defined by an account or a family, used instead of the market rates for a currency pair","properties":{"created_at":{"description":"@Description ISO 8601 timestamp indicating when the rate was originally created","example":"2023-01-15T10:30:00Z","format":"date-time","type":"string"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"from_currency":{"description":"@Description Currency converted from, the rate is also used in reverse","example":"EUR","type":"string"},"id":{"description":"@Description Unique identifier for the manual rate (UUID format)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"owner":{"$ref":"#/components/schemas/dto.OwnerModel"},"rate":{"description":"@Description Amount of the target currency for one unit of the source currency","example":1.08,"type":"number"},"subscription_id":{"description":"@Description Only subscription the rate applies to, the rate applies to every subscription when missing","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"to_currency":{"description":"@Description Currency converted to","example":"USD","type":"string"},"updated_at":{"description":"@Description ISO 8601 timestamp indicating when the rate was last modified","example":"2023-01-20T14:45:30Z","format":"date-time","type":"string"}},"required":["created_at","etag","from_currency","id","owner","rate","to_currency","updated_at"],"type":"object"},"dto.MemberBalanceModel":{"description":"Net balance of a member, positive when the member is owed money and negative when the member owes money","properties":{"balance":{"$ref":"#/components/schemas/dto.AmountModel"},"member_id":{"description":"@Description ID of the family member","example":"123e4567-e89b-12d3-a456-426614174001","type":"string"},"name":{"description":"@Description Name of the family member","example":"John Doe","type":"string"}},"required":["balance","member_id","name"],"type":"object"},"dto.NotificationPreferencesModel":{"description":"Email reminders the account opted in to, sent a number of lead days before each event","properties":{"cancellation_deadline_lead_days":{"description":"@Description Days before the last day to cancel a subscription its reminder is sent","example":7,"type":"integer"},"enabled":{"description":"@Description Whether reminders are emailed, reminders are opt-in","example":true,"type":"boolean"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"free_trial_end_lead_days":{"description":"@Description Days before the end of a free trial its reminder is sent","example":3,"type":"integer"},"locale":{"description":"@Description Language of the reminders","enum":["en","fr"],"example":"en","type":"string"},"renewal_lead_days":{"description":"@Description Days before a renewal its reminder is sent, 0 sends it on the day","example":3,"type":"integer"},"updated_at":{"description":"@Description ISO 8601 timestamp indicating when the preferences were last updated","example":"2025-01-15T00:00:00Z","format":"date-time","type":"string"}},"required":["cancellation_deadline_lead_days","enabled","etag","free_trial_end_lead_days","locale","renewal_lead_days","updated_at"],"type":"object"},"dto.OwnerModel":{"description":"@Description Ownership information specifying whether this provider belongs to a user or family","properties":{"etag":{"description":"@Description Entity tag for optimistic concurrency control","example":"W/\"123456789\"","type":"string"},"family_id":{"description":"@Description Family LabelID when an ownership type is family (required for family ownership)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"type":{"description":"@Description Type of ownership (personal, family or system)","enum":["personal","family","system"],"example":"personal","type":"string"},"userId":{"description":"@Description UserProfile LabelID when an ownership type is personal (required for personal ownership)","example":"123e4567-e89b-12d3-a456-426614174001","type":"string"}},"required":["etag","type"],"type":"object"},"dto.PaginatedResponseModel-ProviderModel":{"properties":{"data":{"description":"Data contains the list of items for the current page","items":{"$ref":"#/components/schemas/dto.ProviderModel"},"type":"array","uniqueItems":false},"length":{"description":"Length represents the number of items in the current page","type":"integer"},"total":{"description":"Total represents the total number of items available","type":"integer"}},"required":["data","length","total"],"type":"object"},"dto.PaginatedResponseModel-SubscriptionModel":{"properties":{"data":{"description":"Data contains the list of items for the current page","items":{"$ref":"#/components/schemas/dto.SubscriptionModel"},"type":"array","uniqueItems":false},"length":{"description":"Length represents the number of items in the current page","type":"integer"},"total":{"description":"Total represents the total number of items available","type":"integer"}},"required":["data","length","total"],"type":"object"},"dto.PaginatedResponseModel-dto_BudgetModel":{"properties":{"data":{"description":"Data contains the list of items for the current page","items":{"$ref":"#/components/schemas/dto.BudgetModel"},"type":"array","uniqueItems":false},"length":{"description":"Length represents the number of items in the current page","type":"integer"},"total":{"description":"Total represents the total number of items available","type":"integer"}},"required":["data","length","total"],"type":"object"},"dto.PaginatedResponseModel-dto_BudgetStatusModel":{"properties":{"data":{"description":"Data contains the list of items for the current page","items":{"$ref":"#/components/schemas/dto.BudgetStatusModel"},"type":"array","uniqueItems":false},"length":{"description":"Length represents the number of items in the current page","type":"integer"},"total":{"description":"Total represents the total number of items available","type":"integer"}},"required":["data","length","total"],"type":"object"},"dto.PaginatedResponseModel-dto_LabelModel":{"properties":{"data":{"description":"Data contains the list of items for the current page","items":{"$ref":"#/components/schemas/dto.LabelModel"},"type":"array","uniqueItems":false},"length":{"description":"Length represents the number of items in the current page","type":"integer"},"total":{"description":"Total represents the total number of items available","type":"integer"}},"required":["data","length","total"],"type":"object"},"dto.PaginatedResponseModel-dto_ManualRateModel":{"properties":{"data":{"description":"Data contains the list of items for the current page","items":{"$ref":"#/components/schemas/dto.ManualRateModel"},"type":"array","uniqueItems":false},"length":{"description":"Length represents the number of items in the current page","type":"integer"},"total":{"description":"Total represents the total number of items available","type":"integer"}},"required":["data","length","total"],"type":"object"},"dto.PaginatedResponseModel-dto_PaymentMethodModel":{"properties":{"data":{"description":"Data contains the list of items for the current page","items":{"$ref":"#/components/schemas/dto.PaymentMethodModel"},"type":"array","uniqueItems":false},"length":{"description":"Length represents the number of items in the current page","type":"integer"},"total":{"description":"Total represents the total number of items available","type":"integer"}},"required":["data","length","total"],"type":"object"},"dto.PaginatedResponseModel-dto_WebhookDeliveryModel":{"properties":{"data":{"description":"Data contains the list of items for the current page","items":{"$ref":"#/components/schemas/dto.WebhookDeliveryModel"},"type":"array","uniqueItems":false},"length":{"description":"Length represents the number of items in the current page","type":"integer"},"total":{"description":"Total represents the total number of items available","type":"integer"}},"required":["data","length","total"],"type":"object"},"dto.PauseSubscriptionRequest":{"properties":{"end_date":{"format":"date-time","type":"string"},"start_date":{"format":"date-time","type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["start_date"],"type":"object"},"dto.PaymentMethodModel":{"description":"@Description Payment method the subscription is charged to","properties":{"created_at":{"description":"@Description ISO 8601 timestamp indicating when the payment method was originally created","example":"2023-01-15T10:30:00Z","format":"date-time","type":"string"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"expiry_month":{"description":"@Description Month of expiry (1-12)","example":3,"maximum":12,"minimum":1,"type":"integer"},"expiry_year":{"description":"@Description Year of expiry","example":2027,"type":"integer"},"id":{"description":"@Description Unique identifier for the payment method (UUID format)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"label":{"description":"@Description Display name of the payment method","example":"Personal Visa","maxLength":100,"minLength":1,"type":"string"},"last_four":{"description":"@Description Last four digits of the card or account number","example":"4242","pattern":"^[0-9]{4}$","type":"string"},"owner":{"$ref":"#/components/schemas/dto.OwnerModel"},"type":{"description":"@Description Kind of payment method","enum":["card","bank_account","wallet","other"],"example":"card","type":"string"},"updated_at":{"description":"@Description ISO 8601 timestamp indicating when the payment method was last modified","example":"2023-01-20T14:45:30Z","format":"date-time","type":"string"}},"required":["created_at","etag","id","label","owner","type","updated_at"],"type":"object"},"dto.ProviderModel":{"description":"Provider object containing information about a subscription service provider and their available plans","properties":{"created_at":{"description":"@Description ISO 8601 timestamp when the provider was originally created","example":"2023-01-15T10:30:00Z","format":"date-time","type":"string"},"description":{"description":"@Description Optional detailed description of the provider and their services","example":"Streaming service offering movies and TV shows","type":"string"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"icon_url":{"description":"@Description Optional URL to the provider's icon or logo image","example":"https://example.com/netflix-icon.png","type":"string"},"id":{"description":"@Description Unique identifier for the provider (UUID format)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"key":{"example":"netflix","maxLength":255,"minLength":1,"type":"string"},"labels":{"description":"@Description List of label IDs associated with this provider for categorization","example":["123e4567-e89b-12d3-a456-426614174001","123e4567-e89b-12d3-a456-426614174002"],"items":{"type":"string"},"type":"array","uniqueItems":false},"name":{"description":"@Description Display name of the service provider","example":"Netflix","maxLength":255,"minLength":1,"type":"string"},"owner":{"$ref":"#/components/schemas/dto.OwnerModel"},"pricing_page_url":{"description":"@Description Optional URL to the provider's pricing information page","example":"https://netflix.com/pricing","type":"string"},"updated_at":{"description":"@Description ISO 8601 timestamp when the provider was last modified","example":"2023-01-20T14:45:30Z","format":"date-time","type":"string"},"url":{"description":"@Description Optional URL to the provider's main website","example":"https://netflix.com","type":"string"}},"required":["created_at","etag","id","key","labels","name","owner","updated_at"],"type":"object"},"dto.QuotaUsageModel":{"properties":{"enabled":{"example":true,"type":"boolean"},"feature":{"enum":["unknown","subscriptions","active_subscriptions_count","custom_labels","custom_labels_count","custom_providers","custom_providers_count","family","family_members_count"],"type":"string"},"limit":{"type":"integer"},"remaining":{"type":"integer"},"type":{"enum":["boolean","quota","unknown"],"type":"string"},"used":{"type":"integer"}},"type":"object"},"dto.RateSyncModel":{"description":"Run of the backfill of the market rates missing over a window of days","properties":{"created_at":{"description":"@Description ISO 8601 timestamp indicating when the synchronization started","example":"2025-01-15T00:00:00Z","format":"date-time","type":"string"},"cursor":{"description":"@Description Last processed day, a synchronization that did not complete resumes on the day after it","example":"2024-06-30T00:00:00Z","format":"date-time","type":"string"},"end_date":{"description":"@Description Last day of the synchronized window","example":"2025-01-15T00:00:00Z","format":"date-time","type":"string"},"filled_days":{"description":"@Description Number of processed days whose missing rates were added","example":12,"type":"integer"},"finished_at":{"description":"@Description ISO 8601 timestamp indicating when the synchronization completed or failed","example":"2025-01-15T00:05:00Z","format":"date-time","type":"string"},"id":{"description":"@Description Unique identifier for the synchronization (UUID format)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"last_error":{"description":"@Description Error the synchronization failed with","example":"missing USD-\u003eNOK rate from external source","type":"string"},"processed_days":{"description":"@Description Number of days already processed","example":168,"type":"integer"},"start_date":{"description":"@Description First day of the synchronized window","example":"2024-01-15T00:00:00Z","format":"date-time","type":"string"},"status":{"description":"@Description State of the synchronization","enum":["running","completed","failed"],"example":"running","type":"string"},"total_days":{"description":"@Description Number of days of the window","example":366,"type":"integer"},"unavailable_days":{"description":"@Description Number of processed days no rate source has rates for","example":2,"type":"integer"},"updated_at":{"description":"@Description ISO 8601 timestamp indicating when the progress was last saved","example":"2025-01-15T00:02:30Z","format":"date-time","type":"string"}},"required":["created_at","end_date","filled_days","id","processed_days","start_date","status","total_days","unavailable_days","updated_at"],"type":"object"},"dto.RecordChargeRequest":{"properties":{"actual_amount":{"$ref":"#/components/schemas/dto.AmountModel"},"status":{"enum":["expected","paid","failed","refunded","adjusted"],"type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["status"],"type":"object"},"dto.RecordSettlementRequest":{"properties":{"amount":{"$ref":"#/components/schemas/dto.AmountModel"},"created_at":{"format":"date-time","type":"string"},"from_member_id":{"type":"string"},"note":{"type":"string"},"settled_at":{"format":"date-time","type":"string"},"to_member_id":{"type":"string"}},"required":["amount","from_member_id","to_member_id"],"type":"object"},"dto.ResumeSubscriptionRequest":{"properties":{"resumed_at":{"format":"date-time","type":"string"},"updated_at":{"format":"date-time","type":"string"}},"type":"object"},"dto.SettlementModel":{"description":"Settlement recorded when a member pays another one back outside the application","properties":{"amount":{"$ref":"#/components/schemas/dto.AmountModel"},"created_at":{"description":"@Description ISO 8601 timestamp when the settlement was created","format":"date-time","type":"string"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"family_id":{"description":"@Description Family of the members","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"from_member_id":{"description":"@Description Member who paid","example":"123e4567-e89b-12d3-a456-426614174001","type":"string"},"id":{"description":"@Description Unique identifier of the settlement","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"note":{"description":"@Description Optional note about the payment","example":"Streaming services for March","type":"string"},"settled_at":{"description":"@Description ISO 8601 timestamp of the payment","example":"2024-01-01T00:00:00Z","format":"date-time","type":"string"},"to_member_id":{"description":"@Description Member who received the payment","example":"123e4567-e89b-12d3-a456-426614174002","type":"string"},"updated_at":{"description":"@Description ISO 8601 timestamp when the settlement was last updated","format":"date-time","type":"string"}},"required":["amount","created_at","etag","family_id","from_member_id","id","settled_at","to_member_id","updated_at"],"type":"object"},"dto.SubscriptionContractModel":{"description":"@Description Contract terms of the subscription (null when the subscription has no commitment)","properties":{"auto_renew":{"description":"@Description Indicates whether the subscription renews automatically at the end of each period","example":true,"type":"boolean"},"minimum_term":{"description":"@Description Minimum commitment in months counted from the start date (null when there is no commitment)","example":12,"minimum":1,"type":"integer"},"notice_period":{"description":"@Description Number of days before a renewal the cancellation must be requested (null when there is no notice period)","example":30,"minimum":0,"type":"integer"}},"type":"object"},"dto.SubscriptionCostShareModel":{"description":"Share of a family member, a percentage or a fixed amount per billing period depending on the split type","properties":{"member_id":{"description":"@Description ID of the family member (UUID format)","example":"123e4567-e89b-12d3-a456-426614174001","type":"string"},"value":{"description":"@Description Percentage for a percentage split, amount in the subscription currency for a fixed split, ignored for an equal split","example":50,"type":"number"}},"required":["member_id"],"type":"object"},"dto.SubscriptionCostSplitModel":{"description":"@Description How the cost is shared between family members (null when it is not shared)","properties":{"shares":{"description":"@Description Members taking part in the split, an equal split without shares uses the family users of the subscription","items":{"$ref":"#/components/schemas/dto.SubscriptionCostShareModel"},"type":"array","uniqueItems":false},"type":{"description":"@Description How the cost is shared","enum":["equal","percentage","fixed"],"example":"percentage","type":"string"}},"required":["type"],"type":"object"},"dto.SubscriptionForecastChargeResponse":{"properties":{"at":{"format":"date-time","type":"string"},"family":{"description":"True when the charge is paid by the family","type":"boolean"},"provider_id":{"type":"string"},"source":{"$ref":"#/components/schemas/dto.AmountModel"},"subscription_id":{"type":"string"},"total":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["at","family","provider_id","source","subscription_id","total"],"type":"object"},"dto.SubscriptionForecastMonthResponse":{"properties":{"charges":{"items":{"$ref":"#/components/schemas/dto.SubscriptionForecastChargeResponse"},"type":"array","uniqueItems":false},"family":{"$ref":"#/components/schemas/dto.AmountModel"},"month":{"description":"First day of the month in the account timezone","format":"date-time","type":"string"},"personal":{"$ref":"#/components/schemas/dto.AmountModel"},"total":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["charges","family","month","personal","total"],"type":"object"},"dto.SubscriptionForecastResponse":{"properties":{"family":{"$ref":"#/components/schemas/dto.AmountModel"},"months":{"items":{"$ref":"#/components/schemas/dto.SubscriptionForecastMonthResponse"},"type":"array","uniqueItems":false},"personal":{"$ref":"#/components/schemas/dto.AmountModel"},"total":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["family","months","personal","total"],"type":"object"},"dto.SubscriptionFreeTrialModel":{"description":"@Description Number of free trial days remaining (null if no trial or trial expired)","properties":{"end_date":{"format":"date-time","type":"string"},"start_date":{"format":"date-time","type":"string"}},"required":["end_date","start_date"],"type":"object"},"dto.SubscriptionImportResponse":{"properties":{"created":{"example":8,"type":"integer"},"dry_run":{"type":"boolean"},"failed":{"example":2,"type":"integer"},"rows":{"items":{"$ref":"#/components/schemas/dto.SubscriptionImportRowResponse"},"type":"array","uniqueItems":false},"total":{"example":10,"type":"integer"},"valid":{"example":0,"type":"integer"}},"type":"object"},"dto.SubscriptionImportRowErrorResponse":{"properties":{"field":{"example":"customRecurrency","type":"string"},"message":{"example":"CustomRecurrency is required when Recurrency is CustomRecurrency","type":"string"}},"required":["message"],"type":"object"},"dto.SubscriptionImportRowResponse":{"properties":{"errors":{"items":{"$ref":"#/components/schemas/dto.SubscriptionImportRowErrorResponse"},"type":"array","uniqueItems":false},"index":{"example":0,"type":"integer"},"status":{"enum":["created","valid","failed"],"type":"string"},"subscription_id":{"type":"string"}},"required":["index","status"],"type":"object"},"dto.SubscriptionModel":{"description":"@Description Subscription affected by the expiry","properties":{"billing_anchor_day":{"description":"@Description Day of the month monthly and yearly renewals fall on, clamped to the last day of shorter months (null to follow the start date)","example":31,"maximum":31,"minimum":1,"type":"integer"},"cancellation_deadline":{"description":"@Description ISO 8601 timestamp of the last day the subscription can be cancelled before its next renewal","example":"2024-01-01T00:00:00Z","format":"date-time","type":"string"},"contract":{"$ref":"#/components/schemas/dto.SubscriptionContractModel"},"cost_split":{"$ref":"#/components/schemas/dto.SubscriptionCostSplitModel"},"created_at":{"description":"@Description ISO 8601 timestamp when the subscription was originally created","example":"2023-01-15T10:30:00Z","format":"date-time","type":"string"},"current_price":{"$ref":"#/components/schemas/dto.AmountModel"},"custom_recurrency":{"description":"@Description Number of interval units between two renewals (required when recurrency is custom)","example":2,"maximum":3650,"minimum":1,"type":"integer"},"custom_recurrency_unit":{"description":"@Description Unit of the custom recurrency interval, e.g. 2 weeks or 28 days (set when recurrency is custom)","enum":["day","week","month","year"],"example":"week","type":"string"},"end_date":{"description":"@Description ISO 8601 timestamp when the subscription expires (null for ongoing subscriptions)","example":"2024-01-01T00:00:00Z","format":"date-time","type":"string"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"family_users":{"description":"@Description List of family member IDs who use this service (for shared subscriptions)","example":["123e4567-e89b-12d3-a456-426614174005","123e4567-e89b-12d3-a456-426614174006"],"items":{"type":"string"},"type":"array","uniqueItems":false},"free_trial":{"$ref":"#/components/schemas/dto.SubscriptionFreeTrialModel"},"friendly_name":{"description":"@Description Optional custom name for easy identification of the subscription","example":"Netflix Family Account","maxLength":255,"type":"string"},"id":{"description":"@Description Unique identifier for the subscription (UUID format)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"is_active":{"description":"@Description Indicates whether the subscription is currently active or not","example":true,"type":"boolean"},"is_paused":{"description":"@Description Indicates whether the subscription is currently paused","example":false,"type":"boolean"},"label_refs":{"description":"@Description List of labels associated with this subscription","items":{"$ref":"#/components/schemas/dto.LabelRefModel"},"type":"array","uniqueItems":false},"owner":{"$ref":"#/components/schemas/dto.OwnerModel"},"pauses":{"description":"@Description Periods during which the subscription is suspended, ordered by start date","items":{"$ref":"#/components/schemas/dto.SubscriptionPauseModel"},"type":"array","uniqueItems":false},"payer":{"$ref":"#/components/schemas/dto.SubscriptionPayerModel"},"payment_method_id":{"description":"@Description Identifier of the payment method the subscription is charged to (null when unknown)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"price":{"$ref":"#/components/schemas/dto.AmountModel"},"promotion_end_date":{"description":"@Description ISO 8601 timestamp when the promotion in effect ends and the regular price applies (null without promotion)","example":"2024-04-01T00:00:00Z","format":"date-time","type":"string"},"promotions":{"description":"@Description Discounted pricing phases ordered by start date","items":{"$ref":"#/components/schemas/dto.SubscriptionPromotionModel"},"type":"array","uniqueItems":false},"provider_id":{"description":"@Description LabelID of the service provider offering this subscription","example":"123e4567-e89b-12d3-a456-426614174002","type":"string"},"recurrency":{"description":"@Description Billing recurrency pattern (monthly, yearly, custom, etc.)","enum":["unknown","one_time","monthly","quarterly","half_yearly","yearly","custom"],"example":"monthly","type":"string"},"start_date":{"description":"@Description ISO 8601 timestamp when the subscription becomes active","example":"2023-01-01T00:00:00Z","format":"date-time","type":"string"},"updated_at":{"description":"@Description ISO 8601 timestamp when the subscription was last modified","example":"2023-01-20T14:45:30Z","format":"date-time","type":"string"}},"required":["created_at","etag","id","is_active","is_paused","owner","provider_id","recurrency","start_date","updated_at"],"type":"object"},"dto.SubscriptionPauseModel":{"description":"Pause of a subscription, the subscription is neither billed nor active between its start and end dates","properties":{"end_date":{"description":"@Description ISO 8601 timestamp when the subscription resumes (null while the resume date is unknown)","example":"2024-04-01T00:00:00Z","format":"date-time","type":"string"},"start_date":{"description":"@Description ISO 8601 timestamp when the pause starts","example":"2024-01-01T00:00:00Z","format":"date-time","type":"string"}},"required":["start_date"],"type":"object"},"dto.SubscriptionPayerModel":{"description":"@Description Information about who pays for this subscription within the family","properties":{"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"memberId":{"description":"@Description LabelID of the specific family member who pays (required when type is family_member)","example":"123e4567-e89b-12d3-a456-426614174001","type":"string"},"type":{"description":"@Description Type of payer (family or family member)","enum":["family","family_member"],"example":"family_member","type":"string"}},"required":["etag","type"],"type":"object"},"dto.SubscriptionPriceChangeModel":{"description":"Price change of a subscription, the price applies from its effective date until the next change","properties":{"effective_from":{"description":"@Description ISO 8601 timestamp from which this price applies","example":"2024-01-01T00:00:00Z","format":"date-time","type":"string"},"price":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["effective_from","price"],"type":"object"},"dto.SubscriptionPromotionModel":{"description":"Promotion of a subscription, its price is charged instead of the regular price between its start and end dates","properties":{"end_date":{"description":"@Description ISO 8601 timestamp when the regular price applies again","example":"2024-04-01T00:00:00Z","format":"date-time","type":"string"},"price":{"$ref":"#/components/schemas/dto.AmountModel"},"start_date":{"description":"@Description ISO 8601 timestamp when the promotional price starts","example":"2024-01-01T00:00:00Z","format":"date-time","type":"string"}},"required":["end_date","price","start_date"],"type":"object"},"dto.SubscriptionSpendingBucketResponse":{"properties":{"end":{"description":"First day after the bucket","format":"date-time","type":"string"},"groups":{"description":"One entry per key of the response, in the same order","items":{"$ref":"#/components/schemas/dto.SubscriptionSpendingGroupResponse"},"type":"array","uniqueItems":false},"start":{"format":"date-time","type":"string"},"total":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["end","groups","start","total"],"type":"object"},"dto.SubscriptionSpendingGroupResponse":{"properties":{"key":{"description":"Provider ID, label ID, family member ID, \"family\" or owner type depending on the grouping,\nempty for the spending without a label or a payer","type":"string"},"total":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["key","total"],"type":"object"},"dto.SubscriptionSpendingResponse":{"properties":{"bucket":{"example":"month","type":"string"},"buckets":{"items":{"$ref":"#/components/schemas/dto.SubscriptionSpendingBucketResponse"},"type":"array","uniqueItems":false},"group_by":{"example":"provider","type":"string"},"keys":{"description":"Every group found in the range, the most expensive first","items":{"type":"string"},"type":"array","uniqueItems":false},"total":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["bucket","buckets","group_by","keys","total"],"type":"object"},"dto.SubscriptionSummaryResponse":{"properties":{"active":{"example":10,"type":"integer"},"active_family":{"example":5,"type":"integer"},"active_personal":{"example":5,"type":"integer"},"family_last_month":{"$ref":"#/components/schemas/dto.AmountModel"},"family_last_year":{"$ref":"#/components/schemas/dto.AmountModel"},"family_monthly":{"$ref":"#/components/schemas/dto.AmountModel"},"family_yearly":{"$ref":"#/components/schemas/dto.AmountModel"},"personal_last_month":{"$ref":"#/components/schemas/dto.AmountModel"},"personal_last_year":{"$ref":"#/components/schemas/dto.AmountModel"},"personal_monthly":{"$ref":"#/components/schemas/dto.AmountModel"},"personal_yearly":{"$ref":"#/components/schemas/dto.AmountModel"},"top_labels":{"items":{"$ref":"#/components/schemas/dto.SubscriptionSummaryTopLabelResponse"},"type":"array","uniqueItems":false},"top_providers":{"items":{"$ref":"#/components/schemas/dto.SubscriptionSummaryTopProviderResponse"},"type":"array","uniqueItems":false},"total_last_month":{"$ref":"#/components/schemas/dto.AmountModel"},"total_last_year":{"$ref":"#/components/schemas/dto.AmountModel"},"total_monthly":{"$ref":"#/components/schemas/dto.AmountModel"},"total_yearly":{"$ref":"#/components/schemas/dto.AmountModel"},"upcoming_renewals":{"items":{"$ref":"#/components/schemas/dto.SubscriptionSummaryUpcomingRenewalResponse"},"type":"array","uniqueItems":false}},"type":"object"},"dto.SubscriptionSummaryTopLabelResponse":{"properties":{"label_id":{"type":"string"},"total":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["label_id"],"type":"object"},"dto.SubscriptionSummaryTopProviderResponse":{"properties":{"duration":{"type":"string"},"provider_id":{"type":"string"},"total":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["provider_id"],"type":"object"},"dto.SubscriptionSummaryUpcomingRenewalResponse":{"properties":{"at":{"format":"date-time","type":"string"},"cancellation_deadline":{"description":"Last day to cancel before the subscription renews, null when it does not renew by itself","format":"date-time","type":"string"},"provider_id":{"type":"string"},"source":{"$ref":"#/components/schemas/dto.AmountModel"},"subscription_id":{"type":"string"},"total":{"$ref":"#/components/schemas/dto.AmountModel"}},"required":["at","provider_id","subscription_id"],"type":"object"},"dto.TransferModel":{"description":"Payment a member has to make to another one so every balance goes back to zero","properties":{"amount":{"$ref":"#/components/schemas/dto.AmountModel"},"from_member_id":{"description":"@Description Member who has to pay","example":"123e4567-e89b-12d3-a456-426614174001","type":"string"},"to_member_id":{"description":"@Description Member who has to be paid","example":"123e4567-e89b-12d3-a456-426614174002","type":"string"}},"required":["amount","from_member_id","to_member_id"],"type":"object"},"dto.UpdateBudgetRequest":{"properties":{"label_id":{"type":"string"},"limit":{"$ref":"#/components/schemas/dto.AmountModel"},"name":{"type":"string"},"period":{"enum":["monthly","yearly"],"example":"monthly","type":"string"},"scope":{"enum":["label","family","account"],"example":"label","type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["limit","name","period","scope"],"type":"object"},"dto.UpdateFamilyMemberRequest":{"properties":{"name":{"type":"string"},"type":{"enum":["owner","adult","kid"],"type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["name","type"],"type":"object"},"dto.UpdateFamilyRequest":{"properties":{"name":{"type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["name"],"type":"object"},"dto.UpdateLabelRequest":{"properties":{"color":{"type":"string"},"name":{"type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["color","name"],"type":"object"},"dto.UpdateManualRateRequest":{"properties":{"from_currency":{"example":"EUR","type":"string"},"rate":{"example":1.08,"type":"number"},"to_currency":{"example":"USD","type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["from_currency","rate","to_currency"],"type":"object"},"dto.UpdateNotificationPreferencesRequest":{"properties":{"cancellation_deadline_lead_days":{"description":"Days before the last day to cancel a subscription its reminder is sent","example":7,"maximum":60,"minimum":0,"type":"integer"},"enabled":{"description":"Whether reminders are emailed","example":true,"type":"boolean"},"free_trial_end_lead_days":{"description":"Days before the end of a free trial its reminder is sent","example":3,"maximum":60,"minimum":0,"type":"integer"},"locale":{"description":"Language of the reminders","enum":["en","fr"],"example":"fr","type":"string"},"renewal_lead_days":{"description":"Days before a renewal its reminder is sent, 0 sends it on the day","example":3,"maximum":60,"minimum":0,"type":"integer"}},"required":["locale"],"type":"object"},"dto.UpdatePaymentMethodRequest":{"properties":{"expiry_month":{"example":3,"type":"integer"},"expiry_year":{"example":2027,"type":"integer"},"label":{"type":"string"},"last_four":{"example":"4242","type":"string"},"type":{"enum":["card","bank_account","wallet","other"],"example":"card","type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["label","type"],"type":"object"},"dto.UpdatePreferredCurrencyRequest":{"properties":{"currency":{"type":"string"}},"required":["currency"],"type":"object"},"dto.UpdatePreferredTimezoneRequest":{"properties":{"timezone":{"description":"IANA timezone name","example":"Europe/Paris","type":"string"}},"required":["timezone"],"type":"object"},"dto.UpdateProviderRequest":{"properties":{"description":{"type":"string"},"icon_url":{"type":"string"},"labels":{"items":{"type":"string"},"type":"array","uniqueItems":false},"name":{"type":"string"},"pricing_page_url":{"type":"string"},"updated_at":{"format":"date-time","type":"string"},"url":{"type":"string"}},"required":["labels","name"],"type":"object"},"dto.UpdateSubscriptionRequest":{"properties":{"billing_anchor_day":{"maximum":31,"minimum":1,"type":"integer"},"contract":{"$ref":"#/components/schemas/dto.SubscriptionContractModel"},"cost_split":{"$ref":"#/components/schemas/dto.SubscriptionCostSplitModel"},"custom_recurrency":{"type":"integer"},"custom_recurrency_unit":{"enum":["day","week","month","year"],"type":"string"},"end_date":{"format":"date-time","type":"string"},"free_trial":{"$ref":"#/components/schemas/dto.SubscriptionFreeTrialModel"},"friendly_name":{"type":"string"},"labels":{"items":{"type":"string"},"type":"array","uniqueItems":false},"owner":{"enum":["personal","family","system"],"example":"personal","type":"string"},"payer":{"$ref":"#/components/schemas/dto.EditableSubscriptionPayerModel"},"payment_method_id":{"type":"string"},"price":{"$ref":"#/components/schemas/dto.AmountModel"},"provider_id":{"type":"string"},"provider_key":{"type":"string"},"recurrency":{"type":"string"},"service_users":{"items":{"type":"string"},"type":"array","uniqueItems":false},"start_date":{"format":"date-time","type":"string"},"updated_at":{"format":"date-time","type":"string"}},"required":["owner","provider_id","recurrency","start_date"],"type":"object"},"dto.UpdateWebhookEndpointRequest":{"properties":{"enabled":{"description":"Whether events are posted to the endpoint","example":true,"type":"boolean"},"event_types":{"description":"Events the endpoint receives, every event when empty","example":["subscription.created","renewal.due"],"items":{"type":"string"},"type":"array","uniqueItems":false},"url":{"description":"Absolute http or https URL the events are posted to","example":"https://example.com/hooks/subtracker","type":"string"}},"required":["url"],"type":"object"},"dto.UserPreferredCurrencyModel":{"properties":{"currency":{"type":"string"}},"type":"object"},"dto.UserPreferredTimezoneModel":{"properties":{"timezone":{"description":"IANA timezone name, UTC when the user has not configured one","example":"Europe/Paris","type":"string"}},"type":"object"},"dto.WebhookDeliveryModel":{"description":"Delivery of an event to an endpoint, retried with an exponential backoff until it succeeds or is given up","properties":{"attempts":{"description":"@Description Number of attempts made so far","example":1,"type":"integer"},"created_at":{"description":"@Description ISO 8601 timestamp indicating when the event was enqueued","example":"2025-01-15T10:30:00Z","format":"date-time","type":"string"},"delivered_at":{"description":"@Description ISO 8601 timestamp indicating when the endpoint accepted the event","example":"2025-01-15T10:30:01Z","format":"date-time","type":"string"},"event_id":{"description":"@Description Identifier of the event, shared by the redeliveries of the event","example":"0193b2a4-8c1e-7d3a-9f4b-2c6d8e0a1b3c","type":"string"},"event_type":{"description":"@Description Type of the event","example":"subscription.created","type":"string"},"id":{"description":"@Description Unique identifier of the delivery (UUID format), sent in the X-SubTracker-Delivery header","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"last_error":{"description":"@Description Reason of the failure of the last attempt","example":"unexpected status code 500","type":"string"},"last_status_code":{"description":"@Description HTTP status code returned by the endpoint to the last attempt","example":200,"type":"integer"},"next_attempt_at":{"description":"@Description ISO 8601 timestamp of the next attempt of a pending delivery","example":"2025-01-15T10:31:00Z","format":"date-time","type":"string"},"payload":{"description":"@Description JSON body posted to the endpoint","type":"object"},"redelivery_of":{"description":"@Description Delivery this one sends again, when it is a redelivery","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"status":{"description":"@Description State of the delivery","enum":["pending","succeeded","failed"],"example":"succeeded","type":"string"},"updated_at":{"description":"@Description ISO 8601 timestamp indicating when the delivery was last attempted","example":"2025-01-15T10:30:01Z","format":"date-time","type":"string"}},"required":["attempts","created_at","event_id","event_type","id","payload","status","updated_at"],"type":"object"},"dto.WebhookEndpointModel":{"description":"Endpoint receiving the events of the account, each request is signed with its secret","properties":{"created_at":{"description":"@Description ISO 8601 timestamp indicating when the endpoint was created","example":"2025-01-15T10:30:00Z","format":"date-time","type":"string"},"enabled":{"description":"@Description Whether events are posted to the endpoint","example":true,"type":"boolean"},"etag":{"description":"@Description Entity tag used for optimistic concurrency control to prevent conflicting updates","example":"W/\"123456789\"","type":"string"},"event_types":{"description":"@Description Events the endpoint receives, every event when empty","example":["subscription.created","renewal.due"],"items":{"type":"string"},"type":"array","uniqueItems":false},"id":{"description":"@Description Unique identifier of the endpoint (UUID format)","example":"123e4567-e89b-12d3-a456-426614174000","type":"string"},"secret":{"description":"@Description Secret signing the requests, only returned when the endpoint is created","example":"whsec_3q2-7wEj0ZqIzO8TMr5jOv1rOxOQw2dDXy0m5lxq9yY","type":"string"},"updated_at":{"description":"@Description ISO 8601 timestamp indicating when the endpoint was last modified","example":"2025-01-20T14:45:30Z","format":"date-time","type":"string"},"url":{"description":"@Description URL the events are posted to","example":"https://example.com/hooks/subtracker","type":"string"}},"required":["created_at","enabled","etag","event_types","id","updated_at","url"],"type":"object"},"ginx.HttpErrorResponse":{"description":"RFC7807 Problem Details error response","properties":{"detail":{"example":"Missing required field 'name'","type":"string"},"instance":{"example":"/api/resource/123","type":"string"},"status":{"example":400,"type":"integer"},"title":{"example":"Bad Request","type":"string"},"type":{"example":"about:blank","type":"string"}},"type":"object"}}},
     "info": {"contact":{"email":"support@mistribe.com","name":"API Support","url":"http://subtracker.mistribe.com/support"},"description":"{{escape .Description}}","license":{"name":"Apache 2.0","url":"http://www.apache.org/licenses/LICENSE-2.0.html"},"termsOfService":"http://subtracker.mistribe.com/terms/","title":"{{.Title}}","version":"{{.Version}}"},
     "externalDocs": {"description":"","url":""},
-    "paths": {"/accounts":{"delete":{"description":"Deletes the authenticated user's account","responses":{"204":{"description":"No Content"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete user","tags":["accounts"]}},"/accounts/preferred/currency":{"get":{"description":"Returns the preferred currency for the authenticated account","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UserPreferredCurrencyModel"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"}},"summary":"Get user preferred currency","tags":["accounts"]},"put":{"description":"Updates the preferred currency for the authenticated account","parameters":[{"description":"Bearer token","in":"header","name":"Authorization","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdatePreferredCurrencyRequest"}}},"description":"Profile update parameters","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"}},"summary":"Update user preferred currency","tags":["accounts"]}},"/accounts/quota/usage":{"get":{"description":"Retrieve the current quota usage and limits for the authenticated user","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.QuotaUsageModel"},"type":"array"}}},"description":"Successfully retrieved quota usage"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get quota usage","tags":["accounts"]}},"/currencies/rates":{"get":{"description":"Get exchange rates for all currencies at a specific date","parameters":[{"description":"Conversion date in RFC3339 format (default: current time)","in":"query","name":"date","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CurrencyRatesModel"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get Currency Rates","tags":["currencies"]}},"/currencies/supported":{"get":{"description":"get details of all supported currencies","responses":{"200":{"content":{"application/json":{"schema":{"items":{"type":"string"},"type":"array"}}},"description":"currencies"}},"summary":"Get Supported Currencies","tags":["currencies"]}},"/family":{"get":{"description":"Retrieve the user's family","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyModel"}}},"description":"Successfully retrieved family"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid LabelID format"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get user's family","tags":["family"]},"post":{"description":"Create a new family with the authenticated user as the owner and initial member","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateFamilyRequest"}}},"description":"Family creation data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyModel"}}},"description":"Successfully created family"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Create a new family","tags":["family"]}},"/family/quota/usage":{"get":{"description":"Retrieve the current quota usage and limits for the authenticated user","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.QuotaUsageModel"},"type":"array"}}},"description":"Successfully retrieved quota usage"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get quota usage","tags":["family"]}},"/family/{familyId}":{"delete":{"description":"Permanently delete a family and all its members","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Family successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid family LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete family by LabelID","tags":["family"]},"put":{"description":"Update family information such as name and other details","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateFamilyRequest"}}},"description":"Updated family data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyModel"}}},"description":"Successfully updated family"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data or family LabelID"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update a family","tags":["family"]}},"/family/{familyId}/accept":{"post":{"description":"Accepts an invitation to join a family using the provided invitation code","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyAcceptInvitationRequest"}}},"description":"Invitation acceptance details","required":true},"responses":{"204":{"content":{"application/json":{}},"description":"Successfully accepted invitation"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid or missing authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Accept a family invitation","tags":["family"]}},"/family/{familyId}/decline":{"post":{"description":"Endpoint to decline an invitation to join a family","parameters":[{"description":"Family LabelID","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyDeclineInvitationRequest"}}},"description":"Decline invitation request","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request"}},"summary":"Decline family invitation","tags":["family"]}},"/family/{familyId}/invitation":{"get":{"description":"Get information about a family invitation using invitation code","parameters":[{"description":"Family LabelID","in":"path","name":"familyId","required":true,"schema":{"type":"string"}},{"description":"Invitation code","in":"query","name":"code","required":true,"schema":{"type":"string"}},{"description":"Family member LabelID","in":"query","name":"family_member_id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilySeeInvitationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request"}},"summary":"View family invitation details","tags":["family"]}},"/family/{familyId}/invite":{"post":{"description":"Creates an invitation for a new member to join the family","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyInviteRequest"}}},"description":"Invitation details including email, name, member LabelID and type (adult/kid)","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyInviteResponse"}}},"description":"Successfully created invitation with code and IDs"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid or missing authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Invite a new member to the family","tags":["family"]}},"/family/{familyId}/members":{"post":{"description":"Add a new member to an existing family","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateFamilyMemberRequest"}}},"description":"Family member creation data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyModel"}}},"description":"Successfully added family member"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data or family LabelID"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Add a new family member","tags":["family"]}},"/family/{familyId}/members/{familyMemberId}":{"delete":{"description":"Permanently delete a family member from a family","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}},{"description":"Family member LabelID (UUID format)","in":"path","name":"familyMemberId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Family member successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family or family member not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete family member by LabelID","tags":["family"]},"put":{"description":"Update an existing family member's information such as name and kid status","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}},{"description":"Family member LabelID (UUID format)","in":"path","name":"familyMemberId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateFamilyMemberRequest"}}},"description":"Updated family member data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyModel"}}},"description":"Successfully updated family member"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data or LabelID format"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family or family member not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update family member by LabelID","tags":["family"]}},"/family/{familyId}/members/{familyMemberId}/revoke":{"post":{"description":"Revokes a member from the family","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}},{"description":"Family Member LabelID (UUID format)","in":"path","name":"familyMemberId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"type":"object"}}}},"responses":{"204":{"content":{"application/json":{}},"description":"Successfully revoked member"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid or missing authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family or member not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Revoke family member","tags":["family"]}},"/healthz/live":{"get":{"description":"Returns the health status of the application","responses":{"200":{"content":{"application/json":{"schema":{"additionalProperties":{"type":"string"},"type":"object"}}},"description":"Health status"}},"summary":"Health check endpoint","tags":["health"]}},"/labels":{"get":{"description":"Retrieve a paginated list of labels with optional filtering by owner type and search text","parameters":[{"description":"Search text to filter labels by name","in":"query","name":"search","schema":{"type":"string"}},{"description":"Maximum number of items to return (default: 10)","in":"query","name":"limit","schema":{"type":"integer"}},{"description":"Number of items to skip for pagination (default: 0)","in":"query","name":"offset","schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaginatedResponseModel-dto_LabelModel"}}},"description":"Paginated list of labels"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid query parameters"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get all labels","tags":["labels"]},"post":{"description":"Create a new label with specified name, color, and owner information","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateLabelRequest"}}},"description":"Label creation data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.LabelModel"}}},"description":"Successfully created label"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Create a new label","tags":["labels"]}},"/labels/export":{"get":{"description":"Export all labels in CSV, JSON, or YAML format","parameters":[{"description":"Export format (csv, json, yaml)","in":"query","name":"format","schema":{"default":"json","type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"type":"file"}},"application/x-yaml":{"schema":{"type":"string"}}},"description":"Exported labels file"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Invalid format parameter"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Export labels","tags":["labels"]}},"/labels/quota/usage":{"get":{"description":"Retrieve the current quota usage and limits for the authenticated user","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.QuotaUsageModel"},"type":"array"}}},"description":"Successfully retrieved quota usage"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get quota usage","tags":["labels"]}},"/labels/{labelId}":{"delete":{"description":"Permanently delete a label by its unique identifier","parameters":[{"description":"Label LabelID (UUID format)","in":"path","name":"labelId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Label successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Label not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete label by LabelID","tags":["labels"]},"get":{"description":"Retrieve a single label by its unique identifier","parameters":[{"description":"Label LabelID (UUID format)","in":"path","name":"labelId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.LabelModel"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Label not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get label by LabelID","tags":["labels"]},"put":{"description":"Update an existing label's name and color by its unique identifier","parameters":[{"description":"Label LabelID (UUID format)","in":"path","name":"labelId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateLabelRequest"}}},"description":"Updated label data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.LabelModel"}}},"description":"Successfully updated label"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid LabelID format or input data"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Label not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update label by LabelID","tags":["labels"]}},"/providers":{"get":{"description":"Retrieve a paginated list of all providers with their plans and prices","parameters":[{"description":"Search term","in":"query","name":"search","schema":{"type":"string"}},{"description":"Offset (default: 0)","in":"query","name":"offset","schema":{"type":"integer"}},{"description":"Limit per request (default: 10)","in":"query","name":"limit","schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaginatedResponseModel-ProviderModel"}}},"description":"Paginated list of providers"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid query parameters"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get all providers","tags":["providers"]},"post":{"description":"Create a new service provider with labels and owner information","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateProviderRequest"}}},"description":"Provider creation data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.ProviderModel"}}},"description":"Successfully created provider"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Create a new provider","tags":["providers"]}},"/providers/export":{"get":{"description":"Export all providers in CSV, JSON, or YAML format","parameters":[{"description":"Export format (csv, json, yaml)","in":"query","name":"format","schema":{"default":"json","type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"type":"file"}},"application/x-yaml":{"schema":{"type":"string"}}},"description":"Exported providers file"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Invalid format parameter"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Export providers","tags":["providers"]}},"/providers/quota/usage":{"get":{"description":"Retrieve the current quota usage and limits for the authenticated user","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.QuotaUsageModel"},"type":"array"}}},"description":"Successfully retrieved quota usage"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get quota usage","tags":["providers"]}},"/providers/{providerId}":{"delete":{"description":"Permanently delete a provider and all its associated plans and prices","parameters":[{"description":"Provider LabelID (UUID format)","in":"path","name":"providerId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Provider successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid provider LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Provider not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete provider by LabelID","tags":["providers"]},"get":{"description":"Retrieve a single provider with all its plans and prices by LabelID","parameters":[{"description":"Provider ID (UUID format) or Provider Key (string format)","in":"path","name":"providerId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.ProviderModel"}}},"description":"Successfully retrieved provider"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid provider LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Provider not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get provider by LabelID","tags":["providers"]},"put":{"description":"Update an existing provider's basic information","parameters":[{"description":"Provider LabelID (UUID format)","in":"path","name":"providerId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateProviderRequest"}}},"description":"Updated provider data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.ProviderModel"}}},"description":"Successfully updated provider"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data or provider LabelID"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Provider not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update provider by LabelID","tags":["providers"]}},"/subscriptions":{"get":{"description":"Retrieve a paginated list of all subscriptions for the authenticated user","parameters":[{"description":"Search text","in":"query","name":"search","schema":{"type":"string"}},{"description":"Filter by recurrency types","in":"query","name":"recurrencies","schema":{"items":{"type":"string"},"type":"array"}},{"description":"Filter by start date (RFC3339)","in":"query","name":"from_date","schema":{"type":"string"}},{"description":"Filter by end date (RFC3339)","in":"query","name":"to_date","schema":{"type":"string"}},{"description":"Filter by user IDs","in":"query","name":"users","schema":{"items":{"type":"string"},"type":"array"}},{"description":"Include inactive subscriptions","in":"query","name":"with_inactive","schema":{"type":"boolean"}},{"description":"Filter by provider IDs","in":"query","name":"providers","schema":{"items":{"type":"string"},"type":"array"}},{"description":"Number of items per page (default: 10)","in":"query","name":"limit","schema":{"type":"integer"}},{"description":"Page number (default: 0)","in":"query","name":"offset","schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaginatedResponseModel-SubscriptionModel"}}},"description":"Paginated list of subscriptions"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid query parameters"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get all subscriptions","tags":["subscriptions"]},"post":{"description":"Create a new subscription with provider, plan, pricing, and payment information","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateSubscriptionRequest"}}},"description":"Subscription creation data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionModel"}}},"description":"Successfully created subscription"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Create a new subscription","tags":["subscriptions"]}},"/subscriptions/export":{"get":{"description":"Export all subscriptions in CSV, JSON, or YAML format","parameters":[{"description":"Export format (csv, json, yaml)","in":"query","name":"format","schema":{"default":"json","type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"type":"file"}},"application/x-yaml":{"schema":{"type":"string"}}},"description":"Exported subscriptions file"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Invalid format parameter"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Export subscriptions","tags":["subscriptions"]}},"/subscriptions/quota/usage":{"get":{"description":"Retrieve the current quota usage and limits for the authenticated user","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.QuotaUsageModel"},"type":"array"}}},"description":"Successfully retrieved quota usage"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get quota usage","tags":["subscriptions"]}},"/subscriptions/summary":{"get":{"description":"Returns summary information about subscriptions including total costs and upcoming renewals","parameters":[{"description":"Number of top providers to return","in":"query","name":"top_providers","required":true,"schema":{"type":"integer"}},{"description":"Number of top labels to return","in":"query","name":"top_labels","required":true,"schema":{"type":"integer"}},{"description":"Number of upcoming renewals to return","in":"query","name":"upcoming_renewals","required":true,"schema":{"type":"integer"}},{"description":"Include monthly total costs","in":"query","name":"total_monthly","required":true,"schema":{"type":"boolean"}},{"description":"Include yearly total costs","in":"query","name":"total_yearly","required":true,"schema":{"type":"boolean"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionSummaryResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request"}},"summary":"Get subscription summary","tags":["subscriptions"]}},"/subscriptions/{subscriptionId}":{"delete":{"description":"Permanently delete an existing subscription","parameters":[{"description":"Subscription LabelID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Subscription successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid subscription LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete subscription by LabelID","tags":["subscriptions"]},"get":{"description":"Retrieve a single subscription with all its details including provider, plan, and pricing information","parameters":[{"description":"Subscription LabelID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionModel"}}},"description":"Successfully retrieved subscription"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid subscription LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get subscription by LabelID","tags":["subscriptions"]},"put":{"description":"Update an existing subscription's details including provider, plan, pricing, and payment information","parameters":[{"description":"Subscription LabelID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateSubscriptionRequest"}}},"description":"Updated subscription data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionModel"}}},"description":"Successfully updated subscription"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data or subscription LabelID"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update subscription by LabelID","tags":["subscriptions"]}},"/version":{"get":{"description":"Returns the build version of the SubTracker API","responses":{"200":{"content":{"application/json":{"schema":{"additionalProperties":{"type":"string"},"type":"object"}}},"description":"Version info"}},"summary":"Get API version","tags":["version"]}}},
+    "paths": {"/accounts":{"delete":{"description":"Deletes the authenticated user's account","responses":{"204":{"description":"No Content"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete user","tags":["accounts"]}},"/accounts/preferred/currency":{"get":{"description":"Returns the preferred currency for the authenticated account","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UserPreferredCurrencyModel"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"}},"summary":"Get user preferred currency","tags":["accounts"]},"put":{"description":"Updates the preferred currency for the authenticated account","parameters":[{"description":"Bearer token","in":"header","name":"Authorization","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdatePreferredCurrencyRequest"}}},"description":"Profile update parameters","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"}},"summary":"Update user preferred currency","tags":["accounts"]}},"/accounts/preferred/timezone":{"get":{"description":"Returns the timezone renewal dates are calculated in for the authenticated account","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UserPreferredTimezoneModel"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"}},"summary":"Get user preferred timezone","tags":["accounts"]},"put":{"description":"Updates the IANA timezone renewal dates are calculated in for the authenticated account","parameters":[{"description":"Bearer token","in":"header","name":"Authorization","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdatePreferredTimezoneRequest"}}},"description":"Timezone update parameters","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"}},"summary":"Update user preferred timezone","tags":["accounts"]}},"/accounts/quota/usage":{"get":{"description":"Retrieve the current quota usage and limits for the authenticated user","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.QuotaUsageModel"},"type":"array"}}},"description":"Successfully retrieved quota usage"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get quota usage","tags":["accounts"]}},"/budgets":{"get":{"description":"Retrieve a paginated list of the budgets of the user and their family","parameters":[{"description":"Maximum number of items to return (default: 10)","in":"query","name":"limit","schema":{"type":"integer"}},{"description":"Number of items to skip for pagination (default: 0)","in":"query","name":"offset","schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaginatedResponseModel-dto_BudgetModel"}}},"description":"Paginated list of budgets"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid query parameters"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get all budgets","tags":["budgets"]},"post":{"description":"Create a monthly or yearly spending limit for a label, the family or the personal account","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateBudgetRequest"}}},"description":"Budget creation data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.BudgetModel"}}},"description":"Successfully created budget"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Budgets are not available for the plan"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Label not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Create a new budget","tags":["budgets"]}},"/budgets/status":{"get":{"description":"Compare each budget with the cost of the active subscriptions it covers, normalized to its period and converted into the currency of its limit","parameters":[{"description":"Maximum number of items to return (default: 10)","in":"query","name":"limit","schema":{"type":"integer"}},{"description":"Number of items to skip for pagination (default: 0)","in":"query","name":"offset","schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaginatedResponseModel-dto_BudgetStatusModel"}}},"description":"Paginated list of budget statuses"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid query parameters"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get the status of the budgets","tags":["budgets"]}},"/budgets/{budgetId}":{"delete":{"description":"Permanently delete a budget, the subscriptions it covers are left untouched","parameters":[{"description":"Budget ID (UUID format)","in":"path","name":"budgetId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Budget successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid ID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Budget not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete budget by ID","tags":["budgets"]},"get":{"description":"Retrieve a single budget by its unique identifier","parameters":[{"description":"Budget ID (UUID format)","in":"path","name":"budgetId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.BudgetModel"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid ID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Budget not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get budget by ID","tags":["budgets"]},"put":{"description":"Update an existing budget by its unique identifier","parameters":[{"description":"Budget ID (UUID format)","in":"path","name":"budgetId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateBudgetRequest"}}},"description":"Updated budget data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.BudgetModel"}}},"description":"Successfully updated budget"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid ID format or input data"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Budget not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update budget by ID","tags":["budgets"]}},"/calendar/feed":{"delete":{"description":"Deletes the calendar feed of the authenticated account, its URL stops working","responses":{"204":{"description":"No Content"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"The account has no calendar feed"}},"summary":"Revoke the calendar feed","tags":["calendar"]},"post":{"description":"Creates the calendar feed of the authenticated account and returns its token","responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CalendarFeedModel"}}},"description":"Created"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"The account already has a calendar feed"}},"summary":"Create the calendar feed","tags":["calendar"]}},"/calendar/feed/rotate":{"post":{"description":"Replaces the token of the calendar feed of the authenticated account, the previous URL stops working","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CalendarFeedModel"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"The account has no calendar feed"}},"summary":"Rotate the calendar feed token","tags":["calendar"]}},"/calendar/{token}.ics":{"get":{"description":"iCalendar feed of the upcoming renewals, free trial ends and cancellation deadlines of the account owning the token. No session is required, the token is the credential.","parameters":[{"description":"Feed token followed by .ics","in":"path","name":"token","required":true,"schema":{"type":"string"}},{"description":"Number of upcoming renewals per subscription","in":"query","name":"renewals","schema":{"default":3,"maximum":24,"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"type":"file"}},"text/calendar":{"schema":{"type":"string"}}},"description":"iCalendar feed"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unknown or revoked token"}},"summary":"Get the calendar feed","tags":["calendar"]}},"/currencies/manual-rates":{"get":{"description":"Retrieve a paginated list of the manual exchange rates of the user and their family","parameters":[{"description":"Maximum number of items to return (default: 10)","in":"query","name":"limit","schema":{"type":"integer"}},{"description":"Number of items to skip for pagination (default: 0)","in":"query","name":"offset","schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaginatedResponseModel-dto_ManualRateModel"}}},"description":"Paginated list of manual rates"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid query parameters"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get all manual rates","tags":["currencies"]},"post":{"description":"Create an exchange rate used instead of the market rates for a currency pair, for every subscription or for a single one","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateManualRateRequest"}}},"description":"Manual rate creation data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.ManualRateModel"}}},"description":"Successfully created manual rate"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Conflict - The currency pair already has a manual rate"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Create a new manual rate","tags":["currencies"]}},"/currencies/manual-rates/{manualRateId}":{"delete":{"description":"Permanently delete a manual rate, the market rates are used again for its currency pair","parameters":[{"description":"Manual rate ID (UUID format)","in":"path","name":"manualRateId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Manual rate successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid ID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Manual rate not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete manual rate by ID","tags":["currencies"]},"put":{"description":"Update the currency pair or the rate of an existing manual rate","parameters":[{"description":"Manual rate ID (UUID format)","in":"path","name":"manualRateId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateManualRateRequest"}}},"description":"Updated manual rate data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.ManualRateModel"}}},"description":"Successfully updated manual rate"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid ID format or input data"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Manual rate not found"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Conflict - The currency pair already has a manual rate"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update manual rate by ID","tags":["currencies"]}},"/currencies/rates":{"get":{"description":"Get exchange rates for all currencies at a specific date","parameters":[{"description":"Conversion date in RFC3339 format (default: current time)","in":"query","name":"date","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CurrencyRatesModel"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get Currency Rates","tags":["currencies"]}},"/currencies/rates/sync":{"get":{"description":"Retrieve the progress of the last backfill of the missing market rates, restricted to administrators","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.RateSyncModel"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Administrators only"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"No synchronization has run yet"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get the rate synchronization progress","tags":["currencies"]}},"/currencies/supported":{"get":{"description":"get details of all supported currencies","responses":{"200":{"content":{"application/json":{"schema":{"items":{"type":"string"},"type":"array"}}},"description":"currencies"}},"summary":"Get Supported Currencies","tags":["currencies"]}},"/family":{"get":{"description":"Retrieve the user's family","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyModel"}}},"description":"Successfully retrieved family"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid LabelID format"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get user's family","tags":["family"]},"post":{"description":"Create a new family with the authenticated user as the owner and initial member","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateFamilyRequest"}}},"description":"Family creation data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyModel"}}},"description":"Successfully created family"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Create a new family","tags":["family"]}},"/family/quota/usage":{"get":{"description":"Retrieve the current quota usage and limits for the authenticated user","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.QuotaUsageModel"},"type":"array"}}},"description":"Successfully retrieved quota usage"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get quota usage","tags":["family"]}},"/family/{familyId}":{"delete":{"description":"Permanently delete a family and all its members","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Family successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid family LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete family by LabelID","tags":["family"]},"put":{"description":"Update family information such as name and other details","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateFamilyRequest"}}},"description":"Updated family data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyModel"}}},"description":"Successfully updated family"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data or family LabelID"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update a family","tags":["family"]}},"/family/{familyId}/accept":{"post":{"description":"Accepts an invitation to join a family using the provided invitation code","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyAcceptInvitationRequest"}}},"description":"Invitation acceptance details","required":true},"responses":{"204":{"content":{"application/json":{}},"description":"Successfully accepted invitation"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid or missing authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Accept a family invitation","tags":["family"]}},"/family/{familyId}/decline":{"post":{"description":"Endpoint to decline an invitation to join a family","parameters":[{"description":"Family LabelID","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyDeclineInvitationRequest"}}},"description":"Decline invitation request","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request"}},"summary":"Decline family invitation","tags":["family"]}},"/family/{familyId}/invitation":{"get":{"description":"Get information about a family invitation using invitation code","parameters":[{"description":"Family LabelID","in":"path","name":"familyId","required":true,"schema":{"type":"string"}},{"description":"Invitation code","in":"query","name":"code","required":true,"schema":{"type":"string"}},{"description":"Family member LabelID","in":"query","name":"family_member_id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilySeeInvitationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request"}},"summary":"View family invitation details","tags":["family"]}},"/family/{familyId}/invite":{"post":{"description":"Creates an invitation for a new member to join the family","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyInviteRequest"}}},"description":"Invitation details including email, name, member LabelID and type (adult/kid)","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyInviteResponse"}}},"description":"Successfully created invitation with code and IDs"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid or missing authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Invite a new member to the family","tags":["family"]}},"/family/{familyId}/ledger":{"get":{"description":"Retrieve who owes whom within the family, in the preferred currency. Each member owes their share of every charge paid by another member, settlements reduce what is owed","parameters":[{"description":"Family ID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyLedgerModel"}}},"description":"Successfully retrieved family ledger"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid ID format"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get family ledger","tags":["family"]}},"/family/{familyId}/members":{"post":{"description":"Add a new member to an existing family","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateFamilyMemberRequest"}}},"description":"Family member creation data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyModel"}}},"description":"Successfully added family member"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data or family LabelID"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Add a new family member","tags":["family"]}},"/family/{familyId}/members/{familyMemberId}":{"delete":{"description":"Permanently delete a family member from a family","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}},{"description":"Family member LabelID (UUID format)","in":"path","name":"familyMemberId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Family member successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family or family member not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete family member by LabelID","tags":["family"]},"put":{"description":"Update an existing family member's information such as name and kid status","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}},{"description":"Family member LabelID (UUID format)","in":"path","name":"familyMemberId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateFamilyMemberRequest"}}},"description":"Updated family member data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilyModel"}}},"description":"Successfully updated family member"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data or LabelID format"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family or family member not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update family member by LabelID","tags":["family"]}},"/family/{familyId}/members/{familyMemberId}/revoke":{"post":{"description":"Revokes a member from the family","parameters":[{"description":"Family LabelID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}},{"description":"Family Member LabelID (UUID format)","in":"path","name":"familyMemberId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"type":"object"}}}},"responses":{"204":{"content":{"application/json":{}},"description":"Successfully revoked member"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid or missing authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family or member not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Revoke family member","tags":["family"]}},"/family/{familyId}/settlements":{"post":{"description":"Record that a family member paid another one back, e.g. Alice paid Bob 30 EUR","parameters":[{"description":"Family ID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.RecordSettlementRequest"}}},"description":"Settlement data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SettlementModel"}}},"description":"Successfully recorded settlement"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family or family member not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Record a settlement","tags":["family"]}},"/family/{familyId}/settlements/{settlementId}":{"delete":{"description":"Delete a settlement recorded by mistake, what it settled is owed again","parameters":[{"description":"Family ID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}},{"description":"Settlement ID (UUID format)","in":"path","name":"settlementId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Settlement successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid ID format"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Settlement not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete a settlement","tags":["family"]}},"/family/{familyId}/shares":{"get":{"description":"Retrieve the monthly and yearly share of each family member for the active family subscriptions, in the preferred currency","parameters":[{"description":"Family ID (UUID format)","in":"path","name":"familyId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.FamilySharesModel"}}},"description":"Successfully retrieved family shares"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid ID format"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Family not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get family member shares","tags":["family"]}},"/healthz/live":{"get":{"description":"Returns the health status of the application","responses":{"200":{"content":{"application/json":{"schema":{"additionalProperties":{"type":"string"},"type":"object"}}},"description":"Health status"}},"summary":"Health check endpoint","tags":["health"]}},"/labels":{"get":{"description":"Retrieve a paginated list of labels with optional filtering by owner type and search text","parameters":[{"description":"Search text to filter labels by name","in":"query","name":"search","schema":{"type":"string"}},{"description":"Maximum number of items to return (default: 10)","in":"query","name":"limit","schema":{"type":"integer"}},{"description":"Number of items to skip for pagination (default: 0)","in":"query","name":"offset","schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaginatedResponseModel-dto_LabelModel"}}},"description":"Paginated list of labels"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid query parameters"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get all labels","tags":["labels"]},"post":{"description":"Create a new label with specified name, color, and owner information","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateLabelRequest"}}},"description":"Label creation data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.LabelModel"}}},"description":"Successfully created label"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Create a new label","tags":["labels"]}},"/labels/export":{"get":{"description":"Export all labels in CSV, JSON, or YAML format","parameters":[{"description":"Export format (csv, json, yaml)","in":"query","name":"format","schema":{"default":"json","type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"type":"file"}},"application/x-yaml":{"schema":{"type":"string"}}},"description":"Exported labels file"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Invalid format parameter"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Export labels","tags":["labels"]}},"/labels/quota/usage":{"get":{"description":"Retrieve the current quota usage and limits for the authenticated user","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.QuotaUsageModel"},"type":"array"}}},"description":"Successfully retrieved quota usage"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get quota usage","tags":["labels"]}},"/labels/{labelId}":{"delete":{"description":"Permanently delete a label by its unique identifier","parameters":[{"description":"Label LabelID (UUID format)","in":"path","name":"labelId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Label successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Label not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete label by LabelID","tags":["labels"]},"get":{"description":"Retrieve a single label by its unique identifier","parameters":[{"description":"Label LabelID (UUID format)","in":"path","name":"labelId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.LabelModel"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Label not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get label by LabelID","tags":["labels"]},"put":{"description":"Update an existing label's name and color by its unique identifier","parameters":[{"description":"Label LabelID (UUID format)","in":"path","name":"labelId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateLabelRequest"}}},"description":"Updated label data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.LabelModel"}}},"description":"Successfully updated label"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid LabelID format or input data"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Label not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update label by LabelID","tags":["labels"]}},"/notifications/preferences":{"get":{"description":"Returns the email reminders of the authenticated account, the defaults when it never saved any","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.NotificationPreferencesModel"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get notification preferences","tags":["notifications"]},"put":{"description":"Opts the authenticated account in or out of email reminders and sets their language and lead days","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateNotificationPreferencesRequest"}}},"description":"Notification preferences","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.NotificationPreferencesModel"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid locale or lead days"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update notification preferences","tags":["notifications"]}},"/payment-methods":{"get":{"description":"Retrieve a paginated list of the payment methods of the user and their family","parameters":[{"description":"Search text to filter payment methods by label","in":"query","name":"search","schema":{"type":"string"}},{"description":"Maximum number of items to return (default: 10)","in":"query","name":"limit","schema":{"type":"integer"}},{"description":"Number of items to skip for pagination (default: 0)","in":"query","name":"offset","schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaginatedResponseModel-dto_PaymentMethodModel"}}},"description":"Paginated list of payment methods"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid query parameters"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get all payment methods","tags":["payment-methods"]},"post":{"description":"Create a new card, bank account or wallet subscriptions can be charged to","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreatePaymentMethodRequest"}}},"description":"Payment method creation data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaymentMethodModel"}}},"description":"Successfully created payment method"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Create a new payment method","tags":["payment-methods"]}},"/payment-methods/expiring":{"get":{"description":"List the active subscriptions whose payment method expires before their next renewal, ordered by renewal date","parameters":[{"description":"Only the subscriptions charged to this payment method (UUID format)","in":"query","name":"payment_method_id","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.ExpiringPaymentMethodSubscriptionModel"},"type":"array"}}},"description":"Subscriptions that will fail to renew"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid ID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Payment method not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get subscriptions affected by an expiring payment method","tags":["payment-methods"]}},"/payment-methods/{paymentMethodId}":{"delete":{"description":"Permanently delete a payment method, the subscriptions charged to it are left without payment method","parameters":[{"description":"Payment method ID (UUID format)","in":"path","name":"paymentMethodId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Payment method successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid ID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Payment method not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete payment method by ID","tags":["payment-methods"]},"get":{"description":"Retrieve a single payment method by its unique identifier","parameters":[{"description":"Payment method ID (UUID format)","in":"path","name":"paymentMethodId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaymentMethodModel"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid ID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Payment method not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get payment method by ID","tags":["payment-methods"]},"put":{"description":"Update an existing payment method by its unique identifier","parameters":[{"description":"Payment method ID (UUID format)","in":"path","name":"paymentMethodId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdatePaymentMethodRequest"}}},"description":"Updated payment method data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaymentMethodModel"}}},"description":"Successfully updated payment method"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid ID format or input data"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Payment method not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update payment method by ID","tags":["payment-methods"]}},"/providers":{"get":{"description":"Retrieve a paginated list of all providers with their plans and prices","parameters":[{"description":"Search term","in":"query","name":"search","schema":{"type":"string"}},{"description":"Offset (default: 0)","in":"query","name":"offset","schema":{"type":"integer"}},{"description":"Limit per request (default: 10)","in":"query","name":"limit","schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaginatedResponseModel-ProviderModel"}}},"description":"Paginated list of providers"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid query parameters"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get all providers","tags":["providers"]},"post":{"description":"Create a new service provider with labels and owner information","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateProviderRequest"}}},"description":"Provider creation data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.ProviderModel"}}},"description":"Successfully created provider"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Create a new provider","tags":["providers"]}},"/providers/export":{"get":{"description":"Export all providers in CSV, JSON, or YAML format","parameters":[{"description":"Export format (csv, json, yaml)","in":"query","name":"format","schema":{"default":"json","type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"type":"file"}},"application/x-yaml":{"schema":{"type":"string"}}},"description":"Exported providers file"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Invalid format parameter"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Export providers","tags":["providers"]}},"/providers/quota/usage":{"get":{"description":"Retrieve the current quota usage and limits for the authenticated user","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.QuotaUsageModel"},"type":"array"}}},"description":"Successfully retrieved quota usage"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get quota usage","tags":["providers"]}},"/providers/{providerId}":{"delete":{"description":"Permanently delete a provider and all its associated plans and prices","parameters":[{"description":"Provider LabelID (UUID format)","in":"path","name":"providerId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Provider successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid provider LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Provider not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete provider by LabelID","tags":["providers"]},"get":{"description":"Retrieve a single provider with all its plans and prices by LabelID","parameters":[{"description":"Provider ID (UUID format) or Provider Key (string format)","in":"path","name":"providerId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.ProviderModel"}}},"description":"Successfully retrieved provider"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid provider LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Provider not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get provider by LabelID","tags":["providers"]},"put":{"description":"Update an existing provider's basic information","parameters":[{"description":"Provider LabelID (UUID format)","in":"path","name":"providerId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateProviderRequest"}}},"description":"Updated provider data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.ProviderModel"}}},"description":"Successfully updated provider"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data or provider LabelID"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Provider not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update provider by LabelID","tags":["providers"]}},"/subscriptions":{"get":{"description":"Retrieve a paginated list of all subscriptions for the authenticated user","parameters":[{"description":"Search text","in":"query","name":"search","schema":{"type":"string"}},{"description":"Filter by recurrency types","in":"query","name":"recurrencies","schema":{"items":{"type":"string"},"type":"array"}},{"description":"Filter by start date (RFC3339)","in":"query","name":"from_date","schema":{"type":"string"}},{"description":"Filter by end date (RFC3339)","in":"query","name":"to_date","schema":{"type":"string"}},{"description":"Filter by user IDs","in":"query","name":"users","schema":{"items":{"type":"string"},"type":"array"}},{"description":"Include inactive subscriptions","in":"query","name":"with_inactive","schema":{"type":"boolean"}},{"description":"Filter by provider IDs","in":"query","name":"providers","schema":{"items":{"type":"string"},"type":"array"}},{"description":"Keep subscriptions whose last day to cancel is on or after this date (RFC3339)","in":"query","name":"cancellation_deadline_from","schema":{"type":"string"}},{"description":"Keep subscriptions whose last day to cancel is on or before this date (RFC3339)","in":"query","name":"cancellation_deadline_to","schema":{"type":"string"}},{"description":"Number of items per page (default: 10)","in":"query","name":"limit","schema":{"type":"integer"}},{"description":"Page number (default: 0)","in":"query","name":"offset","schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaginatedResponseModel-SubscriptionModel"}}},"description":"Paginated list of subscriptions"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid query parameters"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get all subscriptions","tags":["subscriptions"]},"post":{"description":"Create a new subscription with provider, plan, pricing, and payment information","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateSubscriptionRequest"}}},"description":"Subscription creation data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionModel"}}},"description":"Successfully created subscription"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Create a new subscription","tags":["subscriptions"]}},"/subscriptions/export":{"get":{"description":"Export all subscriptions in CSV, JSON, or YAML format, or their upcoming renewals, free trial ends and cancellation deadlines as an iCalendar (ics) file","parameters":[{"description":"Export format (csv, json, yaml, ics)","in":"query","name":"format","schema":{"default":"json","type":"string"}},{"description":"Number of upcoming renewals per subscription in the ics format","in":"query","name":"renewals","schema":{"default":3,"maximum":24,"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"type":"file"}},"text/calendar":{"schema":{"type":"string"}}},"description":"Exported subscriptions file"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Invalid format parameter"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Export subscriptions","tags":["subscriptions"]}},"/subscriptions/forecast":{"get":{"description":"Returns the charges expected from today until the end of the horizon, month by month, split between personal and family spending. Charges during a free trial or after the end date are left out and amounts are converted into the preferred currency at the latest rate.","parameters":[{"description":"Number of months to forecast, the current month included","in":"query","name":"months","schema":{"default":12,"maximum":36,"minimum":1,"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionForecastResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request"}},"summary":"Get the cash-flow forecast","tags":["subscriptions"]}},"/subscriptions/import":{"post":{"description":"Import subscriptions from a CSV, JSON, or YAML file as produced by the export endpoint","parameters":[{"description":"Import format (csv, json, yaml), defaults to the file extension or json","in":"query","name":"format","schema":{"type":"string"}},{"description":"Validate the file without saving anything","in":"query","name":"dry_run","schema":{"default":false,"type":"boolean"}}],"requestBody":{"content":{"application/json":{"schema":{"type":"file"}},"application/x-yaml":{"schema":{"type":"file"}},"multipart/form-data":{"schema":{"type":"file"}},"text/csv":{"schema":{"type":"file"}}},"description":"File to import (multipart upload), the request body is used otherwise"},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionImportResponse"}}},"description":"Per-row import report"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Invalid format or unreadable file"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Import subscriptions","tags":["subscriptions"]}},"/subscriptions/quota/usage":{"get":{"description":"Retrieve the current quota usage and limits for the authenticated user","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.QuotaUsageModel"},"type":"array"}}},"description":"Successfully retrieved quota usage"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get quota usage","tags":["subscriptions"]}},"/subscriptions/spending":{"get":{"description":"Returns what was spent on the subscriptions in each month, quarter or year of the range, grouped by provider, label, payer or owner type. Each bucket is converted into the preferred currency at the rate of its first day.","parameters":[{"description":"First day of the range (RFC3339), twelve buckets before the end by default","in":"query","name":"from","schema":{"type":"string"}},{"description":"Last day of the range (RFC3339), today by default","in":"query","name":"to","schema":{"type":"string"}},{"description":"Size of the buckets","in":"query","name":"bucket","schema":{"default":"month","enum":["month","quarter","year"],"type":"string"}},{"description":"Grouping of the spending","in":"query","name":"group_by","schema":{"default":"provider","enum":["provider","label","payer","owner_type"],"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionSpendingResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request"}},"summary":"Get subscription spending over time","tags":["subscriptions"]}},"/subscriptions/summary":{"get":{"description":"Returns summary information about subscriptions including total costs and upcoming renewals","parameters":[{"description":"Number of top providers to return","in":"query","name":"top_providers","required":true,"schema":{"type":"integer"}},{"description":"Number of top labels to return","in":"query","name":"top_labels","required":true,"schema":{"type":"integer"}},{"description":"Number of upcoming renewals to return","in":"query","name":"upcoming_renewals","required":true,"schema":{"type":"integer"}},{"description":"Include monthly total costs","in":"query","name":"total_monthly","required":true,"schema":{"type":"boolean"}},{"description":"Include yearly total costs","in":"query","name":"total_yearly","required":true,"schema":{"type":"boolean"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionSummaryResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request"}},"summary":"Get subscription summary","tags":["subscriptions"]}},"/subscriptions/{subscriptionId}":{"delete":{"description":"Permanently delete an existing subscription","parameters":[{"description":"Subscription LabelID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Subscription successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid subscription LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete subscription by LabelID","tags":["subscriptions"]},"get":{"description":"Retrieve a single subscription with all its details including provider, plan, and pricing information","parameters":[{"description":"Subscription LabelID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionModel"}}},"description":"Successfully retrieved subscription"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid subscription LabelID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get subscription by LabelID","tags":["subscriptions"]},"put":{"description":"Update an existing subscription's details including provider, plan, pricing, and payment information","parameters":[{"description":"Subscription LabelID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateSubscriptionRequest"}}},"description":"Updated subscription data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionModel"}}},"description":"Successfully updated subscription"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data or subscription LabelID"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update subscription by LabelID","tags":["subscriptions"]}},"/subscriptions/{subscriptionId}/charges":{"get":{"description":"Retrieve the charge ledger of a subscription ordered by due date, every occurrence billed so far has its charge","parameters":[{"description":"Subscription ID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.ChargeModel"},"type":"array"}}},"description":"Successfully retrieved charges"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid subscription ID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get subscription charges","tags":["subscriptions"]}},"/subscriptions/{subscriptionId}/charges/{chargeId}":{"put":{"description":"Mark a charge as paid, failed, refunded or adjusted with the amount really charged. Without an actual amount, paid uses the expected amount and failed or refunded use zero.","parameters":[{"description":"Subscription ID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}},{"description":"Charge ID (UUID format)","in":"path","name":"chargeId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.RecordChargeRequest"}}},"description":"Charge outcome","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.ChargeModel"}}},"description":"Successfully recorded charge"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription or charge not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Record a subscription charge","tags":["subscriptions"]}},"/subscriptions/{subscriptionId}/pauses":{"get":{"description":"Retrieve the periods during which a subscription is suspended ordered by start date","parameters":[{"description":"Subscription ID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.SubscriptionPauseModel"},"type":"array"}}},"description":"Successfully retrieved pauses"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid subscription ID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get subscription pauses","tags":["subscriptions"]},"post":{"description":"Suspend the subscription from the given date, the paused period is excluded from spending and renewals. Omit the end date when the resume date is unknown","parameters":[{"description":"Subscription ID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PauseSubscriptionRequest"}}},"description":"Pause data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionModel"}}},"description":"Successfully paused subscription"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription is already paused during this period"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Pause a subscription","tags":["subscriptions"]}},"/subscriptions/{subscriptionId}/prices":{"get":{"description":"Retrieve the dated price timeline of a subscription ordered by effective date","parameters":[{"description":"Subscription ID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.SubscriptionPriceChangeModel"},"type":"array"}}},"description":"Successfully retrieved price history"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid subscription ID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get subscription price history","tags":["subscriptions"]},"post":{"description":"Record a new price for the subscription effective from the given date, earlier periods keep the price that was in effect then","parameters":[{"description":"Subscription ID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.AddSubscriptionPriceChangeRequest"}}},"description":"Price change data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionModel"}}},"description":"Successfully added price change"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"A price change already exists at this date"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Add a price change to a subscription","tags":["subscriptions"]}},"/subscriptions/{subscriptionId}/promotions":{"get":{"description":"Retrieve the discounted pricing phases of a subscription ordered by start date","parameters":[{"description":"Subscription ID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.SubscriptionPromotionModel"},"type":"array"}}},"description":"Successfully retrieved promotions"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid subscription ID format"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get subscription promotions","tags":["subscriptions"]},"post":{"description":"Charge a discounted price for a number of months or until an end date, the regular price applies automatically afterward. The promotion starts with the subscription when no start date is given","parameters":[{"description":"Subscription ID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.AddSubscriptionPromotionRequest"}}},"description":"Promotion data","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionModel"}}},"description":"Successfully added promotion"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid input data"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"A promotion already applies during this period"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Add a promotion to a subscription","tags":["subscriptions"]}},"/subscriptions/{subscriptionId}/resume":{"post":{"description":"End the pause in effect at the given date, the subscription is resumed immediately when no date is provided","parameters":[{"description":"Subscription ID (UUID format)","in":"path","name":"subscriptionId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.ResumeSubscriptionRequest"}}},"description":"Resume data","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SubscriptionModel"}}},"description":"Successfully resumed subscription"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Subscription is not paused"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Subscription not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Resume a paused subscription","tags":["subscriptions"]}},"/version":{"get":{"description":"Returns the build version of the SubTracker API","responses":{"200":{"content":{"application/json":{"schema":{"additionalProperties":{"type":"string"},"type":"object"}}},"description":"Version info"}},"summary":"Get API version","tags":["version"]}},"/webhooks":{"get":{"description":"Returns the webhook endpoints of the authenticated account, their secret is not returned","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/dto.WebhookEndpointModel"},"type":"array"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get all webhook endpoints","tags":["webhooks"]},"post":{"description":"Registers a URL the events of the authenticated account are posted to. The secret signing the requests is only returned by this call.","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateWebhookEndpointRequest"}}},"description":"Webhook endpoint","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.WebhookEndpointModel"}}},"description":"Created"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid URL or event type, or endpoint limit reached"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Create a webhook endpoint","tags":["webhooks"]}},"/webhooks/{endpointId}":{"delete":{"description":"Permanently deletes a webhook endpoint of the authenticated account and its delivery log","parameters":[{"description":"Webhook endpoint ID (UUID format)","in":"path","name":"endpointId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content - Webhook endpoint successfully deleted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid endpoint ID format"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Webhook endpoint not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Delete a webhook endpoint","tags":["webhooks"]},"get":{"description":"Returns a webhook endpoint of the authenticated account, its secret is not returned","parameters":[{"description":"Webhook endpoint ID (UUID format)","in":"path","name":"endpointId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.WebhookEndpointModel"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid endpoint ID format"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Webhook endpoint not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get webhook endpoint by ID","tags":["webhooks"]},"put":{"description":"Replaces the URL, the event types and the state of a webhook endpoint of the authenticated account","parameters":[{"description":"Webhook endpoint ID (UUID format)","in":"path","name":"endpointId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateWebhookEndpointRequest"}}},"description":"Webhook endpoint","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.WebhookEndpointModel"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid URL or event type"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Webhook endpoint not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Update a webhook endpoint","tags":["webhooks"]}},"/webhooks/{endpointId}/deliveries":{"get":{"description":"Returns the deliveries of the events posted to a webhook endpoint of the authenticated account, the most recent first","parameters":[{"description":"Webhook endpoint ID (UUID format)","in":"path","name":"endpointId","required":true,"schema":{"type":"string"}},{"description":"Maximum number of items to return (default: 10)","in":"query","name":"limit","schema":{"type":"integer"}},{"description":"Number of items to skip for pagination (default: 0)","in":"query","name":"offset","schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.PaginatedResponseModel-dto_WebhookDeliveryModel"}}},"description":"Paginated list of deliveries"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid endpoint ID format"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Webhook endpoint not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Get the delivery log of a webhook endpoint","tags":["webhooks"]}},"/webhooks/{endpointId}/deliveries/{deliveryId}/redeliver":{"post":{"description":"Sends again the event of a delivery of the log, as a new delivery attempted as soon as possible","parameters":[{"description":"Webhook endpoint ID (UUID format)","in":"path","name":"endpointId","required":true,"schema":{"type":"string"}},{"description":"Webhook delivery ID (UUID format)","in":"path","name":"deliveryId","required":true,"schema":{"type":"string"}}],"responses":{"202":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.WebhookDeliveryModel"}}},"description":"Accepted"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Bad Request - Invalid ID format or delivery still pending"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Unauthorized - Invalid user authentication"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Webhook endpoint or delivery not found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ginx.HttpErrorResponse"}}},"description":"Internal Server Error"}},"summary":"Redeliver a webhook event","tags":["webhooks"]}}},
     "openapi": "3.1.0",
     "servers": [
         {"description":"Production server","url":"https://api.subtracker.mistribe.com"},
@@ -0,0 +1,48 @@
+package paymentmethod
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/router/ginfx"
+	"github.com/mistribe/subtracker/internal/adapters/http/router/middlewares"
+)
+
+type EndpointGroup struct {
+	routes      []ginfx.Endpoint
+	middlewares []gin.HandlerFunc
+}
+
+func NewEndpointGroup(
+	createEndpoint *CreateEndpoint,
+	updateEndpoint *UpdateEndpoint,
+	deleteEndpoint *DeleteEndpoint,
+	getEndpoint *GetEndpoint,
+	getAllEndpoint *GetAllEndpoint,
+	expiringEndpoint *ExpiringEndpoint,
+	authenticationMiddleware *middlewares.AuthenticationMiddleware) *EndpointGroup {
+	return &EndpointGroup{
+		routes: []ginfx.Endpoint{
+			createEndpoint,
+			updateEndpoint,
+			deleteEndpoint,
+			getEndpoint,
+			getAllEndpoint,
+			expiringEndpoint,
+		},
+		middlewares: []gin.HandlerFunc{
+			authenticationMiddleware.Middleware(),
+		},
+	}
+}
+
+func (g EndpointGroup) Prefix() string {
+	return "/payment-methods"
+}
+
+func (g EndpointGroup) Routes() []ginfx.Endpoint {
+	return g.routes
+}
+
+func (g EndpointGroup) Middlewares() []gin.HandlerFunc {
+	return g.middlewares
+}
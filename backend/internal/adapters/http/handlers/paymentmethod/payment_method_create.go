@@ -0,0 +1,100 @@
+package paymentmethod
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/paymentmethod/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+)
+
+type CreateEndpoint struct {
+	handler ports.CommandHandler[command.CreatePaymentMethodCommand, paymentmethod.PaymentMethod]
+}
+
+func createPaymentMethodRequestToCommand(m dto.CreatePaymentMethodRequest) (command.CreatePaymentMethodCommand,
+	error) {
+	owner, err := types.TryParseOwnerType(m.Owner)
+	if err != nil {
+		return command.CreatePaymentMethodCommand{}, err
+	}
+	paymentMethodType, err := paymentmethod.ParseType(m.Type)
+	if err != nil {
+		return command.CreatePaymentMethodCommand{}, err
+	}
+	paymentMethodID, err := types.ParsePaymentMethodIDOrNil(m.Id)
+	if err != nil {
+		return command.CreatePaymentMethodCommand{}, err
+	}
+	return command.CreatePaymentMethodCommand{
+		PaymentMethodID: option.New(paymentMethodID),
+		Type:            paymentMethodType,
+		Label:           m.Label,
+		LastFour:        m.LastFour,
+		ExpiryMonth:     m.ExpiryMonth,
+		ExpiryYear:      m.ExpiryYear,
+		Owner:           owner,
+		CreatedAt:       option.New(m.CreatedAt),
+	}, nil
+}
+
+// Handle godoc
+//
+//	@Summary		Create a new payment method
+//	@Description	Create a new card, bank account or wallet subscriptions can be charged to
+//	@Tags			payment-methods
+//	@Accept			json
+//	@Produce		json
+//	@Param			paymentMethod	body		dto.CreatePaymentMethodRequest	true	"Payment method creation data"
+//	@Success		201				{object}	dto.PaymentMethodModel			"Successfully created payment method"
+//	@Failure		400				{object}	HttpErrorResponse				"Bad Request - Invalid input data"
+//	@Failure		401				{object}	HttpErrorResponse				"Unauthorized - Invalid user authentication"
+//	@Failure		500				{object}	HttpErrorResponse				"Internal Server Error"
+//	@Router			/payment-methods [post]
+func (e CreateEndpoint) Handle(c *gin.Context) {
+	var model dto.CreatePaymentMethodRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd, err := createPaymentMethodRequestToCommand(model)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithStatus[paymentmethod.PaymentMethod](http.StatusCreated),
+		WithMapping[paymentmethod.PaymentMethod](func(pm paymentmethod.PaymentMethod) any {
+			return dto.NewPaymentMethodModel(pm)
+		}))
+}
+
+func (e CreateEndpoint) Pattern() []string {
+	return []string{
+		"",
+	}
+}
+
+func (e CreateEndpoint) Method() string {
+	return http.MethodPost
+}
+
+func (e CreateEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewCreateEndpoint(
+	handler ports.CommandHandler[command.CreatePaymentMethodCommand, paymentmethod.PaymentMethod]) *CreateEndpoint {
+	return &CreateEndpoint{
+		handler: handler,
+	}
+}
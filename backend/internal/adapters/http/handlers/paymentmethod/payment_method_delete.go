@@ -0,0 +1,61 @@
+package paymentmethod
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/paymentmethod/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type DeleteEndpoint struct {
+	handler ports.CommandHandler[command.DeletePaymentMethodCommand, bool]
+}
+
+// Handle godoc
+//
+//	@Summary		Delete payment method by ID
+//	@Description	Permanently delete a payment method, the subscriptions charged to it are left without payment method
+//	@Tags			payment-methods
+//	@Param			paymentMethodId	path	string	true	"Payment method ID (UUID format)"
+//	@Success		204				"No Content - Payment method successfully deleted"
+//	@Failure		400				{object}	HttpErrorResponse	"Bad Request - Invalid ID format"
+//	@Failure		404				{object}	HttpErrorResponse	"Payment method not found"
+//	@Failure		500				{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/payment-methods/{paymentMethodId} [delete]
+func (e DeleteEndpoint) Handle(c *gin.Context) {
+	paymentMethodID, err := types.ParsePaymentMethodID(c.Param("paymentMethodId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.DeletePaymentMethodCommand{
+		PaymentMethodID: paymentMethodID,
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c, r, WithNoContent[bool]())
+}
+
+func (e DeleteEndpoint) Pattern() []string {
+	return []string{
+		"/:paymentMethodId",
+	}
+}
+
+func (e DeleteEndpoint) Method() string {
+	return http.MethodDelete
+}
+
+func (e DeleteEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewDeleteEndpoint(handler ports.CommandHandler[command.DeletePaymentMethodCommand, bool]) *DeleteEndpoint {
+	return &DeleteEndpoint{
+		handler: handler,
+	}
+}
@@ -0,0 +1,77 @@
+package paymentmethod
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/paymentmethod/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+)
+
+type ExpiringEndpoint struct {
+	handler ports.QueryHandler[query.ExpiringQuery, []query.AffectedSubscription]
+}
+
+// Handle godoc
+//
+//	@Summary		Get subscriptions affected by an expiring payment method
+//	@Description	List the active subscriptions whose payment method expires before their next renewal, ordered by renewal date
+//	@Tags			payment-methods
+//	@Produce		json
+//	@Param			payment_method_id	query		string										false	"Only the subscriptions charged to this payment method (UUID format)"
+//	@Success		200					{array}		dto.ExpiringPaymentMethodSubscriptionModel	"Subscriptions that will fail to renew"
+//	@Failure		400					{object}	HttpErrorResponse							"Bad Request - Invalid ID format"
+//	@Failure		404					{object}	HttpErrorResponse							"Payment method not found"
+//	@Failure		500					{object}	HttpErrorResponse							"Internal Server Error"
+//	@Router			/payment-methods/expiring [get]
+func (e ExpiringEndpoint) Handle(c *gin.Context) {
+	var paymentMethodID *types.PaymentMethodID
+	if rawID, ok := c.GetQuery("payment_method_id"); ok {
+		parsedID, err := types.ParsePaymentMethodID(rawID)
+		if err != nil {
+			FromError(c, err)
+			return
+		}
+		paymentMethodID = &parsedID
+	}
+
+	r := e.handler.Handle(c, query.NewExpiringQuery(paymentMethodID))
+	FromResult(c,
+		r,
+		WithMapping[[]query.AffectedSubscription](func(affected []query.AffectedSubscription) any {
+			return herd.Select(affected,
+				func(a query.AffectedSubscription) dto.ExpiringPaymentMethodSubscriptionModel {
+					return dto.ExpiringPaymentMethodSubscriptionModel{
+						Subscription:           dto.NewSubscriptionModel(a.Subscription),
+						PaymentMethod:          dto.NewPaymentMethodModel(a.PaymentMethod),
+						NextRenewalDate:        a.NextRenewalDate,
+						PaymentMethodExpiresAt: a.PaymentExpiresAt,
+					}
+				})
+		}))
+}
+
+func (e ExpiringEndpoint) Pattern() []string {
+	return []string{
+		"/expiring",
+	}
+}
+
+func (e ExpiringEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e ExpiringEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewExpiringEndpoint(handler ports.QueryHandler[query.ExpiringQuery, []query.AffectedSubscription]) *ExpiringEndpoint {
+	return &ExpiringEndpoint{
+		handler: handler,
+	}
+}
@@ -0,0 +1,65 @@
+package paymentmethod
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/paymentmethod/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type GetEndpoint struct {
+	handler ports.QueryHandler[query.FindOneQuery, paymentmethod.PaymentMethod]
+}
+
+// Handle godoc
+//
+//	@Summary		Get payment method by ID
+//	@Description	Retrieve a single payment method by its unique identifier
+//	@Tags			payment-methods
+//	@Produce		json
+//	@Param			paymentMethodId	path		string	true	"Payment method ID (UUID format)"
+//	@Success		200				{object}	dto.PaymentMethodModel
+//	@Failure		400				{object}	HttpErrorResponse	"Bad Request - Invalid ID format"
+//	@Failure		404				{object}	HttpErrorResponse	"Payment method not found"
+//	@Failure		500				{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/payment-methods/{paymentMethodId} [get]
+func (e GetEndpoint) Handle(c *gin.Context) {
+	paymentMethodID, err := types.ParsePaymentMethodID(c.Param("paymentMethodId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	r := e.handler.Handle(c, query.NewFindOneQuery(paymentMethodID))
+	FromResult(c,
+		r,
+		WithMapping[paymentmethod.PaymentMethod](func(pm paymentmethod.PaymentMethod) any {
+			return dto.NewPaymentMethodModel(pm)
+		}))
+}
+
+func (e GetEndpoint) Pattern() []string {
+	return []string{
+		"/:paymentMethodId",
+	}
+}
+
+func (e GetEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewGetEndpoint(handler ports.QueryHandler[query.FindOneQuery, paymentmethod.PaymentMethod]) *GetEndpoint {
+	return &GetEndpoint{
+		handler: handler,
+	}
+}
@@ -0,0 +1,72 @@
+package paymentmethod
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/internal/usecase/paymentmethod/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type GetAllEndpoint struct {
+	handler ports.QueryHandler[query.FindAllQuery, shared.PaginatedResponse[paymentmethod.PaymentMethod]]
+}
+
+func NewGetAllEndpoint(
+	handler ports.QueryHandler[query.FindAllQuery, shared.PaginatedResponse[paymentmethod.PaymentMethod]]) *GetAllEndpoint {
+	return &GetAllEndpoint{handler: handler}
+}
+
+// Handle godoc
+//
+//	@Summary		Get all payment methods
+//	@Description	Retrieve a paginated list of the payment methods of the user and their family
+//	@Tags			payment-methods
+//	@Produce		json
+//	@Param			search	query		string												false	"Search text to filter payment methods by label"
+//	@Param			limit	query		integer												false	"Maximum number of items to return (default: 10)"
+//	@Param			offset	query		integer												false	"Number of items to skip for pagination (default: 0)"
+//	@Success		200		{object}	dto.PaginatedResponseModel[dto.PaymentMethodModel]	"Paginated list of payment methods"
+//	@Failure		400		{object}	HttpErrorResponse									"Bad Request - Invalid query parameters"
+//	@Failure		500		{object}	HttpErrorResponse									"Internal Server Error"
+//	@Router			/payment-methods [get]
+func (e GetAllEndpoint) Handle(c *gin.Context) {
+	searchText := c.DefaultQuery("search", "")
+
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	offset, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	r := e.handler.Handle(c, query.NewFindAllQuery(searchText, limit, offset))
+	FromResult(c,
+		r,
+		WithMapping[shared.PaginatedResponse[paymentmethod.PaymentMethod]](
+			func(paginatedResult shared.PaginatedResponse[paymentmethod.PaymentMethod]) any {
+				return dto.NewPaginatedResponseModel(paginatedResult, dto.NewPaymentMethodModel)
+			}))
+}
+
+func (e GetAllEndpoint) Pattern() []string {
+	return []string{
+		"",
+	}
+}
+
+func (e GetAllEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetAllEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -0,0 +1,103 @@
+package paymentmethod
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/paymentmethod/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+)
+
+type UpdateEndpoint struct {
+	handler ports.CommandHandler[command.UpdatePaymentMethodCommand, paymentmethod.PaymentMethod]
+}
+
+func updatePaymentMethodRequestToCommand(
+	m dto.UpdatePaymentMethodRequest,
+	paymentMethodID types.PaymentMethodID) (command.UpdatePaymentMethodCommand, error) {
+	paymentMethodType, err := paymentmethod.ParseType(m.Type)
+	if err != nil {
+		return command.UpdatePaymentMethodCommand{}, err
+	}
+	updatedAt := option.None[time.Time]()
+	if m.UpdatedAt != nil {
+		updatedAt = option.Some(*m.UpdatedAt)
+	}
+	return command.UpdatePaymentMethodCommand{
+		PaymentMethodID: paymentMethodID,
+		Type:            paymentMethodType,
+		Label:           m.Label,
+		LastFour:        m.LastFour,
+		ExpiryMonth:     m.ExpiryMonth,
+		ExpiryYear:      m.ExpiryYear,
+		UpdatedAt:       updatedAt,
+	}, nil
+}
+
+// Handle godoc
+//
+//	@Summary		Update payment method by ID
+//	@Description	Update an existing payment method by its unique identifier
+//	@Tags			payment-methods
+//	@Accept			json
+//	@Produce		json
+//	@Param			paymentMethodId	path		string							true	"Payment method ID (UUID format)"
+//	@Param			paymentMethod	body		dto.UpdatePaymentMethodRequest	true	"Updated payment method data"
+//	@Success		200				{object}	dto.PaymentMethodModel			"Successfully updated payment method"
+//	@Failure		400				{object}	HttpErrorResponse				"Bad Request - Invalid ID format or input data"
+//	@Failure		404				{object}	HttpErrorResponse				"Payment method not found"
+//	@Failure		500				{object}	HttpErrorResponse				"Internal Server Error"
+//	@Router			/payment-methods/{paymentMethodId} [put]
+func (e UpdateEndpoint) Handle(c *gin.Context) {
+	paymentMethodID, err := types.ParsePaymentMethodID(c.Param("paymentMethodId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	var model dto.UpdatePaymentMethodRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd, err := updatePaymentMethodRequestToCommand(model, paymentMethodID)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithMapping[paymentmethod.PaymentMethod](func(pm paymentmethod.PaymentMethod) any {
+			return dto.NewPaymentMethodModel(pm)
+		}))
+}
+
+func (e UpdateEndpoint) Pattern() []string {
+	return []string{
+		"/:paymentMethodId",
+	}
+}
+
+func (e UpdateEndpoint) Method() string {
+	return http.MethodPut
+}
+
+func (e UpdateEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+func NewUpdateEndpoint(
+	handler ports.CommandHandler[command.UpdatePaymentMethodCommand, paymentmethod.PaymentMethod]) *UpdateEndpoint {
+	return &UpdateEndpoint{
+		handler: handler,
+	}
+}
@@ -31,12 +31,26 @@ func NewEndpointGroup(
 	updateEndpoint *UpdateEndpoint,
 	deleteEndpoint *DeleteEndpoint,
 	summaryEndpoint *SummaryEndpoint,
+	spendingEndpoint *SpendingEndpoint,
+	forecastEndpoint *ForecastEndpoint,
 	subscriptionQuotaUsageEndpoint *GetQuotaUsageEndpoint,
 	exportEndpoint *ExportEndpoint,
+	importEndpoint *ImportEndpoint,
+	addPriceChangeEndpoint *AddPriceChangeEndpoint,
+	getPriceHistoryEndpoint *GetPriceHistoryEndpoint,
+	getChargesEndpoint *GetChargesEndpoint,
+	recordChargeEndpoint *RecordChargeEndpoint,
+	pauseEndpoint *PauseEndpoint,
+	resumeEndpoint *ResumeEndpoint,
+	getPausesEndpoint *GetPausesEndpoint,
+	addPromotionEndpoint *AddPromotionEndpoint,
+	getPromotionsEndpoint *GetPromotionsEndpoint,
 	authenticationMiddleware *middlewares.AuthenticationMiddleware) *EndpointGroup {
 	return &EndpointGroup{
 		routes: []ginfx.Endpoint{
 			summaryEndpoint,
+			spendingEndpoint,
+			forecastEndpoint,
 			getEndpoint,
 			getAllEndpoint,
 			createEndpoint,
@@ -44,6 +58,16 @@ func NewEndpointGroup(
 			deleteEndpoint,
 			subscriptionQuotaUsageEndpoint,
 			exportEndpoint,
+			importEndpoint,
+			addPriceChangeEndpoint,
+			getPriceHistoryEndpoint,
+			getChargesEndpoint,
+			recordChargeEndpoint,
+			pauseEndpoint,
+			resumeEndpoint,
+			getPausesEndpoint,
+			addPromotionEndpoint,
+			getPromotionsEndpoint,
 		},
 		middlewares: []gin.HandlerFunc{
 			authenticationMiddleware.Middleware(),
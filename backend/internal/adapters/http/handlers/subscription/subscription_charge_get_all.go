@@ -0,0 +1,67 @@
+package subscription
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+)
+
+type GetChargesEndpoint struct {
+	handler ports.QueryHandler[query.FindChargesQuery, []charge.Charge]
+}
+
+func NewGetChargesEndpoint(
+	handler ports.QueryHandler[query.FindChargesQuery, []charge.Charge]) *GetChargesEndpoint {
+	return &GetChargesEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Get subscription charges
+//	@Description	Retrieve the charge ledger of a subscription ordered by due date, every occurrence billed so far has its charge
+//	@Tags			subscriptions
+//	@Produce		json
+//	@Param			subscriptionId	path		string				true	"Subscription ID (UUID format)"
+//	@Success		200				{array}		dto.ChargeModel		"Successfully retrieved charges"
+//	@Failure		400				{object}	HttpErrorResponse	"Bad Request - Invalid subscription ID format"
+//	@Failure		404				{object}	HttpErrorResponse	"Subscription not found"
+//	@Failure		500				{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/subscriptions/{subscriptionId}/charges [get]
+func (e GetChargesEndpoint) Handle(c *gin.Context) {
+	subscriptionID, err := types.ParseSubscriptionID(c.Param("subscriptionId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	q := query.NewFindChargesQuery(subscriptionID)
+	r := e.handler.Handle(c, q)
+	FromResult(c,
+		r,
+		WithMapping[[]charge.Charge](func(charges []charge.Charge) any {
+			return herd.Select(charges, dto.NewChargeModel)
+		}))
+}
+
+func (e GetChargesEndpoint) Pattern() []string {
+	return []string{
+		"/:subscriptionId/charges",
+	}
+}
+
+func (e GetChargesEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetChargesEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
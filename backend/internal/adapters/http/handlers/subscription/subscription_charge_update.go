@@ -0,0 +1,105 @@
+package subscription
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+)
+
+type RecordChargeEndpoint struct {
+	handler ports.CommandHandler[command.RecordChargeCommand, charge.Charge]
+}
+
+func NewRecordChargeEndpoint(
+	handler ports.CommandHandler[command.RecordChargeCommand, charge.Charge]) *RecordChargeEndpoint {
+	return &RecordChargeEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Record a subscription charge
+//	@Description	Mark a charge as paid, failed, refunded or adjusted with the amount really charged. Without an actual amount, paid uses the expected amount and failed or refunded use zero.
+//	@Tags			subscriptions
+//	@Accept			json
+//	@Produce		json
+//	@Param			subscriptionId	path		string					true	"Subscription ID (UUID format)"
+//	@Param			chargeId		path		string					true	"Charge ID (UUID format)"
+//	@Param			charge			body		dto.RecordChargeRequest	true	"Charge outcome"
+//	@Success		200				{object}	dto.ChargeModel			"Successfully recorded charge"
+//	@Failure		400				{object}	HttpErrorResponse		"Bad Request - Invalid input data"
+//	@Failure		401				{object}	HttpErrorResponse		"Unauthorized - Invalid user authentication"
+//	@Failure		404				{object}	HttpErrorResponse		"Subscription or charge not found"
+//	@Failure		500				{object}	HttpErrorResponse		"Internal Server Error"
+//	@Router			/subscriptions/{subscriptionId}/charges/{chargeId} [put]
+func (e RecordChargeEndpoint) Handle(c *gin.Context) {
+	subscriptionID, err := types.ParseSubscriptionID(c.Param("subscriptionId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	chargeID, err := types.ParseChargeID(c.Param("chargeId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	var model dto.RecordChargeRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	status, err := charge.ParseStatus(model.Status)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	var actualAmount currency.Amount
+	if model.ActualAmount != nil {
+		actualAmount, err = model.ActualAmount.Amount()
+		if err != nil {
+			FromError(c, err)
+			return
+		}
+	}
+
+	cmd := command.RecordChargeCommand{
+		SubscriptionID: subscriptionID,
+		ChargeID:       chargeID,
+		Status:         status,
+		ActualAmount:   actualAmount,
+		UpdatedAt:      option.New(model.UpdatedAt),
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithMapping[charge.Charge](func(chg charge.Charge) any {
+			return dto.NewChargeModel(chg)
+		}))
+}
+
+func (e RecordChargeEndpoint) Pattern() []string {
+	return []string{
+		"/:subscriptionId/charges/:chargeId",
+	}
+}
+
+func (e RecordChargeEndpoint) Method() string {
+	return http.MethodPut
+}
+
+func (e RecordChargeEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
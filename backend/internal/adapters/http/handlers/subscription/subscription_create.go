@@ -87,6 +87,18 @@ func createSubscriptionRequestToCommand(r dto.CreateSubscriptionRequest) (comman
 	if err != nil {
 		return command.CreateSubscriptionCommand{}, err
 	}
+	costSplit, err := dto.NewSubscriptionCostSplit(r.CostSplit)
+	if err != nil {
+		return command.CreateSubscriptionCommand{}, err
+	}
+	customRecurrency, err := dto.NewSubscriptionCustomRecurrency(r.CustomRecurrency, r.CustomRecurrencyUnit)
+	if err != nil {
+		return command.CreateSubscriptionCommand{}, err
+	}
+	paymentMethodID, err := types.ParsePaymentMethodIDOrNil(r.PaymentMethodId)
+	if err != nil {
+		return command.CreateSubscriptionCommand{}, err
+	}
 	if r.ProviderKey == nil && providerID == nil {
 		return command.CreateSubscriptionCommand{}, errors.New("provider id or provider key is required")
 	}
@@ -94,18 +106,22 @@ func createSubscriptionRequestToCommand(r dto.CreateSubscriptionRequest) (comman
 		SubscriptionID:   option.New(subscriptionID),
 		FriendlyName:     r.FriendlyName,
 		FreeTrial:        freeTrial,
+		Contract:         dto.NewSubscriptionContract(r.Contract),
 		ProviderID:       providerID,
 		ProviderKey:      r.ProviderKey,
 		Price:            price.Amount(),
 		Owner:            owner,
 		PayerType:        payerType,
 		PayerMemberId:    payerMemberId,
+		CostSplit:        costSplit,
+		PaymentMethodID:  paymentMethodID,
 		FamilyUsers:      familyUsers,
 		Labels:           labels,
 		StartDate:        r.StartDate,
 		EndDate:          r.EndDate,
 		Recurrency:       recurrency,
-		CustomRecurrency: r.CustomRecurrency,
+		CustomRecurrency: customRecurrency,
+		BillingAnchorDay: r.BillingAnchorDay,
 		CreatedAt:        option.New(r.CreatedAt),
 	}, nil
 }
@@ -4,17 +4,23 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/mistribe/subtracker/internal/adapters/http/dto"
 	"github.com/mistribe/subtracker/internal/adapters/http/export"
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/domain/subscription"
 	"github.com/mistribe/subtracker/internal/domain/types"
 	"github.com/mistribe/subtracker/internal/ports"
 	"github.com/mistribe/subtracker/internal/shared"
+	calendarQuery "github.com/mistribe/subtracker/internal/usecase/calendar/query"
 	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
+	"github.com/mistribe/subtracker/pkg/decimal"
 	. "github.com/mistribe/subtracker/pkg/ginx"
 	"github.com/mistribe/subtracker/pkg/langext/result"
 	"github.com/mistribe/subtracker/pkg/x"
@@ -25,39 +31,51 @@ type ExportEndpoint struct {
 	handler          ports.QueryHandler[query.FindAllQuery, shared.PaginatedResponse[subscription.Subscription]]
 	labelResolver    export.LabelResolver
 	providerResolver export.ProviderResolver
+	spendingResolver export.SpendingResolver
 	exportService    export.ExportService
+	calendarHandler  ports.QueryHandler[calendarQuery.FindEventsQuery, []calendar.Event]
 }
 
 func NewExportEndpoint(
 	handler ports.QueryHandler[query.FindAllQuery, shared.PaginatedResponse[subscription.Subscription]],
 	labelResolver export.LabelResolver,
 	providerResolver export.ProviderResolver,
-	exportService export.ExportService) *ExportEndpoint {
+	spendingResolver export.SpendingResolver,
+	exportService export.ExportService,
+	calendarHandler ports.QueryHandler[calendarQuery.FindEventsQuery, []calendar.Event]) *ExportEndpoint {
 	return &ExportEndpoint{
 		handler:          handler,
 		labelResolver:    labelResolver,
 		exportService:    exportService,
 		providerResolver: providerResolver,
+		spendingResolver: spendingResolver,
+		calendarHandler:  calendarHandler,
 	}
 }
 
 // Handle godoc
 //
 //	@Summary		Export subscriptions
-//	@Description	Export all subscriptions in CSV, JSON, or YAML format
+//	@Description	Export all subscriptions in CSV, JSON, or YAML format, or their upcoming renewals, free trial ends and cancellation deadlines as an iCalendar (ics) file
 //	@Tags			subscriptions
 //	@Produce		text/csv
 //	@Produce		application/json
 //	@Produce		application/x-yaml
-//	@Param			format	query		string				false	"Export format (csv, json, yaml)"	default(json)
-//	@Success		200		{file}		file				"Exported subscriptions file"
-//	@Failure		400		{object}	HttpErrorResponse	"Invalid format parameter"
-//	@Failure		401		{object}	HttpErrorResponse	"Unauthorized"
-//	@Failure		500		{object}	HttpErrorResponse	"Internal Server Error"
+//	@Produce		text/calendar
+//	@Param			format		query		string				false	"Export format (csv, json, yaml, ics)"								default(json)
+//	@Param			renewals	query		int					false	"Number of upcoming renewals per subscription in the ics format"	default(3)	maximum(24)
+//	@Success		200			{file}		file				"Exported subscriptions file"
+//	@Failure		400			{object}	HttpErrorResponse	"Invalid format parameter"
+//	@Failure		401			{object}	HttpErrorResponse	"Unauthorized"
+//	@Failure		500			{object}	HttpErrorResponse	"Internal Server Error"
 //	@Router			/subscriptions/export [get]
 func (e ExportEndpoint) Handle(c *gin.Context) {
 	// Parse format parameter (default to json)
 	formatParam := c.DefaultQuery("format", "json")
+	if strings.EqualFold(strings.TrimSpace(formatParam), string(export.FormatICS)) {
+		e.exportCalendar(c)
+		return
+	}
 	format, err := e.exportService.ParseFormat(formatParam)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, NewProblem(
@@ -106,10 +124,16 @@ func (e ExportEndpoint) Handle(c *gin.Context) {
 			return nil
 		}
 
+		spending, err := e.spendingResolver.ResolveSpending(c, subscriptions)
+		if err != nil {
+			FromError(c, err)
+			return nil
+		}
+
 		// Transform domain subscriptions to export models
 		exportModels := make([]dto.SubscriptionExportModel, len(subscriptions))
 		for i, sub := range subscriptions {
-			exportModels[i] = transformSubscriptionToExportModel(sub, labelIDToName, providerIDToKey)
+			exportModels[i] = transformSubscriptionToExportModel(sub, labelIDToName, providerIDToKey, spending)
 		}
 
 		// Set response headers
@@ -148,6 +172,29 @@ func (e ExportEndpoint) Handle(c *gin.Context) {
 	})
 }
 
+// exportCalendar writes the upcoming events of the subscriptions as an iCalendar file
+func (e ExportEndpoint) exportCalendar(c *gin.Context) {
+	renewals, err := strconv.Atoi(c.DefaultQuery("renewals", "0"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	r := e.calendarHandler.Handle(c, calendarQuery.NewFindEventsQuery(renewals))
+	r.IfFailure(func(err error) {
+		FromError(c, err)
+	})
+	r.IfSuccess(func(events []calendar.Event) {
+		c.Header("Content-Type", e.exportService.GetContentType(export.FormatICS))
+		timestamp := time.Now().Format("2006-01-02T15-04-05")
+		filename := fmt.Sprintf("subscriptions_%s.%s", timestamp, e.exportService.GetFileExtension(export.FormatICS))
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+		if err := export.EncodeICS(c.Writer, "SubTracker", events); err != nil {
+			_ = c.Error(err)
+		}
+	})
+}
+
 func (e ExportEndpoint) extractLabelNames(ctx context.Context,
 	subscriptions []subscription.Subscription) (map[types.LabelID]string, error) {
 	// Extract all unique label IDs from subscriptions
@@ -201,7 +248,8 @@ func (e ExportEndpoint) Middlewares() []gin.HandlerFunc {
 // transformSubscriptionToExportModel converts a domain subscription to an export model
 func transformSubscriptionToExportModel(sub subscription.Subscription,
 	labelIDToName map[types.LabelID]string,
-	providerIDToKey map[types.ProviderID]string) dto.SubscriptionExportModel {
+	providerIDToKey map[types.ProviderID]string,
+	spending map[types.SubscriptionID]export.Spending) dto.SubscriptionExportModel {
 	// Resolve label IDs to names
 	labelNames := make([]string, 0)
 	for labelRef := range sub.Labels().It() {
@@ -225,10 +273,10 @@ func transformSubscriptionToExportModel(sub subscription.Subscription,
 	}
 
 	// Extract custom price amount and currency
-	customPriceAmount := 0.0
+	customPriceAmount := decimal.Zero
 	customPriceCurrency := ""
 	if sub.Price() != nil && sub.Price().Amount().IsValid() {
-		customPriceAmount = sub.Price().Amount().Value()
+		customPriceAmount = sub.Price().Amount().Decimal()
 		customPriceCurrency = sub.Price().Amount().Currency().String()
 	}
 
@@ -256,22 +304,116 @@ func transformSubscriptionToExportModel(sub subscription.Subscription,
 		familyUsers = append(familyUsers, user.String())
 	}
 
+	var spent *decimal.Decimal
+	var charges []dto.ChargeExportModel
+	if ledger, ok := spending[sub.Id()]; ok {
+		if ledger.TotalSpent.IsValid() {
+			spent = x.P(ledger.TotalSpent.Round(currency.DefaultRounding).Decimal())
+		}
+		for _, chg := range ledger.Charges {
+			charges = append(charges, dto.ChargeExportModel{
+				DueDate:        chg.DueDate().Format("2006-01-02"),
+				Status:         chg.Status().String(),
+				ExpectedAmount: chg.ExpectedAmount().Decimal(),
+				ActualAmount:   chg.ActualAmount().Decimal(),
+				Currency:       chg.ActualAmount().Currency().String(),
+			})
+		}
+	}
+
+	var customRecurrency *int32
+	var customRecurrencyUnit *string
+	if sub.CustomRecurrency() != nil {
+		customRecurrency = x.P(sub.CustomRecurrency().Count)
+		customRecurrencyUnit = x.P(sub.CustomRecurrency().Unit.String())
+	}
+
+	var contractMinimumTerm *int32
+	var contractAutoRenew *bool
+	var contractNoticePeriod *int32
+	if sub.Contract() != nil {
+		contractMinimumTerm = sub.Contract().MinimumTerm()
+		contractAutoRenew = x.P(sub.Contract().AutoRenew())
+		contractNoticePeriod = sub.Contract().NoticePeriod()
+	}
+
+	var costSplitType *string
+	var costShares []dto.CostShareExportModel
+	if sub.CostSplit() != nil {
+		costSplitType = x.P(sub.CostSplit().Type().String())
+		for _, share := range sub.CostSplit().Shares() {
+			costShares = append(costShares, dto.CostShareExportModel{
+				MemberId: share.MemberId.String(),
+				Value:    share.Value,
+			})
+		}
+	}
+
+	var paymentMethodId *string
+	if sub.PaymentMethodId() != nil {
+		paymentMethodId = x.P(sub.PaymentMethodId().String())
+	}
+
+	var priceHistory []dto.PriceChangeExportModel
+	for change := range sub.PriceHistory().It() {
+		priceHistory = append(priceHistory, dto.PriceChangeExportModel{
+			EffectiveFrom: change.EffectiveFrom.Format("2006-01-02"),
+			Amount:        change.Amount.Decimal(),
+			Currency:      change.Amount.Currency().String(),
+		})
+	}
+
+	var promotions []dto.PromotionExportModel
+	for promotion := range sub.Promotions().It() {
+		promotions = append(promotions, dto.PromotionExportModel{
+			StartDate: promotion.StartDate.Format("2006-01-02"),
+			EndDate:   promotion.EndDate.Format("2006-01-02"),
+			Amount:    promotion.Amount.Decimal(),
+			Currency:  promotion.Amount.Currency().String(),
+		})
+	}
+
+	var pauses []dto.PauseExportModel
+	for pause := range sub.Pauses().It() {
+		var pauseEnd *string
+		if pause.EndDate != nil {
+			pauseEnd = x.P(pause.EndDate.Format("2006-01-02"))
+		}
+		pauses = append(pauses, dto.PauseExportModel{
+			StartDate: pause.StartDate.Format("2006-01-02"),
+			EndDate:   pauseEnd,
+		})
+	}
+
 	return dto.SubscriptionExportModel{
-		Id:                 sub.Id().String(),
-		ProviderKey:        providerIDToKey[sub.ProviderId()],
-		FriendlyName:       sub.FriendlyName(),
-		StartDate:          startDate,
-		EndDate:            endDate,
-		Recurrency:         sub.Recurrency().String(),
-		CustomRecurrency:   sub.CustomRecurrency(),
-		Amount:             customPriceAmount,
-		Currency:           customPriceCurrency,
-		OwnerType:          ownerType,
-		FreeTrialStartDate: freeTrialStartDate,
-		FreeTrialEndDate:   freeTrialEndDate,
-		Payer:              payer,
-		PayerMemberId:      payerMemberId,
-		FamilyUsers:        familyUsers,
-		Labels:             labelNames,
+		Id:                   sub.Id().String(),
+		ProviderKey:          providerIDToKey[sub.ProviderId()],
+		FriendlyName:         sub.FriendlyName(),
+		StartDate:            startDate,
+		EndDate:              endDate,
+		Recurrency:           sub.Recurrency().String(),
+		CustomRecurrency:     customRecurrency,
+		CustomRecurrencyUnit: customRecurrencyUnit,
+		BillingAnchorDay:     sub.BillingAnchorDay(),
+		Amount:               customPriceAmount,
+		Currency:             customPriceCurrency,
+		OwnerType:            ownerType,
+		FreeTrialStartDate:   freeTrialStartDate,
+		FreeTrialEndDate:     freeTrialEndDate,
+		Payer:                payer,
+		PayerMemberId:        payerMemberId,
+		FamilyUsers:          familyUsers,
+		Labels:               labelNames,
+		ContractMinimumTerm:  contractMinimumTerm,
+		ContractAutoRenew:    contractAutoRenew,
+		ContractNoticePeriod: contractNoticePeriod,
+		CostSplitType:        costSplitType,
+		CostShares:           costShares,
+		PaymentMethodId:      paymentMethodId,
+		PriceHistory:         priceHistory,
+		Promotions:           promotions,
+		Pauses:               pauses,
+		TotalSpent:           spent,
+		Charges:              charges,
 	}
 }
@@ -20,10 +20,13 @@ import (
 	"github.com/mistribe/subtracker/internal/adapters/http/dto"
 	"github.com/mistribe/subtracker/internal/adapters/http/export"
 	"github.com/mistribe/subtracker/internal/adapters/http/handlers/subscription"
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/charge"
 	"github.com/mistribe/subtracker/internal/domain/currency"
 	domainSubscription "github.com/mistribe/subtracker/internal/domain/subscription"
 	"github.com/mistribe/subtracker/internal/domain/types"
 	"github.com/mistribe/subtracker/internal/shared"
+	calendarQuery "github.com/mistribe/subtracker/internal/usecase/calendar/query"
 	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
 	"github.com/mistribe/subtracker/pkg/langext/result"
 )
@@ -71,9 +74,19 @@ func TestExportEndpoint_CSV_Format(t *testing.T) {
 			providerID4: "provider-2",
 		}, nil).Maybe()
 
+	// Create mock spending resolver
+	spendingResolverMock := export.NewMockSpendingResolver(t)
+	spendingResolverMock.EXPECT().ResolveSpending(mock.Anything, mock.Anything).
+		Return(map[types.SubscriptionID]export.Spending{
+			types.MustParseSubscriptionID("00000000-0000-0000-0000-000000000001"): {
+				TotalSpent: currency.NewAmount(42.5, currency.USD),
+			},
+		}, nil).Maybe()
+
 	// Create endpoint
 	exportService := export.NewExportService()
-	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock, exportService)
+	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock,
+		spendingResolverMock, exportService, nil)
 
 	// Create test request
 	gin.SetMode(gin.TestMode)
@@ -98,8 +111,10 @@ func TestExportEndpoint_CSV_Format(t *testing.T) {
 
 	// Verify headers
 	expectedHeaders := []string{"id", "providerKey", "friendlyName", "startDate", "endDate", "recurrency",
-		"customRecurrency", "amount", "currency", "ownerType", "freeTrialStartDate",
-		"freeTrialEndDate", "payer", "payerMemberId", "familyUsers", "labels"}
+		"customRecurrency", "customRecurrencyUnit", "billingAnchorDay", "amount", "currency", "ownerType",
+		"freeTrialStartDate", "freeTrialEndDate", "payer", "payerMemberId", "familyUsers", "labels",
+		"contractMinimumTerm", "contractAutoRenew", "contractNoticePeriod", "costSplitType", "costShares",
+		"paymentMethodId", "priceHistory", "promotions", "pauses", "totalSpent"}
 	assert.Equal(t, expectedHeaders, records[0])
 
 	// Verify first row
@@ -109,9 +124,10 @@ func TestExportEndpoint_CSV_Format(t *testing.T) {
 	// Date should be in ISO 8601 format (YYYY-MM-DD)
 	assert.Regexp(t, `^\d{4}-\d{2}-\d{2}$`, records[1][3])
 	assert.Equal(t, "monthly", records[1][5])
-	assert.Equal(t, "9.99", records[1][7])
-	assert.Equal(t, "USD", records[1][8])
-	assert.Equal(t, "personal", records[1][9])
+	assert.Equal(t, "9.99", records[1][9])
+	assert.Equal(t, "USD", records[1][10])
+	assert.Equal(t, "personal", records[1][11])
+	assert.Equal(t, "42.50", records[1][27])
 }
 
 func TestExportEndpoint_JSON_Format(t *testing.T) {
@@ -150,9 +166,23 @@ func TestExportEndpoint_JSON_Format(t *testing.T) {
 			providerID2: "provider-2",
 		}, nil).Maybe()
 
+	subscriptionID := types.MustParseSubscriptionID("00000000-0000-0000-0000-000000000001")
+	paid := charge.NewCharge(types.NewChargeID(), subscriptionID, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		currency.NewAmount(9.99, currency.USD), currency.NewAmount(12.49, currency.USD), charge.AdjustedStatus,
+		time.Now(), time.Now())
+	spendingResolverMock := export.NewMockSpendingResolver(t)
+	spendingResolverMock.EXPECT().ResolveSpending(mock.Anything, mock.Anything).
+		Return(map[types.SubscriptionID]export.Spending{
+			subscriptionID: {
+				TotalSpent: currency.NewAmount(42.5, currency.USD),
+				Charges:    []charge.Charge{paid},
+			},
+		}, nil).Maybe()
+
 	// Create endpoint
 	exportService := export.NewExportService()
-	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock, exportService)
+	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock,
+		spendingResolverMock, exportService, nil)
 
 	// Create test request
 	gin.SetMode(gin.TestMode)
@@ -183,11 +213,19 @@ func TestExportEndpoint_JSON_Format(t *testing.T) {
 	// Date should be in ISO 8601 format (YYYY-MM-DD)
 	assert.Regexp(t, `^\d{4}-\d{2}-\d{2}$`, exportModels[0].StartDate)
 	assert.Equal(t, "monthly", exportModels[0].Recurrency)
-	assert.Equal(t, 9.99, exportModels[0].Amount)
+	assert.Equal(t, "9.99", exportModels[0].Amount.String())
 	assert.Equal(t, "USD", exportModels[0].Currency)
 	assert.Equal(t, "personal", exportModels[0].OwnerType)
 	// Verify labels are names, not IDs
 	assert.NotEmpty(t, exportModels[0].Labels)
+	// Verify the recorded charges of the ledger are exported
+	require.Len(t, exportModels[0].Charges, 1)
+	assert.Equal(t, "2024-02-01", exportModels[0].Charges[0].DueDate)
+	assert.Equal(t, "adjusted", exportModels[0].Charges[0].Status)
+	assert.Equal(t, "9.99", exportModels[0].Charges[0].ExpectedAmount.String())
+	assert.Equal(t, "12.49", exportModels[0].Charges[0].ActualAmount.String())
+	assert.Equal(t, "USD", exportModels[0].Charges[0].Currency)
+	assert.Empty(t, exportModels[1].Charges)
 }
 
 func TestExportEndpoint_YAML_Format(t *testing.T) {
@@ -226,9 +264,13 @@ func TestExportEndpoint_YAML_Format(t *testing.T) {
 			providerID2: "provider-2",
 		}, nil).Maybe()
 
+	spendingResolverMock := export.NewMockSpendingResolver(t)
+	spendingResolverMock.EXPECT().ResolveSpending(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
 	// Create endpoint
 	exportService := export.NewExportService()
-	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock, exportService)
+	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock,
+		spendingResolverMock, exportService, nil)
 
 	// Create test request
 	gin.SetMode(gin.TestMode)
@@ -271,9 +313,13 @@ func TestExportEndpoint_InvalidFormat(t *testing.T) {
 	// Create mock provider resolver
 	providerResolverMock := export.NewMockProviderResolver(t)
 
+	spendingResolverMock := export.NewMockSpendingResolver(t)
+	spendingResolverMock.EXPECT().ResolveSpending(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
 	// Create endpoint
 	exportService := export.NewExportService()
-	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock, exportService)
+	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock,
+		spendingResolverMock, exportService, nil)
 
 	// Create test request with invalid format
 	gin.SetMode(gin.TestMode)
@@ -289,6 +335,61 @@ func TestExportEndpoint_InvalidFormat(t *testing.T) {
 	assert.Contains(t, w.Body.String(), "invalid format")
 }
 
+// mockCalendarHandler is a mock implementation of the calendar events query handler
+type mockCalendarHandler struct {
+	handleFunc func(ctx context.Context, q calendarQuery.FindEventsQuery) result.Result[[]calendar.Event]
+}
+
+func (m *mockCalendarHandler) Handle(ctx context.Context,
+	q calendarQuery.FindEventsQuery) result.Result[[]calendar.Event] {
+	return m.handleFunc(ctx, q)
+}
+
+func TestExportEndpoint_ICS_Format(t *testing.T) {
+	subscriptionId := types.MustParseSubscriptionID("00000000-0000-0000-0000-000000000001")
+	calendarHandler := &mockCalendarHandler{
+		handleFunc: func(ctx context.Context, q calendarQuery.FindEventsQuery) result.Result[[]calendar.Event] {
+			assert.Equal(t, 6, q.Renewals)
+			return result.Success([]calendar.Event{
+				{
+					UID:            subscriptionId.String() + "-renewal-20250315@subtracker",
+					SubscriptionId: subscriptionId,
+					Kind:           calendar.RenewalEventKind,
+					Date:           time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+					Summary:        "Netflix renewal",
+				},
+			})
+		},
+	}
+
+	// The subscription query is not used by the calendar export
+	mockHandler := &mockQueryHandler{
+		handleFunc: func(ctx context.Context,
+			q query.FindAllQuery) result.Result[shared.PaginatedResponse[domainSubscription.Subscription]] {
+			t.Fatal("handler should not be called for the ics format")
+			return result.Fail[shared.PaginatedResponse[domainSubscription.Subscription]](nil)
+		},
+	}
+
+	endpoint := subscription.NewExportEndpoint(mockHandler, export.NewMockLabelResolver(t),
+		export.NewMockProviderResolver(t), export.NewMockSpendingResolver(t), export.NewExportService(),
+		calendarHandler)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/subscriptions/export?format=ics&renewals=6", nil)
+
+	endpoint.Handle(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, export.ContentTypeICS, w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), ".ics")
+	assert.Contains(t, w.Body.String(), "BEGIN:VCALENDAR\r\n")
+	assert.Contains(t, w.Body.String(), "UID:"+subscriptionId.String()+"-renewal-20250315@subtracker\r\n")
+	assert.Contains(t, w.Body.String(), "DTSTART;VALUE=DATE:20250315\r\n")
+}
+
 func TestExportEndpoint_EmptyResultSet(t *testing.T) {
 	// Create mock handler returning empty results
 	mockHandler := &mockQueryHandler{
@@ -305,9 +406,13 @@ func TestExportEndpoint_EmptyResultSet(t *testing.T) {
 	providerResolverMock := export.NewMockProviderResolver(t)
 	providerResolverMock.EXPECT().ResolveProviderKeys(mock.Anything, mock.Anything).Return(map[types.ProviderID]string{}, nil).Maybe()
 
+	spendingResolverMock := export.NewMockSpendingResolver(t)
+	spendingResolverMock.EXPECT().ResolveSpending(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
 	// Create endpoint
 	exportService := export.NewExportService()
-	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock, exportService)
+	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock,
+		spendingResolverMock, exportService, nil)
 
 	t.Run("CSV with empty data", func(t *testing.T) {
 		gin.SetMode(gin.TestMode)
@@ -323,7 +428,7 @@ func TestExportEndpoint_EmptyResultSet(t *testing.T) {
 		lines := strings.Split(strings.TrimSpace(output), "\n")
 		require.Len(t, lines, 1)
 		assert.Equal(t,
-			"id,providerKey,friendlyName,startDate,endDate,recurrency,customRecurrency,amount,currency,ownerType,freeTrialStartDate,freeTrialEndDate,payer,payerMemberId,familyUsers,labels",
+			"id,providerKey,friendlyName,startDate,endDate,recurrency,customRecurrency,customRecurrencyUnit,billingAnchorDay,amount,currency,ownerType,freeTrialStartDate,freeTrialEndDate,payer,payerMemberId,familyUsers,labels,contractMinimumTerm,contractAutoRenew,contractNoticePeriod,costSplitType,costShares,paymentMethodId,priceHistory,promotions,pauses,totalSpent",
 			lines[0])
 	})
 
@@ -377,16 +482,23 @@ func TestExportEndpoint_FreeTrialDates(t *testing.T) {
 		subID1,
 		&friendlyName,
 		freeTrial,
+		nil,
 		providerID1,
 		price,
+		nil,
+		nil,
 		types.NewPersonalOwner(types.UserID("user-123")),
 		nil,
 		nil,
+		nil,
+		nil,
 		[]domainSubscription.LabelRef{},
 		startDate,
 		nil,
+		nil,
 		domainSubscription.MonthlyRecurrency,
 		nil,
+		nil,
 		now,
 		now,
 	)
@@ -399,16 +511,23 @@ func TestExportEndpoint_FreeTrialDates(t *testing.T) {
 		subID2,
 		nil,
 		nil,
+		nil,
 		providerID2,
 		price,
+		nil,
+		nil,
 		types.NewPersonalOwner(types.UserID("user-123")),
 		nil,
 		nil,
+		nil,
+		nil,
 		[]domainSubscription.LabelRef{},
 		startDate,
 		nil,
+		nil,
 		domainSubscription.MonthlyRecurrency,
 		nil,
+		nil,
 		now,
 		now,
 	)
@@ -436,9 +555,13 @@ func TestExportEndpoint_FreeTrialDates(t *testing.T) {
 			providerID4: "provider-2",
 		}, nil).Maybe()
 
+	spendingResolverMock := export.NewMockSpendingResolver(t)
+	spendingResolverMock.EXPECT().ResolveSpending(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
 	// Create endpoint
 	exportService := export.NewExportService()
-	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock, exportService)
+	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock,
+		spendingResolverMock, exportService, nil)
 
 	// Create test request
 	gin.SetMode(gin.TestMode)
@@ -504,9 +627,13 @@ func TestExportEndpoint_LabelsAreNames(t *testing.T) {
 			providerID6: "provider-2",
 		}, nil).Maybe()
 
+	spendingResolverMock := export.NewMockSpendingResolver(t)
+	spendingResolverMock.EXPECT().ResolveSpending(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
 	// Create endpoint
 	exportService := export.NewExportService()
-	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock, exportService)
+	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock,
+		spendingResolverMock, exportService, nil)
 
 	// Create test request
 	gin.SetMode(gin.TestMode)
@@ -560,16 +687,23 @@ func createTestSubscriptions() []domainSubscription.Subscription {
 		subID1,
 		&friendlyName1,
 		nil,
+		nil,
 		providerID1,
 		price1,
+		nil,
+		nil,
 		types.NewPersonalOwner(types.UserID("user-123")),
 		nil,
 		nil,
+		nil,
+		nil,
 		labelRefs1,
 		startDate,
 		nil,
+		nil,
 		domainSubscription.MonthlyRecurrency,
 		nil,
+		nil,
 		now,
 		now,
 	)
@@ -590,16 +724,23 @@ func createTestSubscriptions() []domainSubscription.Subscription {
 		subID2,
 		nil,
 		nil,
+		nil,
 		providerID2,
 		price2,
+		nil,
+		nil,
 		types.NewFamilyOwner(familyID),
 		nil,
 		nil,
+		nil,
+		nil,
 		labelRefs2,
 		startDate,
 		&endDate,
+		nil,
 		domainSubscription.YearlyRecurrency,
 		nil,
+		nil,
 		now,
 		now,
 	)
@@ -0,0 +1,85 @@
+package subscription
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
+)
+
+type ForecastEndpoint struct {
+	handler ports.QueryHandler[query.ForecastQuery, query.ForecastQueryResponse]
+}
+
+func NewForecastEndpoint(handler ports.QueryHandler[query.ForecastQuery, query.ForecastQueryResponse]) *ForecastEndpoint {
+	return &ForecastEndpoint{handler: handler}
+}
+
+// Handle godoc
+//
+//	@Summary		Get the cash-flow forecast
+//	@Description	Returns the charges expected from today until the end of the horizon, month by month, split between personal and family spending. Charges during a free trial or after the end date are left out and amounts are converted into the preferred currency at the latest rate.
+//	@Tags			subscriptions
+//	@Produce		json
+//	@Param			months	query		integer	false	"Number of months to forecast, the current month included"	default(12)	minimum(1)	maximum(36)
+//	@Success		200		{object}	dto.SubscriptionForecastResponse
+//	@Failure		400		{object}	HttpErrorResponse
+//	@Router			/subscriptions/forecast [get]
+func (e ForecastEndpoint) Handle(c *gin.Context) {
+	var model dto.SubscriptionForecastRequest
+	if err := c.ShouldBindQuery(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	r := e.handler.Handle(c, query.NewForecastQuery(model.Months))
+	FromResult(c,
+		r,
+		WithMapping[query.ForecastQueryResponse](func(res query.ForecastQueryResponse) any {
+			return dto.SubscriptionForecastResponse{
+				Months: herd.Select(res.Months,
+					func(month query.ForecastMonthResponse) dto.SubscriptionForecastMonthResponse {
+						return dto.SubscriptionForecastMonthResponse{
+							Month:    month.Month,
+							Total:    dto.NewAmount(month.Total),
+							Personal: dto.NewAmount(month.Personal),
+							Family:   dto.NewAmount(month.Family),
+							Charges: herd.Select(month.Charges,
+								func(charge query.ForecastChargeResponse) dto.SubscriptionForecastChargeResponse {
+									return dto.SubscriptionForecastChargeResponse{
+										SubscriptionId: charge.SubscriptionId.String(),
+										ProviderId:     charge.ProviderId.String(),
+										At:             charge.At,
+										Total:          dto.NewAmount(charge.Total),
+										Source:         dto.NewAmount(charge.Source),
+										Family:         charge.Family,
+									}
+								}),
+						}
+					}),
+				Total:    dto.NewAmount(res.Total),
+				Personal: dto.NewAmount(res.Personal),
+				Family:   dto.NewAmount(res.Family),
+			}
+		}))
+}
+
+func (e ForecastEndpoint) Pattern() []string {
+	return []string{
+		"/forecast",
+	}
+}
+
+func (e ForecastEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e ForecastEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
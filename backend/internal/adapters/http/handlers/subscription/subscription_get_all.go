@@ -25,15 +25,17 @@ func NewGetAllEndpoint(handler ports.QueryHandler[query.FindAllQuery, shared.Pag
 }
 
 type subscriptionGetAllQueryParams struct {
-	Search       string     `form:"search"`
-	Recurrencies []string   `form:"recurrencies"`
-	FromDate     *time.Time `form:"from_date"`
-	ToDate       *time.Time `form:"to_date"`
-	Users        []string   `form:"users"`
-	WithInactive bool       `form:"with_inactive"`
-	Providers    []string   `form:"providers"`
-	Limit        int64      `form:"limit"`
-	Offset       int64      `form:"offset"`
+	Search                   string     `form:"search"`
+	Recurrencies             []string   `form:"recurrencies"`
+	FromDate                 *time.Time `form:"from_date"`
+	ToDate                   *time.Time `form:"to_date"`
+	Users                    []string   `form:"users"`
+	WithInactive             bool       `form:"with_inactive"`
+	Providers                []string   `form:"providers"`
+	CancellationDeadlineFrom *time.Time `form:"cancellation_deadline_from"`
+	CancellationDeadlineTo   *time.Time `form:"cancellation_deadline_to"`
+	Limit                    int64      `form:"limit"`
+	Offset                   int64      `form:"offset"`
 }
 
 // Handle godoc
@@ -42,18 +44,20 @@ type subscriptionGetAllQueryParams struct {
 //	@Description	Retrieve a paginated list of all subscriptions for the authenticated user
 //	@Tags			subscriptions
 //	@Produce		json
-//	@Param			search			query		string											false	"Search text"
-//	@Param			recurrencies	query		[]string										false	"Filter by recurrency types"
-//	@Param			from_date		query		string											false	"Filter by start date (RFC3339)"
-//	@Param			to_date			query		string											false	"Filter by end date (RFC3339)"
-//	@Param			users			query		[]string										false	"Filter by user IDs"
-//	@Param			with_inactive	query		boolean											false	"Include inactive subscriptions"
-//	@Param			providers		query		[]string										false	"Filter by provider IDs"
-//	@Param			limit			query		integer											false	"Number of items per page (default: 10)"
-//	@Param			offset			query		integer											false	"Page number (default: 0)"
-//	@Success		200				{object}	dto.PaginatedResponseModel[SubscriptionModel]	"Paginated list of subscriptions"
-//	@Failure		400				{object}	HttpErrorResponse								"Bad Request - Invalid query parameters"
-//	@Failure		500				{object}	HttpErrorResponse								"Internal Server Error"
+//	@Param			search						query		string											false	"Search text"
+//	@Param			recurrencies				query		[]string										false	"Filter by recurrency types"
+//	@Param			from_date					query		string											false	"Filter by start date (RFC3339)"
+//	@Param			to_date						query		string											false	"Filter by end date (RFC3339)"
+//	@Param			users						query		[]string										false	"Filter by user IDs"
+//	@Param			with_inactive				query		boolean											false	"Include inactive subscriptions"
+//	@Param			providers					query		[]string										false	"Filter by provider IDs"
+//	@Param			cancellation_deadline_from	query		string											false	"Keep subscriptions whose last day to cancel is on or after this date (RFC3339)"
+//	@Param			cancellation_deadline_to	query		string											false	"Keep subscriptions whose last day to cancel is on or before this date (RFC3339)"
+//	@Param			limit						query		integer											false	"Number of items per page (default: 10)"
+//	@Param			offset						query		integer											false	"Page number (default: 0)"
+//	@Success		200							{object}	dto.PaginatedResponseModel[SubscriptionModel]	"Paginated list of subscriptions"
+//	@Failure		400							{object}	HttpErrorResponse								"Bad Request - Invalid query parameters"
+//	@Failure		500							{object}	HttpErrorResponse								"Internal Server Error"
 //	@Router			/subscriptions [get]
 func (s GetAllEndpoint) Handle(c *gin.Context) {
 	var params subscriptionGetAllQueryParams
@@ -88,6 +92,8 @@ func (s GetAllEndpoint) Handle(c *gin.Context) {
 		users,
 		providers,
 		params.WithInactive,
+		params.CancellationDeadlineFrom,
+		params.CancellationDeadlineTo,
 		params.Limit,
 		params.Offset)
 	r := s.handler.Handle(c, q)
@@ -0,0 +1,462 @@
+package subscription
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/adapters/http/export"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	"github.com/mistribe/subtracker/pkg/decimal"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/x"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+	"github.com/mistribe/subtracker/pkg/x/validation"
+)
+
+type ImportEndpoint struct {
+	handler       ports.CommandHandler[command.ImportSubscriptionsCommand, command.ImportSubscriptionsResult]
+	exportService export.ExportService
+}
+
+func NewImportEndpoint(
+	handler ports.CommandHandler[command.ImportSubscriptionsCommand, command.ImportSubscriptionsResult],
+	exportService export.ExportService) *ImportEndpoint {
+	return &ImportEndpoint{
+		handler:       handler,
+		exportService: exportService,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Import subscriptions
+//	@Description	Import subscriptions from a CSV, JSON, or YAML file as produced by the export endpoint
+//	@Tags			subscriptions
+//	@Accept			multipart/form-data
+//	@Accept			text/csv
+//	@Accept			application/json
+//	@Accept			application/x-yaml
+//	@Produce		json
+//	@Param			file	formData	file							false	"File to import (multipart upload), the request body is used otherwise"
+//	@Param			format	query		string							false	"Import format (csv, json, yaml), defaults to the file extension or json"
+//	@Param			dry_run	query		boolean							false	"Validate the file without saving anything"	default(false)
+//	@Success		200		{object}	dto.SubscriptionImportResponse	"Per-row import report"
+//	@Failure		400		{object}	HttpErrorResponse				"Invalid format or unreadable file"
+//	@Failure		401		{object}	HttpErrorResponse				"Unauthorized"
+//	@Failure		500		{object}	HttpErrorResponse				"Internal Server Error"
+//	@Router			/subscriptions/import [post]
+func (e ImportEndpoint) Handle(c *gin.Context) {
+	dryRun := false
+	if dryRunParam := c.Query("dry_run"); dryRunParam != "" {
+		parsed, err := strconv.ParseBool(dryRunParam)
+		if err != nil {
+			FromError(c, err)
+			return
+		}
+		dryRun = parsed
+	}
+
+	reader, filename, err := openImportFile(c)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	defer reader.Close()
+
+	formatParam := c.Query("format")
+	if formatParam == "" {
+		formatParam = strings.TrimPrefix(filepath.Ext(filename), ".")
+	}
+	if formatParam == "" {
+		formatParam = string(export.FormatJSON)
+	}
+	format, err := e.exportService.ParseFormat(formatParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, NewProblem(
+			ProblemTypeAboutBlank,
+			"Bad Request",
+			http.StatusBadRequest,
+			export.ErrInvalidFormat.Error(),
+			c.FullPath(),
+		))
+		return
+	}
+
+	var models []dto.SubscriptionExportModel
+	var decodeErr error
+	switch format {
+	case export.FormatCSV:
+		decodeErr = export.DecodeCSV(reader, &models)
+	case export.FormatJSON:
+		decodeErr = export.DecodeJSON(reader, &models)
+	case export.FormatYAML:
+		decodeErr = export.DecodeYAML(reader, &models)
+	}
+	if decodeErr != nil {
+		c.JSON(http.StatusBadRequest, NewProblem(
+			ProblemTypeAboutBlank,
+			"Bad Request",
+			http.StatusBadRequest,
+			decodeErr.Error(),
+			c.FullPath(),
+		))
+		return
+	}
+
+	// Rows that cannot be parsed are reported directly, the others are sent to the use case
+	var parseFailures []dto.SubscriptionImportRowResponse
+	rows := make([]command.ImportSubscriptionRow, 0, len(models))
+	for i, model := range models {
+		row, err := exportModelToImportRow(i, model)
+		if err != nil {
+			parseFailures = append(parseFailures, dto.SubscriptionImportRowResponse{
+				Index:  i,
+				Status: command.ImportRowStatusFailed.String(),
+				Errors: []dto.SubscriptionImportRowErrorResponse{newImportRowError(err)},
+			})
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	cmd := command.ImportSubscriptionsCommand{
+		Rows:   rows,
+		DryRun: dryRun,
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithMapping[command.ImportSubscriptionsResult](func(res command.ImportSubscriptionsResult) any {
+			return newSubscriptionImportResponse(res, parseFailures)
+		}))
+}
+
+func (e ImportEndpoint) Pattern() []string {
+	return []string{"/import"}
+}
+
+func (e ImportEndpoint) Method() string {
+	return http.MethodPost
+}
+
+func (e ImportEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
+
+// openImportFile returns the uploaded file when the request is multipart, the raw body otherwise
+func openImportFile(c *gin.Context) (io.ReadCloser, string, error) {
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			return nil, "", err
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			return nil, "", err
+		}
+		return file, fileHeader.Filename, nil
+	}
+
+	if c.Request.Body == nil {
+		return nil, "", errors.New("missing file to import")
+	}
+	return c.Request.Body, "", nil
+}
+
+// exportModelToImportRow is the reverse of transformSubscriptionToExportModel
+func exportModelToImportRow(index int, model dto.SubscriptionExportModel) (command.ImportSubscriptionRow, error) {
+	subscriptionID := option.None[types.SubscriptionID]()
+	if strings.TrimSpace(model.Id) != "" {
+		id, err := types.ParseSubscriptionID(strings.TrimSpace(model.Id))
+		if err != nil {
+			return command.ImportSubscriptionRow{}, validation.NewError("id", "invalid subscription id")
+		}
+		subscriptionID = option.Some(id)
+	}
+
+	if strings.TrimSpace(model.ProviderKey) == "" {
+		return command.ImportSubscriptionRow{}, validation.NewError("providerKey", "provider key is required")
+	}
+
+	startDate, err := time.Parse(time.DateOnly, model.StartDate)
+	if err != nil {
+		return command.ImportSubscriptionRow{}, validation.NewError("startDate", "start date must be formatted as YYYY-MM-DD")
+	}
+	endDate, err := parseOptionalImportDate(model.EndDate)
+	if err != nil {
+		return command.ImportSubscriptionRow{}, validation.NewError("endDate", "end date must be formatted as YYYY-MM-DD")
+	}
+
+	recurrency, err := subscription.ParseRecurrencyType(model.Recurrency)
+	if err != nil {
+		return command.ImportSubscriptionRow{}, validation.NewError("recurrency", err.Error())
+	}
+
+	owner, err := types.TryParseOwnerType(model.OwnerType)
+	if err != nil {
+		return command.ImportSubscriptionRow{}, validation.NewError("ownerType", err.Error())
+	}
+
+	var price currency.Amount
+	if model.Currency != "" {
+		cry, err := currency.ParseISO(model.Currency)
+		if err != nil {
+			return command.ImportSubscriptionRow{}, validation.NewError("currency", "unknown currency")
+		}
+		price = currency.NewAmountFromDecimal(model.Amount, cry)
+	}
+
+	var freeTrial subscription.FreeTrial
+	freeTrialStart, err := parseOptionalImportDate(model.FreeTrialStartDate)
+	if err != nil {
+		return command.ImportSubscriptionRow{}, validation.NewError("freeTrialStartDate",
+			"free trial start date must be formatted as YYYY-MM-DD")
+	}
+	freeTrialEnd, err := parseOptionalImportDate(model.FreeTrialEndDate)
+	if err != nil {
+		return command.ImportSubscriptionRow{}, validation.NewError("freeTrialEndDate",
+			"free trial end date must be formatted as YYYY-MM-DD")
+	}
+	if freeTrialStart != nil && freeTrialEnd != nil {
+		freeTrial = subscription.NewFreeTrial(*freeTrialStart, *freeTrialEnd)
+	}
+
+	var payerType *subscription.PayerType
+	var payerMemberId *types.FamilyMemberID
+	if model.Payer != nil && *model.Payer != "" {
+		parsedPayerType, err := subscription.ParsePayerType(*model.Payer)
+		if err != nil {
+			return command.ImportSubscriptionRow{}, validation.NewError("payer", err.Error())
+		}
+		payerType = x.P(parsedPayerType)
+		payerMemberId, err = types.ParseFamilyMemberIDOrNil(model.PayerMemberId)
+		if err != nil {
+			return command.ImportSubscriptionRow{}, validation.NewError("payerMemberId", "invalid family member id")
+		}
+	}
+
+	customRecurrencyUnit := model.CustomRecurrencyUnit
+	if customRecurrencyUnit != nil && *customRecurrencyUnit == "" {
+		customRecurrencyUnit = nil
+	}
+	customRecurrency, err := dto.NewSubscriptionCustomRecurrency(model.CustomRecurrency, customRecurrencyUnit)
+	if err != nil {
+		return command.ImportSubscriptionRow{}, validation.NewError("customRecurrencyUnit", err.Error())
+	}
+
+	familyUsers, err := herd.SelectErr(nonEmptyStrings(model.FamilyUsers), types.ParseFamilyMemberID)
+	if err != nil {
+		return command.ImportSubscriptionRow{}, validation.NewError("familyUsers", "invalid family member id")
+	}
+
+	var contract subscription.Contract
+	if model.ContractAutoRenew != nil {
+		contract = subscription.NewContract(model.ContractMinimumTerm, *model.ContractAutoRenew,
+			model.ContractNoticePeriod)
+	} else if model.ContractMinimumTerm != nil || model.ContractNoticePeriod != nil {
+		return command.ImportSubscriptionRow{}, validation.NewError("contractAutoRenew",
+			"contract auto renew is required with a contract")
+	}
+
+	var costSplit subscription.CostSplit
+	if model.CostSplitType != nil && *model.CostSplitType != "" {
+		splitType, err := subscription.ParseCostSplitType(*model.CostSplitType)
+		if err != nil {
+			return command.ImportSubscriptionRow{}, validation.NewError("costSplitType", err.Error())
+		}
+		shares, err := herd.SelectErr(model.CostShares,
+			func(share dto.CostShareExportModel) (subscription.CostShare, error) {
+				memberId, err := types.ParseFamilyMemberID(share.MemberId)
+				if err != nil {
+					return subscription.CostShare{}, err
+				}
+				return subscription.CostShare{
+					MemberId: memberId,
+					Value:    share.Value,
+				}, nil
+			})
+		if err != nil {
+			return command.ImportSubscriptionRow{}, validation.NewError("costShares", "invalid family member id")
+		}
+		costSplit = subscription.NewCostSplit(splitType, shares)
+	} else if len(model.CostShares) > 0 {
+		return command.ImportSubscriptionRow{}, validation.NewError("costSplitType",
+			"cost split type is required with cost shares")
+	}
+
+	paymentMethodId, err := types.ParsePaymentMethodIDOrNil(model.PaymentMethodId)
+	if err != nil {
+		return command.ImportSubscriptionRow{}, validation.NewError("paymentMethodId", "invalid payment method id")
+	}
+
+	priceHistory := make([]subscription.PriceChange, 0, len(model.PriceHistory))
+	for _, change := range model.PriceHistory {
+		effectiveFrom, err := time.Parse(time.DateOnly, change.EffectiveFrom)
+		if err != nil {
+			return command.ImportSubscriptionRow{}, validation.NewError("priceHistory",
+				"effective date must be formatted as YYYY-MM-DD")
+		}
+		amount, err := parseImportAmount(change.Amount, change.Currency)
+		if err != nil {
+			return command.ImportSubscriptionRow{}, validation.NewError("priceHistory", "unknown currency")
+		}
+		priceHistory = append(priceHistory, subscription.PriceChange{
+			EffectiveFrom: effectiveFrom,
+			Amount:        amount,
+		})
+	}
+
+	promotions := make([]subscription.Promotion, 0, len(model.Promotions))
+	for _, promotion := range model.Promotions {
+		promotionStart, startErr := time.Parse(time.DateOnly, promotion.StartDate)
+		promotionEnd, endErr := time.Parse(time.DateOnly, promotion.EndDate)
+		if startErr != nil || endErr != nil {
+			return command.ImportSubscriptionRow{}, validation.NewError("promotions",
+				"promotion dates must be formatted as YYYY-MM-DD")
+		}
+		amount, err := parseImportAmount(promotion.Amount, promotion.Currency)
+		if err != nil {
+			return command.ImportSubscriptionRow{}, validation.NewError("promotions", "unknown currency")
+		}
+		promotions = append(promotions, subscription.Promotion{
+			StartDate: promotionStart,
+			EndDate:   promotionEnd,
+			Amount:    amount,
+		})
+	}
+
+	pauses := make([]subscription.Pause, 0, len(model.Pauses))
+	for _, pause := range model.Pauses {
+		pauseStart, startErr := time.Parse(time.DateOnly, pause.StartDate)
+		pauseEnd, endErr := parseOptionalImportDate(pause.EndDate)
+		if startErr != nil || endErr != nil {
+			return command.ImportSubscriptionRow{}, validation.NewError("pauses",
+				"pause dates must be formatted as YYYY-MM-DD")
+		}
+		pauses = append(pauses, subscription.Pause{
+			StartDate: pauseStart,
+			EndDate:   pauseEnd,
+		})
+	}
+
+	return command.ImportSubscriptionRow{
+		Index:            index,
+		SubscriptionID:   subscriptionID,
+		ProviderKey:      strings.TrimSpace(model.ProviderKey),
+		FriendlyName:     model.FriendlyName,
+		FreeTrial:        freeTrial,
+		Price:            price,
+		Owner:            owner,
+		PayerType:        payerType,
+		PayerMemberId:    payerMemberId,
+		FamilyUsers:      familyUsers,
+		Labels:           nonEmptyStrings(model.Labels),
+		StartDate:        startDate,
+		EndDate:          endDate,
+		Recurrency:       recurrency,
+		CustomRecurrency: customRecurrency,
+		BillingAnchorDay: model.BillingAnchorDay,
+		Contract:         contract,
+		CostSplit:        costSplit,
+		PaymentMethodID:  paymentMethodId,
+		PriceHistory:     priceHistory,
+		Promotions:       promotions,
+		Pauses:           pauses,
+	}, nil
+}
+
+func parseImportAmount(value decimal.Decimal, code string) (currency.Amount, error) {
+	cry, err := currency.ParseISO(code)
+	if err != nil {
+		return nil, err
+	}
+	return currency.NewAmountFromDecimal(value, cry), nil
+}
+
+func parseOptionalImportDate(value *string) (*time.Time, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.DateOnly, *value)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+func nonEmptyStrings(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+	return result
+}
+
+func newImportRowError(err error) dto.SubscriptionImportRowErrorResponse {
+	var validationErr validation.Error
+	if errors.As(err, &validationErr) {
+		return dto.SubscriptionImportRowErrorResponse{
+			Field:   x.P(validationErr.Field()),
+			Message: validationErr.Message(),
+		}
+	}
+	return dto.SubscriptionImportRowErrorResponse{
+		Message: err.Error(),
+	}
+}
+
+func newSubscriptionImportResponse(
+	res command.ImportSubscriptionsResult,
+	parseFailures []dto.SubscriptionImportRowResponse) dto.SubscriptionImportResponse {
+	rows := make([]dto.SubscriptionImportRowResponse, 0, len(res.Rows)+len(parseFailures))
+	rows = append(rows, parseFailures...)
+	for _, row := range res.Rows {
+		var subscriptionId *string
+		if row.Status != command.ImportRowStatusFailed {
+			subscriptionId = x.P(row.SubscriptionID.String())
+		}
+		rows = append(rows, dto.SubscriptionImportRowResponse{
+			Index:          row.Index,
+			SubscriptionId: subscriptionId,
+			Status:         row.Status.String(),
+			Errors:         herd.Select(row.Errors, newImportRowError),
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Index < rows[j].Index
+	})
+
+	response := dto.SubscriptionImportResponse{
+		DryRun: res.DryRun,
+		Total:  len(rows),
+		Rows:   rows,
+	}
+	for _, row := range rows {
+		switch row.Status {
+		case command.ImportRowStatusCreated.String():
+			response.Created++
+		case command.ImportRowStatusValid.String():
+			response.Valid++
+		case command.ImportRowStatusFailed.String():
+			response.Failed++
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,309 @@
+package subscription_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/adapters/http/export"
+	"github.com/mistribe/subtracker/internal/adapters/http/handlers/subscription"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	domainSubscription "github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
+	"github.com/mistribe/subtracker/pkg/decimal"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+// mockImportCommandHandler records the received command and reports every row as valid
+type mockImportCommandHandler struct {
+	received *command.ImportSubscriptionsCommand
+}
+
+func (m *mockImportCommandHandler) Handle(_ context.Context,
+	cmd command.ImportSubscriptionsCommand) result.Result[command.ImportSubscriptionsResult] {
+	m.received = &cmd
+	rows := make([]command.ImportSubscriptionRowResult, len(cmd.Rows))
+	for i, row := range cmd.Rows {
+		rows[i] = command.ImportSubscriptionRowResult{
+			Index:          row.Index,
+			SubscriptionID: *row.SubscriptionID.Value(),
+			Status:         command.ImportRowStatusValid,
+		}
+	}
+	return result.Success(command.ImportSubscriptionsResult{DryRun: cmd.DryRun, Rows: rows})
+}
+
+func exportTestSubscriptions(t *testing.T, format string) []byte {
+	return exportSubscriptions(t, format, createTestSubscriptions())
+}
+
+func exportSubscriptions(t *testing.T, format string, subscriptions []domainSubscription.Subscription) []byte {
+	mockHandler := &mockQueryHandler{
+		handleFunc: func(ctx context.Context,
+			q query.FindAllQuery) result.Result[shared.PaginatedResponse[domainSubscription.Subscription]] {
+			return result.Success(shared.NewPaginatedResponse(subscriptions, int64(len(subscriptions))))
+		},
+	}
+	labelResolverMock := export.NewMockLabelResolver(t)
+	labelID1, _ := types.ParseLabelID("00000000-0000-0000-0000-000000000011")
+	labelID2, _ := types.ParseLabelID("00000000-0000-0000-0000-000000000012")
+	labelResolverMock.EXPECT().ResolveLabelNames(mock.Anything, mock.Anything).
+		Return(map[types.LabelID]string{
+			labelID1: "Label 1",
+			labelID2: "Label 2",
+		}, nil).Maybe()
+	providerResolverMock := export.NewMockProviderResolver(t)
+	providerID1, _ := types.ParseProviderID("00000000-0000-0000-0000-000000000101")
+	providerID2, _ := types.ParseProviderID("00000000-0000-0000-0000-000000000102")
+	providerResolverMock.EXPECT().ResolveProviderKeys(mock.Anything, mock.Anything).
+		Return(map[types.ProviderID]string{
+			providerID1: "provider-1",
+			providerID2: "provider-2",
+		}, nil).Maybe()
+
+	spendingResolverMock := export.NewMockSpendingResolver(t)
+	spendingResolverMock.EXPECT().ResolveSpending(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
+	endpoint := subscription.NewExportEndpoint(mockHandler, labelResolverMock, providerResolverMock,
+		spendingResolverMock, export.NewExportService(), nil)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/subscriptions/export?format="+format, nil)
+	endpoint.Handle(c)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	return w.Body.Bytes()
+}
+
+func TestImportEndpoint_RoundTrip(t *testing.T) {
+	for _, format := range []string{"csv", "json", "yaml"} {
+		t.Run(format, func(t *testing.T) {
+			body := exportTestSubscriptions(t, format)
+
+			handler := &mockImportCommandHandler{}
+			endpoint := subscription.NewImportEndpoint(handler, export.NewExportService())
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/subscriptions/import?dry_run=true&format="+format,
+				bytes.NewReader(body))
+
+			endpoint.Handle(c)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.NotNil(t, handler.received)
+			assert.True(t, handler.received.DryRun)
+			require.Len(t, handler.received.Rows, 2)
+
+			first := handler.received.Rows[0]
+			assert.Equal(t, "00000000-0000-0000-0000-000000000001", first.SubscriptionID.Value().String())
+			assert.Equal(t, "provider-1", first.ProviderKey)
+			assert.Equal(t, "My Subscription", *first.FriendlyName)
+			assert.Equal(t, types.PersonalOwnerType, first.Owner)
+			assert.Equal(t, domainSubscription.MonthlyRecurrency, first.Recurrency)
+			assert.Equal(t, 9.99, first.Price.Value())
+			assert.Equal(t, currency.USD, first.Price.Currency())
+			assert.ElementsMatch(t, []string{"Label 1", "Label 2"}, first.Labels)
+
+			second := handler.received.Rows[1]
+			assert.Equal(t, "provider-2", second.ProviderKey)
+			assert.Equal(t, types.FamilyOwnerType, second.Owner)
+			assert.NotNil(t, second.EndDate)
+			assert.Equal(t, []string{"Label 1"}, second.Labels)
+
+			var response dto.SubscriptionImportResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.True(t, response.DryRun)
+			assert.Equal(t, 2, response.Total)
+			assert.Equal(t, 2, response.Valid)
+		})
+	}
+}
+
+func TestImportEndpoint_RoundTripsTheContractAndHistory(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	pauseEnd := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	memberID1 := types.MustParseFamilyMemberID("00000000-0000-0000-0000-000000000201")
+	memberID2 := types.MustParseFamilyMemberID("00000000-0000-0000-0000-000000000202")
+	paymentMethodID := types.MustParsePaymentMethodID("00000000-0000-0000-0000-000000000301")
+	familyID := types.MustParseFamilyID("00000000-0000-0000-0000-000000000456")
+	providerID, _ := types.ParseProviderID("00000000-0000-0000-0000-000000000101")
+	shares := []domainSubscription.CostShare{
+		{MemberId: memberID1, Value: decimal.NewFromInt(60)},
+		{MemberId: memberID2, Value: decimal.NewFromInt(40)},
+	}
+	sub := domainSubscription.NewSubscription(
+		types.MustParseSubscriptionID("00000000-0000-0000-0000-000000000001"),
+		nil,
+		nil,
+		domainSubscription.NewContract(x.P(int32(12)), true, x.P(int32(1))),
+		providerID,
+		domainSubscription.NewPrice(currency.NewAmount(12.99, currency.USD)),
+		[]domainSubscription.PriceChange{
+			{EffectiveFrom: startDate, Amount: currency.NewAmount(9.99, currency.USD)},
+			{EffectiveFrom: startDate.AddDate(0, 6, 0), Amount: currency.NewAmount(12.99, currency.USD)},
+		},
+		[]domainSubscription.Promotion{
+			{StartDate: startDate, EndDate: startDate.AddDate(0, 2, 0), Amount: currency.NewAmount(4.99, currency.USD)},
+		},
+		types.NewFamilyOwner(familyID),
+		nil,
+		domainSubscription.NewCostSplit(domainSubscription.PercentageCostSplit, shares),
+		&paymentMethodID,
+		[]types.FamilyMemberID{memberID1, memberID2},
+		nil,
+		startDate,
+		nil,
+		[]domainSubscription.Pause{
+			{StartDate: startDate.AddDate(0, 2, 0), EndDate: &pauseEnd},
+			{StartDate: startDate.AddDate(0, 9, 0)},
+		},
+		domainSubscription.MonthlyRecurrency,
+		nil,
+		nil,
+		startDate,
+		startDate,
+	)
+
+	for _, format := range []string{"csv", "json", "yaml"} {
+		t.Run(format, func(t *testing.T) {
+			body := exportSubscriptions(t, format, []domainSubscription.Subscription{sub})
+
+			handler := &mockImportCommandHandler{}
+			endpoint := subscription.NewImportEndpoint(handler, export.NewExportService())
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/subscriptions/import?dry_run=true&format="+format,
+				bytes.NewReader(body))
+
+			endpoint.Handle(c)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.NotNil(t, handler.received)
+			require.Len(t, handler.received.Rows, 1)
+			row := handler.received.Rows[0]
+
+			require.NotNil(t, row.Contract)
+			assert.Equal(t, int32(12), *row.Contract.MinimumTerm())
+			assert.True(t, row.Contract.AutoRenew())
+			assert.Equal(t, int32(1), *row.Contract.NoticePeriod())
+
+			require.NotNil(t, row.CostSplit)
+			assert.Equal(t, domainSubscription.PercentageCostSplit, row.CostSplit.Type())
+			require.Len(t, row.CostSplit.Shares(), 2)
+			assert.Equal(t, memberID1, row.CostSplit.Shares()[0].MemberId)
+			assert.True(t, decimal.NewFromInt(60).Equal(row.CostSplit.Shares()[0].Value))
+			assert.Equal(t, memberID2, row.CostSplit.Shares()[1].MemberId)
+			assert.True(t, decimal.NewFromInt(40).Equal(row.CostSplit.Shares()[1].Value))
+
+			require.NotNil(t, row.PaymentMethodID)
+			assert.Equal(t, paymentMethodID, *row.PaymentMethodID)
+
+			require.Len(t, row.PriceHistory, 2)
+			assert.True(t, startDate.Equal(row.PriceHistory[0].EffectiveFrom))
+			assert.Equal(t, 9.99, row.PriceHistory[0].Amount.Value())
+			assert.True(t, startDate.AddDate(0, 6, 0).Equal(row.PriceHistory[1].EffectiveFrom))
+			assert.Equal(t, 12.99, row.PriceHistory[1].Amount.Value())
+			assert.Equal(t, currency.USD, row.PriceHistory[1].Amount.Currency())
+
+			require.Len(t, row.Promotions, 1)
+			assert.True(t, startDate.Equal(row.Promotions[0].StartDate))
+			assert.True(t, startDate.AddDate(0, 2, 0).Equal(row.Promotions[0].EndDate))
+			assert.Equal(t, 4.99, row.Promotions[0].Amount.Value())
+
+			require.Len(t, row.Pauses, 2)
+			assert.True(t, startDate.AddDate(0, 2, 0).Equal(row.Pauses[0].StartDate))
+			require.NotNil(t, row.Pauses[0].EndDate)
+			assert.True(t, pauseEnd.Equal(*row.Pauses[0].EndDate))
+			assert.True(t, startDate.AddDate(0, 9, 0).Equal(row.Pauses[1].StartDate))
+			assert.Nil(t, row.Pauses[1].EndDate)
+		})
+	}
+}
+
+func TestImportEndpoint_MultipartUpload(t *testing.T) {
+	body := exportTestSubscriptions(t, "csv")
+
+	var form bytes.Buffer
+	writer := multipart.NewWriter(&form)
+	part, err := writer.CreateFormFile("file", "subscriptions.csv")
+	require.NoError(t, err)
+	_, err = part.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	handler := &mockImportCommandHandler{}
+	endpoint := subscription.NewImportEndpoint(handler, export.NewExportService())
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/subscriptions/import", &form)
+	c.Request.Header.Set("Content-Type", writer.FormDataContentType())
+
+	endpoint.Handle(c)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, handler.received)
+	assert.False(t, handler.received.DryRun)
+	assert.Len(t, handler.received.Rows, 2)
+}
+
+func TestImportEndpoint_ReportsUnparsableRows(t *testing.T) {
+	input := `[
+  {"id": "00000000-0000-0000-0000-000000000001", "providerKey": "provider-1", "startDate": "2025-01-01",
+   "recurrency": "monthly", "amount": 9.99, "currency": "USD", "ownerType": "personal"},
+  {"id": "", "providerKey": "provider-1", "startDate": "01/01/2025",
+   "recurrency": "monthly", "amount": 9.99, "currency": "USD", "ownerType": "personal"}
+]`
+	handler := &mockImportCommandHandler{}
+	endpoint := subscription.NewImportEndpoint(handler, export.NewExportService())
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/subscriptions/import", strings.NewReader(input))
+
+	endpoint.Handle(c)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Len(t, handler.received.Rows, 1)
+
+	var response dto.SubscriptionImportResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response.Rows, 2)
+	assert.Equal(t, "valid", response.Rows[0].Status)
+	assert.Equal(t, "failed", response.Rows[1].Status)
+	require.Len(t, response.Rows[1].Errors, 1)
+	assert.Equal(t, "startDate", *response.Rows[1].Errors[0].Field)
+	assert.Equal(t, 1, response.Failed)
+}
+
+func TestImportEndpoint_InvalidFormat(t *testing.T) {
+	endpoint := subscription.NewImportEndpoint(&mockImportCommandHandler{}, export.NewExportService())
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/subscriptions/import?format=xml", strings.NewReader("<xml/>"))
+
+	endpoint.Handle(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
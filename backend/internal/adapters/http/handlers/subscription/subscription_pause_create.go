@@ -0,0 +1,84 @@
+package subscription
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+)
+
+type PauseEndpoint struct {
+	handler ports.CommandHandler[command.PauseSubscriptionCommand, subscription.Subscription]
+}
+
+func NewPauseEndpoint(
+	handler ports.CommandHandler[command.PauseSubscriptionCommand, subscription.Subscription]) *PauseEndpoint {
+	return &PauseEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Pause a subscription
+//	@Description	Suspend the subscription from the given date, the paused period is excluded from spending and renewals. Omit the end date when the resume date is unknown
+//	@Tags			subscriptions
+//	@Accept			json
+//	@Produce		json
+//	@Param			subscriptionId	path		string							true	"Subscription ID (UUID format)"
+//	@Param			pause			body		dto.PauseSubscriptionRequest	true	"Pause data"
+//	@Success		201				{object}	dto.SubscriptionModel			"Successfully paused subscription"
+//	@Failure		400				{object}	HttpErrorResponse				"Bad Request - Invalid input data"
+//	@Failure		401				{object}	HttpErrorResponse				"Unauthorized - Invalid user authentication"
+//	@Failure		404				{object}	HttpErrorResponse				"Subscription not found"
+//	@Failure		409				{object}	HttpErrorResponse				"Subscription is already paused during this period"
+//	@Failure		500				{object}	HttpErrorResponse				"Internal Server Error"
+//	@Router			/subscriptions/{subscriptionId}/pauses [post]
+func (e PauseEndpoint) Handle(c *gin.Context) {
+	subscriptionID, err := types.ParseSubscriptionID(c.Param("subscriptionId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	var model dto.PauseSubscriptionRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.PauseSubscriptionCommand{
+		SubscriptionID: subscriptionID,
+		StartDate:      model.StartDate,
+		EndDate:        model.EndDate,
+		UpdatedAt:      option.New(model.UpdatedAt),
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithStatus[subscription.Subscription](http.StatusCreated),
+		WithMapping[subscription.Subscription](func(sub subscription.Subscription) any {
+			return dto.NewSubscriptionModel(sub)
+		}))
+}
+
+func (e PauseEndpoint) Pattern() []string {
+	return []string{
+		"/:subscriptionId/pauses",
+	}
+}
+
+func (e PauseEndpoint) Method() string {
+	return http.MethodPost
+}
+
+func (e PauseEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -0,0 +1,67 @@
+package subscription
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+)
+
+type GetPausesEndpoint struct {
+	handler ports.QueryHandler[query.FindPausesQuery, []subscription.Pause]
+}
+
+func NewGetPausesEndpoint(
+	handler ports.QueryHandler[query.FindPausesQuery, []subscription.Pause]) *GetPausesEndpoint {
+	return &GetPausesEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Get subscription pauses
+//	@Description	Retrieve the periods during which a subscription is suspended ordered by start date
+//	@Tags			subscriptions
+//	@Produce		json
+//	@Param			subscriptionId	path		string						true	"Subscription ID (UUID format)"
+//	@Success		200				{array}		dto.SubscriptionPauseModel	"Successfully retrieved pauses"
+//	@Failure		400				{object}	HttpErrorResponse			"Bad Request - Invalid subscription ID format"
+//	@Failure		404				{object}	HttpErrorResponse			"Subscription not found"
+//	@Failure		500				{object}	HttpErrorResponse			"Internal Server Error"
+//	@Router			/subscriptions/{subscriptionId}/pauses [get]
+func (e GetPausesEndpoint) Handle(c *gin.Context) {
+	subscriptionID, err := types.ParseSubscriptionID(c.Param("subscriptionId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	q := query.NewFindPausesQuery(subscriptionID)
+	r := e.handler.Handle(c, q)
+	FromResult(c,
+		r,
+		WithMapping[[]subscription.Pause](func(pauses []subscription.Pause) any {
+			return herd.Select(pauses, dto.NewSubscriptionPauseModel)
+		}))
+}
+
+func (e GetPausesEndpoint) Pattern() []string {
+	return []string{
+		"/:subscriptionId/pauses",
+	}
+}
+
+func (e GetPausesEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetPausesEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -0,0 +1,90 @@
+package subscription
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+)
+
+type AddPriceChangeEndpoint struct {
+	handler ports.CommandHandler[command.AddPriceChangeCommand, subscription.Subscription]
+}
+
+func NewAddPriceChangeEndpoint(
+	handler ports.CommandHandler[command.AddPriceChangeCommand, subscription.Subscription]) *AddPriceChangeEndpoint {
+	return &AddPriceChangeEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Add a price change to a subscription
+//	@Description	Record a new price for the subscription effective from the given date, earlier periods keep the price that was in effect then
+//	@Tags			subscriptions
+//	@Accept			json
+//	@Produce		json
+//	@Param			subscriptionId	path		string									true	"Subscription ID (UUID format)"
+//	@Param			priceChange		body		dto.AddSubscriptionPriceChangeRequest	true	"Price change data"
+//	@Success		201				{object}	dto.SubscriptionModel					"Successfully added price change"
+//	@Failure		400				{object}	HttpErrorResponse						"Bad Request - Invalid input data"
+//	@Failure		401				{object}	HttpErrorResponse						"Unauthorized - Invalid user authentication"
+//	@Failure		404				{object}	HttpErrorResponse						"Subscription not found"
+//	@Failure		409				{object}	HttpErrorResponse						"A price change already exists at this date"
+//	@Failure		500				{object}	HttpErrorResponse						"Internal Server Error"
+//	@Router			/subscriptions/{subscriptionId}/prices [post]
+func (e AddPriceChangeEndpoint) Handle(c *gin.Context) {
+	subscriptionID, err := types.ParseSubscriptionID(c.Param("subscriptionId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	var model dto.AddSubscriptionPriceChangeRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	amount, err := model.Price.Amount()
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.AddPriceChangeCommand{
+		SubscriptionID: subscriptionID,
+		EffectiveFrom:  model.EffectiveFrom,
+		Amount:         amount,
+		UpdatedAt:      option.New(model.UpdatedAt),
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithStatus[subscription.Subscription](http.StatusCreated),
+		WithMapping[subscription.Subscription](func(sub subscription.Subscription) any {
+			return dto.NewSubscriptionModel(sub)
+		}))
+}
+
+func (e AddPriceChangeEndpoint) Pattern() []string {
+	return []string{
+		"/:subscriptionId/prices",
+	}
+}
+
+func (e AddPriceChangeEndpoint) Method() string {
+	return http.MethodPost
+}
+
+func (e AddPriceChangeEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
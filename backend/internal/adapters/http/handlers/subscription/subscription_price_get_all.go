@@ -0,0 +1,67 @@
+package subscription
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+)
+
+type GetPriceHistoryEndpoint struct {
+	handler ports.QueryHandler[query.FindPriceHistoryQuery, []subscription.PriceChange]
+}
+
+func NewGetPriceHistoryEndpoint(
+	handler ports.QueryHandler[query.FindPriceHistoryQuery, []subscription.PriceChange]) *GetPriceHistoryEndpoint {
+	return &GetPriceHistoryEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Get subscription price history
+//	@Description	Retrieve the dated price timeline of a subscription ordered by effective date
+//	@Tags			subscriptions
+//	@Produce		json
+//	@Param			subscriptionId	path		string								true	"Subscription ID (UUID format)"
+//	@Success		200				{array}		dto.SubscriptionPriceChangeModel	"Successfully retrieved price history"
+//	@Failure		400				{object}	HttpErrorResponse					"Bad Request - Invalid subscription ID format"
+//	@Failure		404				{object}	HttpErrorResponse					"Subscription not found"
+//	@Failure		500				{object}	HttpErrorResponse					"Internal Server Error"
+//	@Router			/subscriptions/{subscriptionId}/prices [get]
+func (e GetPriceHistoryEndpoint) Handle(c *gin.Context) {
+	subscriptionID, err := types.ParseSubscriptionID(c.Param("subscriptionId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	q := query.NewFindPriceHistoryQuery(subscriptionID)
+	r := e.handler.Handle(c, q)
+	FromResult(c,
+		r,
+		WithMapping[[]subscription.PriceChange](func(changes []subscription.PriceChange) any {
+			return herd.Select(changes, dto.NewSubscriptionPriceChangeModel)
+		}))
+}
+
+func (e GetPriceHistoryEndpoint) Pattern() []string {
+	return []string{
+		"/:subscriptionId/prices",
+	}
+}
+
+func (e GetPriceHistoryEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetPriceHistoryEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
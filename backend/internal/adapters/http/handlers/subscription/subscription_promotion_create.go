@@ -0,0 +1,92 @@
+package subscription
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+)
+
+type AddPromotionEndpoint struct {
+	handler ports.CommandHandler[command.AddPromotionCommand, subscription.Subscription]
+}
+
+func NewAddPromotionEndpoint(
+	handler ports.CommandHandler[command.AddPromotionCommand, subscription.Subscription]) *AddPromotionEndpoint {
+	return &AddPromotionEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Add a promotion to a subscription
+//	@Description	Charge a discounted price for a number of months or until an end date, the regular price applies automatically afterward. The promotion starts with the subscription when no start date is given
+//	@Tags			subscriptions
+//	@Accept			json
+//	@Produce		json
+//	@Param			subscriptionId	path		string								true	"Subscription ID (UUID format)"
+//	@Param			promotion		body		dto.AddSubscriptionPromotionRequest	true	"Promotion data"
+//	@Success		201				{object}	dto.SubscriptionModel				"Successfully added promotion"
+//	@Failure		400				{object}	HttpErrorResponse					"Bad Request - Invalid input data"
+//	@Failure		401				{object}	HttpErrorResponse					"Unauthorized - Invalid user authentication"
+//	@Failure		404				{object}	HttpErrorResponse					"Subscription not found"
+//	@Failure		409				{object}	HttpErrorResponse					"A promotion already applies during this period"
+//	@Failure		500				{object}	HttpErrorResponse					"Internal Server Error"
+//	@Router			/subscriptions/{subscriptionId}/promotions [post]
+func (e AddPromotionEndpoint) Handle(c *gin.Context) {
+	subscriptionID, err := types.ParseSubscriptionID(c.Param("subscriptionId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	var model dto.AddSubscriptionPromotionRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	amount, err := model.Price.Amount()
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.AddPromotionCommand{
+		SubscriptionID: subscriptionID,
+		StartDate:      model.StartDate,
+		EndDate:        model.EndDate,
+		DurationMonths: model.DurationMonths,
+		Amount:         amount,
+		UpdatedAt:      option.New(model.UpdatedAt),
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithStatus[subscription.Subscription](http.StatusCreated),
+		WithMapping[subscription.Subscription](func(sub subscription.Subscription) any {
+			return dto.NewSubscriptionModel(sub)
+		}))
+}
+
+func (e AddPromotionEndpoint) Pattern() []string {
+	return []string{
+		"/:subscriptionId/promotions",
+	}
+}
+
+func (e AddPromotionEndpoint) Method() string {
+	return http.MethodPost
+}
+
+func (e AddPromotionEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -0,0 +1,67 @@
+package subscription
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+)
+
+type GetPromotionsEndpoint struct {
+	handler ports.QueryHandler[query.FindPromotionsQuery, []subscription.Promotion]
+}
+
+func NewGetPromotionsEndpoint(
+	handler ports.QueryHandler[query.FindPromotionsQuery, []subscription.Promotion]) *GetPromotionsEndpoint {
+	return &GetPromotionsEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Get subscription promotions
+//	@Description	Retrieve the discounted pricing phases of a subscription ordered by start date
+//	@Tags			subscriptions
+//	@Produce		json
+//	@Param			subscriptionId	path		string							true	"Subscription ID (UUID format)"
+//	@Success		200				{array}		dto.SubscriptionPromotionModel	"Successfully retrieved promotions"
+//	@Failure		400				{object}	HttpErrorResponse				"Bad Request - Invalid subscription ID format"
+//	@Failure		404				{object}	HttpErrorResponse				"Subscription not found"
+//	@Failure		500				{object}	HttpErrorResponse				"Internal Server Error"
+//	@Router			/subscriptions/{subscriptionId}/promotions [get]
+func (e GetPromotionsEndpoint) Handle(c *gin.Context) {
+	subscriptionID, err := types.ParseSubscriptionID(c.Param("subscriptionId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	q := query.NewFindPromotionsQuery(subscriptionID)
+	r := e.handler.Handle(c, q)
+	FromResult(c,
+		r,
+		WithMapping[[]subscription.Promotion](func(promotions []subscription.Promotion) any {
+			return herd.Select(promotions, dto.NewSubscriptionPromotionModel)
+		}))
+}
+
+func (e GetPromotionsEndpoint) Pattern() []string {
+	return []string{
+		"/:subscriptionId/promotions",
+	}
+}
+
+func (e GetPromotionsEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetPromotionsEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -0,0 +1,81 @@
+package subscription
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+)
+
+type ResumeEndpoint struct {
+	handler ports.CommandHandler[command.ResumeSubscriptionCommand, subscription.Subscription]
+}
+
+func NewResumeEndpoint(
+	handler ports.CommandHandler[command.ResumeSubscriptionCommand, subscription.Subscription]) *ResumeEndpoint {
+	return &ResumeEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Resume a paused subscription
+//	@Description	End the pause in effect at the given date, the subscription is resumed immediately when no date is provided
+//	@Tags			subscriptions
+//	@Accept			json
+//	@Produce		json
+//	@Param			subscriptionId	path		string							true	"Subscription ID (UUID format)"
+//	@Param			resume			body		dto.ResumeSubscriptionRequest	true	"Resume data"
+//	@Success		200				{object}	dto.SubscriptionModel			"Successfully resumed subscription"
+//	@Failure		400				{object}	HttpErrorResponse				"Bad Request - Subscription is not paused"
+//	@Failure		401				{object}	HttpErrorResponse				"Unauthorized - Invalid user authentication"
+//	@Failure		404				{object}	HttpErrorResponse				"Subscription not found"
+//	@Failure		500				{object}	HttpErrorResponse				"Internal Server Error"
+//	@Router			/subscriptions/{subscriptionId}/resume [post]
+func (e ResumeEndpoint) Handle(c *gin.Context) {
+	subscriptionID, err := types.ParseSubscriptionID(c.Param("subscriptionId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	var model dto.ResumeSubscriptionRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.ResumeSubscriptionCommand{
+		SubscriptionID: subscriptionID,
+		ResumedAt:      option.New(model.ResumedAt),
+		UpdatedAt:      option.New(model.UpdatedAt),
+	}
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithMapping[subscription.Subscription](func(sub subscription.Subscription) any {
+			return dto.NewSubscriptionModel(sub)
+		}))
+}
+
+func (e ResumeEndpoint) Pattern() []string {
+	return []string{
+		"/:subscriptionId/resume",
+	}
+}
+
+func (e ResumeEndpoint) Method() string {
+	return http.MethodPost
+}
+
+func (e ResumeEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -0,0 +1,104 @@
+package subscription
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	. "github.com/mistribe/subtracker/pkg/ginx"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
+)
+
+type SpendingEndpoint struct {
+	handler ports.QueryHandler[query.SpendingQuery, query.SpendingQueryResponse]
+}
+
+func NewSpendingEndpoint(handler ports.QueryHandler[query.SpendingQuery, query.SpendingQueryResponse]) *SpendingEndpoint {
+	return &SpendingEndpoint{handler: handler}
+}
+
+// Handle godoc
+//
+//	@Summary		Get subscription spending over time
+//	@Description	Returns what was spent on the subscriptions in each month, quarter or year of the range, grouped by provider, label, payer or owner type. Each bucket is converted into the preferred currency at the rate of its first day.
+//	@Tags			subscriptions
+//	@Produce		json
+//	@Param			from		query		string	false	"First day of the range (RFC3339), twelve buckets before the end by default"
+//	@Param			to			query		string	false	"Last day of the range (RFC3339), today by default"
+//	@Param			bucket		query		string	false	"Size of the buckets"		Enums(month, quarter, year)					default(month)
+//	@Param			group_by	query		string	false	"Grouping of the spending"	Enums(provider, label, payer, owner_type)	default(provider)
+//	@Success		200			{object}	dto.SubscriptionSpendingResponse
+//	@Failure		400			{object}	HttpErrorResponse
+//	@Router			/subscriptions/spending [get]
+func (e SpendingEndpoint) Handle(c *gin.Context) {
+	var model dto.SubscriptionSpendingRequest
+	if err := c.ShouldBindQuery(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	bucket := subscription.MonthSpendingBucket
+	if model.Bucket != "" {
+		var err error
+		bucket, err = subscription.ParseSpendingBucket(model.Bucket)
+		if err != nil {
+			FromError(c, err)
+			return
+		}
+	}
+	groupBy := subscription.ProviderSpendingDimension
+	if model.GroupBy != "" {
+		var err error
+		groupBy, err = subscription.ParseSpendingDimension(model.GroupBy)
+		if err != nil {
+			FromError(c, err)
+			return
+		}
+	}
+
+	r := e.handler.Handle(c, query.NewSpendingQuery(model.From, model.To, bucket, groupBy))
+	FromResult(c,
+		r,
+		WithMapping[query.SpendingQueryResponse](func(res query.SpendingQueryResponse) any {
+			return dto.SubscriptionSpendingResponse{
+				Bucket:  res.Bucket.String(),
+				GroupBy: res.GroupBy.String(),
+				Keys:    res.Keys,
+				Buckets: herd.Select(res.Buckets,
+					func(bucket query.SpendingBucketResponse) dto.SubscriptionSpendingBucketResponse {
+						return dto.SubscriptionSpendingBucketResponse{
+							Start: bucket.Start,
+							End:   bucket.End,
+							Total: dto.NewAmount(bucket.Total),
+							Groups: herd.Select(bucket.Groups,
+								func(group query.SpendingGroupResponse) dto.SubscriptionSpendingGroupResponse {
+									return dto.SubscriptionSpendingGroupResponse{
+										Key:   group.Key,
+										Total: dto.NewAmount(group.Total),
+									}
+								}),
+						}
+					}),
+				Total: dto.NewAmount(res.Total),
+			}
+		}))
+}
+
+func (e SpendingEndpoint) Pattern() []string {
+	return []string{
+		"/spending",
+	}
+}
+
+func (e SpendingEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e SpendingEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -92,6 +92,8 @@ func (e SummaryEndpoint) Handle(c *gin.Context) {
 							SubscriptionId: upcomingRenewal.SubscriptionId.String(),
 							At:             upcomingRenewal.At,
 							Total:          dto.NewAmount(upcomingRenewal.Total),
+
+							CancellationDeadline: upcomingRenewal.CancellationDeadline,
 						}
 						if upcomingRenewal.Source != nil {
 							s := dto.NewAmount(*upcomingRenewal.Source)
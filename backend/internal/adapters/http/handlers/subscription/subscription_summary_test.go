@@ -465,20 +465,20 @@ func TestDTOMapping_DirectConversion(t *testing.T) {
 	assert.Equal(t, domainResponse.ActivePersonal, dtoResponse.ActivePersonal)
 	assert.Equal(t, domainResponse.ActiveFamily, dtoResponse.ActiveFamily)
 
-	assert.Equal(t, domainResponse.TotalMonthly.Value(), dtoResponse.TotalMonthly.Value)
-	assert.Equal(t, domainResponse.TotalYearly.Value(), dtoResponse.TotalYearly.Value)
-	assert.Equal(t, domainResponse.TotalLastMonth.Value(), dtoResponse.TotalLastMonth.Value)
-	assert.Equal(t, domainResponse.TotalLastYear.Value(), dtoResponse.TotalLastYear.Value)
-
-	assert.Equal(t, domainResponse.PersonalMonthly.Value(), dtoResponse.PersonalMonthly.Value)
-	assert.Equal(t, domainResponse.PersonalYearly.Value(), dtoResponse.PersonalYearly.Value)
-	assert.Equal(t, domainResponse.PersonalLastMonth.Value(), dtoResponse.PersonalLastMonth.Value)
-	assert.Equal(t, domainResponse.PersonalLastYear.Value(), dtoResponse.PersonalLastYear.Value)
-
-	assert.Equal(t, domainResponse.FamilyMonthly.Value(), dtoResponse.FamilyMonthly.Value)
-	assert.Equal(t, domainResponse.FamilyYearly.Value(), dtoResponse.FamilyYearly.Value)
-	assert.Equal(t, domainResponse.FamilyLastMonth.Value(), dtoResponse.FamilyLastMonth.Value)
-	assert.Equal(t, domainResponse.FamilyLastYear.Value(), dtoResponse.FamilyLastYear.Value)
+	assert.True(t, domainResponse.TotalMonthly.Decimal().Equal(dtoResponse.TotalMonthly.Value))
+	assert.True(t, domainResponse.TotalYearly.Decimal().Equal(dtoResponse.TotalYearly.Value))
+	assert.True(t, domainResponse.TotalLastMonth.Decimal().Equal(dtoResponse.TotalLastMonth.Value))
+	assert.True(t, domainResponse.TotalLastYear.Decimal().Equal(dtoResponse.TotalLastYear.Value))
+
+	assert.True(t, domainResponse.PersonalMonthly.Decimal().Equal(dtoResponse.PersonalMonthly.Value))
+	assert.True(t, domainResponse.PersonalYearly.Decimal().Equal(dtoResponse.PersonalYearly.Value))
+	assert.True(t, domainResponse.PersonalLastMonth.Decimal().Equal(dtoResponse.PersonalLastMonth.Value))
+	assert.True(t, domainResponse.PersonalLastYear.Decimal().Equal(dtoResponse.PersonalLastYear.Value))
+
+	assert.True(t, domainResponse.FamilyMonthly.Decimal().Equal(dtoResponse.FamilyMonthly.Value))
+	assert.True(t, domainResponse.FamilyYearly.Decimal().Equal(dtoResponse.FamilyYearly.Value))
+	assert.True(t, domainResponse.FamilyLastMonth.Decimal().Equal(dtoResponse.FamilyLastMonth.Value))
+	assert.True(t, domainResponse.FamilyLastYear.Decimal().Equal(dtoResponse.FamilyLastYear.Value))
 
 	// Verify currency codes match
 	assert.Equal(t, "USD", dtoResponse.TotalMonthly.Currency)
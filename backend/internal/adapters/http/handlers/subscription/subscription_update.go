@@ -79,6 +79,18 @@ func updateSubscriptionRequestToCommand(
 	if err != nil {
 		return command.UpdateSubscriptionCommand{}, err
 	}
+	costSplit, err := dto.NewSubscriptionCostSplit(r.CostSplit)
+	if err != nil {
+		return command.UpdateSubscriptionCommand{}, err
+	}
+	customRecurrency, err := dto.NewSubscriptionCustomRecurrency(r.CustomRecurrency, r.CustomRecurrencyUnit)
+	if err != nil {
+		return command.UpdateSubscriptionCommand{}, err
+	}
+	paymentMethodID, err := types.ParsePaymentMethodIDOrNil(r.PaymentMethodId)
+	if err != nil {
+		return command.UpdateSubscriptionCommand{}, err
+	}
 	if r.ProviderKey == nil && providerID == nil {
 		return command.UpdateSubscriptionCommand{}, errors.New("provider id or provider key is required")
 	}
@@ -86,18 +98,22 @@ func updateSubscriptionRequestToCommand(
 		SubscriptionID:   subscriptionID,
 		FriendlyName:     r.FriendlyName,
 		FreeTrial:        freeTrial,
+		Contract:         dto.NewSubscriptionContract(r.Contract),
 		ProviderID:       providerID,
 		ProviderKey:      r.ProviderKey,
 		Price:            price.Amount(),
 		Owner:            owner,
 		PayerType:        payerType,
 		PayerMemberId:    payerMemberId,
+		CostSplit:        costSplit,
+		PaymentMethodID:  paymentMethodID,
 		FamilyUsers:      familyUsers,
 		Labels:           labels,
 		StartDate:        r.StartDate,
 		EndDate:          r.EndDate,
 		Recurrency:       recurrency,
-		CustomRecurrency: r.CustomRecurrency,
+		CustomRecurrency: customRecurrency,
+		BillingAnchorDay: r.BillingAnchorDay,
 		UpdatedAt:        option.New(r.UpdatedAt),
 	}, nil
 }
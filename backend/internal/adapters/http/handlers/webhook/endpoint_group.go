@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/router/ginfx"
+	"github.com/mistribe/subtracker/internal/adapters/http/router/middlewares"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+)
+
+// EndpointGroup manages the webhook endpoints of the authenticated account and their delivery logs
+type EndpointGroup struct {
+	routes      []ginfx.Endpoint
+	middlewares []gin.HandlerFunc
+}
+
+func NewEndpointGroup(
+	getAllEndpoint *GetAllEndpoint,
+	getEndpoint *GetEndpoint,
+	createEndpoint *CreateEndpoint,
+	updateEndpoint *UpdateEndpoint,
+	deleteEndpoint *DeleteEndpoint,
+	getDeliveriesEndpoint *GetDeliveriesEndpoint,
+	redeliverEndpoint *RedeliverEndpoint,
+	authenticationMiddleware *middlewares.AuthenticationMiddleware) *EndpointGroup {
+	return &EndpointGroup{
+		routes: []ginfx.Endpoint{
+			getAllEndpoint,
+			getEndpoint,
+			createEndpoint,
+			updateEndpoint,
+			deleteEndpoint,
+			getDeliveriesEndpoint,
+			redeliverEndpoint,
+		},
+		middlewares: []gin.HandlerFunc{
+			authenticationMiddleware.Middleware(),
+		},
+	}
+}
+
+func (g EndpointGroup) Prefix() string {
+	return "/webhooks"
+}
+
+func (g EndpointGroup) Routes() []ginfx.Endpoint {
+	return g.routes
+}
+
+func (g EndpointGroup) Middlewares() []gin.HandlerFunc {
+	return g.middlewares
+}
+
+func parseEventTypes(input []string) ([]webhook.EventType, error) {
+	eventTypes := make([]webhook.EventType, 0, len(input))
+	for _, s := range input {
+		eventType, err := webhook.ParseEventType(s)
+		if err != nil {
+			return nil, err
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+	return eventTypes, nil
+}
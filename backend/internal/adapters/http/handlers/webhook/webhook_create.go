@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/webhook/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type CreateEndpoint struct {
+	handler ports.CommandHandler[command.CreateEndpointCommand, webhook.Endpoint]
+}
+
+func NewCreateEndpoint(handler ports.CommandHandler[command.CreateEndpointCommand, webhook.Endpoint]) *CreateEndpoint {
+	return &CreateEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Create a webhook endpoint
+//	@Description	Registers a URL the events of the authenticated account are posted to. The secret signing the requests is only returned by this call.
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.CreateWebhookEndpointRequest	true	"Webhook endpoint"
+//	@Success		201		{object}	dto.WebhookEndpointModel
+//	@Failure		400		{object}	HttpErrorResponse	"Bad Request - Invalid URL or event type, or endpoint limit reached"
+//	@Failure		401		{object}	HttpErrorResponse	"Unauthorized - Invalid user authentication"
+//	@Failure		500		{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/webhooks [post]
+func (e CreateEndpoint) Handle(c *gin.Context) {
+	var model dto.CreateWebhookEndpointRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	eventTypes, err := parseEventTypes(model.EventTypes)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.CreateEndpointCommand{
+		Url:        model.Url,
+		EventTypes: eventTypes,
+	}
+
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithStatus[webhook.Endpoint](http.StatusCreated),
+		WithMapping[webhook.Endpoint](func(endpoint webhook.Endpoint) any {
+			return dto.NewCreatedWebhookEndpointModel(endpoint)
+		}))
+}
+
+func (e CreateEndpoint) Pattern() []string {
+	return []string{
+		"",
+	}
+}
+
+func (e CreateEndpoint) Method() string {
+	return http.MethodPost
+}
+
+func (e CreateEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/webhook/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type DeleteEndpoint struct {
+	handler ports.CommandHandler[command.DeleteEndpointCommand, bool]
+}
+
+func NewDeleteEndpoint(handler ports.CommandHandler[command.DeleteEndpointCommand, bool]) *DeleteEndpoint {
+	return &DeleteEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Delete a webhook endpoint
+//	@Description	Permanently deletes a webhook endpoint of the authenticated account and its delivery log
+//	@Tags			webhooks
+//	@Param			endpointId	path	string	true	"Webhook endpoint ID (UUID format)"
+//	@Success		204			"No Content - Webhook endpoint successfully deleted"
+//	@Failure		400			{object}	HttpErrorResponse	"Bad Request - Invalid endpoint ID format"
+//	@Failure		401			{object}	HttpErrorResponse	"Unauthorized - Invalid user authentication"
+//	@Failure		404			{object}	HttpErrorResponse	"Webhook endpoint not found"
+//	@Failure		500			{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/webhooks/{endpointId} [delete]
+func (e DeleteEndpoint) Handle(c *gin.Context) {
+	endpointID, err := types.ParseWebhookEndpointID(c.Param("endpointId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	r := e.handler.Handle(c, command.DeleteEndpointCommand{EndpointID: endpointID})
+	FromResult(c, r, WithNoContent[bool]())
+}
+
+func (e DeleteEndpoint) Pattern() []string {
+	return []string{
+		"/:endpointId",
+	}
+}
+
+func (e DeleteEndpoint) Method() string {
+	return http.MethodDelete
+}
+
+func (e DeleteEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/internal/usecase/webhook/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type GetDeliveriesEndpoint struct {
+	handler ports.QueryHandler[query.FindDeliveriesQuery, shared.PaginatedResponse[webhook.Delivery]]
+}
+
+func NewGetDeliveriesEndpoint(
+	handler ports.QueryHandler[query.FindDeliveriesQuery, shared.PaginatedResponse[webhook.Delivery]]) *GetDeliveriesEndpoint {
+	return &GetDeliveriesEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Get the delivery log of a webhook endpoint
+//	@Description	Returns the deliveries of the events posted to a webhook endpoint of the authenticated account, the most recent first
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			endpointId	path		string													true	"Webhook endpoint ID (UUID format)"
+//	@Param			limit		query		integer													false	"Maximum number of items to return (default: 10)"
+//	@Param			offset		query		integer													false	"Number of items to skip for pagination (default: 0)"
+//	@Success		200			{object}	dto.PaginatedResponseModel[dto.WebhookDeliveryModel]	"Paginated list of deliveries"
+//	@Failure		400			{object}	HttpErrorResponse										"Bad Request - Invalid endpoint ID format"
+//	@Failure		401			{object}	HttpErrorResponse										"Unauthorized - Invalid user authentication"
+//	@Failure		404			{object}	HttpErrorResponse										"Webhook endpoint not found"
+//	@Failure		500			{object}	HttpErrorResponse										"Internal Server Error"
+//	@Router			/webhooks/{endpointId}/deliveries [get]
+func (e GetDeliveriesEndpoint) Handle(c *gin.Context) {
+	endpointID, err := types.ParseWebhookEndpointID(c.Param("endpointId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	offset, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	q := query.FindDeliveriesQuery{
+		EndpointID: endpointID,
+		Limit:      limit,
+		Offset:     offset,
+	}
+	r := e.handler.Handle(c, q)
+	FromResult(c,
+		r,
+		WithMapping[shared.PaginatedResponse[webhook.Delivery]](func(paginatedResult shared.PaginatedResponse[webhook.Delivery]) any {
+			return dto.NewPaginatedResponseModel(paginatedResult, dto.NewWebhookDeliveryModel)
+		}))
+}
+
+func (e GetDeliveriesEndpoint) Pattern() []string {
+	return []string{
+		"/:endpointId/deliveries",
+	}
+}
+
+func (e GetDeliveriesEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetDeliveriesEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
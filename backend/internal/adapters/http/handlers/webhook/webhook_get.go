@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/webhook/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type GetEndpoint struct {
+	handler ports.QueryHandler[query.FindEndpointQuery, webhook.Endpoint]
+}
+
+func NewGetEndpoint(handler ports.QueryHandler[query.FindEndpointQuery, webhook.Endpoint]) *GetEndpoint {
+	return &GetEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Get webhook endpoint by ID
+//	@Description	Returns a webhook endpoint of the authenticated account, its secret is not returned
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			endpointId	path		string	true	"Webhook endpoint ID (UUID format)"
+//	@Success		200			{object}	dto.WebhookEndpointModel
+//	@Failure		400			{object}	HttpErrorResponse	"Bad Request - Invalid endpoint ID format"
+//	@Failure		401			{object}	HttpErrorResponse	"Unauthorized - Invalid user authentication"
+//	@Failure		404			{object}	HttpErrorResponse	"Webhook endpoint not found"
+//	@Failure		500			{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/webhooks/{endpointId} [get]
+func (e GetEndpoint) Handle(c *gin.Context) {
+	endpointID, err := types.ParseWebhookEndpointID(c.Param("endpointId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	r := e.handler.Handle(c, query.FindEndpointQuery{EndpointID: endpointID})
+	FromResult(c, r, WithMapping[webhook.Endpoint](func(endpoint webhook.Endpoint) any {
+		return dto.NewWebhookEndpointModel(endpoint)
+	}))
+}
+
+func (e GetEndpoint) Pattern() []string {
+	return []string{
+		"/:endpointId",
+	}
+}
+
+func (e GetEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
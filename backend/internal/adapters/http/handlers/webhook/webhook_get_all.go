@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/webhook/query"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type GetAllEndpoint struct {
+	handler ports.QueryHandler[query.FindEndpointsQuery, []webhook.Endpoint]
+}
+
+func NewGetAllEndpoint(handler ports.QueryHandler[query.FindEndpointsQuery, []webhook.Endpoint]) *GetAllEndpoint {
+	return &GetAllEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Get all webhook endpoints
+//	@Description	Returns the webhook endpoints of the authenticated account, their secret is not returned
+//	@Tags			webhooks
+//	@Produce		json
+//	@Success		200	{array}		dto.WebhookEndpointModel
+//	@Failure		401	{object}	HttpErrorResponse	"Unauthorized - Invalid user authentication"
+//	@Failure		500	{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/webhooks [get]
+func (e GetAllEndpoint) Handle(c *gin.Context) {
+	r := e.handler.Handle(c, query.FindEndpointsQuery{})
+	FromResult(c, r, WithMapping[[]webhook.Endpoint](func(endpoints []webhook.Endpoint) any {
+		return dto.NewWebhookEndpointModels(endpoints)
+	}))
+}
+
+func (e GetAllEndpoint) Pattern() []string {
+	return []string{
+		"",
+	}
+}
+
+func (e GetAllEndpoint) Method() string {
+	return http.MethodGet
+}
+
+func (e GetAllEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
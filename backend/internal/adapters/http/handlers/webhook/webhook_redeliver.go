@@ -0,0 +1,78 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/webhook/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type RedeliverEndpoint struct {
+	handler ports.CommandHandler[command.RedeliverCommand, webhook.Delivery]
+}
+
+func NewRedeliverEndpoint(handler ports.CommandHandler[command.RedeliverCommand, webhook.Delivery]) *RedeliverEndpoint {
+	return &RedeliverEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Redeliver a webhook event
+//	@Description	Sends again the event of a delivery of the log, as a new delivery attempted as soon as possible
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			endpointId	path		string	true	"Webhook endpoint ID (UUID format)"
+//	@Param			deliveryId	path		string	true	"Webhook delivery ID (UUID format)"
+//	@Success		202			{object}	dto.WebhookDeliveryModel
+//	@Failure		400			{object}	HttpErrorResponse	"Bad Request - Invalid ID format or delivery still pending"
+//	@Failure		401			{object}	HttpErrorResponse	"Unauthorized - Invalid user authentication"
+//	@Failure		404			{object}	HttpErrorResponse	"Webhook endpoint or delivery not found"
+//	@Failure		500			{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/webhooks/{endpointId}/deliveries/{deliveryId}/redeliver [post]
+func (e RedeliverEndpoint) Handle(c *gin.Context) {
+	endpointID, err := types.ParseWebhookEndpointID(c.Param("endpointId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	deliveryID, err := types.ParseWebhookDeliveryID(c.Param("deliveryId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.RedeliverCommand{
+		EndpointID: endpointID,
+		DeliveryID: deliveryID,
+	}
+
+	r := e.handler.Handle(c, cmd)
+	FromResult(c,
+		r,
+		WithStatus[webhook.Delivery](http.StatusAccepted),
+		WithMapping[webhook.Delivery](func(delivery webhook.Delivery) any {
+			return dto.NewWebhookDeliveryModel(delivery)
+		}))
+}
+
+func (e RedeliverEndpoint) Pattern() []string {
+	return []string{
+		"/:endpointId/deliveries/:deliveryId/redeliver",
+	}
+}
+
+func (e RedeliverEndpoint) Method() string {
+	return http.MethodPost
+}
+
+func (e RedeliverEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mistribe/subtracker/internal/adapters/http/dto"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/webhook/command"
+	. "github.com/mistribe/subtracker/pkg/ginx"
+)
+
+type UpdateEndpoint struct {
+	handler ports.CommandHandler[command.UpdateEndpointCommand, webhook.Endpoint]
+}
+
+func NewUpdateEndpoint(handler ports.CommandHandler[command.UpdateEndpointCommand, webhook.Endpoint]) *UpdateEndpoint {
+	return &UpdateEndpoint{
+		handler: handler,
+	}
+}
+
+// Handle godoc
+//
+//	@Summary		Update a webhook endpoint
+//	@Description	Replaces the URL, the event types and the state of a webhook endpoint of the authenticated account
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			endpointId	path		string								true	"Webhook endpoint ID (UUID format)"
+//	@Param			request		body		dto.UpdateWebhookEndpointRequest	true	"Webhook endpoint"
+//	@Success		200			{object}	dto.WebhookEndpointModel
+//	@Failure		400			{object}	HttpErrorResponse	"Bad Request - Invalid URL or event type"
+//	@Failure		401			{object}	HttpErrorResponse	"Unauthorized - Invalid user authentication"
+//	@Failure		404			{object}	HttpErrorResponse	"Webhook endpoint not found"
+//	@Failure		500			{object}	HttpErrorResponse	"Internal Server Error"
+//	@Router			/webhooks/{endpointId} [put]
+func (e UpdateEndpoint) Handle(c *gin.Context) {
+	endpointID, err := types.ParseWebhookEndpointID(c.Param("endpointId"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	var model dto.UpdateWebhookEndpointRequest
+	if err := c.ShouldBindJSON(&model); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	eventTypes, err := parseEventTypes(model.EventTypes)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	cmd := command.UpdateEndpointCommand{
+		EndpointID: endpointID,
+		Url:        model.Url,
+		EventTypes: eventTypes,
+		Enabled:    model.Enabled,
+	}
+
+	r := e.handler.Handle(c, cmd)
+	FromResult(c, r, WithMapping[webhook.Endpoint](func(endpoint webhook.Endpoint) any {
+		return dto.NewWebhookEndpointModel(endpoint)
+	}))
+}
+
+func (e UpdateEndpoint) Pattern() []string {
+	return []string{
+		"/:endpointId",
+	}
+}
+
+func (e UpdateEndpoint) Method() string {
+	return http.MethodPut
+}
+
+func (e UpdateEndpoint) Middlewares() []gin.HandlerFunc {
+	return nil
+}
@@ -6,11 +6,16 @@ import (
 	"github.com/mistribe/subtracker/internal/adapters/http/export"
 	. "github.com/mistribe/subtracker/internal/adapters/http/handlers"
 	"github.com/mistribe/subtracker/internal/adapters/http/handlers/account"
+	"github.com/mistribe/subtracker/internal/adapters/http/handlers/budget"
+	"github.com/mistribe/subtracker/internal/adapters/http/handlers/calendar"
 	"github.com/mistribe/subtracker/internal/adapters/http/handlers/currency"
 	"github.com/mistribe/subtracker/internal/adapters/http/handlers/family"
 	"github.com/mistribe/subtracker/internal/adapters/http/handlers/label"
+	"github.com/mistribe/subtracker/internal/adapters/http/handlers/notification"
+	"github.com/mistribe/subtracker/internal/adapters/http/handlers/paymentmethod"
 	"github.com/mistribe/subtracker/internal/adapters/http/handlers/provider"
 	"github.com/mistribe/subtracker/internal/adapters/http/handlers/subscription"
+	"github.com/mistribe/subtracker/internal/adapters/http/handlers/webhook"
 	"github.com/mistribe/subtracker/internal/adapters/http/router/ginfx"
 	"github.com/mistribe/subtracker/internal/adapters/http/router/middlewares"
 )
@@ -29,8 +34,20 @@ func BuildRoutesModule() fx.Option {
 			subscription.NewUpdateEndpoint,
 			subscription.NewDeleteEndpoint,
 			subscription.NewSummaryEndpoint,
+			subscription.NewSpendingEndpoint,
+			subscription.NewForecastEndpoint,
 			subscription.NewGetQuotaUsageEndpoint,
 			subscription.NewExportEndpoint,
+			subscription.NewImportEndpoint,
+			subscription.NewAddPriceChangeEndpoint,
+			subscription.NewGetPriceHistoryEndpoint,
+			subscription.NewGetChargesEndpoint,
+			subscription.NewRecordChargeEndpoint,
+			subscription.NewPauseEndpoint,
+			subscription.NewResumeEndpoint,
+			subscription.NewGetPausesEndpoint,
+			subscription.NewAddPromotionEndpoint,
+			subscription.NewGetPromotionsEndpoint,
 			ginfx.AsEndpointGroup(subscription.NewEndpointGroup),
 
 			label.NewGetAllEndpoint,
@@ -42,6 +59,22 @@ func BuildRoutesModule() fx.Option {
 			label.NewExportEndpoint,
 			ginfx.AsEndpointGroup(label.NewEndpointGroup),
 
+			paymentmethod.NewGetAllEndpoint,
+			paymentmethod.NewGetEndpoint,
+			paymentmethod.NewCreateEndpoint,
+			paymentmethod.NewUpdateEndpoint,
+			paymentmethod.NewDeleteEndpoint,
+			paymentmethod.NewExpiringEndpoint,
+			ginfx.AsEndpointGroup(paymentmethod.NewEndpointGroup),
+
+			budget.NewGetAllEndpoint,
+			budget.NewGetEndpoint,
+			budget.NewCreateEndpoint,
+			budget.NewUpdateEndpoint,
+			budget.NewDeleteEndpoint,
+			budget.NewStatusEndpoint,
+			ginfx.AsEndpointGroup(budget.NewEndpointGroup),
+
 			family.NewCreateEndpoint,
 			family.NewUpdateEndpoint,
 			family.NewDeleteEndpoint,
@@ -55,6 +88,10 @@ func BuildRoutesModule() fx.Option {
 			family.NewMemberUpdateEndpoint,
 			family.NewMemberDeleteEndpoint,
 			family.NewGetQuotaUsageEndpoint,
+			family.NewSharesEndpoint,
+			family.NewLedgerEndpoint,
+			family.NewSettlementCreateEndpoint,
+			family.NewSettlementDeleteEndpoint,
 			ginfx.AsEndpointGroup(family.NewEndpointGroup),
 
 			provider.NewGetEndpoint,
@@ -68,14 +105,41 @@ func BuildRoutesModule() fx.Option {
 
 			currency.NewSupportedEndpoint,
 			currency.NewGetRateEndpoint,
+			currency.NewGetAllManualRatesEndpoint,
+			currency.NewCreateManualRateEndpoint,
+			currency.NewUpdateManualRateEndpoint,
+			currency.NewDeleteManualRateEndpoint,
+			currency.NewGetRateSyncEndpoint,
 			ginfx.AsEndpointGroup(currency.NewEndpointGroup),
 
 			account.NewUserGetPreferredCurrencyEndpoint,
 			account.NewUpdatePreferredCurrencyEndpoint,
+			account.NewGetPreferredTimezoneEndpoint,
+			account.NewUpdatePreferredTimezoneEndpoint,
 			account.NewDeleteEndpoint,
 			account.NewGetQuotaUsageEndpoint,
 			ginfx.AsEndpointGroup(account.NewEndpointGroup),
 
+			calendar.NewCreateFeedEndpoint,
+			calendar.NewRotateFeedEndpoint,
+			calendar.NewRevokeFeedEndpoint,
+			calendar.NewGetFeedEndpoint,
+			ginfx.AsEndpointGroup(calendar.NewEndpointGroup),
+			ginfx.AsEndpointGroup(calendar.NewFeedEndpointGroup),
+
+			notification.NewGetPreferencesEndpoint,
+			notification.NewUpdatePreferencesEndpoint,
+			ginfx.AsEndpointGroup(notification.NewEndpointGroup),
+
+			webhook.NewGetAllEndpoint,
+			webhook.NewGetEndpoint,
+			webhook.NewCreateEndpoint,
+			webhook.NewUpdateEndpoint,
+			webhook.NewDeleteEndpoint,
+			webhook.NewGetDeliveriesEndpoint,
+			webhook.NewRedeliverEndpoint,
+			ginfx.AsEndpointGroup(webhook.NewEndpointGroup),
+
 			ginfx.AsEndpoint(NewHealthCheckLiveEndpoint),
 			ginfx.AsEndpoint(NewVersionEndpoint),
 		),
@@ -90,3 +90,25 @@ func (r *Context) Execute(ctx context.Context, stmt postgres.Statement) (int64,
 	}
 	return result.RowsAffected()
 }
+
+// WithTransaction runs fn in a transaction committed when fn succeeds and rolled back otherwise, the queries run
+// with the context given to fn take part in it. fn joins the transaction of ctx when there is already one.
+func (r *Context) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(TransactionKey).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	sqlDB := stdlib.OpenDB(*r.pgxConfig)
+	defer sqlDB.Close()
+	tx, err := sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	// Rolling back a committed transaction does nothing
+	defer tx.Rollback()
+
+	if err = fn(context.WithValue(ctx, TransactionKey, tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
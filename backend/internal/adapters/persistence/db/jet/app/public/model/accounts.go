@@ -21,4 +21,5 @@ type Accounts struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	Etag      string
+	Timezone  *string
 }
@@ -9,27 +9,36 @@ package model
 
 import (
 	"github.com/google/uuid"
+	"github.com/mistribe/subtracker/pkg/decimal"
 	"time"
 )
 
 type Subscriptions struct {
-	ID                  uuid.UUID `sql:"primary_key"`
-	OwnerType           string
-	OwnerFamilyID       *uuid.UUID
-	OwnerUserID         *string
-	FriendlyName        *string
-	FreeTrialStartDate  *time.Time
-	FreeTrialEndDate    *time.Time
-	ProviderID          uuid.UUID
-	PayerType           *string
-	PayerMemberID       *uuid.UUID
-	StartDate           time.Time
-	EndDate             *time.Time
-	Recurrency          string
-	CustomRecurrency    *int32
-	CustomPriceCurrency *string
-	CustomPriceAmount   *float64
-	CreatedAt           time.Time
-	UpdatedAt           time.Time
-	Etag                string
+	ID                    uuid.UUID `sql:"primary_key"`
+	OwnerType             string
+	OwnerFamilyID         *uuid.UUID
+	OwnerUserID           *string
+	FriendlyName          *string
+	FreeTrialStartDate    *time.Time
+	FreeTrialEndDate      *time.Time
+	ProviderID            uuid.UUID
+	PayerType             *string
+	PayerMemberID         *uuid.UUID
+	StartDate             time.Time
+	EndDate               *time.Time
+	Recurrency            string
+	CustomRecurrencyCount *int32
+	CustomRecurrencyUnit  *string
+	BillingAnchorDay      *int32
+	CustomPriceCurrency   *string
+	CustomPriceAmount     *decimal.Decimal
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	Etag                  string
+	ContractMinimumTerm   *int32
+	ContractAutoRenew     *bool
+	ContractNoticePeriod  *int32
+	CostSplitType         *string
+	PaymentMethodID       *uuid.UUID
+	CancellationDeadline  *time.Time
 }
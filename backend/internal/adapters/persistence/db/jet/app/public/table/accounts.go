@@ -25,6 +25,7 @@ type accountsTable struct {
 	CreatedAt postgres.ColumnTimestamp
 	UpdatedAt postgres.ColumnTimestamp
 	Etag      postgres.ColumnString
+	Timezone  postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -74,8 +75,9 @@ func newAccountsTableImpl(schemaName, tableName, alias string) accountsTable {
 		CreatedAtColumn = postgres.TimestampColumn("created_at")
 		UpdatedAtColumn = postgres.TimestampColumn("updated_at")
 		EtagColumn      = postgres.StringColumn("etag")
-		allColumns      = postgres.ColumnList{IDColumn, CurrencyColumn, PlanColumn, FamilyIDColumn, RoleColumn, CreatedAtColumn, UpdatedAtColumn, EtagColumn}
-		mutableColumns  = postgres.ColumnList{CurrencyColumn, PlanColumn, FamilyIDColumn, RoleColumn, CreatedAtColumn, UpdatedAtColumn, EtagColumn}
+		TimezoneColumn  = postgres.StringColumn("timezone")
+		allColumns      = postgres.ColumnList{IDColumn, CurrencyColumn, PlanColumn, FamilyIDColumn, RoleColumn, CreatedAtColumn, UpdatedAtColumn, EtagColumn, TimezoneColumn}
+		mutableColumns  = postgres.ColumnList{CurrencyColumn, PlanColumn, FamilyIDColumn, RoleColumn, CreatedAtColumn, UpdatedAtColumn, EtagColumn, TimezoneColumn}
 		defaultColumns  = postgres.ColumnList{RoleColumn, CreatedAtColumn, UpdatedAtColumn, EtagColumn}
 	)
 
@@ -91,6 +93,7 @@ func newAccountsTableImpl(schemaName, tableName, alias string) accountsTable {
 		CreatedAt: CreatedAtColumn,
 		UpdatedAt: UpdatedAtColumn,
 		Etag:      EtagColumn,
+		Timezone:  TimezoneColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -17,25 +17,33 @@ type subscriptionsTable struct {
 	postgres.Table
 
 	// Columns
-	ID                  postgres.ColumnString
-	OwnerType           postgres.ColumnString
-	OwnerFamilyID       postgres.ColumnString
-	OwnerUserID         postgres.ColumnString
-	FriendlyName        postgres.ColumnString
-	FreeTrialStartDate  postgres.ColumnTimestampz
-	FreeTrialEndDate    postgres.ColumnTimestampz
-	ProviderID          postgres.ColumnString
-	PayerType           postgres.ColumnString
-	PayerMemberID       postgres.ColumnString
-	StartDate           postgres.ColumnTimestampz
-	EndDate             postgres.ColumnTimestampz
-	Recurrency          postgres.ColumnString
-	CustomRecurrency    postgres.ColumnInteger
-	CustomPriceCurrency postgres.ColumnString
-	CustomPriceAmount   postgres.ColumnFloat
-	CreatedAt           postgres.ColumnTimestampz
-	UpdatedAt           postgres.ColumnTimestampz
-	Etag                postgres.ColumnString
+	ID                    postgres.ColumnString
+	OwnerType             postgres.ColumnString
+	OwnerFamilyID         postgres.ColumnString
+	OwnerUserID           postgres.ColumnString
+	FriendlyName          postgres.ColumnString
+	FreeTrialStartDate    postgres.ColumnTimestampz
+	FreeTrialEndDate      postgres.ColumnTimestampz
+	ProviderID            postgres.ColumnString
+	PayerType             postgres.ColumnString
+	PayerMemberID         postgres.ColumnString
+	StartDate             postgres.ColumnTimestampz
+	EndDate               postgres.ColumnTimestampz
+	Recurrency            postgres.ColumnString
+	CustomRecurrencyCount postgres.ColumnInteger
+	CustomRecurrencyUnit  postgres.ColumnString
+	BillingAnchorDay      postgres.ColumnInteger
+	CustomPriceCurrency   postgres.ColumnString
+	CustomPriceAmount     postgres.ColumnFloat
+	CreatedAt             postgres.ColumnTimestampz
+	UpdatedAt             postgres.ColumnTimestampz
+	Etag                  postgres.ColumnString
+	ContractMinimumTerm   postgres.ColumnInteger
+	ContractAutoRenew     postgres.ColumnBool
+	ContractNoticePeriod  postgres.ColumnInteger
+	CostSplitType         postgres.ColumnString
+	PaymentMethodID       postgres.ColumnString
+	CancellationDeadline  postgres.ColumnTimestampz
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -77,53 +85,69 @@ func newSubscriptionsTable(schemaName, tableName, alias string) *SubscriptionsTa
 
 func newSubscriptionsTableImpl(schemaName, tableName, alias string) subscriptionsTable {
 	var (
-		IDColumn                  = postgres.StringColumn("id")
-		OwnerTypeColumn           = postgres.StringColumn("owner_type")
-		OwnerFamilyIDColumn       = postgres.StringColumn("owner_family_id")
-		OwnerUserIDColumn         = postgres.StringColumn("owner_user_id")
-		FriendlyNameColumn        = postgres.StringColumn("friendly_name")
-		FreeTrialStartDateColumn  = postgres.TimestampzColumn("free_trial_start_date")
-		FreeTrialEndDateColumn    = postgres.TimestampzColumn("free_trial_end_date")
-		ProviderIDColumn          = postgres.StringColumn("provider_id")
-		PayerTypeColumn           = postgres.StringColumn("payer_type")
-		PayerMemberIDColumn       = postgres.StringColumn("payer_member_id")
-		StartDateColumn           = postgres.TimestampzColumn("start_date")
-		EndDateColumn             = postgres.TimestampzColumn("end_date")
-		RecurrencyColumn          = postgres.StringColumn("recurrency")
-		CustomRecurrencyColumn    = postgres.IntegerColumn("custom_recurrency")
-		CustomPriceCurrencyColumn = postgres.StringColumn("custom_price_currency")
-		CustomPriceAmountColumn   = postgres.FloatColumn("custom_price_amount")
-		CreatedAtColumn           = postgres.TimestampzColumn("created_at")
-		UpdatedAtColumn           = postgres.TimestampzColumn("updated_at")
-		EtagColumn                = postgres.StringColumn("etag")
-		allColumns                = postgres.ColumnList{IDColumn, OwnerTypeColumn, OwnerFamilyIDColumn, OwnerUserIDColumn, FriendlyNameColumn, FreeTrialStartDateColumn, FreeTrialEndDateColumn, ProviderIDColumn, PayerTypeColumn, PayerMemberIDColumn, StartDateColumn, EndDateColumn, RecurrencyColumn, CustomRecurrencyColumn, CustomPriceCurrencyColumn, CustomPriceAmountColumn, CreatedAtColumn, UpdatedAtColumn, EtagColumn}
-		mutableColumns            = postgres.ColumnList{OwnerTypeColumn, OwnerFamilyIDColumn, OwnerUserIDColumn, FriendlyNameColumn, FreeTrialStartDateColumn, FreeTrialEndDateColumn, ProviderIDColumn, PayerTypeColumn, PayerMemberIDColumn, StartDateColumn, EndDateColumn, RecurrencyColumn, CustomRecurrencyColumn, CustomPriceCurrencyColumn, CustomPriceAmountColumn, CreatedAtColumn, UpdatedAtColumn, EtagColumn}
-		defaultColumns            = postgres.ColumnList{}
+		IDColumn                    = postgres.StringColumn("id")
+		OwnerTypeColumn             = postgres.StringColumn("owner_type")
+		OwnerFamilyIDColumn         = postgres.StringColumn("owner_family_id")
+		OwnerUserIDColumn           = postgres.StringColumn("owner_user_id")
+		FriendlyNameColumn          = postgres.StringColumn("friendly_name")
+		FreeTrialStartDateColumn    = postgres.TimestampzColumn("free_trial_start_date")
+		FreeTrialEndDateColumn      = postgres.TimestampzColumn("free_trial_end_date")
+		ProviderIDColumn            = postgres.StringColumn("provider_id")
+		PayerTypeColumn             = postgres.StringColumn("payer_type")
+		PayerMemberIDColumn         = postgres.StringColumn("payer_member_id")
+		StartDateColumn             = postgres.TimestampzColumn("start_date")
+		EndDateColumn               = postgres.TimestampzColumn("end_date")
+		RecurrencyColumn            = postgres.StringColumn("recurrency")
+		CustomRecurrencyCountColumn = postgres.IntegerColumn("custom_recurrency_count")
+		CustomRecurrencyUnitColumn  = postgres.StringColumn("custom_recurrency_unit")
+		BillingAnchorDayColumn      = postgres.IntegerColumn("billing_anchor_day")
+		CustomPriceCurrencyColumn   = postgres.StringColumn("custom_price_currency")
+		CustomPriceAmountColumn     = postgres.FloatColumn("custom_price_amount")
+		CreatedAtColumn             = postgres.TimestampzColumn("created_at")
+		UpdatedAtColumn             = postgres.TimestampzColumn("updated_at")
+		EtagColumn                  = postgres.StringColumn("etag")
+		ContractMinimumTermColumn   = postgres.IntegerColumn("contract_minimum_term")
+		ContractAutoRenewColumn     = postgres.BoolColumn("contract_auto_renew")
+		ContractNoticePeriodColumn  = postgres.IntegerColumn("contract_notice_period")
+		CostSplitTypeColumn         = postgres.StringColumn("cost_split_type")
+		PaymentMethodIDColumn       = postgres.StringColumn("payment_method_id")
+		CancellationDeadlineColumn  = postgres.TimestampzColumn("cancellation_deadline")
+		allColumns                  = postgres.ColumnList{IDColumn, OwnerTypeColumn, OwnerFamilyIDColumn, OwnerUserIDColumn, FriendlyNameColumn, FreeTrialStartDateColumn, FreeTrialEndDateColumn, ProviderIDColumn, PayerTypeColumn, PayerMemberIDColumn, StartDateColumn, EndDateColumn, RecurrencyColumn, CustomRecurrencyCountColumn, CustomRecurrencyUnitColumn, BillingAnchorDayColumn, CustomPriceCurrencyColumn, CustomPriceAmountColumn, CreatedAtColumn, UpdatedAtColumn, EtagColumn, ContractMinimumTermColumn, ContractAutoRenewColumn, ContractNoticePeriodColumn, CostSplitTypeColumn, PaymentMethodIDColumn, CancellationDeadlineColumn}
+		mutableColumns              = postgres.ColumnList{OwnerTypeColumn, OwnerFamilyIDColumn, OwnerUserIDColumn, FriendlyNameColumn, FreeTrialStartDateColumn, FreeTrialEndDateColumn, ProviderIDColumn, PayerTypeColumn, PayerMemberIDColumn, StartDateColumn, EndDateColumn, RecurrencyColumn, CustomRecurrencyCountColumn, CustomRecurrencyUnitColumn, BillingAnchorDayColumn, CustomPriceCurrencyColumn, CustomPriceAmountColumn, CreatedAtColumn, UpdatedAtColumn, EtagColumn, ContractMinimumTermColumn, ContractAutoRenewColumn, ContractNoticePeriodColumn, CostSplitTypeColumn, PaymentMethodIDColumn, CancellationDeadlineColumn}
+		defaultColumns              = postgres.ColumnList{}
 	)
 
 	return subscriptionsTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:                  IDColumn,
-		OwnerType:           OwnerTypeColumn,
-		OwnerFamilyID:       OwnerFamilyIDColumn,
-		OwnerUserID:         OwnerUserIDColumn,
-		FriendlyName:        FriendlyNameColumn,
-		FreeTrialStartDate:  FreeTrialStartDateColumn,
-		FreeTrialEndDate:    FreeTrialEndDateColumn,
-		ProviderID:          ProviderIDColumn,
-		PayerType:           PayerTypeColumn,
-		PayerMemberID:       PayerMemberIDColumn,
-		StartDate:           StartDateColumn,
-		EndDate:             EndDateColumn,
-		Recurrency:          RecurrencyColumn,
-		CustomRecurrency:    CustomRecurrencyColumn,
-		CustomPriceCurrency: CustomPriceCurrencyColumn,
-		CustomPriceAmount:   CustomPriceAmountColumn,
-		CreatedAt:           CreatedAtColumn,
-		UpdatedAt:           UpdatedAtColumn,
-		Etag:                EtagColumn,
+		ID:                    IDColumn,
+		OwnerType:             OwnerTypeColumn,
+		OwnerFamilyID:         OwnerFamilyIDColumn,
+		OwnerUserID:           OwnerUserIDColumn,
+		FriendlyName:          FriendlyNameColumn,
+		FreeTrialStartDate:    FreeTrialStartDateColumn,
+		FreeTrialEndDate:      FreeTrialEndDateColumn,
+		ProviderID:            ProviderIDColumn,
+		PayerType:             PayerTypeColumn,
+		PayerMemberID:         PayerMemberIDColumn,
+		StartDate:             StartDateColumn,
+		EndDate:               EndDateColumn,
+		Recurrency:            RecurrencyColumn,
+		CustomRecurrencyCount: CustomRecurrencyCountColumn,
+		CustomRecurrencyUnit:  CustomRecurrencyUnitColumn,
+		BillingAnchorDay:      BillingAnchorDayColumn,
+		CustomPriceCurrency:   CustomPriceCurrencyColumn,
+		CustomPriceAmount:     CustomPriceAmountColumn,
+		CreatedAt:             CreatedAtColumn,
+		UpdatedAt:             UpdatedAtColumn,
+		Etag:                  EtagColumn,
+		ContractMinimumTerm:   ContractMinimumTermColumn,
+		ContractAutoRenew:     ContractAutoRenewColumn,
+		ContractNoticePeriod:  ContractNoticePeriodColumn,
+		CostSplitType:         CostSplitTypeColumn,
+		PaymentMethodID:       PaymentMethodIDColumn,
+		CancellationDeadline:  CancellationDeadlineColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
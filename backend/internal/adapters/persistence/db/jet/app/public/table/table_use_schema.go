@@ -11,13 +11,30 @@ package table
 // this method only once at the beginning of the program.
 func UseSchema(schema string) {
 	Accounts = Accounts.FromSchema(schema)
+	Budgets = Budgets.FromSchema(schema)
+	CalendarFeeds = CalendarFeeds.FromSchema(schema)
+	Charges = Charges.FromSchema(schema)
+	CurrencyRateSyncs = CurrencyRateSyncs.FromSchema(schema)
 	CurrencyRates = CurrencyRates.FromSchema(schema)
 	Families = Families.FromSchema(schema)
 	FamilyMembers = FamilyMembers.FromSchema(schema)
+	FamilySettlements = FamilySettlements.FromSchema(schema)
 	Labels = Labels.FromSchema(schema)
+	ManualRates = ManualRates.FromSchema(schema)
+	NotificationPreferences = NotificationPreferences.FromSchema(schema)
+	NotificationReminders = NotificationReminders.FromSchema(schema)
+	OutboxEvents = OutboxEvents.FromSchema(schema)
+	PaymentMethods = PaymentMethods.FromSchema(schema)
 	ProviderLabels = ProviderLabels.FromSchema(schema)
 	Providers = Providers.FromSchema(schema)
+	SubscriptionCostShares = SubscriptionCostShares.FromSchema(schema)
 	SubscriptionFamilyUsers = SubscriptionFamilyUsers.FromSchema(schema)
 	SubscriptionLabels = SubscriptionLabels.FromSchema(schema)
+	SubscriptionPauses = SubscriptionPauses.FromSchema(schema)
+	SubscriptionPriceHistory = SubscriptionPriceHistory.FromSchema(schema)
+	SubscriptionPromotions = SubscriptionPromotions.FromSchema(schema)
 	Subscriptions = Subscriptions.FromSchema(schema)
+	WebhookDeliveries = WebhookDeliveries.FromSchema(schema)
+	WebhookEndpointEventTypes = WebhookEndpointEventTypes.FromSchema(schema)
+	WebhookEndpoints = WebhookEndpoints.FromSchema(schema)
 }
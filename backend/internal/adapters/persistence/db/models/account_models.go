@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
 	"github.com/mistribe/subtracker/internal/domain/account"
 	"github.com/mistribe/subtracker/internal/domain/currency"
@@ -39,9 +41,18 @@ func CreateAccountFromJetRow(row AccountRow) (account.Account, error) {
 		familyID = x.P(types.FamilyID(*row.FamilyID))
 	}
 	role := types.ParseRoleOrDefault(row.Role, types.RoleUser)
+	var timezone *time.Location
+	if row.Timezone != nil {
+		loc, err := account.ParseTimezone(*row.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		timezone = loc
+	}
 
 	acc := account.New(types.UserID(id),
 		userCurrency,
+		timezone,
 		plan,
 		role,
 		familyID,
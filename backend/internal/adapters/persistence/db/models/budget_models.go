@@ -0,0 +1,38 @@
+package models
+
+import (
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func CreateBudgetFromModel(source model.Budgets) budget.Budget {
+	ownerType := types.MustParseOwnerType(source.OwnerType)
+	var ownerFamilyID *types.FamilyID
+	var ownerUserID *types.UserID
+	if source.OwnerFamilyID != nil {
+		ownerFamilyID = x.P(types.FamilyID(*source.OwnerFamilyID))
+	}
+	if source.OwnerUserID != nil {
+		ownerUserID = x.P(types.UserID(*source.OwnerUserID))
+	}
+	owner := types.NewOwner(ownerType, ownerFamilyID, ownerUserID)
+	var labelID *types.LabelID
+	if source.LabelID != nil {
+		labelID = x.P(types.LabelID(*source.LabelID))
+	}
+	b := budget.NewBudget(
+		types.BudgetID(source.ID),
+		owner,
+		source.Name,
+		budget.MustParseScope(source.Scope),
+		labelID,
+		budget.MustParsePeriod(source.Period),
+		currency.NewAmountFromDecimal(source.LimitAmount, currency.MustParseISO(source.LimitCurrency)),
+		source.CreatedAt,
+		source.UpdatedAt)
+	b.Clean()
+	return b
+}
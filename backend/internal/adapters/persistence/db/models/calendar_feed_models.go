@@ -0,0 +1,18 @@
+package models
+
+import (
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func CreateCalendarFeedFromModel(source model.CalendarFeeds) calendar.Feed {
+	feed := calendar.NewFeed(
+		types.CalendarFeedID(source.ID),
+		types.UserID(source.UserID),
+		source.TokenHash,
+		source.CreatedAt,
+		source.UpdatedAt)
+	feed.Clean()
+	return feed
+}
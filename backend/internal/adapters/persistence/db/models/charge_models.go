@@ -0,0 +1,27 @@
+package models
+
+import (
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func CreateChargeFromModel(source model.Charges) charge.Charge {
+	expectedAmount := currency.NewAmountFromDecimal(source.ExpectedAmount, currency.MustParseISO(source.ExpectedCurrency))
+	var actualAmount currency.Amount
+	if source.ActualAmount != nil && source.ActualCurrency != nil {
+		actualAmount = currency.NewAmountFromDecimal(*source.ActualAmount, currency.MustParseISO(*source.ActualCurrency))
+	}
+	chg := charge.NewCharge(
+		types.ChargeID(source.ID),
+		types.SubscriptionID(source.SubscriptionID),
+		source.DueDate,
+		expectedAmount,
+		actualAmount,
+		charge.MustParseStatus(source.Status),
+		source.CreatedAt,
+		source.UpdatedAt)
+	chg.Clean()
+	return chg
+}
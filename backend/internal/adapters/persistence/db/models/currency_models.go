@@ -26,3 +26,21 @@ func CreateCurrencyRateFromModel(source model.CurrencyRates) (currency.Rate, err
 		source.UpdatedAt,
 	), nil
 }
+
+func CreateRateSyncFromModel(source model.CurrencyRateSyncs) currency.RateSync {
+	s := currency.NewRateSync(
+		types.RateSyncID(source.ID),
+		currency.MustParseRateSyncStatus(source.Status),
+		source.StartDate,
+		source.EndDate,
+		source.CursorDate,
+		int(source.ProcessedDays),
+		int(source.FilledDays),
+		int(source.UnavailableDays),
+		source.LastError,
+		source.FinishedAt,
+		source.CreatedAt,
+		source.UpdatedAt)
+	s.Clean()
+	return s
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func CreateManualRateFromModel(source model.ManualRates) currency.ManualRate {
+	ownerType := types.MustParseOwnerType(source.OwnerType)
+	var ownerFamilyID *types.FamilyID
+	var ownerUserID *types.UserID
+	if source.OwnerFamilyID != nil {
+		ownerFamilyID = x.P(types.FamilyID(*source.OwnerFamilyID))
+	}
+	if source.OwnerUserID != nil {
+		ownerUserID = x.P(types.UserID(*source.OwnerUserID))
+	}
+	owner := types.NewOwner(ownerType, ownerFamilyID, ownerUserID)
+	var subscriptionID *types.SubscriptionID
+	if source.SubscriptionID != nil {
+		subscriptionID = x.P(types.SubscriptionID(*source.SubscriptionID))
+	}
+	r := currency.NewManualRate(
+		types.ManualRateID(source.ID),
+		owner,
+		subscriptionID,
+		currency.MustParseISO(source.FromCurrency),
+		currency.MustParseISO(source.ToCurrency),
+		source.ExchangeRate,
+		source.CreatedAt,
+		source.UpdatedAt)
+	r.Clean()
+	return r
+}
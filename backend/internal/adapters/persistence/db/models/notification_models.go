@@ -0,0 +1,37 @@
+package models
+
+import (
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func CreateNotificationPreferencesFromModel(source model.NotificationPreferences) notification.Preferences {
+	preferences := notification.NewPreferences(
+		types.UserID(source.UserID),
+		source.Enabled,
+		notification.MustParseLocale(source.Locale),
+		int(source.RenewalLeadDays),
+		int(source.FreeTrialEndLeadDays),
+		int(source.CancellationDeadlineLeadDays),
+		source.CreatedAt,
+		source.UpdatedAt)
+	preferences.Clean()
+	return preferences
+}
+
+func CreateReminderFromModel(source model.NotificationReminders) notification.Reminder {
+	reminder := notification.NewReminder(
+		types.ReminderID(source.ID),
+		types.UserID(source.UserID),
+		types.SubscriptionID(source.SubscriptionID),
+		calendar.MustParseEventKind(source.Kind),
+		source.EventDate,
+		notification.MustParseReminderStatus(source.Status),
+		source.SentAt,
+		source.CreatedAt,
+		source.UpdatedAt)
+	reminder.Clean()
+	return reminder
+}
@@ -0,0 +1,163 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/decimal"
+)
+
+var ErrUnknownOutboxEvent = errors.New("unknown outbox event")
+
+type subscriptionCreatedPayload struct {
+	SubscriptionId uuid.UUID `json:"subscription_id"`
+	ProviderId     uuid.UUID `json:"provider_id"`
+}
+
+type priceChangedPayload struct {
+	SubscriptionId uuid.UUID        `json:"subscription_id"`
+	EffectiveFrom  time.Time        `json:"effective_from"`
+	Previous       *decimal.Decimal `json:"previous"`
+	Amount         decimal.Decimal  `json:"amount"`
+	Currency       string           `json:"currency"`
+}
+
+type memberInvitedPayload struct {
+	FamilyId uuid.UUID `json:"family_id"`
+	MemberId uuid.UUID `json:"member_id"`
+}
+
+type invitationAcceptedPayload struct {
+	FamilyId uuid.UUID `json:"family_id"`
+	MemberId uuid.UUID `json:"member_id"`
+	UserId   string    `json:"user_id"`
+}
+
+// outboxCodec converts an event to the payload stored in the outbox and back
+type outboxCodec struct {
+	encode func(event entity.Event) ([]byte, error)
+	decode func(payload []byte) (entity.Event, error)
+}
+
+func newOutboxCodec[TEvent entity.Event, TPayload any](
+	toPayload func(TEvent) TPayload,
+	fromPayload func(TPayload) TEvent) outboxCodec {
+	return outboxCodec{
+		encode: func(event entity.Event) ([]byte, error) {
+			typed, ok := event.(TEvent)
+			if !ok {
+				return nil, fmt.Errorf("%w: %T is not a %s", ErrUnknownOutboxEvent, event, event.EventName())
+			}
+			return json.Marshal(toPayload(typed))
+		},
+		decode: func(payload []byte) (entity.Event, error) {
+			var source TPayload
+			if err := json.Unmarshal(payload, &source); err != nil {
+				return nil, err
+			}
+			return fromPayload(source), nil
+		},
+	}
+}
+
+// outboxCodecs are the events that can go through the outbox, by name
+var outboxCodecs = map[string]outboxCodec{
+	subscription.SubscriptionCreatedEventName: newOutboxCodec(
+		func(event subscription.SubscriptionCreated) subscriptionCreatedPayload {
+			return subscriptionCreatedPayload{
+				SubscriptionId: uuid.UUID(event.SubscriptionId),
+				ProviderId:     uuid.UUID(event.ProviderId),
+			}
+		},
+		func(source subscriptionCreatedPayload) subscription.SubscriptionCreated {
+			return subscription.SubscriptionCreated{
+				SubscriptionId: types.SubscriptionID(source.SubscriptionId),
+				ProviderId:     types.ProviderID(source.ProviderId),
+			}
+		}),
+	subscription.PriceChangedEventName: newOutboxCodec(
+		func(event subscription.PriceChanged) priceChangedPayload {
+			return priceChangedPayload{
+				SubscriptionId: uuid.UUID(event.SubscriptionId),
+				EffectiveFrom:  event.EffectiveFrom,
+				Previous:       event.Previous,
+				Amount:         event.Amount,
+				Currency:       event.Currency,
+			}
+		},
+		func(source priceChangedPayload) subscription.PriceChanged {
+			return subscription.PriceChanged{
+				SubscriptionId: types.SubscriptionID(source.SubscriptionId),
+				EffectiveFrom:  source.EffectiveFrom,
+				Previous:       source.Previous,
+				Amount:         source.Amount,
+				Currency:       source.Currency,
+			}
+		}),
+	family.MemberInvitedEventName: newOutboxCodec(
+		func(event family.MemberInvited) memberInvitedPayload {
+			return memberInvitedPayload{
+				FamilyId: uuid.UUID(event.FamilyId),
+				MemberId: uuid.UUID(event.MemberId),
+			}
+		},
+		func(source memberInvitedPayload) family.MemberInvited {
+			return family.MemberInvited{
+				FamilyId: types.FamilyID(source.FamilyId),
+				MemberId: types.FamilyMemberID(source.MemberId),
+			}
+		}),
+	family.InvitationAcceptedEventName: newOutboxCodec(
+		func(event family.InvitationAccepted) invitationAcceptedPayload {
+			return invitationAcceptedPayload{
+				FamilyId: uuid.UUID(event.FamilyId),
+				MemberId: uuid.UUID(event.MemberId),
+				UserId:   event.UserId.String(),
+			}
+		},
+		func(source invitationAcceptedPayload) family.InvitationAccepted {
+			return family.InvitationAccepted{
+				FamilyId: types.FamilyID(source.FamilyId),
+				MemberId: types.FamilyMemberID(source.MemberId),
+				UserId:   types.UserID(source.UserId),
+			}
+		}),
+}
+
+// CreateOutboxModelFromEvent returns the pending outbox row of the event
+func CreateOutboxModelFromEvent(event entity.Event, createdAt time.Time) (model.OutboxEvents, error) {
+	codec, ok := outboxCodecs[event.EventName()]
+	if !ok {
+		return model.OutboxEvents{}, fmt.Errorf("%w: %s", ErrUnknownOutboxEvent, event.EventName())
+	}
+	payload, err := codec.encode(event)
+	if err != nil {
+		return model.OutboxEvents{}, err
+	}
+
+	return model.OutboxEvents{
+		ID:        uuid.Must(uuid.NewV7()),
+		EventName: event.EventName(),
+		Payload:   string(payload),
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// CreateEventFromOutboxModel reads back the event of an outbox row
+func CreateEventFromOutboxModel(source model.OutboxEvents) (entity.Event, error) {
+	codec, ok := outboxCodecs[source.EventName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownOutboxEvent, source.EventName)
+	}
+
+	return codec.decode([]byte(source.Payload))
+}
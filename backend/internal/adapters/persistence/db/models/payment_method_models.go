@@ -0,0 +1,33 @@
+package models
+
+import (
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func CreatePaymentMethodFromModel(source model.PaymentMethods) paymentmethod.PaymentMethod {
+	ownerType := types.MustParseOwnerType(source.OwnerType)
+	var ownerFamilyID *types.FamilyID
+	var ownerUserID *types.UserID
+	if source.OwnerFamilyID != nil {
+		ownerFamilyID = x.P(types.FamilyID(*source.OwnerFamilyID))
+	}
+	if source.OwnerUserID != nil {
+		ownerUserID = x.P(types.UserID(*source.OwnerUserID))
+	}
+	owner := types.NewOwner(ownerType, ownerFamilyID, ownerUserID)
+	pm := paymentmethod.NewPaymentMethod(
+		types.PaymentMethodID(source.ID),
+		owner,
+		paymentmethod.MustParseType(source.Type),
+		source.Label,
+		source.LastFour,
+		source.ExpiryMonth,
+		source.ExpiryYear,
+		source.CreatedAt,
+		source.UpdatedAt)
+	pm.Clean()
+	return pm
+}
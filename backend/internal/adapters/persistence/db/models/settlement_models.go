@@ -0,0 +1,23 @@
+package models
+
+import (
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func CreateSettlementFromModel(source model.FamilySettlements) ledger.Settlement {
+	s := ledger.NewSettlement(
+		types.SettlementID(source.ID),
+		types.FamilyID(source.FamilyID),
+		types.FamilyMemberID(source.FromMemberID),
+		types.FamilyMemberID(source.ToMemberID),
+		currency.NewAmountFromDecimal(source.Amount, currency.MustParseISO(source.Currency)),
+		source.SettledAt,
+		source.Note,
+		source.CreatedAt,
+		source.UpdatedAt)
+	s.Clean()
+	return s
+}
@@ -1,8 +1,6 @@
 package models
 
 import (
-	"fmt"
-
 	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
 	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/domain/subscription"
@@ -11,23 +9,32 @@ import (
 	"github.com/mistribe/subtracker/pkg/x/herd"
 )
 
-type SubscriptionRow struct {
+type SubscriptionRowWithCount struct {
 	model.Subscriptions
-	SubscriptionFamilyUsers *model.SubscriptionFamilyUsers `json:"subscription_family_users"`
-	SubscriptionLabels      *model.SubscriptionLabels      `json:"subscription_labels"`
-	ProviderLabels          *model.ProviderLabels          `json:"provider_labels"`
+	TotalCount int64 `alias:"total_count"`
 }
 
-type SubscriptionRowWithCount struct {
-	SubscriptionRow
-	TotalCount int64 `alias:"total_count"`
+// SubscriptionChildren are the rows of the child collections of a set of subscriptions, each collection is
+// loaded on its own so that its rows are not multiplied by the rows of the others
+type SubscriptionChildren struct {
+	FamilyUsers    []model.SubscriptionFamilyUsers
+	Labels         []model.SubscriptionLabels
+	ProviderLabels []model.ProviderLabels
+	PriceHistory   []model.SubscriptionPriceHistory
+	Pauses         []model.SubscriptionPauses
+	Promotions     []model.SubscriptionPromotions
+	CostShares     []model.SubscriptionCostShares
 }
 
 func createSubscriptionFromJet(
 	jetModel model.Subscriptions,
 	familyUsers []types.FamilyMemberID,
 	subscriptionLabels []types.LabelID,
-	providerLabels []types.LabelID) subscription.Subscription {
+	providerLabels []types.LabelID,
+	priceHistory []subscription.PriceChange,
+	promotions []subscription.Promotion,
+	costShares []subscription.CostShare,
+	pauses []subscription.Pause) subscription.Subscription {
 	var freeTrial subscription.FreeTrial
 	if jetModel.FreeTrialEndDate != nil && jetModel.FreeTrialStartDate != nil {
 		freeTrial = subscription.NewFreeTrial(
@@ -36,10 +43,24 @@ func createSubscriptionFromJet(
 		)
 	}
 
+	var contract subscription.Contract
+	if jetModel.ContractAutoRenew != nil {
+		contract = subscription.NewContract(
+			jetModel.ContractMinimumTerm,
+			*jetModel.ContractAutoRenew,
+			jetModel.ContractNoticePeriod,
+		)
+	}
+
+	var costSplit subscription.CostSplit
+	if jetModel.CostSplitType != nil {
+		costSplit = subscription.NewCostSplit(subscription.MustParseCostSplitType(*jetModel.CostSplitType), costShares)
+	}
+
 	var customPrice subscription.Price
 	if jetModel.CustomPriceCurrency != nil && jetModel.CustomPriceAmount != nil {
 		cry := currency.MustParseISO(*jetModel.CustomPriceCurrency)
-		customPrice = subscription.NewPrice(currency.NewAmount(*jetModel.CustomPriceAmount, cry))
+		customPrice = subscription.NewPrice(currency.NewAmountFromDecimal(*jetModel.CustomPriceAmount, cry))
 	}
 
 	var payer subscription.Payer
@@ -68,6 +89,18 @@ func createSubscriptionFromJet(
 
 	recurrency := subscription.MustParseRecurrencyType(jetModel.Recurrency)
 
+	var customRecurrency *subscription.Interval
+	if jetModel.CustomRecurrencyCount != nil && jetModel.CustomRecurrencyUnit != nil {
+		customRecurrency = subscription.NewInterval(
+			subscription.MustParseIntervalUnit(*jetModel.CustomRecurrencyUnit),
+			*jetModel.CustomRecurrencyCount)
+	}
+
+	var paymentMethodID *types.PaymentMethodID
+	if jetModel.PaymentMethodID != nil {
+		paymentMethodID = x.P(types.PaymentMethodID(*jetModel.PaymentMethodID))
+	}
+
 	var labelRefs []subscription.LabelRef
 	for _, subscriptionLabel := range subscriptionLabels {
 		labelRefs = append(labelRefs, subscription.LabelRef{
@@ -87,16 +120,23 @@ func createSubscriptionFromJet(
 		types.SubscriptionID(jetModel.ID),
 		jetModel.FriendlyName,
 		freeTrial,
+		contract,
 		types.ProviderID(jetModel.ProviderID),
 		customPrice,
+		priceHistory,
+		promotions,
 		owner,
 		payer,
+		costSplit,
+		paymentMethodID,
 		familyUsers,
 		labelRefs,
 		jetModel.StartDate,
 		jetModel.EndDate,
+		pauses,
 		recurrency,
-		jetModel.CustomRecurrency,
+		customRecurrency,
+		jetModel.BillingAnchorDay,
 		jetModel.CreatedAt,
 		jetModel.UpdatedAt,
 	)
@@ -104,74 +144,76 @@ func createSubscriptionFromJet(
 	return sub
 }
 
-// CreateSubscriptionFromJetRows converts flattened jet rows to domain subscriptions
-func CreateSubscriptionFromJetRows(rows []SubscriptionRow) []subscription.Subscription {
-	if len(rows) == 0 {
+// CreateSubscriptionsFromJet converts jet subscriptions and the rows of their child collections to domain
+// subscriptions, in the order of the jet subscriptions
+func CreateSubscriptionsFromJet(
+	jetSubscriptions []model.Subscriptions,
+	children SubscriptionChildren) []subscription.Subscription {
+	if len(jetSubscriptions) == 0 {
 		return nil
 	}
 
-	subscriptions := herd.NewDictionary[types.SubscriptionID, model.Subscriptions]()
-	orderedIDs := herd.NewList[types.SubscriptionID]()
-	familyUserSet := herd.NewSet[string]()
-	subscriptionFamilyUsers := herd.NewDictionary[types.SubscriptionID, []types.FamilyMemberID]()
-	subscriptionLabelSet := herd.NewSet[string]()
+	familyUsers := herd.NewDictionary[types.SubscriptionID, []types.FamilyMemberID]()
+	for _, row := range children.FamilyUsers {
+		subscriptionID := types.SubscriptionID(row.SubscriptionID)
+		familyUsers[subscriptionID] = append(familyUsers[subscriptionID], types.FamilyMemberID(row.FamilyMemberID))
+	}
 	subscriptionLabels := herd.NewDictionary[types.SubscriptionID, []types.LabelID]()
-	providerLabelSet := herd.NewSet[string]()
-	providerLabels := herd.NewDictionary[types.SubscriptionID, []types.LabelID]()
-
-	for _, row := range rows {
-		jetSubscription := row.Subscriptions
-		subscriptionID := types.SubscriptionID(jetSubscription.ID)
-		if _, ok := subscriptions[subscriptionID]; !ok {
-			subscriptions[subscriptionID] = jetSubscription
-			orderedIDs.Add(subscriptionID)
-		}
-		if row.SubscriptionFamilyUsers != nil {
-			key := fmt.Sprintf("%s:%s", subscriptionID, *row.SubscriptionFamilyUsers)
-			if !familyUserSet.Contains(key) {
-				familyUserSet.Add(key)
-				subscriptionFamilyUsers[subscriptionID] = append(subscriptionFamilyUsers[subscriptionID],
-					types.FamilyMemberID(row.SubscriptionFamilyUsers.FamilyMemberID))
-			}
-		}
-		if row.SubscriptionLabels != nil {
-			key := fmt.Sprintf("%s:%s", subscriptionID, *row.SubscriptionLabels)
-			if !subscriptionLabelSet.Contains(key) {
-				subscriptionLabelSet.Add(key)
-				subscriptionLabels[subscriptionID] = append(subscriptionLabels[subscriptionID],
-					types.LabelID(row.SubscriptionLabels.LabelID))
-			}
-		}
-		if row.ProviderLabels != nil {
-			key := fmt.Sprintf("%s:%s", subscriptionID, *row.ProviderLabels)
-			if !providerLabelSet.Contains(key) {
-				providerLabelSet.Add(key)
-				providerLabels[subscriptionID] = append(providerLabels[subscriptionID],
-					types.LabelID(row.ProviderLabels.LabelID))
-			}
-		}
+	for _, row := range children.Labels {
+		subscriptionID := types.SubscriptionID(row.SubscriptionID)
+		subscriptionLabels[subscriptionID] = append(subscriptionLabels[subscriptionID], types.LabelID(row.LabelID))
+	}
+	providerLabels := herd.NewDictionary[types.ProviderID, []types.LabelID]()
+	for _, row := range children.ProviderLabels {
+		providerID := types.ProviderID(row.ProviderID)
+		providerLabels[providerID] = append(providerLabels[providerID], types.LabelID(row.LabelID))
+	}
+	priceHistory := herd.NewDictionary[types.SubscriptionID, []subscription.PriceChange]()
+	for _, row := range children.PriceHistory {
+		subscriptionID := types.SubscriptionID(row.SubscriptionID)
+		priceHistory[subscriptionID] = append(priceHistory[subscriptionID], subscription.PriceChange{
+			EffectiveFrom: row.EffectiveFrom,
+			Amount:        currency.NewAmountFromDecimal(row.Amount, currency.MustParseISO(row.Currency)),
+		})
+	}
+	pauses := herd.NewDictionary[types.SubscriptionID, []subscription.Pause]()
+	for _, row := range children.Pauses {
+		subscriptionID := types.SubscriptionID(row.SubscriptionID)
+		pauses[subscriptionID] = append(pauses[subscriptionID], subscription.Pause{
+			StartDate: row.StartDate,
+			EndDate:   row.EndDate,
+		})
+	}
+	promotions := herd.NewDictionary[types.SubscriptionID, []subscription.Promotion]()
+	for _, row := range children.Promotions {
+		subscriptionID := types.SubscriptionID(row.SubscriptionID)
+		promotions[subscriptionID] = append(promotions[subscriptionID], subscription.Promotion{
+			StartDate: row.StartDate,
+			EndDate:   row.EndDate,
+			Amount:    currency.NewAmountFromDecimal(row.Amount, currency.MustParseISO(row.Currency)),
+		})
+	}
+	costShares := herd.NewDictionary[types.SubscriptionID, []subscription.CostShare]()
+	for _, row := range children.CostShares {
+		subscriptionID := types.SubscriptionID(row.SubscriptionID)
+		costShares[subscriptionID] = append(costShares[subscriptionID], subscription.CostShare{
+			MemberId: types.FamilyMemberID(row.FamilyMemberID),
+			Value:    row.Value,
+		})
 	}
 
-	results := make([]subscription.Subscription, 0, orderedIDs.Len())
-	for subscriptionId := range orderedIDs.It() {
-		jetSubscription := subscriptions[subscriptionId]
-		jetSubscriptionServiceUsers, _ := subscriptionFamilyUsers[subscriptionId]
-		jetSubscriptionLabels, _ := subscriptionLabels[subscriptionId]
-		jetProviderLabels, _ := providerLabels[subscriptionId]
-		result := createSubscriptionFromJet(jetSubscription,
-			jetSubscriptionServiceUsers,
-			jetSubscriptionLabels,
-			jetProviderLabels)
-		results = append(results, result)
+	results := make([]subscription.Subscription, 0, len(jetSubscriptions))
+	for _, jetSubscription := range jetSubscriptions {
+		subscriptionID := types.SubscriptionID(jetSubscription.ID)
+		results = append(results, createSubscriptionFromJet(jetSubscription,
+			familyUsers[subscriptionID],
+			subscriptionLabels[subscriptionID],
+			providerLabels[types.ProviderID(jetSubscription.ProviderID)],
+			priceHistory[subscriptionID],
+			promotions[subscriptionID],
+			costShares[subscriptionID],
+			pauses[subscriptionID]))
 	}
 
 	return results
 }
-
-func CreateSubscriptionFromJetRowsWithCount(rows []SubscriptionRowWithCount) []subscription.Subscription {
-	simpleRows := herd.Select(rows, func(row SubscriptionRowWithCount) SubscriptionRow {
-		return row.SubscriptionRow
-	})
-
-	return CreateSubscriptionFromJetRows(simpleRows)
-}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+type WebhookEndpointRow struct {
+	WebhookEndpoints          model.WebhookEndpoints           `json:"webhook_endpoints"`
+	WebhookEndpointEventTypes *model.WebhookEndpointEventTypes `json:"webhook_endpoint_event_types"`
+}
+
+// CreateWebhookEndpointsFromJetRows groups the rows of the endpoints with their event types, in the order of the
+// rows
+func CreateWebhookEndpointsFromJetRows(rows []WebhookEndpointRow) []webhook.Endpoint {
+	var ids []types.WebhookEndpointID
+	endpoints := make(map[types.WebhookEndpointID]model.WebhookEndpoints)
+	eventTypes := make(map[types.WebhookEndpointID][]webhook.EventType)
+	for _, row := range rows {
+		id := types.WebhookEndpointID(row.WebhookEndpoints.ID)
+		if _, ok := endpoints[id]; !ok {
+			ids = append(ids, id)
+			endpoints[id] = row.WebhookEndpoints
+		}
+		if row.WebhookEndpointEventTypes != nil && row.WebhookEndpointEventTypes.EventType != "" {
+			eventTypes[id] = append(eventTypes[id],
+				webhook.MustParseEventType(row.WebhookEndpointEventTypes.EventType))
+		}
+	}
+
+	results := make([]webhook.Endpoint, len(ids))
+	for i, id := range ids {
+		source := endpoints[id]
+		endpoint := webhook.NewEndpoint(
+			id,
+			types.UserID(source.UserID),
+			source.URL,
+			source.Secret,
+			eventTypes[id],
+			source.Enabled,
+			source.CreatedAt,
+			source.UpdatedAt)
+		endpoint.Clean()
+		results[i] = endpoint
+	}
+
+	return results
+}
+
+func CreateWebhookDeliveryFromModel(source model.WebhookDeliveries) webhook.Delivery {
+	var lastStatusCode *int
+	if source.LastStatusCode != nil {
+		lastStatusCode = x.P(int(*source.LastStatusCode))
+	}
+	var redeliveryOf *types.WebhookDeliveryID
+	if source.RedeliveryOf != nil {
+		redeliveryOf = x.P(types.WebhookDeliveryID(*source.RedeliveryOf))
+	}
+
+	delivery := webhook.NewDelivery(
+		types.WebhookDeliveryID(source.ID),
+		types.WebhookEndpointID(source.EndpointID),
+		source.EventID,
+		webhook.MustParseEventType(source.EventType),
+		[]byte(source.Payload),
+		webhook.MustParseDeliveryStatus(source.Status),
+		int(source.Attempts),
+		source.NextAttemptAt,
+		lastStatusCode,
+		source.LastError,
+		source.DeliveredAt,
+		redeliveryOf,
+		source.CreatedAt,
+		source.UpdatedAt)
+	delivery.Clean()
+	return delivery
+}
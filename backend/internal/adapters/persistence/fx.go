@@ -18,6 +18,18 @@ func BuildPersistenceModule() fx.Option {
 			repositories.NewAccountRepository,
 			repositories.NewCurrencyRateRepository,
 			repositories.NewUsageRepository,
+			repositories.NewChargeRepository,
+			repositories.NewSettlementRepository,
+			repositories.NewPaymentMethodRepository,
+			repositories.NewCalendarFeedRepository,
+			repositories.NewBudgetRepository,
+			repositories.NewManualRateRepository,
+			repositories.NewRateSyncRepository,
+			repositories.NewNotificationPreferencesRepository,
+			repositories.NewReminderRepository,
+			repositories.NewWebhookEndpointRepository,
+			repositories.NewWebhookDeliveryRepository,
+			repositories.NewOutboxRepository,
 		),
 	)
 }
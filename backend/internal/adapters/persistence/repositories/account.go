@@ -76,6 +76,7 @@ func (r AccountRepository) create(ctx context.Context, acc account.Account) erro
 		INSERT(
 			Accounts.ID,
 			Accounts.Currency,
+			Accounts.Timezone,
 			Accounts.Plan,
 			Accounts.Role,
 			Accounts.FamilyID,
@@ -91,6 +92,14 @@ func (r AccountRepository) create(ctx context.Context, acc account.Account) erro
 					return NULL
 				},
 			),
+			x.TernaryFunc(acc.Timezone() != nil,
+				func() Expression {
+					return String(acc.Timezone().String())
+				},
+				func() Expression {
+					return NULL
+				},
+			),
 			String(acc.PlanID().String()),
 			String(acc.Role().String()),
 			x.TernaryFunc(
@@ -112,6 +121,7 @@ func (r AccountRepository) update(ctx context.Context, acc account.Account) erro
 	stmt := Accounts.
 		UPDATE(
 			Accounts.Currency,
+			Accounts.Timezone,
 			Accounts.Plan,
 			Accounts.Role,
 			Accounts.FamilyID,
@@ -127,6 +137,14 @@ func (r AccountRepository) update(ctx context.Context, acc account.Account) erro
 					return NULL
 				},
 			),
+			x.TernaryFunc(acc.Timezone() != nil,
+				func() Expression {
+					return String(acc.Timezone().String())
+				},
+				func() Expression {
+					return NULL
+				},
+			),
 			String(acc.PlanID().String()),
 			String(acc.Role().String()),
 			x.TernaryFunc(
@@ -0,0 +1,278 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	. "github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+type BudgetRepository struct {
+	dbContext *db.Context
+}
+
+func NewBudgetRepository(dbContext *db.Context) ports.BudgetRepository {
+	return &BudgetRepository{
+		dbContext: dbContext,
+	}
+}
+
+type budgetRowWithCount struct {
+	Budgets    model.Budgets `json:"budgets"`
+	TotalCount int64         `json:"total_count"`
+}
+
+func (r BudgetRepository) GetById(ctx context.Context, id types.BudgetID) (budget.Budget, error) {
+	stmt := SELECT(Budgets.AllColumns).
+		FROM(Budgets).
+		WHERE(Budgets.ID.EQ(UUID(id)))
+
+	var row model.Budgets
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return nil, err
+	}
+
+	if row.ID == uuid.Nil {
+		return nil, nil
+	}
+
+	return models.CreateBudgetFromModel(row), nil
+}
+
+func (r BudgetRepository) GetByIdForUser(ctx context.Context, userId types.UserID, id types.BudgetID) (
+	budget.Budget,
+	error) {
+	stmt := SELECT(Budgets.AllColumns).
+		FROM(
+			Budgets.
+				LEFT_JOIN(Families, Families.ID.EQ(Budgets.OwnerFamilyID)).
+				LEFT_JOIN(FamilyMembers, FamilyMembers.FamilyID.EQ(Families.ID)),
+		).
+		WHERE(
+			Budgets.ID.EQ(UUID(id)).
+				AND(budgetVisibleTo(userId)),
+		)
+
+	var row model.Budgets
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return nil, err
+	}
+
+	if row.ID == uuid.Nil {
+		return nil, nil
+	}
+
+	return models.CreateBudgetFromModel(row), nil
+}
+
+func (r BudgetRepository) GetAll(
+	ctx context.Context,
+	userId types.UserID,
+	parameters ports.BudgetQueryParameters) ([]budget.Budget, int64, error) {
+	stmt := SELECT(
+		Budgets.AllColumns,
+		COUNT(STAR).OVER().AS("total_count"),
+	).
+		FROM(
+			Budgets.
+				LEFT_JOIN(Families, Families.ID.EQ(Budgets.OwnerFamilyID)).
+				LEFT_JOIN(FamilyMembers, FamilyMembers.FamilyID.EQ(Families.ID)),
+		).
+		WHERE(budgetVisibleTo(userId)).
+		ORDER_BY(Budgets.Name.ASC(), Budgets.ID.ASC()).
+		LIMIT(parameters.Limit).
+		OFFSET(parameters.Offset)
+
+	var rows []budgetRowWithCount
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, 0, err
+	}
+	if len(rows) == 0 {
+		return nil, 0, nil
+	}
+
+	budgets := herd.Select(rows, func(row budgetRowWithCount) budget.Budget {
+		return models.CreateBudgetFromModel(row.Budgets)
+	})
+
+	return budgets, rows[0].TotalCount, nil
+}
+
+func budgetVisibleTo(userId types.UserID) BoolExpression {
+	return Budgets.OwnerType.EQ(String("personal")).
+		AND(Budgets.OwnerUserID.EQ(String(userId.String()))).
+		OR(Budgets.OwnerType.EQ(String("family")).AND(FamilyMembers.UserID.EQ(String(userId.String()))))
+}
+
+func (r BudgetRepository) Save(ctx context.Context, budgets ...budget.Budget) error {
+	var newBudgets []budget.Budget
+	for _, b := range budgets {
+		if !b.IsExists() {
+			newBudgets = append(newBudgets, b)
+		} else {
+			if err := r.update(ctx, b); err != nil {
+				return err
+			}
+		}
+	}
+	if len(newBudgets) > 0 {
+		if err := r.create(ctx, newBudgets); err != nil {
+			return err
+		}
+	}
+
+	for _, b := range budgets {
+		b.Clean()
+	}
+
+	return nil
+}
+
+func (r BudgetRepository) create(ctx context.Context, budgets []budget.Budget) error {
+	if len(budgets) == 0 {
+		return nil
+	}
+
+	stmt := Budgets.INSERT(
+		Budgets.ID,
+		Budgets.OwnerType,
+		Budgets.OwnerFamilyID,
+		Budgets.OwnerUserID,
+		Budgets.Name,
+		Budgets.Scope,
+		Budgets.LabelID,
+		Budgets.Period,
+		Budgets.LimitAmount,
+		Budgets.LimitCurrency,
+		Budgets.CreatedAt,
+		Budgets.UpdatedAt,
+		Budgets.Etag,
+	)
+
+	for _, b := range budgets {
+		ownerFamilyID, ownerUserID := budgetOwnerValues(b)
+
+		stmt = stmt.VALUES(
+			UUID(b.Id()),
+			String(b.Owner().Type().String()),
+			ownerFamilyID,
+			ownerUserID,
+			String(b.Name()),
+			String(b.Scope().String()),
+			budgetLabelValue(b),
+			String(b.Period().String()),
+			Decimal(b.Limit().Decimal().String()),
+			String(b.Limit().Currency().String()),
+			TimestampzT(b.CreatedAt()),
+			TimestampzT(b.UpdatedAt()),
+			String(b.ETag()),
+		)
+	}
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count != int64(len(budgets)) {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func (r BudgetRepository) update(ctx context.Context, b budget.Budget) error {
+	if !b.IsDirty() {
+		return nil
+	}
+
+	ownerFamilyID, ownerUserID := budgetOwnerValues(b)
+
+	stmt := Budgets.UPDATE().
+		SET(
+			Budgets.OwnerType.SET(String(b.Owner().Type().String())),
+			Budgets.OwnerFamilyID.SET(ownerFamilyID),
+			Budgets.OwnerUserID.SET(ownerUserID),
+			Budgets.Name.SET(String(b.Name())),
+			Budgets.Scope.SET(String(b.Scope().String())),
+			Budgets.LabelID.SET(budgetLabelValue(b)),
+			Budgets.Period.SET(String(b.Period().String())),
+			Budgets.LimitAmount.SET(Decimal(b.Limit().Decimal().String())),
+			Budgets.LimitCurrency.SET(String(b.Limit().Currency().String())),
+			Budgets.UpdatedAt.SET(TimestampzT(b.UpdatedAt())),
+			Budgets.Etag.SET(String(b.ETag())),
+		).
+		WHERE(Budgets.ID.EQ(UUID(b.Id())))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func budgetOwnerValues(b budget.Budget) (StringExpression, StringExpression) {
+	switch b.Owner().Type() {
+	case types.PersonalOwnerType:
+		return StringExp(NULL), String(b.Owner().UserId().String())
+	case types.FamilyOwnerType:
+		return StringExp(UUID(b.Owner().FamilyId())), StringExp(NULL)
+	default:
+		return StringExp(NULL), StringExp(NULL)
+	}
+}
+
+func budgetLabelValue(b budget.Budget) StringExpression {
+	if b.LabelId() == nil {
+		return StringExp(NULL)
+	}
+	return StringExp(UUID(*b.LabelId()))
+}
+
+func (r BudgetRepository) Delete(ctx context.Context, id types.BudgetID) (bool, error) {
+	stmt := Budgets.DELETE().
+		WHERE(Budgets.ID.EQ(UUID(id)))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r BudgetRepository) Exists(ctx context.Context, ids ...types.BudgetID) (bool, error) {
+	if len(ids) == 0 {
+		return true, nil
+	}
+
+	vals := make([]Expression, len(ids))
+	for i, id := range ids {
+		vals[i] = UUID(id)
+	}
+
+	stmt := SELECT(COUNT(Budgets.ID).AS("count")).
+		FROM(Budgets).
+		WHERE(Budgets.ID.IN(vals...))
+
+	var row struct {
+		Count int
+	}
+
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return false, err
+	}
+
+	return row.Count == len(ids), nil
+}
@@ -0,0 +1,163 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	. "github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+type CalendarFeedRepository struct {
+	dbContext *db.Context
+}
+
+func NewCalendarFeedRepository(dbContext *db.Context) ports.CalendarFeedRepository {
+	return &CalendarFeedRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r CalendarFeedRepository) GetById(ctx context.Context, id types.CalendarFeedID) (calendar.Feed, error) {
+	return r.getOne(ctx, CalendarFeeds.ID.EQ(UUID(id)))
+}
+
+func (r CalendarFeedRepository) GetByUserId(ctx context.Context, userId types.UserID) (calendar.Feed, error) {
+	return r.getOne(ctx, CalendarFeeds.UserID.EQ(String(userId.String())))
+}
+
+func (r CalendarFeedRepository) GetByTokenHash(ctx context.Context, tokenHash string) (calendar.Feed, error) {
+	return r.getOne(ctx, CalendarFeeds.TokenHash.EQ(String(tokenHash)))
+}
+
+func (r CalendarFeedRepository) getOne(ctx context.Context, condition BoolExpression) (calendar.Feed, error) {
+	stmt := SELECT(CalendarFeeds.AllColumns).
+		FROM(CalendarFeeds).
+		WHERE(condition)
+
+	var row model.CalendarFeeds
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return nil, err
+	}
+
+	if row.ID == uuid.Nil {
+		return nil, nil
+	}
+
+	return models.CreateCalendarFeedFromModel(row), nil
+}
+
+func (r CalendarFeedRepository) Save(ctx context.Context, feeds ...calendar.Feed) error {
+	for _, feed := range feeds {
+		var err error
+		if !feed.IsExists() {
+			err = r.create(ctx, feed)
+		} else {
+			err = r.update(ctx, feed)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, feed := range feeds {
+		feed.Clean()
+	}
+
+	return nil
+}
+
+func (r CalendarFeedRepository) create(ctx context.Context, feed calendar.Feed) error {
+	stmt := CalendarFeeds.INSERT(
+		CalendarFeeds.ID,
+		CalendarFeeds.UserID,
+		CalendarFeeds.TokenHash,
+		CalendarFeeds.CreatedAt,
+		CalendarFeeds.UpdatedAt,
+		CalendarFeeds.Etag,
+	).VALUES(
+		UUID(feed.Id()),
+		String(feed.UserId().String()),
+		String(feed.TokenHash()),
+		TimestampzT(feed.CreatedAt()),
+		TimestampzT(feed.UpdatedAt()),
+		String(feed.ETag()),
+	)
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count != 1 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func (r CalendarFeedRepository) update(ctx context.Context, feed calendar.Feed) error {
+	if !feed.IsDirty() {
+		return nil
+	}
+
+	stmt := CalendarFeeds.UPDATE().
+		SET(
+			CalendarFeeds.TokenHash.SET(String(feed.TokenHash())),
+			CalendarFeeds.UpdatedAt.SET(TimestampzT(feed.UpdatedAt())),
+			CalendarFeeds.Etag.SET(String(feed.ETag())),
+		).
+		WHERE(CalendarFeeds.ID.EQ(UUID(feed.Id())))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func (r CalendarFeedRepository) Delete(ctx context.Context, id types.CalendarFeedID) (bool, error) {
+	stmt := CalendarFeeds.DELETE().
+		WHERE(CalendarFeeds.ID.EQ(UUID(id)))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r CalendarFeedRepository) Exists(ctx context.Context, ids ...types.CalendarFeedID) (bool, error) {
+	if len(ids) == 0 {
+		return true, nil
+	}
+
+	vals := make([]Expression, len(ids))
+	for i, id := range ids {
+		vals[i] = UUID(id)
+	}
+
+	stmt := SELECT(COUNT(CalendarFeeds.ID).AS("count")).
+		FROM(CalendarFeeds).
+		WHERE(CalendarFeeds.ID.IN(vals...))
+
+	var row struct {
+		Count int
+	}
+
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return false, err
+	}
+
+	return row.Count == len(ids), nil
+}
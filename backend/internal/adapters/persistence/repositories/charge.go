@@ -0,0 +1,231 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	. "github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+type ChargeRepository struct {
+	dbContext *db.Context
+}
+
+func NewChargeRepository(dbContext *db.Context) ports.ChargeRepository {
+	return &ChargeRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r ChargeRepository) GetById(ctx context.Context, chargeId types.ChargeID) (charge.Charge, error) {
+	stmt := SELECT(Charges.AllColumns).
+		FROM(Charges).
+		WHERE(Charges.ID.EQ(UUID(chargeId)))
+
+	var row model.Charges
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return nil, err
+	}
+
+	if row.ID == uuid.Nil {
+		return nil, nil
+	}
+
+	return models.CreateChargeFromModel(row), nil
+}
+
+func (r ChargeRepository) GetBySubscriptionId(ctx context.Context, subscriptionId types.SubscriptionID) (
+	[]charge.Charge,
+	error) {
+	stmt := SELECT(Charges.AllColumns).
+		FROM(Charges).
+		WHERE(Charges.SubscriptionID.EQ(UUID(subscriptionId))).
+		ORDER_BY(Charges.DueDate.ASC())
+
+	var rows []model.Charges
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+
+	return herd.Select(rows, models.CreateChargeFromModel), nil
+}
+
+func (r ChargeRepository) GetRecordedBySubscriptionIds(
+	ctx context.Context,
+	subscriptionIds ...types.SubscriptionID) ([]charge.Charge, error) {
+	if len(subscriptionIds) == 0 {
+		return nil, nil
+	}
+
+	vals := make([]Expression, len(subscriptionIds))
+	for i, id := range subscriptionIds {
+		vals[i] = UUID(id)
+	}
+
+	stmt := SELECT(Charges.AllColumns).
+		FROM(Charges).
+		WHERE(
+			Charges.SubscriptionID.IN(vals...).
+				AND(Charges.ActualAmount.IS_NOT_NULL()),
+		).
+		ORDER_BY(Charges.SubscriptionID.ASC(), Charges.DueDate.ASC())
+
+	var rows []model.Charges
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+
+	return herd.Select(rows, models.CreateChargeFromModel), nil
+}
+
+func (r ChargeRepository) Save(ctx context.Context, charges ...charge.Charge) error {
+	var newCharges []charge.Charge
+	for _, chg := range charges {
+		if !chg.IsExists() {
+			newCharges = append(newCharges, chg)
+		} else {
+			if err := r.update(ctx, chg); err != nil {
+				return err
+			}
+		}
+	}
+	if len(newCharges) > 0 {
+		if err := r.create(ctx, newCharges); err != nil {
+			return err
+		}
+	}
+
+	for _, chg := range charges {
+		chg.Clean()
+	}
+
+	return nil
+}
+
+func (r ChargeRepository) create(ctx context.Context, charges []charge.Charge) error {
+	if len(charges) == 0 {
+		return nil
+	}
+
+	stmt := Charges.INSERT(
+		Charges.ID,
+		Charges.SubscriptionID,
+		Charges.DueDate,
+		Charges.Status,
+		Charges.ExpectedAmount,
+		Charges.ExpectedCurrency,
+		Charges.ActualAmount,
+		Charges.ActualCurrency,
+		Charges.CreatedAt,
+		Charges.UpdatedAt,
+		Charges.Etag,
+	)
+
+	for _, chg := range charges {
+		actualAmountVal, actualCurrencyVal := chargeActualAmountValues(chg)
+		stmt = stmt.VALUES(
+			UUID(chg.Id()),
+			UUID(chg.SubscriptionId()),
+			TimestampzT(chg.DueDate()),
+			String(chg.Status().String()),
+			Decimal(chg.ExpectedAmount().Decimal().String()),
+			String(chg.ExpectedAmount().Currency().String()),
+			actualAmountVal,
+			actualCurrencyVal,
+			TimestampzT(chg.CreatedAt()),
+			TimestampzT(chg.UpdatedAt()),
+			String(chg.ETag()),
+		)
+	}
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count != int64(len(charges)) {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func (r ChargeRepository) update(ctx context.Context, chg charge.Charge) error {
+	if !chg.IsDirty() {
+		return nil
+	}
+
+	actualAmountVal, actualCurrencyVal := chargeActualAmountValues(chg)
+	stmt := Charges.UPDATE().
+		SET(
+			Charges.Status.SET(String(chg.Status().String())),
+			Charges.ExpectedAmount.SET(Decimal(chg.ExpectedAmount().Decimal().String())),
+			Charges.ExpectedCurrency.SET(String(chg.ExpectedAmount().Currency().String())),
+			Charges.ActualAmount.SET(actualAmountVal),
+			Charges.ActualCurrency.SET(actualCurrencyVal),
+			Charges.UpdatedAt.SET(TimestampzT(chg.UpdatedAt())),
+			Charges.Etag.SET(String(chg.ETag())),
+		).
+		WHERE(Charges.ID.EQ(UUID(chg.Id())))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func chargeActualAmountValues(chg charge.Charge) (FloatExpression, StringExpression) {
+	if chg.ActualAmount() == nil {
+		return FloatExp(NULL), StringExp(NULL)
+	}
+	return Decimal(chg.ActualAmount().Decimal().String()), String(chg.ActualAmount().Currency().String())
+}
+
+func (r ChargeRepository) Delete(ctx context.Context, id types.ChargeID) (bool, error) {
+	stmt := Charges.DELETE().
+		WHERE(Charges.ID.EQ(UUID(id)))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r ChargeRepository) Exists(ctx context.Context, ids ...types.ChargeID) (bool, error) {
+	if len(ids) == 0 {
+		return true, nil
+	}
+
+	vals := make([]Expression, len(ids))
+	for i, id := range ids {
+		vals[i] = UUID(id)
+	}
+
+	stmt := SELECT(COUNT(Charges.ID).AS("count")).
+		FROM(Charges).
+		WHERE(Charges.ID.IN(vals...))
+
+	var row struct {
+		Count int
+	}
+
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return false, err
+	}
+
+	return row.Count == len(ids), nil
+}
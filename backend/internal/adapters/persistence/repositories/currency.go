@@ -44,6 +44,38 @@ func (r CurrencyRateRepository) GetLatestUpdateDate(ctx context.Context) (time.T
 	return *row.LatestUpdateDate, nil
 }
 
+func (r CurrencyRateRepository) CountPairsByDate(
+	ctx context.Context,
+	start, end time.Time,
+	units []currency.Unit) (map[time.Time]int, error) {
+	codes := herd.Select(units, func(unit currency.Unit) Expression {
+		return String(unit.String())
+	})
+	stmt := SELECT(
+		CurrencyRates.RateDate.AS("rate_date"),
+		COUNT(DISTINCT(CONCAT(CurrencyRates.FromCurrency, CurrencyRates.ToCurrency))).AS("pairs"),
+	).
+		FROM(CurrencyRates).
+		WHERE(CurrencyRates.RateDate.BETWEEN(DateT(start), DateT(end)).
+			AND(CurrencyRates.FromCurrency.IN(codes...)).
+			AND(CurrencyRates.ToCurrency.IN(codes...))).
+		GROUP_BY(CurrencyRates.RateDate)
+
+	var rows []struct {
+		RateDate time.Time
+		Pairs    int
+	}
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[time.Time]int, len(rows))
+	for _, row := range rows {
+		counts[currency.RateDate(row.RateDate)] = row.Pairs
+	}
+	return counts, nil
+}
+
 func (r CurrencyRateRepository) GetById(ctx context.Context, rateID types.RateID) (currency.Rate, error) {
 	stmt := SELECT(CurrencyRates.AllColumns).
 		FROM(CurrencyRates).
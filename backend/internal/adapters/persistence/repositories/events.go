@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"github.com/mistribe/subtracker/internal/domain/entity"
+)
+
+// recordedEvents returns the events of the saved entities in order, it must be called before Clean discards them
+func recordedEvents[TKey comparable, TEntity entity.Entity[TKey]](entities []TEntity) []entity.Event {
+	var events []entity.Event
+	for _, e := range entities {
+		events = append(events, e.Events()...)
+	}
+	return events
+}
@@ -19,11 +19,13 @@ import (
 
 type FamilyRepository struct {
 	dbContext *db.Context
+	outbox    ports.OutboxRepository
 }
 
-func NewFamilyRepository(repository *db.Context) ports.FamilyRepository {
+func NewFamilyRepository(repository *db.Context, outbox ports.OutboxRepository) ports.FamilyRepository {
 	return &FamilyRepository{
 		dbContext: repository,
+		outbox:    outbox,
 	}
 }
 
@@ -114,21 +116,29 @@ func (r FamilyRepository) GetById(ctx context.Context, familyID types.FamilyID)
 }
 
 func (r FamilyRepository) Save(ctx context.Context, families ...family.Family) error {
-	var newFamilies []family.Family
-	for _, fam := range families {
-		if !fam.IsExists() {
-			newFamilies = append(newFamilies, fam)
-		} else {
-			if err := r.update(ctx, fam); err != nil {
-				return err
+	// The recorded events are stored with the families, they are published once the transaction commits
+	err := r.dbContext.WithTransaction(ctx, func(ctx context.Context) error {
+		var newFamilies []family.Family
+		for _, fam := range families {
+			if !fam.IsExists() {
+				newFamilies = append(newFamilies, fam)
+			} else {
+				if err := r.update(ctx, fam); err != nil {
+					return err
+				}
 			}
 		}
-	}
 
-	if len(newFamilies) > 0 {
-		if err := r.create(ctx, newFamilies); err != nil {
-			return err
+		if len(newFamilies) > 0 {
+			if err := r.create(ctx, newFamilies); err != nil {
+				return err
+			}
 		}
+
+		return r.outbox.Add(ctx, recordedEvents(families)...)
+	})
+	if err != nil {
+		return err
 	}
 
 	for _, fam := range families {
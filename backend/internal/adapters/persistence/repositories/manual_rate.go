@@ -0,0 +1,292 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	. "github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+type ManualRateRepository struct {
+	dbContext *db.Context
+}
+
+func NewManualRateRepository(dbContext *db.Context) ports.ManualRateRepository {
+	return &ManualRateRepository{
+		dbContext: dbContext,
+	}
+}
+
+type manualRateRowWithCount struct {
+	ManualRates model.ManualRates `json:"manual_rates"`
+	TotalCount  int64             `json:"total_count"`
+}
+
+func (r ManualRateRepository) GetById(ctx context.Context, id types.ManualRateID) (currency.ManualRate, error) {
+	stmt := SELECT(ManualRates.AllColumns).
+		FROM(ManualRates).
+		WHERE(ManualRates.ID.EQ(UUID(id)))
+
+	var row model.ManualRates
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return nil, err
+	}
+
+	if row.ID == uuid.Nil {
+		return nil, nil
+	}
+
+	return models.CreateManualRateFromModel(row), nil
+}
+
+func (r ManualRateRepository) GetByIdForUser(ctx context.Context, userId types.UserID, id types.ManualRateID) (
+	currency.ManualRate,
+	error) {
+	stmt := SELECT(ManualRates.AllColumns).
+		FROM(
+			ManualRates.
+				LEFT_JOIN(Families, Families.ID.EQ(ManualRates.OwnerFamilyID)).
+				LEFT_JOIN(FamilyMembers, FamilyMembers.FamilyID.EQ(Families.ID)),
+		).
+		WHERE(
+			ManualRates.ID.EQ(UUID(id)).
+				AND(manualRateVisibleTo(userId)),
+		)
+
+	var row model.ManualRates
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return nil, err
+	}
+
+	if row.ID == uuid.Nil {
+		return nil, nil
+	}
+
+	return models.CreateManualRateFromModel(row), nil
+}
+
+func (r ManualRateRepository) GetAll(
+	ctx context.Context,
+	userId types.UserID,
+	parameters ports.ManualRateQueryParameters) ([]currency.ManualRate, int64, error) {
+	stmt := SELECT(
+		ManualRates.AllColumns,
+		COUNT(STAR).OVER().AS("total_count"),
+	).
+		FROM(
+			ManualRates.
+				LEFT_JOIN(Families, Families.ID.EQ(ManualRates.OwnerFamilyID)).
+				LEFT_JOIN(FamilyMembers, FamilyMembers.FamilyID.EQ(Families.ID)),
+		).
+		WHERE(manualRateVisibleTo(userId)).
+		ORDER_BY(ManualRates.FromCurrency.ASC(), ManualRates.ToCurrency.ASC(), ManualRates.ID.ASC()).
+		LIMIT(parameters.Limit).
+		OFFSET(parameters.Offset)
+
+	var rows []manualRateRowWithCount
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, 0, err
+	}
+	if len(rows) == 0 {
+		return nil, 0, nil
+	}
+
+	rates := herd.Select(rows, func(row manualRateRowWithCount) currency.ManualRate {
+		return models.CreateManualRateFromModel(row.ManualRates)
+	})
+
+	return rates, rows[0].TotalCount, nil
+}
+
+func (r ManualRateRepository) GetAllForUser(ctx context.Context, userId types.UserID) (currency.ManualRates, error) {
+	stmt := SELECT(ManualRates.AllColumns).
+		FROM(
+			ManualRates.
+				LEFT_JOIN(Families, Families.ID.EQ(ManualRates.OwnerFamilyID)).
+				LEFT_JOIN(FamilyMembers, FamilyMembers.FamilyID.EQ(Families.ID)),
+		).
+		WHERE(manualRateVisibleTo(userId)).
+		ORDER_BY(ManualRates.ID.ASC())
+
+	var rows []model.ManualRates
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+
+	return herd.Select(rows, func(row model.ManualRates) currency.ManualRate {
+		return models.CreateManualRateFromModel(row)
+	}), nil
+}
+
+func manualRateVisibleTo(userId types.UserID) BoolExpression {
+	return ManualRates.OwnerType.EQ(String("personal")).
+		AND(ManualRates.OwnerUserID.EQ(String(userId.String()))).
+		OR(ManualRates.OwnerType.EQ(String("family")).AND(FamilyMembers.UserID.EQ(String(userId.String()))))
+}
+
+func (r ManualRateRepository) Save(ctx context.Context, rates ...currency.ManualRate) error {
+	var newRates []currency.ManualRate
+	for _, rate := range rates {
+		if !rate.IsExists() {
+			newRates = append(newRates, rate)
+		} else {
+			if err := r.update(ctx, rate); err != nil {
+				return err
+			}
+		}
+	}
+	if len(newRates) > 0 {
+		if err := r.create(ctx, newRates); err != nil {
+			return err
+		}
+	}
+
+	for _, rate := range rates {
+		rate.Clean()
+	}
+
+	return nil
+}
+
+func (r ManualRateRepository) create(ctx context.Context, rates []currency.ManualRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	stmt := ManualRates.INSERT(
+		ManualRates.ID,
+		ManualRates.OwnerType,
+		ManualRates.OwnerFamilyID,
+		ManualRates.OwnerUserID,
+		ManualRates.SubscriptionID,
+		ManualRates.FromCurrency,
+		ManualRates.ToCurrency,
+		ManualRates.ExchangeRate,
+		ManualRates.CreatedAt,
+		ManualRates.UpdatedAt,
+		ManualRates.Etag,
+	)
+
+	for _, rate := range rates {
+		ownerFamilyID, ownerUserID := manualRateOwnerValues(rate)
+
+		stmt = stmt.VALUES(
+			UUID(rate.Id()),
+			String(rate.Owner().Type().String()),
+			ownerFamilyID,
+			ownerUserID,
+			manualRateSubscriptionValue(rate),
+			String(rate.FromCurrency().String()),
+			String(rate.ToCurrency().String()),
+			Float(rate.ExchangeRate()),
+			TimestampzT(rate.CreatedAt()),
+			TimestampzT(rate.UpdatedAt()),
+			String(rate.ETag()),
+		)
+	}
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count != int64(len(rates)) {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func (r ManualRateRepository) update(ctx context.Context, rate currency.ManualRate) error {
+	if !rate.IsDirty() {
+		return nil
+	}
+
+	ownerFamilyID, ownerUserID := manualRateOwnerValues(rate)
+
+	stmt := ManualRates.UPDATE().
+		SET(
+			ManualRates.OwnerType.SET(String(rate.Owner().Type().String())),
+			ManualRates.OwnerFamilyID.SET(ownerFamilyID),
+			ManualRates.OwnerUserID.SET(ownerUserID),
+			ManualRates.SubscriptionID.SET(manualRateSubscriptionValue(rate)),
+			ManualRates.FromCurrency.SET(String(rate.FromCurrency().String())),
+			ManualRates.ToCurrency.SET(String(rate.ToCurrency().String())),
+			ManualRates.ExchangeRate.SET(Float(rate.ExchangeRate())),
+			ManualRates.UpdatedAt.SET(TimestampzT(rate.UpdatedAt())),
+			ManualRates.Etag.SET(String(rate.ETag())),
+		).
+		WHERE(ManualRates.ID.EQ(UUID(rate.Id())))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func manualRateOwnerValues(rate currency.ManualRate) (StringExpression, StringExpression) {
+	switch rate.Owner().Type() {
+	case types.PersonalOwnerType:
+		return StringExp(NULL), String(rate.Owner().UserId().String())
+	case types.FamilyOwnerType:
+		return StringExp(UUID(rate.Owner().FamilyId())), StringExp(NULL)
+	default:
+		return StringExp(NULL), StringExp(NULL)
+	}
+}
+
+func manualRateSubscriptionValue(rate currency.ManualRate) StringExpression {
+	if rate.SubscriptionId() == nil {
+		return StringExp(NULL)
+	}
+	return StringExp(UUID(*rate.SubscriptionId()))
+}
+
+func (r ManualRateRepository) Delete(ctx context.Context, id types.ManualRateID) (bool, error) {
+	stmt := ManualRates.DELETE().
+		WHERE(ManualRates.ID.EQ(UUID(id)))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r ManualRateRepository) Exists(ctx context.Context, ids ...types.ManualRateID) (bool, error) {
+	if len(ids) == 0 {
+		return true, nil
+	}
+
+	vals := make([]Expression, len(ids))
+	for i, id := range ids {
+		vals[i] = UUID(id)
+	}
+
+	stmt := SELECT(COUNT(ManualRates.ID).AS("count")).
+		FROM(ManualRates).
+		WHERE(ManualRates.ID.IN(vals...))
+
+	var row struct {
+		Count int
+	}
+
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return false, err
+	}
+
+	return row.Count == len(ids), nil
+}
@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	. "github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+type NotificationPreferencesRepository struct {
+	dbContext *db.Context
+}
+
+func NewNotificationPreferencesRepository(dbContext *db.Context) ports.NotificationPreferencesRepository {
+	return &NotificationPreferencesRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r NotificationPreferencesRepository) GetByUserId(
+	ctx context.Context,
+	userId types.UserID) (notification.Preferences, error) {
+	stmt := SELECT(NotificationPreferences.AllColumns).
+		FROM(NotificationPreferences).
+		WHERE(NotificationPreferences.UserID.EQ(String(userId.String()))).
+		LIMIT(1)
+
+	var rows []model.NotificationPreferences
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return models.CreateNotificationPreferencesFromModel(rows[0]), nil
+}
+
+func (r NotificationPreferencesRepository) GetAllEnabled(ctx context.Context) ([]notification.Preferences, error) {
+	stmt := SELECT(NotificationPreferences.AllColumns).
+		FROM(NotificationPreferences).
+		WHERE(NotificationPreferences.Enabled.IS_TRUE()).
+		ORDER_BY(NotificationPreferences.UserID.ASC())
+
+	var rows []model.NotificationPreferences
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+
+	all := make([]notification.Preferences, len(rows))
+	for i, row := range rows {
+		all[i] = models.CreateNotificationPreferencesFromModel(row)
+	}
+	return all, nil
+}
+
+func (r NotificationPreferencesRepository) Save(ctx context.Context, preferences notification.Preferences) error {
+	if !preferences.IsDirty() {
+		return nil
+	}
+
+	var err error
+	if preferences.IsExists() {
+		err = r.update(ctx, preferences)
+	} else {
+		err = r.create(ctx, preferences)
+	}
+	if err != nil {
+		return err
+	}
+
+	preferences.Clean()
+	return nil
+}
+
+func (r NotificationPreferencesRepository) create(ctx context.Context, preferences notification.Preferences) error {
+	stmt := NotificationPreferences.INSERT(
+		NotificationPreferences.UserID,
+		NotificationPreferences.Enabled,
+		NotificationPreferences.Locale,
+		NotificationPreferences.RenewalLeadDays,
+		NotificationPreferences.FreeTrialEndLeadDays,
+		NotificationPreferences.CancellationDeadlineLeadDays,
+		NotificationPreferences.CreatedAt,
+		NotificationPreferences.UpdatedAt,
+		NotificationPreferences.Etag,
+	).VALUES(
+		String(preferences.UserId().String()),
+		Bool(preferences.Enabled()),
+		String(preferences.Locale().String()),
+		Int32(int32(preferences.RenewalLeadDays())),
+		Int32(int32(preferences.FreeTrialEndLeadDays())),
+		Int32(int32(preferences.CancellationDeadlineLeadDays())),
+		TimestampzT(preferences.CreatedAt()),
+		TimestampzT(preferences.UpdatedAt()),
+		String(preferences.ETag()),
+	)
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count != 1 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func (r NotificationPreferencesRepository) update(ctx context.Context, preferences notification.Preferences) error {
+	stmt := NotificationPreferences.UPDATE().
+		SET(
+			NotificationPreferences.Enabled.SET(Bool(preferences.Enabled())),
+			NotificationPreferences.Locale.SET(String(preferences.Locale().String())),
+			NotificationPreferences.RenewalLeadDays.SET(Int32(int32(preferences.RenewalLeadDays()))),
+			NotificationPreferences.FreeTrialEndLeadDays.SET(Int32(int32(preferences.FreeTrialEndLeadDays()))),
+			NotificationPreferences.CancellationDeadlineLeadDays.SET(
+				Int32(int32(preferences.CancellationDeadlineLeadDays()))),
+			NotificationPreferences.UpdatedAt.SET(TimestampzT(preferences.UpdatedAt())),
+			NotificationPreferences.Etag.SET(String(preferences.ETag())),
+		).
+		WHERE(NotificationPreferences.UserID.EQ(String(preferences.UserId().String())))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
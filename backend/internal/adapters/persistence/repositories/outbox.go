@@ -0,0 +1,269 @@
+package repositories
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	. "github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/ports"
+
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+const (
+	// outboxMaxAttempts is the number of failed publishes after which an event is kept aside
+	outboxMaxAttempts    = 10
+	outboxInitialBackoff = 30 * time.Second
+	outboxMaxBackoff     = time.Hour
+	outboxMaxErrorLength = 500
+	// outboxLease is how long the events claimed by a relay are skipped by the other relays, an event is claimed
+	// again when its relay stops or takes longer to publish it
+	outboxLease = 5 * time.Minute
+)
+
+type OutboxRepository struct {
+	dbContext *db.Context
+}
+
+func NewOutboxRepository(dbContext *db.Context) ports.OutboxRepository {
+	return &OutboxRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r OutboxRepository) Add(ctx context.Context, events ...entity.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	stmt := OutboxEvents.INSERT(
+		OutboxEvents.ID,
+		OutboxEvents.EventName,
+		OutboxEvents.Payload,
+		OutboxEvents.CreatedAt,
+		OutboxEvents.Attempts,
+	)
+	now := time.Now()
+	for _, event := range events {
+		row, err := models.CreateOutboxModelFromEvent(event, now)
+		if err != nil {
+			return err
+		}
+		stmt = stmt.VALUES(
+			UUID(row.ID),
+			String(row.EventName),
+			String(row.Payload),
+			TimestampzT(row.CreatedAt),
+			Int32(row.Attempts),
+		)
+	}
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count != int64(len(events)) {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func (r OutboxRepository) Relay(
+	ctx context.Context,
+	limit int64,
+	publish func(ctx context.Context, events ...entity.Event) error) (ports.OutboxRelayResult, error) {
+	now := time.Now()
+	rows, err := r.claim(ctx, limit, now)
+	if err != nil {
+		return ports.OutboxRelayResult{}, err
+	}
+	if len(rows) == 0 {
+		return ports.OutboxRelayResult{}, nil
+	}
+
+	// The subscribers run outside of the transactions of the relay, the lease keeps the other relays off the claimed events
+	var published []Expression
+	unreadable := make(map[int]error)
+	failures := make(map[int]error)
+	for i, row := range rows {
+		event, err := models.CreateEventFromOutboxModel(row)
+		if err != nil {
+			unreadable[i] = err
+			continue
+		}
+		if err := publish(ctx, event); err != nil {
+			failures[i] = err
+			continue
+		}
+		published = append(published, UUID(row.ID))
+	}
+
+	var result ports.OutboxRelayResult
+	err = r.dbContext.WithTransaction(ctx, func(txCtx context.Context) error {
+		for i, row := range rows {
+			if cause, ok := unreadable[i]; ok {
+				// An event that cannot be read back will not be later, it is kept aside with its error and not purged
+				if err := r.markFailed(txCtx, row, now, cause); err != nil {
+					return err
+				}
+				result.Failed++
+				continue
+			}
+			cause, ok := failures[i]
+			if !ok {
+				continue
+			}
+			failed, err := r.markRetried(txCtx, row, now, cause)
+			if err != nil {
+				return err
+			}
+			if failed {
+				result.Failed++
+			} else {
+				result.Retried++
+			}
+		}
+		if len(published) == 0 {
+			return nil
+		}
+
+		update := OutboxEvents.UPDATE().
+			SET(
+				OutboxEvents.ProcessedAt.SET(TimestampzT(now)),
+				OutboxEvents.LockedUntil.SET(TimestampzExp(NULL)),
+			).
+			WHERE(OutboxEvents.ID.IN(published...))
+		if _, err := r.dbContext.Execute(txCtx, update); err != nil {
+			return err
+		}
+		result.Published = len(published)
+		return nil
+	})
+	if err != nil {
+		return ports.OutboxRelayResult{}, err
+	}
+
+	return result, nil
+}
+
+// claim takes up to limit pending events that are due and not leased by another relay, and leases them for
+// outboxLease. The transaction only lasts for the claim, the locks are released before the events are published.
+func (r OutboxRepository) claim(ctx context.Context, limit int64, now time.Time) ([]model.OutboxEvents, error) {
+	var rows []model.OutboxEvents
+	err := r.dbContext.WithTransaction(ctx, func(txCtx context.Context) error {
+		stmt := SELECT(OutboxEvents.AllColumns).
+			FROM(OutboxEvents).
+			WHERE(
+				OutboxEvents.ProcessedAt.IS_NULL().
+					AND(OutboxEvents.FailedAt.IS_NULL()).
+					AND(OutboxEvents.NextAttemptAt.IS_NULL().
+						OR(OutboxEvents.NextAttemptAt.LT_EQ(TimestampzT(now)))).
+					AND(OutboxEvents.LockedUntil.IS_NULL().
+						OR(OutboxEvents.LockedUntil.LT_EQ(TimestampzT(now)))),
+			).
+			ORDER_BY(OutboxEvents.CreatedAt.ASC(), OutboxEvents.ID.ASC()).
+			LIMIT(limit).
+			FOR(UPDATE().SKIP_LOCKED())
+
+		if err := r.dbContext.Query(txCtx, stmt, &rows); err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		ids := make([]Expression, 0, len(rows))
+		for _, row := range rows {
+			ids = append(ids, UUID(row.ID))
+		}
+		update := OutboxEvents.UPDATE().
+			SET(OutboxEvents.LockedUntil.SET(TimestampzT(now.Add(outboxLease)))).
+			WHERE(OutboxEvents.ID.IN(ids...))
+		_, err := r.dbContext.Execute(txCtx, update)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r OutboxRepository) markFailed(ctx context.Context, row model.OutboxEvents, now time.Time, cause error) error {
+	stmt := OutboxEvents.UPDATE().
+		SET(
+			OutboxEvents.FailedAt.SET(TimestampzT(now)),
+			OutboxEvents.LockedUntil.SET(TimestampzExp(NULL)),
+			OutboxEvents.Error.SET(String(outboxError(cause))),
+		).
+		WHERE(OutboxEvents.ID.EQ(UUID(row.ID)))
+
+	_, err := r.dbContext.Execute(ctx, stmt)
+	return err
+}
+
+// markRetried records a failed publish of the event, it is attempted again after a backoff that doubles at each
+// attempt or kept aside after outboxMaxAttempts attempts. It returns whether the event was kept aside.
+func (r OutboxRepository) markRetried(
+	ctx context.Context,
+	row model.OutboxEvents,
+	now time.Time,
+	cause error) (bool, error) {
+	attempts := row.Attempts + 1
+	if attempts >= outboxMaxAttempts {
+		stmt := OutboxEvents.UPDATE().
+			SET(
+				OutboxEvents.Attempts.SET(Int32(attempts)),
+				OutboxEvents.FailedAt.SET(TimestampzT(now)),
+				OutboxEvents.LockedUntil.SET(TimestampzExp(NULL)),
+				OutboxEvents.Error.SET(String(outboxError(cause))),
+			).
+			WHERE(OutboxEvents.ID.EQ(UUID(row.ID)))
+		_, err := r.dbContext.Execute(ctx, stmt)
+		return true, err
+	}
+
+	stmt := OutboxEvents.UPDATE().
+		SET(
+			OutboxEvents.Attempts.SET(Int32(attempts)),
+			OutboxEvents.NextAttemptAt.SET(TimestampzT(now.Add(outboxBackoff(attempts)))),
+			OutboxEvents.LockedUntil.SET(TimestampzExp(NULL)),
+			OutboxEvents.Error.SET(String(outboxError(cause))),
+		).
+		WHERE(OutboxEvents.ID.EQ(UUID(row.ID)))
+	_, err := r.dbContext.Execute(ctx, stmt)
+	return false, err
+}
+
+// outboxBackoff returns the time to wait after the failed attempt before the next one
+func outboxBackoff(attempt int32) time.Duration {
+	backoff := outboxInitialBackoff
+	for i := int32(1); i < attempt; i++ {
+		backoff *= 2
+		if backoff >= outboxMaxBackoff {
+			return outboxMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// outboxError returns the error kept on an event, bounded since the failures of every subscriber are joined
+func outboxError(cause error) string {
+	message := cause.Error()
+	if len(message) > outboxMaxErrorLength {
+		return strings.ToValidUTF8(message[:outboxMaxErrorLength], "")
+	}
+	return message
+}
+
+func (r OutboxRepository) DeleteProcessedBefore(ctx context.Context, before time.Time) (int64, error) {
+	// The failed events are never processed, they stay until they are looked into
+	stmt := OutboxEvents.DELETE().
+		WHERE(OutboxEvents.ProcessedAt.LT(TimestampzT(before)))
+
+	return r.dbContext.Execute(ctx, stmt)
+}
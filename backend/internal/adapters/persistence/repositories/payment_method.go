@@ -0,0 +1,301 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	. "github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+type PaymentMethodRepository struct {
+	dbContext *db.Context
+}
+
+func NewPaymentMethodRepository(dbContext *db.Context) ports.PaymentMethodRepository {
+	return &PaymentMethodRepository{
+		dbContext: dbContext,
+	}
+}
+
+type paymentMethodRowWithCount struct {
+	PaymentMethods model.PaymentMethods `json:"payment_methods"`
+	TotalCount     int64                `json:"total_count"`
+}
+
+func (r PaymentMethodRepository) GetById(ctx context.Context, id types.PaymentMethodID) (
+	paymentmethod.PaymentMethod,
+	error) {
+	stmt := SELECT(PaymentMethods.AllColumns).
+		FROM(PaymentMethods).
+		WHERE(PaymentMethods.ID.EQ(UUID(id)))
+
+	var row model.PaymentMethods
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return nil, err
+	}
+
+	if row.ID == uuid.Nil {
+		return nil, nil
+	}
+
+	return models.CreatePaymentMethodFromModel(row), nil
+}
+
+func (r PaymentMethodRepository) GetByIdForUser(ctx context.Context, userId types.UserID, id types.PaymentMethodID) (
+	paymentmethod.PaymentMethod,
+	error) {
+	stmt := SELECT(PaymentMethods.AllColumns).
+		FROM(
+			PaymentMethods.
+				LEFT_JOIN(Families, Families.ID.EQ(PaymentMethods.OwnerFamilyID)).
+				LEFT_JOIN(FamilyMembers, FamilyMembers.FamilyID.EQ(Families.ID)),
+		).
+		WHERE(
+			PaymentMethods.ID.EQ(UUID(id)).
+				AND(paymentMethodVisibleTo(userId)),
+		)
+
+	var row model.PaymentMethods
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return nil, err
+	}
+
+	if row.ID == uuid.Nil {
+		return nil, nil
+	}
+
+	return models.CreatePaymentMethodFromModel(row), nil
+}
+
+func (r PaymentMethodRepository) GetAll(
+	ctx context.Context,
+	userId types.UserID,
+	parameters ports.PaymentMethodQueryParameters) ([]paymentmethod.PaymentMethod, int64, error) {
+	condition := paymentMethodVisibleTo(userId)
+	if parameters.SearchText != "" {
+		condition = condition.AND(
+			PaymentMethods.Label.LIKE(String(fmt.Sprintf("%%%s%%", parameters.SearchText))))
+	}
+
+	stmt := SELECT(
+		PaymentMethods.AllColumns,
+		COUNT(STAR).OVER().AS("total_count"),
+	).
+		FROM(
+			PaymentMethods.
+				LEFT_JOIN(Families, Families.ID.EQ(PaymentMethods.OwnerFamilyID)).
+				LEFT_JOIN(FamilyMembers, FamilyMembers.FamilyID.EQ(Families.ID)),
+		).
+		WHERE(condition).
+		ORDER_BY(PaymentMethods.Label.ASC()).
+		LIMIT(parameters.Limit).
+		OFFSET(parameters.Offset)
+
+	var rows []paymentMethodRowWithCount
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, 0, err
+	}
+	if len(rows) == 0 {
+		return nil, 0, nil
+	}
+
+	paymentMethods := herd.Select(rows, func(row paymentMethodRowWithCount) paymentmethod.PaymentMethod {
+		return models.CreatePaymentMethodFromModel(row.PaymentMethods)
+	})
+
+	return paymentMethods, rows[0].TotalCount, nil
+}
+
+func paymentMethodVisibleTo(userId types.UserID) BoolExpression {
+	return PaymentMethods.OwnerType.EQ(String("personal")).
+		AND(PaymentMethods.OwnerUserID.EQ(String(userId.String()))).
+		OR(PaymentMethods.OwnerType.EQ(String("family")).AND(FamilyMembers.UserID.EQ(String(userId.String()))))
+}
+
+func (r PaymentMethodRepository) Save(ctx context.Context, paymentMethods ...paymentmethod.PaymentMethod) error {
+	var newPaymentMethods []paymentmethod.PaymentMethod
+	for _, pm := range paymentMethods {
+		if !pm.IsExists() {
+			newPaymentMethods = append(newPaymentMethods, pm)
+		} else {
+			if err := r.update(ctx, pm); err != nil {
+				return err
+			}
+		}
+	}
+	if len(newPaymentMethods) > 0 {
+		if err := r.create(ctx, newPaymentMethods); err != nil {
+			return err
+		}
+	}
+
+	for _, pm := range paymentMethods {
+		pm.Clean()
+	}
+
+	return nil
+}
+
+func (r PaymentMethodRepository) create(ctx context.Context, paymentMethods []paymentmethod.PaymentMethod) error {
+	if len(paymentMethods) == 0 {
+		return nil
+	}
+
+	stmt := PaymentMethods.INSERT(
+		PaymentMethods.ID,
+		PaymentMethods.OwnerType,
+		PaymentMethods.OwnerFamilyID,
+		PaymentMethods.OwnerUserID,
+		PaymentMethods.Type,
+		PaymentMethods.Label,
+		PaymentMethods.LastFour,
+		PaymentMethods.ExpiryMonth,
+		PaymentMethods.ExpiryYear,
+		PaymentMethods.CreatedAt,
+		PaymentMethods.UpdatedAt,
+		PaymentMethods.Etag,
+	)
+
+	for _, pm := range paymentMethods {
+		ownerFamilyID, ownerUserID := paymentMethodOwnerValues(pm)
+		lastFour, expiryMonth, expiryYear := paymentMethodDetailValues(pm)
+
+		stmt = stmt.VALUES(
+			UUID(pm.Id()),
+			String(pm.Owner().Type().String()),
+			ownerFamilyID,
+			ownerUserID,
+			String(pm.Type().String()),
+			String(pm.Label()),
+			lastFour,
+			expiryMonth,
+			expiryYear,
+			TimestampzT(pm.CreatedAt()),
+			TimestampzT(pm.UpdatedAt()),
+			String(pm.ETag()),
+		)
+	}
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count != int64(len(paymentMethods)) {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func (r PaymentMethodRepository) update(ctx context.Context, pm paymentmethod.PaymentMethod) error {
+	if !pm.IsDirty() {
+		return nil
+	}
+
+	ownerFamilyID, ownerUserID := paymentMethodOwnerValues(pm)
+	lastFour, expiryMonth, expiryYear := paymentMethodDetailValues(pm)
+
+	stmt := PaymentMethods.UPDATE().
+		SET(
+			PaymentMethods.OwnerType.SET(String(pm.Owner().Type().String())),
+			PaymentMethods.OwnerFamilyID.SET(ownerFamilyID),
+			PaymentMethods.OwnerUserID.SET(ownerUserID),
+			PaymentMethods.Type.SET(String(pm.Type().String())),
+			PaymentMethods.Label.SET(String(pm.Label())),
+			PaymentMethods.LastFour.SET(lastFour),
+			PaymentMethods.ExpiryMonth.SET(expiryMonth),
+			PaymentMethods.ExpiryYear.SET(expiryYear),
+			PaymentMethods.UpdatedAt.SET(TimestampzT(pm.UpdatedAt())),
+			PaymentMethods.Etag.SET(String(pm.ETag())),
+		).
+		WHERE(PaymentMethods.ID.EQ(UUID(pm.Id())))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func paymentMethodOwnerValues(pm paymentmethod.PaymentMethod) (StringExpression, StringExpression) {
+	switch pm.Owner().Type() {
+	case types.PersonalOwnerType:
+		return StringExp(NULL), String(pm.Owner().UserId().String())
+	case types.FamilyOwnerType:
+		return StringExp(UUID(pm.Owner().FamilyId())), StringExp(NULL)
+	default:
+		return StringExp(NULL), StringExp(NULL)
+	}
+}
+
+func paymentMethodDetailValues(pm paymentmethod.PaymentMethod) (
+	StringExpression,
+	IntegerExpression,
+	IntegerExpression) {
+	lastFour := StringExp(NULL)
+	if pm.LastFour() != nil {
+		lastFour = String(*pm.LastFour())
+	}
+
+	expiryMonth := IntExp(NULL)
+	if pm.ExpiryMonth() != nil {
+		expiryMonth = Int32(*pm.ExpiryMonth())
+	}
+
+	expiryYear := IntExp(NULL)
+	if pm.ExpiryYear() != nil {
+		expiryYear = Int32(*pm.ExpiryYear())
+	}
+
+	return lastFour, expiryMonth, expiryYear
+}
+
+func (r PaymentMethodRepository) Delete(ctx context.Context, id types.PaymentMethodID) (bool, error) {
+	stmt := PaymentMethods.DELETE().
+		WHERE(PaymentMethods.ID.EQ(UUID(id)))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r PaymentMethodRepository) Exists(ctx context.Context, ids ...types.PaymentMethodID) (bool, error) {
+	if len(ids) == 0 {
+		return true, nil
+	}
+
+	vals := make([]Expression, len(ids))
+	for i, id := range ids {
+		vals[i] = UUID(id)
+	}
+
+	stmt := SELECT(COUNT(PaymentMethods.ID).AS("count")).
+		FROM(PaymentMethods).
+		WHERE(PaymentMethods.ID.IN(vals...))
+
+	var row struct {
+		Count int
+	}
+
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return false, err
+	}
+
+	return row.Count == len(ids), nil
+}
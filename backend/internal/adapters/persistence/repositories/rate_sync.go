@@ -0,0 +1,206 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	. "github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+type RateSyncRepository struct {
+	dbContext *db.Context
+}
+
+func NewRateSyncRepository(dbContext *db.Context) ports.RateSyncRepository {
+	return &RateSyncRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r RateSyncRepository) GetById(ctx context.Context, id types.RateSyncID) (currency.RateSync, error) {
+	stmt := SELECT(CurrencyRateSyncs.AllColumns).
+		FROM(CurrencyRateSyncs).
+		WHERE(CurrencyRateSyncs.ID.EQ(UUID(id))).
+		LIMIT(1)
+
+	var rows []model.CurrencyRateSyncs
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return models.CreateRateSyncFromModel(rows[0]), nil
+}
+
+func (r RateSyncRepository) GetLatest(ctx context.Context) (currency.RateSync, error) {
+	stmt := SELECT(CurrencyRateSyncs.AllColumns).
+		FROM(CurrencyRateSyncs).
+		ORDER_BY(CurrencyRateSyncs.CreatedAt.DESC(), CurrencyRateSyncs.ID.DESC()).
+		LIMIT(1)
+
+	var rows []model.CurrencyRateSyncs
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return models.CreateRateSyncFromModel(rows[0]), nil
+}
+
+func (r RateSyncRepository) Save(ctx context.Context, syncs ...currency.RateSync) error {
+	for _, sync := range syncs {
+		var err error
+		if sync.IsExists() {
+			err = r.update(ctx, sync)
+		} else {
+			err = r.create(ctx, sync)
+		}
+		if err != nil {
+			return err
+		}
+		sync.Clean()
+	}
+
+	return nil
+}
+
+func (r RateSyncRepository) create(ctx context.Context, sync currency.RateSync) error {
+	stmt := CurrencyRateSyncs.INSERT(
+		CurrencyRateSyncs.ID,
+		CurrencyRateSyncs.Status,
+		CurrencyRateSyncs.StartDate,
+		CurrencyRateSyncs.EndDate,
+		CurrencyRateSyncs.CursorDate,
+		CurrencyRateSyncs.ProcessedDays,
+		CurrencyRateSyncs.FilledDays,
+		CurrencyRateSyncs.UnavailableDays,
+		CurrencyRateSyncs.LastError,
+		CurrencyRateSyncs.FinishedAt,
+		CurrencyRateSyncs.CreatedAt,
+		CurrencyRateSyncs.UpdatedAt,
+		CurrencyRateSyncs.Etag,
+	).VALUES(
+		UUID(sync.Id()),
+		String(sync.Status().String()),
+		DateT(sync.StartDate()),
+		DateT(sync.EndDate()),
+		rateSyncCursorValue(sync),
+		Int32(int32(sync.ProcessedDays())),
+		Int32(int32(sync.FilledDays())),
+		Int32(int32(sync.UnavailableDays())),
+		rateSyncLastErrorValue(sync),
+		rateSyncFinishedAtValue(sync),
+		TimestampzT(sync.CreatedAt()),
+		TimestampzT(sync.UpdatedAt()),
+		String(sync.ETag()),
+	)
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count != 1 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func (r RateSyncRepository) update(ctx context.Context, sync currency.RateSync) error {
+	if !sync.IsDirty() {
+		return nil
+	}
+
+	stmt := CurrencyRateSyncs.UPDATE().
+		SET(
+			CurrencyRateSyncs.Status.SET(String(sync.Status().String())),
+			CurrencyRateSyncs.StartDate.SET(DateT(sync.StartDate())),
+			CurrencyRateSyncs.EndDate.SET(DateT(sync.EndDate())),
+			CurrencyRateSyncs.CursorDate.SET(rateSyncCursorValue(sync)),
+			CurrencyRateSyncs.ProcessedDays.SET(Int32(int32(sync.ProcessedDays()))),
+			CurrencyRateSyncs.FilledDays.SET(Int32(int32(sync.FilledDays()))),
+			CurrencyRateSyncs.UnavailableDays.SET(Int32(int32(sync.UnavailableDays()))),
+			CurrencyRateSyncs.LastError.SET(rateSyncLastErrorValue(sync)),
+			CurrencyRateSyncs.FinishedAt.SET(rateSyncFinishedAtValue(sync)),
+			CurrencyRateSyncs.UpdatedAt.SET(TimestampzT(sync.UpdatedAt())),
+			CurrencyRateSyncs.Etag.SET(String(sync.ETag())),
+		).
+		WHERE(CurrencyRateSyncs.ID.EQ(UUID(sync.Id())))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func rateSyncCursorValue(sync currency.RateSync) DateExpression {
+	if sync.Cursor() == nil {
+		return DateExp(NULL)
+	}
+	return DateT(*sync.Cursor())
+}
+
+func rateSyncLastErrorValue(sync currency.RateSync) StringExpression {
+	if sync.LastError() == nil {
+		return StringExp(NULL)
+	}
+	return String(*sync.LastError())
+}
+
+func rateSyncFinishedAtValue(sync currency.RateSync) TimestampzExpression {
+	if sync.FinishedAt() == nil {
+		return TimestampzExp(NULL)
+	}
+	return TimestampzT(*sync.FinishedAt())
+}
+
+func (r RateSyncRepository) Delete(ctx context.Context, id types.RateSyncID) (bool, error) {
+	stmt := CurrencyRateSyncs.DELETE().
+		WHERE(CurrencyRateSyncs.ID.EQ(UUID(id)))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r RateSyncRepository) Exists(ctx context.Context, ids ...types.RateSyncID) (bool, error) {
+	if len(ids) == 0 {
+		return true, nil
+	}
+
+	vals := make([]Expression, len(ids))
+	for i, id := range ids {
+		vals[i] = UUID(id)
+	}
+
+	stmt := SELECT(COUNT(CurrencyRateSyncs.ID).AS("count")).
+		FROM(CurrencyRateSyncs).
+		WHERE(CurrencyRateSyncs.ID.IN(vals...))
+
+	var row struct {
+		Count int
+	}
+
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return false, err
+	}
+
+	return row.Count == len(ids), nil
+}
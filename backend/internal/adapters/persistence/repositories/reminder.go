@@ -0,0 +1,196 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	. "github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+// reminderClaimTimeout is how long a pending reminder stays claimed, the instance that claimed it stopped before
+// sending it or releasing its claim after that
+const reminderClaimTimeout = 15 * time.Minute
+
+type ReminderRepository struct {
+	dbContext *db.Context
+}
+
+func NewReminderRepository(dbContext *db.Context) ports.ReminderRepository {
+	return &ReminderRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r ReminderRepository) GetById(ctx context.Context, id types.ReminderID) (notification.Reminder, error) {
+	stmt := SELECT(NotificationReminders.AllColumns).
+		FROM(NotificationReminders).
+		WHERE(NotificationReminders.ID.EQ(UUID(id))).
+		LIMIT(1)
+
+	var rows []model.NotificationReminders
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return models.CreateReminderFromModel(rows[0]), nil
+}
+
+func (r ReminderRepository) Claim(ctx context.Context, reminder notification.Reminder) (bool, error) {
+	// The unique constraint on the event decides which instance claims the reminder, a stale pending claim is
+	// taken over with the id of the new one so that the instance that left it can no longer mark nor release it
+	stmt := r.insert(reminder).
+		ON_CONFLICT().ON_CONSTRAINT("uq_notification_reminders_event").
+		DO_UPDATE(SET(
+			NotificationReminders.ID.SET(NotificationReminders.EXCLUDED.ID),
+			NotificationReminders.CreatedAt.SET(NotificationReminders.EXCLUDED.CreatedAt),
+			NotificationReminders.UpdatedAt.SET(NotificationReminders.EXCLUDED.UpdatedAt),
+			NotificationReminders.Etag.SET(NotificationReminders.EXCLUDED.Etag),
+		).WHERE(
+			NotificationReminders.Status.EQ(String(notification.PendingReminderStatus.String())).
+				AND(NotificationReminders.UpdatedAt.LT(TimestampzT(time.Now().Add(-reminderClaimTimeout)))),
+		))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+	if count == 0 {
+		return false, nil
+	}
+
+	reminder.Clean()
+	return true, nil
+}
+
+func (r ReminderRepository) Save(ctx context.Context, reminders ...notification.Reminder) error {
+	for _, reminder := range reminders {
+		var err error
+		if reminder.IsExists() {
+			err = r.update(ctx, reminder)
+		} else {
+			err = r.create(ctx, reminder)
+		}
+		if err != nil {
+			return err
+		}
+		reminder.Clean()
+	}
+
+	return nil
+}
+
+func (r ReminderRepository) insert(reminder notification.Reminder) InsertStatement {
+	return NotificationReminders.INSERT(
+		NotificationReminders.ID,
+		NotificationReminders.UserID,
+		NotificationReminders.SubscriptionID,
+		NotificationReminders.Kind,
+		NotificationReminders.EventDate,
+		NotificationReminders.Status,
+		NotificationReminders.SentAt,
+		NotificationReminders.CreatedAt,
+		NotificationReminders.UpdatedAt,
+		NotificationReminders.Etag,
+	).VALUES(
+		UUID(reminder.Id()),
+		String(reminder.UserId().String()),
+		UUID(reminder.SubscriptionId()),
+		String(reminder.Kind().String()),
+		DateT(reminder.EventDate()),
+		String(reminder.Status().String()),
+		reminderSentAtValue(reminder),
+		TimestampzT(reminder.CreatedAt()),
+		TimestampzT(reminder.UpdatedAt()),
+		String(reminder.ETag()),
+	)
+}
+
+func (r ReminderRepository) create(ctx context.Context, reminder notification.Reminder) error {
+	count, err := r.dbContext.Execute(ctx, r.insert(reminder))
+	if err != nil {
+		return err
+	}
+	if count != 1 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func (r ReminderRepository) update(ctx context.Context, reminder notification.Reminder) error {
+	if !reminder.IsDirty() {
+		return nil
+	}
+
+	stmt := NotificationReminders.UPDATE().
+		SET(
+			NotificationReminders.Status.SET(String(reminder.Status().String())),
+			NotificationReminders.SentAt.SET(reminderSentAtValue(reminder)),
+			NotificationReminders.UpdatedAt.SET(TimestampzT(reminder.UpdatedAt())),
+			NotificationReminders.Etag.SET(String(reminder.ETag())),
+		).
+		WHERE(NotificationReminders.ID.EQ(UUID(reminder.Id())))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func reminderSentAtValue(reminder notification.Reminder) TimestampzExpression {
+	if reminder.SentAt() == nil {
+		return TimestampzExp(NULL)
+	}
+	return TimestampzT(*reminder.SentAt())
+}
+
+func (r ReminderRepository) Delete(ctx context.Context, id types.ReminderID) (bool, error) {
+	stmt := NotificationReminders.DELETE().
+		WHERE(NotificationReminders.ID.EQ(UUID(id)))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r ReminderRepository) Exists(ctx context.Context, ids ...types.ReminderID) (bool, error) {
+	if len(ids) == 0 {
+		return true, nil
+	}
+
+	vals := make([]Expression, len(ids))
+	for i, id := range ids {
+		vals[i] = UUID(id)
+	}
+
+	stmt := SELECT(COUNT(NotificationReminders.ID).AS("count")).
+		FROM(NotificationReminders).
+		WHERE(NotificationReminders.ID.IN(vals...))
+
+	var row struct {
+		Count int
+	}
+
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return false, err
+	}
+
+	return row.Count == len(ids), nil
+}
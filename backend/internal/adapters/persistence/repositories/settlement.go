@@ -0,0 +1,204 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	. "github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+type SettlementRepository struct {
+	dbContext *db.Context
+}
+
+func NewSettlementRepository(dbContext *db.Context) ports.SettlementRepository {
+	return &SettlementRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r SettlementRepository) GetById(ctx context.Context, settlementId types.SettlementID) (
+	ledger.Settlement,
+	error) {
+	stmt := SELECT(FamilySettlements.AllColumns).
+		FROM(FamilySettlements).
+		WHERE(FamilySettlements.ID.EQ(UUID(settlementId)))
+
+	var row model.FamilySettlements
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return nil, err
+	}
+
+	if row.ID == uuid.Nil {
+		return nil, nil
+	}
+
+	return models.CreateSettlementFromModel(row), nil
+}
+
+func (r SettlementRepository) GetByFamilyId(ctx context.Context, familyId types.FamilyID) (
+	[]ledger.Settlement,
+	error) {
+	stmt := SELECT(FamilySettlements.AllColumns).
+		FROM(FamilySettlements).
+		WHERE(FamilySettlements.FamilyID.EQ(UUID(familyId))).
+		ORDER_BY(FamilySettlements.SettledAt.ASC())
+
+	var rows []model.FamilySettlements
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+
+	return herd.Select(rows, models.CreateSettlementFromModel), nil
+}
+
+func (r SettlementRepository) Save(ctx context.Context, settlements ...ledger.Settlement) error {
+	var newSettlements []ledger.Settlement
+	for _, s := range settlements {
+		if !s.IsExists() {
+			newSettlements = append(newSettlements, s)
+		} else {
+			if err := r.update(ctx, s); err != nil {
+				return err
+			}
+		}
+	}
+	if len(newSettlements) > 0 {
+		if err := r.create(ctx, newSettlements); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range settlements {
+		s.Clean()
+	}
+
+	return nil
+}
+
+func (r SettlementRepository) create(ctx context.Context, settlements []ledger.Settlement) error {
+	if len(settlements) == 0 {
+		return nil
+	}
+
+	stmt := FamilySettlements.INSERT(
+		FamilySettlements.ID,
+		FamilySettlements.FamilyID,
+		FamilySettlements.FromMemberID,
+		FamilySettlements.ToMemberID,
+		FamilySettlements.Amount,
+		FamilySettlements.Currency,
+		FamilySettlements.SettledAt,
+		FamilySettlements.Note,
+		FamilySettlements.CreatedAt,
+		FamilySettlements.UpdatedAt,
+		FamilySettlements.Etag,
+	)
+
+	for _, s := range settlements {
+		stmt = stmt.VALUES(
+			UUID(s.Id()),
+			UUID(s.FamilyId()),
+			UUID(s.From()),
+			UUID(s.To()),
+			Decimal(s.Amount().Decimal().String()),
+			String(s.Amount().Currency().String()),
+			TimestampzT(s.SettledAt()),
+			settlementNoteValue(s),
+			TimestampzT(s.CreatedAt()),
+			TimestampzT(s.UpdatedAt()),
+			String(s.ETag()),
+		)
+	}
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count != int64(len(settlements)) {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func (r SettlementRepository) update(ctx context.Context, s ledger.Settlement) error {
+	if !s.IsDirty() {
+		return nil
+	}
+
+	stmt := FamilySettlements.UPDATE().
+		SET(
+			FamilySettlements.FromMemberID.SET(UUID(s.From())),
+			FamilySettlements.ToMemberID.SET(UUID(s.To())),
+			FamilySettlements.Amount.SET(Decimal(s.Amount().Decimal().String())),
+			FamilySettlements.Currency.SET(String(s.Amount().Currency().String())),
+			FamilySettlements.SettledAt.SET(TimestampzT(s.SettledAt())),
+			FamilySettlements.Note.SET(settlementNoteValue(s)),
+			FamilySettlements.UpdatedAt.SET(TimestampzT(s.UpdatedAt())),
+			FamilySettlements.Etag.SET(String(s.ETag())),
+		).
+		WHERE(FamilySettlements.ID.EQ(UUID(s.Id())))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func settlementNoteValue(s ledger.Settlement) StringExpression {
+	if s.Note() == nil {
+		return StringExp(NULL)
+	}
+	return String(*s.Note())
+}
+
+func (r SettlementRepository) Delete(ctx context.Context, id types.SettlementID) (bool, error) {
+	stmt := FamilySettlements.DELETE().
+		WHERE(FamilySettlements.ID.EQ(UUID(id)))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r SettlementRepository) Exists(ctx context.Context, ids ...types.SettlementID) (bool, error) {
+	if len(ids) == 0 {
+		return true, nil
+	}
+
+	vals := make([]Expression, len(ids))
+	for i, id := range ids {
+		vals[i] = UUID(id)
+	}
+
+	stmt := SELECT(COUNT(FamilySettlements.ID).AS("count")).
+		FROM(FamilySettlements).
+		WHERE(FamilySettlements.ID.IN(vals...))
+
+	var row struct {
+		Count int
+	}
+
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return false, err
+	}
+
+	return row.Count == len(ids), nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"iter"
+	"time"
 
 	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
 	. "github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
 	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
 	"github.com/mistribe/subtracker/internal/domain/subscription"
@@ -21,11 +23,28 @@ const batchSize = 10
 
 type SubscriptionRepository struct {
 	dbContext *db.Context
+	outbox    ports.OutboxRepository
 }
 
-func NewSubscriptionRepository(repository *db.Context) ports.SubscriptionRepository {
+// subscriptionIsActive mirrors Subscription.IsActive: the subscription started, did not end and is not paused
+func subscriptionIsActive() BoolExpression {
+	return NOW().GT_EQ(Subscriptions.StartDate).
+		AND(Subscriptions.EndDate.IS_NULL().OR(NOW().LT_EQ(Subscriptions.EndDate))).
+		AND(NOT(EXISTS(
+			SELECT(Int(1)).
+				FROM(SubscriptionPauses).
+				WHERE(SubscriptionPauses.SubscriptionID.EQ(Subscriptions.ID).
+					AND(SubscriptionPauses.StartDate.LT_EQ(NOW())).
+					AND(SubscriptionPauses.EndDate.IS_NULL().OR(NOW().LT(SubscriptionPauses.EndDate)))),
+		)))
+}
+
+func NewSubscriptionRepository(
+	repository *db.Context,
+	outbox ports.OutboxRepository) ports.SubscriptionRepository {
 	return &SubscriptionRepository{
 		dbContext: repository,
+		outbox:    outbox,
 	}
 }
 
@@ -34,16 +53,11 @@ func (r SubscriptionRepository) GetById(ctx context.Context, id types.Subscripti
 	error) {
 	stmt := SELECT(
 		Subscriptions.AllColumns,
-		SubscriptionFamilyUsers.FamilyMemberID,
-		SubscriptionFamilyUsers.SubscriptionID,
 	).
-		FROM(
-			Subscriptions.
-				LEFT_JOIN(SubscriptionFamilyUsers, SubscriptionFamilyUsers.SubscriptionID.EQ(Subscriptions.ID)),
-		).
+		FROM(Subscriptions).
 		WHERE(Subscriptions.ID.EQ(UUID(id)))
 
-	var rows []models.SubscriptionRow
+	var rows []model.Subscriptions
 
 	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
 		return nil, err
@@ -53,9 +67,9 @@ func (r SubscriptionRepository) GetById(ctx context.Context, id types.Subscripti
 		return nil, nil
 	}
 
-	subscriptions := models.CreateSubscriptionFromJetRows(rows)
-	if len(subscriptions) == 0 {
-		return nil, nil
+	subscriptions, err := r.withChildren(ctx, rows)
+	if err != nil {
+		return nil, err
 	}
 
 	return subscriptions[0], nil
@@ -67,13 +81,8 @@ func (r SubscriptionRepository) GetByIdForUser(ctx context.Context, userId types
 
 	stmt := SELECT(
 		Subscriptions.AllColumns,
-		SubscriptionFamilyUsers.FamilyMemberID,
-		SubscriptionFamilyUsers.SubscriptionID,
 	).
-		FROM(
-			Subscriptions.
-				LEFT_JOIN(SubscriptionFamilyUsers, SubscriptionFamilyUsers.SubscriptionID.EQ(Subscriptions.ID)),
-		).
+		FROM(Subscriptions).
 		WHERE(
 			Subscriptions.ID.EQ(UUID(id)).
 				AND(
@@ -92,7 +101,7 @@ func (r SubscriptionRepository) GetByIdForUser(ctx context.Context, userId types
 				),
 		)
 
-	var rows []models.SubscriptionRow
+	var rows []model.Subscriptions
 
 	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
 		return nil, err
@@ -102,9 +111,9 @@ func (r SubscriptionRepository) GetByIdForUser(ctx context.Context, userId types
 		return nil, nil
 	}
 
-	subscriptions := models.CreateSubscriptionFromJetRows(rows)
-	if len(subscriptions) == 0 {
-		return nil, nil
+	subscriptions, err := r.withChildren(ctx, rows)
+	if err != nil {
+		return nil, err
 	}
 
 	return subscriptions[0], nil
@@ -114,30 +123,14 @@ func (r SubscriptionRepository) GetAll(
 	ctx context.Context,
 	parameters ports.SubscriptionQueryParameters) ([]subscription.Subscription, int64, error) {
 
-	pagedSubs := SELECT(
+	stmt := SELECT(
 		Subscriptions.AllColumns,
 		COUNT(STAR).OVER().AS("subscription_row_with_count.total_count"),
 	).
 		FROM(Subscriptions).
 		ORDER_BY(Subscriptions.ID).
 		LIMIT(parameters.Limit).
-		OFFSET(parameters.Offset).
-		AsTable("s")
-
-	stmt := SELECT(
-		pagedSubs.AllColumns(),
-		SubscriptionFamilyUsers.AllColumns,
-		SubscriptionLabels.AllColumns,
-		ProviderLabels.AllColumns,
-	).
-		FROM(
-			pagedSubs.
-				LEFT_JOIN(SubscriptionFamilyUsers,
-					SubscriptionFamilyUsers.SubscriptionID.EQ(Subscriptions.ID.From(pagedSubs))).
-				LEFT_JOIN(SubscriptionLabels, SubscriptionLabels.SubscriptionID.EQ(Subscriptions.ID.From(pagedSubs))).
-				LEFT_JOIN(Providers, Providers.ID.EQ(Subscriptions.ProviderID.From(pagedSubs))).
-				LEFT_JOIN(ProviderLabels, ProviderLabels.ProviderID.EQ(Providers.ID)),
-		)
+		OFFSET(parameters.Offset)
 
 	var rows []models.SubscriptionRowWithCount
 
@@ -149,13 +142,14 @@ func (r SubscriptionRepository) GetAll(
 		return nil, 0, nil
 	}
 
-	totalCount := rows[0].TotalCount
-	subscriptions := models.CreateSubscriptionFromJetRowsWithCount(rows)
-	if len(subscriptions) == 0 {
-		return nil, 0, nil
+	subscriptions, err := r.withChildren(ctx, herd.Select(rows, func(row models.SubscriptionRowWithCount) model.Subscriptions {
+		return row.Subscriptions
+	}))
+	if err != nil {
+		return nil, 0, err
 	}
 
-	return subscriptions, totalCount, nil
+	return subscriptions, rows[0].TotalCount, nil
 }
 
 func (r SubscriptionRepository) GetAllForUser(
@@ -232,29 +226,37 @@ func (r SubscriptionRepository) GetAllForUser(
 		searchFilter = searchFilter.AND(Subscriptions.Recurrency.IN(recurrencyVals...))
 	}
 
+	// Add cancellation deadline filter if provided
+	if parameters.CancellationDeadlineFrom != nil || parameters.CancellationDeadlineTo != nil {
+		searchFilter = searchFilter.AND(Subscriptions.CancellationDeadline.IS_NOT_NULL())
+		if parameters.CancellationDeadlineFrom != nil {
+			searchFilter = searchFilter.AND(
+				Subscriptions.CancellationDeadline.GT_EQ(TimestampzT(*parameters.CancellationDeadlineFrom)),
+			)
+		}
+		if parameters.CancellationDeadlineTo != nil {
+			searchFilter = searchFilter.AND(
+				Subscriptions.CancellationDeadline.LT_EQ(TimestampzT(*parameters.CancellationDeadlineTo)),
+			)
+		}
+	}
+
 	// Add filter on active only
 	if !parameters.WithInactive {
-		searchFilter = searchFilter.AND(
-			NOW().GT_EQ(Subscriptions.StartDate).
-				AND(Subscriptions.EndDate.IS_NULL().
-					OR(NOW().LT_EQ(Subscriptions.EndDate))),
-		)
+		searchFilter = searchFilter.AND(subscriptionIsActive())
 	}
 
 	// Build matches CTE with is_active calculation
 	matches := SELECT(
 		Subscriptions.AllColumns,
 		providers.AllColumns(),
-		CAST(
-			NOW().GT_EQ(Subscriptions.StartDate).
-				AND(Subscriptions.EndDate.IS_NULL().OR(NOW().LT_EQ(Subscriptions.EndDate))),
-		).AS_BOOL().AS("is_active"),
+		CAST(subscriptionIsActive()).AS_BOOL().AS("is_active"),
 	).FROM(
 		Subscriptions.LEFT_JOIN(providers, Providers.ID.From(providers).EQ(Subscriptions.ProviderID)),
 	).WHERE(searchFilter).AsTable("matches")
 
-	// Build counted CTE with pagination
-	counted := SELECT(
+	// Paginate the matches, the child collections are loaded once the page is known
+	stmt := SELECT(
 		matches.AllColumns(),
 		COUNT(STAR).OVER().AS("subscription_row_with_count.total_count"),
 	).FROM(matches).
@@ -262,23 +264,7 @@ func (r SubscriptionRepository) GetAllForUser(
 			LOWER(Providers.Name.From(matches)).ASC(),
 			Subscriptions.ID.From(matches).ASC()).
 		LIMIT(parameters.Limit).
-		OFFSET(parameters.Offset).
-		AsTable("paged")
-
-	// Final query with service users
-	stmt := SELECT(
-		counted.AllColumns(),
-		SubscriptionFamilyUsers.AllColumns,
-		SubscriptionLabels.AllColumns,
-		ProviderLabels.AllColumns,
-	).FROM(
-		counted.
-			LEFT_JOIN(SubscriptionFamilyUsers,
-				SubscriptionFamilyUsers.SubscriptionID.EQ(Subscriptions.ID.From(counted))).
-			LEFT_JOIN(SubscriptionLabels, SubscriptionLabels.SubscriptionID.EQ(Subscriptions.ID.From(counted))).
-			LEFT_JOIN(Providers, Providers.ID.EQ(Subscriptions.ProviderID.From(counted))).
-			LEFT_JOIN(ProviderLabels, ProviderLabels.ProviderID.EQ(Providers.ID)),
-	)
+		OFFSET(parameters.Offset)
 
 	var rows []models.SubscriptionRowWithCount
 
@@ -290,16 +276,114 @@ func (r SubscriptionRepository) GetAllForUser(
 		return nil, 0, nil
 	}
 
-	totalCount := rows[0].TotalCount
-	subscriptions := models.CreateSubscriptionFromJetRowsWithCount(rows)
-	if len(subscriptions) == 0 {
-		return nil, 0, nil
+	subscriptions, err := r.withChildren(ctx, herd.Select(rows, func(row models.SubscriptionRowWithCount) model.Subscriptions {
+		return row.Subscriptions
+	}))
+	if err != nil {
+		return nil, 0, err
 	}
 
-	return subscriptions, totalCount, nil
+	return subscriptions, rows[0].TotalCount, nil
 
 }
 
+func (r SubscriptionRepository) GetAllWithStaleCancellationDeadline(
+	ctx context.Context,
+	now time.Time,
+	limit, offset int64) ([]subscription.Subscription, error) {
+	stmt := SELECT(Subscriptions.AllColumns).
+		FROM(Subscriptions).
+		WHERE(Subscriptions.ContractAutoRenew.IS_TRUE().
+			AND(Subscriptions.EndDate.IS_NULL()).
+			AND(Subscriptions.CancellationDeadline.IS_NULL().
+				OR(Subscriptions.CancellationDeadline.LT(TimestampzT(now))))).
+		ORDER_BY(Subscriptions.ID).
+		LIMIT(limit).
+		OFFSET(offset)
+
+	var rows []model.Subscriptions
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return r.withChildren(ctx, rows)
+}
+
+// withChildren loads the child collections of the subscriptions with a query per collection and returns the
+// domain subscriptions
+func (r SubscriptionRepository) withChildren(
+	ctx context.Context,
+	jetSubscriptions []model.Subscriptions) ([]subscription.Subscription, error) {
+	subscriptionIds := make([]Expression, len(jetSubscriptions))
+	providerIds := make([]Expression, len(jetSubscriptions))
+	for i, jetSubscription := range jetSubscriptions {
+		subscriptionIds[i] = UUID(jetSubscription.ID)
+		providerIds[i] = UUID(jetSubscription.ProviderID)
+	}
+
+	var children models.SubscriptionChildren
+	queries := []struct {
+		stmt SelectStatement
+		dest any
+	}{
+		{
+			stmt: SELECT(SubscriptionFamilyUsers.AllColumns).
+				FROM(SubscriptionFamilyUsers).
+				WHERE(SubscriptionFamilyUsers.SubscriptionID.IN(subscriptionIds...)),
+			dest: &children.FamilyUsers,
+		},
+		{
+			stmt: SELECT(SubscriptionLabels.AllColumns).
+				FROM(SubscriptionLabels).
+				WHERE(SubscriptionLabels.SubscriptionID.IN(subscriptionIds...)),
+			dest: &children.Labels,
+		},
+		{
+			stmt: SELECT(ProviderLabels.AllColumns).
+				FROM(ProviderLabels).
+				WHERE(ProviderLabels.ProviderID.IN(providerIds...)),
+			dest: &children.ProviderLabels,
+		},
+		{
+			stmt: SELECT(SubscriptionPriceHistory.AllColumns).
+				FROM(SubscriptionPriceHistory).
+				WHERE(SubscriptionPriceHistory.SubscriptionID.IN(subscriptionIds...)).
+				ORDER_BY(SubscriptionPriceHistory.EffectiveFrom),
+			dest: &children.PriceHistory,
+		},
+		{
+			stmt: SELECT(SubscriptionPauses.AllColumns).
+				FROM(SubscriptionPauses).
+				WHERE(SubscriptionPauses.SubscriptionID.IN(subscriptionIds...)).
+				ORDER_BY(SubscriptionPauses.StartDate),
+			dest: &children.Pauses,
+		},
+		{
+			stmt: SELECT(SubscriptionPromotions.AllColumns).
+				FROM(SubscriptionPromotions).
+				WHERE(SubscriptionPromotions.SubscriptionID.IN(subscriptionIds...)).
+				ORDER_BY(SubscriptionPromotions.StartDate),
+			dest: &children.Promotions,
+		},
+		{
+			stmt: SELECT(SubscriptionCostShares.AllColumns).
+				FROM(SubscriptionCostShares).
+				WHERE(SubscriptionCostShares.SubscriptionID.IN(subscriptionIds...)),
+			dest: &children.CostShares,
+		},
+	}
+	for _, query := range queries {
+		if err := r.dbContext.Query(ctx, query.stmt, query.dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return models.CreateSubscriptionsFromJet(jetSubscriptions, children), nil
+}
+
 func (r SubscriptionRepository) GetAllIt(
 	ctx context.Context,
 	userId types.UserID, searchText string) iter.Seq[subscription.Subscription] {
@@ -333,21 +417,29 @@ func (r SubscriptionRepository) GetAllIt(
 }
 
 func (r SubscriptionRepository) Save(ctx context.Context, subscriptions ...subscription.Subscription) error {
-	var newSubscriptions []subscription.Subscription
-	for _, sub := range subscriptions {
-		if !sub.IsExists() {
-			newSubscriptions = append(newSubscriptions, sub)
-		} else {
-			if err := r.update(ctx, sub); err != nil {
-				return err
+	// The recorded events are stored with the subscriptions, they are published once the transaction commits
+	err := r.dbContext.WithTransaction(ctx, func(ctx context.Context) error {
+		var newSubscriptions []subscription.Subscription
+		for _, sub := range subscriptions {
+			if !sub.IsExists() {
+				newSubscriptions = append(newSubscriptions, sub)
+			} else {
+				if err := r.update(ctx, sub); err != nil {
+					return err
+				}
 			}
 		}
-	}
 
-	if len(newSubscriptions) > 0 {
-		if err := r.create(ctx, newSubscriptions); err != nil {
-			return err
+		if len(newSubscriptions) > 0 {
+			if err := r.create(ctx, newSubscriptions); err != nil {
+				return err
+			}
 		}
+
+		return r.outbox.Add(ctx, recordedEvents(subscriptions)...)
+	})
+	if err != nil {
+		return err
 	}
 
 	for _, sub := range subscriptions {
@@ -416,7 +508,15 @@ func (r SubscriptionRepository) create(ctx context.Context, subscriptions []subs
 		Subscriptions.StartDate,
 		Subscriptions.EndDate,
 		Subscriptions.Recurrency,
-		Subscriptions.CustomRecurrency,
+		Subscriptions.CustomRecurrencyCount,
+		Subscriptions.CustomRecurrencyUnit,
+		Subscriptions.BillingAnchorDay,
+		Subscriptions.ContractMinimumTerm,
+		Subscriptions.ContractAutoRenew,
+		Subscriptions.ContractNoticePeriod,
+		Subscriptions.CostSplitType,
+		Subscriptions.PaymentMethodID,
+		Subscriptions.CancellationDeadline,
 		Subscriptions.CreatedAt,
 		Subscriptions.UpdatedAt,
 		Subscriptions.Etag,
@@ -442,7 +542,7 @@ func (r SubscriptionRepository) create(ctx context.Context, subscriptions []subs
 		var customPriceCurrencyVal, customPriceAmountVal Expression
 		if sub.Price() != nil {
 			customPriceCurrencyVal = String(sub.Price().Amount().Currency().String())
-			customPriceAmountVal = Float(sub.Price().Amount().Value())
+			customPriceAmountVal = Decimal(sub.Price().Amount().Decimal().String())
 		} else {
 			customPriceCurrencyVal = NULL
 			customPriceAmountVal = NULL
@@ -481,12 +581,8 @@ func (r SubscriptionRepository) create(ctx context.Context, subscriptions []subs
 			endDateVal = NULL
 		}
 
-		var customRecurrencyVal Expression
-		if sub.CustomRecurrency() != nil {
-			customRecurrencyVal = Int32(*sub.CustomRecurrency())
-		} else {
-			customRecurrencyVal = NULL
-		}
+		customRecurrencyCountVal, customRecurrencyUnitVal := subscriptionCustomRecurrencyValues(sub)
+		contractMinimumTermVal, contractAutoRenewVal, contractNoticePeriodVal := subscriptionContractValues(sub)
 
 		stmt = stmt.VALUES(
 			UUID(sub.Id()),
@@ -504,7 +600,15 @@ func (r SubscriptionRepository) create(ctx context.Context, subscriptions []subs
 			TimestampzT(sub.StartDate()),
 			endDateVal,
 			String(sub.Recurrency().String()),
-			customRecurrencyVal,
+			customRecurrencyCountVal,
+			customRecurrencyUnitVal,
+			subscriptionBillingAnchorDayValue(sub),
+			contractMinimumTermVal,
+			contractAutoRenewVal,
+			contractNoticePeriodVal,
+			subscriptionCostSplitTypeValue(sub),
+			subscriptionPaymentMethodIdValue(sub),
+			subscriptionCancellationDeadlineValue(sub),
 			TimestampzT(sub.CreatedAt()),
 			TimestampzT(sub.UpdatedAt()),
 			String(sub.ETag()),
@@ -555,6 +659,149 @@ func (r SubscriptionRepository) create(ctx context.Context, subscriptions []subs
 		}
 	}
 
+	// Insert subscription labels, labels inherited from the provider are stored on the provider
+	hasLabels := false
+	labelStmt := SubscriptionLabels.INSERT(
+		SubscriptionLabels.SubscriptionID,
+		SubscriptionLabels.LabelID,
+	)
+	for _, sub := range subscriptions {
+		for labelRef := range sub.Labels().It() {
+			if labelRef.Source != subscription.LabelSourceSubscription {
+				continue
+			}
+			hasLabels = true
+			labelStmt = labelStmt.VALUES(
+				UUID(sub.Id()),
+				UUID(labelRef.LabelId),
+			)
+		}
+	}
+
+	if hasLabels {
+		if _, err := r.dbContext.Execute(ctx, labelStmt); err != nil {
+			return err
+		}
+	}
+
+	// Insert price history
+	hasPriceHistory := false
+	priceHistoryStmt := SubscriptionPriceHistory.INSERT(
+		SubscriptionPriceHistory.SubscriptionID,
+		SubscriptionPriceHistory.EffectiveFrom,
+		SubscriptionPriceHistory.Amount,
+		SubscriptionPriceHistory.Currency,
+		SubscriptionPriceHistory.CreatedAt,
+	)
+	for _, sub := range subscriptions {
+		for change := range sub.PriceHistory().It() {
+			hasPriceHistory = true
+			priceHistoryStmt = priceHistoryStmt.VALUES(
+				UUID(sub.Id()),
+				TimestampzT(change.EffectiveFrom),
+				Decimal(change.Amount.Decimal().String()),
+				String(change.Amount.Currency().String()),
+				TimestampzT(sub.UpdatedAt()),
+			)
+		}
+	}
+
+	if hasPriceHistory {
+		if _, err := r.dbContext.Execute(ctx, priceHistoryStmt); err != nil {
+			return err
+		}
+	}
+
+	// Insert pauses
+	hasPauses := false
+	pauseStmt := SubscriptionPauses.INSERT(
+		SubscriptionPauses.SubscriptionID,
+		SubscriptionPauses.StartDate,
+		SubscriptionPauses.EndDate,
+		SubscriptionPauses.CreatedAt,
+	)
+	for _, sub := range subscriptions {
+		for pause := range sub.Pauses().It() {
+			hasPauses = true
+			pauseStmt = pauseStmt.VALUES(
+				UUID(sub.Id()),
+				TimestampzT(pause.StartDate),
+				pauseEndDateValue(pause),
+				TimestampzT(sub.UpdatedAt()),
+			)
+		}
+	}
+
+	if hasPauses {
+		if _, err := r.dbContext.Execute(ctx, pauseStmt); err != nil {
+			return err
+		}
+	}
+
+	// Insert promotions
+	hasPromotions := false
+	promotionStmt := SubscriptionPromotions.INSERT(
+		SubscriptionPromotions.SubscriptionID,
+		SubscriptionPromotions.StartDate,
+		SubscriptionPromotions.EndDate,
+		SubscriptionPromotions.Amount,
+		SubscriptionPromotions.Currency,
+		SubscriptionPromotions.CreatedAt,
+	)
+	for _, sub := range subscriptions {
+		for promotion := range sub.Promotions().It() {
+			hasPromotions = true
+			promotionStmt = promotionStmt.VALUES(
+				UUID(sub.Id()),
+				TimestampzT(promotion.StartDate),
+				TimestampzT(promotion.EndDate),
+				Decimal(promotion.Amount.Decimal().String()),
+				String(promotion.Amount.Currency().String()),
+				TimestampzT(sub.UpdatedAt()),
+			)
+		}
+	}
+
+	if hasPromotions {
+		if _, err := r.dbContext.Execute(ctx, promotionStmt); err != nil {
+			return err
+		}
+	}
+
+	// Insert cost shares
+	hasCostShares := false
+	costShareStmt := SubscriptionCostShares.INSERT(
+		SubscriptionCostShares.SubscriptionID,
+		SubscriptionCostShares.FamilyMemberID,
+		SubscriptionCostShares.Value,
+	)
+	for _, sub := range subscriptions {
+		if sub.CostSplit() == nil {
+			continue
+		}
+		for _, share := range sub.CostSplit().Shares() {
+			hasCostShares = true
+			costShareStmt = costShareStmt.VALUES(
+				UUID(sub.Id()),
+				UUID(share.MemberId),
+				Decimal(share.Value.String()),
+			)
+		}
+	}
+
+	if hasCostShares {
+		if _, err := r.dbContext.Execute(ctx, costShareStmt); err != nil {
+			return err
+		}
+	}
+
+	for _, sub := range subscriptions {
+		sub.Labels().ClearChanges()
+		sub.PriceHistory().ClearChanges()
+		sub.Pauses().ClearChanges()
+		sub.Promotions().ClearChanges()
+	}
+
 	return nil
 }
 
@@ -581,7 +828,7 @@ func (r SubscriptionRepository) update(ctx context.Context, sub subscription.Sub
 		var customPriceAmountVal FloatExpression
 		if sub.Price() != nil {
 			customPriceCurrencyVal = String(sub.Price().Amount().Currency().String())
-			customPriceAmountVal = Float(sub.Price().Amount().Value())
+			customPriceAmountVal = Decimal(sub.Price().Amount().Decimal().String())
 		} else {
 			customPriceCurrencyVal = StringExp(NULL)
 			customPriceAmountVal = FloatExp(NULL)
@@ -620,12 +867,8 @@ func (r SubscriptionRepository) update(ctx context.Context, sub subscription.Sub
 			endDateVal = TimestampzExp(NULL)
 		}
 
-		var customRecurrencyVal IntegerExpression
-		if sub.CustomRecurrency() != nil {
-			customRecurrencyVal = Int32(*sub.CustomRecurrency())
-		} else {
-			customRecurrencyVal = IntExp(NULL)
-		}
+		customRecurrencyCountVal, customRecurrencyUnitVal := subscriptionCustomRecurrencyValues(sub)
+		contractMinimumTermVal, contractAutoRenewVal, contractNoticePeriodVal := subscriptionContractValues(sub)
 
 		stmt := Subscriptions.UPDATE().
 			SET(
@@ -643,7 +886,14 @@ func (r SubscriptionRepository) update(ctx context.Context, sub subscription.Sub
 				Subscriptions.StartDate.SET(TimestampzT(sub.StartDate())),
 				Subscriptions.EndDate.SET(endDateVal),
 				Subscriptions.Recurrency.SET(String(sub.Recurrency().String())),
-				Subscriptions.CustomRecurrency.SET(customRecurrencyVal),
+				Subscriptions.CustomRecurrencyCount.SET(customRecurrencyCountVal),
+				Subscriptions.CustomRecurrencyUnit.SET(customRecurrencyUnitVal),
+				Subscriptions.BillingAnchorDay.SET(subscriptionBillingAnchorDayValue(sub)),
+				Subscriptions.ContractMinimumTerm.SET(contractMinimumTermVal),
+				Subscriptions.ContractAutoRenew.SET(contractAutoRenewVal),
+				Subscriptions.ContractNoticePeriod.SET(contractNoticePeriodVal),
+				Subscriptions.CostSplitType.SET(subscriptionCostSplitTypeValue(sub)),
+				Subscriptions.PaymentMethodID.SET(subscriptionPaymentMethodIdValue(sub)),
 				Subscriptions.UpdatedAt.SET(TimestampzT(sub.UpdatedAt())),
 				Subscriptions.Etag.SET(String(sub.ETag())),
 			).
@@ -656,6 +906,10 @@ func (r SubscriptionRepository) update(ctx context.Context, sub subscription.Sub
 		if count == 0 {
 			return db.ErrMissMatchAffectRow
 		}
+
+		if err := r.replaceCostSharesWithJet(ctx, sub); err != nil {
+			return err
+		}
 	}
 
 	// Handle tracked changes for service users (additions & deletions, no updates required).
@@ -663,8 +917,376 @@ func (r SubscriptionRepository) update(ctx context.Context, sub subscription.Sub
 		return err
 	}
 
+	if err := r.saveTrackedLabelsWithJet(ctx, sub.Id(), sub.Labels()); err != nil {
+		return err
+	}
+
+	if err := r.saveTrackedPriceHistoryWithJet(ctx, sub); err != nil {
+		return err
+	}
+
+	if err := r.saveTrackedPausesWithJet(ctx, sub); err != nil {
+		return err
+	}
+
+	if err := r.saveTrackedPromotionsWithJet(ctx, sub); err != nil {
+		return err
+	}
+
+	if err := r.saveCancellationDeadline(ctx, sub); err != nil {
+		return err
+	}
+
 	// Clear change tracking on successful persistence.
 	sub.FamilyUsers().ClearChanges()
+	sub.Labels().ClearChanges()
+	sub.PriceHistory().ClearChanges()
+	sub.Pauses().ClearChanges()
+	sub.Promotions().ClearChanges()
+	return nil
+}
+
+// saveCancellationDeadline stores the cancellation deadline of the subscription, it moves with the pauses and the
+// current date so it is written on every save and not only when the subscription is dirty
+func (r SubscriptionRepository) saveCancellationDeadline(ctx context.Context, sub subscription.Subscription) error {
+	deadline := subscriptionCancellationDeadlineValue(sub)
+	stmt := Subscriptions.UPDATE(Subscriptions.CancellationDeadline).
+		SET(deadline).
+		WHERE(Subscriptions.ID.EQ(UUID(sub.Id())).
+			AND(Subscriptions.CancellationDeadline.IS_DISTINCT_FROM(deadline)))
+
+	_, err := r.dbContext.Execute(ctx, stmt)
+	return err
+}
+
+func (r SubscriptionRepository) saveTrackedPromotionsWithJet(
+	ctx context.Context, sub subscription.Subscription) error {
+	promotions := sub.Promotions()
+
+	// Handle new promotions
+	newPromotions := promotions.Added()
+	if len(newPromotions) > 0 {
+		stmt := SubscriptionPromotions.INSERT(
+			SubscriptionPromotions.SubscriptionID,
+			SubscriptionPromotions.StartDate,
+			SubscriptionPromotions.EndDate,
+			SubscriptionPromotions.Amount,
+			SubscriptionPromotions.Currency,
+			SubscriptionPromotions.CreatedAt,
+		)
+
+		for _, promotion := range newPromotions {
+			stmt = stmt.VALUES(
+				UUID(sub.Id()),
+				TimestampzT(promotion.StartDate),
+				TimestampzT(promotion.EndDate),
+				Decimal(promotion.Amount.Decimal().String()),
+				String(promotion.Amount.Currency().String()),
+				TimestampzT(sub.UpdatedAt()),
+			)
+		}
+
+		if _, err := r.dbContext.Execute(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	// Handle updated promotions
+	for promotion := range promotions.UpdatedIt() {
+		stmt := SubscriptionPromotions.UPDATE().
+			SET(
+				SubscriptionPromotions.EndDate.SET(TimestampzT(promotion.EndDate)),
+				SubscriptionPromotions.Amount.SET(Decimal(promotion.Amount.Decimal().String())),
+				SubscriptionPromotions.Currency.SET(String(promotion.Amount.Currency().String())),
+			).
+			WHERE(
+				SubscriptionPromotions.SubscriptionID.EQ(UUID(sub.Id())).
+					AND(SubscriptionPromotions.StartDate.EQ(TimestampzT(promotion.StartDate))),
+			)
+
+		if _, err := r.dbContext.Execute(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	// Handle removed promotions
+	for promotion := range promotions.RemovedIt() {
+		stmt := SubscriptionPromotions.DELETE().
+			WHERE(
+				SubscriptionPromotions.SubscriptionID.EQ(UUID(sub.Id())).
+					AND(SubscriptionPromotions.StartDate.EQ(TimestampzT(promotion.StartDate))),
+			)
+
+		if _, err := r.dbContext.Execute(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r SubscriptionRepository) saveTrackedPausesWithJet(
+	ctx context.Context, sub subscription.Subscription) error {
+	pauses := sub.Pauses()
+
+	// Handle new pauses
+	newPauses := pauses.Added()
+	if len(newPauses) > 0 {
+		stmt := SubscriptionPauses.INSERT(
+			SubscriptionPauses.SubscriptionID,
+			SubscriptionPauses.StartDate,
+			SubscriptionPauses.EndDate,
+			SubscriptionPauses.CreatedAt,
+		)
+
+		for _, pause := range newPauses {
+			stmt = stmt.VALUES(
+				UUID(sub.Id()),
+				TimestampzT(pause.StartDate),
+				pauseEndDateValue(pause),
+				TimestampzT(sub.UpdatedAt()),
+			)
+		}
+
+		if _, err := r.dbContext.Execute(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	// Handle resumed pauses
+	for pause := range pauses.UpdatedIt() {
+		stmt := SubscriptionPauses.UPDATE().
+			SET(SubscriptionPauses.EndDate.SET(pauseEndDateValue(pause))).
+			WHERE(
+				SubscriptionPauses.SubscriptionID.EQ(UUID(sub.Id())).
+					AND(SubscriptionPauses.StartDate.EQ(TimestampzT(pause.StartDate))),
+			)
+
+		if _, err := r.dbContext.Execute(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	// Handle cancelled pauses
+	for pause := range pauses.RemovedIt() {
+		stmt := SubscriptionPauses.DELETE().
+			WHERE(
+				SubscriptionPauses.SubscriptionID.EQ(UUID(sub.Id())).
+					AND(SubscriptionPauses.StartDate.EQ(TimestampzT(pause.StartDate))),
+			)
+
+		if _, err := r.dbContext.Execute(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replaceCostSharesWithJet rewrites the shares of the cost split, the split is replaced as a whole
+func (r SubscriptionRepository) replaceCostSharesWithJet(
+	ctx context.Context, sub subscription.Subscription) error {
+	deleteStmt := SubscriptionCostShares.DELETE().
+		WHERE(SubscriptionCostShares.SubscriptionID.EQ(UUID(sub.Id())))
+	if _, err := r.dbContext.Execute(ctx, deleteStmt); err != nil {
+		return err
+	}
+
+	if sub.CostSplit() == nil || len(sub.CostSplit().Shares()) == 0 {
+		return nil
+	}
+
+	stmt := SubscriptionCostShares.INSERT(
+		SubscriptionCostShares.SubscriptionID,
+		SubscriptionCostShares.FamilyMemberID,
+		SubscriptionCostShares.Value,
+	)
+	for _, share := range sub.CostSplit().Shares() {
+		stmt = stmt.VALUES(
+			UUID(sub.Id()),
+			UUID(share.MemberId),
+			Decimal(share.Value.String()),
+		)
+	}
+
+	_, err := r.dbContext.Execute(ctx, stmt)
+	return err
+}
+
+func subscriptionCostSplitTypeValue(sub subscription.Subscription) StringExpression {
+	if sub.CostSplit() == nil {
+		return StringExp(NULL)
+	}
+	return String(sub.CostSplit().Type().String())
+}
+
+func subscriptionPaymentMethodIdValue(sub subscription.Subscription) StringExpression {
+	if sub.PaymentMethodId() == nil {
+		return StringExp(NULL)
+	}
+	return StringExp(UUID(*sub.PaymentMethodId()))
+}
+
+// subscriptionCancellationDeadlineValue is the cancellation deadline of the subscription as of now, the lists
+// filter on the stored value
+func subscriptionCancellationDeadlineValue(sub subscription.Subscription) TimestampzExpression {
+	deadline := sub.CancellationDeadline()
+	if deadline == nil {
+		return TimestampzExp(NULL)
+	}
+	return TimestampzT(*deadline)
+}
+
+func subscriptionCustomRecurrencyValues(sub subscription.Subscription) (IntegerExpression, StringExpression) {
+	customRecurrency := sub.CustomRecurrency()
+	if customRecurrency == nil {
+		return IntExp(NULL), StringExp(NULL)
+	}
+
+	return Int32(customRecurrency.Count), String(customRecurrency.Unit.String())
+}
+
+func subscriptionBillingAnchorDayValue(sub subscription.Subscription) IntegerExpression {
+	if sub.BillingAnchorDay() == nil {
+		return IntExp(NULL)
+	}
+
+	return Int32(*sub.BillingAnchorDay())
+}
+
+func subscriptionContractValues(sub subscription.Subscription) (
+	IntegerExpression,
+	BoolExpression,
+	IntegerExpression) {
+	contract := sub.Contract()
+	if contract == nil {
+		return IntExp(NULL), BoolExp(NULL), IntExp(NULL)
+	}
+
+	minimumTermVal := IntExp(NULL)
+	if contract.MinimumTerm() != nil {
+		minimumTermVal = Int32(*contract.MinimumTerm())
+	}
+	noticePeriodVal := IntExp(NULL)
+	if contract.NoticePeriod() != nil {
+		noticePeriodVal = Int32(*contract.NoticePeriod())
+	}
+
+	return minimumTermVal, Bool(contract.AutoRenew()), noticePeriodVal
+}
+
+func pauseEndDateValue(pause subscription.Pause) TimestampzExpression {
+	if pause.EndDate == nil {
+		return TimestampzExp(NULL)
+	}
+	return TimestampzT(*pause.EndDate)
+}
+
+func (r SubscriptionRepository) saveTrackedPriceHistoryWithJet(
+	ctx context.Context, sub subscription.Subscription) error {
+	priceHistory := sub.PriceHistory()
+
+	// Handle new price changes
+	newChanges := priceHistory.Added()
+	if len(newChanges) > 0 {
+		stmt := SubscriptionPriceHistory.INSERT(
+			SubscriptionPriceHistory.SubscriptionID,
+			SubscriptionPriceHistory.EffectiveFrom,
+			SubscriptionPriceHistory.Amount,
+			SubscriptionPriceHistory.Currency,
+			SubscriptionPriceHistory.CreatedAt,
+		)
+
+		for _, change := range newChanges {
+			stmt = stmt.VALUES(
+				UUID(sub.Id()),
+				TimestampzT(change.EffectiveFrom),
+				Decimal(change.Amount.Decimal().String()),
+				String(change.Amount.Currency().String()),
+				TimestampzT(sub.UpdatedAt()),
+			)
+		}
+
+		if _, err := r.dbContext.Execute(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	// Handle corrected price changes
+	for change := range priceHistory.UpdatedIt() {
+		stmt := SubscriptionPriceHistory.UPDATE().
+			SET(
+				SubscriptionPriceHistory.Amount.SET(Decimal(change.Amount.Decimal().String())),
+				SubscriptionPriceHistory.Currency.SET(String(change.Amount.Currency().String())),
+			).
+			WHERE(
+				SubscriptionPriceHistory.SubscriptionID.EQ(UUID(sub.Id())).
+					AND(SubscriptionPriceHistory.EffectiveFrom.EQ(TimestampzT(change.EffectiveFrom))),
+			)
+
+		if _, err := r.dbContext.Execute(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	// Handle deleted price changes
+	for change := range priceHistory.RemovedIt() {
+		stmt := SubscriptionPriceHistory.DELETE().
+			WHERE(
+				SubscriptionPriceHistory.SubscriptionID.EQ(UUID(sub.Id())).
+					AND(SubscriptionPriceHistory.EffectiveFrom.EQ(TimestampzT(change.EffectiveFrom))),
+			)
+
+		if _, err := r.dbContext.Execute(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r SubscriptionRepository) saveTrackedLabelsWithJet(
+	ctx context.Context, subscriptionId types.SubscriptionID,
+	labels *slicesx.Tracked[subscription.LabelRef]) error {
+	// Handle new labels
+	hasNewLabels := false
+	stmt := SubscriptionLabels.INSERT(
+		SubscriptionLabels.SubscriptionID,
+		SubscriptionLabels.LabelID,
+	)
+	for labelRef := range labels.AddedIt() {
+		if labelRef.Source != subscription.LabelSourceSubscription {
+			continue
+		}
+		hasNewLabels = true
+		stmt = stmt.VALUES(
+			UUID(subscriptionId),
+			UUID(labelRef.LabelId),
+		)
+	}
+
+	if hasNewLabels {
+		if _, err := r.dbContext.Execute(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	// Handle deleted labels
+	for labelRef := range labels.RemovedIt() {
+		if labelRef.Source != subscription.LabelSourceSubscription {
+			continue
+		}
+		deleteStmt := SubscriptionLabels.DELETE().
+			WHERE(
+				SubscriptionLabels.SubscriptionID.EQ(UUID(subscriptionId)).
+					AND(SubscriptionLabels.LabelID.EQ(UUID(labelRef.LabelId))),
+			)
+
+		if _, err := r.dbContext.Execute(ctx, deleteStmt); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
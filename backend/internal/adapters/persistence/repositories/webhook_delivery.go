@@ -0,0 +1,299 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/model"
+	. "github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+type webhookDeliveryRowWithCount struct {
+	WebhookDeliveries model.WebhookDeliveries `json:"webhook_deliveries"`
+	TotalCount        int64                   `json:"total_count"`
+}
+
+type WebhookDeliveryRepository struct {
+	dbContext *db.Context
+}
+
+func NewWebhookDeliveryRepository(dbContext *db.Context) ports.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r WebhookDeliveryRepository) GetById(
+	ctx context.Context,
+	id types.WebhookDeliveryID) (webhook.Delivery, error) {
+	stmt := SELECT(WebhookDeliveries.AllColumns).
+		FROM(WebhookDeliveries).
+		WHERE(WebhookDeliveries.ID.EQ(UUID(id))).
+		LIMIT(1)
+
+	var rows []model.WebhookDeliveries
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return models.CreateWebhookDeliveryFromModel(rows[0]), nil
+}
+
+func (r WebhookDeliveryRepository) GetAllByEndpointId(
+	ctx context.Context,
+	endpointId types.WebhookEndpointID,
+	parameters ports.QueryParameters) ([]webhook.Delivery, int64, error) {
+	stmt := SELECT(
+		WebhookDeliveries.AllColumns,
+		COUNT(STAR).OVER().AS("total_count"),
+	).
+		FROM(WebhookDeliveries).
+		WHERE(WebhookDeliveries.EndpointID.EQ(UUID(endpointId))).
+		ORDER_BY(WebhookDeliveries.CreatedAt.DESC(), WebhookDeliveries.ID.DESC()).
+		LIMIT(parameters.Limit).
+		OFFSET(parameters.Offset)
+
+	var rows []webhookDeliveryRowWithCount
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, 0, err
+	}
+	if len(rows) == 0 {
+		return nil, 0, nil
+	}
+
+	deliveries := herd.Select(rows, func(row webhookDeliveryRowWithCount) webhook.Delivery {
+		return models.CreateWebhookDeliveryFromModel(row.WebhookDeliveries)
+	})
+
+	return deliveries, rows[0].TotalCount, nil
+}
+
+func (r WebhookDeliveryRepository) GetDue(
+	ctx context.Context,
+	now time.Time,
+	limit int64) ([]webhook.Delivery, error) {
+	stmt := SELECT(WebhookDeliveries.AllColumns).
+		FROM(WebhookDeliveries).
+		WHERE(
+			WebhookDeliveries.Status.EQ(String(webhook.PendingDeliveryStatus.String())).
+				AND(WebhookDeliveries.NextAttemptAt.LT_EQ(TimestampzT(now))),
+		).
+		ORDER_BY(WebhookDeliveries.NextAttemptAt.ASC(), WebhookDeliveries.ID.ASC()).
+		LIMIT(limit)
+
+	var rows []model.WebhookDeliveries
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+
+	return herd.Select(rows, models.CreateWebhookDeliveryFromModel), nil
+}
+
+func (r WebhookDeliveryRepository) Lease(
+	ctx context.Context,
+	id types.WebhookDeliveryID,
+	now time.Time,
+	until time.Time) (bool, error) {
+	// Only one instance sees the delivery still due, the others update nothing
+	stmt := WebhookDeliveries.UPDATE().
+		SET(WebhookDeliveries.NextAttemptAt.SET(TimestampzT(until))).
+		WHERE(
+			WebhookDeliveries.ID.EQ(UUID(id)).
+				AND(WebhookDeliveries.Status.EQ(String(webhook.PendingDeliveryStatus.String()))).
+				AND(WebhookDeliveries.NextAttemptAt.LT_EQ(TimestampzT(now))),
+		)
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r WebhookDeliveryRepository) Enqueue(ctx context.Context, delivery webhook.Delivery) (bool, error) {
+	// The unique index on the events of the endpoint skips an event already enqueued
+	stmt := r.insert(delivery).
+		ON_CONFLICT(WebhookDeliveries.EndpointID, WebhookDeliveries.EventID).
+		WHERE(WebhookDeliveries.RedeliveryOf.IS_NULL()).
+		DO_NOTHING()
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+	if count == 0 {
+		return false, nil
+	}
+
+	delivery.Clean()
+	return true, nil
+}
+
+func (r WebhookDeliveryRepository) Save(ctx context.Context, deliveries ...webhook.Delivery) error {
+	for _, delivery := range deliveries {
+		var err error
+		if delivery.IsExists() {
+			err = r.update(ctx, delivery)
+		} else {
+			err = r.create(ctx, delivery)
+		}
+		if err != nil {
+			return err
+		}
+		delivery.Clean()
+	}
+
+	return nil
+}
+
+func (r WebhookDeliveryRepository) insert(delivery webhook.Delivery) InsertStatement {
+	return WebhookDeliveries.INSERT(
+		WebhookDeliveries.ID,
+		WebhookDeliveries.EndpointID,
+		WebhookDeliveries.EventID,
+		WebhookDeliveries.EventType,
+		WebhookDeliveries.Payload,
+		WebhookDeliveries.Status,
+		WebhookDeliveries.Attempts,
+		WebhookDeliveries.NextAttemptAt,
+		WebhookDeliveries.LastStatusCode,
+		WebhookDeliveries.LastError,
+		WebhookDeliveries.DeliveredAt,
+		WebhookDeliveries.RedeliveryOf,
+		WebhookDeliveries.CreatedAt,
+		WebhookDeliveries.UpdatedAt,
+		WebhookDeliveries.Etag,
+	).VALUES(
+		UUID(delivery.Id()),
+		UUID(delivery.EndpointId()),
+		String(delivery.EventId()),
+		String(delivery.EventType().String()),
+		String(string(delivery.Payload())),
+		String(delivery.Status().String()),
+		Int32(int32(delivery.Attempts())),
+		optionalTimestampz(delivery.NextAttemptAt()),
+		webhookDeliveryStatusCodeValue(delivery),
+		webhookDeliveryLastErrorValue(delivery),
+		optionalTimestampz(delivery.DeliveredAt()),
+		webhookDeliveryRedeliveryOfValue(delivery),
+		TimestampzT(delivery.CreatedAt()),
+		TimestampzT(delivery.UpdatedAt()),
+		String(delivery.ETag()),
+	)
+}
+
+func (r WebhookDeliveryRepository) create(ctx context.Context, delivery webhook.Delivery) error {
+	count, err := r.dbContext.Execute(ctx, r.insert(delivery))
+	if err != nil {
+		return err
+	}
+	if count != 1 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func (r WebhookDeliveryRepository) update(ctx context.Context, delivery webhook.Delivery) error {
+	if !delivery.IsDirty() {
+		return nil
+	}
+
+	stmt := WebhookDeliveries.UPDATE().
+		SET(
+			WebhookDeliveries.Status.SET(String(delivery.Status().String())),
+			WebhookDeliveries.Attempts.SET(Int32(int32(delivery.Attempts()))),
+			WebhookDeliveries.NextAttemptAt.SET(optionalTimestampz(delivery.NextAttemptAt())),
+			WebhookDeliveries.LastStatusCode.SET(webhookDeliveryStatusCodeValue(delivery)),
+			WebhookDeliveries.LastError.SET(webhookDeliveryLastErrorValue(delivery)),
+			WebhookDeliveries.DeliveredAt.SET(optionalTimestampz(delivery.DeliveredAt())),
+			WebhookDeliveries.UpdatedAt.SET(TimestampzT(delivery.UpdatedAt())),
+			WebhookDeliveries.Etag.SET(String(delivery.ETag())),
+		).
+		WHERE(WebhookDeliveries.ID.EQ(UUID(delivery.Id())))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrMissMatchAffectRow
+	}
+	return nil
+}
+
+func optionalTimestampz(t *time.Time) TimestampzExpression {
+	if t == nil {
+		return TimestampzExp(NULL)
+	}
+	return TimestampzT(*t)
+}
+
+func webhookDeliveryStatusCodeValue(delivery webhook.Delivery) IntegerExpression {
+	if delivery.LastStatusCode() == nil {
+		return IntExp(NULL)
+	}
+	return Int32(int32(*delivery.LastStatusCode()))
+}
+
+func webhookDeliveryLastErrorValue(delivery webhook.Delivery) StringExpression {
+	if delivery.LastError() == nil {
+		return StringExp(NULL)
+	}
+	return String(*delivery.LastError())
+}
+
+func webhookDeliveryRedeliveryOfValue(delivery webhook.Delivery) Expression {
+	if delivery.RedeliveryOf() == nil {
+		return NULL
+	}
+	return UUID(*delivery.RedeliveryOf())
+}
+
+func (r WebhookDeliveryRepository) Delete(ctx context.Context, id types.WebhookDeliveryID) (bool, error) {
+	stmt := WebhookDeliveries.DELETE().
+		WHERE(WebhookDeliveries.ID.EQ(UUID(id)))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r WebhookDeliveryRepository) Exists(ctx context.Context, ids ...types.WebhookDeliveryID) (bool, error) {
+	if len(ids) == 0 {
+		return true, nil
+	}
+
+	vals := make([]Expression, len(ids))
+	for i, id := range ids {
+		vals[i] = UUID(id)
+	}
+
+	stmt := SELECT(COUNT(WebhookDeliveries.ID).AS("count")).
+		FROM(WebhookDeliveries).
+		WHERE(WebhookDeliveries.ID.IN(vals...))
+
+	var row struct {
+		Count int
+	}
+
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return false, err
+	}
+
+	return row.Count == len(ids), nil
+}
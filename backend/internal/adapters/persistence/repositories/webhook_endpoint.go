@@ -0,0 +1,237 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db"
+	. "github.com/mistribe/subtracker/internal/adapters/persistence/db/jet/app/public/table"
+	"github.com/mistribe/subtracker/internal/adapters/persistence/db/models"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+type WebhookEndpointRepository struct {
+	dbContext *db.Context
+}
+
+func NewWebhookEndpointRepository(dbContext *db.Context) ports.WebhookEndpointRepository {
+	return &WebhookEndpointRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r WebhookEndpointRepository) query(ctx context.Context, condition BoolExpression) ([]webhook.Endpoint, error) {
+	stmt := SELECT(
+		WebhookEndpoints.AllColumns,
+		WebhookEndpointEventTypes.EndpointID,
+		WebhookEndpointEventTypes.EventType,
+	).
+		FROM(
+			WebhookEndpoints.
+				LEFT_JOIN(WebhookEndpointEventTypes, WebhookEndpointEventTypes.EndpointID.EQ(WebhookEndpoints.ID)),
+		).
+		WHERE(condition).
+		ORDER_BY(WebhookEndpoints.CreatedAt.ASC(), WebhookEndpoints.ID.ASC(), WebhookEndpointEventTypes.EventType.ASC())
+
+	var rows []models.WebhookEndpointRow
+	if err := r.dbContext.Query(ctx, stmt, &rows); err != nil {
+		return nil, err
+	}
+
+	return models.CreateWebhookEndpointsFromJetRows(rows), nil
+}
+
+func (r WebhookEndpointRepository) GetById(ctx context.Context, id types.WebhookEndpointID) (webhook.Endpoint, error) {
+	endpoints, err := r.query(ctx, WebhookEndpoints.ID.EQ(UUID(id)))
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) == 0 {
+		return nil, nil
+	}
+
+	return endpoints[0], nil
+}
+
+func (r WebhookEndpointRepository) GetByIdForUser(
+	ctx context.Context,
+	userId types.UserID,
+	id types.WebhookEndpointID) (webhook.Endpoint, error) {
+	endpoints, err := r.query(ctx,
+		WebhookEndpoints.ID.EQ(UUID(id)).AND(WebhookEndpoints.UserID.EQ(String(userId.String()))))
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) == 0 {
+		return nil, nil
+	}
+
+	return endpoints[0], nil
+}
+
+func (r WebhookEndpointRepository) GetAllByUserId(
+	ctx context.Context,
+	userId types.UserID) ([]webhook.Endpoint, error) {
+	return r.query(ctx, WebhookEndpoints.UserID.EQ(String(userId.String())))
+}
+
+func (r WebhookEndpointRepository) GetAllAccepting(
+	ctx context.Context,
+	eventType webhook.EventType) ([]webhook.Endpoint, error) {
+	endpoints, err := r.query(ctx, WebhookEndpoints.Enabled.IS_TRUE())
+	if err != nil {
+		return nil, err
+	}
+
+	var accepting []webhook.Endpoint
+	for _, endpoint := range endpoints {
+		if endpoint.Accepts(eventType) {
+			accepting = append(accepting, endpoint)
+		}
+	}
+
+	return accepting, nil
+}
+
+func (r WebhookEndpointRepository) Save(ctx context.Context, endpoints ...webhook.Endpoint) error {
+	for _, endpoint := range endpoints {
+		var err error
+		if endpoint.IsExists() {
+			err = r.update(ctx, endpoint)
+		} else {
+			err = r.create(ctx, endpoint)
+		}
+		if err != nil {
+			return err
+		}
+		endpoint.Clean()
+	}
+
+	return nil
+}
+
+func (r WebhookEndpointRepository) create(ctx context.Context, endpoint webhook.Endpoint) error {
+	stmt := WebhookEndpoints.INSERT(
+		WebhookEndpoints.ID,
+		WebhookEndpoints.UserID,
+		WebhookEndpoints.URL,
+		WebhookEndpoints.Secret,
+		WebhookEndpoints.Enabled,
+		WebhookEndpoints.CreatedAt,
+		WebhookEndpoints.UpdatedAt,
+		WebhookEndpoints.Etag,
+	).VALUES(
+		UUID(endpoint.Id()),
+		String(endpoint.UserId().String()),
+		String(endpoint.Url()),
+		String(endpoint.Secret()),
+		Bool(endpoint.Enabled()),
+		TimestampzT(endpoint.CreatedAt()),
+		TimestampzT(endpoint.UpdatedAt()),
+		String(endpoint.ETag()),
+	)
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count != 1 {
+		return db.ErrMissMatchAffectRow
+	}
+
+	return r.insertEventTypes(ctx, endpoint)
+}
+
+func (r WebhookEndpointRepository) update(ctx context.Context, endpoint webhook.Endpoint) error {
+	if !endpoint.IsDirty() {
+		return nil
+	}
+
+	stmt := WebhookEndpoints.UPDATE().
+		SET(
+			WebhookEndpoints.URL.SET(String(endpoint.Url())),
+			WebhookEndpoints.Secret.SET(String(endpoint.Secret())),
+			WebhookEndpoints.Enabled.SET(Bool(endpoint.Enabled())),
+			WebhookEndpoints.UpdatedAt.SET(TimestampzT(endpoint.UpdatedAt())),
+			WebhookEndpoints.Etag.SET(String(endpoint.ETag())),
+		).
+		WHERE(WebhookEndpoints.ID.EQ(UUID(endpoint.Id())))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrMissMatchAffectRow
+	}
+
+	// The event types are few, they are replaced as a whole
+	deleteStmt := WebhookEndpointEventTypes.DELETE().
+		WHERE(WebhookEndpointEventTypes.EndpointID.EQ(UUID(endpoint.Id())))
+	if _, err = r.dbContext.Execute(ctx, deleteStmt); err != nil {
+		return err
+	}
+
+	return r.insertEventTypes(ctx, endpoint)
+}
+
+func (r WebhookEndpointRepository) insertEventTypes(ctx context.Context, endpoint webhook.Endpoint) error {
+	if len(endpoint.EventTypes()) == 0 {
+		return nil
+	}
+
+	stmt := WebhookEndpointEventTypes.INSERT(
+		WebhookEndpointEventTypes.EndpointID,
+		WebhookEndpointEventTypes.EventType,
+	)
+	for _, eventType := range endpoint.EventTypes() {
+		stmt = stmt.VALUES(
+			UUID(endpoint.Id()),
+			String(eventType.String()),
+		)
+	}
+	stmt = stmt.ON_CONFLICT(WebhookEndpointEventTypes.EndpointID, WebhookEndpointEventTypes.EventType).DO_NOTHING()
+
+	_, err := r.dbContext.Execute(ctx, stmt)
+	return err
+}
+
+func (r WebhookEndpointRepository) Delete(ctx context.Context, id types.WebhookEndpointID) (bool, error) {
+	stmt := WebhookEndpoints.DELETE().
+		WHERE(WebhookEndpoints.ID.EQ(UUID(id)))
+
+	count, err := r.dbContext.Execute(ctx, stmt)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r WebhookEndpointRepository) Exists(ctx context.Context, ids ...types.WebhookEndpointID) (bool, error) {
+	if len(ids) == 0 {
+		return true, nil
+	}
+
+	vals := make([]Expression, len(ids))
+	for i, id := range ids {
+		vals[i] = UUID(id)
+	}
+
+	stmt := SELECT(COUNT(WebhookEndpoints.ID).AS("count")).
+		FROM(WebhookEndpoints).
+		WHERE(WebhookEndpoints.ID.IN(vals...))
+
+	var row struct {
+		Count int
+	}
+
+	if err := r.dbContext.Query(ctx, stmt, &row); err != nil {
+		return false, err
+	}
+
+	return row.Count == len(ids), nil
+}
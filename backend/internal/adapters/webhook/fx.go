@@ -0,0 +1,13 @@
+package webhook
+
+import (
+	"go.uber.org/fx"
+)
+
+func Module() fx.Option {
+	return fx.Module("webhook",
+		fx.Provide(
+			NewHTTPSender,
+		),
+	)
+}
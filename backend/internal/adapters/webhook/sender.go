@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/Oleexo/config-go"
+
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+const (
+	// TimeoutKey bounds a request to a webhook endpoint, in nanoseconds
+	TimeoutKey     = "WEBHOOK_HTTP_TIMEOUT"
+	DefaultTimeout = 10 * time.Second
+
+	userAgent = "SubTracker-Webhooks/1.0"
+	// maxResponseSize is how much of a response is read, only its status code matters
+	maxResponseSize = 64 * 1024
+)
+
+type httpSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender creates a sender that only connects to public addresses. The address is checked once the host is
+// resolved, so that a name cannot be pointed at a private address after its endpoint was registered.
+func NewHTTPSender(cfg config.Configuration) ports.WebhookSender {
+	timeout := time.Duration(cfg.GetIntOrDefault(TimeoutKey, int64(DefaultTimeout)))
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: publicAddressOnly,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would connect to the endpoint in place of the dialer
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return NewHTTPSenderWithClient(&http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	})
+}
+
+// publicAddressOnly refuses the connections to the addresses that are not public, see webhook.IsPublicAddress
+func publicAddressOnly(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", webhook.ErrNonPublicAddress, address)
+	}
+	if !webhook.IsPublicAddress(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", webhook.ErrNonPublicAddress, addrPort.Addr())
+	}
+	return nil
+}
+
+// NewHTTPSenderWithClient creates a sender using client, the redirects are not followed and count as failures
+func NewHTTPSenderWithClient(client *http.Client) ports.WebhookSender {
+	c := *client
+	c.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	return &httpSender{
+		client: &c,
+	}
+}
+
+func (s *httpSender) Send(ctx context.Context, request ports.WebhookRequest) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.Url, bytes.NewReader(request.Body))
+	if err != nil {
+		return 0, err
+	}
+	for key, values := range request.Headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Reading the response lets the connection be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+
+	return resp.StatusCode, nil
+}
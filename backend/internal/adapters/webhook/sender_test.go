@@ -0,0 +1,115 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Oleexo/config-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	adapter "github.com/mistribe/subtracker/internal/adapters/webhook"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+func TestHTTPSender_Send(t *testing.T) {
+	const secret = "whsec_secret"
+	payload := []byte(`{"id":"event-1","type":"label.created","data":{}}`)
+	newRequest := func(url string) ports.WebhookRequest {
+		headers := http.Header{}
+		headers.Set("Content-Type", "application/json")
+		headers.Set(webhook.EventHeader, webhook.LabelCreatedEventType.String())
+		headers.Set(webhook.SignatureHeader, webhook.Sign(secret, time.Now(), payload))
+		return ports.WebhookRequest{Url: url, Headers: headers, Body: payload}
+	}
+
+	t.Run("posts the signed payload", func(t *testing.T) {
+		var received *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		t.Cleanup(server.Close)
+
+		statusCode, err := adapter.NewHTTPSenderWithClient(server.Client()).Send(context.Background(),
+			newRequest(server.URL))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, statusCode)
+		require.NotNil(t, received)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		assert.Equal(t, "label.created", received.Header.Get(webhook.EventHeader))
+		assert.Equal(t, "SubTracker-Webhooks/1.0", received.Header.Get("User-Agent"))
+		assert.Equal(t, payload, body)
+		assert.True(t, webhook.Verify(secret, received.Header.Get(webhook.SignatureHeader), body))
+	})
+
+	t.Run("returns the status code of a failed request", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "boom", http.StatusInternalServerError)
+		}))
+		t.Cleanup(server.Close)
+
+		statusCode, err := adapter.NewHTTPSenderWithClient(server.Client()).Send(context.Background(),
+			newRequest(server.URL))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+
+	t.Run("does not follow redirects", func(t *testing.T) {
+		redirected := false
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			redirected = true
+		}))
+		t.Cleanup(target.Close)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+		}))
+		t.Cleanup(server.Close)
+
+		statusCode, err := adapter.NewHTTPSenderWithClient(server.Client()).Send(context.Background(),
+			newRequest(server.URL))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusTemporaryRedirect, statusCode)
+		assert.False(t, redirected)
+	})
+
+	t.Run("fails when the endpoint does not answer", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		}))
+		t.Cleanup(server.Close)
+		client := server.Client()
+		client.Timeout = 50 * time.Millisecond
+
+		_, err := adapter.NewHTTPSenderWithClient(client).Send(context.Background(), newRequest(server.URL))
+		assert.Error(t, err)
+	})
+
+	t.Run("does not connect to private addresses", func(t *testing.T) {
+		called := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		t.Cleanup(server.Close)
+		sender := adapter.NewHTTPSender(config.NewConfiguration())
+
+		_, err := sender.Send(context.Background(), newRequest(server.URL))
+		assert.ErrorIs(t, err, webhook.ErrNonPublicAddress)
+
+		// A name is checked once resolved
+		_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+		require.NoError(t, err)
+		_, err = sender.Send(context.Background(), newRequest("http://localhost:"+port))
+		assert.ErrorIs(t, err, webhook.ErrNonPublicAddress)
+		assert.False(t, called)
+	})
+}
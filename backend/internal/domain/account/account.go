@@ -22,6 +22,9 @@ type Account interface {
 
 	Currency() option.Option[currency.Unit]
 	SetCurrency(newCurrency currency.Unit)
+	// Timezone is the IANA timezone subscription dates are evaluated in, nil when not configured
+	Timezone() *time.Location
+	SetTimezone(timezone *time.Location)
 	FamilyID() *types.FamilyID
 }
 
@@ -29,6 +32,7 @@ type account struct {
 	*entity.Base[string]
 
 	currency *currency.Unit
+	timezone *time.Location
 	planID   types.PlanID
 	familyID *types.FamilyID
 	role     types.Role
@@ -47,6 +51,15 @@ func (a *account) SetCurrency(newCurrency currency.Unit) {
 	a.SetAsDirty()
 }
 
+func (a *account) Timezone() *time.Location {
+	return a.timezone
+}
+
+func (a *account) SetTimezone(timezone *time.Location) {
+	a.timezone = timezone
+	a.SetAsDirty()
+}
+
 func (a *account) PlanID() types.PlanID {
 	return a.planID
 }
@@ -81,6 +94,10 @@ func (a *account) ETagFields() []interface{} {
 		fields = append(fields, a.FamilyID().String())
 	}
 
+	if a.timezone != nil {
+		fields = append(fields, a.timezone.String())
+	}
+
 	return fields
 }
 func (a *account) ETag() string {
@@ -90,6 +107,7 @@ func (a *account) ETag() string {
 func New(
 	userID types.UserID,
 	currency *currency.Unit,
+	timezone *time.Location,
 	planID types.PlanID,
 	role types.Role,
 	familyID *types.FamilyID,
@@ -98,6 +116,7 @@ func New(
 	return &account{
 		Base:     entity.NewBase[string](userID.String(), createdAt, updatedAt, true, false),
 		currency: currency,
+		timezone: timezone,
 		planID:   planID,
 		familyID: familyID,
 		role:     role,
@@ -0,0 +1,9 @@
+package account
+
+import (
+	ex "github.com/mistribe/subtracker/pkg/x/exception"
+)
+
+var (
+	ErrUnknownTimezone = ex.NewInvalidValue("unknown timezone")
+)
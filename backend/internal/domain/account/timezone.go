@@ -0,0 +1,19 @@
+package account
+
+import (
+	"time"
+	// The api runs from a scratch image without a zoneinfo database
+	_ "time/tzdata"
+)
+
+// ParseTimezone returns the location of an IANA timezone name, e.g. Europe/Paris
+func ParseTimezone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, ErrUnknownTimezone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrUnknownTimezone
+	}
+	return loc, nil
+}
@@ -50,6 +50,7 @@ const (
 	FeatureIdExportCustomProviders
 	FeatureIdFamily
 	FeatureIdFamilyMembersCount
+	FeatureIdBudgets
 )
 
 const (
@@ -68,6 +69,7 @@ const (
 	FeatureIdExportCustomProvidersString    = "export_custom_providers"
 	FeatureIdFamilyString                   = "family"
 	FeatureIdFamilyMembersCountString       = "family_members_count"
+	FeatureIdBudgetsString                  = "budgets"
 )
 
 func FeatureIDToString(feature types.FeatureID) string {
@@ -100,6 +102,8 @@ func FeatureIDToString(feature types.FeatureID) string {
 		return FeatureIdFamilyString
 	case FeatureIdFamilyMembersCount:
 		return FeatureIdFamilyMembersCountString
+	case FeatureIdBudgets:
+		return FeatureIdBudgetsString
 	default:
 		return FeatureIdUnknownString
 	}
@@ -186,6 +190,12 @@ var (
 			Type:        FeatureQuota,
 			Description: "Family members",
 		},
+		FeatureIdBudgets: {
+			ID:          FeatureIdBudgets,
+			Type:        FeatureBoolean,
+			Description: "Budgets",
+			GatedBy:     x.P(FeatureIdSubscriptions),
+		},
 	}
 	Entitlements    map[types.PlanID]map[types.FeatureID]PlanEntitlement
 	AllFeatures     []types.FeatureID
@@ -214,6 +224,8 @@ func init() {
 
 			FeatureIdFamily:             newBoolEntitlement(types.PlanFree, FeatureIdFamily, true),
 			FeatureIdFamilyMembersCount: newQuotaEntitlement(types.PlanFree, FeatureIdFamilyMembersCount, 3),
+
+			FeatureIdBudgets: newBoolEntitlement(types.PlanFree, FeatureIdBudgets, false),
 		},
 		types.PlanPremium: {
 			FeatureIdSubscriptions: newBoolEntitlement(types.PlanPremium, FeatureIdSubscriptions, true),
@@ -234,6 +246,8 @@ func init() {
 
 			FeatureIdFamily:             newBoolEntitlement(types.PlanPremium, FeatureIdFamily, true),
 			FeatureIdFamilyMembersCount: newQuotaEntitlement(types.PlanPremium, FeatureIdFamilyMembersCount, 25),
+
+			FeatureIdBudgets: newBoolEntitlement(types.PlanPremium, FeatureIdBudgets, true),
 		},
 	}
 
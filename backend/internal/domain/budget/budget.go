@@ -0,0 +1,209 @@
+package budget
+
+import (
+	"strings"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x/validation"
+)
+
+// Budget is a spending limit over a period for a label, a family or a personal account
+type Budget interface {
+	entity.Entity[types.BudgetID]
+	entity.ETagEntity
+
+	Owner() types.Owner
+	Name() string
+	Scope() Scope
+	// LabelId is the label whose subscriptions are counted, it is only set for the label scope
+	LabelId() *types.LabelID
+	Period() Period
+	Limit() currency.Amount
+	// Covers returns true if the cost of the subscription counts against the budget
+	Covers(sub subscription.Subscription) bool
+
+	GetValidationErrors() validation.Errors
+	SetName(name string)
+	SetScope(scope Scope, labelId *types.LabelID)
+	SetPeriod(period Period)
+	SetLimit(limit currency.Amount)
+}
+
+type budget struct {
+	*entity.Base[types.BudgetID]
+
+	owner   types.Owner
+	name    string
+	scope   Scope
+	labelId *types.LabelID
+	period  Period
+	limit   currency.Amount
+}
+
+func NewBudget(
+	id types.BudgetID,
+	owner types.Owner,
+	name string,
+	scope Scope,
+	labelId *types.LabelID,
+	period Period,
+	limit currency.Amount,
+	createdAt time.Time,
+	updatedAt time.Time) Budget {
+	return &budget{
+		Base:    entity.NewBase(id, createdAt, updatedAt, true, false),
+		owner:   owner,
+		name:    strings.TrimSpace(name),
+		scope:   scope,
+		labelId: labelId,
+		period:  period,
+		limit:   limit,
+	}
+}
+
+func (b *budget) Owner() types.Owner {
+	return b.owner
+}
+
+func (b *budget) Name() string {
+	return b.name
+}
+
+func (b *budget) Scope() Scope {
+	return b.scope
+}
+
+func (b *budget) LabelId() *types.LabelID {
+	return b.labelId
+}
+
+func (b *budget) Period() Period {
+	return b.period
+}
+
+func (b *budget) Limit() currency.Amount {
+	return b.limit
+}
+
+func (b *budget) Covers(sub subscription.Subscription) bool {
+	switch b.scope {
+	case LabelScope:
+		if b.labelId == nil {
+			return false
+		}
+		for label := range sub.Labels().It() {
+			if label.LabelId == *b.labelId {
+				return true
+			}
+		}
+		return false
+	case FamilyScope:
+		return sub.Owner().Type() == types.FamilyOwnerType &&
+			b.owner.Type() == types.FamilyOwnerType &&
+			sub.Owner().FamilyId() == b.owner.FamilyId()
+	case AccountScope:
+		return sub.Owner().Type() == types.PersonalOwnerType &&
+			b.owner.Type() == types.PersonalOwnerType &&
+			sub.Owner().UserId() == b.owner.UserId()
+	default:
+		return false
+	}
+}
+
+func (b *budget) GetValidationErrors() validation.Errors {
+	var errors validation.Errors
+
+	if b.name == "" {
+		errors = append(errors, validation.NewError("name", "Name is required and cannot be empty"))
+	}
+
+	if len(b.name) > 100 {
+		errors = append(errors, validation.NewError("name", "Name cannot be longer than 100 characters"))
+	}
+
+	switch b.scope {
+	case LabelScope:
+		if b.labelId == nil {
+			errors = append(errors, validation.NewError("label_id", "LabelId is required for a label budget"))
+		}
+	case FamilyScope:
+		if b.owner.Type() != types.FamilyOwnerType {
+			errors = append(errors, validation.NewError("scope", "A family budget must be owned by the family"))
+		}
+	case AccountScope:
+		if b.owner.Type() != types.PersonalOwnerType {
+			errors = append(errors, validation.NewError("scope",
+				"An account budget must be owned by the account"))
+		}
+	default:
+		errors = append(errors, validation.NewError("scope", "Scope is required"))
+	}
+
+	if b.scope != LabelScope && b.labelId != nil {
+		errors = append(errors, validation.NewError("label_id", "LabelId is only allowed for a label budget"))
+	}
+
+	if b.period != MonthlyPeriod && b.period != YearlyPeriod {
+		errors = append(errors, validation.NewError("period", "Period is required"))
+	}
+
+	if b.limit == nil || !b.limit.IsValid() || !b.limit.IsPositive() {
+		errors = append(errors, validation.NewError("limit", "Limit must be greater than zero"))
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+
+	return nil
+}
+
+func (b *budget) SetName(name string) {
+	b.name = strings.TrimSpace(name)
+	b.SetAsDirty()
+}
+
+func (b *budget) SetScope(scope Scope, labelId *types.LabelID) {
+	b.scope = scope
+	b.labelId = labelId
+	b.SetAsDirty()
+}
+
+func (b *budget) SetPeriod(period Period) {
+	if b.period == period {
+		return
+	}
+	b.period = period
+	b.SetAsDirty()
+}
+
+func (b *budget) SetLimit(limit currency.Amount) {
+	b.limit = limit
+	b.SetAsDirty()
+}
+
+func (b *budget) ETagFields() []interface{} {
+	fields := []interface{}{
+		b.owner.ETag(),
+		b.name,
+		b.scope.String(),
+		b.period.String(),
+	}
+
+	if b.labelId != nil {
+		fields = append(fields, b.labelId.String())
+	}
+	if b.limit != nil {
+		fields = append(fields, b.limit.Value(), b.limit.Currency().String())
+	}
+
+	return fields
+}
+
+func (b *budget) ETag() string {
+	return entity.CalculateETag(b)
+}
@@ -0,0 +1,110 @@
+package budget_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/decimal"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func newBudget(owner types.Owner, scope budget.Scope, labelId *types.LabelID, limit float64) budget.Budget {
+	return budget.NewBudget(types.NewBudgetID(), owner, "Streaming", scope, labelId, budget.MonthlyPeriod,
+		currency.NewAmount(limit, currency.EUR), time.Now(), time.Now())
+}
+
+func newSubscription(owner types.Owner, labels ...types.LabelID) subscription.Subscription {
+	var refs []subscription.LabelRef
+	for _, label := range labels {
+		refs = append(refs, subscription.LabelRef{LabelId: label})
+	}
+	return subscription.NewSubscription(types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+		subscription.NewPrice(currency.NewAmount(10, currency.EUR)), nil, nil, owner, nil, nil, nil, nil, refs,
+		time.Now(), nil, nil, subscription.MonthlyRecurrency, nil, nil, time.Now(), time.Now())
+}
+
+func TestBudget_Covers(t *testing.T) {
+	userId := types.UserID("user-1")
+	familyId := types.NewFamilyID()
+	labelId := types.NewLabelID()
+
+	t.Run("label budget covers the subscriptions having the label", func(t *testing.T) {
+		b := newBudget(types.NewPersonalOwner(userId), budget.LabelScope, x.P(labelId), 50)
+
+		assert.True(t, b.Covers(newSubscription(types.NewFamilyOwner(familyId), types.NewLabelID(), labelId)))
+		assert.False(t, b.Covers(newSubscription(types.NewPersonalOwner(userId), types.NewLabelID())))
+	})
+
+	t.Run("family budget covers the subscriptions of the family", func(t *testing.T) {
+		b := newBudget(types.NewFamilyOwner(familyId), budget.FamilyScope, nil, 50)
+
+		assert.True(t, b.Covers(newSubscription(types.NewFamilyOwner(familyId))))
+		assert.False(t, b.Covers(newSubscription(types.NewFamilyOwner(types.NewFamilyID()))))
+		assert.False(t, b.Covers(newSubscription(types.NewPersonalOwner(userId))))
+	})
+
+	t.Run("account budget covers the personal subscriptions of its owner", func(t *testing.T) {
+		b := newBudget(types.NewPersonalOwner(userId), budget.AccountScope, nil, 50)
+
+		assert.True(t, b.Covers(newSubscription(types.NewPersonalOwner(userId))))
+		assert.False(t, b.Covers(newSubscription(types.NewPersonalOwner("user-2"))))
+		assert.False(t, b.Covers(newSubscription(types.NewFamilyOwner(familyId))))
+	})
+}
+
+func TestBudget_GetValidationErrors(t *testing.T) {
+	userId := types.UserID("user-1")
+
+	t.Run("valid budget", func(t *testing.T) {
+		b := newBudget(types.NewPersonalOwner(userId), budget.AccountScope, nil, 50)
+
+		assert.Nil(t, b.GetValidationErrors())
+	})
+
+	t.Run("label budget requires a label", func(t *testing.T) {
+		b := newBudget(types.NewPersonalOwner(userId), budget.LabelScope, nil, 50)
+
+		assert.True(t, b.GetValidationErrors().HasErrors())
+	})
+
+	t.Run("family budget must be owned by a family", func(t *testing.T) {
+		b := newBudget(types.NewPersonalOwner(userId), budget.FamilyScope, nil, 50)
+
+		assert.True(t, b.GetValidationErrors().HasErrors())
+	})
+
+	t.Run("limit must be positive", func(t *testing.T) {
+		b := newBudget(types.NewPersonalOwner(userId), budget.AccountScope, nil, 0)
+
+		assert.True(t, b.GetValidationErrors().HasErrors())
+	})
+}
+
+func TestNewStatus(t *testing.T) {
+	b := newBudget(types.NewPersonalOwner("user-1"), budget.AccountScope, nil, 50)
+
+	t.Run("under the limit", func(t *testing.T) {
+		status := budget.NewStatus(b, decimal.NewFromInt(20))
+
+		assert.Equal(t, 40.0, status.ConsumedPercentage)
+		assert.Equal(t, 0.0, status.ProjectedOverrun.Value())
+		assert.Equal(t, 30.0, status.Remaining.Value())
+		assert.Equal(t, currency.EUR, status.Consumed.Currency())
+		assert.False(t, status.IsExceeded())
+	})
+
+	t.Run("over the limit", func(t *testing.T) {
+		status := budget.NewStatus(b, decimal.NewFromInt(75))
+
+		assert.Equal(t, 150.0, status.ConsumedPercentage)
+		assert.Equal(t, 25.0, status.ProjectedOverrun.Value())
+		assert.Equal(t, 0.0, status.Remaining.Value())
+		assert.True(t, status.IsExceeded())
+	})
+}
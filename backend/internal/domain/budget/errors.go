@@ -0,0 +1,13 @@
+package budget
+
+import (
+	ex "github.com/mistribe/subtracker/pkg/x/exception"
+)
+
+var (
+	ErrBudgetNotFound      = ex.NewNotFound("budget not found")
+	ErrBudgetAlreadyExists = ex.NewAlreadyExists("budget already exists")
+	ErrUnknownBudgetPeriod = ex.NewInvalidValue("unknown budget period")
+	ErrUnknownBudgetScope  = ex.NewInvalidValue("unknown budget scope")
+	ErrBudgetNotAllowed    = ex.NewUnauthorized("budgets are not available for your plan")
+)
@@ -0,0 +1,49 @@
+package budget
+
+import (
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+)
+
+// Period is the length of time a budget limit applies to
+type Period string
+
+const (
+	UnknownPeriod Period = "unknown"
+	MonthlyPeriod Period = "monthly"
+	YearlyPeriod  Period = "yearly"
+)
+
+func (p Period) String() string {
+	return string(p)
+}
+
+// RecurrencyType returns the recurrency the cost of the subscriptions is normalized to
+func (p Period) RecurrencyType() subscription.RecurrencyType {
+	switch p {
+	case MonthlyPeriod:
+		return subscription.MonthlyRecurrency
+	case YearlyPeriod:
+		return subscription.YearlyRecurrency
+	default:
+		return subscription.UnknownRecurrency
+	}
+}
+
+func ParsePeriod(input string) (Period, error) {
+	switch input {
+	case string(MonthlyPeriod):
+		return MonthlyPeriod, nil
+	case string(YearlyPeriod):
+		return YearlyPeriod, nil
+	default:
+		return UnknownPeriod, ErrUnknownBudgetPeriod
+	}
+}
+
+func MustParsePeriod(input string) Period {
+	p, err := ParsePeriod(input)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
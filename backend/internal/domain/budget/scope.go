@@ -0,0 +1,39 @@
+package budget
+
+// Scope defines which subscriptions are counted against a budget
+type Scope string
+
+const (
+	UnknownScope Scope = "unknown"
+	// LabelScope counts the subscriptions having the label of the budget
+	LabelScope Scope = "label"
+	// FamilyScope counts the subscriptions owned by the family of the budget
+	FamilyScope Scope = "family"
+	// AccountScope counts the personal subscriptions of the owner of the budget
+	AccountScope Scope = "account"
+)
+
+func (s Scope) String() string {
+	return string(s)
+}
+
+func ParseScope(input string) (Scope, error) {
+	switch input {
+	case string(LabelScope):
+		return LabelScope, nil
+	case string(FamilyScope):
+		return FamilyScope, nil
+	case string(AccountScope):
+		return AccountScope, nil
+	default:
+		return UnknownScope, ErrUnknownBudgetScope
+	}
+}
+
+func MustParseScope(input string) Scope {
+	s, err := ParseScope(input)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
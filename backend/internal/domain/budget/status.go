@@ -0,0 +1,49 @@
+package budget
+
+import (
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/pkg/decimal"
+)
+
+// Status compares the cost of the subscriptions covered by a budget with its limit
+type Status struct {
+	// Consumed is the cost of the covered subscriptions normalized to the period of the budget
+	Consumed currency.Amount
+	// ConsumedPercentage is the share of the limit consumed, above 100 when the budget is exceeded
+	ConsumedPercentage float64
+	// ProjectedOverrun is how much the covered subscriptions cost above the limit over a period
+	ProjectedOverrun currency.Amount
+	// Remaining is what can still be spent before reaching the limit
+	Remaining currency.Amount
+}
+
+// NewStatus computes the status of a budget given the cost of the subscriptions it covers, expressed in the
+// currency of its limit
+func NewStatus(b Budget, consumed decimal.Decimal) Status {
+	limit := b.Limit()
+	unit := limit.Currency()
+	percentage := 0.0
+	if limit.IsPositive() {
+		percentage = consumed.Mul(decimal.NewFromInt(100)).Div(limit.Decimal()).Float64()
+	}
+
+	overrun := consumed.Sub(limit.Decimal())
+	return Status{
+		Consumed:           currency.NewAmountFromDecimal(consumed, unit),
+		ConsumedPercentage: percentage,
+		ProjectedOverrun:   currency.NewAmountFromDecimal(maxZero(overrun), unit),
+		Remaining:          currency.NewAmountFromDecimal(maxZero(overrun.Neg()), unit),
+	}
+}
+
+func maxZero(value decimal.Decimal) decimal.Decimal {
+	if value.IsNegative() {
+		return decimal.Zero
+	}
+	return value
+}
+
+// IsExceeded returns true when the covered subscriptions cost more than the limit
+func (s Status) IsExceeded() bool {
+	return s.ProjectedOverrun.IsPositive()
+}
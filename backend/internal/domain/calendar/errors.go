@@ -0,0 +1,11 @@
+package calendar
+
+import (
+	ex "github.com/mistribe/subtracker/pkg/x/exception"
+)
+
+var (
+	ErrFeedNotFound      = ex.NewNotFound("calendar feed not found")
+	ErrFeedAlreadyExists = ex.NewAlreadyExists("calendar feed already exists")
+	ErrUnknownEventKind  = ex.NewInvalidValue("unknown calendar event kind")
+)
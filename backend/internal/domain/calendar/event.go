@@ -0,0 +1,137 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+const (
+	// DefaultRenewalCount is the number of upcoming renewals listed for each subscription
+	DefaultRenewalCount = 3
+	// MaxRenewalCount bounds the number of upcoming renewals listed for each subscription
+	MaxRenewalCount = 24
+
+	uidDomain = "subtracker"
+)
+
+type EventKind string
+
+const (
+	RenewalEventKind              EventKind = "renewal"
+	FreeTrialEndEventKind         EventKind = "free_trial_end"
+	CancellationDeadlineEventKind EventKind = "cancellation_deadline"
+)
+
+func (k EventKind) String() string {
+	return string(k)
+}
+
+func ParseEventKind(input string) (EventKind, error) {
+	switch input {
+	case string(RenewalEventKind):
+		return RenewalEventKind, nil
+	case string(FreeTrialEndEventKind):
+		return FreeTrialEndEventKind, nil
+	case string(CancellationDeadlineEventKind):
+		return CancellationDeadlineEventKind, nil
+	default:
+		return "", ErrUnknownEventKind
+	}
+}
+
+func MustParseEventKind(input string) EventKind {
+	k, err := ParseEventKind(input)
+	if err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// Event is an all-day entry of a calendar feed
+type Event struct {
+	// UID is derived from the subscription so calendar applications update the event instead of duplicating it
+	UID            string
+	SubscriptionId types.SubscriptionID
+	Kind           EventKind
+	Date           time.Time
+	// Title names the subscription
+	Title       string
+	Summary     string
+	Description string
+	// Price is what the subscription costs at a renewal, nil for the other kinds
+	Price currency.Amount
+}
+
+// SubscriptionEvents returns the upcoming renewals, free trial end and cancellation deadline of an active
+// subscription, the subscription dates happening in loc. The title names the subscription in the events.
+func SubscriptionEvents(
+	sub subscription.Subscription,
+	title string,
+	renewals int,
+	loc *time.Location) []Event {
+	now := time.Now()
+	// A paused subscription renews once its pause ends
+	if !sub.IsActiveAtIn(now, loc) && !sub.IsPausedAt(now) {
+		return nil
+	}
+
+	var events []Event
+	for _, renewal := range sub.UpcomingRenewalDatesIn(renewals, loc) {
+		description := ""
+		var renewalPrice currency.Amount
+		price := sub.PriceAt(renewal)
+		if price.IsValid() {
+			description = fmt.Sprintf("%s %s", price.Round(currency.DefaultRounding).Decimal(), price.Currency().String())
+			renewalPrice = price
+		}
+		events = append(events, Event{
+			UID:            eventUID(sub.Id(), RenewalEventKind, &renewal),
+			SubscriptionId: sub.Id(),
+			Kind:           RenewalEventKind,
+			Date:           renewal,
+			Title:          title,
+			Summary:        fmt.Sprintf("%s renewal", title),
+			Description:    description,
+			Price:          renewalPrice,
+		})
+	}
+
+	if sub.FreeTrial() != nil {
+		end := sub.FreeTrial().EndDate()
+		if !end.Before(now) {
+			events = append(events, Event{
+				UID:            eventUID(sub.Id(), FreeTrialEndEventKind, nil),
+				SubscriptionId: sub.Id(),
+				Kind:           FreeTrialEndEventKind,
+				Date:           end,
+				Title:          title,
+				Summary:        fmt.Sprintf("%s free trial ends", title),
+			})
+		}
+	}
+
+	if deadline := sub.CancellationDeadlineIn(loc); deadline != nil {
+		events = append(events, Event{
+			UID:            eventUID(sub.Id(), CancellationDeadlineEventKind, nil),
+			SubscriptionId: sub.Id(),
+			Kind:           CancellationDeadlineEventKind,
+			Date:           *deadline,
+			Title:          title,
+			Summary:        fmt.Sprintf("%s cancellation deadline", title),
+			Description:    "Last day to cancel before the subscription renews",
+		})
+	}
+
+	return events
+}
+
+func eventUID(id types.SubscriptionID, kind EventKind, date *time.Time) string {
+	if date == nil {
+		return fmt.Sprintf("%s-%s@%s", id.String(), kind.String(), uidDomain)
+	}
+	return fmt.Sprintf("%s-%s-%s@%s", id.String(), kind.String(), date.Format("20060102"), uidDomain)
+}
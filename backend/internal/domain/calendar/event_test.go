@@ -0,0 +1,128 @@
+package calendar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func newSubscription(
+	start time.Time,
+	endDate *time.Time,
+	freeTrial subscription.FreeTrial,
+	contract subscription.Contract) subscription.Subscription {
+	return subscription.NewSubscription(
+		types.NewSubscriptionID(), nil, freeTrial, contract, types.NewProviderID(),
+		subscription.NewPrice(currency.NewAmount(9.99, currency.EUR)), nil, nil,
+		types.NewPersonalOwner(types.UserID("user-1")), nil, nil, nil, nil, nil,
+		start, endDate, nil, subscription.MonthlyRecurrency, nil, nil, time.Now(), time.Now())
+}
+
+func eventsOfKind(events []calendar.Event, kind calendar.EventKind) []calendar.Event {
+	var filtered []calendar.Event
+	for _, event := range events {
+		if event.Kind == kind {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
+func TestSubscriptionEvents(t *testing.T) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	t.Run("lists the next renewals", func(t *testing.T) {
+		sub := newSubscription(today.AddDate(0, -2, 1), nil, nil, nil)
+
+		events := calendar.SubscriptionEvents(sub, "Netflix", 3, time.UTC)
+
+		var upcoming []time.Time
+		for _, date := range sub.BillingDates(today.AddDate(0, 6, 0)) {
+			if date.After(time.Now()) {
+				upcoming = append(upcoming, date)
+			}
+		}
+		require.Len(t, events, 3)
+		for i, event := range events {
+			assert.Equal(t, calendar.RenewalEventKind, event.Kind)
+			assert.Equal(t, sub.Id(), event.SubscriptionId)
+			assert.Equal(t, "Netflix renewal", event.Summary)
+			assert.Equal(t, "9.99 EUR", event.Description)
+			assert.Equal(t, upcoming[i], event.Date)
+		}
+	})
+
+	t.Run("uids are stable and unique", func(t *testing.T) {
+		sub := newSubscription(today.AddDate(0, -2, 1), nil, nil, nil)
+
+		first := calendar.SubscriptionEvents(sub, "Netflix", 3, time.UTC)
+		second := calendar.SubscriptionEvents(sub, "Netflix", 3, time.UTC)
+
+		uids := make(map[string]bool)
+		for i := range first {
+			assert.Equal(t, first[i].UID, second[i].UID)
+			assert.Contains(t, first[i].UID, sub.Id().String())
+			uids[first[i].UID] = true
+		}
+		assert.Len(t, uids, len(first))
+	})
+
+	t.Run("includes the free trial end", func(t *testing.T) {
+		trialEnd := today.AddDate(0, 0, 10)
+		sub := newSubscription(today.AddDate(0, 0, -4), nil,
+			subscription.NewFreeTrial(today.AddDate(0, 0, -4), trialEnd), nil)
+
+		events := eventsOfKind(calendar.SubscriptionEvents(sub, "Disney+", 1, time.UTC),
+			calendar.FreeTrialEndEventKind)
+
+		require.Len(t, events, 1)
+		assert.Equal(t, trialEnd, events[0].Date)
+		assert.Equal(t, "Disney+ free trial ends", events[0].Summary)
+		assert.Equal(t, sub.Id().String()+"-free_trial_end@subtracker", events[0].UID)
+	})
+
+	t.Run("includes the cancellation deadline", func(t *testing.T) {
+		sub := newSubscription(today.AddDate(0, -3, 20), nil, nil,
+			subscription.NewContract(nil, true, x.P(int32(7))))
+
+		events := eventsOfKind(calendar.SubscriptionEvents(sub, "Gym", 1, time.UTC),
+			calendar.CancellationDeadlineEventKind)
+
+		require.Len(t, events, 1)
+		assert.Equal(t, *sub.CancellationDeadlineIn(time.UTC), events[0].Date)
+	})
+
+	t.Run("ended subscriptions have no events", func(t *testing.T) {
+		sub := newSubscription(today.AddDate(-1, 0, 0), x.P(today.AddDate(0, -1, 0)), nil, nil)
+
+		assert.Empty(t, calendar.SubscriptionEvents(sub, "Netflix", 3, time.UTC))
+	})
+
+	t.Run("renewals stop at the end date", func(t *testing.T) {
+		sub := newSubscription(today.AddDate(0, -1, 1), x.P(today.AddDate(0, 1, 15)), nil, nil)
+
+		assert.Len(t, calendar.SubscriptionEvents(sub, "Netflix", 3, time.UTC), 2)
+	})
+
+	t.Run("renewals happen at local midnight in the account timezone", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+		sub := newSubscription(today.AddDate(0, -2, 1), nil, nil, nil)
+
+		events := calendar.SubscriptionEvents(sub, "Netflix", 2, loc)
+
+		require.NotEmpty(t, events)
+		for _, event := range events {
+			assert.Equal(t, loc, event.Date.Location())
+			assert.Equal(t, 0, event.Date.Hour())
+		}
+	})
+}
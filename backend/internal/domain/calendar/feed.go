@@ -0,0 +1,102 @@
+package calendar
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+const tokenSize = 32
+
+// Feed gives calendar applications access to the renewals of an account through a secret token.
+// Only the hash of the token is kept, the token itself is shown once when it is created or rotated.
+type Feed interface {
+	entity.Entity[types.CalendarFeedID]
+	entity.ETagEntity
+
+	UserId() types.UserID
+	TokenHash() string
+	// Rotate replaces the token of the feed and returns the new one
+	Rotate() (string, error)
+}
+
+type feed struct {
+	*entity.Base[types.CalendarFeedID]
+
+	userId    types.UserID
+	tokenHash string
+}
+
+func NewFeed(
+	id types.CalendarFeedID,
+	userId types.UserID,
+	tokenHash string,
+	createdAt time.Time,
+	updatedAt time.Time) Feed {
+	return &feed{
+		Base:      entity.NewBase(id, createdAt, updatedAt, true, false),
+		userId:    userId,
+		tokenHash: tokenHash,
+	}
+}
+
+// CreateFeed creates the feed of an account and returns it with its token
+func CreateFeed(userId types.UserID) (Feed, string, error) {
+	token, err := newToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	return NewFeed(types.NewCalendarFeedID(), userId, HashToken(token), now, now), token, nil
+}
+
+// HashToken returns the hash stored for a feed token
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func newToken() (string, error) {
+	randomBytes := make([]byte, tokenSize)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(randomBytes), nil
+}
+
+func (f *feed) UserId() types.UserID {
+	return f.userId
+}
+
+func (f *feed) TokenHash() string {
+	return f.tokenHash
+}
+
+func (f *feed) Rotate() (string, error) {
+	token, err := newToken()
+	if err != nil {
+		return "", err
+	}
+
+	f.tokenHash = HashToken(token)
+	f.SetUpdatedAt(time.Now())
+	return token, nil
+}
+
+func (f *feed) ETagFields() []interface{} {
+	return []interface{}{
+		f.userId.String(),
+		f.tokenHash,
+	}
+}
+
+func (f *feed) ETag() string {
+	return entity.CalculateETag(f)
+}
@@ -0,0 +1,45 @@
+package calendar_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func TestFeed(t *testing.T) {
+	t.Run("only the hash of the token is kept", func(t *testing.T) {
+		feed, token, err := calendar.CreateFeed(types.UserID("user-1"))
+		require.NoError(t, err)
+
+		assert.NotEmpty(t, token)
+		assert.NotEqual(t, token, feed.TokenHash())
+		assert.Equal(t, calendar.HashToken(token), feed.TokenHash())
+		assert.Equal(t, types.UserID("user-1"), feed.UserId())
+	})
+
+	t.Run("tokens can be used in a URL", func(t *testing.T) {
+		_, token, err := calendar.CreateFeed(types.UserID("user-1"))
+		require.NoError(t, err)
+
+		assert.Regexp(t, `^[A-Za-z0-9_-]{43}$`, token)
+	})
+
+	t.Run("rotating replaces the token", func(t *testing.T) {
+		feed, token, err := calendar.CreateFeed(types.UserID("user-1"))
+		require.NoError(t, err)
+		feed.Clean()
+		etag := feed.ETag()
+
+		rotated, err := feed.Rotate()
+		require.NoError(t, err)
+
+		assert.NotEqual(t, token, rotated)
+		assert.Equal(t, calendar.HashToken(rotated), feed.TokenHash())
+		assert.NotEqual(t, etag, feed.ETag())
+		assert.True(t, feed.IsDirty())
+	})
+}
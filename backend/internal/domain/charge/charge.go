@@ -0,0 +1,196 @@
+package charge
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x/validation"
+)
+
+// Charge is a single billing occurrence of a subscription
+type Charge interface {
+	entity.Entity[types.ChargeID]
+	entity.ETagEntity
+
+	// SubscriptionId is the subscription billed by this charge
+	SubscriptionId() types.SubscriptionID
+	// DueDate is the date of the billing occurrence
+	DueDate() time.Time
+	// ExpectedAmount is the price of the subscription in effect at the due date
+	ExpectedAmount() currency.Amount
+	// ActualAmount is the amount really charged, nil until the charge is recorded
+	ActualAmount() currency.Amount
+	Status() Status
+
+	// Amount returns the actual amount when recorded, the expected amount otherwise
+	Amount() currency.Amount
+	// Difference returns how much the actual amount deviates from the expected amount
+	Difference() currency.Amount
+	// IsRecorded returns true if an actual amount has been recorded
+	IsRecorded() bool
+
+	// Record sets the outcome of the charge. When actual is nil, paid charges
+	// default to the expected amount, failed and refunded charges to zero.
+	Record(status Status, actual currency.Amount) error
+	SetExpectedAmount(amount currency.Amount)
+
+	GetValidationErrors() validation.Errors
+}
+
+type charge struct {
+	*entity.Base[types.ChargeID]
+
+	subscriptionId types.SubscriptionID
+	dueDate        time.Time
+	expectedAmount currency.Amount
+	actualAmount   currency.Amount
+	status         Status
+}
+
+func NewCharge(
+	id types.ChargeID,
+	subscriptionId types.SubscriptionID,
+	dueDate time.Time,
+	expectedAmount currency.Amount,
+	actualAmount currency.Amount,
+	status Status,
+	createdAt time.Time,
+	updatedAt time.Time) Charge {
+	return &charge{
+		Base:           entity.NewBase(id, createdAt, updatedAt, true, false),
+		subscriptionId: subscriptionId,
+		dueDate:        dueDate,
+		expectedAmount: expectedAmount,
+		actualAmount:   actualAmount,
+		status:         status,
+	}
+}
+
+func (c *charge) SubscriptionId() types.SubscriptionID {
+	return c.subscriptionId
+}
+
+func (c *charge) DueDate() time.Time {
+	return c.dueDate
+}
+
+func (c *charge) ExpectedAmount() currency.Amount {
+	return c.expectedAmount
+}
+
+func (c *charge) ActualAmount() currency.Amount {
+	return c.actualAmount
+}
+
+func (c *charge) Status() Status {
+	return c.status
+}
+
+func (c *charge) Amount() currency.Amount {
+	if c.actualAmount != nil {
+		return c.actualAmount
+	}
+	return c.expectedAmount
+}
+
+func (c *charge) Difference() currency.Amount {
+	if c.actualAmount == nil {
+		return currency.NewAmount(0, c.expectedAmount.Currency())
+	}
+	difference := c.actualAmount.Decimal().Sub(c.expectedAmount.Decimal())
+	return currency.NewAmountFromDecimal(difference, c.expectedAmount.Currency())
+}
+
+func (c *charge) IsRecorded() bool {
+	return c.actualAmount != nil
+}
+
+func (c *charge) Record(status Status, actual currency.Amount) error {
+	if actual != nil {
+		if !actual.IsValid() || actual.IsNegative() {
+			return ErrInvalidActualAmount
+		}
+		if actual.Currency() != c.expectedAmount.Currency() {
+			return ErrActualCurrencyMismatch
+		}
+	}
+
+	switch status {
+	case ExpectedStatus:
+		// Going back to expected forgets what was recorded
+		actual = nil
+	case PaidStatus:
+		if actual == nil {
+			actual = c.expectedAmount
+		}
+	case FailedStatus, RefundedStatus:
+		if actual == nil {
+			actual = currency.NewAmount(0, c.expectedAmount.Currency())
+		}
+	case AdjustedStatus:
+		if actual == nil {
+			return ErrActualAmountRequired
+		}
+	default:
+		return ErrUnknownChargeStatus
+	}
+
+	c.status = status
+	c.actualAmount = actual
+	c.SetAsDirty()
+	return nil
+}
+
+func (c *charge) SetExpectedAmount(amount currency.Amount) {
+	c.expectedAmount = amount
+	c.SetAsDirty()
+}
+
+func (c *charge) GetValidationErrors() validation.Errors {
+	var errors validation.Errors
+
+	if c.expectedAmount == nil || !c.expectedAmount.IsValid() {
+		errors = append(errors, validation.NewError("expectedAmount", "ExpectedAmount is required and must be valid"))
+	}
+
+	if c.status != ExpectedStatus && c.actualAmount == nil {
+		errors = append(errors,
+			validation.NewError("actualAmount", "ActualAmount is required once the charge is recorded"))
+	}
+
+	if c.actualAmount != nil && c.expectedAmount != nil &&
+		c.actualAmount.Currency() != c.expectedAmount.Currency() {
+		errors = append(errors,
+			validation.NewError("actualAmount", "ActualAmount currency cannot differ from the expected currency"))
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+
+	return nil
+}
+
+func (c *charge) ETagFields() []interface{} {
+	fields := []interface{}{
+		c.subscriptionId.String(),
+		c.dueDate,
+		c.status.String(),
+	}
+
+	if c.expectedAmount != nil {
+		fields = append(fields, c.expectedAmount.Value(), c.expectedAmount.Currency().String())
+	}
+
+	if c.actualAmount != nil {
+		fields = append(fields, c.actualAmount.Value(), c.actualAmount.Currency().String())
+	}
+
+	return fields
+}
+
+func (c *charge) ETag() string {
+	return entity.CalculateETag(c)
+}
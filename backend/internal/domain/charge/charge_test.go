@@ -0,0 +1,196 @@
+package charge_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func newMonthlySubscription(amount float64, start time.Time) subscription.Subscription {
+	return subscription.NewSubscription(
+		types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+		subscription.NewPrice(currency.NewAmount(amount, currency.EUR)), nil, nil,
+		types.NewPersonalOwner(types.UserID("user-1")), nil, nil, nil, nil, nil,
+		start, nil, nil, subscription.MonthlyRecurrency, nil, nil, time.Now(), time.Now())
+}
+
+func newExpectedCharge(subscriptionID types.SubscriptionID, dueDate time.Time, amount float64) charge.Charge {
+	return charge.NewCharge(types.NewChargeID(), subscriptionID, dueDate,
+		currency.NewAmount(amount, currency.EUR), nil, charge.ExpectedStatus, time.Now(), time.Now())
+}
+
+func TestCharge_Record(t *testing.T) {
+	t.Run("paid defaults to the expected amount", func(t *testing.T) {
+		chg := newExpectedCharge(types.NewSubscriptionID(), time.Now(), 10)
+
+		require.NoError(t, chg.Record(charge.PaidStatus, nil))
+
+		assert.Equal(t, charge.PaidStatus, chg.Status())
+		assert.Equal(t, 10.0, chg.Amount().Value())
+		assert.Equal(t, 0.0, chg.Difference().Value())
+		assert.True(t, chg.IsRecorded())
+		assert.True(t, chg.IsDirty())
+	})
+
+	t.Run("failed and refunded default to zero", func(t *testing.T) {
+		for _, status := range []charge.Status{charge.FailedStatus, charge.RefundedStatus} {
+			chg := newExpectedCharge(types.NewSubscriptionID(), time.Now(), 10)
+
+			require.NoError(t, chg.Record(status, nil))
+
+			assert.Equal(t, 0.0, chg.Amount().Value())
+			assert.Equal(t, -10.0, chg.Difference().Value())
+		}
+	})
+
+	t.Run("adjusted requires an actual amount", func(t *testing.T) {
+		chg := newExpectedCharge(types.NewSubscriptionID(), time.Now(), 10)
+
+		assert.ErrorIs(t, chg.Record(charge.AdjustedStatus, nil), charge.ErrActualAmountRequired)
+		require.NoError(t, chg.Record(charge.AdjustedStatus, currency.NewAmount(12.5, currency.EUR)))
+		assert.Equal(t, 12.5, chg.Amount().Value())
+		assert.Equal(t, 10.0, chg.ExpectedAmount().Value())
+	})
+
+	t.Run("rejects an amount in another currency", func(t *testing.T) {
+		chg := newExpectedCharge(types.NewSubscriptionID(), time.Now(), 10)
+
+		err := chg.Record(charge.PaidStatus, currency.NewAmount(10, currency.USD))
+
+		assert.ErrorIs(t, err, charge.ErrActualCurrencyMismatch)
+		assert.False(t, chg.IsRecorded())
+	})
+
+	t.Run("going back to expected forgets the actual amount", func(t *testing.T) {
+		chg := newExpectedCharge(types.NewSubscriptionID(), time.Now(), 10)
+		require.NoError(t, chg.Record(charge.PaidStatus, currency.NewAmount(11, currency.EUR)))
+
+		require.NoError(t, chg.Record(charge.ExpectedStatus, nil))
+
+		assert.False(t, chg.IsRecorded())
+		assert.Equal(t, 10.0, chg.Amount().Value())
+		assert.Nil(t, chg.GetValidationErrors())
+	})
+}
+
+func TestReconcile(t *testing.T) {
+	t.Run("materializes every occurrence billed so far", func(t *testing.T) {
+		start := time.Now().AddDate(0, -3, -1)
+		sub := newMonthlySubscription(10, start)
+
+		rec := charge.Reconcile(sub, nil, time.Now())
+
+		require.Len(t, rec.Created, 4)
+		assert.True(t, rec.Created[0].DueDate().Equal(start))
+		assert.True(t, rec.Created[3].DueDate().Equal(start.AddDate(0, 3, 0)))
+		for _, c := range rec.Created {
+			assert.Equal(t, sub.Id(), c.SubscriptionId())
+			assert.Equal(t, charge.ExpectedStatus, c.Status())
+			assert.Equal(t, 10.0, c.ExpectedAmount().Value())
+		}
+		assert.Empty(t, rec.Updated)
+		assert.Empty(t, rec.Removed)
+	})
+
+	t.Run("only creates missing occurrences and follows price changes", func(t *testing.T) {
+		start := time.Now().AddDate(0, -3, -1)
+		sub := newMonthlySubscription(10, start)
+		first := newExpectedCharge(sub.Id(), start, 10)
+		last := newExpectedCharge(sub.Id(), start.AddDate(0, 3, 0), 10)
+		require.NoError(t, sub.AddPriceChange(start.AddDate(0, 3, 0), currency.NewAmount(12, currency.EUR)))
+
+		rec := charge.Reconcile(sub, []charge.Charge{first, last}, time.Now())
+
+		assert.Len(t, rec.Created, 2)
+		require.Len(t, rec.Updated, 1)
+		assert.Equal(t, last, rec.Updated[0])
+		assert.Equal(t, 12.0, last.ExpectedAmount().Value())
+		assert.Equal(t, 10.0, first.ExpectedAmount().Value())
+	})
+
+	t.Run("removes stale expected charges but keeps recorded ones", func(t *testing.T) {
+		start := time.Now().AddDate(0, -3, -1)
+		sub := newMonthlySubscription(10, start)
+		stale := newExpectedCharge(sub.Id(), start.AddDate(0, 0, 10), 10)
+		recorded := newExpectedCharge(sub.Id(), start.AddDate(0, 0, 15), 10)
+		require.NoError(t, recorded.Record(charge.PaidStatus, nil))
+
+		rec := charge.Reconcile(sub, []charge.Charge{stale, recorded}, time.Now())
+
+		assert.Equal(t, []charge.Charge{stale}, rec.Removed)
+	})
+
+	t.Run("starts charging at the end of the free trial", func(t *testing.T) {
+		start := time.Now().AddDate(0, -3, -1)
+		sub := newMonthlySubscription(10, start)
+		sub.SetFreeTrial(subscription.NewFreeTrial(start, start.AddDate(0, 1, 1)))
+		trialCharge := newExpectedCharge(sub.Id(), start, 10)
+
+		rec := charge.Reconcile(sub, []charge.Charge{trialCharge}, time.Now())
+
+		require.Len(t, rec.Created, 2)
+		assert.True(t, rec.Created[0].DueDate().Equal(start.AddDate(0, 2, 0)))
+		assert.True(t, rec.Created[1].DueDate().Equal(start.AddDate(0, 3, 0)))
+		assert.Equal(t, []charge.Charge{trialCharge}, rec.Removed)
+	})
+}
+
+func TestTotalSpent(t *testing.T) {
+	start := time.Now().AddDate(-1, 0, -1)
+	sub := newMonthlySubscription(10, start)
+	paid := newExpectedCharge(sub.Id(), start, 10)
+	require.NoError(t, paid.Record(charge.PaidStatus, nil))
+	refunded := newExpectedCharge(sub.Id(), start.AddDate(0, 1, 0), 10)
+	require.NoError(t, refunded.Record(charge.RefundedStatus, currency.NewAmount(4, currency.EUR)))
+
+	total := charge.TotalSpent(sub, []charge.Charge{paid, refunded})
+
+	assert.InDelta(t, sub.GetTotalSpent().Value()-6, total.Value(), 0.0001)
+	assert.Equal(t, currency.EUR, total.Currency())
+}
+
+func TestSpendingByPeriod(t *testing.T) {
+	start := time.Now().AddDate(-1, 0, -1)
+	sub := newMonthlySubscription(10, start)
+	paid := newExpectedCharge(sub.Id(), start, 10)
+	require.NoError(t, paid.Record(charge.PaidStatus, nil))
+	refunded := newExpectedCharge(sub.Id(), start.AddDate(0, 1, 0), 10)
+	require.NoError(t, refunded.Record(charge.RefundedStatus, currency.NewAmount(4, currency.EUR)))
+
+	periods := charge.SpendingByPeriod(sub, []charge.Charge{paid, refunded})
+
+	require.Len(t, periods, len(sub.GetSpendingByPeriod()))
+	// The refund is deducted from the period it was charged in
+	assert.Equal(t, 10.0, periods[0].Amount.Value())
+	assert.InDelta(t, 4.0, periods[1].Amount.Value(), 0.0001)
+	total := 0.0
+	for _, period := range periods {
+		total += period.Amount.Value()
+	}
+	assert.InDelta(t, charge.TotalSpent(sub, []charge.Charge{paid, refunded}).Value(), total, 0.0001)
+}
+
+func TestRecurrencyAmountAt(t *testing.T) {
+	start := time.Now().AddDate(-1, 0, -1)
+	sub := newMonthlySubscription(10, start)
+	at := start.AddDate(0, 2, 5)
+	adjusted := newExpectedCharge(sub.Id(), start.AddDate(0, 2, 0), 10)
+	require.NoError(t, adjusted.Record(charge.AdjustedStatus, currency.NewAmount(15, currency.EUR)))
+	// An older recorded occurrence does not apply to a later period
+	older := newExpectedCharge(sub.Id(), start.AddDate(0, 1, 0), 10)
+	require.NoError(t, older.Record(charge.FailedStatus, nil))
+
+	assert.Equal(t, 15.0,
+		charge.RecurrencyAmountAt(sub, []charge.Charge{older, adjusted}, subscription.MonthlyRecurrency, at).Value())
+	assert.Equal(t, 180.0,
+		charge.RecurrencyAmountAt(sub, []charge.Charge{older, adjusted}, subscription.YearlyRecurrency, at).Value())
+	assert.Equal(t, 10.0,
+		charge.RecurrencyAmountAt(sub, []charge.Charge{older}, subscription.MonthlyRecurrency, at).Value())
+}
@@ -0,0 +1,13 @@
+package charge
+
+import (
+	ex "github.com/mistribe/subtracker/pkg/x/exception"
+)
+
+var (
+	ErrChargeNotFound         = ex.NewNotFound("charge not found")
+	ErrUnknownChargeStatus    = ex.NewInvalidValue("unknown charge status")
+	ErrActualAmountRequired   = ex.NewInvalidValue("an actual amount is required to adjust a charge")
+	ErrInvalidActualAmount    = ex.NewInvalidValue("actual amount cannot be negative")
+	ErrActualCurrencyMismatch = ex.NewInvalidValue("actual amount must use the charge currency")
+)
@@ -0,0 +1,154 @@
+package charge
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+// Reconciliation lists the changes needed to bring the charges of a subscription in line with its billing occurrences
+type Reconciliation struct {
+	// Created are the expected charges of occurrences that were not materialized yet
+	Created []Charge
+	// Updated are the expected charges whose amount no longer matches the price in effect at their due date
+	Updated []Charge
+	// Removed are the expected charges that no longer match any occurrence
+	Removed []Charge
+}
+
+// Reconcile materializes every billing occurrence of the subscription up to until, the occurrences covered by its
+// free trial are not charged. Recorded charges are never updated nor removed.
+func Reconcile(sub subscription.Subscription, existing []Charge, until time.Time) Reconciliation {
+	var rec Reconciliation
+	// Due dates are matched to the second since persisted timestamps lose their nanoseconds
+	known := make(map[int64]Charge, len(existing))
+	for _, c := range existing {
+		known[c.DueDate().Unix()] = c
+	}
+
+	now := time.Now()
+	dates := sub.BillingDates(until)
+	occurrences := make(map[int64]struct{}, len(dates))
+	for _, dueDate := range dates {
+		if sub.FreeTrial() != nil && sub.FreeTrial().Covers(dueDate) {
+			continue
+		}
+		occurrences[dueDate.Unix()] = struct{}{}
+		expected := sub.PriceAt(dueDate)
+		if !expected.IsValid() {
+			continue
+		}
+
+		c, ok := known[dueDate.Unix()]
+		if !ok {
+			rec.Created = append(rec.Created, NewCharge(
+				types.NewChargeID(),
+				sub.Id(),
+				dueDate,
+				expected,
+				nil,
+				ExpectedStatus,
+				now,
+				now,
+			))
+			continue
+		}
+		if !c.IsRecorded() && !c.ExpectedAmount().IsEqual(expected) {
+			c.SetExpectedAmount(expected)
+			c.SetUpdatedAt(now)
+			rec.Updated = append(rec.Updated, c)
+		}
+	}
+
+	for _, c := range existing {
+		if _, ok := occurrences[c.DueDate().Unix()]; !ok && !c.IsRecorded() {
+			rec.Removed = append(rec.Removed, c)
+		}
+	}
+
+	return rec
+}
+
+// TotalSpent returns the total spent of the subscription where recorded charges
+// replace the expected amount of their occurrence
+func TotalSpent(sub subscription.Subscription, charges []Charge) currency.Amount {
+	total := sub.GetTotalSpent()
+	if !total.IsValid() {
+		return total
+	}
+
+	value := total.Decimal()
+	for _, c := range charges {
+		if !c.IsRecorded() || c.SubscriptionId() != sub.Id() {
+			continue
+		}
+		if !sub.IsActiveAt(c.DueDate()) || c.DueDate().After(time.Now()) {
+			continue
+		}
+		value = value.Add(c.Difference().Decimal())
+	}
+
+	return currency.NewAmountFromDecimal(value, total.Currency())
+}
+
+// SpendingByPeriod returns what was spent on each elapsed billing period of the subscription where recorded
+// charges replace the expected amount of their occurrence. It adds up to TotalSpent.
+func SpendingByPeriod(sub subscription.Subscription, charges []Charge) []subscription.PeriodSpending {
+	periods := sub.GetSpendingByPeriod()
+	if len(periods) == 0 {
+		return periods
+	}
+
+	for _, c := range charges {
+		if !c.IsRecorded() || c.SubscriptionId() != sub.Id() {
+			continue
+		}
+		if !sub.IsActiveAt(c.DueDate()) || c.DueDate().After(time.Now()) {
+			continue
+		}
+		// The difference belongs to the last period started by its due date
+		index := 0
+		for i, period := range periods {
+			if period.Start.After(c.DueDate()) {
+				break
+			}
+			index = i
+		}
+		periods[index].Amount = periods[index].Amount.Add(c.Difference().Decimal())
+	}
+
+	return periods
+}
+
+// RecurrencyAmountAt returns the amount of the subscription for the given recurrency type at t.
+// When the occurrence billed at t has been recorded, the amount is scaled to what was really charged.
+func RecurrencyAmountAt(
+	sub subscription.Subscription,
+	charges []Charge,
+	to subscription.RecurrencyType,
+	t time.Time) currency.Amount {
+	dates := sub.BillingDates(t)
+	if len(dates) == 0 {
+		return sub.GetRecurrencyAmountAt(to, t)
+	}
+
+	dueDate := dates[len(dates)-1]
+	for _, c := range charges {
+		if !c.IsRecorded() || c.SubscriptionId() != sub.Id() || c.DueDate().Unix() != dueDate.Unix() {
+			continue
+		}
+		expected := c.ExpectedAmount()
+		if expected.IsZero() {
+			break
+		}
+		amount := sub.GetRecurrencyAmountAt(to, dueDate)
+		if !amount.IsValid() {
+			return amount
+		}
+		return amount.Mul(c.ActualAmount().Decimal()).Div(expected.Decimal())
+	}
+
+	return sub.GetRecurrencyAmountAt(to, t)
+}
@@ -0,0 +1,41 @@
+package charge
+
+type Status string
+
+const (
+	UnknownStatus  Status = "unknown"
+	ExpectedStatus Status = "expected"
+	PaidStatus     Status = "paid"
+	FailedStatus   Status = "failed"
+	RefundedStatus Status = "refunded"
+	AdjustedStatus Status = "adjusted"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+func ParseStatus(input string) (Status, error) {
+	switch input {
+	case string(ExpectedStatus):
+		return ExpectedStatus, nil
+	case string(PaidStatus):
+		return PaidStatus, nil
+	case string(FailedStatus):
+		return FailedStatus, nil
+	case string(RefundedStatus):
+		return RefundedStatus, nil
+	case string(AdjustedStatus):
+		return AdjustedStatus, nil
+	default:
+		return UnknownStatus, ErrUnknownChargeStatus
+	}
+}
+
+func MustParseStatus(input string) Status {
+	status, err := ParseStatus(input)
+	if err != nil {
+		panic(err)
+	}
+	return status
+}
@@ -1,5 +1,21 @@
 package currency
 
+import (
+	"golang.org/x/text/currency"
+
+	"github.com/mistribe/subtracker/pkg/decimal"
+)
+
+// DefaultRounding is the rounding of amounts to the minor units of their currency,
+// rounding ties to even does not bias totals of many amounts
+const DefaultRounding = decimal.HalfEven
+
+// MinorUnits returns the number of decimals of the currency, e.g. 2 for the euro and 0 for the yen
+func MinorUnits(unit Unit) int32 {
+	scale, _ := currency.Standard.Rounding(unit)
+	return int32(scale)
+}
+
 type Amount interface {
 	IsZero() bool
 	IsNegative() bool
@@ -7,121 +23,134 @@ type Amount interface {
 	IsEqual(other Amount) bool
 	IsGreaterThan(other Amount) bool
 	IsLessThan(other Amount) bool
+	// Value is the nearest float of the amount, Decimal must be used for computations
 	Value() float64
+	// Decimal is the exact value of the amount
+	Decimal() decimal.Decimal
 	Source() Amount
+	// RateSource is the kind of rate the amount was converted from its source with
+	RateSource() RateSource
 	Currency() Unit
 	IsValid() bool
-	Add(value float64) Amount
-	Sub(value float64) Amount
-	Div(value float64) Amount
-	Mul(value float64) Amount
+	Add(value decimal.Decimal) Amount
+	Sub(value decimal.Decimal) Amount
+	// Div divides the amount with decimal.DivisionScale decimals, dividing by zero gives an invalid amount
+	Div(value decimal.Decimal) Amount
+	Mul(value decimal.Decimal) Amount
+	// Round returns the amount with the minor units of its currency, e.g. 9.99 EUR or 1200 JPY
+	Round(mode decimal.RoundingMode) Amount
 }
 type amount struct {
-	value    float64
-	currency Unit
-	source   Amount
-	isValid  bool
+	value      decimal.Decimal
+	currency   Unit
+	source     Amount
+	rateSource RateSource
+	isValid    bool
 }
 
-func (a amount) Add(value float64) Amount {
+// apply returns the amount and its source transformed by operation
+func (a amount) apply(
+	operation func(value decimal.Decimal) decimal.Decimal,
+	sourceOperation func(source Amount) Amount) Amount {
 	if !a.isValid {
 		return NewInvalidAmount()
 	}
 
 	var source Amount
 	if a.source != nil {
-		source = a.source.Add(value)
+		source = sourceOperation(a.source)
 	}
 
 	return amount{
-		value:    a.value + value,
-		currency: a.currency,
-		source:   source,
-		isValid:  true,
+		value:      operation(a.value),
+		currency:   a.currency,
+		source:     source,
+		rateSource: a.rateSource,
+		isValid:    true,
 	}
 }
 
-func (a amount) Sub(value float64) Amount {
-	if !a.isValid {
-		return NewInvalidAmount()
-	}
-	var source Amount
-	if a.source != nil {
-		source = a.source.Sub(value)
-	}
+func (a amount) Add(value decimal.Decimal) Amount {
+	return a.apply(func(v decimal.Decimal) decimal.Decimal {
+		return v.Add(value)
+	}, func(source Amount) Amount {
+		return source.Add(value)
+	})
+}
 
-	return amount{
-		value:    a.value - value,
-		currency: a.currency,
-		source:   source,
-		isValid:  true,
-	}
+func (a amount) Sub(value decimal.Decimal) Amount {
+	return a.apply(func(v decimal.Decimal) decimal.Decimal {
+		return v.Sub(value)
+	}, func(source Amount) Amount {
+		return source.Sub(value)
+	})
 }
 
-func (a amount) Div(value float64) Amount {
-	if !a.isValid {
+func (a amount) Div(value decimal.Decimal) Amount {
+	if value.IsZero() {
 		return NewInvalidAmount()
 	}
-	var source Amount
-	if a.source != nil {
-		source = a.source.Div(value)
-	}
-
-	return amount{
-		value:    a.value / value,
-		currency: a.currency,
-		source:   source,
-		isValid:  true,
-	}
+	return a.apply(func(v decimal.Decimal) decimal.Decimal {
+		return v.Div(value)
+	}, func(source Amount) Amount {
+		return source.Div(value)
+	})
 }
 
-func (a amount) Mul(value float64) Amount {
-	if !a.isValid {
-		return NewInvalidAmount()
-	}
-	var source Amount
-	if a.source != nil {
-		source = a.source.Mul(value)
-	}
+func (a amount) Mul(value decimal.Decimal) Amount {
+	return a.apply(func(v decimal.Decimal) decimal.Decimal {
+		return v.Mul(value)
+	}, func(source Amount) Amount {
+		return source.Mul(value)
+	})
+}
 
-	return amount{
-		value:    a.value * value,
-		currency: a.currency,
-		source:   source,
-		isValid:  true,
-	}
+func (a amount) Round(mode decimal.RoundingMode) Amount {
+	return a.apply(func(v decimal.Decimal) decimal.Decimal {
+		return v.Round(MinorUnits(a.currency), mode)
+	}, func(source Amount) Amount {
+		return source.Round(mode)
+	})
 }
 
 func (a amount) Source() Amount {
 	return a.source
 }
 
+func (a amount) RateSource() RateSource {
+	return a.rateSource
+}
+
 func (a amount) IsZero() bool {
-	return a.value == 0
+	return a.value.IsZero()
 }
 
 func (a amount) IsNegative() bool {
-	return a.value < 0
+	return a.value.IsNegative()
 }
 
 func (a amount) IsPositive() bool {
-	return a.value > 0
+	return a.value.IsPositive()
 }
 
 func (a amount) IsEqual(other Amount) bool {
-	return a.value == other.Value() &&
+	return a.value.Equal(other.Decimal()) &&
 		a.currency == other.Currency()
 }
 
 func (a amount) IsGreaterThan(other Amount) bool {
-	return a.value > other.Value()
+	return a.value.GreaterThan(other.Decimal())
 }
 
 func (a amount) IsLessThan(other Amount) bool {
-	return a.value < other.Value()
+	return a.value.LessThan(other.Decimal())
 }
 
 func (a amount) Value() float64 {
+	return a.value.Float64()
+}
+
+func (a amount) Decimal() decimal.Decimal {
 	return a.value
 }
 
@@ -133,7 +162,12 @@ func (a amount) IsValid() bool {
 	return a.isValid
 }
 
+// NewAmount creates an amount from the shortest decimal representation of value, so 9.99 is exactly 9.99
 func NewAmount(value float64, unit Unit) Amount {
+	return NewAmountFromDecimal(decimal.NewFromFloat(value), unit)
+}
+
+func NewAmountFromDecimal(value decimal.Decimal, unit Unit) Amount {
 	return amount{
 		value:    value,
 		currency: unit,
@@ -141,12 +175,19 @@ func NewAmount(value float64, unit Unit) Amount {
 	}
 }
 
-func NewAmountWithSource(value float64, unit Unit, source Amount) Amount {
+// NewAmountWithSource creates an amount converted from source at a market rate
+func NewAmountWithSource(value decimal.Decimal, unit Unit, source Amount) Amount {
+	return NewAmountWithRateSource(value, unit, source, MarketRateSource)
+}
+
+// NewAmountWithRateSource creates an amount converted from source with the given kind of rate
+func NewAmountWithRateSource(value decimal.Decimal, unit Unit, source Amount, rateSource RateSource) Amount {
 	return amount{
-		value:    value,
-		currency: unit,
-		source:   source,
-		isValid:  true,
+		value:      value,
+		currency:   unit,
+		source:     source,
+		rateSource: rateSource,
+		isValid:    true,
 	}
 }
 
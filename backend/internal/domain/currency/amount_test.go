@@ -7,6 +7,7 @@ import (
 	xcur "golang.org/x/text/currency"
 
 	dc "github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/pkg/decimal"
 )
 
 func TestNewAmount(t *testing.T) {
@@ -58,15 +59,43 @@ func TestAmount_Comparisons(t *testing.T) {
 func TestAmount_Arithmetic(t *testing.T) {
 	a := dc.NewAmount(100, xcur.USD)
 
-	assert.Equal(t, 150.0, a.Add(50).Value())
-	assert.Equal(t, 50.0, a.Sub(50).Value())
-	assert.Equal(t, 200.0, a.Mul(2).Value())
-	assert.Equal(t, 50.0, a.Div(2).Value())
+	assert.Equal(t, 150.0, a.Add(decimal.NewFromInt(50)).Value())
+	assert.Equal(t, 50.0, a.Sub(decimal.NewFromInt(50)).Value())
+	assert.Equal(t, 200.0, a.Mul(decimal.NewFromInt(2)).Value())
+	assert.Equal(t, 50.0, a.Div(decimal.NewFromInt(2)).Value())
+	assert.False(t, a.Div(decimal.Zero).IsValid(), "dividing by zero gives an invalid amount")
+}
+
+func TestAmount_ExactSum(t *testing.T) {
+	total := dc.NewAmount(0, xcur.USD)
+	for range 12 {
+		total = total.Add(dc.NewAmount(9.99, xcur.USD).Decimal())
+	}
+
+	assert.Equal(t, "119.88", total.Decimal().String())
+	assert.Equal(t, 119.88, total.Value())
+}
+
+func TestAmount_Round(t *testing.T) {
+	assert.Equal(t, int32(2), dc.MinorUnits(xcur.EUR))
+	assert.Equal(t, int32(0), dc.MinorUnits(xcur.JPY))
+
+	monthly := dc.NewAmount(100, xcur.EUR).Div(decimal.NewFromInt(12))
+	assert.Equal(t, "8.33", monthly.Round(dc.DefaultRounding).Decimal().String())
+	assert.Equal(t, "8.34", monthly.Round(decimal.Up).Decimal().String())
+	assert.Equal(t, "10.00", dc.NewAmount(10, xcur.EUR).Round(dc.DefaultRounding).Decimal().String())
+	assert.Equal(t, "1234", dc.NewAmount(1234.5, xcur.JPY).Round(dc.DefaultRounding).Decimal().String())
+
+	converted := dc.NewAmountWithSource(decimal.MustParse("10.8765"), xcur.USD,
+		dc.NewAmount(10.005, xcur.EUR)).Round(decimal.HalfUp)
+	assert.Equal(t, "10.88", converted.Decimal().String())
+	assert.Equal(t, "10.01", converted.Source().Decimal().String(), "the source is rounded to its own currency")
+	assert.False(t, dc.NewInvalidAmount().Round(dc.DefaultRounding).IsValid())
 }
 
 func TestAmount_WithSource(t *testing.T) {
 	source := dc.NewAmount(100, xcur.USD)
-	a := dc.NewAmountWithSource(200, xcur.USD, source)
+	a := dc.NewAmountWithSource(decimal.NewFromInt(200), xcur.USD, source)
 
 	assert.Equal(t, source, a.Source())
 }
@@ -74,8 +103,19 @@ func TestAmount_WithSource(t *testing.T) {
 func TestAmount_InvalidArithmetic(t *testing.T) {
 	a := dc.NewInvalidAmount()
 
-	assert.False(t, a.Add(50).IsValid())
-	assert.False(t, a.Sub(50).IsValid())
-	assert.False(t, a.Mul(2).IsValid())
-	assert.False(t, a.Div(2).IsValid())
+	assert.False(t, a.Add(decimal.NewFromInt(50)).IsValid())
+	assert.False(t, a.Sub(decimal.NewFromInt(50)).IsValid())
+	assert.False(t, a.Mul(decimal.NewFromInt(2)).IsValid())
+	assert.False(t, a.Div(decimal.NewFromInt(2)).IsValid())
+}
+
+func TestAmount_RateSource(t *testing.T) {
+	source := dc.NewAmount(10, xcur.EUR)
+
+	assert.Equal(t, dc.NoRateSource, source.RateSource())
+	assert.Equal(t, dc.MarketRateSource, dc.NewAmountWithSource(decimal.NewFromInt(12), xcur.USD, source).RateSource())
+
+	converted := dc.NewAmountWithRateSource(decimal.NewFromInt(12), xcur.USD, source, dc.ManualRateSource)
+	assert.Equal(t, dc.ManualRateSource, converted.Add(decimal.NewFromInt(1)).Mul(decimal.NewFromInt(2)).RateSource(),
+		"arithmetic keeps the rate source")
 }
@@ -0,0 +1,13 @@
+package currency
+
+import (
+	ex "github.com/mistribe/subtracker/pkg/x/exception"
+)
+
+var (
+	ErrManualRateNotFound      = ex.NewNotFound("manual rate not found")
+	ErrManualRateAlreadyExists = ex.NewAlreadyExists("a manual rate already exists for this currency pair")
+	ErrUnknownRateSource       = ex.NewInvalidValue("unknown rate source")
+	ErrUnknownRateSyncStatus   = ex.NewInvalidValue("unknown rate sync status")
+	ErrRateSyncNotFound        = ex.NewNotFound("no rate sync has run yet")
+)
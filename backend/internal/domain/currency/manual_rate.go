@@ -0,0 +1,192 @@
+package currency
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x/validation"
+)
+
+type ManualRates []ManualRate
+
+// RateFor returns the manual rate to convert from one currency to another and the kind of that rate.
+// A rate of the subscription comes first, then a rate of the account and finally a rate of the family.
+func (r ManualRates) RateFor(from, to Unit, subscriptionId *types.SubscriptionID) (float64, RateSource, bool) {
+	if subscriptionId != nil {
+		for _, element := range r {
+			if element.SubscriptionId() == nil || *element.SubscriptionId() != *subscriptionId {
+				continue
+			}
+			if rate, ok := element.RateFor(from, to); ok {
+				return rate, SubscriptionRateSource, true
+			}
+		}
+	}
+
+	for _, ownerType := range []types.OwnerType{types.PersonalOwnerType, types.FamilyOwnerType} {
+		for _, element := range r {
+			if element.SubscriptionId() != nil || element.Owner().Type() != ownerType {
+				continue
+			}
+			if rate, ok := element.RateFor(from, to); ok {
+				return rate, ManualRateSource, true
+			}
+		}
+	}
+
+	return 0, NoRateSource, false
+}
+
+// ManualRate is a fixed exchange rate defined by an account or a family, it replaces the market rates
+// for a currency pair, either for every subscription of the owner or for a single subscription
+type ManualRate interface {
+	entity.Entity[types.ManualRateID]
+	entity.ETagEntity
+
+	Owner() types.Owner
+	// SubscriptionId is the only subscription the rate applies to, the rate applies to every subscription when nil
+	SubscriptionId() *types.SubscriptionID
+	FromCurrency() Unit
+	ToCurrency() Unit
+	ExchangeRate() float64
+	// RateFor returns the rate to convert from one currency to another, the inverse rate is used for
+	// the reverse pair
+	RateFor(from, to Unit) (float64, bool)
+	// Overlaps returns true if both rates would apply to the same conversions
+	Overlaps(other ManualRate) bool
+
+	GetValidationErrors() validation.Errors
+	SetPair(from, to Unit)
+	SetExchangeRate(rate float64)
+}
+
+type manualRate struct {
+	*entity.Base[types.ManualRateID]
+
+	owner          types.Owner
+	subscriptionId *types.SubscriptionID
+	fromCurrency   Unit
+	toCurrency     Unit
+	exchangeRate   float64
+}
+
+func NewManualRate(
+	id types.ManualRateID,
+	owner types.Owner,
+	subscriptionId *types.SubscriptionID,
+	fromCurrency Unit,
+	toCurrency Unit,
+	exchangeRate float64,
+	createdAt time.Time,
+	updatedAt time.Time) ManualRate {
+	return &manualRate{
+		Base:           entity.NewBase(id, createdAt, updatedAt, true, false),
+		owner:          owner,
+		subscriptionId: subscriptionId,
+		fromCurrency:   fromCurrency,
+		toCurrency:     toCurrency,
+		exchangeRate:   exchangeRate,
+	}
+}
+
+func (r *manualRate) Owner() types.Owner {
+	return r.owner
+}
+
+func (r *manualRate) SubscriptionId() *types.SubscriptionID {
+	return r.subscriptionId
+}
+
+func (r *manualRate) FromCurrency() Unit {
+	return r.fromCurrency
+}
+
+func (r *manualRate) ToCurrency() Unit {
+	return r.toCurrency
+}
+
+func (r *manualRate) ExchangeRate() float64 {
+	return r.exchangeRate
+}
+
+func (r *manualRate) RateFor(from, to Unit) (float64, bool) {
+	if r.exchangeRate <= 0 {
+		return 0, false
+	}
+	if r.fromCurrency == from && r.toCurrency == to {
+		return r.exchangeRate, true
+	}
+	if r.fromCurrency == to && r.toCurrency == from {
+		return 1 / r.exchangeRate, true
+	}
+	return 0, false
+}
+
+func (r *manualRate) Overlaps(other ManualRate) bool {
+	if other == nil || r.Id() == other.Id() || !r.owner.Equal(other.Owner()) {
+		return false
+	}
+	if (r.subscriptionId == nil) != (other.SubscriptionId() == nil) {
+		return false
+	}
+	if r.subscriptionId != nil && *r.subscriptionId != *other.SubscriptionId() {
+		return false
+	}
+	_, ok := r.RateFor(other.FromCurrency(), other.ToCurrency())
+	return ok
+}
+
+func (r *manualRate) GetValidationErrors() validation.Errors {
+	var errors validation.Errors
+
+	if r.fromCurrency == r.toCurrency {
+		errors = append(errors, validation.NewError("to_currency", "The currencies of the rate must be different"))
+	}
+
+	if r.exchangeRate <= 0 {
+		errors = append(errors, validation.NewError("rate", "Rate must be greater than zero"))
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+
+	return nil
+}
+
+func (r *manualRate) SetPair(from, to Unit) {
+	if r.fromCurrency == from && r.toCurrency == to {
+		return
+	}
+	r.fromCurrency = from
+	r.toCurrency = to
+	r.SetAsDirty()
+}
+
+func (r *manualRate) SetExchangeRate(rate float64) {
+	if r.exchangeRate == rate {
+		return
+	}
+	r.exchangeRate = rate
+	r.SetAsDirty()
+}
+
+func (r *manualRate) ETagFields() []interface{} {
+	fields := []interface{}{
+		r.owner.ETag(),
+		r.fromCurrency.String(),
+		r.toCurrency.String(),
+		r.exchangeRate,
+	}
+
+	if r.subscriptionId != nil {
+		fields = append(fields, r.subscriptionId.String())
+	}
+
+	return fields
+}
+
+func (r *manualRate) ETag() string {
+	return entity.CalculateETag(r)
+}
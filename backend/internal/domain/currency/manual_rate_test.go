@@ -0,0 +1,93 @@
+package currency_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func newManualRate(owner types.Owner, subscriptionId *types.SubscriptionID, rate float64) currency.ManualRate {
+	return currency.NewManualRate(types.NewManualRateID(), owner, subscriptionId, currency.EUR, currency.USD, rate,
+		time.Now(), time.Now())
+}
+
+func TestManualRate_RateFor(t *testing.T) {
+	r := newManualRate(types.NewPersonalOwner("user-1"), nil, 1.25)
+
+	rate, ok := r.RateFor(currency.EUR, currency.USD)
+	assert.True(t, ok)
+	assert.Equal(t, 1.25, rate)
+
+	rate, ok = r.RateFor(currency.USD, currency.EUR)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.8, rate, 1e-9)
+
+	_, ok = r.RateFor(currency.EUR, currency.MustParseISO("GBP"))
+	assert.False(t, ok)
+}
+
+func TestManualRate_GetValidationErrors(t *testing.T) {
+	assert.Nil(t, newManualRate(types.NewPersonalOwner("user-1"), nil, 1.25).GetValidationErrors())
+	assert.Len(t, newManualRate(types.NewPersonalOwner("user-1"), nil, 0).GetValidationErrors(), 1)
+
+	r := newManualRate(types.NewPersonalOwner("user-1"), nil, 1.25)
+	r.SetPair(currency.EUR, currency.EUR)
+	assert.Len(t, r.GetValidationErrors(), 1)
+}
+
+func TestManualRate_Overlaps(t *testing.T) {
+	owner := types.NewPersonalOwner("user-1")
+	subscriptionId := types.NewSubscriptionID()
+	r := newManualRate(owner, nil, 1.25)
+
+	reverse := currency.NewManualRate(types.NewManualRateID(), owner, nil, currency.USD, currency.EUR, 0.8,
+		time.Now(), time.Now())
+	assert.True(t, r.Overlaps(reverse))
+	assert.False(t, r.Overlaps(r))
+	assert.False(t, r.Overlaps(newManualRate(types.NewPersonalOwner("user-2"), nil, 1.25)))
+	assert.False(t, r.Overlaps(newManualRate(owner, x.P(subscriptionId), 1.25)))
+	assert.True(t, newManualRate(owner, x.P(subscriptionId), 1.1).Overlaps(newManualRate(owner, x.P(subscriptionId), 1.2)))
+}
+
+func TestManualRates_RateFor(t *testing.T) {
+	userOwner := types.NewPersonalOwner("user-1")
+	familyOwner := types.NewFamilyOwner(types.NewFamilyID())
+	subscriptionId := types.NewSubscriptionID()
+	rates := currency.ManualRates{
+		newManualRate(familyOwner, nil, 1.1),
+		newManualRate(userOwner, nil, 1.2),
+		newManualRate(familyOwner, x.P(subscriptionId), 1.3),
+	}
+
+	t.Run("prefers the rate of the subscription", func(t *testing.T) {
+		rate, source, ok := rates.RateFor(currency.EUR, currency.USD, x.P(subscriptionId))
+		assert.True(t, ok)
+		assert.Equal(t, 1.3, rate)
+		assert.Equal(t, currency.SubscriptionRateSource, source)
+	})
+
+	t.Run("prefers the rate of the account over the family", func(t *testing.T) {
+		rate, source, ok := rates.RateFor(currency.EUR, currency.USD, x.P(types.NewSubscriptionID()))
+		assert.True(t, ok)
+		assert.Equal(t, 1.2, rate)
+		assert.Equal(t, currency.ManualRateSource, source)
+	})
+
+	t.Run("uses the rate of the family", func(t *testing.T) {
+		rate, source, ok := rates[:1].RateFor(currency.USD, currency.EUR, nil)
+		assert.True(t, ok)
+		assert.InDelta(t, 1/1.1, rate, 1e-9)
+		assert.Equal(t, currency.ManualRateSource, source)
+	})
+
+	t.Run("has nothing for another pair", func(t *testing.T) {
+		_, source, ok := rates.RateFor(currency.EUR, currency.MustParseISO("GBP"), x.P(subscriptionId))
+		assert.False(t, ok)
+		assert.Equal(t, currency.NoRateSource, source)
+	})
+}
@@ -0,0 +1,40 @@
+package currency
+
+// RateSource is the kind of rate an amount was converted with
+type RateSource string
+
+const (
+	// NoRateSource is the source of an amount that was not converted
+	NoRateSource RateSource = ""
+	// MarketRateSource is a daily market rate stored in currency_rates
+	MarketRateSource RateSource = "market"
+	// ManualRateSource is a rate defined by an account or a family for a currency pair
+	ManualRateSource RateSource = "manual"
+	// SubscriptionRateSource is a rate defined for the currency pair of a single subscription
+	SubscriptionRateSource RateSource = "subscription"
+)
+
+func (s RateSource) String() string {
+	return string(s)
+}
+
+func ParseRateSource(input string) (RateSource, error) {
+	switch input {
+	case string(MarketRateSource):
+		return MarketRateSource, nil
+	case string(ManualRateSource):
+		return ManualRateSource, nil
+	case string(SubscriptionRateSource):
+		return SubscriptionRateSource, nil
+	default:
+		return NoRateSource, ErrUnknownRateSource
+	}
+}
+
+func MustParseRateSource(input string) RateSource {
+	s, err := ParseRateSource(input)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
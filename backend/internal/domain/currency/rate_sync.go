@@ -0,0 +1,261 @@
+package currency
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+// RateSyncStatus is the state of a synchronization of the market rates
+type RateSyncStatus string
+
+const (
+	UnknownRateSyncStatus   RateSyncStatus = "unknown"
+	RunningRateSyncStatus   RateSyncStatus = "running"
+	CompletedRateSyncStatus RateSyncStatus = "completed"
+	FailedRateSyncStatus    RateSyncStatus = "failed"
+)
+
+func (s RateSyncStatus) String() string {
+	return string(s)
+}
+
+func ParseRateSyncStatus(input string) (RateSyncStatus, error) {
+	switch input {
+	case string(RunningRateSyncStatus):
+		return RunningRateSyncStatus, nil
+	case string(CompletedRateSyncStatus):
+		return CompletedRateSyncStatus, nil
+	case string(FailedRateSyncStatus):
+		return FailedRateSyncStatus, nil
+	default:
+		return UnknownRateSyncStatus, ErrUnknownRateSyncStatus
+	}
+}
+
+func MustParseRateSyncStatus(input string) RateSyncStatus {
+	s, err := ParseRateSyncStatus(input)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// RateDayOutcome is what a synchronization did for a day
+type RateDayOutcome uint8
+
+const (
+	// RateDayComplete is a day that already had the rate of every supported pair
+	RateDayComplete RateDayOutcome = iota
+	// RateDayFilled is a day whose missing rates were added
+	RateDayFilled
+	// RateDayUnavailable is a day no rate source has rates for
+	RateDayUnavailable
+)
+
+// RateDate returns the calendar day of t, rates are stored by day
+func RateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// RateSync is a run of the backfill of the market rates over a window of days. The cursor is the last processed
+// day, a synchronization that did not complete resumes on the day after it.
+type RateSync interface {
+	entity.Entity[types.RateSyncID]
+	entity.ETagEntity
+
+	// Owner is the system, synchronizations are only visible to administrators
+	Owner() types.Owner
+	Status() RateSyncStatus
+	// StartDate is the first day of the window
+	StartDate() time.Time
+	// EndDate is the last day of the window
+	EndDate() time.Time
+	// Cursor is the last processed day, nil until the first one is processed
+	Cursor() *time.Time
+	TotalDays() int
+	ProcessedDays() int
+	FilledDays() int
+	UnavailableDays() int
+	// LastError is the error the synchronization failed with
+	LastError() *string
+	FinishedAt() *time.Time
+	IsCompleted() bool
+
+	// NextDate returns the next day to process, false once every day of the window was processed
+	NextDate() (time.Time, bool)
+	Advance(date time.Time, outcome RateDayOutcome, at time.Time)
+	// Resume restarts a failed synchronization from its cursor
+	Resume(at time.Time)
+	Complete(at time.Time)
+	Fail(err error, at time.Time)
+}
+
+type rateSync struct {
+	*entity.Base[types.RateSyncID]
+
+	status          RateSyncStatus
+	startDate       time.Time
+	endDate         time.Time
+	cursor          *time.Time
+	processedDays   int
+	filledDays      int
+	unavailableDays int
+	lastError       *string
+	finishedAt      *time.Time
+}
+
+func NewRateSync(
+	id types.RateSyncID,
+	status RateSyncStatus,
+	startDate time.Time,
+	endDate time.Time,
+	cursor *time.Time,
+	processedDays int,
+	filledDays int,
+	unavailableDays int,
+	lastError *string,
+	finishedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time) RateSync {
+	return &rateSync{
+		Base:            entity.NewBase(id, createdAt, updatedAt, true, false),
+		status:          status,
+		startDate:       RateDate(startDate),
+		endDate:         RateDate(endDate),
+		cursor:          cursor,
+		processedDays:   processedDays,
+		filledDays:      filledDays,
+		unavailableDays: unavailableDays,
+		lastError:       lastError,
+		finishedAt:      finishedAt,
+	}
+}
+
+func (s *rateSync) Owner() types.Owner {
+	return types.SystemOwner
+}
+
+func (s *rateSync) Status() RateSyncStatus {
+	return s.status
+}
+
+func (s *rateSync) StartDate() time.Time {
+	return s.startDate
+}
+
+func (s *rateSync) EndDate() time.Time {
+	return s.endDate
+}
+
+func (s *rateSync) Cursor() *time.Time {
+	return s.cursor
+}
+
+func (s *rateSync) TotalDays() int {
+	if s.endDate.Before(s.startDate) {
+		return 0
+	}
+	return int(s.endDate.Sub(s.startDate).Hours()/24) + 1
+}
+
+func (s *rateSync) ProcessedDays() int {
+	return s.processedDays
+}
+
+func (s *rateSync) FilledDays() int {
+	return s.filledDays
+}
+
+func (s *rateSync) UnavailableDays() int {
+	return s.unavailableDays
+}
+
+func (s *rateSync) LastError() *string {
+	return s.lastError
+}
+
+func (s *rateSync) FinishedAt() *time.Time {
+	return s.finishedAt
+}
+
+func (s *rateSync) IsCompleted() bool {
+	return s.status == CompletedRateSyncStatus
+}
+
+func (s *rateSync) NextDate() (time.Time, bool) {
+	next := s.startDate
+	if s.cursor != nil {
+		next = RateDate(*s.cursor).AddDate(0, 0, 1)
+	}
+	if next.After(s.endDate) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+func (s *rateSync) Advance(date time.Time, outcome RateDayOutcome, at time.Time) {
+	day := RateDate(date)
+	s.cursor = &day
+	s.processedDays++
+	switch outcome {
+	case RateDayFilled:
+		s.filledDays++
+	case RateDayUnavailable:
+		s.unavailableDays++
+	default:
+	}
+	s.SetUpdatedAt(at)
+}
+
+func (s *rateSync) Resume(at time.Time) {
+	s.status = RunningRateSyncStatus
+	s.lastError = nil
+	s.finishedAt = nil
+	s.SetUpdatedAt(at)
+}
+
+func (s *rateSync) Complete(at time.Time) {
+	s.status = CompletedRateSyncStatus
+	s.lastError = nil
+	s.finishedAt = &at
+	s.SetUpdatedAt(at)
+}
+
+func (s *rateSync) Fail(err error, at time.Time) {
+	message := err.Error()
+	s.status = FailedRateSyncStatus
+	s.lastError = &message
+	s.finishedAt = &at
+	s.SetUpdatedAt(at)
+}
+
+func (s *rateSync) ETagFields() []interface{} {
+	fields := []interface{}{
+		s.status.String(),
+		s.startDate,
+		s.endDate,
+		s.processedDays,
+		s.filledDays,
+		s.unavailableDays,
+	}
+
+	if s.cursor != nil {
+		fields = append(fields, *s.cursor)
+	}
+
+	if s.lastError != nil {
+		fields = append(fields, *s.lastError)
+	}
+
+	if s.finishedAt != nil {
+		fields = append(fields, *s.finishedAt)
+	}
+
+	return fields
+}
+
+func (s *rateSync) ETag() string {
+	return entity.CalculateETag(s)
+}
@@ -0,0 +1,73 @@
+package currency_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func newRateSync(start, end time.Time) currency.RateSync {
+	return currency.NewRateSync(types.NewRateSyncID(), currency.RunningRateSyncStatus, start, end, nil, 0, 0, 0,
+		nil, nil, time.Now(), time.Now())
+}
+
+func TestRateSync_NextDate(t *testing.T) {
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC)
+	s := newRateSync(start, end)
+	assert.Equal(t, 3, s.TotalDays())
+
+	var days []time.Time
+	outcomes := []currency.RateDayOutcome{currency.RateDayComplete, currency.RateDayFilled, currency.RateDayUnavailable}
+	for date, ok := s.NextDate(); ok; date, ok = s.NextDate() {
+		days = append(days, date)
+		s.Advance(date, outcomes[len(days)-1], time.Now())
+	}
+
+	assert.Equal(t, []time.Time{start, start.AddDate(0, 0, 1), end}, days)
+	assert.Equal(t, 3, s.ProcessedDays())
+	assert.Equal(t, 1, s.FilledDays())
+	assert.Equal(t, 1, s.UnavailableDays())
+	require.NotNil(t, s.Cursor())
+	assert.Equal(t, end, *s.Cursor())
+}
+
+func TestRateSync_Resume(t *testing.T) {
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	s := newRateSync(start, start.AddDate(0, 0, 9))
+	s.Advance(start, currency.RateDayFilled, time.Now())
+	s.Fail(errors.New("source unavailable"), time.Now())
+
+	assert.Equal(t, currency.FailedRateSyncStatus, s.Status())
+	require.NotNil(t, s.LastError())
+	assert.Equal(t, "source unavailable", *s.LastError())
+	assert.NotNil(t, s.FinishedAt())
+	assert.False(t, s.IsCompleted())
+
+	s.Resume(time.Now())
+	assert.Equal(t, currency.RunningRateSyncStatus, s.Status())
+	assert.Nil(t, s.LastError())
+	assert.Nil(t, s.FinishedAt())
+	next, ok := s.NextDate()
+	assert.True(t, ok)
+	assert.Equal(t, start.AddDate(0, 0, 1), next)
+
+	s.Complete(time.Now())
+	assert.True(t, s.IsCompleted())
+}
+
+func TestRateSync_ETag(t *testing.T) {
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	s := newRateSync(start, start.AddDate(0, 0, 9))
+	etag := s.ETag()
+	assert.Equal(t, etag, s.ETag())
+
+	s.Advance(start, currency.RateDayComplete, time.Now())
+	assert.NotEqual(t, etag, s.ETag())
+}
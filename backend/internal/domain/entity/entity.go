@@ -13,6 +13,8 @@ type Entity[TKey comparable] interface {
 	SetUpdatedAt(updatedAt time.Time)
 	SetAsDirty()
 	IsExists() bool
+	// Events returns the events recorded since the entity was loaded or last saved
+	Events() []Event
 }
 
 type Base[TKey comparable] struct {
@@ -21,6 +23,7 @@ type Base[TKey comparable] struct {
 	updatedAt time.Time
 	isDirty   bool
 	isExists  bool
+	events    []Event
 }
 
 func NewBase[TKey comparable](
@@ -50,9 +53,11 @@ func (b *Base[TKey]) UpdatedAt() time.Time {
 	return b.updatedAt
 }
 
+// Clean marks the entity as matching its stored state, the events recorded until then are discarded
 func (b *Base[TKey]) Clean() {
 	b.isDirty = false
 	b.isExists = true
+	b.events = nil
 }
 
 func (b *Base[TKey]) IsDirty() bool {
@@ -72,6 +77,15 @@ func (b *Base[TKey]) IsExists() bool {
 	return b.isExists
 }
 
+// RecordEvent records an event to publish once the entity is saved
+func (b *Base[TKey]) RecordEvent(event Event) {
+	b.events = append(b.events, event)
+}
+
+func (b *Base[TKey]) Events() []Event {
+	return b.events
+}
+
 func (b *Base[TKey]) Equal(other Base[TKey]) bool {
 	return b.id == other.id &&
 		b.createdAt == other.createdAt &&
@@ -0,0 +1,7 @@
+package entity
+
+// Event is a fact recorded by an aggregate when it changes, it is published once the aggregate is saved
+type Event interface {
+	// EventName identifies the kind of the event, e.g. subscription.created
+	EventName() string
+}
@@ -0,0 +1,31 @@
+package family
+
+import (
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+const (
+	MemberInvitedEventName      = "family.member_invited"
+	InvitationAcceptedEventName = "family.invitation_accepted"
+)
+
+// MemberInvited is recorded when a member of a family is given an invitation code
+type MemberInvited struct {
+	FamilyId types.FamilyID
+	MemberId types.FamilyMemberID
+}
+
+func (MemberInvited) EventName() string {
+	return MemberInvitedEventName
+}
+
+// InvitationAccepted is recorded when a user accepts the invitation of a member and becomes that member
+type InvitationAccepted struct {
+	FamilyId types.FamilyID
+	MemberId types.FamilyMemberID
+	UserId   types.UserID
+}
+
+func (InvitationAccepted) EventName() string {
+	return InvitationAcceptedEventName
+}
@@ -22,6 +22,8 @@ type Family interface {
 	RemoveMember(member Member) error
 	GetMember(id types.FamilyMemberID) Member
 	UpdateMember(member Member) error
+	InviteMember(member Member, code string) error
+	AcceptInvitation(member Member, userId types.UserID) error
 	ContainsMember(id types.FamilyMemberID) bool
 	Equal(family Family) bool
 	GetValidationErrors() validation.Errors
@@ -143,6 +145,49 @@ func (f *family) UpdateMember(member Member) error {
 	return nil
 }
 
+// InviteMember gives an invitation code to a member who is not a user yet, the member is added to the family
+// when it is not part of it
+func (f *family) InviteMember(member Member, code string) error {
+	if member.UserId() != nil || !member.SetInvitationCode(&code) {
+		return ErrCannotInviteUser
+	}
+
+	var err error
+	if f.ContainsMember(member.Id()) {
+		err = f.UpdateMember(member)
+	} else {
+		err = f.AddMember(member)
+	}
+	if err != nil {
+		return err
+	}
+
+	f.RecordEvent(MemberInvited{
+		FamilyId: f.Id(),
+		MemberId: member.Id(),
+	})
+	return nil
+}
+
+// AcceptInvitation makes the user the invited member, the invitation code must have been checked
+func (f *family) AcceptInvitation(member Member, userId types.UserID) error {
+	if member.InvitationCode() == nil {
+		return ErrBadInvitationCode
+	}
+
+	member.SetUserId(&userId)
+	if err := f.UpdateMember(member); err != nil {
+		return err
+	}
+
+	f.RecordEvent(InvitationAccepted{
+		FamilyId: f.Id(),
+		MemberId: member.Id(),
+		UserId:   userId,
+	})
+	return nil
+}
+
 func (f *family) ContainsMember(id types.FamilyMemberID) bool {
 	for m := range f.members.It() {
 		if m.Id() == id {
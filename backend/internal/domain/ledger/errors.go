@@ -0,0 +1,10 @@
+package ledger
+
+import (
+	ex "github.com/mistribe/subtracker/pkg/x/exception"
+)
+
+var (
+	ErrSettlementNotFound     = ex.NewNotFound("settlement not found")
+	ErrLedgerCurrencyMismatch = ex.NewInvalidValue("amount must use the ledger currency")
+)
@@ -0,0 +1,161 @@
+package ledger
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/decimal"
+)
+
+// balanceTolerance ignores what is left of a balance once rounding is taken into account
+var balanceTolerance = decimal.MustParse("0.005")
+
+// Transfer is a payment a member has to make to another one to settle up
+type Transfer struct {
+	From   types.FamilyMemberID
+	To     types.FamilyMemberID
+	Amount currency.Amount
+}
+
+// Ledger nets what the members of a family owe each other in a single currency.
+// A positive balance means the member is owed money, a negative one that the member owes money.
+type Ledger interface {
+	FamilyId() types.FamilyID
+	Currency() currency.Unit
+	// Owner scopes the ledger to its family
+	Owner() types.Owner
+
+	// Accrue records that debtor owes amount to creditor, e.g. the share of a charge paid by creditor
+	Accrue(creditor, debtor types.FamilyMemberID, amount currency.Amount) error
+	// Settle records that from paid amount to to
+	Settle(from, to types.FamilyMemberID, amount currency.Amount) error
+
+	// Balance returns the net balance of the member
+	Balance(member types.FamilyMemberID) currency.Amount
+	// Transfers returns the payments that bring every balance back to zero. Debts matching a credit
+	// are settled in a single transfer, the rest goes from the largest debtor to the largest creditor,
+	// so there are never more transfers than members minus one.
+	Transfers() []Transfer
+}
+
+type ledger struct {
+	familyId types.FamilyID
+	unit     currency.Unit
+	balances map[types.FamilyMemberID]decimal.Decimal
+}
+
+func NewLedger(familyId types.FamilyID, unit currency.Unit) Ledger {
+	return &ledger{
+		familyId: familyId,
+		unit:     unit,
+		balances: make(map[types.FamilyMemberID]decimal.Decimal),
+	}
+}
+
+func (l *ledger) FamilyId() types.FamilyID {
+	return l.familyId
+}
+
+func (l *ledger) Currency() currency.Unit {
+	return l.unit
+}
+
+func (l *ledger) Owner() types.Owner {
+	return types.NewFamilyOwner(l.familyId)
+}
+
+func (l *ledger) Accrue(creditor, debtor types.FamilyMemberID, amount currency.Amount) error {
+	if amount == nil || !amount.IsValid() || amount.Currency() != l.unit {
+		return ErrLedgerCurrencyMismatch
+	}
+	if creditor == debtor {
+		return nil
+	}
+
+	l.balances[creditor] = l.balances[creditor].Add(amount.Decimal())
+	l.balances[debtor] = l.balances[debtor].Sub(amount.Decimal())
+	return nil
+}
+
+func (l *ledger) Settle(from, to types.FamilyMemberID, amount currency.Amount) error {
+	// Paying someone back is owing less to them, which is the same as them owing the payer
+	return l.Accrue(from, to, amount)
+}
+
+func (l *ledger) Balance(member types.FamilyMemberID) currency.Amount {
+	balance := l.balances[member]
+	if balance.Abs().LessThan(balanceTolerance) {
+		balance = decimal.Zero
+	}
+	return currency.NewAmountFromDecimal(balance, l.unit)
+}
+
+type position struct {
+	member types.FamilyMemberID
+	value  decimal.Decimal
+}
+
+func (l *ledger) Transfers() []Transfer {
+	var creditors, debtors []*position
+	for member, balance := range l.balances {
+		switch {
+		case balance.Cmp(balanceTolerance) >= 0:
+			creditors = append(creditors, &position{member: member, value: balance})
+		case balance.Neg().Cmp(balanceTolerance) >= 0:
+			debtors = append(debtors, &position{member: member, value: balance.Neg()})
+		}
+	}
+
+	// Largest positions first, ties broken by member so the transfers do not depend on map ordering
+	byValue := func(a, b *position) int {
+		if cmp := b.value.Cmp(a.value); cmp != 0 {
+			return cmp
+		}
+		return strings.Compare(a.member.String(), b.member.String())
+	}
+	slices.SortFunc(creditors, byValue)
+	slices.SortFunc(debtors, byValue)
+
+	var transfers []Transfer
+	pay := func(debtor, creditor *position, value decimal.Decimal) {
+		transfers = append(transfers, Transfer{
+			From:   debtor.member,
+			To:     creditor.member,
+			Amount: currency.NewAmountFromDecimal(value, l.unit),
+		})
+		debtor.value = debtor.value.Sub(value)
+		creditor.value = creditor.value.Sub(value)
+	}
+
+	for _, debtor := range debtors {
+		for _, creditor := range creditors {
+			if creditor.value.Cmp(balanceTolerance) >= 0 &&
+				creditor.value.Sub(debtor.value).Abs().LessThan(balanceTolerance) {
+				pay(debtor, creditor, debtor.value)
+				break
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(debtors) && j < len(creditors) {
+		debtor, creditor := debtors[i], creditors[j]
+		if debtor.value.LessThan(balanceTolerance) {
+			i++
+			continue
+		}
+		if creditor.value.LessThan(balanceTolerance) {
+			j++
+			continue
+		}
+		value := debtor.value
+		if creditor.value.LessThan(value) {
+			value = creditor.value
+		}
+		pay(debtor, creditor, value)
+	}
+
+	return transfers
+}
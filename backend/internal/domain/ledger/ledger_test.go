@@ -0,0 +1,149 @@
+package ledger_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func eur(value float64) currency.Amount {
+	return currency.NewAmount(value, currency.EUR)
+}
+
+func TestLedger_Balances(t *testing.T) {
+	alice := types.NewFamilyMemberID()
+	bob := types.NewFamilyMemberID()
+
+	t.Run("shares paid by another member are owed to them", func(t *testing.T) {
+		l := ledger.NewLedger(types.NewFamilyID(), currency.EUR)
+
+		require.NoError(t, l.Accrue(bob, alice, eur(6)))
+		require.NoError(t, l.Accrue(bob, alice, eur(6)))
+
+		assert.Equal(t, 12.0, l.Balance(bob).Value())
+		assert.Equal(t, -12.0, l.Balance(alice).Value())
+	})
+
+	t.Run("settlements reduce what is owed", func(t *testing.T) {
+		l := ledger.NewLedger(types.NewFamilyID(), currency.EUR)
+		require.NoError(t, l.Accrue(bob, alice, eur(40)))
+
+		require.NoError(t, l.Settle(alice, bob, eur(30)))
+
+		assert.Equal(t, 10.0, l.Balance(bob).Value())
+		assert.Equal(t, -10.0, l.Balance(alice).Value())
+	})
+
+	t.Run("the payer's own share is not a debt", func(t *testing.T) {
+		l := ledger.NewLedger(types.NewFamilyID(), currency.EUR)
+
+		require.NoError(t, l.Accrue(bob, bob, eur(10)))
+
+		assert.Equal(t, 0.0, l.Balance(bob).Value())
+		assert.Empty(t, l.Transfers())
+	})
+
+	t.Run("rejects amounts in another currency", func(t *testing.T) {
+		l := ledger.NewLedger(types.NewFamilyID(), currency.EUR)
+
+		assert.ErrorIs(t, l.Accrue(bob, alice, currency.NewAmount(10, currency.USD)), ledger.ErrLedgerCurrencyMismatch)
+		assert.ErrorIs(t, l.Settle(alice, bob, nil), ledger.ErrLedgerCurrencyMismatch)
+	})
+
+	t.Run("ledger is owned by its family", func(t *testing.T) {
+		familyId := types.NewFamilyID()
+		l := ledger.NewLedger(familyId, currency.EUR)
+
+		assert.Equal(t, types.FamilyOwnerType, l.Owner().Type())
+		assert.Equal(t, familyId, l.Owner().FamilyId())
+	})
+}
+
+func TestLedger_Transfers(t *testing.T) {
+	alice := types.NewFamilyMemberID()
+	bob := types.NewFamilyMemberID()
+	carol := types.NewFamilyMemberID()
+	dave := types.NewFamilyMemberID()
+
+	t.Run("debts chain into a single transfer", func(t *testing.T) {
+		l := ledger.NewLedger(types.NewFamilyID(), currency.EUR)
+		require.NoError(t, l.Accrue(bob, alice, eur(10)))
+		require.NoError(t, l.Accrue(carol, bob, eur(10)))
+
+		transfers := l.Transfers()
+
+		require.Len(t, transfers, 1)
+		assert.Equal(t, alice, transfers[0].From)
+		assert.Equal(t, carol, transfers[0].To)
+		assert.Equal(t, 10.0, transfers[0].Amount.Value())
+	})
+
+	t.Run("matching debts and credits are settled directly", func(t *testing.T) {
+		l := ledger.NewLedger(types.NewFamilyID(), currency.EUR)
+		// alice -15, bob -5, carol +5, dave +15: greedy alone would need three transfers
+		require.NoError(t, l.Accrue(dave, alice, eur(15)))
+		require.NoError(t, l.Accrue(carol, bob, eur(5)))
+
+		transfers := l.Transfers()
+
+		require.Len(t, transfers, 2)
+		for _, transfer := range transfers {
+			switch transfer.From {
+			case alice:
+				assert.Equal(t, dave, transfer.To)
+				assert.Equal(t, 15.0, transfer.Amount.Value())
+			case bob:
+				assert.Equal(t, carol, transfer.To)
+				assert.Equal(t, 5.0, transfer.Amount.Value())
+			}
+		}
+	})
+
+	t.Run("transfers bring every balance back to zero", func(t *testing.T) {
+		l := ledger.NewLedger(types.NewFamilyID(), currency.EUR)
+		require.NoError(t, l.Accrue(alice, bob, eur(10)))
+		require.NoError(t, l.Accrue(alice, carol, eur(10)))
+		require.NoError(t, l.Accrue(alice, dave, eur(10)))
+		require.NoError(t, l.Accrue(bob, carol, eur(25)))
+		require.NoError(t, l.Settle(dave, alice, eur(3.33)))
+
+		transfers := l.Transfers()
+
+		assert.LessOrEqual(t, len(transfers), 3)
+		for _, transfer := range transfers {
+			require.NoError(t, l.Settle(transfer.From, transfer.To, transfer.Amount))
+		}
+		for _, member := range []types.FamilyMemberID{alice, bob, carol, dave} {
+			assert.Equal(t, 0.0, l.Balance(member).Value())
+		}
+		assert.Empty(t, l.Transfers())
+	})
+}
+
+func TestSettlement_Validation(t *testing.T) {
+	alice := types.NewFamilyMemberID()
+	bob := types.NewFamilyMemberID()
+	newSettlement := func(from, to types.FamilyMemberID, amount currency.Amount) ledger.Settlement {
+		return ledger.NewSettlement(types.NewSettlementID(), types.NewFamilyID(), from, to, amount,
+			time.Now(), nil, time.Now(), time.Now())
+	}
+
+	t.Run("valid settlement", func(t *testing.T) {
+		s := newSettlement(alice, bob, eur(30))
+
+		assert.Nil(t, s.GetValidationErrors())
+		assert.Equal(t, types.FamilyOwnerType, s.Owner().Type())
+	})
+
+	t.Run("rejects invalid settlements", func(t *testing.T) {
+		assert.Len(t, newSettlement(alice, alice, eur(30)).GetValidationErrors(), 1)
+		assert.Len(t, newSettlement(alice, bob, eur(0)).GetValidationErrors(), 1)
+		assert.Len(t, newSettlement(alice, bob, nil).GetValidationErrors(), 1)
+	})
+}
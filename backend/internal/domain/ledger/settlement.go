@@ -0,0 +1,135 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x/validation"
+)
+
+// Settlement is a payment made between two family members outside the application,
+// e.g. Alice paid Bob 30 EUR to cover her share of the subscriptions he pays for
+type Settlement interface {
+	entity.Entity[types.SettlementID]
+	entity.ETagEntity
+
+	FamilyId() types.FamilyID
+	// From is the member who paid
+	From() types.FamilyMemberID
+	// To is the member who received the payment
+	To() types.FamilyMemberID
+	Amount() currency.Amount
+	SettledAt() time.Time
+	Note() *string
+	// Owner scopes the settlement to its family
+	Owner() types.Owner
+
+	GetValidationErrors() validation.Errors
+}
+
+type settlement struct {
+	*entity.Base[types.SettlementID]
+
+	familyId  types.FamilyID
+	from      types.FamilyMemberID
+	to        types.FamilyMemberID
+	amount    currency.Amount
+	settledAt time.Time
+	note      *string
+}
+
+func NewSettlement(
+	id types.SettlementID,
+	familyId types.FamilyID,
+	from types.FamilyMemberID,
+	to types.FamilyMemberID,
+	amount currency.Amount,
+	settledAt time.Time,
+	note *string,
+	createdAt time.Time,
+	updatedAt time.Time) Settlement {
+	return &settlement{
+		Base:      entity.NewBase(id, createdAt, updatedAt, true, false),
+		familyId:  familyId,
+		from:      from,
+		to:        to,
+		amount:    amount,
+		settledAt: settledAt,
+		note:      note,
+	}
+}
+
+func (s *settlement) FamilyId() types.FamilyID {
+	return s.familyId
+}
+
+func (s *settlement) From() types.FamilyMemberID {
+	return s.from
+}
+
+func (s *settlement) To() types.FamilyMemberID {
+	return s.to
+}
+
+func (s *settlement) Amount() currency.Amount {
+	return s.amount
+}
+
+func (s *settlement) SettledAt() time.Time {
+	return s.settledAt
+}
+
+func (s *settlement) Note() *string {
+	return s.note
+}
+
+func (s *settlement) Owner() types.Owner {
+	return types.NewFamilyOwner(s.familyId)
+}
+
+func (s *settlement) GetValidationErrors() validation.Errors {
+	var errors validation.Errors
+
+	if s.from == s.to {
+		errors = append(errors, validation.NewError("to", "To cannot be the member who paid"))
+	}
+
+	if s.amount == nil || !s.amount.IsValid() || !s.amount.IsPositive() {
+		errors = append(errors, validation.NewError("amount", "Amount is required and must be greater than 0"))
+	}
+
+	if s.note != nil && len(*s.note) > 255 {
+		errors = append(errors, validation.NewError("note", "Note cannot be longer than 255 characters"))
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+
+	return nil
+}
+
+func (s *settlement) ETagFields() []interface{} {
+	fields := []interface{}{
+		s.familyId.String(),
+		s.from.String(),
+		s.to.String(),
+		s.settledAt,
+	}
+
+	if s.amount != nil {
+		fields = append(fields, s.amount.Value(), s.amount.Currency().String())
+	}
+
+	if s.note != nil {
+		fields = append(fields, *s.note)
+	}
+
+	return fields
+}
+
+func (s *settlement) ETag() string {
+	return entity.CalculateETag(s)
+}
@@ -0,0 +1,10 @@
+package notification
+
+import (
+	ex "github.com/mistribe/subtracker/pkg/x/exception"
+)
+
+var (
+	ErrUnknownLocale         = ex.NewInvalidValue("unknown locale")
+	ErrUnknownReminderStatus = ex.NewInvalidValue("unknown reminder status")
+)
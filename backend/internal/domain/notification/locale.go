@@ -0,0 +1,49 @@
+package notification
+
+import (
+	"strings"
+)
+
+// Locale is the language the notifications of an account are written in
+type Locale string
+
+const (
+	EnglishLocale Locale = "en"
+	FrenchLocale  Locale = "fr"
+
+	DefaultLocale = EnglishLocale
+)
+
+func (l Locale) String() string {
+	return string(l)
+}
+
+func ParseLocale(input string) (Locale, error) {
+	switch input {
+	case string(EnglishLocale):
+		return EnglishLocale, nil
+	case string(FrenchLocale):
+		return FrenchLocale, nil
+	default:
+		return DefaultLocale, ErrUnknownLocale
+	}
+}
+
+func MustParseLocale(input string) Locale {
+	l, err := ParseLocale(input)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// LocaleOf returns the first supported locale of the languages, e.g. fr-CA, the default one when none is supported
+func LocaleOf(languages ...string) Locale {
+	for _, language := range languages {
+		base, _, _ := strings.Cut(language, "-")
+		if locale, err := ParseLocale(strings.ToLower(base)); err == nil {
+			return locale
+		}
+	}
+	return DefaultLocale
+}
@@ -0,0 +1,167 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x/validation"
+)
+
+const (
+	DefaultRenewalLeadDays              = 3
+	DefaultFreeTrialEndLeadDays         = 3
+	DefaultCancellationDeadlineLeadDays = 7
+	// MaxLeadDays bounds how long before an event its reminder can be sent
+	MaxLeadDays = 60
+)
+
+// Preferences are the email reminders an account opted in to. The lead days of a kind is how many days before
+// the event its reminder is sent, 0 sends it on the day of the event.
+type Preferences interface {
+	entity.Entity[types.UserID]
+	entity.ETagEntity
+
+	UserId() types.UserID
+	Enabled() bool
+	Locale() Locale
+	RenewalLeadDays() int
+	FreeTrialEndLeadDays() int
+	CancellationDeadlineLeadDays() int
+	// LeadDays returns the lead days of the kind of event
+	LeadDays(kind calendar.EventKind) int
+	GetValidationErrors() validation.Errors
+
+	SetEnabled(enabled bool)
+	SetLocale(locale Locale)
+	SetLeadDays(renewal, freeTrialEnd, cancellationDeadline int)
+}
+
+type preferences struct {
+	*entity.Base[types.UserID]
+
+	enabled                      bool
+	locale                       Locale
+	renewalLeadDays              int
+	freeTrialEndLeadDays         int
+	cancellationDeadlineLeadDays int
+}
+
+func NewPreferences(
+	userId types.UserID,
+	enabled bool,
+	locale Locale,
+	renewalLeadDays int,
+	freeTrialEndLeadDays int,
+	cancellationDeadlineLeadDays int,
+	createdAt time.Time,
+	updatedAt time.Time) Preferences {
+	return &preferences{
+		Base:                         entity.NewBase(userId, createdAt, updatedAt, true, false),
+		enabled:                      enabled,
+		locale:                       locale,
+		renewalLeadDays:              renewalLeadDays,
+		freeTrialEndLeadDays:         freeTrialEndLeadDays,
+		cancellationDeadlineLeadDays: cancellationDeadlineLeadDays,
+	}
+}
+
+// DefaultPreferences are the preferences of an account that never saved any, reminders are opt-in
+func DefaultPreferences(userId types.UserID, locale Locale) Preferences {
+	now := time.Now()
+	return NewPreferences(userId, false, locale, DefaultRenewalLeadDays, DefaultFreeTrialEndLeadDays,
+		DefaultCancellationDeadlineLeadDays, now, now)
+}
+
+func (p *preferences) UserId() types.UserID {
+	return p.Id()
+}
+
+func (p *preferences) Enabled() bool {
+	return p.enabled
+}
+
+func (p *preferences) Locale() Locale {
+	return p.locale
+}
+
+func (p *preferences) RenewalLeadDays() int {
+	return p.renewalLeadDays
+}
+
+func (p *preferences) FreeTrialEndLeadDays() int {
+	return p.freeTrialEndLeadDays
+}
+
+func (p *preferences) CancellationDeadlineLeadDays() int {
+	return p.cancellationDeadlineLeadDays
+}
+
+func (p *preferences) LeadDays(kind calendar.EventKind) int {
+	switch kind {
+	case calendar.RenewalEventKind:
+		return p.renewalLeadDays
+	case calendar.FreeTrialEndEventKind:
+		return p.freeTrialEndLeadDays
+	case calendar.CancellationDeadlineEventKind:
+		return p.cancellationDeadlineLeadDays
+	default:
+		return 0
+	}
+}
+
+func (p *preferences) GetValidationErrors() validation.Errors {
+	var errors validation.Errors
+
+	leadDays := []struct {
+		field string
+		value int
+	}{
+		{"renewal_lead_days", p.renewalLeadDays},
+		{"free_trial_end_lead_days", p.freeTrialEndLeadDays},
+		{"cancellation_deadline_lead_days", p.cancellationDeadlineLeadDays},
+	}
+	for _, lead := range leadDays {
+		if lead.value < 0 || lead.value > MaxLeadDays {
+			errors = append(errors, validation.NewError(lead.field, "Lead days must be between 0 and 60"))
+		}
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+
+	return nil
+}
+
+func (p *preferences) SetEnabled(enabled bool) {
+	p.enabled = enabled
+	p.SetUpdatedAt(time.Now())
+}
+
+func (p *preferences) SetLocale(locale Locale) {
+	p.locale = locale
+	p.SetUpdatedAt(time.Now())
+}
+
+func (p *preferences) SetLeadDays(renewal, freeTrialEnd, cancellationDeadline int) {
+	p.renewalLeadDays = renewal
+	p.freeTrialEndLeadDays = freeTrialEnd
+	p.cancellationDeadlineLeadDays = cancellationDeadline
+	p.SetUpdatedAt(time.Now())
+}
+
+func (p *preferences) ETagFields() []interface{} {
+	return []interface{}{
+		p.enabled,
+		p.locale.String(),
+		p.renewalLeadDays,
+		p.freeTrialEndLeadDays,
+		p.cancellationDeadlineLeadDays,
+	}
+}
+
+func (p *preferences) ETag() string {
+	return entity.CalculateETag(p)
+}
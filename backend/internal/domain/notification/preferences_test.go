@@ -0,0 +1,41 @@
+package notification_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func TestPreferences_LeadDays(t *testing.T) {
+	p := notification.DefaultPreferences(types.UserID("user-1"), notification.FrenchLocale)
+	assert.False(t, p.Enabled())
+	assert.Equal(t, notification.FrenchLocale, p.Locale())
+
+	p.SetLeadDays(1, 2, 10)
+	assert.Equal(t, 1, p.LeadDays(calendar.RenewalEventKind))
+	assert.Equal(t, 2, p.LeadDays(calendar.FreeTrialEndEventKind))
+	assert.Equal(t, 10, p.LeadDays(calendar.CancellationDeadlineEventKind))
+}
+
+func TestPreferences_GetValidationErrors(t *testing.T) {
+	p := notification.DefaultPreferences(types.UserID("user-1"), notification.EnglishLocale)
+	assert.Nil(t, p.GetValidationErrors())
+
+	p.SetLeadDays(0, notification.MaxLeadDays, 0)
+	assert.Nil(t, p.GetValidationErrors())
+
+	p.SetLeadDays(-1, notification.MaxLeadDays+1, 0)
+	assert.Len(t, p.GetValidationErrors(), 2)
+}
+
+func TestLocaleOf(t *testing.T) {
+	assert.Equal(t, notification.FrenchLocale, notification.LocaleOf("fr-CA"))
+	assert.Equal(t, notification.FrenchLocale, notification.LocaleOf("de-DE", "FR"))
+	assert.Equal(t, notification.EnglishLocale, notification.LocaleOf("en-US", "fr"))
+	assert.Equal(t, notification.DefaultLocale, notification.LocaleOf("de"))
+	assert.Equal(t, notification.DefaultLocale, notification.LocaleOf())
+}
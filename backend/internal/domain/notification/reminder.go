@@ -0,0 +1,164 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+// ReminderStatus is the state of the delivery of a reminder
+type ReminderStatus string
+
+const (
+	UnknownReminderStatus ReminderStatus = "unknown"
+	// PendingReminderStatus is a reminder claimed by an instance that is sending it
+	PendingReminderStatus ReminderStatus = "pending"
+	SentReminderStatus    ReminderStatus = "sent"
+)
+
+func (s ReminderStatus) String() string {
+	return string(s)
+}
+
+func ParseReminderStatus(input string) (ReminderStatus, error) {
+	switch input {
+	case string(PendingReminderStatus):
+		return PendingReminderStatus, nil
+	case string(SentReminderStatus):
+		return SentReminderStatus, nil
+	default:
+		return UnknownReminderStatus, ErrUnknownReminderStatus
+	}
+}
+
+func MustParseReminderStatus(input string) ReminderStatus {
+	s, err := ParseReminderStatus(input)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Reminder records the email sent to a user for an event of a subscription. There is at most one reminder for
+// each user, subscription, kind and day of event, which is what keeps a reminder from being sent twice.
+type Reminder interface {
+	entity.Entity[types.ReminderID]
+	entity.ETagEntity
+
+	UserId() types.UserID
+	SubscriptionId() types.SubscriptionID
+	Kind() calendar.EventKind
+	// EventDate is the day of the event the reminder is about
+	EventDate() time.Time
+	Status() ReminderStatus
+	SentAt() *time.Time
+
+	MarkAsSent(at time.Time)
+}
+
+type reminder struct {
+	*entity.Base[types.ReminderID]
+
+	userId         types.UserID
+	subscriptionId types.SubscriptionID
+	kind           calendar.EventKind
+	eventDate      time.Time
+	status         ReminderStatus
+	sentAt         *time.Time
+}
+
+func NewReminder(
+	id types.ReminderID,
+	userId types.UserID,
+	subscriptionId types.SubscriptionID,
+	kind calendar.EventKind,
+	eventDate time.Time,
+	status ReminderStatus,
+	sentAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time) Reminder {
+	return &reminder{
+		Base:           entity.NewBase(id, createdAt, updatedAt, true, false),
+		userId:         userId,
+		subscriptionId: subscriptionId,
+		kind:           kind,
+		eventDate:      EventDay(eventDate),
+		status:         status,
+		sentAt:         sentAt,
+	}
+}
+
+// NewPendingReminder creates the reminder of a calendar event before it is sent
+func NewPendingReminder(userId types.UserID, event calendar.Event) Reminder {
+	now := time.Now()
+	return NewReminder(types.NewReminderID(), userId, event.SubscriptionId, event.Kind, event.Date,
+		PendingReminderStatus, nil, now, now)
+}
+
+// EventDay returns the calendar day of t in its own location, the dates of the events are already in the
+// timezone of the account
+func EventDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// IsDue tells whether the reminder of an event is to be sent at now, loc is the timezone of the account
+func IsDue(event calendar.Event, leadDays int, now time.Time, loc *time.Location) bool {
+	if loc == nil {
+		loc = time.UTC
+	}
+	today := EventDay(now.In(loc))
+	days := int(EventDay(event.Date).Sub(today).Hours() / 24)
+	return days >= 0 && days <= leadDays
+}
+
+func (r *reminder) UserId() types.UserID {
+	return r.userId
+}
+
+func (r *reminder) SubscriptionId() types.SubscriptionID {
+	return r.subscriptionId
+}
+
+func (r *reminder) Kind() calendar.EventKind {
+	return r.kind
+}
+
+func (r *reminder) EventDate() time.Time {
+	return r.eventDate
+}
+
+func (r *reminder) Status() ReminderStatus {
+	return r.status
+}
+
+func (r *reminder) SentAt() *time.Time {
+	return r.sentAt
+}
+
+func (r *reminder) MarkAsSent(at time.Time) {
+	r.status = SentReminderStatus
+	r.sentAt = &at
+	r.SetUpdatedAt(at)
+}
+
+func (r *reminder) ETagFields() []interface{} {
+	fields := []interface{}{
+		r.userId.String(),
+		r.subscriptionId.String(),
+		r.kind.String(),
+		r.eventDate,
+		r.status.String(),
+	}
+
+	if r.sentAt != nil {
+		fields = append(fields, *r.sentAt)
+	}
+
+	return fields
+}
+
+func (r *reminder) ETag() string {
+	return entity.CalculateETag(r)
+}
@@ -0,0 +1,60 @@
+package notification_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func TestIsDue(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	event := calendar.Event{
+		Kind: calendar.RenewalEventKind,
+		Date: time.Date(2025, time.March, 10, 0, 0, 0, 0, paris),
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		lead int
+		want bool
+	}{
+		{"before the lead days", time.Date(2025, time.March, 6, 12, 0, 0, 0, paris), 3, false},
+		{"first day of the lead days", time.Date(2025, time.March, 7, 0, 30, 0, 0, paris), 3, true},
+		{"on the day of the event", time.Date(2025, time.March, 10, 23, 0, 0, 0, paris), 0, true},
+		{"after the event", time.Date(2025, time.March, 11, 0, 0, 0, 0, paris), 3, false},
+		// 23:30 UTC on the 6th is already the 7th in Paris
+		{"in the timezone of the account", time.Date(2025, time.March, 6, 23, 30, 0, 0, time.UTC), 3, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, notification.IsDue(event, tt.lead, tt.now, paris))
+		})
+	}
+}
+
+func TestReminder_MarkAsSent(t *testing.T) {
+	event := calendar.Event{
+		SubscriptionId: types.NewSubscriptionID(),
+		Kind:           calendar.FreeTrialEndEventKind,
+		Date:           time.Date(2025, time.March, 10, 0, 0, 0, 0, time.FixedZone("UTC-8", -8*3600)),
+	}
+	r := notification.NewPendingReminder(types.UserID("user-1"), event)
+	assert.Equal(t, notification.PendingReminderStatus, r.Status())
+	assert.Equal(t, time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC), r.EventDate())
+	etag := r.ETag()
+
+	at := time.Now()
+	r.MarkAsSent(at)
+	assert.Equal(t, notification.SentReminderStatus, r.Status())
+	require.NotNil(t, r.SentAt())
+	assert.Equal(t, at, *r.SentAt())
+	assert.NotEqual(t, etag, r.ETag())
+}
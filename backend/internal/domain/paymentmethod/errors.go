@@ -0,0 +1,11 @@
+package paymentmethod
+
+import (
+	ex "github.com/mistribe/subtracker/pkg/x/exception"
+)
+
+var (
+	ErrPaymentMethodNotFound      = ex.NewNotFound("payment method not found")
+	ErrPaymentMethodAlreadyExists = ex.NewAlreadyExists("payment method already exists")
+	ErrUnknownPaymentMethodType   = ex.NewInvalidValue("unknown payment method type")
+)
@@ -0,0 +1,208 @@
+package paymentmethod
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x/validation"
+)
+
+var (
+	lastFourRegex = regexp.MustCompile(`^[0-9]{4}$`)
+)
+
+const (
+	minExpiryYear = 2000
+	maxExpiryYear = 2100
+)
+
+// PaymentMethod is the card, bank account or wallet a subscription is charged to
+type PaymentMethod interface {
+	entity.Entity[types.PaymentMethodID]
+	entity.ETagEntity
+
+	Owner() types.Owner
+	Type() Type
+	Label() string
+	// LastFour holds the last four digits of the card or account number
+	LastFour() *string
+	ExpiryMonth() *int32
+	ExpiryYear() *int32
+	// ExpiresAt returns the first instant at which the payment method can no longer be used,
+	// i.e. the start of the month following its expiry month. It is nil when no expiry is set.
+	ExpiresAt() *time.Time
+	IsExpiredAt(at time.Time) bool
+
+	GetValidationErrors() validation.Errors
+	SetType(paymentMethodType Type)
+	SetLabel(label string)
+	SetLastFour(lastFour *string)
+	SetExpiry(month *int32, year *int32)
+}
+
+type paymentMethod struct {
+	*entity.Base[types.PaymentMethodID]
+
+	owner             types.Owner
+	paymentMethodType Type
+	label             string
+	lastFour          *string
+	expiryMonth       *int32
+	expiryYear        *int32
+}
+
+func NewPaymentMethod(
+	id types.PaymentMethodID,
+	owner types.Owner,
+	paymentMethodType Type,
+	label string,
+	lastFour *string,
+	expiryMonth *int32,
+	expiryYear *int32,
+	createdAt time.Time,
+	updatedAt time.Time) PaymentMethod {
+	return &paymentMethod{
+		Base:              entity.NewBase(id, createdAt, updatedAt, true, false),
+		owner:             owner,
+		paymentMethodType: paymentMethodType,
+		label:             strings.TrimSpace(label),
+		lastFour:          lastFour,
+		expiryMonth:       expiryMonth,
+		expiryYear:        expiryYear,
+	}
+}
+
+func (p *paymentMethod) Owner() types.Owner {
+	return p.owner
+}
+
+func (p *paymentMethod) Type() Type {
+	return p.paymentMethodType
+}
+
+func (p *paymentMethod) Label() string {
+	return p.label
+}
+
+func (p *paymentMethod) LastFour() *string {
+	return p.lastFour
+}
+
+func (p *paymentMethod) ExpiryMonth() *int32 {
+	return p.expiryMonth
+}
+
+func (p *paymentMethod) ExpiryYear() *int32 {
+	return p.expiryYear
+}
+
+func (p *paymentMethod) ExpiresAt() *time.Time {
+	if p.expiryMonth == nil || p.expiryYear == nil {
+		return nil
+	}
+
+	// a card expiring 03/2026 can still be charged until the end of March
+	expiresAt := time.Date(int(*p.expiryYear), time.Month(*p.expiryMonth), 1, 0, 0, 0, 0, time.UTC).
+		AddDate(0, 1, 0)
+	return &expiresAt
+}
+
+func (p *paymentMethod) IsExpiredAt(at time.Time) bool {
+	expiresAt := p.ExpiresAt()
+	if expiresAt == nil {
+		return false
+	}
+
+	return !at.Before(*expiresAt)
+}
+
+func (p *paymentMethod) GetValidationErrors() validation.Errors {
+	var errors validation.Errors
+
+	if p.paymentMethodType == UnknownType || p.paymentMethodType == "" {
+		errors = append(errors, validation.NewError("type", "Type is required"))
+	}
+
+	label := strings.TrimSpace(p.label)
+	if label == "" {
+		errors = append(errors, validation.NewError("label", "Label is required and cannot be empty"))
+	}
+
+	if len(label) > 100 {
+		errors = append(errors, validation.NewError("label", "Label cannot be longer than 100 characters"))
+	}
+
+	if p.lastFour != nil && !lastFourRegex.MatchString(*p.lastFour) {
+		errors = append(errors, validation.NewError("last_four", "LastFour must contain exactly 4 digits"))
+	}
+
+	if (p.expiryMonth == nil) != (p.expiryYear == nil) {
+		errors = append(errors, validation.NewError("expiry",
+			"ExpiryMonth and ExpiryYear must be provided together"))
+	}
+
+	if p.expiryMonth != nil && (*p.expiryMonth < 1 || *p.expiryMonth > 12) {
+		errors = append(errors, validation.NewError("expiry_month", "ExpiryMonth must be between 1 and 12"))
+	}
+
+	if p.expiryYear != nil && (*p.expiryYear < minExpiryYear || *p.expiryYear > maxExpiryYear) {
+		errors = append(errors, validation.NewError("expiry_year", "ExpiryYear must be between 2000 and 2100"))
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+
+	return nil
+}
+
+func (p *paymentMethod) SetType(paymentMethodType Type) {
+	if p.paymentMethodType == paymentMethodType {
+		return
+	}
+	p.paymentMethodType = paymentMethodType
+	p.SetAsDirty()
+}
+
+func (p *paymentMethod) SetLabel(label string) {
+	p.label = strings.TrimSpace(label)
+	p.SetAsDirty()
+}
+
+func (p *paymentMethod) SetLastFour(lastFour *string) {
+	p.lastFour = lastFour
+	p.SetAsDirty()
+}
+
+func (p *paymentMethod) SetExpiry(month *int32, year *int32) {
+	p.expiryMonth = month
+	p.expiryYear = year
+	p.SetAsDirty()
+}
+
+func (p *paymentMethod) ETagFields() []interface{} {
+	fields := []interface{}{
+		p.owner.ETag(),
+		p.paymentMethodType.String(),
+		p.label,
+	}
+
+	if p.lastFour != nil {
+		fields = append(fields, *p.lastFour)
+	}
+	if p.expiryMonth != nil {
+		fields = append(fields, *p.expiryMonth)
+	}
+	if p.expiryYear != nil {
+		fields = append(fields, *p.expiryYear)
+	}
+
+	return fields
+}
+
+func (p *paymentMethod) ETag() string {
+	return entity.CalculateETag(p)
+}
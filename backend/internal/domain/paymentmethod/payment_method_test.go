@@ -0,0 +1,77 @@
+package paymentmethod_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func newCard(lastFour *string, month, year *int32) paymentmethod.PaymentMethod {
+	return paymentmethod.NewPaymentMethod(types.NewPaymentMethodID(), types.NewPersonalOwner("user-1"),
+		paymentmethod.CardType, "Personal Visa", lastFour, month, year, time.Now(), time.Now())
+}
+
+func TestPaymentMethod_Expiry(t *testing.T) {
+	t.Run("a card can be charged until the end of its expiry month", func(t *testing.T) {
+		card := newCard(x.P("4242"), x.P(int32(3)), x.P(int32(2026)))
+
+		expiresAt := card.ExpiresAt()
+
+		assert.Equal(t, time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC), *expiresAt)
+		assert.False(t, card.IsExpiredAt(time.Date(2026, time.March, 31, 23, 0, 0, 0, time.UTC)))
+		assert.True(t, card.IsExpiredAt(time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("december expiry rolls over to the next year", func(t *testing.T) {
+		card := newCard(nil, x.P(int32(12)), x.P(int32(2026)))
+
+		assert.Equal(t, time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), *card.ExpiresAt())
+	})
+
+	t.Run("without expiry it never expires", func(t *testing.T) {
+		account := newCard(nil, nil, nil)
+
+		assert.Nil(t, account.ExpiresAt())
+		assert.False(t, account.IsExpiredAt(time.Now().AddDate(50, 0, 0)))
+	})
+}
+
+func TestPaymentMethod_Validation(t *testing.T) {
+	t.Run("valid payment methods", func(t *testing.T) {
+		assert.Nil(t, newCard(x.P("0042"), x.P(int32(1)), x.P(int32(2030))).GetValidationErrors())
+		assert.Nil(t, newCard(nil, nil, nil).GetValidationErrors())
+	})
+
+	t.Run("last four must be four digits", func(t *testing.T) {
+		assert.Len(t, newCard(x.P("42"), nil, nil).GetValidationErrors(), 1)
+		assert.Len(t, newCard(x.P("42a4"), nil, nil).GetValidationErrors(), 1)
+	})
+
+	t.Run("expiry month and year go together", func(t *testing.T) {
+		assert.Len(t, newCard(nil, x.P(int32(4)), nil).GetValidationErrors(), 1)
+		assert.Len(t, newCard(nil, x.P(int32(13)), x.P(int32(2030))).GetValidationErrors(), 1)
+		assert.Len(t, newCard(nil, x.P(int32(4)), x.P(int32(30))).GetValidationErrors(), 1)
+	})
+
+	t.Run("label and type are required", func(t *testing.T) {
+		pm := paymentmethod.NewPaymentMethod(types.NewPaymentMethodID(), types.NewPersonalOwner("user-1"),
+			paymentmethod.UnknownType, "  ", nil, nil, nil, time.Now(), time.Now())
+
+		assert.Len(t, pm.GetValidationErrors(), 2)
+	})
+
+	t.Run("etag follows the expiry", func(t *testing.T) {
+		card := newCard(nil, x.P(int32(4)), x.P(int32(2030)))
+		etag := card.ETag()
+
+		card.SetExpiry(x.P(int32(4)), x.P(int32(2031)))
+
+		assert.NotEqual(t, etag, card.ETag())
+		assert.True(t, card.IsDirty())
+	})
+}
@@ -0,0 +1,38 @@
+package paymentmethod
+
+type Type string
+
+const (
+	UnknownType     Type = "unknown"
+	CardType        Type = "card"
+	BankAccountType Type = "bank_account"
+	WalletType      Type = "wallet"
+	OtherType       Type = "other"
+)
+
+func (t Type) String() string {
+	return string(t)
+}
+
+func ParseType(input string) (Type, error) {
+	switch input {
+	case string(CardType):
+		return CardType, nil
+	case string(BankAccountType):
+		return BankAccountType, nil
+	case string(WalletType):
+		return WalletType, nil
+	case string(OtherType):
+		return OtherType, nil
+	default:
+		return UnknownType, ErrUnknownPaymentMethodType
+	}
+}
+
+func MustParseType(input string) Type {
+	t, err := ParseType(input)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
@@ -0,0 +1,435 @@
+package subscription_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func newAnchoredSubscription(
+	start time.Time,
+	endDate *time.Time,
+	recurrency subscription.RecurrencyType,
+	interval *subscription.Interval,
+	anchorDay *int32) subscription.Subscription {
+	return subscription.NewSubscription(
+		types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+		subscription.NewPrice(currency.NewAmount(10, currency.EUR)), nil, nil,
+		types.NewPersonalOwner(types.UserID("user-1")), nil, nil, nil, nil, nil,
+		start, endDate, nil, recurrency, interval, anchorDay, time.Now(), time.Now())
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func TestSubscription_BillingAnchorDay(t *testing.T) {
+	tests := []struct {
+		name       string
+		start      time.Time
+		recurrency subscription.RecurrencyType
+		interval   *subscription.Interval
+		anchorDay  *int32
+		until      time.Time
+		expected   []time.Time
+	}{
+		{
+			name:       "end of month in a leap year",
+			start:      date(2024, time.January, 31),
+			recurrency: subscription.MonthlyRecurrency,
+			until:      date(2024, time.May, 1),
+			expected: []time.Time{
+				date(2024, time.January, 31),
+				date(2024, time.February, 29),
+				date(2024, time.March, 31),
+				date(2024, time.April, 30),
+			},
+		},
+		{
+			name:       "end of month in a common year",
+			start:      date(2025, time.January, 31),
+			recurrency: subscription.MonthlyRecurrency,
+			until:      date(2025, time.May, 1),
+			expected: []time.Time{
+				date(2025, time.January, 31),
+				date(2025, time.February, 28),
+				date(2025, time.March, 31),
+				date(2025, time.April, 30),
+			},
+		},
+		{
+			name:       "explicit anchor after a short start month",
+			start:      date(2025, time.April, 30),
+			recurrency: subscription.MonthlyRecurrency,
+			anchorDay:  x.P(int32(31)),
+			until:      date(2025, time.August, 1),
+			expected: []time.Time{
+				date(2025, time.April, 30),
+				date(2025, time.May, 31),
+				date(2025, time.June, 30),
+				date(2025, time.July, 31),
+			},
+		},
+		{
+			name:       "explicit anchor moves renewals after the start date",
+			start:      date(2025, time.January, 3),
+			recurrency: subscription.MonthlyRecurrency,
+			anchorDay:  x.P(int32(15)),
+			until:      date(2025, time.April, 1),
+			expected: []time.Time{
+				date(2025, time.January, 3),
+				date(2025, time.February, 15),
+				date(2025, time.March, 15),
+			},
+		},
+		{
+			name:       "yearly from a leap day",
+			start:      date(2024, time.February, 29),
+			recurrency: subscription.YearlyRecurrency,
+			until:      date(2028, time.March, 1),
+			expected: []time.Time{
+				date(2024, time.February, 29),
+				date(2025, time.February, 28),
+				date(2026, time.February, 28),
+				date(2027, time.February, 28),
+				date(2028, time.February, 29),
+			},
+		},
+		{
+			name:       "quarterly through a leap february",
+			start:      date(2023, time.November, 30),
+			recurrency: subscription.QuarterlyRecurrency,
+			until:      date(2024, time.September, 1),
+			expected: []time.Time{
+				date(2023, time.November, 30),
+				date(2024, time.February, 29),
+				date(2024, time.May, 30),
+				date(2024, time.August, 30),
+			},
+		},
+		{
+			name:       "custom months with an anchor",
+			start:      date(2025, time.December, 29),
+			recurrency: subscription.CustomRecurrency,
+			interval:   subscription.NewInterval(subscription.MonthIntervalUnit, 2),
+			anchorDay:  x.P(int32(29)),
+			until:      date(2026, time.May, 1),
+			expected: []time.Time{
+				date(2025, time.December, 29),
+				date(2026, time.February, 28),
+				date(2026, time.April, 29),
+			},
+		},
+		{
+			name:       "day intervals ignore the anchor",
+			start:      date(2024, time.February, 1),
+			recurrency: subscription.CustomRecurrency,
+			interval:   subscription.NewInterval(subscription.DayIntervalUnit, 28),
+			anchorDay:  x.P(int32(31)),
+			until:      date(2024, time.March, 31),
+			expected: []time.Time{
+				date(2024, time.February, 1),
+				date(2024, time.February, 29),
+				date(2024, time.March, 28),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := newAnchoredSubscription(tt.start, nil, tt.recurrency, tt.interval, tt.anchorDay)
+
+			assert.Nil(t, sub.GetValidationErrors())
+			assert.Equal(t, tt.expected, sub.BillingDates(tt.until))
+		})
+	}
+}
+
+func TestSubscription_BillingAnchorDayValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		anchorDay *int32
+		valid     bool
+	}{
+		{name: "not set", anchorDay: nil, valid: true},
+		{name: "first day", anchorDay: x.P(int32(1)), valid: true},
+		{name: "last day", anchorDay: x.P(int32(31)), valid: true},
+		{name: "zero", anchorDay: x.P(int32(0)), valid: false},
+		{name: "after the last day", anchorDay: x.P(int32(32)), valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := newAnchoredSubscription(date(2025, time.January, 1), nil, subscription.MonthlyRecurrency, nil,
+				tt.anchorDay)
+
+			if tt.valid {
+				assert.Nil(t, sub.GetValidationErrors())
+			} else {
+				assert.NotNil(t, sub.GetValidationErrors())
+			}
+		})
+	}
+}
+
+func TestSubscription_IsActiveAtIn(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      *time.Time
+		at       time.Time
+		loc      *time.Location
+		expected bool
+	}{
+		{
+			name:     "started at local midnight on the spring forward day",
+			start:    date(2025, time.March, 30),
+			at:       time.Date(2025, time.March, 30, 0, 30, 0, 0, paris),
+			loc:      paris,
+			expected: true,
+		},
+		{
+			name:     "same instant is still the day before in UTC",
+			start:    date(2025, time.March, 30),
+			at:       time.Date(2025, time.March, 30, 0, 30, 0, 0, paris),
+			loc:      time.UTC,
+			expected: false,
+		},
+		{
+			name:     "after the skipped hour",
+			start:    date(2025, time.March, 30),
+			at:       time.Date(2025, time.March, 30, 3, 30, 0, 0, paris),
+			loc:      paris,
+			expected: true,
+		},
+		{
+			name:     "last minute before the end on the fall back day",
+			start:    date(2025, time.January, 1),
+			end:      x.P(date(2025, time.October, 26)),
+			at:       time.Date(2025, time.October, 25, 23, 59, 0, 0, paris),
+			loc:      paris,
+			expected: true,
+		},
+		{
+			name:     "ended at local midnight on the fall back day",
+			start:    date(2025, time.January, 1),
+			end:      x.P(date(2025, time.October, 26)),
+			at:       time.Date(2025, time.October, 26, 0, 0, 0, 0, paris),
+			loc:      paris,
+			expected: false,
+		},
+		{
+			name:     "not started yet the evening before in New York",
+			start:    date(2025, time.March, 9),
+			at:       time.Date(2025, time.March, 8, 23, 30, 0, 0, newYork),
+			loc:      newYork,
+			expected: false,
+		},
+		{
+			name:     "started when it is already the next day in UTC",
+			start:    date(2025, time.March, 9),
+			at:       time.Date(2025, time.March, 8, 23, 30, 0, 0, newYork),
+			loc:      time.UTC,
+			expected: true,
+		},
+		{
+			name:     "started after the New York spring forward",
+			start:    date(2025, time.March, 9),
+			at:       time.Date(2025, time.March, 9, 3, 0, 0, 0, newYork),
+			loc:      newYork,
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := newAnchoredSubscription(tt.start, tt.end, subscription.MonthlyRecurrency, nil, nil)
+
+			assert.Equal(t, tt.expected, sub.IsActiveAtIn(tt.at, tt.loc))
+		})
+	}
+}
+
+func TestSubscription_GetNextRenewalDateIn(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	newYork := mustLoadLocation(t, "America/New_York")
+	auckland := mustLoadLocation(t, "Pacific/Auckland")
+
+	tests := []struct {
+		name       string
+		start      time.Time
+		recurrency subscription.RecurrencyType
+		interval   *subscription.Interval
+		loc        *time.Location
+	}{
+		{
+			name:       "yearly from a daylight saving change in Paris",
+			start:      date(2021, time.March, 28),
+			recurrency: subscription.YearlyRecurrency,
+			loc:        paris,
+		},
+		{
+			name:       "weekly across daylight saving changes in New York",
+			start:      date(2024, time.November, 3),
+			recurrency: subscription.CustomRecurrency,
+			interval:   subscription.NewInterval(subscription.WeekIntervalUnit, 1),
+			loc:        newYork,
+		},
+		{
+			name:       "monthly from a leap day in Auckland",
+			start:      date(2020, time.February, 29),
+			recurrency: subscription.MonthlyRecurrency,
+			loc:        auckland,
+		},
+		{
+			name:       "monthly in UTC",
+			start:      date(2020, time.January, 31),
+			recurrency: subscription.MonthlyRecurrency,
+			loc:        time.UTC,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := newAnchoredSubscription(tt.start, nil, tt.recurrency, tt.interval, nil)
+
+			next := sub.GetNextRenewalDateIn(tt.loc)
+
+			require.NotNil(t, next)
+			assert.Equal(t, tt.loc, next.Location())
+			// Renewals happen at local midnight whatever the offset of that day
+			assert.Equal(t, 0, next.Hour())
+			assert.Equal(t, 0, next.Minute())
+			assert.True(t, next.After(time.Now()))
+
+			// The renewal is the first billing date that is not before the wall clock of the timezone
+			now := time.Now().In(tt.loc)
+			wallClock := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), now.Minute(), now.Second(), 0,
+				time.UTC)
+			var expected time.Time
+			for _, d := range sub.BillingDates(wallClock.AddDate(1, 1, 0)) {
+				if !d.Before(wallClock) {
+					expected = d
+					break
+				}
+			}
+			assert.Equal(t, expected.Year(), next.Year())
+			assert.Equal(t, expected.Month(), next.Month())
+			assert.Equal(t, expected.Day(), next.Day())
+		})
+	}
+}
+
+func TestSubscription_CancellationDeadlineIn(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	now := time.Now().UTC()
+	sub := newAnchoredSubscription(date(now.Year()-1, time.March, 31), nil, subscription.MonthlyRecurrency, nil, nil)
+	sub.SetContract(subscription.NewContract(nil, true, x.P(int32(7))))
+
+	next := sub.GetNextRenewalDateIn(paris)
+	require.NotNil(t, next)
+	deadline := sub.CancellationDeadlineIn(paris)
+	require.NotNil(t, deadline)
+
+	// The deadline is a local midnight too, even when a daylight saving change happens within the notice period
+	if next.AddDate(0, 0, -7).After(time.Now()) {
+		assert.True(t, deadline.Equal(next.AddDate(0, 0, -7)))
+	}
+	assert.Equal(t, 0, deadline.Hour())
+}
+
+func TestSubscription_UpcomingRenewalDatesIn(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	now := time.Now().UTC()
+	start := date(now.Year()-1, time.January, 15)
+
+	t.Run("returns the next renewals at local midnight", func(t *testing.T) {
+		sub := newAnchoredSubscription(start, nil, subscription.MonthlyRecurrency, nil, nil)
+
+		dates := sub.UpcomingRenewalDatesIn(4, paris)
+
+		require.Len(t, dates, 4)
+		next := sub.GetNextRenewalDateIn(paris)
+		require.NotNil(t, next)
+		assert.True(t, dates[0].Equal(*next))
+		for i, d := range dates {
+			assert.Equal(t, paris, d.Location())
+			assert.Equal(t, 0, d.Hour())
+			assert.Equal(t, 15, d.Day())
+			if i > 0 {
+				assert.True(t, d.After(dates[i-1]))
+			}
+		}
+	})
+
+	t.Run("stops at the end date", func(t *testing.T) {
+		endDate := date(now.Year(), now.Month(), 1).AddDate(0, 2, 0)
+		sub := newAnchoredSubscription(start, &endDate, subscription.MonthlyRecurrency, nil, nil)
+
+		dates := sub.UpcomingRenewalDatesIn(12, paris)
+
+		assert.NotEmpty(t, dates)
+		assert.LessOrEqual(t, len(dates), 2)
+		for _, d := range dates {
+			assert.True(t, d.Before(inParis(endDate, paris)))
+		}
+	})
+
+	t.Run("stops at the commitment end without auto renew", func(t *testing.T) {
+		sub := newAnchoredSubscription(start, nil, subscription.MonthlyRecurrency, nil, nil)
+		sub.SetContract(subscription.NewContract(x.P(int32(24)), false, nil))
+		commitmentEnd := start.AddDate(0, 24, 0)
+
+		dates := sub.UpcomingRenewalDatesIn(24, paris)
+
+		assert.NotEmpty(t, dates)
+		assert.Less(t, len(dates), 24)
+		for _, d := range dates {
+			assert.False(t, d.After(inParis(commitmentEnd, paris)))
+		}
+	})
+}
+
+func inParis(d time.Time, paris *time.Location) time.Time {
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, paris)
+}
+
+func TestSubscription_UpcomingChargesIn(t *testing.T) {
+	now := time.Now().UTC()
+	firstMonth := date(now.Year(), now.Month(), 1)
+	start := firstMonth.AddDate(0, -1, 0)
+	sub := subscription.NewSubscription(
+		types.NewSubscriptionID(), nil, subscription.NewFreeTrial(start, firstMonth.AddDate(0, 2, 0)), nil,
+		types.NewProviderID(), subscription.NewPrice(currency.NewAmount(10, currency.EUR)), nil, nil,
+		types.NewPersonalOwner(types.UserID("user-1")), nil, nil, nil, nil, nil,
+		start, nil, nil, subscription.MonthlyRecurrency, nil, nil, time.Now(), time.Now())
+	require.NoError(t, sub.AddPriceChange(firstMonth.AddDate(0, 3, 0), currency.NewAmount(15, currency.EUR)))
+
+	charges := sub.UpcomingChargesIn(firstMonth.AddDate(0, 5, 0), time.UTC)
+
+	// The renewals during the free trial are not charged
+	require.Len(t, charges, 3)
+	assert.Equal(t, firstMonth.AddDate(0, 2, 0), charges[0].DueDate)
+	assert.Equal(t, 10.0, charges[0].Amount.Value())
+	assert.Equal(t, firstMonth.AddDate(0, 3, 0), charges[1].DueDate)
+	assert.Equal(t, 15.0, charges[1].Amount.Value())
+	assert.Equal(t, firstMonth.AddDate(0, 4, 0), charges[2].DueDate)
+	assert.Equal(t, 15.0, charges[2].Amount.Value())
+}
@@ -0,0 +1,99 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/pkg/x/validation"
+)
+
+// Contract is the commitment attached to a subscription
+type Contract interface {
+	entity.ETagEntity
+
+	// MinimumTerm is the number of months the subscription cannot be cancelled for, nil when there is no commitment
+	MinimumTerm() *int32
+	// AutoRenew is true when the subscription renews by itself at the end of each period
+	AutoRenew() bool
+	// NoticePeriod is the number of days before a renewal the cancellation must be requested, nil when none
+	NoticePeriod() *int32
+	// CommitmentEndDate returns the end of the minimum term for a subscription started at startDate
+	CommitmentEndDate(startDate time.Time) *time.Time
+
+	GetValidationErrors() validation.Errors
+}
+
+func NewContract(
+	minimumTerm *int32,
+	autoRenew bool,
+	noticePeriod *int32) Contract {
+	return &contract{
+		minimumTerm:  minimumTerm,
+		autoRenew:    autoRenew,
+		noticePeriod: noticePeriod,
+	}
+}
+
+type contract struct {
+	minimumTerm  *int32
+	autoRenew    bool
+	noticePeriod *int32
+}
+
+func (c contract) MinimumTerm() *int32 {
+	return c.minimumTerm
+}
+
+func (c contract) AutoRenew() bool {
+	return c.autoRenew
+}
+
+func (c contract) NoticePeriod() *int32 {
+	return c.noticePeriod
+}
+
+func (c contract) CommitmentEndDate(startDate time.Time) *time.Time {
+	if c.minimumTerm == nil {
+		return nil
+	}
+	end := startDate.AddDate(0, int(*c.minimumTerm), 0)
+	return &end
+}
+
+func (c contract) ETagFields() []interface{} {
+	fields := []interface{}{
+		c.autoRenew,
+	}
+
+	if c.minimumTerm != nil {
+		fields = append(fields, "minimumTerm", *c.minimumTerm)
+	}
+
+	if c.noticePeriod != nil {
+		fields = append(fields, "noticePeriod", *c.noticePeriod)
+	}
+
+	return fields
+}
+
+func (c contract) ETag() string {
+	return entity.CalculateETag(c)
+}
+
+func (c contract) GetValidationErrors() validation.Errors {
+	var errors validation.Errors
+
+	if c.minimumTerm != nil && *c.minimumTerm <= 0 {
+		errors = append(errors, validation.NewError("minimumTerm", "MinimumTerm must be greater than 0"))
+	}
+
+	if c.noticePeriod != nil && *c.noticePeriod < 0 {
+		errors = append(errors, validation.NewError("noticePeriod", "NoticePeriod cannot be negative"))
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+
+	return nil
+}
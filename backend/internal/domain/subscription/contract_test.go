@@ -0,0 +1,112 @@
+package subscription_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func TestSubscription_CancellationDeadline(t *testing.T) {
+	t.Run("deadline is the next renewal minus the notice period", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -3, 20))
+		sub.SetContract(subscription.NewContract(nil, true, x.P(int32(7))))
+
+		next := sub.GetNextRenewalDate()
+		require.NotNil(t, next)
+		deadline := sub.CancellationDeadline()
+		require.NotNil(t, deadline)
+		assert.True(t, deadline.Equal(next.AddDate(0, 0, -7)))
+		assert.True(t, sub.IsDirty())
+	})
+
+	t.Run("renewals within the minimum term cannot be cancelled", func(t *testing.T) {
+		start := time.Now().AddDate(0, -2, 0)
+		sub := newMonthlySubscription(10, start)
+		sub.SetContract(subscription.NewContract(x.P(int32(12)), true, x.P(int32(30))))
+
+		deadline := sub.CancellationDeadline()
+		require.NotNil(t, deadline)
+		assert.True(t, deadline.Equal(start.AddDate(0, 12, 0).AddDate(0, 0, -30)))
+	})
+
+	t.Run("a passed deadline rolls over to the following renewal", func(t *testing.T) {
+		now := time.Now()
+		start := time.Date(now.Year(), now.Month()-3, 1, 0, 0, 0, 0, time.UTC)
+		sub := newMonthlySubscription(10, start)
+		sub.SetContract(subscription.NewContract(nil, true, x.P(int32(40))))
+
+		next := sub.GetNextRenewalDate()
+		require.NotNil(t, next)
+		deadline := sub.CancellationDeadline()
+		require.NotNil(t, deadline)
+		renewal := deadline.AddDate(0, 0, 40)
+		assert.False(t, deadline.Before(now))
+		assert.True(t, renewal.After(*next))
+		assert.Equal(t, 1, renewal.Day())
+	})
+
+	t.Run("no deadline without auto renewal", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -2, 0))
+		sub.SetContract(subscription.NewContract(x.P(int32(6)), false, x.P(int32(30))))
+
+		assert.Nil(t, sub.CancellationDeadline())
+		assert.NotNil(t, sub.GetNextRenewalDate())
+	})
+
+	t.Run("no renewal once the commitment ends without auto renewal", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -12, 0))
+		sub.SetContract(subscription.NewContract(x.P(int32(6)), false, nil))
+
+		assert.Nil(t, sub.GetNextRenewalDate())
+	})
+
+	t.Run("no deadline while paused indefinitely", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -2, 0))
+		sub.SetContract(subscription.NewContract(nil, true, x.P(int32(7))))
+
+		require.NoError(t, sub.Pause(time.Now().Add(-time.Hour), nil))
+
+		assert.Nil(t, sub.CancellationDeadline())
+	})
+
+	t.Run("no deadline without contract", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -2, 0))
+
+		assert.Nil(t, sub.Contract())
+		assert.Nil(t, sub.CancellationDeadline())
+	})
+}
+
+func TestContract_Validation(t *testing.T) {
+	t.Run("valid contract", func(t *testing.T) {
+		c := subscription.NewContract(x.P(int32(12)), true, x.P(int32(0)))
+
+		assert.Nil(t, c.GetValidationErrors())
+	})
+
+	t.Run("rejects invalid terms", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -2, 0))
+		sub.SetContract(subscription.NewContract(x.P(int32(0)), true, x.P(int32(-1))))
+
+		errs := sub.GetValidationErrors()
+		require.NotNil(t, errs)
+		assert.Len(t, errs, 2)
+	})
+
+	t.Run("contract changes the etag", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -2, 0))
+		sub.SetContract(subscription.NewContract(x.P(int32(12)), true, x.P(int32(30))))
+		etag := sub.ETag()
+
+		sub.SetContract(subscription.NewContract(x.P(int32(12)), true, x.P(int32(30))))
+		assert.Equal(t, etag, sub.ETag())
+
+		sub.SetContract(subscription.NewContract(x.P(int32(12)), true, x.P(int32(14))))
+		assert.NotEqual(t, etag, sub.ETag())
+	})
+}
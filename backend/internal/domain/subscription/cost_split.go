@@ -0,0 +1,205 @@
+package subscription
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/decimal"
+	"github.com/mistribe/subtracker/pkg/x/validation"
+)
+
+type CostSplitType string
+
+const (
+	UnknownCostSplit    CostSplitType = "unknown"
+	EqualCostSplit      CostSplitType = "equal"
+	PercentageCostSplit CostSplitType = "percentage"
+	FixedCostSplit      CostSplitType = "fixed"
+)
+
+// costSplitTolerance absorbs rounding when checking that shares add up
+var costSplitTolerance = decimal.MustParse("0.01")
+
+var oneHundred = decimal.NewFromInt(100)
+
+func (c CostSplitType) String() string {
+	return string(c)
+}
+
+func ParseCostSplitType(input string) (CostSplitType, error) {
+	switch input {
+	case string(EqualCostSplit):
+		return EqualCostSplit, nil
+	case string(PercentageCostSplit):
+		return PercentageCostSplit, nil
+	case string(FixedCostSplit):
+		return FixedCostSplit, nil
+	default:
+		return UnknownCostSplit, ErrUnknownCostSplitType
+	}
+}
+
+func MustParseCostSplitType(input string) CostSplitType {
+	t, err := ParseCostSplitType(input)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// CostShare is the part of the cost a family member pays.
+// Value is a percentage for a percentage split, an amount in the subscription currency per billing period
+// for a fixed split and is ignored for an equal split.
+type CostShare struct {
+	MemberId types.FamilyMemberID
+	Value    decimal.Decimal
+}
+
+// CostSplit defines how the cost of a subscription is shared between family members
+type CostSplit interface {
+	entity.ETagEntity
+
+	Type() CostSplitType
+	// Shares are the members taking part in the split, an equal split without shares uses the family users
+	Shares() []CostShare
+	// Split distributes amount between the members, fallback is used by an equal split without shares.
+	// Fixed shares keep their ratio when amount differs from their sum, e.g. during a promotion.
+	Split(amount currency.Amount, fallback []types.FamilyMemberID) map[types.FamilyMemberID]currency.Amount
+
+	GetValidationErrors() validation.Errors
+}
+
+func NewCostSplit(splitType CostSplitType, shares []CostShare) CostSplit {
+	return &costSplit{
+		splitType: splitType,
+		shares:    shares,
+	}
+}
+
+type costSplit struct {
+	splitType CostSplitType
+	shares    []CostShare
+}
+
+func (c costSplit) Type() CostSplitType {
+	return c.splitType
+}
+
+func (c costSplit) Shares() []CostShare {
+	return c.shares
+}
+
+func (c costSplit) total() decimal.Decimal {
+	total := decimal.Zero
+	for _, share := range c.shares {
+		total = total.Add(share.Value)
+	}
+	return total
+}
+
+func (c costSplit) Split(
+	amount currency.Amount,
+	fallback []types.FamilyMemberID) map[types.FamilyMemberID]currency.Amount {
+	if amount == nil || !amount.IsValid() {
+		return nil
+	}
+
+	parts := make(map[types.FamilyMemberID]currency.Amount)
+	switch c.splitType {
+	case EqualCostSplit:
+		members := fallback
+		if len(c.shares) > 0 {
+			members = make([]types.FamilyMemberID, 0, len(c.shares))
+			for _, share := range c.shares {
+				members = append(members, share.MemberId)
+			}
+		}
+		if len(members) == 0 {
+			return nil
+		}
+		part := amount.Div(decimal.NewFromInt(int64(len(members))))
+		for _, member := range members {
+			parts[member] = part
+		}
+	case PercentageCostSplit:
+		for _, share := range c.shares {
+			parts[share.MemberId] = amount.Mul(share.Value).Div(oneHundred)
+		}
+	case FixedCostSplit:
+		total := c.total()
+		if !total.IsPositive() {
+			return nil
+		}
+		for _, share := range c.shares {
+			parts[share.MemberId] = amount.Mul(share.Value).Div(total)
+		}
+	default:
+		return nil
+	}
+
+	return parts
+}
+
+func (c costSplit) ETagFields() []interface{} {
+	fields := []interface{}{
+		c.splitType.String(),
+	}
+
+	// Shares are loaded in no particular order, sort them so the etag does not depend on it
+	shares := slices.Clone(c.shares)
+	slices.SortFunc(shares, func(a, b CostShare) int {
+		return strings.Compare(a.MemberId.String(), b.MemberId.String())
+	})
+	for _, share := range shares {
+		fields = append(fields, share.MemberId.String(), share.Value.Float64())
+	}
+
+	return fields
+}
+
+func (c costSplit) ETag() string {
+	return entity.CalculateETag(c)
+}
+
+func (c costSplit) GetValidationErrors() validation.Errors {
+	var errors validation.Errors
+
+	if c.splitType != EqualCostSplit && c.splitType != PercentageCostSplit && c.splitType != FixedCostSplit {
+		errors = append(errors, validation.NewError("costSplit", "CostSplit type is unknown"))
+	}
+
+	members := make(map[types.FamilyMemberID]struct{}, len(c.shares))
+	for _, share := range c.shares {
+		if _, ok := members[share.MemberId]; ok {
+			errors = append(errors, validation.NewError("costSplit", "CostSplit cannot contain a member twice"))
+			break
+		}
+		members[share.MemberId] = struct{}{}
+	}
+
+	switch c.splitType {
+	case PercentageCostSplit, FixedCostSplit:
+		if len(c.shares) == 0 {
+			errors = append(errors, validation.NewError("costSplit", "CostSplit requires at least one share"))
+			break
+		}
+		for _, share := range c.shares {
+			if !share.Value.IsPositive() {
+				errors = append(errors, validation.NewError("costSplit", "CostSplit shares must be greater than 0"))
+				break
+			}
+		}
+		if c.splitType == PercentageCostSplit && c.total().Sub(oneHundred).Abs().GreaterThan(costSplitTolerance) {
+			errors = append(errors, validation.NewError("costSplit", "CostSplit percentages must add up to 100"))
+		}
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package subscription_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/decimal"
+)
+
+func newFamilySubscription(amount float64, members ...types.FamilyMemberID) subscription.Subscription {
+	return subscription.NewSubscription(
+		types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+		subscription.NewPrice(currency.NewAmount(amount, currency.EUR)), nil, nil,
+		types.NewFamilyOwner(types.NewFamilyID()), nil, nil, nil, members, nil,
+		time.Now().AddDate(0, -2, 0), nil, nil, subscription.MonthlyRecurrency, nil, nil, time.Now(), time.Now())
+}
+
+func TestCostSplit_Split(t *testing.T) {
+	alice := types.NewFamilyMemberID()
+	bob := types.NewFamilyMemberID()
+	amount := currency.NewAmount(30, currency.EUR)
+
+	t.Run("equal split without shares uses the fallback members", func(t *testing.T) {
+		split := subscription.NewCostSplit(subscription.EqualCostSplit, nil)
+
+		parts := split.Split(amount, []types.FamilyMemberID{alice, bob})
+
+		require.Len(t, parts, 2)
+		assert.Equal(t, 15.0, parts[alice].Value())
+		assert.Equal(t, 15.0, parts[bob].Value())
+		assert.Nil(t, split.Split(amount, nil))
+	})
+
+	t.Run("percentage split", func(t *testing.T) {
+		split := subscription.NewCostSplit(subscription.PercentageCostSplit, []subscription.CostShare{
+			{MemberId: alice, Value: decimal.NewFromInt(70)},
+			{MemberId: bob, Value: decimal.NewFromInt(30)},
+		})
+
+		parts := split.Split(amount, nil)
+
+		assert.InDelta(t, 21.0, parts[alice].Value(), 0.0001)
+		assert.InDelta(t, 9.0, parts[bob].Value(), 0.0001)
+		assert.Equal(t, currency.EUR, parts[alice].Currency())
+	})
+
+	t.Run("fixed split keeps its ratio for another amount", func(t *testing.T) {
+		split := subscription.NewCostSplit(subscription.FixedCostSplit, []subscription.CostShare{
+			{MemberId: alice, Value: decimal.NewFromInt(20)},
+			{MemberId: bob, Value: decimal.NewFromInt(10)},
+		})
+
+		parts := split.Split(currency.NewAmount(15, currency.EUR), nil)
+
+		assert.Equal(t, 10.0, parts[alice].Value())
+		assert.Equal(t, 5.0, parts[bob].Value())
+	})
+}
+
+func TestCostSplit_Validation(t *testing.T) {
+	alice := types.NewFamilyMemberID()
+	bob := types.NewFamilyMemberID()
+
+	t.Run("valid splits", func(t *testing.T) {
+		assert.Nil(t, subscription.NewCostSplit(subscription.EqualCostSplit, nil).GetValidationErrors())
+		assert.Nil(t, subscription.NewCostSplit(subscription.PercentageCostSplit, []subscription.CostShare{
+			{MemberId: alice, Value: decimal.MustParse("33.33")},
+			{MemberId: bob, Value: decimal.MustParse("66.67")},
+		}).GetValidationErrors())
+	})
+
+	t.Run("percentages must add up to 100", func(t *testing.T) {
+		errs := subscription.NewCostSplit(subscription.PercentageCostSplit, []subscription.CostShare{
+			{MemberId: alice, Value: decimal.NewFromInt(50)},
+			{MemberId: bob, Value: decimal.NewFromInt(40)},
+		}).GetValidationErrors()
+
+		assert.Len(t, errs, 1)
+	})
+
+	t.Run("rejects duplicated members and empty shares", func(t *testing.T) {
+		assert.NotNil(t, subscription.NewCostSplit(subscription.FixedCostSplit, []subscription.CostShare{
+			{MemberId: alice, Value: decimal.NewFromInt(5)},
+			{MemberId: alice, Value: decimal.NewFromInt(5)},
+		}).GetValidationErrors())
+		assert.NotNil(t, subscription.NewCostSplit(subscription.FixedCostSplit, nil).GetValidationErrors())
+		assert.NotNil(t, subscription.NewCostSplit(subscription.FixedCostSplit, []subscription.CostShare{
+			{MemberId: alice, Value: decimal.NewFromInt(0)},
+		}).GetValidationErrors())
+	})
+
+	t.Run("etag does not depend on the order of the shares", func(t *testing.T) {
+		first := subscription.NewCostSplit(subscription.FixedCostSplit, []subscription.CostShare{
+			{MemberId: alice, Value: decimal.NewFromInt(5)},
+			{MemberId: bob, Value: decimal.NewFromInt(10)},
+		})
+		second := subscription.NewCostSplit(subscription.FixedCostSplit, []subscription.CostShare{
+			{MemberId: bob, Value: decimal.NewFromInt(10)},
+			{MemberId: alice, Value: decimal.NewFromInt(5)},
+		})
+
+		assert.Equal(t, first.ETag(), second.ETag())
+	})
+}
+
+func TestSubscription_SetCostSplit(t *testing.T) {
+	alice := types.NewFamilyMemberID()
+	bob := types.NewFamilyMemberID()
+
+	t.Run("member shares follow the recurrency", func(t *testing.T) {
+		sub := newFamilySubscription(12, alice, bob)
+
+		require.NoError(t, sub.SetCostSplit(subscription.NewCostSplit(subscription.EqualCostSplit, nil)))
+
+		monthly := sub.GetMemberShares(subscription.MonthlyRecurrency)
+		yearly := sub.GetMemberShares(subscription.YearlyRecurrency)
+		assert.Equal(t, 6.0, monthly[alice].Value())
+		assert.Equal(t, 72.0, yearly[bob].Value())
+		assert.Nil(t, sub.GetValidationErrors())
+		assert.True(t, sub.IsDirty())
+	})
+
+	t.Run("requires a family owner", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -2, 0))
+
+		err := sub.SetCostSplit(subscription.NewCostSplit(subscription.EqualCostSplit, nil))
+
+		assert.ErrorIs(t, err, subscription.ErrCostSplitNeedFamily)
+		assert.Nil(t, sub.CostSplit())
+	})
+
+	t.Run("fixed shares must add up to the price", func(t *testing.T) {
+		sub := newFamilySubscription(12, alice, bob)
+
+		err := sub.SetCostSplit(subscription.NewCostSplit(subscription.FixedCostSplit, []subscription.CostShare{
+			{MemberId: alice, Value: decimal.NewFromInt(5)},
+			{MemberId: bob, Value: decimal.NewFromInt(5)},
+		}))
+
+		assert.ErrorIs(t, err, subscription.ErrCostSplitMismatch)
+	})
+
+	t.Run("members must be family users", func(t *testing.T) {
+		sub := newFamilySubscription(12, alice)
+
+		require.NoError(t, sub.SetCostSplit(subscription.NewCostSplit(subscription.PercentageCostSplit,
+			[]subscription.CostShare{
+				{MemberId: alice, Value: decimal.NewFromInt(50)},
+				{MemberId: bob, Value: decimal.NewFromInt(50)},
+			})))
+
+		assert.NotNil(t, sub.GetValidationErrors())
+	})
+
+	t.Run("removing the split", func(t *testing.T) {
+		sub := newFamilySubscription(12, alice, bob)
+		require.NoError(t, sub.SetCostSplit(subscription.NewCostSplit(subscription.EqualCostSplit, nil)))
+		etag := sub.ETag()
+
+		require.NoError(t, sub.SetCostSplit(nil))
+
+		assert.Nil(t, sub.CostSplit())
+		assert.Nil(t, sub.GetMemberShares(subscription.MonthlyRecurrency))
+		assert.NotEqual(t, etag, sub.ETag())
+	})
+}
@@ -13,3 +13,29 @@ func monthsBetweenCalendar(a, b time.Time) int {
 	}
 	return (b.Year()-a.Year())*12 + int(b.Month()-a.Month())
 }
+
+// Subscription dates are calendar dates: their UTC wall clock is the one entered by the user,
+// and they happen at that wall clock in the timezone of the account looking at them.
+
+// floatingTime returns the wall clock of t in loc as a UTC time, so it can be compared with subscription dates
+func floatingTime(t time.Time, loc *time.Location) time.Time {
+	l := t.In(loc)
+	return time.Date(l.Year(), l.Month(), l.Day(), l.Hour(), l.Minute(), l.Second(), l.Nanosecond(), time.UTC)
+}
+
+// inLocation returns the instant at which the subscription date d happens in loc
+func inLocation(d time.Time, loc *time.Location) time.Time {
+	u := d.UTC()
+	return time.Date(u.Year(), u.Month(), u.Day(), u.Hour(), u.Minute(), u.Second(), u.Nanosecond(), loc)
+}
+
+// addMonthsClamped adds months to t and moves it to the given day of the month,
+// clamped to the last day of shorter months (the 31st becomes the 30th in April and the 28th or 29th in February)
+func addMonthsClamped(t time.Time, months int, day int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(months), 1,
+		t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := time.Date(firstOfMonth.Year(), firstOfMonth.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+
+	return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), min(day, lastDay),
+		t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
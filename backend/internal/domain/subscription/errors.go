@@ -11,4 +11,29 @@ var (
 	ErrUnknownRecurrencyType          = ex.NewInvalidValue("unknown recurrency type")
 	ErrActiveSubscriptionLimitReached = ex.NewInvalidValue("active subscription limit reached")
 	ErrPayerNeedFamily                = ex.NewInvalidValue("subscription need to be owned by a family to set a payer")
+	ErrImportNotAllowed               = ex.NewUnauthorized("subscription import is not available for your plan")
+	ErrPriceChangeAlreadyExists       = ex.NewAlreadyExists("a price change already exists at this date")
+	ErrPriceChangeBeforeStartDate     = ex.NewInvalidValue("price change cannot be effective before the subscription start date")
+	ErrPriceChangeCurrencyMismatch    = ex.NewInvalidValue("price change must use the subscription currency")
+	ErrInvalidPriceChangeAmount       = ex.NewInvalidValue("price change amount must be greater than 0")
+	ErrPauseBeforeStartDate           = ex.NewInvalidValue("pause cannot start before the subscription start date")
+	ErrPauseAfterEndDate              = ex.NewInvalidValue("pause cannot start after the subscription end date")
+	ErrInvalidPauseRange              = ex.NewInvalidValue("pause end date must be after its start date")
+	ErrPauseOverlap                   = ex.NewAlreadyExists("subscription is already paused during this period")
+	ErrSubscriptionNotPaused          = ex.NewInvalidOperation("subscription is not paused")
+	ErrPromotionBeforeStartDate       = ex.NewInvalidValue("promotion cannot start before the subscription start date")
+	ErrInvalidPromotionRange          = ex.NewInvalidValue("promotion end date must be after its start date")
+	ErrPromotionDurationRequired      = ex.NewInvalidValue("promotion requires either a duration or an end date")
+	ErrInvalidPromotionAmount         = ex.NewInvalidValue("promotion amount cannot be negative")
+	ErrPromotionCurrencyMismatch      = ex.NewInvalidValue("promotion must use the subscription currency")
+	ErrUnknownCostSplitType           = ex.NewInvalidValue("unknown cost split type")
+	ErrUnknownIntervalUnit            = ex.NewInvalidValue("unknown recurrency interval unit")
+	ErrCostSplitNeedFamily            = ex.NewInvalidValue("subscription need to be owned by a family to split its cost")
+	ErrCostSplitMismatch              = ex.NewInvalidValue("fixed shares must add up to the subscription price")
+	ErrPromotionOverlap               = ex.NewAlreadyExists("a promotion already applies during this period")
+	ErrUnknownSpendingBucket          = ex.NewInvalidValue("unknown spending bucket")
+	ErrUnknownSpendingDimension       = ex.NewInvalidValue("unknown spending dimension")
+	ErrInvalidSpendingRange           = ex.NewInvalidValue("spending range end must be after its start")
+	ErrSpendingRangeTooLarge          = ex.NewInvalidValue("spending range has too many buckets")
+	ErrInvalidForecastHorizon         = ex.NewInvalidValue("forecast horizon must be between 1 and 36 months")
 )
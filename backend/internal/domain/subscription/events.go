@@ -0,0 +1,59 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/decimal"
+)
+
+const (
+	SubscriptionCreatedEventName = "subscription.created"
+	PriceChangedEventName        = "subscription.price_changed"
+)
+
+// SubscriptionCreated is recorded when a new subscription is built, it is discarded when an existing
+// subscription is loaded
+type SubscriptionCreated struct {
+	SubscriptionId types.SubscriptionID
+	ProviderId     types.ProviderID
+}
+
+func (SubscriptionCreated) EventName() string {
+	return SubscriptionCreatedEventName
+}
+
+// PriceChanged is recorded when the price of a subscription is corrected or a price change is added
+type PriceChanged struct {
+	SubscriptionId types.SubscriptionID
+	// EffectiveFrom is the date the new amount applies from
+	EffectiveFrom time.Time
+	// Previous is the amount that applied at EffectiveFrom before the change, nil when the subscription had none
+	Previous *decimal.Decimal
+	Amount   decimal.Decimal
+	// Currency is the ISO 4217 code of both amounts
+	Currency string
+}
+
+func (PriceChanged) EventName() string {
+	return PriceChangedEventName
+}
+
+func newPriceChanged(
+	subscriptionId types.SubscriptionID,
+	effectiveFrom time.Time,
+	previous currency.Amount,
+	amount currency.Amount) PriceChanged {
+	event := PriceChanged{
+		SubscriptionId: subscriptionId,
+		EffectiveFrom:  effectiveFrom,
+		Amount:         amount.Decimal(),
+		Currency:       amount.Currency().String(),
+	}
+	if previous != nil && previous.IsValid() {
+		value := previous.Decimal()
+		event.Previous = &value
+	}
+	return event
+}
@@ -0,0 +1,87 @@
+package subscription_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+)
+
+func TestSubscription_Events(t *testing.T) {
+	t.Run("a new subscription records its creation", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -1, 0))
+
+		require.Len(t, sub.Events(), 1)
+		created, ok := sub.Events()[0].(subscription.SubscriptionCreated)
+		require.True(t, ok)
+		assert.Equal(t, sub.Id(), created.SubscriptionId)
+		assert.Equal(t, sub.ProviderId(), created.ProviderId)
+		assert.Equal(t, subscription.SubscriptionCreatedEventName, created.EventName())
+	})
+
+	t.Run("clean discards the recorded events", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -1, 0))
+
+		sub.Clean()
+
+		assert.Empty(t, sub.Events())
+	})
+
+	t.Run("adding a price change records the previous amount", func(t *testing.T) {
+		start := time.Now().AddDate(0, -6, 0)
+		sub := newMonthlySubscription(10, start)
+		sub.Clean()
+
+		raisedAt := start.AddDate(0, 3, 0)
+		require.NoError(t, sub.AddPriceChange(raisedAt, currency.NewAmount(12, currency.EUR)))
+
+		require.Len(t, sub.Events(), 1)
+		changed, ok := sub.Events()[0].(subscription.PriceChanged)
+		require.True(t, ok)
+		assert.Equal(t, sub.Id(), changed.SubscriptionId)
+		assert.True(t, changed.EffectiveFrom.Equal(raisedAt))
+		require.NotNil(t, changed.Previous)
+		assert.Equal(t, "10", changed.Previous.String())
+		assert.Equal(t, "12", changed.Amount.String())
+		assert.Equal(t, "EUR", changed.Currency)
+	})
+
+	t.Run("a rejected price change records nothing", func(t *testing.T) {
+		start := time.Now().AddDate(0, -6, 0)
+		sub := newMonthlySubscription(10, start)
+		sub.Clean()
+
+		assert.Error(t, sub.AddPriceChange(start.AddDate(0, 0, -1), currency.NewAmount(12, currency.EUR)))
+
+		assert.Empty(t, sub.Events())
+	})
+
+	t.Run("editing the price records the change in effect", func(t *testing.T) {
+		start := time.Now().AddDate(0, -6, 0)
+		sub := newMonthlySubscription(10, start)
+		sub.Clean()
+
+		sub.SetPrice(currency.NewAmount(11, currency.EUR))
+
+		require.Len(t, sub.Events(), 1)
+		changed, ok := sub.Events()[0].(subscription.PriceChanged)
+		require.True(t, ok)
+		assert.True(t, changed.EffectiveFrom.Equal(start))
+		require.NotNil(t, changed.Previous)
+		assert.Equal(t, "10", changed.Previous.String())
+		assert.Equal(t, "11", changed.Amount.String())
+	})
+
+	t.Run("setting the same price records nothing", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -6, 0))
+		sub.Clean()
+
+		sub.SetPrice(currency.NewAmount(10, currency.EUR))
+
+		assert.Empty(t, sub.Events())
+	})
+}
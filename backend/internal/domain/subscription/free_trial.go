@@ -12,6 +12,8 @@ type FreeTrial interface {
 
 	StartDate() time.Time
 	EndDate() time.Time
+	// Covers returns true when t is within the free trial, no charge is due during that time
+	Covers(t time.Time) bool
 
 	GetValidationErrors() validation.Errors
 }
@@ -38,6 +40,10 @@ func (f freeTrial) EndDate() time.Time {
 	return f.endDate
 }
 
+func (f freeTrial) Covers(t time.Time) bool {
+	return !t.Before(f.startDate) && t.Before(f.endDate)
+}
+
 func (f freeTrial) ETagFields() []interface{} {
 	return []interface{}{
 		f.endDate,
@@ -0,0 +1,129 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/pkg/decimal"
+)
+
+// averageDaysPerMonth is the mean month length of the Gregorian calendar (365.2425 / 12)
+const averageDaysPerMonth = 30.436875
+
+var averageDaysPerMonthDecimal = decimal.MustParse("30.436875")
+
+type IntervalUnit string
+
+const (
+	UnknownIntervalUnit IntervalUnit = "unknown"
+	DayIntervalUnit     IntervalUnit = "day"
+	WeekIntervalUnit    IntervalUnit = "week"
+	MonthIntervalUnit   IntervalUnit = "month"
+	YearIntervalUnit    IntervalUnit = "year"
+)
+
+func (u IntervalUnit) String() string {
+	return string(u)
+}
+
+func ParseIntervalUnit(input string) (IntervalUnit, error) {
+	switch input {
+	case string(DayIntervalUnit):
+		return DayIntervalUnit, nil
+	case string(WeekIntervalUnit):
+		return WeekIntervalUnit, nil
+	case string(MonthIntervalUnit):
+		return MonthIntervalUnit, nil
+	case string(YearIntervalUnit):
+		return YearIntervalUnit, nil
+	default:
+		return UnknownIntervalUnit, ErrUnknownIntervalUnit
+	}
+}
+
+func MustParseIntervalUnit(input string) IntervalUnit {
+	u, err := ParseIntervalUnit(input)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// Interval is the time between two renewals of a custom recurrency, e.g. every 2 weeks or every 28 days
+type Interval struct {
+	Unit  IntervalUnit
+	Count int32
+}
+
+func NewInterval(unit IntervalUnit, count int32) *Interval {
+	return &Interval{
+		Unit:  unit,
+		Count: count,
+	}
+}
+
+// Months returns the length of the interval in months, using the average month length for days and weeks
+func (i Interval) Months() float64 {
+	switch i.Unit {
+	case DayIntervalUnit:
+		return float64(i.Count) / averageDaysPerMonth
+	case WeekIntervalUnit:
+		return float64(i.Count) * 7 / averageDaysPerMonth
+	case MonthIntervalUnit:
+		return float64(i.Count)
+	case YearIntervalUnit:
+		return float64(i.Count) * 12
+	default:
+		return 0
+	}
+}
+
+// monthsRatio returns the length of the interval in months as a fraction, so that amounts can be multiplied
+// by the numerator before being divided by the denominator
+func (i Interval) monthsRatio() (numerator decimal.Decimal, denominator decimal.Decimal) {
+	count := decimal.NewFromInt(int64(i.Count))
+	switch i.Unit {
+	case DayIntervalUnit:
+		return count, averageDaysPerMonthDecimal
+	case WeekIntervalUnit:
+		return count.Mul(decimal.NewFromInt(7)), averageDaysPerMonthDecimal
+	case MonthIntervalUnit:
+		return count, decimal.NewFromInt(1)
+	case YearIntervalUnit:
+		return count.Mul(decimal.NewFromInt(12)), decimal.NewFromInt(1)
+	default:
+		return decimal.Zero, decimal.NewFromInt(1)
+	}
+}
+
+// calendarMonths returns the length of the interval in calendar months, 0 for day and week intervals
+func (i Interval) calendarMonths() int {
+	switch i.Unit {
+	case MonthIntervalUnit:
+		return int(i.Count)
+	case YearIntervalUnit:
+		return int(i.Count) * 12
+	default:
+		return 0
+	}
+}
+
+// advance returns the n-th occurrence after from.
+// Month and year intervals fall on the anchor day, clamped to the last day of shorter months.
+// Always offset from the same origin so short months do not shift later occurrences.
+func (i Interval) advance(from time.Time, n int, anchorDay int) time.Time {
+	switch i.Unit {
+	case DayIntervalUnit:
+		return from.AddDate(0, 0, n*int(i.Count))
+	case WeekIntervalUnit:
+		return from.AddDate(0, 0, n*int(i.Count)*7)
+	case MonthIntervalUnit, YearIntervalUnit:
+		return addMonthsClamped(from, n*i.calendarMonths(), anchorDay)
+	default:
+		return from
+	}
+}
+
+// approximateDuration is the average length of the interval, only used to estimate occurrence indexes
+func (i Interval) approximateDuration() time.Duration {
+	return time.Duration(i.Months() * averageDaysPerMonth * float64(24*time.Hour))
+}
@@ -0,0 +1,169 @@
+package subscription_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func newCustomSubscription(amount float64, start time.Time, interval *subscription.Interval) subscription.Subscription {
+	return subscription.NewSubscription(
+		types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+		subscription.NewPrice(currency.NewAmount(amount, currency.EUR)), nil, nil,
+		types.NewPersonalOwner(types.UserID("user-1")), nil, nil, nil, nil, nil,
+		start, nil, nil, subscription.CustomRecurrency, interval, nil, time.Now(), time.Now())
+}
+
+func TestSubscription_CustomRecurrencyAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		interval *subscription.Interval
+		monthly  float64
+		yearly   float64
+	}{
+		{
+			name:     "weekly",
+			amount:   7,
+			interval: subscription.NewInterval(subscription.WeekIntervalUnit, 1),
+			monthly:  30.436875,
+			yearly:   365.2425,
+		},
+		{
+			name:     "bi-weekly",
+			amount:   14,
+			interval: subscription.NewInterval(subscription.WeekIntervalUnit, 2),
+			monthly:  30.436875,
+			yearly:   365.2425,
+		},
+		{
+			name:     "every 28 days",
+			amount:   28,
+			interval: subscription.NewInterval(subscription.DayIntervalUnit, 28),
+			monthly:  30.436875,
+			yearly:   365.2425,
+		},
+		{
+			name:     "every 2 months",
+			amount:   20,
+			interval: subscription.NewInterval(subscription.MonthIntervalUnit, 2),
+			monthly:  10,
+			yearly:   120,
+		},
+		{
+			name:     "every 2 years",
+			amount:   240,
+			interval: subscription.NewInterval(subscription.YearIntervalUnit, 2),
+			monthly:  10,
+			yearly:   120,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := newCustomSubscription(tt.amount, time.Now().AddDate(0, -1, 0), tt.interval)
+
+			assert.InDelta(t, tt.monthly, sub.GetRecurrencyAmount(subscription.MonthlyRecurrency).Value(), 0.0001)
+			assert.InDelta(t, tt.yearly, sub.GetRecurrencyAmount(subscription.YearlyRecurrency).Value(), 0.0001)
+		})
+	}
+}
+
+func TestSubscription_CustomRecurrencyNextRenewalDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval *subscription.Interval
+		elapsed  int
+		next     int
+	}{
+		{name: "weekly", interval: subscription.NewInterval(subscription.WeekIntervalUnit, 1), elapsed: 10, next: 14},
+		{name: "bi-weekly", interval: subscription.NewInterval(subscription.WeekIntervalUnit, 2), elapsed: 15, next: 28},
+		{name: "every 28 days", interval: subscription.NewInterval(subscription.DayIntervalUnit, 28), elapsed: 30,
+			next: 56},
+		{name: "daily", interval: subscription.NewInterval(subscription.DayIntervalUnit, 1), elapsed: 400, next: 400},
+		{name: "not started yet", interval: subscription.NewInterval(subscription.WeekIntervalUnit, 1), elapsed: -3,
+			next: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Start a bit later in the day so no occurrence falls exactly on now
+			start := time.Now().AddDate(0, 0, -tt.elapsed).Add(time.Hour)
+			sub := newCustomSubscription(10, start, tt.interval)
+
+			next := sub.GetNextRenewalDate()
+
+			require.NotNil(t, next)
+			assert.WithinDuration(t, start.AddDate(0, 0, tt.next), *next, 0)
+		})
+	}
+}
+
+func TestSubscription_CustomRecurrencyBillingDates(t *testing.T) {
+	start := time.Date(2025, time.January, 30, 0, 0, 0, 0, time.UTC)
+
+	t.Run("weeks follow the start date", func(t *testing.T) {
+		sub := newCustomSubscription(10, start, subscription.NewInterval(subscription.WeekIntervalUnit, 2))
+
+		dates := sub.BillingDates(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC))
+
+		assert.Equal(t, []time.Time{
+			start,
+			time.Date(2025, time.February, 13, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.February, 27, 0, 0, 0, 0, time.UTC),
+		}, dates)
+	})
+
+	t.Run("paused occurrences are skipped", func(t *testing.T) {
+		sub := newCustomSubscription(10, start, subscription.NewInterval(subscription.DayIntervalUnit, 28))
+		require.NoError(t, sub.Pause(start.AddDate(0, 0, 20), x.P(start.AddDate(0, 0, 30))))
+
+		dates := sub.BillingDates(time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC))
+
+		assert.Equal(t, []time.Time{start, start.AddDate(0, 0, 56)}, dates)
+	})
+}
+
+func TestSubscription_CustomRecurrencyTotalSpent(t *testing.T) {
+	start := time.Now().AddDate(0, 0, -30)
+	sub := newCustomSubscription(5, start, subscription.NewInterval(subscription.WeekIntervalUnit, 1))
+
+	// Four full weeks have elapsed
+	assert.Equal(t, 20.0, sub.GetTotalSpent().Value())
+}
+
+func TestSubscription_CustomRecurrencyValidation(t *testing.T) {
+	start := time.Now().AddDate(0, -1, 0)
+
+	assert.Nil(t, newCustomSubscription(10, start,
+		subscription.NewInterval(subscription.DayIntervalUnit, 28)).GetValidationErrors())
+	assert.NotNil(t, newCustomSubscription(10, start, nil).GetValidationErrors())
+	assert.NotNil(t, newCustomSubscription(10, start,
+		subscription.NewInterval(subscription.WeekIntervalUnit, 0)).GetValidationErrors())
+	assert.NotNil(t, newCustomSubscription(10, start,
+		subscription.NewInterval(subscription.UnknownIntervalUnit, 3)).GetValidationErrors())
+}
+
+func TestSubscription_RecurrencyAmountIsExact(t *testing.T) {
+	t.Run("yearly price normalized to a month and back", func(t *testing.T) {
+		sub := newCustomSubscription(119.88, time.Now().AddDate(0, -1, 0),
+			subscription.NewInterval(subscription.YearIntervalUnit, 1))
+
+		assert.Equal(t, "9.99", sub.GetRecurrencyAmount(subscription.MonthlyRecurrency).Decimal().String())
+		assert.Equal(t, "119.88", sub.GetRecurrencyAmount(subscription.YearlyRecurrency).Decimal().String())
+	})
+
+	t.Run("monthly price over a year", func(t *testing.T) {
+		sub := newMonthlySubscription(9.99, time.Now().AddDate(0, -1, 0))
+
+		assert.Equal(t, "119.88", sub.GetRecurrencyAmount(subscription.YearlyRecurrency).Decimal().String())
+		assert.Equal(t, 119.88, sub.GetRecurrencyAmount(subscription.YearlyRecurrency).Value())
+	})
+}
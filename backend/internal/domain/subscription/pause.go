@@ -0,0 +1,64 @@
+package subscription
+
+import (
+	"time"
+)
+
+// Pause is a period during which the subscription is suspended, an open EndDate means it has not been resumed yet
+type Pause struct {
+	StartDate time.Time
+	EndDate   *time.Time
+}
+
+// Contains returns true if t falls within the pause, the end date being excluded
+func (p Pause) Contains(t time.Time) bool {
+	if t.Before(p.StartDate) {
+		return false
+	}
+	return p.EndDate == nil || t.Before(*p.EndDate)
+}
+
+// Overlaps returns true if both pauses share at least one instant
+func (p Pause) Overlaps(other Pause) bool {
+	if p.EndDate != nil && !p.EndDate.After(other.StartDate) {
+		return false
+	}
+	if other.EndDate != nil && !other.EndDate.After(p.StartDate) {
+		return false
+	}
+	return true
+}
+
+// durationBetween returns how long the pause lasts within [from, to)
+func (p Pause) durationBetween(from time.Time, to time.Time) time.Duration {
+	start := p.StartDate
+	if start.Before(from) {
+		start = from
+	}
+	end := to
+	if p.EndDate != nil && p.EndDate.Before(to) {
+		end = *p.EndDate
+	}
+	if !end.After(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+func PauseUniqueComparer(a Pause, b Pause) bool {
+	return a.StartDate.Equal(b.StartDate)
+}
+
+func PauseComparer(a Pause, b Pause) bool {
+	if !a.StartDate.Equal(b.StartDate) {
+		return false
+	}
+	if a.EndDate == nil || b.EndDate == nil {
+		return a.EndDate == nil && b.EndDate == nil
+	}
+	return a.EndDate.Equal(*b.EndDate)
+}
+
+func pauseLess(a Pause, b Pause) bool {
+	return a.StartDate.Before(b.StartDate)
+}
@@ -0,0 +1,108 @@
+package subscription_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func TestSubscription_Pause(t *testing.T) {
+	t.Run("paused period is excluded from activity and spending", func(t *testing.T) {
+		start := time.Now().AddDate(0, -12, 0)
+		sub := newMonthlySubscription(10, start)
+		pauseStart := start.AddDate(0, 3, 0)
+		pauseEnd := start.AddDate(0, 6, 0)
+
+		require.NoError(t, sub.Pause(pauseStart, &pauseEnd))
+
+		assert.True(t, sub.IsActiveAt(pauseStart.Add(-time.Second)))
+		assert.False(t, sub.IsActiveAt(pauseStart))
+		assert.True(t, sub.IsPausedAt(pauseStart.AddDate(0, 1, 0)))
+		assert.True(t, sub.IsActiveAt(pauseEnd))
+		assert.True(t, sub.IsActive())
+		assert.False(t, sub.IsPaused())
+		assert.Equal(t, 9*10.0, sub.GetTotalSpent().Value())
+		assert.Len(t, sub.BillingDates(start.AddDate(0, 11, 0)), 9)
+		assert.True(t, sub.IsDirty())
+
+		expected := time.Since(start) - pauseEnd.Sub(pauseStart)
+		assert.InDelta(t, expected.Seconds(), sub.GetTotalDuration().Seconds(), 1)
+	})
+
+	t.Run("an open pause stops renewals", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -2, 0))
+
+		require.NoError(t, sub.Pause(time.Now().Add(-time.Hour), nil))
+
+		assert.True(t, sub.IsPaused())
+		assert.False(t, sub.IsActive())
+		assert.Nil(t, sub.GetNextRenewalDate())
+	})
+
+	t.Run("renewals resume after the pause", func(t *testing.T) {
+		start := time.Now().AddDate(0, -2, 0)
+		sub := newMonthlySubscription(10, start)
+		pauseEnd := time.Now().AddDate(0, 0, 45)
+
+		require.NoError(t, sub.Pause(time.Now().AddDate(0, 0, -1), &pauseEnd))
+
+		next := sub.GetNextRenewalDate()
+		require.NotNil(t, next)
+		assert.False(t, next.Before(pauseEnd))
+		assert.True(t, next.Before(pauseEnd.AddDate(0, 1, 0)))
+	})
+
+	t.Run("rejects invalid pauses", func(t *testing.T) {
+		start := time.Now().AddDate(0, -6, 0)
+		sub := newMonthlySubscription(10, start)
+		end := start.AddDate(0, 5, 0)
+		sub.SetEndDate(&end)
+		pauseStart := start.AddDate(0, 1, 0)
+
+		assert.ErrorIs(t, sub.Pause(start.AddDate(0, 0, -1), nil), subscription.ErrPauseBeforeStartDate)
+		assert.ErrorIs(t, sub.Pause(end, nil), subscription.ErrPauseAfterEndDate)
+		assert.ErrorIs(t, sub.Pause(pauseStart, &pauseStart), subscription.ErrInvalidPauseRange)
+		require.NoError(t, sub.Pause(pauseStart, x.P(pauseStart.AddDate(0, 1, 0))))
+		assert.ErrorIs(t, sub.Pause(pauseStart.AddDate(0, 0, 15), nil), subscription.ErrPauseOverlap)
+		assert.ErrorIs(t, sub.Pause(start, nil), subscription.ErrPauseOverlap)
+		assert.NoError(t, sub.Pause(pauseStart.AddDate(0, 1, 0), nil))
+	})
+}
+
+func TestSubscription_Resume(t *testing.T) {
+	t.Run("ends the pause at the given date", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -3, 0))
+		pauseStart := time.Now().AddDate(0, -1, 0)
+		require.NoError(t, sub.Pause(pauseStart, nil))
+
+		resumedAt := time.Now().Add(-time.Hour)
+		require.NoError(t, sub.Resume(resumedAt))
+
+		require.Equal(t, 1, sub.Pauses().Len())
+		require.NotNil(t, sub.Pauses().GetAt(0).EndDate)
+		assert.True(t, sub.Pauses().GetAt(0).EndDate.Equal(resumedAt))
+		assert.False(t, sub.IsPaused())
+		assert.NotNil(t, sub.GetNextRenewalDate())
+	})
+
+	t.Run("resuming on the first day cancels the pause", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -3, 0))
+		pauseStart := time.Now().AddDate(0, 0, 10)
+		require.NoError(t, sub.Pause(pauseStart, nil))
+
+		require.NoError(t, sub.Resume(pauseStart))
+
+		assert.True(t, sub.Pauses().IsEmpty())
+	})
+
+	t.Run("fails when the subscription is not paused", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -3, 0))
+
+		assert.ErrorIs(t, sub.Resume(time.Now()), subscription.ErrSubscriptionNotPaused)
+	})
+}
@@ -1,11 +1,25 @@
 package subscription
 
 import (
+	"time"
+
 	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/domain/entity"
 	"github.com/mistribe/subtracker/pkg/x/validation"
 )
 
+// UpcomingCharge is a future renewal of a subscription and the amount due on that date
+type UpcomingCharge struct {
+	DueDate time.Time
+	Amount  currency.Amount
+}
+
+// PeriodSpending is what was spent on the billing period of a subscription starting at Start
+type PeriodSpending struct {
+	Start  time.Time
+	Amount currency.Amount
+}
+
 type Price interface {
 	entity.ETagEntity
 
@@ -46,7 +60,7 @@ func (c *price) ETagFields() []interface{} {
 func (c *price) GetValidationErrors() validation.Errors {
 	var errors validation.Errors
 
-	if c.Amount().Value() <= 0 {
+	if !c.Amount().IsPositive() {
 		errors = append(errors, validation.NewError("amount", "Amount must be greater than 0"))
 	}
 
@@ -0,0 +1,25 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+)
+
+// PriceChange is the price of a subscription starting from EffectiveFrom until the next change
+type PriceChange struct {
+	EffectiveFrom time.Time
+	Amount        currency.Amount
+}
+
+func PriceChangeUniqueComparer(a PriceChange, b PriceChange) bool {
+	return a.EffectiveFrom.Equal(b.EffectiveFrom)
+}
+
+func PriceChangeComparer(a PriceChange, b PriceChange) bool {
+	return a.EffectiveFrom.Equal(b.EffectiveFrom) && a.Amount.IsEqual(b.Amount)
+}
+
+func priceChangeLess(a PriceChange, b PriceChange) bool {
+	return a.EffectiveFrom.Before(b.EffectiveFrom)
+}
@@ -0,0 +1,182 @@
+package subscription_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+func newMonthlySubscription(amount float64, start time.Time) subscription.Subscription {
+	return subscription.NewSubscription(
+		types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+		subscription.NewPrice(currency.NewAmount(amount, currency.EUR)), nil, nil,
+		types.NewPersonalOwner(types.UserID("user-1")), nil, nil, nil, nil, nil,
+		start, nil, nil, subscription.MonthlyRecurrency, nil, nil, time.Now(), time.Now())
+}
+
+func TestSubscription_AddPriceChange(t *testing.T) {
+	t.Run("keeps the original price for earlier periods", func(t *testing.T) {
+		start := time.Now().AddDate(0, -12, 0)
+		sub := newMonthlySubscription(10, start)
+
+		raisedAt := start.AddDate(0, 9, 0)
+		require.NoError(t, sub.AddPriceChange(raisedAt, currency.NewAmount(12, currency.EUR)))
+
+		require.Equal(t, 2, sub.PriceHistory().Len())
+		assert.True(t, sub.PriceHistory().GetAt(0).EffectiveFrom.Equal(start))
+		assert.Equal(t, 10.0, sub.PriceAt(start.AddDate(0, 1, 0)).Value())
+		assert.Equal(t, 12.0, sub.PriceAt(time.Now()).Value())
+		assert.Equal(t, 12.0, sub.GetPrice().Value())
+		assert.Equal(t, 12.0, sub.Price().Amount().Value())
+		assert.Equal(t, 9*10.0+3*12.0, sub.GetTotalSpent().Value())
+		assert.True(t, sub.IsDirty())
+	})
+
+	t.Run("future changes do not affect the current price", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, -2, 0))
+
+		require.NoError(t, sub.AddPriceChange(time.Now().AddDate(0, 1, 0), currency.NewAmount(20, currency.EUR)))
+
+		assert.Equal(t, 10.0, sub.GetPrice().Value())
+		assert.Equal(t, 20.0, sub.PriceAt(time.Now().AddDate(0, 2, 0)).Value())
+		assert.Equal(t, 10.0, sub.GetRecurrencyAmount(subscription.MonthlyRecurrency).Value())
+		assert.Equal(t, 20.0,
+			sub.GetRecurrencyAmountAt(subscription.MonthlyRecurrency, time.Now().AddDate(0, 2, 0)).Value())
+	})
+
+	t.Run("rejects invalid changes", func(t *testing.T) {
+		start := time.Now().AddDate(0, -2, 0)
+		sub := newMonthlySubscription(10, start)
+		effective := time.Now().AddDate(0, -1, 0)
+
+		assert.ErrorIs(t, sub.AddPriceChange(start.AddDate(0, 0, -1), currency.NewAmount(12, currency.EUR)),
+			subscription.ErrPriceChangeBeforeStartDate)
+		assert.ErrorIs(t, sub.AddPriceChange(effective, currency.NewAmount(12, currency.USD)),
+			subscription.ErrPriceChangeCurrencyMismatch)
+		assert.ErrorIs(t, sub.AddPriceChange(effective, currency.NewAmount(0, currency.EUR)),
+			subscription.ErrInvalidPriceChangeAmount)
+		require.NoError(t, sub.AddPriceChange(effective, currency.NewAmount(12, currency.EUR)))
+		assert.ErrorIs(t, sub.AddPriceChange(effective, currency.NewAmount(14, currency.EUR)),
+			subscription.ErrPriceChangeAlreadyExists)
+	})
+
+	t.Run("editing the price corrects the price in effect", func(t *testing.T) {
+		start := time.Now().AddDate(0, -6, 0)
+		sub := newMonthlySubscription(10, start)
+		require.NoError(t, sub.AddPriceChange(time.Now().AddDate(0, -1, 0), currency.NewAmount(12, currency.EUR)))
+		sub.PriceHistory().ClearChanges()
+
+		sub.SetPrice(currency.NewAmount(13, currency.EUR))
+
+		assert.Equal(t, 13.0, sub.GetPrice().Value())
+		assert.Equal(t, 10.0, sub.PriceAt(start).Value())
+		assert.Len(t, sub.PriceHistory().Updated(), 1)
+	})
+}
+
+func TestSubscription_GetTotalSpent_YearlyPriceChange(t *testing.T) {
+	start := time.Now().AddDate(-2, 0, 0)
+	sub := subscription.NewSubscription(
+		types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+		subscription.NewPrice(currency.NewAmount(120, currency.EUR)),
+		[]subscription.PriceChange{
+			{EffectiveFrom: start, Amount: currency.NewAmount(120, currency.EUR)},
+			// Raised in the middle of the second billing year, the second year keeps its price
+			{EffectiveFrom: start.AddDate(1, 6, 0), Amount: currency.NewAmount(240, currency.EUR)},
+		},
+		nil,
+		types.NewPersonalOwner(types.UserID("user-1")), nil, nil, nil, nil, nil,
+		start, nil, nil, subscription.YearlyRecurrency, nil, nil, time.Now(), time.Now())
+
+	assert.Equal(t, 240.0, sub.GetTotalSpent().Value())
+	assert.Equal(t, 240.0, sub.GetPrice().Value())
+}
+
+func TestSubscription_GetSpendingByPeriod(t *testing.T) {
+	t.Run("one entry per elapsed month at the price of the month", func(t *testing.T) {
+		start := time.Now().AddDate(0, -12, 0)
+		sub := newMonthlySubscription(10, start)
+		require.NoError(t, sub.AddPriceChange(start.AddDate(0, 9, 0), currency.NewAmount(12, currency.EUR)))
+
+		periods := sub.GetSpendingByPeriod()
+
+		require.Len(t, periods, 12)
+		total := 0.0
+		for i, period := range periods {
+			if i > 0 {
+				assert.True(t, period.Start.After(periods[i-1].Start))
+			}
+			assert.Equal(t, currency.EUR, period.Amount.Currency())
+			total += period.Amount.Value()
+		}
+		assert.True(t, periods[0].Start.Equal(start))
+		assert.Equal(t, 10.0, periods[0].Amount.Value())
+		assert.Equal(t, 12.0, periods[11].Amount.Value())
+		assert.Equal(t, sub.GetTotalSpent().Value(), total)
+	})
+
+	t.Run("elapsed months of a billing year are grouped", func(t *testing.T) {
+		start := time.Now().AddDate(-2, -6, 0)
+		sub := subscription.NewSubscription(
+			types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+			subscription.NewPrice(currency.NewAmount(120, currency.EUR)), nil, nil,
+			types.NewPersonalOwner(types.UserID("user-1")), nil, nil, nil, nil, nil,
+			start, nil, nil, subscription.YearlyRecurrency, nil, nil, time.Now(), time.Now())
+
+		periods := sub.GetSpendingByPeriod()
+
+		require.Len(t, periods, 3)
+		assert.True(t, periods[1].Start.Equal(start.AddDate(1, 0, 0)))
+		assert.True(t, periods[2].Start.Equal(start.AddDate(2, 0, 0)))
+		assert.Equal(t, 120.0, periods[0].Amount.Value())
+		assert.Equal(t, 120.0, periods[1].Amount.Value())
+		// Six months of the third year have elapsed
+		assert.Equal(t, 60.0, periods[2].Amount.Value())
+		assert.Equal(t, 300.0, sub.GetTotalSpent().Value())
+	})
+
+	t.Run("months started at the end of a month do not drift", func(t *testing.T) {
+		year := time.Now().Year() - 1
+		sub := newMonthlySubscription(10, time.Date(year, time.January, 31, 12, 0, 0, 0, time.UTC))
+
+		periods := sub.GetSpendingByPeriod()
+
+		require.GreaterOrEqual(t, len(periods), 3)
+		lastOfFebruary := time.Date(year, time.March, 0, 12, 0, 0, 0, time.UTC)
+		assert.True(t, periods[1].Start.Equal(lastOfFebruary), periods[1].Start)
+		assert.True(t, periods[2].Start.Equal(time.Date(year, time.March, 31, 12, 0, 0, 0, time.UTC)),
+			periods[2].Start)
+	})
+
+	t.Run("months start on the billing anchor day", func(t *testing.T) {
+		year := time.Now().Year() - 1
+		anchorDay := int32(20)
+		start := time.Date(year, time.January, 10, 12, 0, 0, 0, time.UTC)
+		sub := subscription.NewSubscription(
+			types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+			subscription.NewPrice(currency.NewAmount(10, currency.EUR)), nil, nil,
+			types.NewPersonalOwner(types.UserID("user-1")), nil, nil, nil, nil, nil,
+			start, nil, nil, subscription.QuarterlyRecurrency, nil, &anchorDay, time.Now(), time.Now())
+
+		periods := sub.GetSpendingByPeriod()
+
+		require.GreaterOrEqual(t, len(periods), 3)
+		assert.True(t, periods[0].Start.Equal(start))
+		assert.True(t, periods[1].Start.Equal(time.Date(year, time.April, 20, 12, 0, 0, 0, time.UTC)),
+			periods[1].Start)
+		assert.True(t, periods[2].Start.Equal(time.Date(year, time.July, 20, 12, 0, 0, 0, time.UTC)),
+			periods[2].Start)
+	})
+
+	t.Run("nothing before the start", func(t *testing.T) {
+		sub := newMonthlySubscription(10, time.Now().AddDate(0, 1, 0))
+
+		assert.Empty(t, sub.GetSpendingByPeriod())
+	})
+}
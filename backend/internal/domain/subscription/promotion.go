@@ -0,0 +1,36 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+)
+
+// Promotion is a discounted price charged from StartDate until EndDate, the regular price applies afterward
+type Promotion struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Amount    currency.Amount
+}
+
+// Contains returns true if t falls within the promotion, the end date being excluded
+func (p Promotion) Contains(t time.Time) bool {
+	return !t.Before(p.StartDate) && t.Before(p.EndDate)
+}
+
+// Overlaps returns true if both promotions share at least one instant
+func (p Promotion) Overlaps(other Promotion) bool {
+	return p.StartDate.Before(other.EndDate) && other.StartDate.Before(p.EndDate)
+}
+
+func PromotionUniqueComparer(a Promotion, b Promotion) bool {
+	return a.StartDate.Equal(b.StartDate)
+}
+
+func PromotionComparer(a Promotion, b Promotion) bool {
+	return a.StartDate.Equal(b.StartDate) && a.EndDate.Equal(b.EndDate) && a.Amount.IsEqual(b.Amount)
+}
+
+func promotionLess(a Promotion, b Promotion) bool {
+	return a.StartDate.Before(b.StartDate)
+}
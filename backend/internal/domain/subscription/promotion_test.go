@@ -0,0 +1,98 @@
+package subscription_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+)
+
+func TestSubscription_AddPromotion(t *testing.T) {
+	t.Run("introductory price applies for the first months", func(t *testing.T) {
+		start := time.Now().AddDate(0, -12, 0)
+		sub := newMonthlySubscription(10, start)
+
+		require.NoError(t, sub.AddPromotion(start, start.AddDate(0, 3, 0), currency.NewAmount(5, currency.EUR)))
+
+		assert.Equal(t, 1, sub.Promotions().Len())
+		assert.Equal(t, 5.0, sub.PriceAt(start).Value())
+		assert.Equal(t, 10.0, sub.PriceAt(start.AddDate(0, 3, 0)).Value())
+		assert.Equal(t, 10.0, sub.GetPrice().Value())
+		assert.Equal(t, 10.0, sub.Price().Amount().Value())
+		assert.Equal(t, 3*5.0+9*10.0, sub.GetTotalSpent().Value())
+		assert.Nil(t, sub.PromotionEndDate())
+		assert.True(t, sub.IsDirty())
+	})
+
+	t.Run("promotion in effect drives the current price and renewal amount", func(t *testing.T) {
+		start := time.Now().AddDate(0, -2, 0)
+		sub := newMonthlySubscription(10, start)
+		end := start.AddDate(0, 6, 0)
+
+		require.NoError(t, sub.AddPromotion(start, end, currency.NewAmount(4, currency.EUR)))
+
+		assert.Equal(t, 4.0, sub.GetPrice().Value())
+		assert.Equal(t, 10.0, sub.Price().Amount().Value())
+		assert.Equal(t, 4.0*12, sub.GetRecurrencyAmount(subscription.YearlyRecurrency).Value())
+		assert.Equal(t, 10.0, sub.PriceAt(end).Value())
+		if assert.NotNil(t, sub.PromotionEndDate()) {
+			assert.True(t, sub.PromotionEndDate().Equal(end))
+		}
+	})
+
+	t.Run("back-to-back phases end with the last one", func(t *testing.T) {
+		start := time.Now().AddDate(0, -1, 0)
+		sub := newMonthlySubscription(10, start)
+		first := start.AddDate(0, 3, 0)
+		second := first.AddDate(0, 3, 0)
+
+		require.NoError(t, sub.AddPromotion(first, second, currency.NewAmount(7, currency.EUR)))
+		require.NoError(t, sub.AddPromotion(start, first, currency.NewAmount(3, currency.EUR)))
+
+		assert.True(t, sub.Promotions().GetAt(0).StartDate.Equal(start))
+		assert.Equal(t, 3.0, sub.GetPrice().Value())
+		assert.Equal(t, 7.0, sub.PriceAt(first).Value())
+		if assert.NotNil(t, sub.PromotionEndDate()) {
+			assert.True(t, sub.PromotionEndDate().Equal(second))
+		}
+	})
+
+	t.Run("price changes keep the regular price apart from promotions", func(t *testing.T) {
+		start := time.Now().AddDate(0, -2, 0)
+		sub := newMonthlySubscription(10, start)
+
+		require.NoError(t, sub.AddPromotion(start, start.AddDate(0, 6, 0), currency.NewAmount(4, currency.EUR)))
+		require.NoError(t, sub.AddPriceChange(time.Now().Add(-time.Hour), currency.NewAmount(12, currency.EUR)))
+
+		assert.Equal(t, 12.0, sub.Price().Amount().Value())
+		assert.Equal(t, 4.0, sub.GetPrice().Value())
+	})
+
+	t.Run("rejects invalid promotions", func(t *testing.T) {
+		start := time.Now().AddDate(0, -2, 0)
+		sub := newMonthlySubscription(10, start)
+		end := start.AddDate(0, 3, 0)
+		require.NoError(t, sub.AddPromotion(start, end, currency.NewAmount(5, currency.EUR)))
+
+		assert.ErrorIs(t,
+			sub.AddPromotion(start.AddDate(0, 0, -1), end, currency.NewAmount(5, currency.EUR)),
+			subscription.ErrPromotionBeforeStartDate)
+		assert.ErrorIs(t,
+			sub.AddPromotion(end, end, currency.NewAmount(5, currency.EUR)),
+			subscription.ErrInvalidPromotionRange)
+		assert.ErrorIs(t,
+			sub.AddPromotion(end, end.AddDate(0, 1, 0), currency.NewAmount(-1, currency.EUR)),
+			subscription.ErrInvalidPromotionAmount)
+		assert.ErrorIs(t,
+			sub.AddPromotion(end, end.AddDate(0, 1, 0), currency.NewAmount(5, currency.USD)),
+			subscription.ErrPromotionCurrencyMismatch)
+		assert.ErrorIs(t,
+			sub.AddPromotion(start.AddDate(0, 1, 0), end.AddDate(0, 1, 0), currency.NewAmount(5, currency.EUR)),
+			subscription.ErrPromotionOverlap)
+		assert.Equal(t, 1, sub.Promotions().Len())
+	})
+}
@@ -86,43 +86,3 @@ func ParseRecurrencyTypesOrDefault(inputs []string, defaultValue RecurrencyType)
 	}
 	return result
 }
-
-func toRecurrencyPrice(
-	amount float64,
-	from RecurrencyType,
-	customRecurrency *int32,
-	to RecurrencyType) float64 {
-	if from == to {
-		return amount
-	}
-
-	monthlyAmount := amount
-	switch from {
-	case MonthlyRecurrency:
-		monthlyAmount = amount
-	case QuarterlyRecurrency:
-		monthlyAmount = amount / 3
-	case HalfYearlyRecurrency:
-		monthlyAmount = amount / 6
-	case YearlyRecurrency:
-		monthlyAmount = amount / 12
-	case CustomRecurrency:
-		if customRecurrency != nil {
-			monthlyAmount = amount / float64(*customRecurrency)
-		}
-		return 0
-	}
-
-	switch to {
-	case MonthlyRecurrency:
-		return monthlyAmount
-	case QuarterlyRecurrency:
-		return monthlyAmount * 3
-	case HalfYearlyRecurrency:
-		return monthlyAmount * 6
-	case YearlyRecurrency:
-		return monthlyAmount * 12
-	default:
-		return amount
-	}
-}
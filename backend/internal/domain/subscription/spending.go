@@ -0,0 +1,109 @@
+package subscription
+
+import (
+	"time"
+)
+
+// SpendingBucket is the size of the periods spending is aggregated over
+type SpendingBucket string
+
+const (
+	UnknownSpendingBucket SpendingBucket = "unknown"
+	MonthSpendingBucket   SpendingBucket = "month"
+	QuarterSpendingBucket SpendingBucket = "quarter"
+	YearSpendingBucket    SpendingBucket = "year"
+)
+
+func (b SpendingBucket) String() string {
+	return string(b)
+}
+
+// Months returns the number of months covered by a bucket
+func (b SpendingBucket) Months() int {
+	switch b {
+	case MonthSpendingBucket:
+		return 1
+	case QuarterSpendingBucket:
+		return 3
+	case YearSpendingBucket:
+		return 12
+	default:
+		return 0
+	}
+}
+
+// Start returns the first day of the bucket containing the calendar date of t
+func (b SpendingBucket) Start(t time.Time) time.Time {
+	month := t.Month()
+	switch b {
+	case QuarterSpendingBucket:
+		month = time.Month((int(month)-1)/3*3 + 1)
+	case YearSpendingBucket:
+		month = time.January
+	}
+	return time.Date(t.Year(), month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Next returns the start of the bucket following the one starting at start
+func (b SpendingBucket) Next(start time.Time) time.Time {
+	return start.AddDate(0, b.Months(), 0)
+}
+
+func ParseSpendingBucket(input string) (SpendingBucket, error) {
+	switch input {
+	case string(MonthSpendingBucket):
+		return MonthSpendingBucket, nil
+	case string(QuarterSpendingBucket):
+		return QuarterSpendingBucket, nil
+	case string(YearSpendingBucket):
+		return YearSpendingBucket, nil
+	default:
+		return UnknownSpendingBucket, ErrUnknownSpendingBucket
+	}
+}
+
+func MustParseSpendingBucket(input string) SpendingBucket {
+	b, err := ParseSpendingBucket(input)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// SpendingDimension is what spending is grouped by within a bucket
+type SpendingDimension string
+
+const (
+	UnknownSpendingDimension   SpendingDimension = "unknown"
+	ProviderSpendingDimension  SpendingDimension = "provider"
+	LabelSpendingDimension     SpendingDimension = "label"
+	PayerSpendingDimension     SpendingDimension = "payer"
+	OwnerTypeSpendingDimension SpendingDimension = "owner_type"
+)
+
+func (d SpendingDimension) String() string {
+	return string(d)
+}
+
+func ParseSpendingDimension(input string) (SpendingDimension, error) {
+	switch input {
+	case string(ProviderSpendingDimension):
+		return ProviderSpendingDimension, nil
+	case string(LabelSpendingDimension):
+		return LabelSpendingDimension, nil
+	case string(PayerSpendingDimension):
+		return PayerSpendingDimension, nil
+	case string(OwnerTypeSpendingDimension):
+		return OwnerTypeSpendingDimension, nil
+	default:
+		return UnknownSpendingDimension, ErrUnknownSpendingDimension
+	}
+}
+
+func MustParseSpendingDimension(input string) SpendingDimension {
+	d, err := ParseSpendingDimension(input)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
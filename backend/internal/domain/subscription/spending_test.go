@@ -0,0 +1,67 @@
+package subscription_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+)
+
+func TestSpendingBucket_Start(t *testing.T) {
+	tests := []struct {
+		name          string
+		bucket        subscription.SpendingBucket
+		at            time.Time
+		expectedStart time.Time
+		expectedNext  time.Time
+	}{
+		{
+			name:          "month",
+			bucket:        subscription.MonthSpendingBucket,
+			at:            time.Date(2024, time.February, 29, 13, 0, 0, 0, time.UTC),
+			expectedStart: date(2024, time.February, 1),
+			expectedNext:  date(2024, time.March, 1),
+		},
+		{
+			name:          "quarter",
+			bucket:        subscription.QuarterSpendingBucket,
+			at:            date(2025, time.June, 30),
+			expectedStart: date(2025, time.April, 1),
+			expectedNext:  date(2025, time.July, 1),
+		},
+		{
+			name:          "last quarter",
+			bucket:        subscription.QuarterSpendingBucket,
+			at:            date(2025, time.October, 1),
+			expectedStart: date(2025, time.October, 1),
+			expectedNext:  date(2026, time.January, 1),
+		},
+		{
+			name:          "year",
+			bucket:        subscription.YearSpendingBucket,
+			at:            date(2025, time.December, 31),
+			expectedStart: date(2025, time.January, 1),
+			expectedNext:  date(2026, time.January, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := tt.bucket.Start(tt.at)
+
+			assert.Equal(t, tt.expectedStart, start)
+			assert.Equal(t, tt.expectedNext, tt.bucket.Next(start))
+		})
+	}
+}
+
+func TestParseSpendingDimension(t *testing.T) {
+	dimension, err := subscription.ParseSpendingDimension("owner_type")
+	assert.NoError(t, err)
+	assert.Equal(t, subscription.OwnerTypeSpendingDimension, dimension)
+
+	_, err = subscription.ParseSpendingDimension("currency")
+	assert.ErrorIs(t, err, subscription.ErrUnknownSpendingDimension)
+}
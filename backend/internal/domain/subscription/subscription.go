@@ -1,12 +1,15 @@
 package subscription
 
 import (
+	"slices"
 	"time"
 
 	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/domain/entity"
 	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/decimal"
 	"github.com/mistribe/subtracker/pkg/slicesx"
+	"github.com/mistribe/subtracker/pkg/x"
 	"github.com/mistribe/subtracker/pkg/x/herd"
 	"github.com/mistribe/subtracker/pkg/x/validation"
 )
@@ -24,51 +27,118 @@ type Subscription interface {
 	FriendlyName() *string
 	//FreeTrial is the configuration of the free trial for the subscription
 	FreeTrial() FreeTrial
+	// Contract is the commitment of the subscription (minimum term, auto-renew, notice period), nil when there is none
+	Contract() Contract
 	// ProviderId is the provider of the subscription
 	ProviderId() types.ProviderID
 	Price() Price
+	// PriceHistory is the dated price timeline of the subscription ordered by effective date
+	PriceHistory() *slicesx.Tracked[PriceChange]
+	// Promotions are the discounted pricing phases of the subscription ordered by start date
+	Promotions() *slicesx.Tracked[Promotion]
 	// Owner is who own the subscription
 	Owner() types.Owner
 	// Payer is who is paying the subscription
 	Payer() Payer
+	// CostSplit is how the cost is shared between family members, nil when it is not shared
+	CostSplit() CostSplit
+	// PaymentMethodId is the card or account the subscription is charged to, nil when unknown
+	PaymentMethodId() *types.PaymentMethodID
 	FamilyUsers() *slicesx.Tracked[types.FamilyMemberID]
 	// StartDate is when the subscription as start
 	StartDate() time.Time
 	// EndDate is when the subscription as stop
 	EndDate() *time.Time
+	// Pauses are the periods during which the subscription is suspended ordered by start date
+	Pauses() *slicesx.Tracked[Pause]
 	// Recurrency defined the type of recurrency for the subscription
 	Recurrency() RecurrencyType
-	// CustomRecurrency When the recurrency is custom, this is the number of month between each recurrence
-	CustomRecurrency() *int32
+	// CustomRecurrency When the recurrency is custom, this is the interval between each recurrence
+	CustomRecurrency() *Interval
+	// BillingAnchorDay is the day of the month monthly and yearly renewals fall on, nil to follow the start date.
+	// It is clamped to the last day of shorter months
+	BillingAnchorDay() *int32
 	Labels() *slicesx.Tracked[LabelRef]
 
 	// GetRecurrencyAmount returns the amount of the subscription for the given recurrency type
 	GetRecurrencyAmount(to RecurrencyType) currency.Amount
+	// GetRecurrencyAmountAt returns the amount for the given recurrency type with the price in effect at t
+	GetRecurrencyAmountAt(to RecurrencyType, t time.Time) currency.Amount
 	// GetNextRenewalDate returns the next renewal date of the subscription
 	GetNextRenewalDate() *time.Time
+	// GetNextRenewalDateIn returns the next renewal date of the subscription, its dates happening in loc
+	GetNextRenewalDateIn(loc *time.Location) *time.Time
+	// UpcomingRenewalDatesIn returns up to count renewal dates from now, its dates happening in loc
+	UpcomingRenewalDatesIn(count int, loc *time.Location) []time.Time
+	// UpcomingChargesIn returns the renewals from now until the given instant with the price in effect at each of
+	// them, its dates happening in loc. Renewals during the free trial are not charged.
+	UpcomingChargesIn(until time.Time, loc *time.Location) []UpcomingCharge
+	// CancellationDeadline returns the last day to cancel before the next renewal the contract allows to stop,
+	// nil when the subscription does not renew by itself
+	CancellationDeadline() *time.Time
+	// CancellationDeadlineIn returns the cancellation deadline with the subscription dates happening in loc
+	CancellationDeadlineIn(loc *time.Location) *time.Time
+	// BillingDates returns every billing occurrence from the start date up to and including until
+	BillingDates(until time.Time) []time.Time
 	// GetPrice returns the price of the subscription from custom or price LabelID
 	GetPrice() currency.Amount
+	// PriceAt returns the price in effect at the given time t, including any promotion
+	PriceAt(t time.Time) currency.Amount
+	// PromotionAt returns the promotion in effect at the given time t, nil when the regular price applies
+	PromotionAt(t time.Time) *Promotion
+	// PromotionEndDate returns when the promotional pricing in effect today ends, following back-to-back phases,
+	// nil when the regular price applies
+	PromotionEndDate() *time.Time
+	// GetMemberShares returns the part of the amount for the given recurrency type each family member pays,
+	// nil without cost split
+	GetMemberShares(to RecurrencyType) map[types.FamilyMemberID]currency.Amount
+	// SplitAmount returns the part of amount each family member pays, nil without cost split
+	SplitAmount(amount currency.Amount) map[types.FamilyMemberID]currency.Amount
 	// GetTotalSpent returns the total spent of the subscription
 	GetTotalSpent() currency.Amount
+	// GetSpendingByPeriod returns what was spent on each elapsed billing period, oldest first.
+	// The amounts add up to GetTotalSpent.
+	GetSpendingByPeriod() []PeriodSpending
 	// IsActive returns true if the subscription is active
 	IsActive() bool
 	// IsStarted returns true if the subscription is started
 	IsStarted() bool
 	// IsActiveAt determines whether the subscription is active at the given time t.
 	IsActiveAt(t time.Time) bool
+	// IsActiveAtIn determines whether the subscription is active at the given time t, its dates happening in loc
+	IsActiveAtIn(t time.Time, loc *time.Location) bool
+	// IsPaused returns true if the subscription is currently suspended
+	IsPaused() bool
+	// IsPausedAt returns true if the subscription is suspended at the given time t
+	IsPausedAt(t time.Time) bool
 	// GetTotalDuration returns the total duration of the subscription
 	GetTotalDuration() time.Duration
 
 	SetFriendlyName(name *string)
 	SetFreeTrial(trial FreeTrial)
+	SetContract(contract Contract)
 	SetPrice(amount currency.Amount)
+	// AddPriceChange records a new price effective from the given date
+	AddPriceChange(effectiveFrom time.Time, amount currency.Amount) error
+	// AddPromotion charges amount instead of the regular price from the given date until the end date
+	AddPromotion(from time.Time, until time.Time, amount currency.Amount) error
 	SetOwner(owner types.Owner)
 	SetPayer(payer Payer)
+	// SetCostSplit replaces how the cost is shared, nil stops sharing it
+	SetCostSplit(split CostSplit) error
+	SetPaymentMethodId(paymentMethodId *types.PaymentMethodID)
 	SetFamilyUsers(familyMembers []types.FamilyMemberID)
+	// SetLabels replaces the labels set on the subscription, the labels inherited from its provider are kept
+	SetLabels(labelIds []types.LabelID)
 	SetStartDate(startDate time.Time)
 	SetEndDate(endDate *time.Time)
+	// Pause suspends the subscription from the given date, until is nil when the resume date is unknown
+	Pause(from time.Time, until *time.Time) error
+	// Resume ends the pause in effect at the given date
+	Resume(at time.Time) error
 	SetRecurrency(recurrency RecurrencyType)
-	SetCustomRecurrency(customRecurrency *int32)
+	SetCustomRecurrency(customRecurrency *Interval)
+	SetBillingAnchorDay(day *int32)
 	SetProviderId(providerID types.ProviderID)
 
 	Equal(other Subscription) bool
@@ -80,50 +150,84 @@ type subscription struct {
 
 	friendlyName     *string
 	freeTrial        FreeTrial
+	contract         Contract
 	providerId       types.ProviderID
 	price            Price
+	priceHistory     *slicesx.Tracked[PriceChange]
+	promotions       *slicesx.Tracked[Promotion]
 	owner            types.Owner
 	payer            Payer
+	costSplit        CostSplit
+	paymentMethodId  *types.PaymentMethodID
 	familyUsers      *slicesx.Tracked[types.FamilyMemberID]
 	labels           *slicesx.Tracked[LabelRef]
 	startDate        time.Time
 	endDate          *time.Time
+	pauses           *slicesx.Tracked[Pause]
 	recurrency       RecurrencyType
-	customRecurrency *int32
+	customRecurrency *Interval
+	billingAnchorDay *int32
 }
 
 func NewSubscription(
 	id types.SubscriptionID,
 	friendlyName *string,
 	freeTrial FreeTrial,
+	contract Contract,
 	providerId types.ProviderID,
 	customPrice Price,
+	priceHistory []PriceChange,
+	promotions []Promotion,
 	owner types.Owner,
 	payer Payer,
+	costSplit CostSplit,
+	paymentMethodId *types.PaymentMethodID,
 	familyUsers []types.FamilyMemberID,
 	labels []LabelRef,
 	startDate time.Time,
 	endDate *time.Time,
+	pauses []Pause,
 	recurrency RecurrencyType,
-	customRecurrency *int32,
+	customRecurrency *Interval,
+	billingAnchorDay *int32,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) Subscription {
-	return &subscription{
+	sub := &subscription{
 		Base:             entity.NewBase[types.SubscriptionID](id, createdAt, updatedAt, true, false),
 		friendlyName:     friendlyName,
 		freeTrial:        freeTrial,
+		contract:         contract,
 		providerId:       providerId,
 		price:            customPrice,
+		priceHistory:     slicesx.NewTracked(priceHistory, PriceChangeUniqueComparer, PriceChangeComparer),
+		promotions:       slicesx.NewTracked(promotions, PromotionUniqueComparer, PromotionComparer),
 		owner:            owner,
 		payer:            payer,
+		costSplit:        costSplit,
+		paymentMethodId:  paymentMethodId,
 		familyUsers:      slicesx.NewTracked(familyUsers, types.FamilyMemberIdComparer, types.FamilyMemberIdComparer),
 		labels:           slicesx.NewTracked(labels, LabelRefUniqueComparer, LabelRefComparer),
 		startDate:        startDate,
 		endDate:          endDate,
+		pauses:           slicesx.NewTracked(pauses, PauseUniqueComparer, PauseComparer),
 		recurrency:       recurrency,
 		customRecurrency: customRecurrency,
+		billingAnchorDay: billingAnchorDay,
 	}
+	sub.priceHistory.Sort(priceChangeLess)
+	sub.pauses.Sort(pauseLess)
+	sub.promotions.Sort(promotionLess)
+	// The price always reflects the amount in effect today, a scheduled change may have started since the last save
+	if sub.price != nil && sub.priceHistory.IsNotEmpty() {
+		sub.price.SetAmount(sub.regularPriceAt(time.Now()))
+	}
+	sub.RecordEvent(SubscriptionCreated{
+		SubscriptionId: id,
+		ProviderId:     providerId,
+	})
+
+	return sub
 }
 
 func (s *subscription) Labels() *slicesx.Tracked[LabelRef] {
@@ -141,42 +245,160 @@ func (s *subscription) GetTotalDuration() time.Duration {
 	if s.startDate.After(time.Now()) {
 		return time.Duration(0)
 	}
+	end := time.Now()
 	if s.endDate != nil {
-		return s.endDate.Sub(s.startDate)
+		end = *s.endDate
 	}
 
-	return time.Now().Sub(s.startDate)
+	// Paused periods are not part of the time spent with the subscription
+	duration := end.Sub(s.startDate)
+	for pause := range s.pauses.It() {
+		duration -= pause.durationBetween(s.startDate, end)
+	}
+
+	return duration
 }
 
 func (s *subscription) IsActiveAt(t time.Time) bool {
 	if s.startDate.After(t) {
 		return false
 	}
-	if s.endDate != nil {
-		return s.endDate.After(t)
+	if s.endDate != nil && !s.endDate.After(t) {
+		return false
 	}
-	return true
+	return !s.IsPausedAt(t)
+}
+
+func (s *subscription) IsActiveAtIn(t time.Time, loc *time.Location) bool {
+	return s.IsActiveAt(floatingTime(t, loc))
 }
 
 func (s *subscription) IsActive() bool {
-	if s.startDate.After(time.Now()) {
-		return false
+	return s.IsActiveAt(time.Now())
+}
+
+func (s *subscription) IsPaused() bool {
+	return s.IsPausedAt(time.Now())
+}
+
+func (s *subscription) IsPausedAt(t time.Time) bool {
+	_, ok := s.pauseAt(t)
+	return ok
+}
+
+func (s *subscription) pauseAt(t time.Time) (Pause, bool) {
+	for pause := range s.pauses.It() {
+		if pause.Contains(t) {
+			return pause, true
+		}
 	}
-	if s.endDate != nil {
-		return s.endDate.After(time.Now())
+	return Pause{}, false
+}
+
+func (s *subscription) Pauses() *slicesx.Tracked[Pause] {
+	return s.pauses
+}
+
+func (s *subscription) Pause(from time.Time, until *time.Time) error {
+	if from.Before(s.startDate) {
+		return ErrPauseBeforeStartDate
+	}
+	if s.endDate != nil && !from.Before(*s.endDate) {
+		return ErrPauseAfterEndDate
+	}
+	if until != nil && !until.After(from) {
+		return ErrInvalidPauseRange
 	}
 
-	return true
+	pause := Pause{
+		StartDate: from,
+		EndDate:   until,
+	}
+	for existing := range s.pauses.It() {
+		if existing.Overlaps(pause) {
+			return ErrPauseOverlap
+		}
+	}
+
+	s.pauses.Add(pause)
+	s.pauses.Sort(pauseLess)
+	s.SetAsDirty()
+
+	return nil
+}
+
+func (s *subscription) Resume(at time.Time) error {
+	pause, ok := s.pauseAt(at)
+	if !ok {
+		return ErrSubscriptionNotPaused
+	}
+
+	// Resuming on the first day of the pause cancels it entirely
+	if !at.After(pause.StartDate) {
+		s.pauses.Remove(pause)
+	} else {
+		s.pauses.Update(Pause{
+			StartDate: pause.StartDate,
+			EndDate:   &at,
+		})
+	}
+	s.SetAsDirty()
+
+	return nil
 }
 
 func (s *subscription) GetTotalSpent() currency.Amount {
-	if s.price == nil {
+	unit, spent, ok := s.spentByPeriod()
+	if !ok {
 		return currency.NewInvalidAmount()
 	}
 
-	price := s.GetRecurrencyAmount(MonthlyRecurrency)
+	total := decimal.Zero
+	for _, period := range spent {
+		total = total.Add(period.value)
+	}
+
+	return currency.NewAmountFromDecimal(total, unit)
+}
+
+func (s *subscription) GetSpendingByPeriod() []PeriodSpending {
+	unit, spent, ok := s.spentByPeriod()
+	if !ok {
+		return nil
+	}
+
+	var periods []PeriodSpending
+	for _, period := range spent {
+		last := len(periods) - 1
+		if last >= 0 && periods[last].Start.Equal(period.start) {
+			periods[last].Amount = periods[last].Amount.Add(period.value)
+			continue
+		}
+		periods = append(periods, PeriodSpending{
+			Start:  period.start,
+			Amount: currency.NewAmountFromDecimal(period.value, unit),
+		})
+	}
+
+	return periods
+}
+
+// spentValue is the amount spent on a month or an occurrence, start being the beginning of its billing period
+type spentValue struct {
+	start time.Time
+	value decimal.Decimal
+}
+
+// spentByPeriod returns what was spent on each elapsed month, or each elapsed occurrence for day and week
+// intervals, in the currency of the subscription. It is false when the price is unknown.
+func (s *subscription) spentByPeriod() (currency.Unit, []spentValue, bool) {
+	if s.price == nil {
+		return currency.Unit{}, nil, false
+	}
+
+	price := s.price.Amount()
 	if !price.IsValid() {
-		return currency.NewInvalidAmount()
+		return currency.Unit{}, nil, false
 	}
 
 	now := time.Now()
@@ -186,30 +408,202 @@ func (s *subscription) GetTotalSpent() currency.Amount {
 	}
 
 	if s.startDate.After(now) {
-		return currency.NewAmount(0, price.Currency())
+		return price.Currency(), nil, true
+	}
+
+	// Day and week intervals do not line up with calendar months, each elapsed occurrence is charged instead
+	if interval, ok := s.renewalInterval(); ok && interval.calendarMonths() == 0 {
+		return price.Currency(), s.spentByOccurrence(interval, endDate), true
 	}
 
 	months := monthsBetweenCalendar(s.startDate, endDate)
 	if months <= 0 {
-		return currency.NewAmount(0, price.Currency())
+		return price.Currency(), nil, true
+	}
+
+	periodMonths := int(s.getMonths())
+	if periodMonths <= 0 {
+		return currency.Unit{}, nil, false
 	}
 
-	return currency.NewAmount(price.Value()*float64(months), price.Currency())
+	// Each month is charged at the price in effect at the start of its billing period. The months fall on the
+	// anchor day like the renewals, so that a period starts on its renewal.
+	monthly := Interval{Unit: MonthIntervalUnit, Count: 1}
+	var spent []spentValue
+	for month := 0; month < months; month++ {
+		// Months starting while the subscription is paused are not charged
+		if s.IsPausedAt(s.occurrence(monthly, month)) {
+			continue
+		}
+		periodStart := s.occurrence(monthly, month-month%periodMonths)
+		spent = append(spent, spentValue{
+			start: periodStart,
+			value: s.getMonthlyPriceAt(periodStart).Decimal(),
+		})
+	}
+
+	return price.Currency(), spent, true
+}
+
+// spentByOccurrence returns the price of every elapsed billing period that did not start during a pause
+func (s *subscription) spentByOccurrence(interval Interval, endDate time.Time) []spentValue {
+	var spent []spentValue
+	for k := 0; !s.occurrence(interval, k+1).After(endDate); k++ {
+		occurrence := s.occurrence(interval, k)
+		if s.IsPausedAt(occurrence) {
+			continue
+		}
+		spent = append(spent, spentValue{
+			start: occurrence,
+			value: s.PriceAt(occurrence).Decimal(),
+		})
+	}
+	return spent
 }
 
 func (s *subscription) GetPrice() currency.Amount {
-	if s.price != nil {
-		return currency.NewAmount(s.price.Amount().Value(), s.price.Amount().Currency())
+	return s.PriceAt(time.Now())
+}
+
+func (s *subscription) PriceAt(t time.Time) currency.Amount {
+	if promotion := s.PromotionAt(t); promotion != nil {
+		return currency.NewAmountFromDecimal(promotion.Amount.Decimal(), promotion.Amount.Currency())
 	}
 
-	return currency.NewInvalidAmount()
+	return s.regularPriceAt(t)
 }
 
-func (s *subscription) getMonths() int {
+// regularPriceAt returns the price from the price history in effect at t, ignoring promotions
+func (s *subscription) regularPriceAt(t time.Time) currency.Amount {
+	if s.priceHistory.IsEmpty() {
+		if s.price != nil {
+			return currency.NewAmountFromDecimal(s.price.Amount().Decimal(), s.price.Amount().Currency())
+		}
+		return currency.NewInvalidAmount()
+	}
+
+	// Before the first change, the earliest known price applies
+	amount := s.priceHistory.GetAt(0).Amount
+	for change := range s.priceHistory.It() {
+		if change.EffectiveFrom.After(t) {
+			break
+		}
+		amount = change.Amount
+	}
+
+	return currency.NewAmountFromDecimal(amount.Decimal(), amount.Currency())
+}
+
+func (s *subscription) PriceHistory() *slicesx.Tracked[PriceChange] {
+	return s.priceHistory
+}
+
+func (s *subscription) AddPriceChange(effectiveFrom time.Time, amount currency.Amount) error {
+	if amount == nil || !amount.IsValid() || !amount.IsPositive() {
+		return ErrInvalidPriceChangeAmount
+	}
+	if effectiveFrom.Before(s.startDate) {
+		return ErrPriceChangeBeforeStartDate
+	}
+	if s.price != nil && s.price.Amount().Currency() != amount.Currency() {
+		return ErrPriceChangeCurrencyMismatch
+	}
+
+	change := PriceChange{
+		EffectiveFrom: effectiveFrom,
+		Amount:        amount,
+	}
+	if s.priceHistory.Contains(change) {
+		return ErrPriceChangeAlreadyExists
+	}
+	previous := s.regularPriceAt(effectiveFrom)
+
+	// The first change also records the price paid until then, so earlier periods keep their original price
+	if s.priceHistory.IsEmpty() && s.price != nil && effectiveFrom.After(s.startDate) {
+		s.priceHistory.Add(PriceChange{
+			EffectiveFrom: s.startDate,
+			Amount:        s.price.Amount(),
+		})
+	}
+	s.priceHistory.Add(change)
+	s.priceHistory.Sort(priceChangeLess)
+
+	current := s.regularPriceAt(time.Now())
+	if s.price == nil {
+		s.price = NewPrice(current)
+	} else {
+		s.price.SetAmount(current)
+	}
+	s.SetAsDirty()
+	s.RecordEvent(newPriceChanged(s.Id(), effectiveFrom, previous, amount))
+
+	return nil
+}
+
+func (s *subscription) Promotions() *slicesx.Tracked[Promotion] {
+	return s.promotions
+}
+
+func (s *subscription) PromotionAt(t time.Time) *Promotion {
+	for promotion := range s.promotions.It() {
+		if promotion.Contains(t) {
+			return &promotion
+		}
+	}
+	return nil
+}
+
+func (s *subscription) PromotionEndDate() *time.Time {
+	promotion := s.PromotionAt(time.Now())
+	if promotion == nil {
+		return nil
+	}
+
+	end := promotion.EndDate
+	for next := s.PromotionAt(end); next != nil; next = s.PromotionAt(end) {
+		end = next.EndDate
+	}
+	return &end
+}
+
+func (s *subscription) AddPromotion(from time.Time, until time.Time, amount currency.Amount) error {
+	if amount == nil || !amount.IsValid() || amount.IsNegative() {
+		return ErrInvalidPromotionAmount
+	}
+	if from.Before(s.startDate) {
+		return ErrPromotionBeforeStartDate
+	}
+	if !until.After(from) {
+		return ErrInvalidPromotionRange
+	}
+	if s.price != nil && s.price.Amount().Currency() != amount.Currency() {
+		return ErrPromotionCurrencyMismatch
+	}
+
+	promotion := Promotion{
+		StartDate: from,
+		EndDate:   until,
+		Amount:    amount,
+	}
+	for existing := range s.promotions.It() {
+		if existing.Overlaps(promotion) {
+			return ErrPromotionOverlap
+		}
+	}
+
+	s.promotions.Add(promotion)
+	s.promotions.Sort(promotionLess)
+	s.SetAsDirty()
+
+	return nil
+}
+
+// getMonths returns the length of a billing period in months, fractional for day and week intervals
+func (s *subscription) getMonths() float64 {
 	switch s.recurrency {
 	case CustomRecurrency:
 		if s.customRecurrency != nil {
-			return int(*s.customRecurrency)
+			return s.customRecurrency.Months()
 		}
 		return 0
 	case OneTimeRecurrency:
@@ -220,104 +614,311 @@ func (s *subscription) getMonths() int {
 		} else {
 			endDate = time.Now()
 		}
-		return monthsBetweenCalendar(startDate, endDate)
+		return float64(monthsBetweenCalendar(startDate, endDate))
 	default:
-		return s.recurrency.TotalMonths()
+		return float64(s.recurrency.TotalMonths())
 	}
 }
 
-func (s *subscription) getMonthlyPrice() currency.Amount {
-	var price float64
-	numberOfMonths := s.getMonths()
-	if s.price != nil {
-		price = s.price.Amount().Value()
-	} else {
+// getMonthsRatio returns the length of a billing period in months as a fraction, see Interval.monthsRatio
+func (s *subscription) getMonthsRatio() (numerator decimal.Decimal, denominator decimal.Decimal) {
+	if s.recurrency == CustomRecurrency && s.customRecurrency != nil {
+		return s.customRecurrency.monthsRatio()
+	}
+	return decimal.NewFromInt(int64(s.getMonths())), decimal.NewFromInt(1)
+}
+
+// getPriceOverMonthsAt returns the price in effect at t spread over a number of months.
+// The price is multiplied before being divided by the length of the billing period, so a yearly price
+// normalized to a year stays exact.
+func (s *subscription) getPriceOverMonthsAt(t time.Time, months int) currency.Amount {
+	if s.price == nil {
 		return currency.NewInvalidAmount()
 	}
+	numerator, denominator := s.getMonthsRatio()
+	price := s.PriceAt(t)
 
-	return currency.NewAmount(price/float64(numberOfMonths), s.price.Amount().Currency())
+	return price.Mul(decimal.NewFromInt(int64(months)).Mul(denominator)).Div(numerator)
+}
+
+func (s *subscription) getMonthlyPriceAt(t time.Time) currency.Amount {
+	return s.getPriceOverMonthsAt(t, 1)
 }
 
 func (s *subscription) GetRecurrencyAmount(to RecurrencyType) currency.Amount {
-	if s.price != nil {
-		monthlyPrice := s.getMonthlyPrice()
+	return s.GetRecurrencyAmountAt(to, time.Now())
+}
 
-		return currency.NewAmount(
-			monthlyPrice.Value()*float64(to.TotalMonths()),
-			s.price.Amount().Currency(),
-		)
+func (s *subscription) GetRecurrencyAmountAt(to RecurrencyType, t time.Time) currency.Amount {
+	return s.getPriceOverMonthsAt(t, to.TotalMonths())
+}
+
+func (s *subscription) GetNextRenewalDate() *time.Time {
+	return s.nextRenewalDate(time.Now())
+}
+
+func (s *subscription) GetNextRenewalDateIn(loc *time.Location) *time.Time {
+	next := s.nextRenewalDate(floatingTime(time.Now(), loc))
+	if next == nil {
+		return nil
 	}
+	return x.P(inLocation(*next, loc))
+}
 
-	return currency.NewInvalidAmount()
+func (s *subscription) UpcomingRenewalDatesIn(count int, loc *time.Location) []time.Time {
+	if count <= 0 {
+		return nil
+	}
+
+	var dates []time.Time
+	for _, renewal := range s.upcomingRenewals(floatingTime(time.Now(), loc), nil, count) {
+		dates = append(dates, inLocation(renewal, loc))
+	}
+	return dates
 }
 
-func (s *subscription) GetNextRenewalDate() *time.Time {
+func (s *subscription) UpcomingChargesIn(until time.Time, loc *time.Location) []UpcomingCharge {
+	var charges []UpcomingCharge
+	for _, renewal := range s.upcomingRenewals(floatingTime(time.Now(), loc), x.P(floatingTime(until, loc)), 0) {
+		if s.freeTrial != nil && s.freeTrial.Covers(renewal) {
+			continue
+		}
+		amount := s.PriceAt(renewal)
+		if !amount.IsValid() {
+			continue
+		}
+		charges = append(charges, UpcomingCharge{
+			DueDate: inLocation(renewal, loc),
+			Amount:  amount,
+		})
+	}
+	return charges
+}
+
+// upcomingRenewals returns the renewals from now, stopping before until when set and after count renewals
+// when count is positive
+func (s *subscription) upcomingRenewals(now time.Time, until *time.Time, count int) []time.Time {
+	if until == nil && count <= 0 {
+		return nil
+	}
+	if s.recurrency == OneTimeRecurrency {
+		if s.startDate.Before(now) || (until != nil && !s.startDate.Before(*until)) {
+			return nil
+		}
+		return []time.Time{s.startDate}
+	}
+	interval, ok := s.renewalInterval()
+	if !ok {
+		return nil
+	}
+
+	var commitmentEnd *time.Time
+	if s.contract != nil && !s.contract.AutoRenew() {
+		commitmentEnd = s.contract.CommitmentEndDate(s.startDate)
+	}
+
+	var dates []time.Time
+	for k := s.firstOccurrenceFrom(interval, now); count <= 0 || len(dates) < count; k++ {
+		renewal := s.occurrence(interval, k)
+		if until != nil && !renewal.Before(*until) {
+			break
+		}
+		if s.endDate != nil && !renewal.Before(*s.endDate) {
+			break
+		}
+		// Without auto-renew the subscription stops at the end of its commitment
+		if s.contract != nil && !s.contract.AutoRenew() && renewal.After(s.startDate) &&
+			(commitmentEnd == nil || !renewal.Before(*commitmentEnd)) {
+			break
+		}
+		if pause, ok := s.pauseAt(renewal); ok {
+			if pause.EndDate == nil {
+				break
+			}
+			continue
+		}
+		dates = append(dates, renewal)
+	}
+
+	return dates
+}
+
+// nextRenewalDate returns the first renewal that is not before now
+func (s *subscription) nextRenewalDate(now time.Time) *time.Time {
 	if s.endDate != nil {
-		if s.endDate.Before(time.Now()) {
+		if s.endDate.Before(now) {
 			return nil
 		}
 		return s.endDate
 	}
 
-	months := s.getMonthsUntilNextRenewal()
-	if months == 0 {
+	interval, ok := s.renewalInterval()
+	if !ok {
 		return nil
 	}
 
-	now := time.Now()
+	// The next renewal is the first occurrence from the start date that is not in the past
+	return s.nextUnpausedRenewal(s.firstOccurrenceFrom(interval, now), interval)
+}
 
-	// If the subscription starts in the future, next renewal is the start date
-	if s.startDate.After(now) {
-		next := s.startDate
-		return &next
+// occurrence returns the k-th billing occurrence, the first one being the start date
+func (s *subscription) occurrence(interval Interval, k int) time.Time {
+	if k == 0 {
+		return s.startDate
 	}
+	anchorDay := s.startDate.Day()
+	if s.billingAnchorDay != nil {
+		anchorDay = int(*s.billingAnchorDay)
+	}
+	return interval.advance(s.startDate, k, anchorDay)
+}
 
-	// Total whole months between start and now (may be adjusted below)
-	diffMonths := (now.Year()-s.startDate.Year())*12 + int(now.Month()-s.startDate.Month())
-
-	// Align start by diffMonths and adjust to ensure aligned date is <= now.
-	// This avoids partial-month overcounting.
-	aligned := s.startDate.AddDate(0, diffMonths, 0)
-	if aligned.After(now) {
-		diffMonths--
-		aligned = s.startDate.AddDate(0, diffMonths, 0)
+// firstOccurrenceFrom returns the index of the first occurrence that is not before t
+func (s *subscription) firstOccurrenceFrom(interval Interval, t time.Time) int {
+	if !s.startDate.Before(t) {
+		return 0
+	}
+	duration := interval.approximateDuration()
+	if duration <= 0 {
+		return 0
 	}
 
-	// Compute the minimal k such that start + k*months >= now
-	// This is a ceiling division: k = ceil(diffMonths / months)
-	k := diffMonths / months
-	if diffMonths%months != 0 {
+	// Estimate the index from the average interval length, then settle on the exact occurrence
+	k := int(t.Sub(s.startDate) / duration)
+	for k > 0 && !s.occurrence(interval, k-1).Before(t) {
+		k--
+	}
+	for s.occurrence(interval, k).Before(t) {
 		k++
 	}
+	return k
+}
 
-	next := s.startDate.AddDate(0, k*months, 0)
-	// Safety: in rare edge cases (e.g., time-of-day differences), ensure next is not in the past
-	if next.Before(now) {
-		next = next.AddDate(0, months, 0)
+// nextUnpausedRenewal returns the first occurrence from the k-th one that does not fall in a pause,
+// nil when the subscription is paused with no resume date
+func (s *subscription) nextUnpausedRenewal(k int, interval Interval) *time.Time {
+	next := s.occurrence(interval, k)
+	for {
+		pause, ok := s.pauseAt(next)
+		if !ok {
+			break
+		}
+		if pause.EndDate == nil {
+			return nil
+		}
+		k++
+		next = s.occurrence(interval, k)
+	}
+	// Without auto-renew the subscription stops at the end of its commitment instead of renewing
+	if s.contract != nil && !s.contract.AutoRenew() && next.After(s.startDate) {
+		commitmentEnd := s.contract.CommitmentEndDate(s.startDate)
+		if commitmentEnd == nil || !next.Before(*commitmentEnd) {
+			return nil
+		}
 	}
 	return &next
 }
 
-func (s *subscription) getMonthsUntilNextRenewal() int {
+func (s *subscription) CancellationDeadline() *time.Time {
+	return s.cancellationDeadline(time.Now())
+}
+
+func (s *subscription) CancellationDeadlineIn(loc *time.Location) *time.Time {
+	deadline := s.cancellationDeadline(floatingTime(time.Now(), loc))
+	if deadline == nil {
+		return nil
+	}
+	return x.P(inLocation(*deadline, loc))
+}
+
+func (s *subscription) cancellationDeadline(now time.Time) *time.Time {
+	if s.contract == nil || !s.contract.AutoRenew() || s.endDate != nil {
+		return nil
+	}
+
+	interval, ok := s.renewalInterval()
+	if !ok {
+		return nil
+	}
+
+	commitmentEnd := s.contract.CommitmentEndDate(s.startDate)
+	notice := 0
+	if s.contract.NoticePeriod() != nil {
+		notice = int(*s.contract.NoticePeriod())
+	}
+	// Renewals in the past cannot have an upcoming deadline
+	for k := max(1, s.firstOccurrenceFrom(interval, now)); ; k++ {
+		renewal := s.occurrence(interval, k)
+		// Renewals within the minimum term cannot be cancelled
+		if commitmentEnd != nil && renewal.Before(*commitmentEnd) {
+			continue
+		}
+		if pause, ok := s.pauseAt(renewal); ok {
+			if pause.EndDate == nil {
+				return nil
+			}
+			continue
+		}
+		deadline := renewal.AddDate(0, 0, -notice)
+		if deadline.Before(now) {
+			continue
+		}
+		return &deadline
+	}
+}
+
+// renewalInterval returns the interval between two renewals, false when the subscription does not renew
+func (s *subscription) renewalInterval() (Interval, bool) {
 	switch s.recurrency {
 	case MonthlyRecurrency:
-		return 1
+		return Interval{Unit: MonthIntervalUnit, Count: 1}, true
 	case QuarterlyRecurrency:
-		return 3
+		return Interval{Unit: MonthIntervalUnit, Count: 3}, true
 	case HalfYearlyRecurrency:
-		return 6
+		return Interval{Unit: MonthIntervalUnit, Count: 6}, true
 	case YearlyRecurrency:
-		return 12
+		return Interval{Unit: YearIntervalUnit, Count: 1}, true
 	case CustomRecurrency:
-		if s.customRecurrency != nil {
-			return int(*s.customRecurrency)
+		if s.customRecurrency == nil || s.customRecurrency.Months() <= 0 {
+			return Interval{}, false
 		}
-		return 0
+		return *s.customRecurrency, true
 	default:
-		return 0
+		return Interval{}, false
 	}
 }
 
+func (s *subscription) BillingDates(until time.Time) []time.Time {
+	if s.startDate.After(until) {
+		return nil
+	}
+	if s.recurrency == OneTimeRecurrency {
+		return []time.Time{s.startDate}
+	}
+
+	interval, ok := s.renewalInterval()
+	if !ok {
+		return nil
+	}
+
+	var dates []time.Time
+	for i := 0; ; i++ {
+		date := s.occurrence(interval, i)
+		if date.After(until) {
+			break
+		}
+		if s.endDate != nil && !date.Before(*s.endDate) {
+			break
+		}
+		if s.IsPausedAt(date) {
+			continue
+		}
+		dates = append(dates, date)
+	}
+
+	return dates
+}
+
 func (s *subscription) FriendlyName() *string {
 	return s.friendlyName
 }
@@ -327,14 +928,46 @@ func (s *subscription) Price() Price {
 }
 
 func (s *subscription) SetPrice(amount currency.Amount) {
+	previous := s.price.Amount()
 	s.price.SetAmount(amount)
+	// Editing the price corrects the amount in effect today instead of recording a new price change
+	now := time.Now()
+	effectiveFrom := s.startDate
+	var current *PriceChange
+	for change := range s.priceHistory.It() {
+		if change.EffectiveFrom.After(now) {
+			break
+		}
+		current = &change
+	}
+	if current != nil {
+		effectiveFrom = current.EffectiveFrom
+		if !current.Amount.IsEqual(amount) {
+			s.priceHistory.Update(PriceChange{
+				EffectiveFrom: current.EffectiveFrom,
+				Amount:        amount,
+			})
+		}
+	}
 	s.SetAsDirty()
+	if previous == nil || !previous.IsEqual(amount) {
+		s.RecordEvent(newPriceChanged(s.Id(), effectiveFrom, previous, amount))
+	}
 }
 
 func (s *subscription) FreeTrial() FreeTrial {
 	return s.freeTrial
 }
 
+func (s *subscription) Contract() Contract {
+	return s.contract
+}
+
+func (s *subscription) SetContract(contract Contract) {
+	s.contract = contract
+	s.SetAsDirty()
+}
+
 func (s *subscription) ProviderId() types.ProviderID {
 	return s.providerId
 }
@@ -347,6 +980,53 @@ func (s *subscription) Payer() Payer {
 	return s.payer
 }
 
+func (s *subscription) CostSplit() CostSplit {
+	return s.costSplit
+}
+
+func (s *subscription) SetCostSplit(split CostSplit) error {
+	if split != nil {
+		if s.owner.Type() != types.FamilyOwnerType {
+			return ErrCostSplitNeedFamily
+		}
+		if split.Type() == FixedCostSplit && s.price != nil {
+			total := decimal.Zero
+			for _, share := range split.Shares() {
+				total = total.Add(share.Value)
+			}
+			if total.Sub(s.price.Amount().Decimal()).Abs().GreaterThan(costSplitTolerance) {
+				return ErrCostSplitMismatch
+			}
+		}
+	}
+
+	s.costSplit = split
+	s.SetAsDirty()
+
+	return nil
+}
+
+func (s *subscription) PaymentMethodId() *types.PaymentMethodID {
+	return s.paymentMethodId
+}
+
+func (s *subscription) SetPaymentMethodId(paymentMethodId *types.PaymentMethodID) {
+	s.paymentMethodId = paymentMethodId
+	s.SetAsDirty()
+}
+
+func (s *subscription) GetMemberShares(to RecurrencyType) map[types.FamilyMemberID]currency.Amount {
+	return s.SplitAmount(s.GetRecurrencyAmount(to))
+}
+
+func (s *subscription) SplitAmount(amount currency.Amount) map[types.FamilyMemberID]currency.Amount {
+	if s.costSplit == nil {
+		return nil
+	}
+
+	return s.costSplit.Split(amount, s.familyUsers.Values())
+}
+
 func (s *subscription) FamilyUsers() *slicesx.Tracked[types.FamilyMemberID] {
 	return s.familyUsers
 }
@@ -363,7 +1043,7 @@ func (s *subscription) Recurrency() RecurrencyType {
 	return s.recurrency
 }
 
-func (s *subscription) CustomRecurrency() *int32 {
+func (s *subscription) CustomRecurrency() *Interval {
 	return s.customRecurrency
 }
 
@@ -397,6 +1077,21 @@ func (s *subscription) SetFamilyUsers(familyMembers []types.FamilyMemberID) {
 	s.SetAsDirty()
 }
 
+func (s *subscription) SetLabels(labelIds []types.LabelID) {
+	refs := NewSubscriptionLabelRefs(labelIds)
+	for _, ref := range slices.Clone(s.labels.Values()) {
+		if ref.Source == LabelSourceSubscription && !slices.Contains(refs, ref) {
+			s.labels.Remove(ref)
+		}
+	}
+	for _, ref := range refs {
+		if !s.labels.Contains(ref) {
+			s.labels.Add(ref)
+		}
+	}
+	s.SetAsDirty()
+}
+
 func (s *subscription) SetStartDate(startDate time.Time) {
 	s.startDate = startDate
 	s.SetAsDirty()
@@ -412,7 +1107,16 @@ func (s *subscription) SetRecurrency(recurrency RecurrencyType) {
 	s.SetAsDirty()
 }
 
-func (s *subscription) SetCustomRecurrency(customRecurrency *int32) {
+func (s *subscription) BillingAnchorDay() *int32 {
+	return s.billingAnchorDay
+}
+
+func (s *subscription) SetBillingAnchorDay(day *int32) {
+	s.billingAnchorDay = day
+	s.SetAsDirty()
+}
+
+func (s *subscription) SetCustomRecurrency(customRecurrency *Interval) {
 	s.customRecurrency = customRecurrency
 	s.SetAsDirty()
 }
@@ -432,17 +1136,52 @@ func (s *subscription) ETagFields() []interface{} {
 	}
 
 	if s.customRecurrency != nil {
-		fields = append(fields, *s.customRecurrency)
+		fields = append(fields, s.customRecurrency.Unit.String(), s.customRecurrency.Count)
+	}
+
+	if s.billingAnchorDay != nil {
+		fields = append(fields, *s.billingAnchorDay)
+	}
+
+	if s.contract != nil {
+		fields = append(fields, s.contract.ETag())
 	}
 
 	if s.price != nil {
 		fields = append(fields, s.price.ETag())
 	}
 
+	for change := range s.priceHistory.It() {
+		fields = append(fields, change.EffectiveFrom, change.Amount.Value(), change.Amount.Currency().String())
+	}
+
+	for promotion := range s.promotions.It() {
+		fields = append(fields,
+			promotion.StartDate,
+			promotion.EndDate,
+			promotion.Amount.Value(),
+			promotion.Amount.Currency().String())
+	}
+
+	for pause := range s.pauses.It() {
+		fields = append(fields, pause.StartDate)
+		if pause.EndDate != nil {
+			fields = append(fields, *pause.EndDate)
+		}
+	}
+
 	if s.payer != nil {
 		fields = append(fields, s.payer.ETag())
 	}
 
+	if s.costSplit != nil {
+		fields = append(fields, s.costSplit.ETag())
+	}
+
+	if s.paymentMethodId != nil {
+		fields = append(fields, s.paymentMethodId.String())
+	}
+
 	if s.familyUsers != nil && s.familyUsers.Len() > 0 {
 		fields = append(fields, herd.Select(s.familyUsers.Values(), func(su types.FamilyMemberID) string {
 			return su.String()
@@ -477,12 +1216,51 @@ func (s *subscription) GetValidationErrors() validation.Errors {
 		}
 	}
 
+	if s.contract != nil {
+		if err := s.contract.GetValidationErrors(); err != nil {
+			errors = append(errors, err...)
+		}
+	}
+
 	if s.price != nil {
 		if err := s.price.GetValidationErrors(); err != nil {
 			errors = append(errors, err...)
 		}
 	}
 
+	if s.price != nil {
+		for change := range s.priceHistory.It() {
+			if change.Amount.Currency() != s.price.Amount().Currency() {
+				errors = append(errors,
+					validation.NewError("price", "Price currency cannot differ from the price history currency"))
+				break
+			}
+		}
+		for promotion := range s.promotions.It() {
+			if promotion.Amount.Currency() != s.price.Amount().Currency() {
+				errors = append(errors,
+					validation.NewError("promotions", "Promotion currency cannot differ from the price currency"))
+				break
+			}
+		}
+	}
+
+	if s.costSplit != nil {
+		if err := s.costSplit.GetValidationErrors(); err != nil {
+			errors = append(errors, err...)
+		}
+		if s.owner.Type() != types.FamilyOwnerType {
+			errors = append(errors, validation.NewError("costSplit", "CostSplit requires a family owner"))
+		}
+		for _, share := range s.costSplit.Shares() {
+			if !s.familyUsers.Contains(share.MemberId) {
+				errors = append(errors,
+					validation.NewError("costSplit", "CostSplit members must be family users of the subscription"))
+				break
+			}
+		}
+	}
+
 	if s.familyUsers != nil && s.familyUsers.Len() > MaxFamilyMemberPerSubscriptionCount {
 		errors = append(errors, validation.NewError("familyUsers", "Number of service users cannot exceed 10"))
 	}
@@ -493,14 +1271,46 @@ func (s *subscription) GetValidationErrors() validation.Errors {
 				"CustomRecurrency is required when Recurrency is CustomRecurrency"))
 	}
 
-	if s.customRecurrency != nil && *s.customRecurrency <= 0 {
-		errors = append(errors, validation.NewError("customRecurrency", "CustomRecurrency must be greater than 0"))
+	if s.customRecurrency != nil {
+		if s.customRecurrency.Count <= 0 {
+			errors = append(errors, validation.NewError("customRecurrency", "CustomRecurrency must be greater than 0"))
+		}
+		if _, err := ParseIntervalUnit(s.customRecurrency.Unit.String()); err != nil {
+			errors = append(errors, validation.NewError("customRecurrency",
+				"CustomRecurrency unit must be one of day, week, month or year"))
+		}
+	}
+
+	if s.billingAnchorDay != nil && (*s.billingAnchorDay < 1 || *s.billingAnchorDay > 31) {
+		errors = append(errors, validation.NewError("billingAnchorDay", "BillingAnchorDay must be between 1 and 31"))
 	}
 
 	if s.endDate != nil && !s.endDate.IsZero() && s.endDate.Before(s.startDate) {
 		errors = append(errors, validation.NewError("endDate", "EndDate must be after StartDate"))
 	}
 
+	for pause := range s.pauses.It() {
+		if pause.StartDate.Before(s.startDate) {
+			errors = append(errors, validation.NewError("pauses", "Pause cannot start before StartDate"))
+			break
+		}
+		if pause.EndDate != nil && !pause.EndDate.After(pause.StartDate) {
+			errors = append(errors, validation.NewError("pauses", "Pause EndDate must be after its StartDate"))
+			break
+		}
+	}
+
+	for promotion := range s.promotions.It() {
+		if promotion.StartDate.Before(s.startDate) {
+			errors = append(errors, validation.NewError("promotions", "Promotion cannot start before StartDate"))
+			break
+		}
+		if !promotion.EndDate.After(promotion.StartDate) {
+			errors = append(errors, validation.NewError("promotions", "Promotion EndDate must be after its StartDate"))
+			break
+		}
+	}
+
 	if errors.HasErrors() {
 		return errors
 	}
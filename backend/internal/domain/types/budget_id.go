@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+type BudgetID uuid.UUID
+
+func (id BudgetID) String() string {
+	// Convert to real uuid.UUID to avoid infinite recursion
+	u := uuid.UUID(id)
+	return u.String()
+}
+
+func NewBudgetID() BudgetID {
+	return BudgetID(uuid.Must(uuid.NewV7()))
+}
+
+func ParseBudgetID(id string) (BudgetID, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return BudgetID{}, err
+	}
+	return BudgetID(u), nil
+}
+
+func ParseBudgetIDOrNil(id *string) (*BudgetID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	u, err := ParseBudgetID(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func MustParseBudgetID(id string) BudgetID {
+	u, err := ParseBudgetID(id)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+type CalendarFeedID uuid.UUID
+
+func (id CalendarFeedID) String() string {
+	// Convert to real uuid.UUID to avoid infinite recursion
+	u := uuid.UUID(id)
+	return u.String()
+}
+
+func NewCalendarFeedID() CalendarFeedID {
+	return CalendarFeedID(uuid.Must(uuid.NewV7()))
+}
+
+func ParseCalendarFeedID(id string) (CalendarFeedID, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return CalendarFeedID{}, err
+	}
+	return CalendarFeedID(u), nil
+}
+
+func ParseCalendarFeedIDOrNil(id *string) (*CalendarFeedID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	u, err := ParseCalendarFeedID(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func MustParseCalendarFeedID(id string) CalendarFeedID {
+	u, err := ParseCalendarFeedID(id)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+type ChargeID uuid.UUID
+
+func (id ChargeID) String() string {
+	// Convert to real uuid.UUID to avoid infinite recursion
+	u := uuid.UUID(id)
+	return u.String()
+}
+
+func NewChargeID() ChargeID {
+	return ChargeID(uuid.Must(uuid.NewV7()))
+}
+
+func ParseChargeID(id string) (ChargeID, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return ChargeID{}, err
+	}
+	return ChargeID(u), nil
+}
+
+func ParseChargeIDOrNil(id *string) (*ChargeID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	u, err := ParseChargeID(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func MustParseChargeID(id string) ChargeID {
+	u, err := ParseChargeID(id)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+type ManualRateID uuid.UUID
+
+func (id ManualRateID) String() string {
+	// Convert to real uuid.UUID to avoid infinite recursion
+	u := uuid.UUID(id)
+	return u.String()
+}
+
+func NewManualRateID() ManualRateID {
+	return ManualRateID(uuid.Must(uuid.NewV7()))
+}
+
+func ParseManualRateID(id string) (ManualRateID, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return ManualRateID{}, err
+	}
+	return ManualRateID(u), nil
+}
+
+func ParseManualRateIDOrNil(id *string) (*ManualRateID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	u, err := ParseManualRateID(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func MustParseManualRateID(id string) ManualRateID {
+	u, err := ParseManualRateID(id)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
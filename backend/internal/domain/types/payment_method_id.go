@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+type PaymentMethodID uuid.UUID
+
+func (id PaymentMethodID) String() string {
+	// Convert to real uuid.UUID to avoid infinite recursion
+	u := uuid.UUID(id)
+	return u.String()
+}
+
+func NewPaymentMethodID() PaymentMethodID {
+	return PaymentMethodID(uuid.Must(uuid.NewV7()))
+}
+
+func ParsePaymentMethodID(id string) (PaymentMethodID, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return PaymentMethodID{}, err
+	}
+	return PaymentMethodID(u), nil
+}
+
+func ParsePaymentMethodIDOrNil(id *string) (*PaymentMethodID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	u, err := ParsePaymentMethodID(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func MustParsePaymentMethodID(id string) PaymentMethodID {
+	u, err := ParsePaymentMethodID(id)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
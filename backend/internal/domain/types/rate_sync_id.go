@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+type RateSyncID uuid.UUID
+
+func (id RateSyncID) String() string {
+	// Convert to real uuid.UUID to avoid infinite recursion
+	u := uuid.UUID(id)
+	return u.String()
+}
+
+func NewRateSyncID() RateSyncID {
+	return RateSyncID(uuid.Must(uuid.NewV7()))
+}
+
+func ParseRateSyncID(id string) (RateSyncID, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return RateSyncID{}, err
+	}
+	return RateSyncID(u), nil
+}
+
+func ParseRateSyncIDOrNil(id *string) (*RateSyncID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	u, err := ParseRateSyncID(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func MustParseRateSyncID(id string) RateSyncID {
+	u, err := ParseRateSyncID(id)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+type ReminderID uuid.UUID
+
+func (id ReminderID) String() string {
+	// Convert to real uuid.UUID to avoid infinite recursion
+	u := uuid.UUID(id)
+	return u.String()
+}
+
+func NewReminderID() ReminderID {
+	return ReminderID(uuid.Must(uuid.NewV7()))
+}
+
+func ParseReminderID(id string) (ReminderID, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return ReminderID{}, err
+	}
+	return ReminderID(u), nil
+}
+
+func ParseReminderIDOrNil(id *string) (*ReminderID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	u, err := ParseReminderID(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func MustParseReminderID(id string) ReminderID {
+	u, err := ParseReminderID(id)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
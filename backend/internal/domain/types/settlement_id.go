@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+type SettlementID uuid.UUID
+
+func (id SettlementID) String() string {
+	// Convert to real uuid.UUID to avoid infinite recursion
+	u := uuid.UUID(id)
+	return u.String()
+}
+
+func NewSettlementID() SettlementID {
+	return SettlementID(uuid.Must(uuid.NewV7()))
+}
+
+func ParseSettlementID(id string) (SettlementID, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return SettlementID{}, err
+	}
+	return SettlementID(u), nil
+}
+
+func ParseSettlementIDOrNil(id *string) (*SettlementID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	u, err := ParseSettlementID(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func MustParseSettlementID(id string) SettlementID {
+	u, err := ParseSettlementID(id)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
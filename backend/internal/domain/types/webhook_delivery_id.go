@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+type WebhookDeliveryID uuid.UUID
+
+func (id WebhookDeliveryID) String() string {
+	// Convert to real uuid.UUID to avoid infinite recursion
+	u := uuid.UUID(id)
+	return u.String()
+}
+
+func NewWebhookDeliveryID() WebhookDeliveryID {
+	return WebhookDeliveryID(uuid.Must(uuid.NewV7()))
+}
+
+func ParseWebhookDeliveryID(id string) (WebhookDeliveryID, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return WebhookDeliveryID{}, err
+	}
+	return WebhookDeliveryID(u), nil
+}
+
+func ParseWebhookDeliveryIDOrNil(id *string) (*WebhookDeliveryID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	u, err := ParseWebhookDeliveryID(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func MustParseWebhookDeliveryID(id string) WebhookDeliveryID {
+	u, err := ParseWebhookDeliveryID(id)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
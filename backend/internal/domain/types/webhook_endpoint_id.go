@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+type WebhookEndpointID uuid.UUID
+
+func (id WebhookEndpointID) String() string {
+	// Convert to real uuid.UUID to avoid infinite recursion
+	u := uuid.UUID(id)
+	return u.String()
+}
+
+func NewWebhookEndpointID() WebhookEndpointID {
+	return WebhookEndpointID(uuid.Must(uuid.NewV7()))
+}
+
+func ParseWebhookEndpointID(id string) (WebhookEndpointID, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return WebhookEndpointID{}, err
+	}
+	return WebhookEndpointID(u), nil
+}
+
+func ParseWebhookEndpointIDOrNil(id *string) (*WebhookEndpointID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	u, err := ParseWebhookEndpointID(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func MustParseWebhookEndpointID(id string) WebhookEndpointID {
+	u, err := ParseWebhookEndpointID(id)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
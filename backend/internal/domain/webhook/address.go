@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// nonPublicPrefixes are the ranges not covered by the netip predicates that cannot be reached from the internet
+var nonPublicPrefixes = []netip.Prefix{
+	// "this network"
+	netip.MustParsePrefix("0.0.0.0/8"),
+	// shared address space of the carrier-grade NATs
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+// IsPublicAddress reports whether ip may receive webhooks. The host, its private networks and the link-local
+// addresses like the cloud metadata services at 169.254.169.254 are refused so that an endpoint cannot reach the
+// services next to SubTracker.
+func IsPublicAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() ||
+		ip.IsUnspecified() ||
+		ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// isPublicHost reports whether host may be the host of an endpoint. A name is only refused when it always
+// designates the host, its addresses are checked again when a webhook is sent.
+func isPublicHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if ip, err := netip.ParseAddr(host); err == nil {
+		return IsPublicAddress(ip)
+	}
+	return true
+}
@@ -0,0 +1,270 @@
+package webhook
+
+import (
+	"strings"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+const (
+	// MaxAttempts is the number of attempts after which a delivery is given up
+	MaxAttempts    = 8
+	InitialBackoff = 30 * time.Second
+	MaxBackoff     = 4 * time.Hour
+	// maxErrorLength bounds the error kept from a failed attempt
+	maxErrorLength = 500
+)
+
+// DeliveryStatus is the state of the delivery of an event to an endpoint
+type DeliveryStatus string
+
+const (
+	UnknownDeliveryStatus DeliveryStatus = "unknown"
+	// PendingDeliveryStatus is a delivery waiting for its next attempt
+	PendingDeliveryStatus   DeliveryStatus = "pending"
+	SucceededDeliveryStatus DeliveryStatus = "succeeded"
+	// FailedDeliveryStatus is a delivery given up after MaxAttempts attempts
+	FailedDeliveryStatus DeliveryStatus = "failed"
+)
+
+func (s DeliveryStatus) String() string {
+	return string(s)
+}
+
+func ParseDeliveryStatus(input string) (DeliveryStatus, error) {
+	switch input {
+	case string(PendingDeliveryStatus):
+		return PendingDeliveryStatus, nil
+	case string(SucceededDeliveryStatus):
+		return SucceededDeliveryStatus, nil
+	case string(FailedDeliveryStatus):
+		return FailedDeliveryStatus, nil
+	default:
+		return UnknownDeliveryStatus, ErrUnknownDeliveryStatus
+	}
+}
+
+func MustParseDeliveryStatus(input string) DeliveryStatus {
+	s, err := ParseDeliveryStatus(input)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Backoff returns the time to wait after the failed attempt before the next one, it doubles at each attempt
+func Backoff(attempt int) time.Duration {
+	backoff := InitialBackoff
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if backoff >= MaxBackoff {
+			return MaxBackoff
+		}
+	}
+	return backoff
+}
+
+// Delivery is an event sent to an endpoint and the outcome of its attempts, it makes the delivery log of the
+// endpoint. An event is delivered once to an endpoint, a redelivery is a new delivery of the same event.
+type Delivery interface {
+	entity.Entity[types.WebhookDeliveryID]
+	entity.ETagEntity
+
+	EndpointId() types.WebhookEndpointID
+	// EventId identifies the event, it is the same for every delivery of the event
+	EventId() string
+	EventType() EventType
+	Payload() []byte
+	Status() DeliveryStatus
+	Attempts() int
+	// NextAttemptAt is when a pending delivery is attempted
+	NextAttemptAt() *time.Time
+	LastStatusCode() *int
+	LastError() *string
+	DeliveredAt() *time.Time
+	// RedeliveryOf is the delivery this one was redelivered from
+	RedeliveryOf() *types.WebhookDeliveryID
+
+	// RecordSuccess records an attempt answered with a 2xx status code
+	RecordSuccess(statusCode int, at time.Time)
+	// RecordFailure records a failed attempt, statusCode is nil when no response was received. The delivery is
+	// given up after MaxAttempts attempts, otherwise it is attempted again after the backoff.
+	RecordFailure(statusCode *int, reason string, at time.Time)
+	// Redeliver returns a new pending delivery of the event
+	Redeliver() (Delivery, error)
+}
+
+type delivery struct {
+	*entity.Base[types.WebhookDeliveryID]
+
+	endpointId     types.WebhookEndpointID
+	eventId        string
+	eventType      EventType
+	payload        []byte
+	status         DeliveryStatus
+	attempts       int
+	nextAttemptAt  *time.Time
+	lastStatusCode *int
+	lastError      *string
+	deliveredAt    *time.Time
+	redeliveryOf   *types.WebhookDeliveryID
+}
+
+func NewDelivery(
+	id types.WebhookDeliveryID,
+	endpointId types.WebhookEndpointID,
+	eventId string,
+	eventType EventType,
+	payload []byte,
+	status DeliveryStatus,
+	attempts int,
+	nextAttemptAt *time.Time,
+	lastStatusCode *int,
+	lastError *string,
+	deliveredAt *time.Time,
+	redeliveryOf *types.WebhookDeliveryID,
+	createdAt time.Time,
+	updatedAt time.Time) Delivery {
+	return &delivery{
+		Base:           entity.NewBase(id, createdAt, updatedAt, true, false),
+		endpointId:     endpointId,
+		eventId:        eventId,
+		eventType:      eventType,
+		payload:        payload,
+		status:         status,
+		attempts:       attempts,
+		nextAttemptAt:  nextAttemptAt,
+		lastStatusCode: lastStatusCode,
+		lastError:      lastError,
+		deliveredAt:    deliveredAt,
+		redeliveryOf:   redeliveryOf,
+	}
+}
+
+// NewPendingDelivery creates the delivery of an event to an endpoint, attempted as soon as possible
+func NewPendingDelivery(
+	endpointId types.WebhookEndpointID,
+	eventId string,
+	eventType EventType,
+	payload []byte) Delivery {
+	now := time.Now()
+	return NewDelivery(types.NewWebhookDeliveryID(), endpointId, eventId, eventType, payload,
+		PendingDeliveryStatus, 0, &now, nil, nil, nil, nil, now, now)
+}
+
+func (d *delivery) EndpointId() types.WebhookEndpointID {
+	return d.endpointId
+}
+
+func (d *delivery) EventId() string {
+	return d.eventId
+}
+
+func (d *delivery) EventType() EventType {
+	return d.eventType
+}
+
+func (d *delivery) Payload() []byte {
+	return d.payload
+}
+
+func (d *delivery) Status() DeliveryStatus {
+	return d.status
+}
+
+func (d *delivery) Attempts() int {
+	return d.attempts
+}
+
+func (d *delivery) NextAttemptAt() *time.Time {
+	return d.nextAttemptAt
+}
+
+func (d *delivery) LastStatusCode() *int {
+	return d.lastStatusCode
+}
+
+func (d *delivery) LastError() *string {
+	return d.lastError
+}
+
+func (d *delivery) DeliveredAt() *time.Time {
+	return d.deliveredAt
+}
+
+func (d *delivery) RedeliveryOf() *types.WebhookDeliveryID {
+	return d.redeliveryOf
+}
+
+func (d *delivery) RecordSuccess(statusCode int, at time.Time) {
+	d.attempts++
+	d.status = SucceededDeliveryStatus
+	d.nextAttemptAt = nil
+	d.lastStatusCode = &statusCode
+	d.lastError = nil
+	d.deliveredAt = &at
+	d.SetUpdatedAt(at)
+}
+
+func (d *delivery) RecordFailure(statusCode *int, reason string, at time.Time) {
+	d.attempts++
+	d.lastStatusCode = statusCode
+	if len(reason) > maxErrorLength {
+		reason = strings.ToValidUTF8(reason[:maxErrorLength], "")
+	}
+	d.lastError = &reason
+	if d.attempts >= MaxAttempts {
+		d.status = FailedDeliveryStatus
+		d.nextAttemptAt = nil
+	} else {
+		next := at.Add(Backoff(d.attempts))
+		d.nextAttemptAt = &next
+	}
+	d.SetUpdatedAt(at)
+}
+
+func (d *delivery) Redeliver() (Delivery, error) {
+	if d.status == PendingDeliveryStatus {
+		return nil, ErrDeliveryNotRedelivered
+	}
+
+	now := time.Now()
+	id := d.Id()
+	return NewDelivery(types.NewWebhookDeliveryID(), d.endpointId, d.eventId, d.eventType, d.payload,
+		PendingDeliveryStatus, 0, &now, nil, nil, nil, &id, now, now), nil
+}
+
+func (d *delivery) ETagFields() []interface{} {
+	fields := []interface{}{
+		d.endpointId.String(),
+		d.eventId,
+		d.eventType.String(),
+		string(d.payload),
+		d.status.String(),
+		d.attempts,
+	}
+
+	if d.nextAttemptAt != nil {
+		fields = append(fields, *d.nextAttemptAt)
+	}
+	if d.lastStatusCode != nil {
+		fields = append(fields, *d.lastStatusCode)
+	}
+	if d.lastError != nil {
+		fields = append(fields, *d.lastError)
+	}
+	if d.deliveredAt != nil {
+		fields = append(fields, *d.deliveredAt)
+	}
+	if d.redeliveryOf != nil {
+		fields = append(fields, d.redeliveryOf.String())
+	}
+
+	return fields
+}
+
+func (d *delivery) ETag() string {
+	return entity.CalculateETag(d)
+}
@@ -0,0 +1,65 @@
+package webhook_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+)
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, webhook.Backoff(1))
+	assert.Equal(t, time.Minute, webhook.Backoff(2))
+	assert.Equal(t, 8*time.Minute, webhook.Backoff(5))
+	assert.Equal(t, webhook.MaxBackoff, webhook.Backoff(20))
+}
+
+func TestDelivery_RecordFailure(t *testing.T) {
+	d := webhook.NewPendingDelivery(types.NewWebhookEndpointID(), "event-1", webhook.LabelCreatedEventType,
+		[]byte(`{}`))
+	at := time.Now()
+	statusCode := 500
+
+	d.RecordFailure(&statusCode, "unexpected status code 500", at)
+	assert.Equal(t, webhook.PendingDeliveryStatus, d.Status())
+	assert.Equal(t, 1, d.Attempts())
+	require.NotNil(t, d.NextAttemptAt())
+	assert.Equal(t, at.Add(webhook.InitialBackoff), *d.NextAttemptAt())
+	assert.Equal(t, &statusCode, d.LastStatusCode())
+
+	for d.Attempts() < webhook.MaxAttempts {
+		d.RecordFailure(nil, strings.Repeat("é", 300), at)
+	}
+	assert.Equal(t, webhook.FailedDeliveryStatus, d.Status())
+	assert.Nil(t, d.NextAttemptAt())
+	assert.Nil(t, d.LastStatusCode())
+	require.NotNil(t, d.LastError())
+	assert.LessOrEqual(t, len(*d.LastError()), 500)
+}
+
+func TestDelivery_Redeliver(t *testing.T) {
+	d := webhook.NewPendingDelivery(types.NewWebhookEndpointID(), "event-1", webhook.LabelCreatedEventType,
+		[]byte(`{}`))
+
+	_, err := d.Redeliver()
+	assert.ErrorIs(t, err, webhook.ErrDeliveryNotRedelivered)
+
+	d.RecordSuccess(204, time.Now())
+	assert.Equal(t, webhook.SucceededDeliveryStatus, d.Status())
+	require.NotNil(t, d.DeliveredAt())
+
+	redelivery, err := d.Redeliver()
+	require.NoError(t, err)
+	assert.NotEqual(t, d.Id(), redelivery.Id())
+	assert.Equal(t, webhook.PendingDeliveryStatus, redelivery.Status())
+	assert.Equal(t, 0, redelivery.Attempts())
+	assert.Equal(t, d.EventId(), redelivery.EventId())
+	assert.Equal(t, d.Payload(), redelivery.Payload())
+	require.NotNil(t, redelivery.RedeliveryOf())
+	assert.Equal(t, d.Id(), *redelivery.RedeliveryOf())
+}
@@ -0,0 +1,173 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/entity"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/x/validation"
+)
+
+const (
+	// MaxEndpoints bounds the number of webhook endpoints of an account
+	MaxEndpoints = 10
+	secretSize   = 32
+	secretPrefix = "whsec_"
+)
+
+// Endpoint is a URL of an account that receives the events it subscribed to. An endpoint without event types
+// receives every event. The secret signs the requests, it is only shown when the endpoint is created.
+type Endpoint interface {
+	entity.Entity[types.WebhookEndpointID]
+	entity.ETagEntity
+
+	UserId() types.UserID
+	Url() string
+	Secret() string
+	EventTypes() []EventType
+	Enabled() bool
+	// Accepts tells whether the endpoint is to receive an event of the type
+	Accepts(eventType EventType) bool
+	GetValidationErrors() validation.Errors
+
+	SetUrl(url string)
+	SetEventTypes(eventTypes []EventType)
+	SetEnabled(enabled bool)
+}
+
+type endpoint struct {
+	*entity.Base[types.WebhookEndpointID]
+
+	userId     types.UserID
+	url        string
+	secret     string
+	eventTypes []EventType
+	enabled    bool
+}
+
+func NewEndpoint(
+	id types.WebhookEndpointID,
+	userId types.UserID,
+	url string,
+	secret string,
+	eventTypes []EventType,
+	enabled bool,
+	createdAt time.Time,
+	updatedAt time.Time) Endpoint {
+	return &endpoint{
+		Base:       entity.NewBase(id, createdAt, updatedAt, true, false),
+		userId:     userId,
+		url:        url,
+		secret:     secret,
+		eventTypes: eventTypes,
+		enabled:    enabled,
+	}
+}
+
+// CreateEndpoint creates an enabled endpoint of an account with a new secret
+func CreateEndpoint(userId types.UserID, url string, eventTypes []EventType) (Endpoint, error) {
+	secret, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return NewEndpoint(types.NewWebhookEndpointID(), userId, url, secret, eventTypes, true, now, now), nil
+}
+
+func newSecret() (string, error) {
+	randomBytes := make([]byte, secretSize)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+
+	return secretPrefix + base64.RawURLEncoding.EncodeToString(randomBytes), nil
+}
+
+func (e *endpoint) UserId() types.UserID {
+	return e.userId
+}
+
+func (e *endpoint) Url() string {
+	return e.url
+}
+
+func (e *endpoint) Secret() string {
+	return e.secret
+}
+
+func (e *endpoint) EventTypes() []EventType {
+	return e.eventTypes
+}
+
+func (e *endpoint) Enabled() bool {
+	return e.enabled
+}
+
+func (e *endpoint) Accepts(eventType EventType) bool {
+	if !e.enabled {
+		return false
+	}
+	return len(e.eventTypes) == 0 || slices.Contains(e.eventTypes, eventType)
+}
+
+func (e *endpoint) GetValidationErrors() validation.Errors {
+	var errors validation.Errors
+
+	u, err := url.ParseRequestURI(e.url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errors = append(errors, validation.NewError("url", "URL must be an absolute http or https URL"))
+	} else if !isPublicHost(u.Hostname()) {
+		errors = append(errors, validation.NewError("url", "URL must not target a local or private network address"))
+	}
+
+	for _, eventType := range e.eventTypes {
+		if _, err := ParseEventType(eventType.String()); err != nil {
+			errors = append(errors, validation.NewError("event_types", "Unknown event type "+eventType.String()))
+		}
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+
+	return nil
+}
+
+func (e *endpoint) SetUrl(url string) {
+	e.url = url
+	e.SetUpdatedAt(time.Now())
+}
+
+func (e *endpoint) SetEventTypes(eventTypes []EventType) {
+	e.eventTypes = eventTypes
+	e.SetUpdatedAt(time.Now())
+}
+
+func (e *endpoint) SetEnabled(enabled bool) {
+	e.enabled = enabled
+	e.SetUpdatedAt(time.Now())
+}
+
+func (e *endpoint) ETagFields() []interface{} {
+	fields := []interface{}{
+		e.userId.String(),
+		e.url,
+		e.secret,
+		e.enabled,
+	}
+
+	for _, eventType := range e.eventTypes {
+		fields = append(fields, eventType.String())
+	}
+
+	return fields
+}
+
+func (e *endpoint) ETag() string {
+	return entity.CalculateETag(e)
+}
@@ -0,0 +1,69 @@
+package webhook_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+)
+
+func TestCreateEndpoint(t *testing.T) {
+	e, err := webhook.CreateEndpoint(types.UserID("user-1"), "https://example.com/hooks", nil)
+	require.NoError(t, err)
+	assert.True(t, e.Enabled())
+	assert.True(t, strings.HasPrefix(e.Secret(), "whsec_"))
+
+	other, err := webhook.CreateEndpoint(types.UserID("user-1"), "https://example.com/hooks", nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, e.Secret(), other.Secret())
+}
+
+func TestEndpoint_Accepts(t *testing.T) {
+	newEndpoint := func(enabled bool, eventTypes ...webhook.EventType) webhook.Endpoint {
+		return webhook.NewEndpoint(types.NewWebhookEndpointID(), types.UserID("user-1"), "https://example.com",
+			"whsec_secret", eventTypes, enabled, time.Now(), time.Now())
+	}
+
+	assert.True(t, newEndpoint(true).Accepts(webhook.RenewalDueEventType))
+	assert.True(t, newEndpoint(true, webhook.LabelCreatedEventType).Accepts(webhook.LabelCreatedEventType))
+	assert.False(t, newEndpoint(true, webhook.LabelCreatedEventType).Accepts(webhook.LabelDeletedEventType))
+	assert.False(t, newEndpoint(false).Accepts(webhook.LabelCreatedEventType))
+}
+
+func TestEndpoint_GetValidationErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		eventTypes []webhook.EventType
+		fields     []string
+	}{
+		{"https URL", "https://example.com/hooks", nil, nil},
+		{"http URL with a port", "http://example.com:8080/hooks", []webhook.EventType{webhook.RenewalDueEventType},
+			nil},
+		{"localhost", "http://localhost:8080/hooks", nil, []string{"url"}},
+		{"loopback address", "http://127.0.0.1/hooks", nil, []string{"url"}},
+		{"IPv6 loopback address", "http://[::1]:8080/hooks", nil, []string{"url"}},
+		{"private network address", "https://192.168.1.10/hooks", nil, []string{"url"}},
+		{"metadata service", "http://169.254.169.254/latest/meta-data", nil, []string{"url"}},
+		{"relative URL", "/hooks", nil, []string{"url"}},
+		{"other scheme", "ftp://example.com/hooks", nil, []string{"url"}},
+		{"unknown event type", "https://example.com", []webhook.EventType{"label.renamed"}, []string{"event_types"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := webhook.NewEndpoint(types.NewWebhookEndpointID(), types.UserID("user-1"), tt.url, "whsec_secret",
+				tt.eventTypes, true, time.Now(), time.Now())
+			errs := e.GetValidationErrors()
+			var fields []string
+			for _, err := range errs {
+				fields = append(fields, err.Field())
+			}
+			assert.Equal(t, tt.fields, fields)
+		})
+	}
+}
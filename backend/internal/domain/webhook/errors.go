@@ -0,0 +1,15 @@
+package webhook
+
+import (
+	ex "github.com/mistribe/subtracker/pkg/x/exception"
+)
+
+var (
+	ErrEndpointNotFound       = ex.NewNotFound("webhook endpoint not found")
+	ErrDeliveryNotFound       = ex.NewNotFound("webhook delivery not found")
+	ErrEndpointLimitReached   = ex.NewInvalidValue("webhook endpoint limit reached")
+	ErrUnknownEventType       = ex.NewInvalidValue("unknown webhook event type")
+	ErrUnknownDeliveryStatus  = ex.NewInvalidValue("unknown webhook delivery status")
+	ErrNonPublicAddress       = ex.NewInvalidValue("webhook endpoint address is not public")
+	ErrDeliveryNotRedelivered = ex.NewInvalidOperation("a pending webhook delivery cannot be redelivered")
+)
@@ -0,0 +1,66 @@
+package webhook
+
+// EventType is the kind of change a webhook endpoint is notified of
+type EventType string
+
+const (
+	UnknownEventType             EventType = "unknown"
+	SubscriptionCreatedEventType EventType = "subscription.created"
+	SubscriptionUpdatedEventType EventType = "subscription.updated"
+	SubscriptionDeletedEventType EventType = "subscription.deleted"
+	LabelCreatedEventType        EventType = "label.created"
+	LabelUpdatedEventType        EventType = "label.updated"
+	LabelDeletedEventType        EventType = "label.deleted"
+	ProviderCreatedEventType     EventType = "provider.created"
+	ProviderUpdatedEventType     EventType = "provider.updated"
+	ProviderDeletedEventType     EventType = "provider.deleted"
+	FamilyCreatedEventType       EventType = "family.created"
+	FamilyUpdatedEventType       EventType = "family.updated"
+	FamilyDeletedEventType       EventType = "family.deleted"
+	// RenewalDueEventType is sent on the day a subscription renews, in the timezone of the account
+	RenewalDueEventType EventType = "renewal.due"
+)
+
+var eventTypes = []EventType{
+	SubscriptionCreatedEventType,
+	SubscriptionUpdatedEventType,
+	SubscriptionDeletedEventType,
+	LabelCreatedEventType,
+	LabelUpdatedEventType,
+	LabelDeletedEventType,
+	ProviderCreatedEventType,
+	ProviderUpdatedEventType,
+	ProviderDeletedEventType,
+	FamilyCreatedEventType,
+	FamilyUpdatedEventType,
+	FamilyDeletedEventType,
+	RenewalDueEventType,
+}
+
+// GetEventTypes returns every event type an endpoint can subscribe to
+func GetEventTypes() []EventType {
+	types := make([]EventType, len(eventTypes))
+	copy(types, eventTypes)
+	return types
+}
+
+func (t EventType) String() string {
+	return string(t)
+}
+
+func ParseEventType(input string) (EventType, error) {
+	for _, t := range eventTypes {
+		if input == string(t) {
+			return t, nil
+		}
+	}
+	return UnknownEventType, ErrUnknownEventType
+}
+
+func MustParseEventType(input string) EventType {
+	t, err := ParseEventType(input)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
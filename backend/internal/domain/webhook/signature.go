@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the timestamp of the request and the signature of the payload, as t=<unix>,v1=<hex>
+	SignatureHeader = "X-SubTracker-Signature"
+	EventHeader     = "X-SubTracker-Event"
+	DeliveryHeader  = "X-SubTracker-Delivery"
+)
+
+// Sign returns the value of the signature header of a payload sent at timestamp. The signature is the
+// HMAC-SHA256 of "<unix timestamp>.<payload>" keyed with the secret of the endpoint.
+func Sign(secret string, timestamp time.Time, payload []byte) string {
+	unix := timestamp.Unix()
+	return fmt.Sprintf("t=%d,v1=%s", unix, hex.EncodeToString(computeSignature(secret, unix, payload)))
+}
+
+// Verify tells whether the signature header matches the payload, what a receiver does before trusting a request
+func Verify(secret string, header string, payload []byte) bool {
+	var unix int64
+	var signature []byte
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return false
+		}
+		switch key {
+		case "t":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return false
+			}
+			unix = parsed
+		case "v1":
+			decoded, err := hex.DecodeString(value)
+			if err != nil {
+				return false
+			}
+			signature = decoded
+		}
+	}
+	if unix == 0 || signature == nil {
+		return false
+	}
+
+	return hmac.Equal(signature, computeSignature(secret, unix, payload))
+}
+
+func computeSignature(secret string, unix int64, payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(unix, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,39 @@
+package webhook_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+)
+
+func TestSign(t *testing.T) {
+	payload := []byte(`{"type":"label.created"}`)
+	at := time.Unix(1736935800, 0)
+
+	header := webhook.Sign("whsec_secret", at, payload)
+	assert.Regexp(t, `^t=1736935800,v1=[0-9a-f]{64}$`, header)
+	assert.Equal(t, header, webhook.Sign("whsec_secret", at, payload))
+
+	tests := []struct {
+		name    string
+		secret  string
+		header  string
+		payload []byte
+		want    bool
+	}{
+		{"matching signature", "whsec_secret", header, payload, true},
+		{"other secret", "whsec_other", header, payload, false},
+		{"altered payload", "whsec_secret", header, []byte(`{"type":"label.deleted"}`), false},
+		{"altered timestamp", "whsec_secret", "t=1736935801" + header[len("t=1736935800"):], payload, false},
+		{"no timestamp", "whsec_secret", header[len("t=1736935800,"):], payload, false},
+		{"malformed header", "whsec_secret", "v1", payload, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, webhook.Verify(tt.secret, tt.header, tt.payload))
+		})
+	}
+}
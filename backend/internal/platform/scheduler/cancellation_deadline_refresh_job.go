@@ -0,0 +1,67 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Oleexo/config-go"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+const (
+	// CancellationDeadlineRefreshIntervalKey is the time between two refreshes of the stored cancellation deadlines, a duration such as "30s" or "1h"
+	CancellationDeadlineRefreshIntervalKey     = "CANCELLATION_DEADLINE_REFRESH_INTERVAL"
+	DefaultCancellationDeadlineRefreshInterval = time.Hour
+)
+
+// cancellationDeadlineRefreshJob moves the stored cancellation deadlines that passed to the next renewal
+type cancellationDeadlineRefreshJob struct {
+	handler  ports.CommandHandler[command.RefreshCancellationDeadlinesCommand, command.RefreshCancellationDeadlinesResult]
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func newCancellationDeadlineRefreshJob(
+	handler ports.CommandHandler[command.RefreshCancellationDeadlinesCommand, command.RefreshCancellationDeadlinesResult],
+	cfg config.Configuration,
+	logger *slog.Logger) (*cancellationDeadlineRefreshJob, error) {
+	interval, err := durationOrDefault(cfg, CancellationDeadlineRefreshIntervalKey,
+		DefaultCancellationDeadlineRefreshInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &cancellationDeadlineRefreshJob{
+		handler:  handler,
+		interval: interval,
+		logger:   logger,
+	}, nil
+}
+
+func (j cancellationDeadlineRefreshJob) Name() string {
+	return "cancellation_deadline_refresh"
+}
+
+func (j cancellationDeadlineRefreshJob) Interval() time.Duration {
+	return j.interval
+}
+
+func (j cancellationDeadlineRefreshJob) Run(ctx context.Context) error {
+	r := j.handler.Handle(ctx, command.RefreshCancellationDeadlinesCommand{})
+	return result.Match(r, func(report command.RefreshCancellationDeadlinesResult) error {
+		for _, err := range report.Errors {
+			j.logger.Error("failed to refresh cancellation deadline", slog.Any("error", err))
+		}
+		if report.Refreshed > 0 || len(report.Errors) > 0 {
+			j.logger.Info("cancellation deadlines refreshed",
+				slog.Int("refreshed", report.Refreshed),
+				slog.Int("failed_subscriptions", len(report.Errors)))
+		}
+		return nil
+	}, func(err error) error {
+		return err
+	})
+}
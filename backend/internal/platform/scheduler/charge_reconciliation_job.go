@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Oleexo/config-go"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+const (
+	// ChargeReconciliationIntervalKey is the time between two reconciliations of the charge ledgers, a duration such as "30s" or "1h"
+	ChargeReconciliationIntervalKey     = "CHARGE_RECONCILIATION_INTERVAL"
+	DefaultChargeReconciliationInterval = time.Hour
+)
+
+// chargeReconciliationJob materializes the billing occurrences of every subscription into its charge ledger
+type chargeReconciliationJob struct {
+	handler  ports.CommandHandler[command.ReconcileChargesCommand, command.ReconcileChargesResult]
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func newChargeReconciliationJob(
+	handler ports.CommandHandler[command.ReconcileChargesCommand, command.ReconcileChargesResult],
+	cfg config.Configuration,
+	logger *slog.Logger) (*chargeReconciliationJob, error) {
+	interval, err := durationOrDefault(cfg, ChargeReconciliationIntervalKey, DefaultChargeReconciliationInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &chargeReconciliationJob{
+		handler:  handler,
+		interval: interval,
+		logger:   logger,
+	}, nil
+}
+
+func (j chargeReconciliationJob) Name() string {
+	return "charge_reconciliation"
+}
+
+func (j chargeReconciliationJob) Interval() time.Duration {
+	return j.interval
+}
+
+func (j chargeReconciliationJob) Run(ctx context.Context) error {
+	r := j.handler.Handle(ctx, command.ReconcileChargesCommand{})
+	return result.Match(r, func(report command.ReconcileChargesResult) error {
+		for _, err := range report.Errors {
+			j.logger.Error("failed to reconcile charges", slog.Any("error", err))
+		}
+		j.logger.Info("charges reconciled",
+			slog.Int("created", report.Created),
+			slog.Int("updated", report.Updated),
+			slog.Int("removed", report.Removed),
+			slog.Int("failed_subscriptions", len(report.Errors)))
+		return nil
+	}, func(err error) error {
+		return err
+	})
+}
@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Oleexo/config-go"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/outbox/command"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+const (
+	// OutboxPurgeIntervalKey is the time between two purges of the processed events of the outbox, a duration such as "30s" or "1h"
+	OutboxPurgeIntervalKey     = "OUTBOX_PURGE_INTERVAL"
+	DefaultOutboxPurgeInterval = time.Hour
+	// OutboxRetentionKey is how long the processed events are kept in the outbox, a duration such as "30s" or "1h"
+	OutboxRetentionKey     = "OUTBOX_RETENTION"
+	DefaultOutboxRetention = 7 * 24 * time.Hour
+)
+
+// outboxPurgeJob deletes the events of the outbox processed longer ago than the retention
+type outboxPurgeJob struct {
+	handler   ports.CommandHandler[command.PurgeEventsCommand, int64]
+	interval  time.Duration
+	retention time.Duration
+	logger    *slog.Logger
+}
+
+func newOutboxPurgeJob(
+	handler ports.CommandHandler[command.PurgeEventsCommand, int64],
+	cfg config.Configuration,
+	logger *slog.Logger) (*outboxPurgeJob, error) {
+	interval, err := durationOrDefault(cfg, OutboxPurgeIntervalKey, DefaultOutboxPurgeInterval)
+	if err != nil {
+		return nil, err
+	}
+	retention, err := durationOrDefault(cfg, OutboxRetentionKey, DefaultOutboxRetention)
+	if err != nil {
+		return nil, err
+	}
+	return &outboxPurgeJob{
+		handler:   handler,
+		interval:  interval,
+		retention: retention,
+		logger:    logger,
+	}, nil
+}
+
+func (j outboxPurgeJob) Name() string {
+	return "outbox_purge"
+}
+
+func (j outboxPurgeJob) Interval() time.Duration {
+	return j.interval
+}
+
+func (j outboxPurgeJob) Run(ctx context.Context) error {
+	r := j.handler.Handle(ctx, command.PurgeEventsCommand{Retention: j.retention})
+	return result.Match(r, func(deleted int64) error {
+		if deleted > 0 {
+			j.logger.Info("outbox events purged", slog.Int64("deleted", deleted))
+		}
+		return nil
+	}, func(err error) error {
+		return err
+	})
+}
@@ -0,0 +1,69 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Oleexo/config-go"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/outbox/command"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+const (
+	// OutboxRelayIntervalKey is the time between two looks for the pending events of the outbox, a duration such as "30s" or "1h"
+	OutboxRelayIntervalKey     = "OUTBOX_RELAY_INTERVAL"
+	DefaultOutboxRelayInterval = 5 * time.Second
+	// OutboxRelayBatchKey is the maximum number of events published by a run
+	OutboxRelayBatchKey     = "OUTBOX_RELAY_BATCH"
+	DefaultOutboxRelayBatch = 100
+)
+
+// outboxRelayJob publishes the events saved in the outbox with the changes that recorded them
+type outboxRelayJob struct {
+	handler  ports.CommandHandler[command.RelayEventsCommand, ports.OutboxRelayResult]
+	interval time.Duration
+	batch    int64
+	logger   *slog.Logger
+}
+
+func newOutboxRelayJob(
+	handler ports.CommandHandler[command.RelayEventsCommand, ports.OutboxRelayResult],
+	cfg config.Configuration,
+	logger *slog.Logger) (*outboxRelayJob, error) {
+	interval, err := durationOrDefault(cfg, OutboxRelayIntervalKey, DefaultOutboxRelayInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &outboxRelayJob{
+		handler:  handler,
+		interval: interval,
+		batch:    cfg.GetIntOrDefault(OutboxRelayBatchKey, DefaultOutboxRelayBatch),
+		logger:   logger,
+	}, nil
+}
+
+func (j outboxRelayJob) Name() string {
+	return "outbox_relay"
+}
+
+func (j outboxRelayJob) Interval() time.Duration {
+	return j.interval
+}
+
+func (j outboxRelayJob) Run(ctx context.Context) error {
+	r := j.handler.Handle(ctx, command.RelayEventsCommand{Limit: j.batch})
+	return result.Match(r, func(report ports.OutboxRelayResult) error {
+		if report.Published > 0 || report.Retried > 0 || report.Failed > 0 {
+			j.logger.Info("outbox events relayed",
+				slog.Int("published", report.Published),
+				slog.Int("retried", report.Retried),
+				slog.Int("failed", report.Failed))
+		}
+		return nil
+	}, func(err error) error {
+		return err
+	})
+}
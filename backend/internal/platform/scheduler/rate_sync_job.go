@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Oleexo/config-go"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/currency/command"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+const (
+	// RateSyncIntervalKey is the time between two synchronizations of the market rates, a duration such as "30s" or "1h"
+	RateSyncIntervalKey     = "EXCHANGE_RATE_SYNC_INTERVAL"
+	DefaultRateSyncInterval = 24 * time.Hour
+	// RateSyncDaysKey is the number of past days whose missing rates are backfilled
+	RateSyncDaysKey     = "EXCHANGE_RATE_SYNC_DAYS"
+	DefaultRateSyncDays = 365
+)
+
+// rateSyncJob backfills the market rates missing from the database
+type rateSyncJob struct {
+	handler  ports.CommandHandler[command.SyncRatesCommand, currency.RateSync]
+	interval time.Duration
+	days     int
+	logger   *slog.Logger
+}
+
+func newRateSyncJob(
+	handler ports.CommandHandler[command.SyncRatesCommand, currency.RateSync],
+	cfg config.Configuration,
+	logger *slog.Logger) (*rateSyncJob, error) {
+	interval, err := durationOrDefault(cfg, RateSyncIntervalKey, DefaultRateSyncInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &rateSyncJob{
+		handler:  handler,
+		interval: interval,
+		days:     int(cfg.GetIntOrDefault(RateSyncDaysKey, DefaultRateSyncDays)),
+		logger:   logger,
+	}, nil
+}
+
+func (j rateSyncJob) Name() string {
+	return "rate_sync"
+}
+
+func (j rateSyncJob) Interval() time.Duration {
+	return j.interval
+}
+
+func (j rateSyncJob) Run(ctx context.Context) error {
+	r := j.handler.Handle(ctx, command.SyncRatesCommand{Days: j.days})
+	return result.Match(r, func(sync currency.RateSync) error {
+		j.logger.Info("market rates synchronized",
+			slog.Int("processed_days", sync.ProcessedDays()),
+			slog.Int("filled_days", sync.FilledDays()),
+			slog.Int("unavailable_days", sync.UnavailableDays()))
+		return nil
+	}, func(err error) error {
+		return err
+	})
+}
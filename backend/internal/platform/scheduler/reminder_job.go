@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Oleexo/config-go"
+
+	"github.com/mistribe/subtracker/internal/adapters/email"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/notification/command"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+const (
+	// ReminderIntervalKey is the time between two looks for the reminders to send, a duration such as "30s" or "1h"
+	ReminderIntervalKey     = "NOTIFICATION_REMINDER_INTERVAL"
+	DefaultReminderInterval = time.Hour
+)
+
+// reminderJob emails the reminders of the upcoming events, it does nothing until an SMTP server is configured
+type reminderJob struct {
+	handler  ports.CommandHandler[command.SendRemindersCommand, command.SendRemindersResult]
+	interval time.Duration
+	enabled  bool
+	logger   *slog.Logger
+}
+
+func newReminderJob(
+	handler ports.CommandHandler[command.SendRemindersCommand, command.SendRemindersResult],
+	cfg config.Configuration,
+	logger *slog.Logger) (*reminderJob, error) {
+	interval, err := durationOrDefault(cfg, ReminderIntervalKey, DefaultReminderInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &reminderJob{
+		handler:  handler,
+		interval: interval,
+		enabled:  cfg.GetStringOrDefault(email.SMTPHostKey, "") != "",
+		logger:   logger,
+	}, nil
+}
+
+func (j reminderJob) Name() string {
+	return "reminders"
+}
+
+func (j reminderJob) Interval() time.Duration {
+	return j.interval
+}
+
+func (j reminderJob) Run(ctx context.Context) error {
+	if !j.enabled {
+		j.logger.Debug("reminders skipped, no SMTP server configured")
+		return nil
+	}
+
+	r := j.handler.Handle(ctx, command.SendRemindersCommand{})
+	return result.Match(r, func(report command.SendRemindersResult) error {
+		for _, err := range report.Errors {
+			j.logger.Error("failed to send reminders", slog.Any("error", err))
+		}
+		j.logger.Info("reminders sent",
+			slog.Int("sent", report.Sent),
+			slog.Int("failed_accounts", len(report.Errors)))
+		return nil
+	}, func(err error) error {
+		return err
+	})
+}
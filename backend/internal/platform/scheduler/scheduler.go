@@ -0,0 +1,175 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Oleexo/config-go"
+	"go.uber.org/fx"
+)
+
+const (
+	// EnabledKey turns off every scheduled job when false
+	EnabledKey = "SCHEDULER_ENABLED"
+	// slowRunThreshold is the duration from which a successful run is logged at the info level
+	slowRunThreshold = time.Minute
+)
+
+// Job is a task run in the background at a fixed interval
+type Job interface {
+	Name() string
+	// Interval is the time between the end of a run and the start of the next one
+	Interval() time.Duration
+	Run(ctx context.Context) error
+}
+
+// Scheduler runs every job once at start then after each interval, until the application stops
+type Scheduler struct {
+	jobs   []Job
+	logger *slog.Logger
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+type SchedulerParams struct {
+	fx.In
+
+	Jobs      []Job `group:"scheduled_jobs"`
+	Config    config.Configuration
+	Lifecycle fx.Lifecycle
+	Logger    *slog.Logger
+}
+
+func NewScheduler(params SchedulerParams) *Scheduler {
+	s := &Scheduler{
+		jobs:   params.Jobs,
+		logger: params.Logger,
+	}
+	if params.Config.GetBoolOrDefault(EnabledKey, true) {
+		params.Lifecycle.Append(fx.Hook{
+			OnStart: func(context.Context) error {
+				s.Start()
+				return nil
+			},
+			OnStop: s.Stop,
+		})
+	}
+	return s
+}
+
+// Start runs each job in its own goroutine, the runs do not depend on the start context
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}()
+	}
+}
+
+// Stop cancels the running jobs and waits for them until ctx is done
+func (s *Scheduler) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	for {
+		s.run(ctx, job)
+
+		timer := time.NewTimer(job.Interval())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	startedAt := time.Now()
+	s.logger.Debug("scheduled job started", slog.String("job", job.Name()))
+	if err := job.Run(ctx); err != nil {
+		s.logger.Error("scheduled job failed",
+			slog.String("job", job.Name()),
+			slog.Duration("duration", time.Since(startedAt)),
+			slog.Any("error", err))
+		return
+	}
+
+	duration := time.Since(startedAt)
+	level := slog.LevelDebug
+	if duration >= slowRunThreshold {
+		level = slog.LevelInfo
+	}
+	s.logger.Log(ctx, level, "scheduled job completed",
+		slog.String("job", job.Name()),
+		slog.Duration("duration", duration))
+}
+
+// durationOrDefault reads the duration of key written with its unit, such as "30s" or "1h", defaultValue when the
+// key is not set
+func durationOrDefault(cfg config.Configuration, key string, defaultValue time.Duration) (time.Duration, error) {
+	value := cfg.GetStringOrDefault(key, "")
+	if value == "" {
+		// The environment reads a number without unit as an integer
+		if number := cfg.GetIntOrDefault(key, 0); number != 0 {
+			return 0, fmt.Errorf("%s must be a duration with its unit such as \"30s\", got %d", key, number)
+		}
+		return defaultValue, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a duration such as \"30s\": %w", key, err)
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("%s must be a positive duration, got %s", key, value)
+	}
+	return duration, nil
+}
+
+func AsJob(f any) any {
+	return fx.Annotate(f,
+		fx.As(new(Job)),
+		fx.ResultTags(`group:"scheduled_jobs"`),
+	)
+}
+
+func BuildSchedulerModule() fx.Option {
+	return fx.Module("scheduler",
+		fx.Provide(
+			AsJob(newRateSyncJob),
+			AsJob(newReminderJob),
+			AsJob(newWebhookDeliveryJob),
+			AsJob(newWebhookRenewalJob),
+			AsJob(newOutboxRelayJob),
+			AsJob(newOutboxPurgeJob),
+			AsJob(newChargeReconciliationJob),
+			AsJob(newCancellationDeadlineRefreshJob),
+			NewScheduler,
+		),
+		fx.Invoke(func(s *Scheduler) {}),
+	)
+}
@@ -0,0 +1,87 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Oleexo/config-go"
+	"github.com/Oleexo/config-go/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingJob struct {
+	runs     atomic.Int32
+	interval time.Duration
+	err      error
+}
+
+func (j *countingJob) Name() string {
+	return "counting"
+}
+
+func (j *countingJob) Interval() time.Duration {
+	return j.interval
+}
+
+func (j *countingJob) Run(context.Context) error {
+	j.runs.Add(1)
+	return j.err
+}
+
+func TestScheduler(t *testing.T) {
+	t.Run("runs the jobs until stopped", func(t *testing.T) {
+		job := &countingJob{interval: time.Millisecond}
+		failing := &countingJob{interval: time.Millisecond, err: errors.New("failed")}
+		s := &Scheduler{jobs: []Job{job, failing}, logger: slog.New(slog.DiscardHandler)}
+
+		s.Start()
+		assert.Eventually(t, func() bool {
+			return job.runs.Load() >= 2 && failing.runs.Load() >= 2
+		}, time.Second, time.Millisecond)
+		require.NoError(t, s.Stop(t.Context()))
+
+		runs := job.runs.Load()
+		time.Sleep(5 * time.Millisecond)
+		assert.Equal(t, runs, job.runs.Load())
+	})
+
+	t.Run("stop without start", func(t *testing.T) {
+		s := &Scheduler{logger: slog.New(slog.DiscardHandler)}
+		assert.NoError(t, s.Stop(t.Context()))
+	})
+}
+
+func TestDurationOrDefault(t *testing.T) {
+	newConfig := func(entry config.Entry) config.Configuration {
+		return config.NewConfiguration(mem.WithMemory(map[string]config.Entry{"INTERVAL": entry}))
+	}
+
+	t.Run("reads a duration with its unit", func(t *testing.T) {
+		duration, err := durationOrDefault(newConfig(config.NewEntryString("1h30m")), "INTERVAL", time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, 90*time.Minute, duration)
+	})
+
+	t.Run("defaults when not set", func(t *testing.T) {
+		duration, err := durationOrDefault(config.NewConfiguration(), "INTERVAL", time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, time.Second, duration)
+	})
+
+	t.Run("rejects a number without unit", func(t *testing.T) {
+		_, err := durationOrDefault(newConfig(config.NewEntryInt(5000000000)), "INTERVAL", time.Second)
+		assert.ErrorContains(t, err, "INTERVAL")
+	})
+
+	t.Run("rejects an invalid or non positive duration", func(t *testing.T) {
+		_, err := durationOrDefault(newConfig(config.NewEntryString("soon")), "INTERVAL", time.Second)
+		assert.ErrorContains(t, err, "INTERVAL")
+		_, err = durationOrDefault(newConfig(config.NewEntryString("-5s")), "INTERVAL", time.Second)
+		assert.ErrorContains(t, err, "INTERVAL")
+	})
+}
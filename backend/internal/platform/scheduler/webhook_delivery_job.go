@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Oleexo/config-go"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/webhook/command"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+const (
+	// WebhookDeliveryIntervalKey is the time between two looks for the webhook deliveries to attempt, a duration such as "30s" or "1h"
+	WebhookDeliveryIntervalKey     = "WEBHOOK_DELIVERY_INTERVAL"
+	DefaultWebhookDeliveryInterval = 30 * time.Second
+	// WebhookDeliveryBatchKey is the maximum number of deliveries attempted by a run
+	WebhookDeliveryBatchKey     = "WEBHOOK_DELIVERY_BATCH"
+	DefaultWebhookDeliveryBatch = 100
+)
+
+// webhookDeliveryJob attempts the pending webhook deliveries whose next attempt is due
+type webhookDeliveryJob struct {
+	handler  ports.CommandHandler[command.DeliverWebhooksCommand, command.DeliverWebhooksResult]
+	interval time.Duration
+	batch    int64
+	logger   *slog.Logger
+}
+
+func newWebhookDeliveryJob(
+	handler ports.CommandHandler[command.DeliverWebhooksCommand, command.DeliverWebhooksResult],
+	cfg config.Configuration,
+	logger *slog.Logger) (*webhookDeliveryJob, error) {
+	interval, err := durationOrDefault(cfg, WebhookDeliveryIntervalKey, DefaultWebhookDeliveryInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &webhookDeliveryJob{
+		handler:  handler,
+		interval: interval,
+		batch:    cfg.GetIntOrDefault(WebhookDeliveryBatchKey, DefaultWebhookDeliveryBatch),
+		logger:   logger,
+	}, nil
+}
+
+func (j webhookDeliveryJob) Name() string {
+	return "webhook_delivery"
+}
+
+func (j webhookDeliveryJob) Interval() time.Duration {
+	return j.interval
+}
+
+func (j webhookDeliveryJob) Run(ctx context.Context) error {
+	r := j.handler.Handle(ctx, command.DeliverWebhooksCommand{Limit: j.batch})
+	return result.Match(r, func(report command.DeliverWebhooksResult) error {
+		if report.Succeeded > 0 || report.Failed > 0 {
+			j.logger.Info("webhooks delivered",
+				slog.Int("succeeded", report.Succeeded),
+				slog.Int("failed", report.Failed))
+		}
+		return nil
+	}, func(err error) error {
+		return err
+	})
+}
@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Oleexo/config-go"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/webhook/command"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+const (
+	// WebhookRenewalIntervalKey is the time between two looks for the renewals due today, a duration such as "30s" or "1h"
+	WebhookRenewalIntervalKey     = "WEBHOOK_RENEWAL_INTERVAL"
+	DefaultWebhookRenewalInterval = time.Hour
+)
+
+// webhookRenewalJob publishes the renewal.due webhook events of the renewals happening today
+type webhookRenewalJob struct {
+	handler  ports.CommandHandler[command.PublishRenewalsCommand, command.PublishRenewalsResult]
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func newWebhookRenewalJob(
+	handler ports.CommandHandler[command.PublishRenewalsCommand, command.PublishRenewalsResult],
+	cfg config.Configuration,
+	logger *slog.Logger) (*webhookRenewalJob, error) {
+	interval, err := durationOrDefault(cfg, WebhookRenewalIntervalKey, DefaultWebhookRenewalInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &webhookRenewalJob{
+		handler:  handler,
+		interval: interval,
+		logger:   logger,
+	}, nil
+}
+
+func (j webhookRenewalJob) Name() string {
+	return "webhook_renewals"
+}
+
+func (j webhookRenewalJob) Interval() time.Duration {
+	return j.interval
+}
+
+func (j webhookRenewalJob) Run(ctx context.Context) error {
+	r := j.handler.Handle(ctx, command.PublishRenewalsCommand{})
+	return result.Match(r, func(report command.PublishRenewalsResult) error {
+		for _, err := range report.Errors {
+			j.logger.Error("failed to publish renewals", slog.Any("error", err))
+		}
+		j.logger.Info("renewals published",
+			slog.Int("enqueued", report.Enqueued),
+			slog.Int("failed_accounts", len(report.Errors)))
+		return nil
+	}, func(err error) error {
+		return err
+	})
+}
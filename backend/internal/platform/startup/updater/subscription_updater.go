@@ -18,18 +18,19 @@ import (
 )
 
 type systemSubscriptionModel struct {
-	Id               string                `json:"id"`
-	FriendlyName     string                `json:"friendly_name"`
-	ProviderKey      string                `json:"provider_key"`
-	CustomPrice      *systemPriceModel     `json:"custom_price"`
-	FreeTrial        *systemFreeTrialModel `json:"free_trial"`
-	Owner            systemOwnerModel      `json:"owner"`
-	Payer            *systemPayerModel     `json:"payer"`
-	FamilyUsers      []string              `json:"family_users"`
-	StartDate        time.Time             `json:"start_date"`
-	EndDate          *time.Time            `json:"end_date"`
-	Recurrency       string                `json:"recurrency"`
-	CustomRecurrency *int32                `json:"custom_recurrency"`
+	Id                   string                `json:"id"`
+	FriendlyName         string                `json:"friendly_name"`
+	ProviderKey          string                `json:"provider_key"`
+	CustomPrice          *systemPriceModel     `json:"custom_price"`
+	FreeTrial            *systemFreeTrialModel `json:"free_trial"`
+	Owner                systemOwnerModel      `json:"owner"`
+	Payer                *systemPayerModel     `json:"payer"`
+	FamilyUsers          []string              `json:"family_users"`
+	StartDate            time.Time             `json:"start_date"`
+	EndDate              *time.Time            `json:"end_date"`
+	Recurrency           string                `json:"recurrency"`
+	CustomRecurrency     *int32                `json:"custom_recurrency"`
+	CustomRecurrencyUnit *string               `json:"custom_recurrency_unit"`
 }
 
 type systemFreeTrialModel struct {
@@ -159,21 +160,40 @@ func (l subscriptionUpdater) updateDatabase(ctx context.Context, subscriptions [
 			if err != nil {
 				return err
 			}
+			var customRecurrency *subscription.Interval
+			if model.CustomRecurrency != nil {
+				// Custom recurrencies without a unit are counted in months
+				unit := subscription.MonthIntervalUnit
+				if model.CustomRecurrencyUnit != nil {
+					unit, err = subscription.ParseIntervalUnit(*model.CustomRecurrencyUnit)
+					if err != nil {
+						return err
+					}
+				}
+				customRecurrency = subscription.NewInterval(unit, *model.CustomRecurrency)
+			}
 
 			sub = subscription.NewSubscription(
 				subscriptionID,
 				&model.FriendlyName,
 				freeTrial,
+				nil,
 				providerId,
 				customPrice,
+				nil,
+				nil,
 				owner,
 				payer,
+				nil,
+				nil,
 				familyUsers,
 				nil,
 				model.StartDate,
 				model.EndDate,
+				nil,
 				recurrency,
-				model.CustomRecurrency,
+				customRecurrency,
+				nil,
 				time.Now(),
 				time.Now(),
 			)
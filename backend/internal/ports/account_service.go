@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/domain/types"
@@ -9,4 +10,6 @@ import (
 
 type AccountService interface {
 	GetPreferredCurrency(ctx context.Context, userId types.UserID) currency.Unit
+	// GetTimezone returns the timezone configured by the user, UTC when none is configured
+	GetTimezone(ctx context.Context, userId types.UserID) *time.Location
 }
@@ -6,6 +6,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/domain/types"
@@ -95,3 +96,62 @@ func (_c *MockAccountService_GetPreferredCurrency_Call) RunAndReturn(run func(ct
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetTimezone provides a mock function for the type MockAccountService
+func (_mock *MockAccountService) GetTimezone(ctx context.Context, userId types.UserID) *time.Location {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimezone")
+	}
+
+	var r0 *time.Location
+	if returnFunc, ok := ret.Get(0).(func(context.Context, types.UserID) *time.Location); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*time.Location)
+		}
+	}
+	return r0
+}
+
+// MockAccountService_GetTimezone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimezone'
+type MockAccountService_GetTimezone_Call struct {
+	*mock.Call
+}
+
+// GetTimezone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId types.UserID
+func (_e *MockAccountService_Expecter) GetTimezone(ctx interface{}, userId interface{}) *MockAccountService_GetTimezone_Call {
+	return &MockAccountService_GetTimezone_Call{Call: _e.mock.On("GetTimezone", ctx, userId)}
+}
+
+func (_c *MockAccountService_GetTimezone_Call) Run(run func(ctx context.Context, userId types.UserID)) *MockAccountService_GetTimezone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 types.UserID
+		if args[1] != nil {
+			arg1 = args[1].(types.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccountService_GetTimezone_Call) Return(location *time.Location) *MockAccountService_GetTimezone_Call {
+	_c.Call.Return(location)
+	return _c
+}
+
+func (_c *MockAccountService_GetTimezone_Call) RunAndReturn(run func(ctx context.Context, userId types.UserID) *time.Location) *MockAccountService_GetTimezone_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -8,4 +8,6 @@ import (
 
 type Authentication interface {
 	MustGetConnectedAccount(ctx context.Context) account.ConnectedAccount
+	// GetConnectedAccount returns the account of the request, it is missing outside of an authenticated request
+	GetConnectedAccount(ctx context.Context) (account.ConnectedAccount, bool)
 }
@@ -38,6 +38,68 @@ func (_m *MockAuthentication) EXPECT() *MockAuthentication_Expecter {
 	return &MockAuthentication_Expecter{mock: &_m.Mock}
 }
 
+// GetConnectedAccount provides a mock function for the type MockAuthentication
+func (_mock *MockAuthentication) GetConnectedAccount(ctx context.Context) (account.ConnectedAccount, bool) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectedAccount")
+	}
+
+	var r0 account.ConnectedAccount
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (account.ConnectedAccount, bool)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) account.ConnectedAccount); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(account.ConnectedAccount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) bool); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
+}
+
+// MockAuthentication_GetConnectedAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectedAccount'
+type MockAuthentication_GetConnectedAccount_Call struct {
+	*mock.Call
+}
+
+// GetConnectedAccount is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAuthentication_Expecter) GetConnectedAccount(ctx interface{}) *MockAuthentication_GetConnectedAccount_Call {
+	return &MockAuthentication_GetConnectedAccount_Call{Call: _e.mock.On("GetConnectedAccount", ctx)}
+}
+
+func (_c *MockAuthentication_GetConnectedAccount_Call) Run(run func(ctx context.Context)) *MockAuthentication_GetConnectedAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthentication_GetConnectedAccount_Call) Return(connectedAccount account.ConnectedAccount, b bool) *MockAuthentication_GetConnectedAccount_Call {
+	_c.Call.Return(connectedAccount, b)
+	return _c
+}
+
+func (_c *MockAuthentication_GetConnectedAccount_Call) RunAndReturn(run func(ctx context.Context) (account.ConnectedAccount, bool)) *MockAuthentication_GetConnectedAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MustGetConnectedAccount provides a mock function for the type MockAuthentication
 func (_mock *MockAuthentication) MustGetConnectedAccount(ctx context.Context) account.ConnectedAccount {
 	ret := _mock.Called(ctx)
@@ -0,0 +1,33 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+type BudgetQueryParameters struct {
+	QueryParameters
+}
+
+func NewBudgetQueryParameters(limit, offset int64) BudgetQueryParameters {
+	return BudgetQueryParameters{
+		QueryParameters: QueryParameters{
+			Limit:  limit,
+			Offset: offset,
+		},
+	}
+}
+
+type BudgetRepository interface {
+	Repository[types.BudgetID, budget.Budget]
+
+	GetAll(ctx context.Context, userId types.UserID, parameters BudgetQueryParameters) (
+		[]budget.Budget,
+		int64,
+		error)
+	GetByIdForUser(ctx context.Context, userId types.UserID, id types.BudgetID) (
+		budget.Budget,
+		error)
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+type CalendarFeedRepository interface {
+	Repository[types.CalendarFeedID, calendar.Feed]
+
+	GetByUserId(ctx context.Context, userId types.UserID) (calendar.Feed, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (calendar.Feed, error)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+type ChargeRepository interface {
+	Repository[types.ChargeID, charge.Charge]
+
+	// GetBySubscriptionId returns the charges of a subscription ordered by due date
+	GetBySubscriptionId(ctx context.Context, subscriptionId types.SubscriptionID) ([]charge.Charge, error)
+	// GetRecordedBySubscriptionIds returns the charges with an actual amount of the given subscriptions
+	GetRecordedBySubscriptionIds(ctx context.Context, subscriptionIds ...types.SubscriptionID) ([]charge.Charge, error)
+}
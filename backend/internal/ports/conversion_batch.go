@@ -0,0 +1,56 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+// ConversionBatch collects the amounts to convert while handling a request, so they are all converted in a
+// single call to Exchange.ToCurrencyBatch once every amount is known
+type ConversionBatch struct {
+	conversions []Conversion
+	callbacks   []func(currency.Amount)
+}
+
+// Add queues an amount that belongs to no subscription to convert at the rate of the given day,
+// callback receives the converted amount
+func (b *ConversionBatch) Add(amount currency.Amount, at time.Time, callback func(converted currency.Amount)) {
+	b.conversions = append(b.conversions, Conversion{Amount: amount, At: at})
+	b.callbacks = append(b.callbacks, callback)
+}
+
+// AddForSubscription queues the amount of the subscription to convert at the rate of the given day,
+// the manual rates of the subscription are preferred. callback receives the converted amount.
+func (b *ConversionBatch) AddForSubscription(
+	subscriptionId types.SubscriptionID,
+	amount currency.Amount,
+	at time.Time,
+	callback func(converted currency.Amount)) {
+	b.conversions = append(b.conversions, Conversion{Amount: amount, At: at, SubscriptionId: &subscriptionId})
+	b.callbacks = append(b.callbacks, callback)
+}
+
+// Convert converts the queued amounts and calls their callback in the order they were added.
+// An amount that could not be converted is passed as invalid.
+func (b *ConversionBatch) Convert(ctx context.Context, exchange Exchange, target currency.Unit) error {
+	if len(b.conversions) == 0 {
+		return nil
+	}
+
+	amounts, err := exchange.ToCurrencyBatch(ctx, b.conversions, target)
+	if err != nil {
+		return err
+	}
+	for index, callback := range b.callbacks {
+		if index < len(amounts) {
+			callback(amounts[index])
+		} else {
+			callback(currency.NewInvalidAmount())
+		}
+	}
+
+	return nil
+}
@@ -14,4 +14,7 @@ type CurrencyRepository interface {
 	GetRateAt(ctx context.Context, from, to currency.Unit, at time.Time) (currency.Rate, error)
 	GetRatesByDate(ctx context.Context, date time.Time) (currency.Rates, error)
 	GetLatestUpdateDate(ctx context.Context) (time.Time, error)
+	// CountPairsByDate returns the number of distinct pairs of units having a rate on each day from start to end,
+	// days without any rate are absent
+	CountPairsByDate(ctx context.Context, start, end time.Time, units []currency.Unit) (map[time.Time]int, error)
 }
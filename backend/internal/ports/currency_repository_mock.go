@@ -40,6 +40,86 @@ func (_m *MockCurrencyRepository) EXPECT() *MockCurrencyRepository_Expecter {
 	return &MockCurrencyRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountPairsByDate provides a mock function for the type MockCurrencyRepository
+func (_mock *MockCurrencyRepository) CountPairsByDate(ctx context.Context, start time.Time, end time.Time, units []currency.Unit) (map[time.Time]int, error) {
+	ret := _mock.Called(ctx, start, end, units)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPairsByDate")
+	}
+
+	var r0 map[time.Time]int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, []currency.Unit) (map[time.Time]int, error)); ok {
+		return returnFunc(ctx, start, end, units)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, []currency.Unit) map[time.Time]int); ok {
+		r0 = returnFunc(ctx, start, end, units)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[time.Time]int)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, []currency.Unit) error); ok {
+		r1 = returnFunc(ctx, start, end, units)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCurrencyRepository_CountPairsByDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPairsByDate'
+type MockCurrencyRepository_CountPairsByDate_Call struct {
+	*mock.Call
+}
+
+// CountPairsByDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - start time.Time
+//   - end time.Time
+//   - units []currency.Unit
+func (_e *MockCurrencyRepository_Expecter) CountPairsByDate(ctx interface{}, start interface{}, end interface{}, units interface{}) *MockCurrencyRepository_CountPairsByDate_Call {
+	return &MockCurrencyRepository_CountPairsByDate_Call{Call: _e.mock.On("CountPairsByDate", ctx, start, end, units)}
+}
+
+func (_c *MockCurrencyRepository_CountPairsByDate_Call) Run(run func(ctx context.Context, start time.Time, end time.Time, units []currency.Unit)) *MockCurrencyRepository_CountPairsByDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 []currency.Unit
+		if args[3] != nil {
+			arg3 = args[3].([]currency.Unit)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCurrencyRepository_CountPairsByDate_Call) Return(timeToInt map[time.Time]int, err error) *MockCurrencyRepository_CountPairsByDate_Call {
+	_c.Call.Return(timeToInt, err)
+	return _c
+}
+
+func (_c *MockCurrencyRepository_CountPairsByDate_Call) RunAndReturn(run func(ctx context.Context, start time.Time, end time.Time, units []currency.Unit) (map[time.Time]int, error)) *MockCurrencyRepository_CountPairsByDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function for the type MockCurrencyRepository
 func (_mock *MockCurrencyRepository) Delete(ctx context.Context, entityId types.RateID) (bool, error) {
 	ret := _mock.Called(ctx, entityId)
@@ -0,0 +1,78 @@
+package ports
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"go.uber.org/fx"
+
+	"github.com/mistribe/subtracker/internal/domain/entity"
+)
+
+// EventPublisher publishes the events recorded by the aggregates once they are saved
+type EventPublisher interface {
+	// Publish notifies the subscribers of each event and returns their failures. A failing subscriber does not
+	// stop the others, the events are published again to every subscriber so the subscribers must be idempotent.
+	Publish(ctx context.Context, events ...entity.Event) error
+}
+
+// EventHandler is a subscriber of the events of type TEvent
+type EventHandler[TEvent entity.Event] interface {
+	Handle(ctx context.Context, event TEvent) error
+}
+
+// EventSubscriber is an EventHandler of any event type, it ignores the events of the other types
+type EventSubscriber interface {
+	Notify(ctx context.Context, event entity.Event) error
+}
+
+type eventSubscriber[TEvent entity.Event] struct {
+	handler EventHandler[TEvent]
+}
+
+func NewEventSubscriber[TEvent entity.Event](handler EventHandler[TEvent]) EventSubscriber {
+	return eventSubscriber[TEvent]{
+		handler: handler,
+	}
+}
+
+func (s eventSubscriber[TEvent]) Notify(ctx context.Context, event entity.Event) error {
+	typed, ok := event.(TEvent)
+	if !ok {
+		return nil
+	}
+	return s.handler.Handle(ctx, typed)
+}
+
+func (s eventSubscriber[TEvent]) String() string {
+	return fmt.Sprintf("%T", s.handler)
+}
+
+// AsEventHandler subscribes the EventHandler[TEvent] built by the constructor f to the events of type TEvent
+func AsEventHandler[TEvent entity.Event](f any) any {
+	constructor := reflect.ValueOf(f)
+	constructorType := constructor.Type()
+	if constructorType.Kind() != reflect.Func || constructorType.IsVariadic() || constructorType.NumOut() != 1 ||
+		!constructorType.Out(0).Implements(reflect.TypeFor[EventHandler[TEvent]]()) {
+		panic(fmt.Sprintf("%s is not a constructor of an event handler of %s", constructorType,
+			reflect.TypeFor[TEvent]()))
+	}
+
+	params := make([]reflect.Type, constructorType.NumIn())
+	for i := range params {
+		params[i] = constructorType.In(i)
+	}
+	subscriberType := reflect.TypeFor[EventSubscriber]()
+	wrapped := reflect.MakeFunc(reflect.FuncOf(params, []reflect.Type{subscriberType}, false),
+		func(args []reflect.Value) []reflect.Value {
+			handler := constructor.Call(args)[0].Interface().(EventHandler[TEvent])
+			subscriber := reflect.New(subscriberType).Elem()
+			subscriber.Set(reflect.ValueOf(NewEventSubscriber(handler)))
+			return []reflect.Value{subscriber}
+		})
+
+	return fx.Annotate(wrapped.Interface(),
+		fx.ResultTags(`group:"event_subscribers"`),
+	)
+}
@@ -5,16 +5,43 @@ import (
 	"time"
 
 	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
 )
 
+// Conversion is an amount to convert at the rate of a given day
+type Conversion struct {
+	Amount currency.Amount
+	At     time.Time
+	// SubscriptionId is the subscription the amount belongs to, its manual rates are preferred when set
+	SubscriptionId *types.SubscriptionID
+}
+
 type Exchange interface {
+	// ToCurrency converts the amount at the latest rate, see ToCurrencyAt
 	ToCurrency(
 		ctx context.Context,
 		initial currency.Amount,
 		target currency.Unit) (currency.Amount, error)
+	// ToCurrencyAt converts the amount at the rate of the given day. The amount belongs to no subscription, only the
+	// manual rates of the connected account that apply to every subscription are preferred. The amounts of a
+	// subscription are converted with ToCurrencyBatch so that its own manual rates apply.
 	ToCurrencyAt(
 		ctx context.Context,
 		initial currency.Amount,
 		target currency.Unit,
 		at time.Time) (currency.Amount, error)
+	// ToCurrencyBatch converts every amount at the rate of its day, the rates of each day are loaded once.
+	// Amounts are returned in the same order as the conversions, an amount without a known rate is invalid.
+	// Manual rates of the connected account are preferred over the market rates, see currency.ManualRates.
+	ToCurrencyBatch(
+		ctx context.Context,
+		conversions []Conversion,
+		target currency.Unit) ([]currency.Amount, error)
+}
+
+// RateProvider fetches the market rates published by the configured rate sources
+type RateProvider interface {
+	// GetRates returns the rate between every pair of units published for the day of at,
+	// nil when no rate source has the rates of that day
+	GetRates(ctx context.Context, at time.Time, units []currency.Unit) (currency.Rates, error)
 }
@@ -192,3 +192,77 @@ func (_c *MockExchange_ToCurrencyAt_Call) RunAndReturn(run func(ctx context.Cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// ToCurrencyBatch provides a mock function for the type MockExchange
+func (_mock *MockExchange) ToCurrencyBatch(ctx context.Context, conversions []Conversion, target currency.Unit) ([]currency.Amount, error) {
+	ret := _mock.Called(ctx, conversions, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToCurrencyBatch")
+	}
+
+	var r0 []currency.Amount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []Conversion, currency.Unit) ([]currency.Amount, error)); ok {
+		return returnFunc(ctx, conversions, target)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []Conversion, currency.Unit) []currency.Amount); ok {
+		r0 = returnFunc(ctx, conversions, target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]currency.Amount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []Conversion, currency.Unit) error); ok {
+		r1 = returnFunc(ctx, conversions, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExchange_ToCurrencyBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToCurrencyBatch'
+type MockExchange_ToCurrencyBatch_Call struct {
+	*mock.Call
+}
+
+// ToCurrencyBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversions []Conversion
+//   - target currency.Unit
+func (_e *MockExchange_Expecter) ToCurrencyBatch(ctx interface{}, conversions interface{}, target interface{}) *MockExchange_ToCurrencyBatch_Call {
+	return &MockExchange_ToCurrencyBatch_Call{Call: _e.mock.On("ToCurrencyBatch", ctx, conversions, target)}
+}
+
+func (_c *MockExchange_ToCurrencyBatch_Call) Run(run func(ctx context.Context, conversions []Conversion, target currency.Unit)) *MockExchange_ToCurrencyBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []Conversion
+		if args[1] != nil {
+			arg1 = args[1].([]Conversion)
+		}
+		var arg2 currency.Unit
+		if args[2] != nil {
+			arg2 = args[2].(currency.Unit)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchange_ToCurrencyBatch_Call) Return(amounts []currency.Amount, err error) *MockExchange_ToCurrencyBatch_Call {
+	_c.Call.Return(amounts, err)
+	return _c
+}
+
+func (_c *MockExchange_ToCurrencyBatch_Call) RunAndReturn(run func(ctx context.Context, conversions []Conversion, target currency.Unit) ([]currency.Amount, error)) *MockExchange_ToCurrencyBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
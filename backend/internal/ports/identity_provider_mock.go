@@ -95,6 +95,72 @@ func (_c *MockIdentityProvider_DeleteUser_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// GetUserEmail provides a mock function for the type MockIdentityProvider
+func (_mock *MockIdentityProvider) GetUserEmail(ctx context.Context, userId types.UserID) (string, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserEmail")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, types.UserID) (string, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, types.UserID) string); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, types.UserID) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdentityProvider_GetUserEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserEmail'
+type MockIdentityProvider_GetUserEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId types.UserID
+func (_e *MockIdentityProvider_Expecter) GetUserEmail(ctx interface{}, userId interface{}) *MockIdentityProvider_GetUserEmail_Call {
+	return &MockIdentityProvider_GetUserEmail_Call{Call: _e.mock.On("GetUserEmail", ctx, userId)}
+}
+
+func (_c *MockIdentityProvider_GetUserEmail_Call) Run(run func(ctx context.Context, userId types.UserID)) *MockIdentityProvider_GetUserEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 types.UserID
+		if args[1] != nil {
+			arg1 = args[1].(types.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdentityProvider_GetUserEmail_Call) Return(s string, err error) *MockIdentityProvider_GetUserEmail_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockIdentityProvider_GetUserEmail_Call) RunAndReturn(run func(ctx context.Context, userId types.UserID) (string, error)) *MockIdentityProvider_GetUserEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReadSessionToken provides a mock function for the type MockIdentityProvider
 func (_mock *MockIdentityProvider) ReadSessionToken(ctx context.Context, sessionToken string) (Identity, error) {
 	ret := _mock.Called(ctx, sessionToken)
@@ -9,6 +9,8 @@ import (
 type IdentityProvider interface {
 	ReadSessionToken(ctx context.Context, sessionToken string) (Identity, error)
 	DeleteUser(ctx context.Context, userId types.UserID) error
+	// GetUserEmail returns the primary email address of the user, empty when the user has none
+	GetUserEmail(ctx context.Context, userId types.UserID) (string, error)
 }
 
 type Identity struct {
@@ -0,0 +1,35 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+type ManualRateQueryParameters struct {
+	QueryParameters
+}
+
+func NewManualRateQueryParameters(limit, offset int64) ManualRateQueryParameters {
+	return ManualRateQueryParameters{
+		QueryParameters: QueryParameters{
+			Limit:  limit,
+			Offset: offset,
+		},
+	}
+}
+
+type ManualRateRepository interface {
+	Repository[types.ManualRateID, currency.ManualRate]
+
+	GetAll(ctx context.Context, userId types.UserID, parameters ManualRateQueryParameters) (
+		[]currency.ManualRate,
+		int64,
+		error)
+	// GetAllForUser returns every manual rate of the user and of their family
+	GetAllForUser(ctx context.Context, userId types.UserID) (currency.ManualRates, error)
+	GetByIdForUser(ctx context.Context, userId types.UserID, id types.ManualRateID) (
+		currency.ManualRate,
+		error)
+}
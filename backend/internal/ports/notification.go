@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/notification"
+)
+
+// Email is a message to a single recipient, with a plain text and an HTML version of the body
+type Email struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+type EmailSender interface {
+	Send(ctx context.Context, email Email) error
+}
+
+// ReminderRenderer writes the email reminding an event in a locale, the recipient is left empty
+type ReminderRenderer interface {
+	Render(locale notification.Locale, event calendar.Event) (Email, error)
+}
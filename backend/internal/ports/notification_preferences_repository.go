@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+type NotificationPreferencesRepository interface {
+	GetByUserId(ctx context.Context, userId types.UserID) (notification.Preferences, error)
+	// GetAllEnabled returns the preferences of the accounts that opted in to reminders
+	GetAllEnabled(ctx context.Context) ([]notification.Preferences, error)
+	Save(ctx context.Context, preferences notification.Preferences) error
+}
@@ -0,0 +1,38 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/entity"
+)
+
+// OutboxRelayResult reports a relay run
+type OutboxRelayResult struct {
+	Published int
+	// Retried are the events whose subscribers failed, they stay pending and are relayed again after a backoff
+	Retried int
+	// Failed are the events that could not be read back or whose subscribers failed too many times, they are kept
+	// aside with their error, neither retried nor purged
+	Failed int
+}
+
+// OutboxRepository stores the events recorded by the aggregates with the change that recorded them, so that they
+// are published even when the process stops right after the change is saved
+type OutboxRepository interface {
+	// Add stores the events in the transaction of ctx
+	Add(ctx context.Context, events ...entity.Event) error
+	// Relay hands up to limit pending events to publish, in the order they were recorded, and marks them
+	// processed. The events are claimed with a lease in a short transaction and published outside of it, so that
+	// concurrent relays take different ones without holding locks while the subscribers run. An event is published
+	// again once its lease expires when its relay stops or takes longer than the lease before marking it. An event
+	// whose publish fails stays pending until its next attempt, so a retried event can be relayed after the events
+	// recorded later.
+	Relay(
+		ctx context.Context,
+		limit int64,
+		publish func(ctx context.Context, events ...entity.Event) error) (OutboxRelayResult, error)
+	// DeleteProcessedBefore deletes the events processed before the given time and returns how many were deleted,
+	// the failed events are kept
+	DeleteProcessedBefore(ctx context.Context, before time.Time) (int64, error)
+}
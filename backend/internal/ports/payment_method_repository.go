@@ -0,0 +1,38 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+type PaymentMethodQueryParameters struct {
+	QueryParameters
+
+	SearchText string
+}
+
+func NewPaymentMethodQueryParameters(
+	searchText string,
+	limit, offset int64) PaymentMethodQueryParameters {
+	return PaymentMethodQueryParameters{
+		QueryParameters: QueryParameters{
+			Limit:  limit,
+			Offset: offset,
+		},
+		SearchText: searchText,
+	}
+}
+
+type PaymentMethodRepository interface {
+	Repository[types.PaymentMethodID, paymentmethod.PaymentMethod]
+
+	GetAll(ctx context.Context, userId types.UserID, parameters PaymentMethodQueryParameters) (
+		[]paymentmethod.PaymentMethod,
+		int64,
+		error)
+	GetByIdForUser(ctx context.Context, userId types.UserID, id types.PaymentMethodID) (
+		paymentmethod.PaymentMethod,
+		error)
+}
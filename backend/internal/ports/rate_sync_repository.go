@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+type RateSyncRepository interface {
+	Repository[types.RateSyncID, currency.RateSync]
+
+	// GetLatest returns the last started synchronization, nil when none has run yet
+	GetLatest(ctx context.Context) (currency.RateSync, error)
+}
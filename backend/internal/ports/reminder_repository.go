@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+type ReminderRepository interface {
+	Repository[types.ReminderID, notification.Reminder]
+	// Claim saves a new reminder unless one already exists for the same user, subscription, kind and event date.
+	// It returns false when the reminder was already claimed, by this instance or another one. A claim still pending
+	// after a timeout is taken over, its instance stopped before sending the reminder or releasing the claim.
+	Claim(ctx context.Context, reminder notification.Reminder) (bool, error)
+}
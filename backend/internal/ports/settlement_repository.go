@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+	"github.com/mistribe/subtracker/internal/domain/types"
+)
+
+type SettlementRepository interface {
+	Repository[types.SettlementID, ledger.Settlement]
+
+	// GetByFamilyId returns the settlements of a family ordered by settlement date
+	GetByFamilyId(ctx context.Context, familyId types.FamilyID) ([]ledger.Settlement, error)
+}
@@ -19,6 +19,9 @@ type SubscriptionQueryParameters struct {
 	Users        []types.UserID
 	Providers    []types.ProviderID
 	WithInactive bool
+	// CancellationDeadlineFrom and CancellationDeadlineTo keep subscriptions whose last day to cancel falls within the window
+	CancellationDeadlineFrom *time.Time
+	CancellationDeadlineTo   *time.Time
 }
 
 func NewSubscriptionQueryParameters(
@@ -29,6 +32,8 @@ func NewSubscriptionQueryParameters(
 	users []types.UserID,
 	providers []types.ProviderID,
 	withInactive bool,
+	cancellationDeadlineFrom *time.Time,
+	cancellationDeadlineTo *time.Time,
 	limit, offset int64) SubscriptionQueryParameters {
 	return SubscriptionQueryParameters{
 		QueryParameters: QueryParameters{
@@ -42,6 +47,9 @@ func NewSubscriptionQueryParameters(
 		ToDate:       toDate,
 		Users:        users,
 		Providers:    providers,
+
+		CancellationDeadlineFrom: cancellationDeadlineFrom,
+		CancellationDeadlineTo:   cancellationDeadlineTo,
 	}
 }
 
@@ -53,4 +61,8 @@ type SubscriptionRepository interface {
 	GetAllForUser(ctx context.Context, userId types.UserID,
 		parameters SubscriptionQueryParameters) ([]subscription.Subscription, int64, error)
 	GetAllIt(ctx context.Context, userId types.UserID, searchText string) iter.Seq[subscription.Subscription]
+	// GetAllWithStaleCancellationDeadline returns the renewing subscriptions whose stored cancellation deadline is
+	// missing or before now, ordered by id. Saving a subscription stores its current deadline.
+	GetAllWithStaleCancellationDeadline(ctx context.Context, now time.Time,
+		limit, offset int64) ([]subscription.Subscription, error)
 }
@@ -7,6 +7,7 @@ package ports
 import (
 	"context"
 	"iter"
+	"time"
 
 	"github.com/mistribe/subtracker/internal/domain/subscription"
 	"github.com/mistribe/subtracker/internal/domain/types"
@@ -400,6 +401,86 @@ func (_c *MockSubscriptionRepository_GetAllIt_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// GetAllWithStaleCancellationDeadline provides a mock function for the type MockSubscriptionRepository
+func (_mock *MockSubscriptionRepository) GetAllWithStaleCancellationDeadline(ctx context.Context, now time.Time, limit int64, offset int64) ([]subscription.Subscription, error) {
+	ret := _mock.Called(ctx, now, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllWithStaleCancellationDeadline")
+	}
+
+	var r0 []subscription.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int64, int64) ([]subscription.Subscription, error)); ok {
+		return returnFunc(ctx, now, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int64, int64) []subscription.Subscription); ok {
+		r0 = returnFunc(ctx, now, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]subscription.Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int64, int64) error); ok {
+		r1 = returnFunc(ctx, now, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionRepository_GetAllWithStaleCancellationDeadline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllWithStaleCancellationDeadline'
+type MockSubscriptionRepository_GetAllWithStaleCancellationDeadline_Call struct {
+	*mock.Call
+}
+
+// GetAllWithStaleCancellationDeadline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int64
+//   - offset int64
+func (_e *MockSubscriptionRepository_Expecter) GetAllWithStaleCancellationDeadline(ctx interface{}, now interface{}, limit interface{}, offset interface{}) *MockSubscriptionRepository_GetAllWithStaleCancellationDeadline_Call {
+	return &MockSubscriptionRepository_GetAllWithStaleCancellationDeadline_Call{Call: _e.mock.On("GetAllWithStaleCancellationDeadline", ctx, now, limit, offset)}
+}
+
+func (_c *MockSubscriptionRepository_GetAllWithStaleCancellationDeadline_Call) Run(run func(ctx context.Context, now time.Time, limit int64, offset int64)) *MockSubscriptionRepository_GetAllWithStaleCancellationDeadline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_GetAllWithStaleCancellationDeadline_Call) Return(subscriptions []subscription.Subscription, err error) *MockSubscriptionRepository_GetAllWithStaleCancellationDeadline_Call {
+	_c.Call.Return(subscriptions, err)
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_GetAllWithStaleCancellationDeadline_Call) RunAndReturn(run func(ctx context.Context, now time.Time, limit int64, offset int64) ([]subscription.Subscription, error)) *MockSubscriptionRepository_GetAllWithStaleCancellationDeadline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetById provides a mock function for the type MockSubscriptionRepository
 func (_mock *MockSubscriptionRepository) GetById(ctx context.Context, entityId types.SubscriptionID) (subscription.Subscription, error) {
 	ret := _mock.Called(ctx, entityId)
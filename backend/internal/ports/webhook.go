@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+	"net/http"
+)
+
+// WebhookRequest is a signed event sent to an endpoint
+type WebhookRequest struct {
+	Url     string
+	Headers http.Header
+	Body    []byte
+}
+
+type WebhookSender interface {
+	// Send posts the request and returns the status code of the response. An error means no response was
+	// received, a response of any status code is not an error.
+	Send(ctx context.Context, request WebhookRequest) (int, error)
+}
@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+)
+
+type WebhookDeliveryRepository interface {
+	Repository[types.WebhookDeliveryID, webhook.Delivery]
+
+	// Enqueue saves a new delivery unless the event was already delivered to the endpoint, redeliveries aside.
+	// It returns false when the delivery already existed.
+	Enqueue(ctx context.Context, delivery webhook.Delivery) (bool, error)
+	// GetAllByEndpointId returns the delivery log of an endpoint, the most recent first
+	GetAllByEndpointId(
+		ctx context.Context,
+		endpointId types.WebhookEndpointID,
+		parameters QueryParameters) ([]webhook.Delivery, int64, error)
+	// GetDue returns the pending deliveries whose next attempt is at or before now, the oldest first
+	GetDue(ctx context.Context, now time.Time, limit int64) ([]webhook.Delivery, error)
+	// Lease postpones the next attempt of a due delivery to until so that no other instance attempts it
+	// meanwhile. It returns false when the delivery is no longer due, another instance leased it.
+	Lease(ctx context.Context, id types.WebhookDeliveryID, now time.Time, until time.Time) (bool, error)
+}
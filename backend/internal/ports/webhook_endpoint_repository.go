@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+)
+
+type WebhookEndpointRepository interface {
+	Repository[types.WebhookEndpointID, webhook.Endpoint]
+
+	GetAllByUserId(ctx context.Context, userId types.UserID) ([]webhook.Endpoint, error)
+	// GetByIdForUser returns the endpoint when it belongs to the user, nil otherwise
+	GetByIdForUser(ctx context.Context, userId types.UserID, id types.WebhookEndpointID) (webhook.Endpoint, error)
+	// GetAllAccepting returns the enabled endpoints that receive the events of the type
+	GetAllAccepting(ctx context.Context, eventType webhook.EventType) ([]webhook.Endpoint, error)
+}
@@ -59,6 +59,7 @@ func (h UpdatePreferredCurrencyCommandHandler) createAccount(
 
 	acc := account.New(userID,
 		&cmd.Currency,
+		nil,
 		types.PlanFree,
 		types.RoleUser,
 		nil,
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type UpdatePreferredTimezoneCommand struct {
+	Timezone *time.Location
+}
+
+func NewUpdatePreferredTimezoneCommand(timezone *time.Location) UpdatePreferredTimezoneCommand {
+	return UpdatePreferredTimezoneCommand{
+		Timezone: timezone,
+	}
+}
+
+type UpdatePreferredTimezoneCommandHandler struct {
+	accountRepository ports.AccountRepository
+	authentication    ports.Authentication
+}
+
+func NewUpdatePreferredTimezoneCommandHandler(
+	accountRepository ports.AccountRepository,
+	authentication ports.Authentication) *UpdatePreferredTimezoneCommandHandler {
+	return &UpdatePreferredTimezoneCommandHandler{
+		accountRepository: accountRepository,
+		authentication:    authentication,
+	}
+}
+
+func (h UpdatePreferredTimezoneCommandHandler) Handle(
+	ctx context.Context,
+	cmd UpdatePreferredTimezoneCommand) result.Result[bool] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	acc, err := h.accountRepository.GetById(ctx, connectedAccount.UserID())
+	if err != nil {
+		return result.Fail[bool](err)
+	}
+
+	if acc == nil {
+		acc = account.New(connectedAccount.UserID(),
+			nil,
+			cmd.Timezone,
+			types.PlanFree,
+			types.RoleUser,
+			nil,
+			time.Now(),
+			time.Now(),
+		)
+	} else {
+		acc.SetTimezone(cmd.Timezone)
+	}
+
+	if err := h.accountRepository.Save(ctx, acc); err != nil {
+		return result.Fail[bool](err)
+	}
+
+	return result.Success(true)
+}
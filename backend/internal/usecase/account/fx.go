@@ -1,6 +1,8 @@
 package account
 
 import (
+	"time"
+
 	"go.uber.org/fx"
 
 	"github.com/mistribe/subtracker/internal/domain/billing"
@@ -15,9 +17,11 @@ func Module() fx.Option {
 		fx.Provide(
 			NewService,
 			ports.AsQueryHandler[query.FindPreferredCurrencyQuery, currency.Unit](query.NewFindPreferredCurrencyQueryHandler),
+			ports.AsQueryHandler[query.FindPreferredTimezoneQuery, *time.Location](query.NewFindPreferredTimezoneQueryHandler),
 			ports.AsQueryHandler[query.GetQuotaUsage, []billing.EffectiveEntitlement](query.NewGetQuotaUsageHandler),
 
 			ports.AsCommandHandler[command.UpdatePreferredCurrencyCommand, bool](command.NewUpdatePreferredCurrencyCommandHandler),
+			ports.AsCommandHandler[command.UpdatePreferredTimezoneCommand, bool](command.NewUpdatePreferredTimezoneCommandHandler),
 			ports.AsCommandHandler[command.DeleteAccountCommand, bool](command.NewDeleteUserCommandHandler),
 		),
 	)
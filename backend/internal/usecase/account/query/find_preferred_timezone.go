@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type FindPreferredTimezoneQuery struct {
+}
+
+func NewFindPreferredTimezoneQuery() FindPreferredTimezoneQuery {
+	return FindPreferredTimezoneQuery{}
+}
+
+type FindPreferredTimezoneQueryHandler struct {
+	accountService ports.AccountService
+	authentication ports.Authentication
+}
+
+func NewFindPreferredTimezoneQueryHandler(
+	accountService ports.AccountService,
+	authentication ports.Authentication) *FindPreferredTimezoneQueryHandler {
+	return &FindPreferredTimezoneQueryHandler{
+		accountService: accountService,
+		authentication: authentication,
+	}
+}
+
+func (h FindPreferredTimezoneQueryHandler) Handle(
+	ctx context.Context,
+	_ FindPreferredTimezoneQuery) result.Result[*time.Location] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	timezone := h.accountService.GetTimezone(ctx, connectedAccount.UserID())
+	return result.Success(timezone)
+}
@@ -3,6 +3,7 @@ package account
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/domain/types"
@@ -30,6 +31,19 @@ func (s service) GetPreferredCurrency(ctx context.Context, userId types.UserID)
 
 }
 
+func (s service) GetTimezone(ctx context.Context, userId types.UserID) *time.Location {
+	profile, err := s.userRepository.GetById(ctx, userId)
+	if err != nil {
+		s.logger.Error("cannot retrieve user profile", "err", err, "user_id", userId, "ctx", ctx)
+	}
+
+	if profile == nil || profile.Timezone() == nil {
+		return time.UTC
+	}
+
+	return profile.Timezone()
+}
+
 func NewService(
 	userRepository ports.AccountRepository,
 	logger *slog.Logger) ports.AccountService {
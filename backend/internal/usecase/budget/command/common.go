@@ -0,0 +1,31 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/label"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+// ensureLabel checks that the label of a label budget is visible to the user
+func ensureLabel(
+	ctx context.Context,
+	labelRepository ports.LabelRepository,
+	userId types.UserID,
+	b budget.Budget) error {
+	if b.Scope() != budget.LabelScope || b.LabelId() == nil {
+		return nil
+	}
+
+	lbl, err := labelRepository.GetByIdForUser(ctx, userId, *b.LabelId())
+	if err != nil {
+		return err
+	}
+	if lbl == nil {
+		return label.ErrLabelNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/billing"
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type CreateBudgetCommand struct {
+	BudgetID  option.Option[types.BudgetID]
+	Name      string
+	Scope     budget.Scope
+	LabelID   *types.LabelID
+	Period    budget.Period
+	Limit     currency.Amount
+	Owner     types.OwnerType
+	CreatedAt option.Option[time.Time]
+}
+
+type CreateBudgetCommandHandler struct {
+	budgetRepository ports.BudgetRepository
+	labelRepository  ports.LabelRepository
+	authentication   ports.Authentication
+	authorization    ports.Authorization
+	entitlement      ports.EntitlementResolver
+	ownerFactory     shared.OwnerFactory
+}
+
+func NewCreateBudgetCommandHandler(
+	budgetRepository ports.BudgetRepository,
+	labelRepository ports.LabelRepository,
+	authentication ports.Authentication,
+	authorization ports.Authorization,
+	entitlement ports.EntitlementResolver,
+	ownerFactory shared.OwnerFactory) *CreateBudgetCommandHandler {
+	return &CreateBudgetCommandHandler{
+		budgetRepository: budgetRepository,
+		labelRepository:  labelRepository,
+		authentication:   authentication,
+		authorization:    authorization,
+		entitlement:      entitlement,
+		ownerFactory:     ownerFactory,
+	}
+}
+
+func (h CreateBudgetCommandHandler) Handle(
+	ctx context.Context,
+	command CreateBudgetCommand) result.Result[budget.Budget] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	allowed, err := h.entitlement.CheckBoolean(ctx, connectedAccount, billing.FeatureIdBudgets)
+	if err != nil {
+		return result.Fail[budget.Budget](err)
+	}
+	if !allowed {
+		return result.Fail[budget.Budget](budget.ErrBudgetNotAllowed)
+	}
+
+	var budgetID types.BudgetID
+	if command.BudgetID != nil && command.BudgetID.IsSome() {
+		budgetID = *command.BudgetID.Value()
+		existing, err := h.budgetRepository.GetById(ctx, budgetID)
+		if err != nil {
+			return result.Fail[budget.Budget](err)
+		}
+		if existing != nil {
+			return result.Fail[budget.Budget](budget.ErrBudgetAlreadyExists)
+		}
+	} else {
+		budgetID = types.NewBudgetID()
+	}
+	var createdAt time.Time
+	if command.CreatedAt != nil && command.CreatedAt.IsSome() {
+		createdAt = *command.CreatedAt.Value()
+	} else {
+		createdAt = time.Now()
+	}
+
+	owner, err := h.ownerFactory.Resolve(ctx, command.Owner)
+	if err != nil {
+		return result.Fail[budget.Budget](err)
+	}
+
+	newBudget := budget.NewBudget(
+		budgetID,
+		owner,
+		command.Name,
+		command.Scope,
+		command.LabelID,
+		command.Period,
+		command.Limit,
+		createdAt,
+		createdAt,
+	)
+
+	permReq := h.authorization.Can(ctx, authorization.PermissionWrite)
+	if permReq == nil {
+		return result.Fail[budget.Budget](authorization.ErrUnauthorized)
+	}
+	if err := permReq.For(newBudget); err != nil {
+		return result.Fail[budget.Budget](err)
+	}
+
+	if err := newBudget.GetValidationErrors(); err != nil {
+		return result.Fail[budget.Budget](err)
+	}
+
+	if err := ensureLabel(ctx, h.labelRepository, connectedAccount.UserID(), newBudget); err != nil {
+		return result.Fail[budget.Budget](err)
+	}
+
+	if err := h.budgetRepository.Save(ctx, newBudget); err != nil {
+		return result.Fail[budget.Budget](err)
+	}
+
+	return result.Success(newBudget)
+}
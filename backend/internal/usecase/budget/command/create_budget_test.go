@@ -0,0 +1,155 @@
+package command_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mistribe/subtracker/internal/domain/account"
+	auth "github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/billing"
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/label"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/budget/command"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func TestCreateBudget(t *testing.T) {
+	userId := types.UserID("userID-Test")
+	owner := types.NewPersonalOwner(userId)
+	labelId := types.NewLabelID()
+
+	newCommand := func(id types.BudgetID) command.CreateBudgetCommand {
+		return command.CreateBudgetCommand{
+			BudgetID:  option.Some(id),
+			Name:      "Streaming",
+			Scope:     budget.LabelScope,
+			LabelID:   x.P(labelId),
+			Period:    budget.MonthlyPeriod,
+			Limit:     currency.NewAmount(50, currency.EUR),
+			Owner:     types.PersonalOwnerType,
+			CreatedAt: option.Some(time.Now()),
+		}
+	}
+
+	type mocks struct {
+		repository        *ports.MockBudgetRepository
+		labelRepository   *ports.MockLabelRepository
+		authentication    *ports.MockAuthentication
+		authorization     *ports.MockAuthorization
+		permissionRequest *ports.MockPermissionRequest
+		entitlement       *ports.MockEntitlementResolver
+		ownerFactory      *shared.MockOwnerFactory
+		account           *account.MockConnectedAccount
+	}
+	newMocks := func(t *testing.T) mocks {
+		m := mocks{
+			repository:        ports.NewMockBudgetRepository(t),
+			labelRepository:   ports.NewMockLabelRepository(t),
+			authentication:    ports.NewMockAuthentication(t),
+			authorization:     ports.NewMockAuthorization(t),
+			permissionRequest: ports.NewMockPermissionRequest(t),
+			entitlement:       ports.NewMockEntitlementResolver(t),
+			ownerFactory:      shared.NewMockOwnerFactory(t),
+			account:           account.NewMockConnectedAccount(t),
+		}
+		m.account.EXPECT().UserID().Return(userId).Maybe()
+		m.authentication.EXPECT().MustGetConnectedAccount(mock.Anything).Return(m.account)
+		return m
+	}
+	newHandler := func(m mocks) *command.CreateBudgetCommandHandler {
+		return command.NewCreateBudgetCommandHandler(m.repository, m.labelRepository, m.authentication,
+			m.authorization, m.entitlement, m.ownerFactory)
+	}
+
+	t.Run("all working", func(t *testing.T) {
+		m := newMocks(t)
+		id := types.NewBudgetID()
+		m.entitlement.EXPECT().CheckBoolean(mock.Anything, m.account, billing.FeatureIdBudgets).Return(true, nil)
+		m.repository.EXPECT().GetById(mock.Anything, id).Return(nil, nil)
+		m.ownerFactory.EXPECT().Resolve(mock.Anything, types.PersonalOwnerType).Return(owner, nil)
+		m.authorization.EXPECT().Can(mock.Anything, auth.PermissionWrite).Return(m.permissionRequest)
+		m.permissionRequest.EXPECT().For(mock.Anything).Return(nil)
+		m.labelRepository.EXPECT().GetByIdForUser(mock.Anything, userId, labelId).
+			Return(label.NewLabel(labelId, owner, "Streaming", nil, "#FF0000", time.Now(), time.Now()), nil)
+		m.repository.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+		res := newHandler(m).Handle(t.Context(), newCommand(id))
+
+		assert.True(t, res.IsSuccess())
+		res.IfSuccess(func(b budget.Budget) {
+			assert.Equal(t, id, b.Id())
+			assert.Equal(t, labelId, *b.LabelId())
+			assert.Equal(t, 50.0, b.Limit().Value())
+		})
+	})
+
+	t.Run("not allowed by the plan", func(t *testing.T) {
+		m := newMocks(t)
+		m.entitlement.EXPECT().CheckBoolean(mock.Anything, m.account, billing.FeatureIdBudgets).Return(false, nil)
+
+		res := newHandler(m).Handle(t.Context(), newCommand(types.NewBudgetID()))
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, budget.ErrBudgetNotAllowed)
+		})
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		m := newMocks(t)
+		id := types.NewBudgetID()
+		existing := budget.NewBudget(id, owner, "Streaming", budget.AccountScope, nil, budget.MonthlyPeriod,
+			currency.NewAmount(50, currency.EUR), time.Now(), time.Now())
+		m.entitlement.EXPECT().CheckBoolean(mock.Anything, m.account, billing.FeatureIdBudgets).Return(true, nil)
+		m.repository.EXPECT().GetById(mock.Anything, id).Return(existing, nil)
+
+		res := newHandler(m).Handle(t.Context(), newCommand(id))
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, budget.ErrBudgetAlreadyExists)
+		})
+	})
+
+	t.Run("unknown label", func(t *testing.T) {
+		m := newMocks(t)
+		id := types.NewBudgetID()
+		m.entitlement.EXPECT().CheckBoolean(mock.Anything, m.account, billing.FeatureIdBudgets).Return(true, nil)
+		m.repository.EXPECT().GetById(mock.Anything, id).Return(nil, nil)
+		m.ownerFactory.EXPECT().Resolve(mock.Anything, types.PersonalOwnerType).Return(owner, nil)
+		m.authorization.EXPECT().Can(mock.Anything, auth.PermissionWrite).Return(m.permissionRequest)
+		m.permissionRequest.EXPECT().For(mock.Anything).Return(nil)
+		m.labelRepository.EXPECT().GetByIdForUser(mock.Anything, userId, labelId).Return(nil, nil)
+
+		res := newHandler(m).Handle(t.Context(), newCommand(id))
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, label.ErrLabelNotFound)
+		})
+	})
+
+	t.Run("invalid limit is not saved", func(t *testing.T) {
+		m := newMocks(t)
+		id := types.NewBudgetID()
+		m.entitlement.EXPECT().CheckBoolean(mock.Anything, m.account, billing.FeatureIdBudgets).Return(true, nil)
+		m.repository.EXPECT().GetById(mock.Anything, id).Return(nil, nil)
+		m.ownerFactory.EXPECT().Resolve(mock.Anything, types.PersonalOwnerType).Return(owner, nil)
+		m.authorization.EXPECT().Can(mock.Anything, auth.PermissionWrite).Return(m.permissionRequest)
+		m.permissionRequest.EXPECT().For(mock.Anything).Return(nil)
+
+		cmd := newCommand(id)
+		cmd.Limit = currency.NewAmount(-10, currency.EUR)
+		res := newHandler(m).Handle(t.Context(), cmd)
+
+		assert.True(t, res.IsFaulted())
+	})
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type DeleteBudgetCommand struct {
+	BudgetID types.BudgetID
+}
+
+type DeleteBudgetCommandHandler struct {
+	budgetRepository ports.BudgetRepository
+	authorization    ports.Authorization
+}
+
+func NewDeleteBudgetCommandHandler(
+	budgetRepository ports.BudgetRepository,
+	authorization ports.Authorization) *DeleteBudgetCommandHandler {
+	return &DeleteBudgetCommandHandler{
+		budgetRepository: budgetRepository,
+		authorization:    authorization,
+	}
+}
+
+func (h DeleteBudgetCommandHandler) Handle(
+	ctx context.Context,
+	command DeleteBudgetCommand) result.Result[bool] {
+	existing, err := h.budgetRepository.GetById(ctx, command.BudgetID)
+	if err != nil {
+		return result.Fail[bool](err)
+	}
+	if existing == nil {
+		return result.Fail[bool](budget.ErrBudgetNotFound)
+	}
+
+	permReq := h.authorization.Can(ctx, authorization.PermissionDelete)
+	if permReq == nil {
+		return result.Fail[bool](authorization.ErrUnauthorized)
+	}
+	if err = permReq.For(existing); err != nil {
+		return result.Fail[bool](err)
+	}
+
+	ok, err := h.budgetRepository.Delete(ctx, command.BudgetID)
+	if err != nil {
+		return result.Fail[bool](err)
+	}
+	if !ok {
+		return result.Fail[bool](budget.ErrBudgetNotFound)
+	}
+
+	return result.Success(true)
+}
@@ -0,0 +1,53 @@
+package command_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	auth "github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/budget/command"
+)
+
+func TestDeleteBudget(t *testing.T) {
+	existing := budget.NewBudget(types.NewBudgetID(), types.NewPersonalOwner("userID-Test"), "Everything",
+		budget.AccountScope, nil, budget.YearlyPeriod, currency.NewAmount(600, currency.EUR), time.Now(),
+		time.Now())
+
+	t.Run("all working", func(t *testing.T) {
+		repository := ports.NewMockBudgetRepository(t)
+		authorization := ports.NewMockAuthorization(t)
+		permissionRequest := ports.NewMockPermissionRequest(t)
+
+		repository.EXPECT().GetById(mock.Anything, existing.Id()).Return(existing, nil)
+		authorization.EXPECT().Can(mock.Anything, auth.PermissionDelete).Return(permissionRequest)
+		permissionRequest.EXPECT().For(existing).Return(nil)
+		repository.EXPECT().Delete(mock.Anything, existing.Id()).Return(true, nil)
+
+		handler := command.NewDeleteBudgetCommandHandler(repository, authorization)
+		res := handler.Handle(t.Context(), command.DeleteBudgetCommand{BudgetID: existing.Id()})
+
+		assert.True(t, res.IsSuccess())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repository := ports.NewMockBudgetRepository(t)
+		authorization := ports.NewMockAuthorization(t)
+
+		repository.EXPECT().GetById(mock.Anything, existing.Id()).Return(nil, nil)
+
+		handler := command.NewDeleteBudgetCommandHandler(repository, authorization)
+		res := handler.Handle(t.Context(), command.DeleteBudgetCommand{BudgetID: existing.Id()})
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, budget.ErrBudgetNotFound)
+		})
+	})
+}
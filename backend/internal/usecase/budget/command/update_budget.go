@@ -0,0 +1,91 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type UpdateBudgetCommand struct {
+	BudgetID  types.BudgetID
+	Name      string
+	Scope     budget.Scope
+	LabelID   *types.LabelID
+	Period    budget.Period
+	Limit     currency.Amount
+	UpdatedAt option.Option[time.Time]
+}
+
+type UpdateBudgetCommandHandler struct {
+	budgetRepository ports.BudgetRepository
+	labelRepository  ports.LabelRepository
+	authentication   ports.Authentication
+	authorization    ports.Authorization
+}
+
+func NewUpdateBudgetCommandHandler(
+	budgetRepository ports.BudgetRepository,
+	labelRepository ports.LabelRepository,
+	authentication ports.Authentication,
+	authorization ports.Authorization) *UpdateBudgetCommandHandler {
+	return &UpdateBudgetCommandHandler{
+		budgetRepository: budgetRepository,
+		labelRepository:  labelRepository,
+		authentication:   authentication,
+		authorization:    authorization,
+	}
+}
+
+func (h UpdateBudgetCommandHandler) Handle(
+	ctx context.Context,
+	command UpdateBudgetCommand) result.Result[budget.Budget] {
+	existing, err := h.budgetRepository.GetById(ctx, command.BudgetID)
+	if err != nil {
+		return result.Fail[budget.Budget](err)
+	}
+	if existing == nil {
+		return result.Fail[budget.Budget](budget.ErrBudgetNotFound)
+	}
+
+	permReq := h.authorization.Can(ctx, authorization.PermissionWrite)
+	if permReq == nil {
+		return result.Fail[budget.Budget](authorization.ErrUnauthorized)
+	}
+	if err = permReq.For(existing); err != nil {
+		return result.Fail[budget.Budget](err)
+	}
+
+	existing.SetName(command.Name)
+	existing.SetScope(command.Scope, command.LabelID)
+	existing.SetPeriod(command.Period)
+	existing.SetLimit(command.Limit)
+	var updatedAt time.Time
+	if command.UpdatedAt != nil && command.UpdatedAt.IsSome() {
+		updatedAt = *command.UpdatedAt.Value()
+	} else {
+		updatedAt = time.Now()
+	}
+	existing.SetUpdatedAt(updatedAt)
+
+	if err := existing.GetValidationErrors(); err != nil {
+		return result.Fail[budget.Budget](err)
+	}
+
+	userId := h.authentication.MustGetConnectedAccount(ctx).UserID()
+	if err := ensureLabel(ctx, h.labelRepository, userId, existing); err != nil {
+		return result.Fail[budget.Budget](err)
+	}
+
+	if err := h.budgetRepository.Save(ctx, existing); err != nil {
+		return result.Fail[budget.Budget](err)
+	}
+
+	return result.Success(existing)
+}
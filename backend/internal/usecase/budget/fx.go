@@ -0,0 +1,24 @@
+package budget
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/internal/usecase/budget/command"
+	"github.com/mistribe/subtracker/internal/usecase/budget/query"
+)
+
+func Module() fx.Option {
+	return fx.Module("app_budget",
+		fx.Provide(
+			ports.AsQueryHandler[query.FindAllQuery, shared.PaginatedResponse[budget.Budget]](query.NewFindAllQueryHandler),
+			ports.AsQueryHandler[query.FindOneQuery, budget.Budget](query.NewFindOneQueryHandler),
+			ports.AsQueryHandler[query.StatusQuery, shared.PaginatedResponse[query.StatusResponse]](query.NewStatusQueryHandler),
+
+			ports.AsCommandHandler[command.CreateBudgetCommand, budget.Budget](command.NewCreateBudgetCommandHandler),
+			ports.AsCommandHandler[command.UpdateBudgetCommand, budget.Budget](command.NewUpdateBudgetCommandHandler),
+			ports.AsCommandHandler[command.DeleteBudgetCommand, bool](command.NewDeleteBudgetCommandHandler),
+		))
+}
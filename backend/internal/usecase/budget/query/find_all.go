@@ -0,0 +1,48 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type FindAllQuery struct {
+	Limit  int64
+	Offset int64
+}
+
+func NewFindAllQuery(limit, offset int64) FindAllQuery {
+	return FindAllQuery{
+		Limit:  limit,
+		Offset: offset,
+	}
+}
+
+type FindAllQueryHandler struct {
+	budgetRepository ports.BudgetRepository
+	authentication   ports.Authentication
+}
+
+func NewFindAllQueryHandler(
+	budgetRepository ports.BudgetRepository,
+	authentication ports.Authentication) *FindAllQueryHandler {
+	return &FindAllQueryHandler{
+		budgetRepository: budgetRepository,
+		authentication:   authentication,
+	}
+}
+
+func (h FindAllQueryHandler) Handle(
+	ctx context.Context,
+	query FindAllQuery) result.Result[shared.PaginatedResponse[budget.Budget]] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	params := ports.NewBudgetQueryParameters(query.Limit, query.Offset)
+	budgets, count, err := h.budgetRepository.GetAll(ctx, connectedAccount.UserID(), params)
+	if err != nil {
+		return result.Fail[shared.PaginatedResponse[budget.Budget]](err)
+	}
+	return result.Success(shared.NewPaginatedResponse(budgets, count))
+}
@@ -0,0 +1,49 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type FindOneQuery struct {
+	BudgetID types.BudgetID
+}
+
+func NewFindOneQuery(budgetID types.BudgetID) FindOneQuery {
+	return FindOneQuery{
+		BudgetID: budgetID,
+	}
+}
+
+type FindOneQueryHandler struct {
+	budgetRepository ports.BudgetRepository
+	authentication   ports.Authentication
+}
+
+func NewFindOneQueryHandler(
+	budgetRepository ports.BudgetRepository,
+	authentication ports.Authentication) *FindOneQueryHandler {
+	return &FindOneQueryHandler{
+		budgetRepository: budgetRepository,
+		authentication:   authentication,
+	}
+}
+
+func (h FindOneQueryHandler) Handle(
+	ctx context.Context,
+	query FindOneQuery) result.Result[budget.Budget] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	b, err := h.budgetRepository.GetByIdForUser(ctx, connectedAccount.UserID(), query.BudgetID)
+	if err != nil {
+		return result.Fail[budget.Budget](err)
+	}
+	if b == nil {
+		return result.Fail[budget.Budget](budget.ErrBudgetNotFound)
+	}
+
+	return result.Success(b)
+}
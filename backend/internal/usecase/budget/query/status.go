@@ -0,0 +1,118 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/pkg/decimal"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// StatusQuery compares the budgets of the connected account with the cost of the subscriptions they cover
+type StatusQuery struct {
+	Limit  int64
+	Offset int64
+}
+
+func NewStatusQuery(limit, offset int64) StatusQuery {
+	return StatusQuery{
+		Limit:  limit,
+		Offset: offset,
+	}
+}
+
+type StatusResponse struct {
+	Budget budget.Budget
+	Status budget.Status
+}
+
+type StatusQueryHandler struct {
+	budgetRepository       ports.BudgetRepository
+	subscriptionRepository ports.SubscriptionRepository
+	accountService         ports.AccountService
+	authentication         ports.Authentication
+	exchange               ports.Exchange
+}
+
+func NewStatusQueryHandler(
+	budgetRepository ports.BudgetRepository,
+	subscriptionRepository ports.SubscriptionRepository,
+	accountService ports.AccountService,
+	authentication ports.Authentication,
+	exchange ports.Exchange) *StatusQueryHandler {
+	return &StatusQueryHandler{
+		budgetRepository:       budgetRepository,
+		subscriptionRepository: subscriptionRepository,
+		accountService:         accountService,
+		authentication:         authentication,
+		exchange:               exchange,
+	}
+}
+
+// Handle returns the status of each budget, the cost of the active subscriptions covered by a budget is
+// normalized to its period and converted at the rate of the day into the currency of its limit
+func (h StatusQueryHandler) Handle(
+	ctx context.Context,
+	query StatusQuery) result.Result[shared.PaginatedResponse[StatusResponse]] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	userId := connectedAccount.UserID()
+	params := ports.NewBudgetQueryParameters(query.Limit, query.Offset)
+	budgets, count, err := h.budgetRepository.GetAll(ctx, userId, params)
+	if err != nil {
+		return result.Fail[shared.PaginatedResponse[StatusResponse]](err)
+	}
+
+	statuses := make([]StatusResponse, 0, len(budgets))
+	if len(budgets) == 0 {
+		return result.Success(shared.NewPaginatedResponse(statuses, count))
+	}
+
+	timezone := h.accountService.GetTimezone(ctx, userId)
+	now := time.Now()
+	subscriptions := slices.Collect(h.subscriptionRepository.GetAllIt(ctx, userId, ""))
+	// The costs are converted in one batch per currency of limit
+	consumed := make([]decimal.Decimal, len(budgets))
+	conversions := make(map[currency.Unit]*ports.ConversionBatch)
+	for index, b := range budgets {
+		consumed[index] = decimal.Zero
+		batch, ok := conversions[b.Limit().Currency()]
+		if !ok {
+			batch = &ports.ConversionBatch{}
+			conversions[b.Limit().Currency()] = batch
+		}
+		for _, sub := range subscriptions {
+			if !b.Covers(sub) || !sub.IsActiveAtIn(now, timezone) {
+				continue
+			}
+
+			amount := sub.GetRecurrencyAmount(b.Period().RecurrencyType())
+			if !amount.IsValid() {
+				continue
+			}
+			batch.AddForSubscription(sub.Id(), amount, now, func(converted currency.Amount) {
+				if converted.IsValid() {
+					consumed[index] = consumed[index].Add(converted.Decimal())
+				}
+			})
+		}
+	}
+	for target, batch := range conversions {
+		if err := batch.Convert(ctx, h.exchange, target); err != nil {
+			return result.Fail[shared.PaginatedResponse[StatusResponse]](err)
+		}
+	}
+
+	for index, b := range budgets {
+		statuses = append(statuses, StatusResponse{
+			Budget: b,
+			Status: budget.NewStatus(b, consumed[index]),
+		})
+	}
+
+	return result.Success(shared.NewPaginatedResponse(statuses, count))
+}
@@ -0,0 +1,126 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/budget"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/internal/usecase/budget/query"
+	"github.com/mistribe/subtracker/pkg/x"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+)
+
+func TestStatusQueryHandler_Handle(t *testing.T) {
+	userId := types.UserID("user-1")
+	familyId := types.NewFamilyID()
+	labelId := types.NewLabelID()
+	startDate := time.Now().AddDate(0, -2, 0)
+
+	newSub := func(amount currency.Amount, recurrency subscription.RecurrencyType, owner types.Owner,
+		endDate *time.Time, labels ...types.LabelID) subscription.Subscription {
+		var refs []subscription.LabelRef
+		for _, label := range labels {
+			refs = append(refs, subscription.LabelRef{LabelId: label})
+		}
+		return subscription.NewSubscription(types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+			subscription.NewPrice(amount), nil, nil, owner, nil, nil, nil, nil, refs, startDate, endDate, nil,
+			recurrency, nil, nil, time.Now(), time.Now())
+	}
+	newBudget := func(owner types.Owner, scope budget.Scope, labelId *types.LabelID, period budget.Period,
+		limit float64) budget.Budget {
+		return budget.NewBudget(types.NewBudgetID(), owner, scope.String(), scope, labelId, period,
+			currency.NewAmount(limit, currency.EUR), time.Now(), time.Now())
+	}
+
+	subscriptions := []subscription.Subscription{
+		newSub(currency.NewAmount(10, currency.EUR), subscription.MonthlyRecurrency,
+			types.NewPersonalOwner(userId), nil, labelId),
+		newSub(currency.NewAmount(120, currency.USD), subscription.YearlyRecurrency,
+			types.NewPersonalOwner(userId), nil, labelId),
+		newSub(currency.NewAmount(30, currency.EUR), subscription.MonthlyRecurrency,
+			types.NewFamilyOwner(familyId), nil),
+		// Ended subscriptions are not counted
+		newSub(currency.NewAmount(100, currency.EUR), subscription.MonthlyRecurrency,
+			types.NewPersonalOwner(userId), x.P(time.Now().AddDate(0, -1, 0)), labelId),
+	}
+	budgets := []budget.Budget{
+		newBudget(types.NewPersonalOwner(userId), budget.LabelScope, x.P(labelId), budget.MonthlyPeriod, 20),
+		newBudget(types.NewFamilyOwner(familyId), budget.FamilyScope, nil, budget.MonthlyPeriod, 25),
+		newBudget(types.NewPersonalOwner(userId), budget.AccountScope, nil, budget.YearlyPeriod, 200),
+	}
+
+	budgetRepo := ports.NewMockBudgetRepository(t)
+	subRepo := ports.NewMockSubscriptionRepository(t)
+	acctService := ports.NewMockAccountService(t)
+	authentication := ports.NewMockAuthentication(t)
+	exch := ports.NewMockExchange(t)
+
+	acc := account.NewMockConnectedAccount(t)
+	acc.EXPECT().UserID().Return(userId)
+	authentication.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc)
+	acctService.EXPECT().GetTimezone(mock.Anything, userId).Return(time.UTC)
+	budgetRepo.EXPECT().GetAll(mock.Anything, userId, ports.NewBudgetQueryParameters(10, 0)).
+		Return(budgets, int64(len(budgets)), nil)
+	subRepo.EXPECT().GetAllIt(mock.Anything, userId, "").
+		Return(func(yield func(subscription.Subscription) bool) {
+			for _, sub := range subscriptions {
+				if !yield(sub) {
+					return
+				}
+			}
+		})
+	// One dollar is worth half a euro
+	exch.EXPECT().ToCurrencyBatch(mock.Anything, mock.Anything, currency.EUR).
+		RunAndReturn(func(
+			_ context.Context,
+			conversions []ports.Conversion,
+			target currency.Unit) ([]currency.Amount, error) {
+			return herd.Select(conversions, func(c ports.Conversion) currency.Amount {
+				// The manual rates of the subscription only apply when its id is given
+				if !assert.NotNil(t, c.SubscriptionId) {
+					return currency.NewInvalidAmount()
+				}
+				if c.Amount.Currency() == currency.USD {
+					return currency.NewAmount(c.Amount.Value()/2, target)
+				}
+				return c.Amount
+			}), nil
+		})
+
+	h := query.NewStatusQueryHandler(budgetRepo, subRepo, acctService, authentication, exch)
+	res := h.Handle(t.Context(), query.NewStatusQuery(10, 0))
+
+	require.True(t, res.IsSuccess())
+	res.IfSuccess(func(r shared.PaginatedResponse[query.StatusResponse]) {
+		require.Len(t, r.Data(), 3)
+
+		// 10 EUR plus 120 USD a year normalized to 5 EUR a month
+		label := r.Data()[0].Status
+		assert.InDelta(t, 15.0, label.Consumed.Value(), 0.0001)
+		assert.InDelta(t, 75.0, label.ConsumedPercentage, 0.0001)
+		assert.Equal(t, 0.0, label.ProjectedOverrun.Value())
+		assert.False(t, label.IsExceeded())
+
+		family := r.Data()[1].Status
+		assert.InDelta(t, 120.0, family.ConsumedPercentage, 0.0001)
+		assert.InDelta(t, 5.0, family.ProjectedOverrun.Value(), 0.0001)
+		assert.True(t, family.IsExceeded())
+
+		// 10 EUR a month is 120 EUR a year, plus 60 EUR
+		personal := r.Data()[2].Status
+		assert.InDelta(t, 180.0, personal.Consumed.Value(), 0.0001)
+		assert.InDelta(t, 90.0, personal.ConsumedPercentage, 0.0001)
+		assert.InDelta(t, 20.0, personal.Remaining.Value(), 0.0001)
+	})
+}
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// CreateFeedCommand creates the calendar feed of the connected account, the result is the feed token
+type CreateFeedCommand struct {
+}
+
+type CreateFeedCommandHandler struct {
+	calendarFeedRepository ports.CalendarFeedRepository
+	authentication         ports.Authentication
+}
+
+func NewCreateFeedCommandHandler(
+	calendarFeedRepository ports.CalendarFeedRepository,
+	authentication ports.Authentication) *CreateFeedCommandHandler {
+	return &CreateFeedCommandHandler{
+		calendarFeedRepository: calendarFeedRepository,
+		authentication:         authentication,
+	}
+}
+
+func (h CreateFeedCommandHandler) Handle(
+	ctx context.Context,
+	_ CreateFeedCommand) result.Result[string] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	existing, err := h.calendarFeedRepository.GetByUserId(ctx, connectedAccount.UserID())
+	if err != nil {
+		return result.Fail[string](err)
+	}
+	if existing != nil {
+		return result.Fail[string](calendar.ErrFeedAlreadyExists)
+	}
+
+	feed, token, err := calendar.CreateFeed(connectedAccount.UserID())
+	if err != nil {
+		return result.Fail[string](err)
+	}
+	if err = h.calendarFeedRepository.Save(ctx, feed); err != nil {
+		return result.Fail[string](err)
+	}
+
+	return result.Success(token)
+}
@@ -0,0 +1,60 @@
+package command_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/calendar/command"
+)
+
+func newAuthentication(t *testing.T, userId types.UserID) *ports.MockAuthentication {
+	authentication := ports.NewMockAuthentication(t)
+	connectedAccount := account.NewMockConnectedAccount(t)
+	connectedAccount.EXPECT().UserID().Return(userId)
+	authentication.EXPECT().MustGetConnectedAccount(mock.Anything).Return(connectedAccount)
+	return authentication
+}
+
+func TestCreateFeed(t *testing.T) {
+	userId := types.UserID("userID-Test")
+
+	t.Run("all working", func(t *testing.T) {
+		repository := ports.NewMockCalendarFeedRepository(t)
+		authentication := newAuthentication(t, userId)
+
+		repository.EXPECT().GetByUserId(mock.Anything, userId).Return(nil, nil)
+		repository.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+		handler := command.NewCreateFeedCommandHandler(repository, authentication)
+		res := handler.Handle(t.Context(), command.CreateFeedCommand{})
+
+		assert.True(t, res.IsSuccess())
+		res.IfSuccess(func(token string) {
+			assert.NotEmpty(t, token)
+		})
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		repository := ports.NewMockCalendarFeedRepository(t)
+		authentication := newAuthentication(t, userId)
+
+		existing := calendar.NewFeed(types.NewCalendarFeedID(), userId, calendar.HashToken("token"), time.Now(),
+			time.Now())
+		repository.EXPECT().GetByUserId(mock.Anything, userId).Return(existing, nil)
+
+		handler := command.NewCreateFeedCommandHandler(repository, authentication)
+		res := handler.Handle(t.Context(), command.CreateFeedCommand{})
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, calendar.ErrFeedAlreadyExists)
+		})
+	})
+}
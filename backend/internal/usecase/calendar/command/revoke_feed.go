@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// RevokeFeedCommand deletes the calendar feed of the connected account
+type RevokeFeedCommand struct {
+}
+
+type RevokeFeedCommandHandler struct {
+	calendarFeedRepository ports.CalendarFeedRepository
+	authentication         ports.Authentication
+}
+
+func NewRevokeFeedCommandHandler(
+	calendarFeedRepository ports.CalendarFeedRepository,
+	authentication ports.Authentication) *RevokeFeedCommandHandler {
+	return &RevokeFeedCommandHandler{
+		calendarFeedRepository: calendarFeedRepository,
+		authentication:         authentication,
+	}
+}
+
+func (h RevokeFeedCommandHandler) Handle(
+	ctx context.Context,
+	_ RevokeFeedCommand) result.Result[bool] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	feed, err := h.calendarFeedRepository.GetByUserId(ctx, connectedAccount.UserID())
+	if err != nil {
+		return result.Fail[bool](err)
+	}
+	if feed == nil {
+		return result.Fail[bool](calendar.ErrFeedNotFound)
+	}
+
+	ok, err := h.calendarFeedRepository.Delete(ctx, feed.Id())
+	if err != nil {
+		return result.Fail[bool](err)
+	}
+	if !ok {
+		return result.Fail[bool](calendar.ErrFeedNotFound)
+	}
+
+	return result.Success(true)
+}
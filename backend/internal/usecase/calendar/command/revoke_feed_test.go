@@ -0,0 +1,48 @@
+package command_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/calendar/command"
+)
+
+func TestRevokeFeed(t *testing.T) {
+	userId := types.UserID("userID-Test")
+
+	t.Run("all working", func(t *testing.T) {
+		repository := ports.NewMockCalendarFeedRepository(t)
+		authentication := newAuthentication(t, userId)
+
+		existing := calendar.NewFeed(types.NewCalendarFeedID(), userId, calendar.HashToken("token"), time.Now(),
+			time.Now())
+		repository.EXPECT().GetByUserId(mock.Anything, userId).Return(existing, nil)
+		repository.EXPECT().Delete(mock.Anything, existing.Id()).Return(true, nil)
+
+		handler := command.NewRevokeFeedCommandHandler(repository, authentication)
+		res := handler.Handle(t.Context(), command.RevokeFeedCommand{})
+
+		assert.True(t, res.IsSuccess())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repository := ports.NewMockCalendarFeedRepository(t)
+		authentication := newAuthentication(t, userId)
+
+		repository.EXPECT().GetByUserId(mock.Anything, userId).Return(nil, nil)
+
+		handler := command.NewRevokeFeedCommandHandler(repository, authentication)
+		res := handler.Handle(t.Context(), command.RevokeFeedCommand{})
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, calendar.ErrFeedNotFound)
+		})
+	})
+}
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// RotateFeedCommand replaces the token of the calendar feed of the connected account,
+// the previous token stops working. The result is the new token.
+type RotateFeedCommand struct {
+}
+
+type RotateFeedCommandHandler struct {
+	calendarFeedRepository ports.CalendarFeedRepository
+	authentication         ports.Authentication
+}
+
+func NewRotateFeedCommandHandler(
+	calendarFeedRepository ports.CalendarFeedRepository,
+	authentication ports.Authentication) *RotateFeedCommandHandler {
+	return &RotateFeedCommandHandler{
+		calendarFeedRepository: calendarFeedRepository,
+		authentication:         authentication,
+	}
+}
+
+func (h RotateFeedCommandHandler) Handle(
+	ctx context.Context,
+	_ RotateFeedCommand) result.Result[string] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	feed, err := h.calendarFeedRepository.GetByUserId(ctx, connectedAccount.UserID())
+	if err != nil {
+		return result.Fail[string](err)
+	}
+	if feed == nil {
+		return result.Fail[string](calendar.ErrFeedNotFound)
+	}
+
+	token, err := feed.Rotate()
+	if err != nil {
+		return result.Fail[string](err)
+	}
+	if err = h.calendarFeedRepository.Save(ctx, feed); err != nil {
+		return result.Fail[string](err)
+	}
+
+	return result.Success(token)
+}
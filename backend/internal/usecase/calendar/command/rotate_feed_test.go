@@ -0,0 +1,52 @@
+package command_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/calendar/command"
+)
+
+func TestRotateFeed(t *testing.T) {
+	userId := types.UserID("userID-Test")
+
+	t.Run("all working", func(t *testing.T) {
+		repository := ports.NewMockCalendarFeedRepository(t)
+		authentication := newAuthentication(t, userId)
+
+		previousHash := calendar.HashToken("previous")
+		existing := calendar.NewFeed(types.NewCalendarFeedID(), userId, previousHash, time.Now(), time.Now())
+		repository.EXPECT().GetByUserId(mock.Anything, userId).Return(existing, nil)
+		repository.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+		handler := command.NewRotateFeedCommandHandler(repository, authentication)
+		res := handler.Handle(t.Context(), command.RotateFeedCommand{})
+
+		assert.True(t, res.IsSuccess())
+		res.IfSuccess(func(token string) {
+			assert.Equal(t, calendar.HashToken(token), existing.TokenHash())
+			assert.NotEqual(t, previousHash, existing.TokenHash())
+		})
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repository := ports.NewMockCalendarFeedRepository(t)
+		authentication := newAuthentication(t, userId)
+
+		repository.EXPECT().GetByUserId(mock.Anything, userId).Return(nil, nil)
+
+		handler := command.NewRotateFeedCommandHandler(repository, authentication)
+		res := handler.Handle(t.Context(), command.RotateFeedCommand{})
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, calendar.ErrFeedNotFound)
+		})
+	})
+}
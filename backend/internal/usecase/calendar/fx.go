@@ -0,0 +1,22 @@
+package calendar
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/calendar/command"
+	"github.com/mistribe/subtracker/internal/usecase/calendar/query"
+)
+
+func Module() fx.Option {
+	return fx.Module("app_calendar",
+		fx.Provide(
+			ports.AsQueryHandler[query.FindEventsQuery, []calendar.Event](query.NewFindEventsQueryHandler),
+			ports.AsQueryHandler[query.FindFeedEventsQuery, []calendar.Event](query.NewFindFeedEventsQueryHandler),
+
+			ports.AsCommandHandler[command.CreateFeedCommand, string](command.NewCreateFeedCommandHandler),
+			ports.AsCommandHandler[command.RotateFeedCommand, string](command.NewRotateFeedCommandHandler),
+			ports.AsCommandHandler[command.RevokeFeedCommand, bool](command.NewRevokeFeedCommandHandler),
+		))
+}
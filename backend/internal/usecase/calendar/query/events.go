@@ -0,0 +1,12 @@
+package query
+
+import (
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+)
+
+func renewalCount(renewals int) int {
+	if renewals <= 0 {
+		return calendar.DefaultRenewalCount
+	}
+	return min(renewals, calendar.MaxRenewalCount)
+}
@@ -0,0 +1,48 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// FindEventsQuery lists the calendar events of the subscriptions of the connected account
+type FindEventsQuery struct {
+	// Renewals is the number of upcoming renewals listed for each subscription
+	Renewals int
+}
+
+func NewFindEventsQuery(renewals int) FindEventsQuery {
+	return FindEventsQuery{
+		Renewals: renewalCount(renewals),
+	}
+}
+
+type FindEventsQueryHandler struct {
+	eventBuilder   shared.CalendarEventBuilder
+	authentication ports.Authentication
+}
+
+func NewFindEventsQueryHandler(
+	eventBuilder shared.CalendarEventBuilder,
+	authentication ports.Authentication) *FindEventsQueryHandler {
+	return &FindEventsQueryHandler{
+		eventBuilder:   eventBuilder,
+		authentication: authentication,
+	}
+}
+
+func (h FindEventsQueryHandler) Handle(
+	ctx context.Context,
+	query FindEventsQuery) result.Result[[]calendar.Event] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	events, err := h.eventBuilder.Build(ctx, connectedAccount.UserID(), renewalCount(query.Renewals))
+	if err != nil {
+		return result.Fail[[]calendar.Event](err)
+	}
+
+	return result.Success(events)
+}
@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// FindFeedEventsQuery lists the calendar events of the account owning the feed token.
+// It does not require a session, the token is the credential.
+type FindFeedEventsQuery struct {
+	Token string
+	// Renewals is the number of upcoming renewals listed for each subscription
+	Renewals int
+}
+
+func NewFindFeedEventsQuery(token string, renewals int) FindFeedEventsQuery {
+	return FindFeedEventsQuery{
+		Token:    token,
+		Renewals: renewalCount(renewals),
+	}
+}
+
+type FindFeedEventsQueryHandler struct {
+	eventBuilder           shared.CalendarEventBuilder
+	calendarFeedRepository ports.CalendarFeedRepository
+}
+
+func NewFindFeedEventsQueryHandler(
+	calendarFeedRepository ports.CalendarFeedRepository,
+	eventBuilder shared.CalendarEventBuilder) *FindFeedEventsQueryHandler {
+	return &FindFeedEventsQueryHandler{
+		eventBuilder:           eventBuilder,
+		calendarFeedRepository: calendarFeedRepository,
+	}
+}
+
+func (h FindFeedEventsQueryHandler) Handle(
+	ctx context.Context,
+	query FindFeedEventsQuery) result.Result[[]calendar.Event] {
+	if query.Token == "" {
+		return result.Fail[[]calendar.Event](calendar.ErrFeedNotFound)
+	}
+
+	feed, err := h.calendarFeedRepository.GetByTokenHash(ctx, calendar.HashToken(query.Token))
+	if err != nil {
+		return result.Fail[[]calendar.Event](err)
+	}
+	if feed == nil {
+		return result.Fail[[]calendar.Event](calendar.ErrFeedNotFound)
+	}
+
+	events, err := h.eventBuilder.Build(ctx, feed.UserId(), renewalCount(query.Renewals))
+	if err != nil {
+		return result.Fail[[]calendar.Event](err)
+	}
+
+	return result.Success(events)
+}
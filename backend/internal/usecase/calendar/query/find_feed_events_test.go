@@ -0,0 +1,109 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/provider"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/calendar/query"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func newMonthlySubscription(
+	userId types.UserID,
+	providerId types.ProviderID,
+	friendlyName *string,
+	startDate time.Time) subscription.Subscription {
+	return subscription.NewSubscription(
+		types.NewSubscriptionID(), friendlyName, nil, nil, providerId,
+		subscription.NewPrice(currency.NewAmount(10, currency.EUR)), nil, nil,
+		types.NewPersonalOwner(userId), nil, nil, nil, nil, nil,
+		startDate, nil, nil, subscription.MonthlyRecurrency, nil, nil, time.Now(), time.Now())
+}
+
+func TestFindFeedEventsQueryHandler_Handle(t *testing.T) {
+	userId := types.UserID("user-1")
+	token := "feed-token"
+	feed := calendar.NewFeed(types.NewCalendarFeedID(), userId, calendar.HashToken(token), time.Now(), time.Now())
+	start := time.Now().UTC().AddDate(0, -1, 0)
+
+	t.Run("lists the events of the feed owner", func(t *testing.T) {
+		feedRepo := ports.NewMockCalendarFeedRepository(t)
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		providerRepo := ports.NewMockProviderRepository(t)
+		accountService := ports.NewMockAccountService(t)
+
+		streaming := provider.NewProvider(types.NewProviderID(), "Netflix", nil, nil, nil, nil, nil,
+			types.NewPersonalOwner(userId), time.Now(), time.Now())
+		subs := []subscription.Subscription{
+			newMonthlySubscription(userId, streaming.Id(), nil, start),
+			newMonthlySubscription(userId, streaming.Id(), x.P("Family plan"), start.AddDate(0, 0, 10)),
+		}
+
+		feedRepo.EXPECT().GetByTokenHash(mock.Anything, calendar.HashToken(token)).Return(feed, nil)
+		accountService.EXPECT().GetTimezone(mock.Anything, userId).Return(time.UTC)
+		subRepo.EXPECT().GetAllIt(mock.Anything, userId, "").Return(func(yield func(subscription.Subscription) bool) {
+			for _, sub := range subs {
+				if !yield(sub) {
+					return
+				}
+			}
+		})
+		// the provider name is only looked up once
+		providerRepo.EXPECT().GetById(mock.Anything, streaming.Id()).Return(streaming, nil).Once()
+
+		handler := query.NewFindFeedEventsQueryHandler(feedRepo,
+			shared.NewCalendarEventBuilder(subRepo, providerRepo, accountService))
+		res := handler.Handle(t.Context(), query.NewFindFeedEventsQuery(token, 2))
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(events []calendar.Event) {
+			require.Len(t, events, 4)
+			summaries := make(map[string]int)
+			for i, event := range events {
+				summaries[event.Summary]++
+				if i > 0 {
+					assert.False(t, event.Date.Before(events[i-1].Date))
+				}
+			}
+			assert.Equal(t, map[string]int{"Netflix renewal": 2, "Family plan renewal": 2}, summaries)
+		})
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		feedRepo := ports.NewMockCalendarFeedRepository(t)
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		providerRepo := ports.NewMockProviderRepository(t)
+		accountService := ports.NewMockAccountService(t)
+
+		feedRepo.EXPECT().GetByTokenHash(mock.Anything, calendar.HashToken("unknown")).Return(nil, nil)
+
+		handler := query.NewFindFeedEventsQueryHandler(feedRepo,
+			shared.NewCalendarEventBuilder(subRepo, providerRepo, accountService))
+		res := handler.Handle(t.Context(), query.NewFindFeedEventsQuery("unknown", 0))
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, calendar.ErrFeedNotFound)
+		})
+	})
+
+	t.Run("empty token", func(t *testing.T) {
+		handler := query.NewFindFeedEventsQueryHandler(ports.NewMockCalendarFeedRepository(t),
+			shared.NewCalendarEventBuilder(ports.NewMockSubscriptionRepository(t), ports.NewMockProviderRepository(t),
+				ports.NewMockAccountService(t)))
+		res := handler.Handle(t.Context(), query.NewFindFeedEventsQuery("", 0))
+
+		assert.True(t, res.IsFaulted())
+	})
+}
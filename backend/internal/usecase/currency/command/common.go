@@ -0,0 +1,29 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+// ensureUniquePair checks that no other manual rate of the owner applies to the same conversions
+func ensureUniquePair(
+	ctx context.Context,
+	manualRateRepository ports.ManualRateRepository,
+	userId types.UserID,
+	rate currency.ManualRate) error {
+	rates, err := manualRateRepository.GetAllForUser(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range rates {
+		if rate.Overlaps(other) {
+			return currency.ErrManualRateAlreadyExists
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type CreateManualRateCommand struct {
+	ManualRateID option.Option[types.ManualRateID]
+	// Owner is ignored for the rate of a subscription, it belongs to the owner of the subscription
+	Owner          types.OwnerType
+	SubscriptionID *types.SubscriptionID
+	FromCurrency   currency.Unit
+	ToCurrency     currency.Unit
+	Rate           float64
+	CreatedAt      option.Option[time.Time]
+}
+
+type CreateManualRateCommandHandler struct {
+	manualRateRepository   ports.ManualRateRepository
+	subscriptionRepository ports.SubscriptionRepository
+	authentication         ports.Authentication
+	authorization          ports.Authorization
+	ownerFactory           shared.OwnerFactory
+}
+
+func NewCreateManualRateCommandHandler(
+	manualRateRepository ports.ManualRateRepository,
+	subscriptionRepository ports.SubscriptionRepository,
+	authentication ports.Authentication,
+	authorization ports.Authorization,
+	ownerFactory shared.OwnerFactory) *CreateManualRateCommandHandler {
+	return &CreateManualRateCommandHandler{
+		manualRateRepository:   manualRateRepository,
+		subscriptionRepository: subscriptionRepository,
+		authentication:         authentication,
+		authorization:          authorization,
+		ownerFactory:           ownerFactory,
+	}
+}
+
+func (h CreateManualRateCommandHandler) Handle(
+	ctx context.Context,
+	command CreateManualRateCommand) result.Result[currency.ManualRate] {
+	userId := h.authentication.MustGetConnectedAccount(ctx).UserID()
+
+	var manualRateID types.ManualRateID
+	if command.ManualRateID != nil && command.ManualRateID.IsSome() {
+		manualRateID = *command.ManualRateID.Value()
+		existing, err := h.manualRateRepository.GetById(ctx, manualRateID)
+		if err != nil {
+			return result.Fail[currency.ManualRate](err)
+		}
+		if existing != nil {
+			return result.Fail[currency.ManualRate](currency.ErrManualRateAlreadyExists)
+		}
+	} else {
+		manualRateID = types.NewManualRateID()
+	}
+	var createdAt time.Time
+	if command.CreatedAt != nil && command.CreatedAt.IsSome() {
+		createdAt = *command.CreatedAt.Value()
+	} else {
+		createdAt = time.Now()
+	}
+
+	var owner types.Owner
+	if command.SubscriptionID != nil {
+		sub, err := h.subscriptionRepository.GetByIdForUser(ctx, userId, *command.SubscriptionID)
+		if err != nil {
+			return result.Fail[currency.ManualRate](err)
+		}
+		if sub == nil {
+			return result.Fail[currency.ManualRate](subscription.ErrSubscriptionNotFound)
+		}
+		owner = sub.Owner()
+	} else {
+		var err error
+		owner, err = h.ownerFactory.Resolve(ctx, command.Owner)
+		if err != nil {
+			return result.Fail[currency.ManualRate](err)
+		}
+	}
+
+	newRate := currency.NewManualRate(
+		manualRateID,
+		owner,
+		command.SubscriptionID,
+		command.FromCurrency,
+		command.ToCurrency,
+		command.Rate,
+		createdAt,
+		createdAt,
+	)
+
+	permReq := h.authorization.Can(ctx, authorization.PermissionWrite)
+	if permReq == nil {
+		return result.Fail[currency.ManualRate](authorization.ErrUnauthorized)
+	}
+	if err := permReq.For(newRate); err != nil {
+		return result.Fail[currency.ManualRate](err)
+	}
+
+	if err := newRate.GetValidationErrors(); err != nil {
+		return result.Fail[currency.ManualRate](err)
+	}
+
+	if err := ensureUniquePair(ctx, h.manualRateRepository, userId, newRate); err != nil {
+		return result.Fail[currency.ManualRate](err)
+	}
+
+	if err := h.manualRateRepository.Save(ctx, newRate); err != nil {
+		return result.Fail[currency.ManualRate](err)
+	}
+
+	return result.Success(newRate)
+}
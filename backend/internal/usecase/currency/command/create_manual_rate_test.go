@@ -0,0 +1,165 @@
+package command_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mistribe/subtracker/internal/domain/account"
+	auth "github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/currency/command"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func TestCreateManualRate(t *testing.T) {
+	userId := types.UserID("userID-Test")
+	owner := types.NewPersonalOwner(userId)
+	familyOwner := types.NewFamilyOwner(types.NewFamilyID())
+
+	newCommand := func(id types.ManualRateID) command.CreateManualRateCommand {
+		return command.CreateManualRateCommand{
+			ManualRateID: option.Some(id),
+			Owner:        types.PersonalOwnerType,
+			FromCurrency: currency.EUR,
+			ToCurrency:   currency.USD,
+			Rate:         1.1,
+			CreatedAt:    option.Some(time.Now()),
+		}
+	}
+
+	type mocks struct {
+		repository             *ports.MockManualRateRepository
+		subscriptionRepository *ports.MockSubscriptionRepository
+		authentication         *ports.MockAuthentication
+		authorization          *ports.MockAuthorization
+		permissionRequest      *ports.MockPermissionRequest
+		ownerFactory           *shared.MockOwnerFactory
+	}
+	newMocks := func(t *testing.T) mocks {
+		m := mocks{
+			repository:             ports.NewMockManualRateRepository(t),
+			subscriptionRepository: ports.NewMockSubscriptionRepository(t),
+			authentication:         ports.NewMockAuthentication(t),
+			authorization:          ports.NewMockAuthorization(t),
+			permissionRequest:      ports.NewMockPermissionRequest(t),
+			ownerFactory:           shared.NewMockOwnerFactory(t),
+		}
+		connectedAccount := account.NewMockConnectedAccount(t)
+		connectedAccount.EXPECT().UserID().Return(userId).Maybe()
+		m.authentication.EXPECT().MustGetConnectedAccount(mock.Anything).Return(connectedAccount)
+		return m
+	}
+	newHandler := func(m mocks) *command.CreateManualRateCommandHandler {
+		return command.NewCreateManualRateCommandHandler(m.repository, m.subscriptionRepository, m.authentication,
+			m.authorization, m.ownerFactory)
+	}
+
+	t.Run("all working", func(t *testing.T) {
+		m := newMocks(t)
+		id := types.NewManualRateID()
+		m.repository.EXPECT().GetById(mock.Anything, id).Return(nil, nil)
+		m.ownerFactory.EXPECT().Resolve(mock.Anything, types.PersonalOwnerType).Return(owner, nil)
+		m.authorization.EXPECT().Can(mock.Anything, auth.PermissionWrite).Return(m.permissionRequest)
+		m.permissionRequest.EXPECT().For(mock.Anything).Return(nil)
+		m.repository.EXPECT().GetAllForUser(mock.Anything, userId).Return(currency.ManualRates{
+			// The same pair of the family does not conflict
+			currency.NewManualRate(types.NewManualRateID(), familyOwner, nil, currency.USD, currency.EUR, 0.9,
+				time.Now(), time.Now()),
+		}, nil)
+		m.repository.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+		res := newHandler(m).Handle(t.Context(), newCommand(id))
+
+		assert.True(t, res.IsSuccess())
+		res.IfSuccess(func(r currency.ManualRate) {
+			assert.Equal(t, id, r.Id())
+			assert.Equal(t, owner, r.Owner())
+			assert.Nil(t, r.SubscriptionId())
+			assert.Equal(t, 1.1, r.ExchangeRate())
+		})
+	})
+
+	t.Run("belongs to the owner of the subscription", func(t *testing.T) {
+		m := newMocks(t)
+		id := types.NewManualRateID()
+		sub := subscription.NewSubscription(types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+			subscription.NewPrice(currency.NewAmount(10, currency.EUR)), nil, nil, familyOwner, nil, nil, nil, nil,
+			nil, time.Now(), nil, nil, subscription.MonthlyRecurrency, nil, nil, time.Now(), time.Now())
+		m.repository.EXPECT().GetById(mock.Anything, id).Return(nil, nil)
+		m.subscriptionRepository.EXPECT().GetByIdForUser(mock.Anything, userId, sub.Id()).Return(sub, nil)
+		m.authorization.EXPECT().Can(mock.Anything, auth.PermissionWrite).Return(m.permissionRequest)
+		m.permissionRequest.EXPECT().For(mock.Anything).Return(nil)
+		m.repository.EXPECT().GetAllForUser(mock.Anything, userId).Return(nil, nil)
+		m.repository.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+		cmd := newCommand(id)
+		cmd.SubscriptionID = x.P(sub.Id())
+		res := newHandler(m).Handle(t.Context(), cmd)
+
+		assert.True(t, res.IsSuccess())
+		res.IfSuccess(func(r currency.ManualRate) {
+			assert.Equal(t, familyOwner, r.Owner())
+			assert.Equal(t, sub.Id(), *r.SubscriptionId())
+		})
+	})
+
+	t.Run("unknown subscription", func(t *testing.T) {
+		m := newMocks(t)
+		id := types.NewManualRateID()
+		subscriptionId := types.NewSubscriptionID()
+		m.repository.EXPECT().GetById(mock.Anything, id).Return(nil, nil)
+		m.subscriptionRepository.EXPECT().GetByIdForUser(mock.Anything, userId, subscriptionId).Return(nil, nil)
+
+		cmd := newCommand(id)
+		cmd.SubscriptionID = x.P(subscriptionId)
+		res := newHandler(m).Handle(t.Context(), cmd)
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, subscription.ErrSubscriptionNotFound)
+		})
+	})
+
+	t.Run("pair already has a rate", func(t *testing.T) {
+		m := newMocks(t)
+		id := types.NewManualRateID()
+		m.repository.EXPECT().GetById(mock.Anything, id).Return(nil, nil)
+		m.ownerFactory.EXPECT().Resolve(mock.Anything, types.PersonalOwnerType).Return(owner, nil)
+		m.authorization.EXPECT().Can(mock.Anything, auth.PermissionWrite).Return(m.permissionRequest)
+		m.permissionRequest.EXPECT().For(mock.Anything).Return(nil)
+		m.repository.EXPECT().GetAllForUser(mock.Anything, userId).Return(currency.ManualRates{
+			currency.NewManualRate(types.NewManualRateID(), owner, nil, currency.USD, currency.EUR, 0.9,
+				time.Now(), time.Now()),
+		}, nil)
+
+		res := newHandler(m).Handle(t.Context(), newCommand(id))
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, currency.ErrManualRateAlreadyExists)
+		})
+	})
+
+	t.Run("invalid rate is not saved", func(t *testing.T) {
+		m := newMocks(t)
+		id := types.NewManualRateID()
+		m.repository.EXPECT().GetById(mock.Anything, id).Return(nil, nil)
+		m.ownerFactory.EXPECT().Resolve(mock.Anything, types.PersonalOwnerType).Return(owner, nil)
+		m.authorization.EXPECT().Can(mock.Anything, auth.PermissionWrite).Return(m.permissionRequest)
+		m.permissionRequest.EXPECT().For(mock.Anything).Return(nil)
+
+		cmd := newCommand(id)
+		cmd.Rate = 0
+		res := newHandler(m).Handle(t.Context(), cmd)
+
+		assert.True(t, res.IsFaulted())
+	})
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type DeleteManualRateCommand struct {
+	ManualRateID types.ManualRateID
+}
+
+type DeleteManualRateCommandHandler struct {
+	manualRateRepository ports.ManualRateRepository
+	authorization        ports.Authorization
+}
+
+func NewDeleteManualRateCommandHandler(
+	manualRateRepository ports.ManualRateRepository,
+	authorization ports.Authorization) *DeleteManualRateCommandHandler {
+	return &DeleteManualRateCommandHandler{
+		manualRateRepository: manualRateRepository,
+		authorization:        authorization,
+	}
+}
+
+func (h DeleteManualRateCommandHandler) Handle(
+	ctx context.Context,
+	command DeleteManualRateCommand) result.Result[bool] {
+	existing, err := h.manualRateRepository.GetById(ctx, command.ManualRateID)
+	if err != nil {
+		return result.Fail[bool](err)
+	}
+	if existing == nil {
+		return result.Fail[bool](currency.ErrManualRateNotFound)
+	}
+
+	permReq := h.authorization.Can(ctx, authorization.PermissionDelete)
+	if permReq == nil {
+		return result.Fail[bool](authorization.ErrUnauthorized)
+	}
+	if err = permReq.For(existing); err != nil {
+		return result.Fail[bool](err)
+	}
+
+	ok, err := h.manualRateRepository.Delete(ctx, command.ManualRateID)
+	if err != nil {
+		return result.Fail[bool](err)
+	}
+	if !ok {
+		return result.Fail[bool](currency.ErrManualRateNotFound)
+	}
+
+	return result.Success(true)
+}
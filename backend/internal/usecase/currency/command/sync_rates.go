@@ -0,0 +1,141 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// SyncRatesCommand backfills the market rates of the supported currencies that are missing over the last days.
+// A synchronization that did not complete is resumed from its last processed day instead of starting a new one.
+type SyncRatesCommand struct {
+	// Days is the number of days before today covered by a new synchronization
+	Days int
+}
+
+type SyncRatesCommandHandler struct {
+	currencyRepository ports.CurrencyRepository
+	rateSyncRepository ports.RateSyncRepository
+	rateProvider       ports.RateProvider
+}
+
+func NewSyncRatesCommandHandler(
+	currencyRepository ports.CurrencyRepository,
+	rateSyncRepository ports.RateSyncRepository,
+	rateProvider ports.RateProvider) *SyncRatesCommandHandler {
+	return &SyncRatesCommandHandler{
+		currencyRepository: currencyRepository,
+		rateSyncRepository: rateSyncRepository,
+		rateProvider:       rateProvider,
+	}
+}
+
+func (h SyncRatesCommandHandler) Handle(
+	ctx context.Context,
+	command SyncRatesCommand) result.Result[currency.RateSync] {
+	sync, err := h.rateSyncRepository.GetLatest(ctx)
+	if err != nil {
+		return result.Fail[currency.RateSync](err)
+	}
+
+	now := time.Now()
+	if sync == nil || sync.IsCompleted() {
+		today := currency.RateDate(now)
+		sync = currency.NewRateSync(types.NewRateSyncID(), currency.RunningRateSyncStatus,
+			today.AddDate(0, 0, -command.Days), today, nil, 0, 0, 0, nil, nil, now, now)
+	} else {
+		sync.Resume(now)
+	}
+	if err = h.rateSyncRepository.Save(ctx, sync); err != nil {
+		return result.Fail[currency.RateSync](err)
+	}
+
+	if err = h.run(ctx, sync); err != nil {
+		// The progress is kept even when the synchronization was cancelled, the next run resumes from it
+		sync.Fail(err, time.Now())
+		if saveErr := h.rateSyncRepository.Save(context.WithoutCancel(ctx), sync); saveErr != nil {
+			return result.Fail[currency.RateSync](saveErr)
+		}
+		return result.Fail[currency.RateSync](err)
+	}
+
+	sync.Complete(time.Now())
+	if err = h.rateSyncRepository.Save(ctx, sync); err != nil {
+		return result.Fail[currency.RateSync](err)
+	}
+	return result.Success(sync)
+}
+
+// run processes every remaining day of the synchronization, the progress is saved after each day
+func (h SyncRatesCommandHandler) run(ctx context.Context, sync currency.RateSync) error {
+	next, ok := sync.NextDate()
+	if !ok {
+		return nil
+	}
+
+	units := currency.GetSupportedCurrencies()
+	pairs := len(units) * (len(units) - 1)
+	counts, err := h.currencyRepository.CountPairsByDate(ctx, next, sync.EndDate(), units)
+	if err != nil {
+		return err
+	}
+
+	for date, ok := sync.NextDate(); ok; date, ok = sync.NextDate() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		outcome := currency.RateDayComplete
+		if counts[date] < pairs {
+			outcome, err = h.fillDay(ctx, date, units)
+			if err != nil {
+				return err
+			}
+		}
+
+		sync.Advance(date, outcome, time.Now())
+		if err := h.rateSyncRepository.Save(ctx, sync); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fillDay saves the rates of the day that are not stored yet
+func (h SyncRatesCommandHandler) fillDay(
+	ctx context.Context,
+	date time.Time,
+	units []currency.Unit) (currency.RateDayOutcome, error) {
+	rates, err := h.rateProvider.GetRates(ctx, date, units)
+	if err != nil {
+		return currency.RateDayComplete, err
+	}
+	if len(rates) == 0 {
+		return currency.RateDayUnavailable, nil
+	}
+
+	existing, err := h.currencyRepository.GetRatesByDate(ctx, date)
+	if err != nil {
+		return currency.RateDayComplete, err
+	}
+
+	var missing []currency.Rate
+	for _, rate := range rates {
+		if _, ok := existing.FindExchangeRate(rate.FromCurrency(), rate.ToCurrency()); !ok {
+			missing = append(missing, rate)
+		}
+	}
+	if len(missing) == 0 {
+		return currency.RateDayComplete, nil
+	}
+
+	if err = h.currencyRepository.Save(ctx, missing...); err != nil {
+		return currency.RateDayComplete, err
+	}
+	return currency.RateDayFilled, nil
+}
@@ -0,0 +1,159 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	xcurrency "golang.org/x/text/currency"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/currency/command"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+func TestSyncRates(t *testing.T) {
+	units := currency.GetSupportedCurrencies()
+	pairs := len(units) * (len(units) - 1)
+	day := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	allRates := func(date time.Time) currency.Rates {
+		var rates currency.Rates
+		for _, from := range units {
+			for _, to := range units {
+				if from != to {
+					rates = append(rates, currency.NewRate(types.NewRateID(), from, to, date, 1.5, time.Now(),
+						time.Now()))
+				}
+			}
+		}
+		return rates
+	}
+	completeCounts := func(_ context.Context, start, end time.Time, _ []xcurrency.Unit) (map[time.Time]int, error) {
+		counts := make(map[time.Time]int)
+		for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+			counts[date] = pairs
+		}
+		return counts, nil
+	}
+	failedSync := func() currency.RateSync {
+		return currency.NewRateSync(types.NewRateSyncID(), currency.FailedRateSyncStatus, day,
+			day.AddDate(0, 0, 3), &day, 1, 0, 0, nil, nil, time.Now(), time.Now())
+	}
+
+	type mocks struct {
+		currencyRepository *ports.MockCurrencyRepository
+		rateSyncRepository *ports.MockRateSyncRepository
+		rateProvider       *ports.MockRateProvider
+	}
+	newMocks := func(t *testing.T) mocks {
+		return mocks{
+			currencyRepository: ports.NewMockCurrencyRepository(t),
+			rateSyncRepository: ports.NewMockRateSyncRepository(t),
+			rateProvider:       ports.NewMockRateProvider(t),
+		}
+	}
+	newHandler := func(m mocks) *command.SyncRatesCommandHandler {
+		return command.NewSyncRatesCommandHandler(m.currencyRepository, m.rateSyncRepository, m.rateProvider)
+	}
+	mustGet := func(t *testing.T, r result.Result[currency.RateSync]) currency.RateSync {
+		return result.Match(r, func(sync currency.RateSync) currency.RateSync {
+			return sync
+		}, func(err error) currency.RateSync {
+			require.NoError(t, err)
+			return nil
+		})
+	}
+
+	t.Run("starts a new synchronization over the last days", func(t *testing.T) {
+		m := newMocks(t)
+		m.rateSyncRepository.EXPECT().GetLatest(mock.Anything).Return(nil, nil)
+		m.rateSyncRepository.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+		m.currencyRepository.EXPECT().CountPairsByDate(mock.Anything, mock.Anything, mock.Anything, units).
+			RunAndReturn(completeCounts)
+
+		sync := mustGet(t, newHandler(m).Handle(t.Context(), command.SyncRatesCommand{Days: 2}))
+
+		today := currency.RateDate(time.Now())
+		assert.True(t, sync.IsCompleted())
+		assert.Equal(t, today.AddDate(0, 0, -2), sync.StartDate())
+		assert.Equal(t, today, sync.EndDate())
+		assert.Equal(t, 3, sync.ProcessedDays())
+		assert.Zero(t, sync.FilledDays())
+		m.rateSyncRepository.AssertNumberOfCalls(t, "Save", 5)
+	})
+
+	t.Run("starts a new synchronization after a completed one", func(t *testing.T) {
+		m := newMocks(t)
+		latest := failedSync()
+		latest.Complete(time.Now())
+		m.rateSyncRepository.EXPECT().GetLatest(mock.Anything).Return(latest, nil)
+		m.rateSyncRepository.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+		m.currencyRepository.EXPECT().CountPairsByDate(mock.Anything, mock.Anything, mock.Anything, units).
+			RunAndReturn(completeCounts)
+
+		sync := mustGet(t, newHandler(m).Handle(t.Context(), command.SyncRatesCommand{Days: 0}))
+
+		assert.NotEqual(t, latest.Id(), sync.Id())
+		assert.Equal(t, 1, sync.ProcessedDays())
+	})
+
+	t.Run("resumes an interrupted synchronization and fills only the missing rates", func(t *testing.T) {
+		m := newMocks(t)
+		latest := failedSync()
+		filledDay := day.AddDate(0, 0, 2)
+		unavailableDay := day.AddDate(0, 0, 3)
+		m.rateSyncRepository.EXPECT().GetLatest(mock.Anything).Return(latest, nil)
+		m.rateSyncRepository.EXPECT().Save(mock.Anything, []currency.RateSync{latest}).Return(nil)
+		m.currencyRepository.EXPECT().CountPairsByDate(mock.Anything, day.AddDate(0, 0, 1), unavailableDay, units).
+			Return(map[time.Time]int{day.AddDate(0, 0, 1): pairs, filledDay: 1}, nil)
+
+		provided := allRates(filledDay)
+		m.rateProvider.EXPECT().GetRates(mock.Anything, filledDay, units).Return(provided, nil)
+		m.rateProvider.EXPECT().GetRates(mock.Anything, unavailableDay, units).Return(nil, nil)
+		m.currencyRepository.EXPECT().GetRatesByDate(mock.Anything, filledDay).
+			Return(currency.Rates{provided[0]}, nil)
+		var saved []currency.Rate
+		m.currencyRepository.EXPECT().Save(mock.Anything, mock.Anything).
+			Run(func(_ context.Context, entities ...currency.Rate) {
+				saved = entities
+			}).
+			Return(nil)
+
+		sync := mustGet(t, newHandler(m).Handle(t.Context(), command.SyncRatesCommand{Days: 365}))
+
+		assert.Equal(t, latest.Id(), sync.Id())
+		assert.True(t, sync.IsCompleted())
+		assert.Equal(t, 4, sync.ProcessedDays())
+		assert.Equal(t, 1, sync.FilledDays())
+		assert.Equal(t, 1, sync.UnavailableDays())
+		assert.NotContains(t, saved, provided[0])
+		assert.Len(t, saved, pairs-1)
+	})
+
+	t.Run("keeps the progress when a day fails", func(t *testing.T) {
+		m := newMocks(t)
+		latest := failedSync()
+		failingDay := day.AddDate(0, 0, 2)
+		m.rateSyncRepository.EXPECT().GetLatest(mock.Anything).Return(latest, nil)
+		m.rateSyncRepository.EXPECT().Save(mock.Anything, []currency.RateSync{latest}).Return(nil)
+		m.currencyRepository.EXPECT().CountPairsByDate(mock.Anything, mock.Anything, mock.Anything, units).
+			Return(map[time.Time]int{day.AddDate(0, 0, 1): pairs}, nil)
+		m.rateProvider.EXPECT().GetRates(mock.Anything, failingDay, units).Return(nil, errors.New("source down"))
+
+		r := newHandler(m).Handle(t.Context(), command.SyncRatesCommand{Days: 365})
+
+		assert.True(t, r.IsFaulted())
+		assert.Equal(t, currency.FailedRateSyncStatus, latest.Status())
+		require.NotNil(t, latest.Cursor())
+		assert.Equal(t, day.AddDate(0, 0, 1), *latest.Cursor())
+		require.NotNil(t, latest.LastError())
+		assert.Equal(t, "source down", *latest.LastError())
+	})
+}
@@ -0,0 +1,83 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type UpdateManualRateCommand struct {
+	ManualRateID types.ManualRateID
+	FromCurrency currency.Unit
+	ToCurrency   currency.Unit
+	Rate         float64
+	UpdatedAt    option.Option[time.Time]
+}
+
+type UpdateManualRateCommandHandler struct {
+	manualRateRepository ports.ManualRateRepository
+	authentication       ports.Authentication
+	authorization        ports.Authorization
+}
+
+func NewUpdateManualRateCommandHandler(
+	manualRateRepository ports.ManualRateRepository,
+	authentication ports.Authentication,
+	authorization ports.Authorization) *UpdateManualRateCommandHandler {
+	return &UpdateManualRateCommandHandler{
+		manualRateRepository: manualRateRepository,
+		authentication:       authentication,
+		authorization:        authorization,
+	}
+}
+
+func (h UpdateManualRateCommandHandler) Handle(
+	ctx context.Context,
+	command UpdateManualRateCommand) result.Result[currency.ManualRate] {
+	existing, err := h.manualRateRepository.GetById(ctx, command.ManualRateID)
+	if err != nil {
+		return result.Fail[currency.ManualRate](err)
+	}
+	if existing == nil {
+		return result.Fail[currency.ManualRate](currency.ErrManualRateNotFound)
+	}
+
+	permReq := h.authorization.Can(ctx, authorization.PermissionWrite)
+	if permReq == nil {
+		return result.Fail[currency.ManualRate](authorization.ErrUnauthorized)
+	}
+	if err = permReq.For(existing); err != nil {
+		return result.Fail[currency.ManualRate](err)
+	}
+
+	existing.SetPair(command.FromCurrency, command.ToCurrency)
+	existing.SetExchangeRate(command.Rate)
+	var updatedAt time.Time
+	if command.UpdatedAt != nil && command.UpdatedAt.IsSome() {
+		updatedAt = *command.UpdatedAt.Value()
+	} else {
+		updatedAt = time.Now()
+	}
+	existing.SetUpdatedAt(updatedAt)
+
+	if err := existing.GetValidationErrors(); err != nil {
+		return result.Fail[currency.ManualRate](err)
+	}
+
+	userId := h.authentication.MustGetConnectedAccount(ctx).UserID()
+	if err := ensureUniquePair(ctx, h.manualRateRepository, userId, existing); err != nil {
+		return result.Fail[currency.ManualRate](err)
+	}
+
+	if err := h.manualRateRepository.Save(ctx, existing); err != nil {
+		return result.Fail[currency.ManualRate](err)
+	}
+
+	return result.Success(existing)
+}
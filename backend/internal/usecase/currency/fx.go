@@ -3,7 +3,10 @@ package currency
 import (
 	"go.uber.org/fx"
 
+	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	currencyCommand "github.com/mistribe/subtracker/internal/usecase/currency/command"
 	currencyQuery "github.com/mistribe/subtracker/internal/usecase/currency/query"
 )
 
@@ -11,6 +14,13 @@ func Module() fx.Option {
 	return fx.Module("app_currency",
 		fx.Provide(
 			ports.AsQueryHandler[currencyQuery.CurrencyRateQuery, currencyQuery.CurrencyRateResponse](currencyQuery.NewCurrencyRateQueryHandler),
+			ports.AsQueryHandler[currencyQuery.FindAllManualRatesQuery, shared.PaginatedResponse[currency.ManualRate]](currencyQuery.NewFindAllManualRatesQueryHandler),
+			ports.AsQueryHandler[currencyQuery.GetRateSyncQuery, currency.RateSync](currencyQuery.NewGetRateSyncQueryHandler),
+
+			ports.AsCommandHandler[currencyCommand.CreateManualRateCommand, currency.ManualRate](currencyCommand.NewCreateManualRateCommandHandler),
+			ports.AsCommandHandler[currencyCommand.UpdateManualRateCommand, currency.ManualRate](currencyCommand.NewUpdateManualRateCommandHandler),
+			ports.AsCommandHandler[currencyCommand.DeleteManualRateCommand, bool](currencyCommand.NewDeleteManualRateCommandHandler),
+			ports.AsCommandHandler[currencyCommand.SyncRatesCommand, currency.RateSync](currencyCommand.NewSyncRatesCommandHandler),
 		),
 	)
 }
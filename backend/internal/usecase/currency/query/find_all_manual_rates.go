@@ -0,0 +1,48 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type FindAllManualRatesQuery struct {
+	Limit  int64
+	Offset int64
+}
+
+func NewFindAllManualRatesQuery(limit, offset int64) FindAllManualRatesQuery {
+	return FindAllManualRatesQuery{
+		Limit:  limit,
+		Offset: offset,
+	}
+}
+
+type FindAllManualRatesQueryHandler struct {
+	manualRateRepository ports.ManualRateRepository
+	authentication       ports.Authentication
+}
+
+func NewFindAllManualRatesQueryHandler(
+	manualRateRepository ports.ManualRateRepository,
+	authentication ports.Authentication) *FindAllManualRatesQueryHandler {
+	return &FindAllManualRatesQueryHandler{
+		manualRateRepository: manualRateRepository,
+		authentication:       authentication,
+	}
+}
+
+func (h FindAllManualRatesQueryHandler) Handle(
+	ctx context.Context,
+	query FindAllManualRatesQuery) result.Result[shared.PaginatedResponse[currency.ManualRate]] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	params := ports.NewManualRateQueryParameters(query.Limit, query.Offset)
+	rates, count, err := h.manualRateRepository.GetAll(ctx, connectedAccount.UserID(), params)
+	if err != nil {
+		return result.Fail[shared.PaginatedResponse[currency.ManualRate]](err)
+	}
+	return result.Success(shared.NewPaginatedResponse(rates, count))
+}
@@ -0,0 +1,47 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// GetRateSyncQuery reads the progress of the last synchronization of the market rates, only administrators can
+// read it
+type GetRateSyncQuery struct {
+}
+
+type GetRateSyncQueryHandler struct {
+	rateSyncRepository ports.RateSyncRepository
+	authorization      ports.Authorization
+}
+
+func NewGetRateSyncQueryHandler(
+	rateSyncRepository ports.RateSyncRepository,
+	authorization ports.Authorization) *GetRateSyncQueryHandler {
+	return &GetRateSyncQueryHandler{
+		rateSyncRepository: rateSyncRepository,
+		authorization:      authorization,
+	}
+}
+
+func (h GetRateSyncQueryHandler) Handle(
+	ctx context.Context,
+	_ GetRateSyncQuery) result.Result[currency.RateSync] {
+	sync, err := h.rateSyncRepository.GetLatest(ctx)
+	if err != nil {
+		return result.Fail[currency.RateSync](err)
+	}
+	if sync == nil {
+		return result.Fail[currency.RateSync](currency.ErrRateSyncNotFound)
+	}
+
+	if err = h.authorization.Can(ctx, authorization.PermissionRead).For(sync); err != nil {
+		return result.Fail[currency.RateSync](err)
+	}
+
+	return result.Success(sync)
+}
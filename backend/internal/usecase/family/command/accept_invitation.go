@@ -51,9 +51,7 @@ func (h AcceptInvitationCommandHandler) Handle(ctx context.Context, cmd AcceptIn
 
 	connectedAccount := h.authService.MustGetConnectedAccount(ctx)
 	userID := connectedAccount.UserID()
-	member.SetUserId(&userID)
-
-	if err = fam.UpdateMember(member); err != nil {
+	if err = fam.AcceptInvitation(member, userID); err != nil {
 		return result.Fail[bool](err)
 	}
 	if vErr := fam.GetValidationErrors(); vErr != nil { // return the actual validation error
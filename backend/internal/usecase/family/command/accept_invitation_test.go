@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/entity"
 	"github.com/mistribe/subtracker/internal/domain/family"
 	"github.com/mistribe/subtracker/internal/domain/types"
 	"github.com/mistribe/subtracker/internal/ports"
@@ -191,6 +192,9 @@ func TestAcceptInvitationCommandHandler(t *testing.T) {
 		require.NotNil(t, updated)
 		require.NotNil(t, updated.UserId())
 		assert.Equal(t, types.UserID("user-123"), *updated.UserId())
+		assert.Equal(t, []entity.Event{family.InvitationAccepted{
+			FamilyId: famID, MemberId: m.Id(), UserId: types.UserID("user-123"),
+		}}, fam.Events())
 		ca.AssertExpectations(t)
 	})
 }
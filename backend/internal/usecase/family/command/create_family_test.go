@@ -27,6 +27,10 @@ func (s stubAuth) MustGetConnectedAccount(ctx context.Context) account.Connected
 	return stubAccount{s.user}
 }
 
+func (s stubAuth) GetConnectedAccount(ctx context.Context) (account.ConnectedAccount, bool) {
+	return stubAccount{s.user}, true
+}
+
 type stubAccount struct{ uid types.UserID }
 
 func (a stubAccount) UserID() types.UserID { return a.uid }
@@ -71,14 +71,7 @@ func (h InviteMemberCommandHandler) inviteExistingMember(
 	familyMember family.Member,
 	code string,
 	fam family.Family) result.Result[InviteMemberResponse] {
-	if familyMember.UserId() != nil {
-		return result.Fail[InviteMemberResponse](family.ErrCannotInviteUser)
-	}
-	if !familyMember.SetInvitationCode(&code) {
-		return result.Fail[InviteMemberResponse](family.ErrCannotInviteUser)
-	}
-
-	if err := fam.UpdateMember(familyMember); err != nil {
+	if err := fam.InviteMember(familyMember, code); err != nil {
 		return result.Fail[InviteMemberResponse](err)
 	}
 
@@ -120,11 +113,11 @@ func (h InviteMemberCommandHandler) inviteNewMember(
 		fam.Id(),
 		memberName,
 		memberType,
-		&invitationCode,
+		nil,
 		time.Now(),
 		time.Now(),
 	)
-	if err := fam.AddMember(familyMember); err != nil {
+	if err := fam.InviteMember(familyMember, invitationCode); err != nil {
 		return result.Fail[InviteMemberResponse](err)
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/entity"
 	"github.com/mistribe/subtracker/internal/domain/family"
 	"github.com/mistribe/subtracker/internal/domain/types"
 	"github.com/mistribe/subtracker/internal/ports"
@@ -134,6 +135,7 @@ func TestInviteMemberCommandHandler(t *testing.T) {
 		require.NotNil(t, updated)
 		require.NotNil(t, updated.InvitationCode())
 		assert.Equal(t, out.Code, *updated.InvitationCode())
+		assert.Equal(t, []entity.Event{family.MemberInvited{FamilyId: famID, MemberId: m.Id()}}, fam.Events())
 	})
 
 	t.Run("New member invite success with default name and type", func(t *testing.T) {
@@ -168,6 +170,8 @@ func TestInviteMemberCommandHandler(t *testing.T) {
 		assert.Equal(t, family.AdultMemberType, created.Type())
 		require.NotNil(t, created.InvitationCode())
 		assert.Equal(t, out.Code, *created.InvitationCode())
+		assert.Equal(t, []entity.Event{family.MemberInvited{FamilyId: famID, MemberId: out.FamilyMemberId}},
+			fam.Events())
 	})
 
 	t.Run("Existing member: validation error prevents Save", func(t *testing.T) {
@@ -4,20 +4,34 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/mistribe/subtracker/internal/usecase/account"
+	"github.com/mistribe/subtracker/internal/usecase/budget"
+	"github.com/mistribe/subtracker/internal/usecase/calendar"
 	"github.com/mistribe/subtracker/internal/usecase/currency"
 	"github.com/mistribe/subtracker/internal/usecase/family"
 	"github.com/mistribe/subtracker/internal/usecase/label"
+	"github.com/mistribe/subtracker/internal/usecase/ledger"
+	"github.com/mistribe/subtracker/internal/usecase/notification"
+	"github.com/mistribe/subtracker/internal/usecase/outbox"
+	"github.com/mistribe/subtracker/internal/usecase/paymentmethod"
 	"github.com/mistribe/subtracker/internal/usecase/provider"
 	"github.com/mistribe/subtracker/internal/usecase/subscription"
+	"github.com/mistribe/subtracker/internal/usecase/webhook"
 )
 
 func BuildApplicationModules() []fx.Option {
 	return []fx.Option{
+		budget.Module(),
+		calendar.Module(),
 		currency.Module(),
 		family.Module(),
 		label.Module(),
+		ledger.Module(),
+		notification.Module(),
+		outbox.Module(),
+		paymentmethod.Module(),
 		provider.Module(),
 		subscription.Module(),
+		webhook.Module(),
 		account.Module(),
 	}
 }
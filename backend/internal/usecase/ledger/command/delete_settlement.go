@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type DeleteSettlementCommand struct {
+	FamilyID     types.FamilyID
+	SettlementID types.SettlementID
+}
+
+type DeleteSettlementCommandHandler struct {
+	settlementRepository ports.SettlementRepository
+	authorization        ports.Authorization
+}
+
+func NewDeleteSettlementCommandHandler(
+	settlementRepository ports.SettlementRepository,
+	authorization ports.Authorization) *DeleteSettlementCommandHandler {
+	return &DeleteSettlementCommandHandler{
+		settlementRepository: settlementRepository,
+		authorization:        authorization,
+	}
+}
+
+func (h DeleteSettlementCommandHandler) Handle(
+	ctx context.Context,
+	cmd DeleteSettlementCommand) result.Result[bool] {
+	settlement, err := h.settlementRepository.GetById(ctx, cmd.SettlementID)
+	if err != nil {
+		return result.Fail[bool](err)
+	}
+	if settlement == nil || settlement.FamilyId() != cmd.FamilyID {
+		return result.Fail[bool](ledger.ErrSettlementNotFound)
+	}
+
+	if err := h.authorization.Can(ctx, authorization.PermissionDelete).For(settlement); err != nil {
+		return result.Fail[bool](err)
+	}
+
+	ok, err := h.settlementRepository.Delete(ctx, cmd.SettlementID)
+	if err != nil {
+		return result.Fail[bool](err)
+	}
+
+	return result.Success(ok)
+}
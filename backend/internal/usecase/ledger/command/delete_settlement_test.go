@@ -0,0 +1,77 @@
+package command_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/ledger/command"
+)
+
+func TestDeleteSettlementCommandHandler_Handle(t *testing.T) {
+	fam, alice, bob := newTestFamily()
+	existing := ledger.NewSettlement(types.NewSettlementID(), fam.Id(), alice.Id(), bob.Id(),
+		currency.NewAmount(30, currency.EUR), time.Now(), nil, time.Now(), time.Now())
+
+	t.Run("returns fault when the settlement belongs to another family", func(t *testing.T) {
+		settlementRepo := ports.NewMockSettlementRepository(t)
+		authz := ports.NewMockAuthorization(t)
+
+		settlementRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+
+		h := command.NewDeleteSettlementCommandHandler(settlementRepo, authz)
+		res := h.Handle(t.Context(), command.DeleteSettlementCommand{
+			FamilyID:     types.NewFamilyID(),
+			SettlementID: existing.Id(),
+		})
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, ledger.ErrSettlementNotFound)
+		})
+	})
+
+	t.Run("returns fault when permission denied", func(t *testing.T) {
+		settlementRepo := ports.NewMockSettlementRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		settlementRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionDelete).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(authorization.ErrUnauthorized)
+
+		h := command.NewDeleteSettlementCommandHandler(settlementRepo, authz)
+		res := h.Handle(t.Context(), command.DeleteSettlementCommand{
+			FamilyID:     fam.Id(),
+			SettlementID: existing.Id(),
+		})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("deletes the settlement", func(t *testing.T) {
+		settlementRepo := ports.NewMockSettlementRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		settlementRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionDelete).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+		settlementRepo.EXPECT().Delete(t.Context(), existing.Id()).Return(true, nil)
+
+		h := command.NewDeleteSettlementCommandHandler(settlementRepo, authz)
+		res := h.Handle(t.Context(), command.DeleteSettlementCommand{
+			FamilyID:     fam.Id(),
+			SettlementID: existing.Id(),
+		})
+
+		assert.True(t, res.IsSuccess())
+	})
+}
@@ -0,0 +1,97 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type RecordSettlementCommand struct {
+	FamilyID types.FamilyID
+	// From is the member who paid
+	From types.FamilyMemberID
+	// To is the member who received the payment
+	To     types.FamilyMemberID
+	Amount currency.Amount
+	// SettledAt defaults to now
+	SettledAt *time.Time
+	Note      *string
+	CreatedAt option.Option[time.Time]
+}
+
+type RecordSettlementCommandHandler struct {
+	settlementRepository ports.SettlementRepository
+	familyRepository     ports.FamilyRepository
+	authorization        ports.Authorization
+}
+
+func NewRecordSettlementCommandHandler(
+	settlementRepository ports.SettlementRepository,
+	familyRepository ports.FamilyRepository,
+	authorization ports.Authorization) *RecordSettlementCommandHandler {
+	return &RecordSettlementCommandHandler{
+		settlementRepository: settlementRepository,
+		familyRepository:     familyRepository,
+		authorization:        authorization,
+	}
+}
+
+func (h RecordSettlementCommandHandler) Handle(
+	ctx context.Context,
+	cmd RecordSettlementCommand) result.Result[ledger.Settlement] {
+	fam, err := h.familyRepository.GetById(ctx, cmd.FamilyID)
+	if err != nil {
+		return result.Fail[ledger.Settlement](err)
+	}
+	if fam == nil {
+		return result.Fail[ledger.Settlement](family.ErrFamilyNotFound)
+	}
+	if !fam.ContainsMember(cmd.From) || !fam.ContainsMember(cmd.To) {
+		return result.Fail[ledger.Settlement](family.ErrFamilyMemberNotFound)
+	}
+
+	var createdAt time.Time
+	if cmd.CreatedAt != nil && cmd.CreatedAt.IsSome() {
+		createdAt = *cmd.CreatedAt.Value()
+	} else {
+		createdAt = time.Now()
+	}
+	settledAt := createdAt
+	if cmd.SettledAt != nil {
+		settledAt = *cmd.SettledAt
+	}
+
+	settlement := ledger.NewSettlement(
+		types.NewSettlementID(),
+		fam.Id(),
+		cmd.From,
+		cmd.To,
+		cmd.Amount,
+		settledAt,
+		cmd.Note,
+		createdAt,
+		createdAt,
+	)
+
+	if err := h.authorization.Can(ctx, authorization.PermissionWrite).For(settlement); err != nil {
+		return result.Fail[ledger.Settlement](err)
+	}
+
+	if err := settlement.GetValidationErrors(); err != nil {
+		return result.Fail[ledger.Settlement](err)
+	}
+
+	if err := h.settlementRepository.Save(ctx, settlement); err != nil {
+		return result.Fail[ledger.Settlement](err)
+	}
+
+	return result.Success(settlement)
+}
@@ -0,0 +1,147 @@
+package command_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/ledger/command"
+)
+
+func newTestFamily() (family.Family, family.Member, family.Member) {
+	familyID := types.NewFamilyID()
+	alice := family.NewMember(types.NewFamilyMemberID(), familyID, "Alice", family.OwnerMemberType, nil,
+		time.Now(), time.Now())
+	bob := family.NewMember(types.NewFamilyMemberID(), familyID, "Bob", family.AdultMemberType, nil,
+		time.Now(), time.Now())
+	fam := family.NewFamily(familyID, types.UserID("user-1"), "Family", []family.Member{alice, bob},
+		time.Now(), time.Now())
+	return fam, alice, bob
+}
+
+func TestRecordSettlementCommandHandler_Handle(t *testing.T) {
+	t.Run("returns fault when family not found", func(t *testing.T) {
+		settlementRepo := ports.NewMockSettlementRepository(t)
+		famRepo := ports.NewMockFamilyRepository(t)
+		authz := ports.NewMockAuthorization(t)
+
+		familyID := types.NewFamilyID()
+		famRepo.EXPECT().GetById(t.Context(), familyID).Return(nil, nil)
+
+		h := command.NewRecordSettlementCommandHandler(settlementRepo, famRepo, authz)
+		res := h.Handle(t.Context(), command.RecordSettlementCommand{FamilyID: familyID})
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, family.ErrFamilyNotFound)
+		})
+	})
+
+	t.Run("returns fault when a member is not part of the family", func(t *testing.T) {
+		settlementRepo := ports.NewMockSettlementRepository(t)
+		famRepo := ports.NewMockFamilyRepository(t)
+		authz := ports.NewMockAuthorization(t)
+
+		fam, alice, _ := newTestFamily()
+		famRepo.EXPECT().GetById(t.Context(), fam.Id()).Return(fam, nil)
+
+		h := command.NewRecordSettlementCommandHandler(settlementRepo, famRepo, authz)
+		res := h.Handle(t.Context(), command.RecordSettlementCommand{
+			FamilyID: fam.Id(),
+			From:     alice.Id(),
+			To:       types.NewFamilyMemberID(),
+			Amount:   currency.NewAmount(30, currency.EUR),
+		})
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, family.ErrFamilyMemberNotFound)
+		})
+	})
+
+	t.Run("returns fault when permission denied", func(t *testing.T) {
+		settlementRepo := ports.NewMockSettlementRepository(t)
+		famRepo := ports.NewMockFamilyRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		fam, alice, bob := newTestFamily()
+		famRepo.EXPECT().GetById(t.Context(), fam.Id()).Return(fam, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(authorization.ErrUnauthorized)
+
+		h := command.NewRecordSettlementCommandHandler(settlementRepo, famRepo, authz)
+		res := h.Handle(t.Context(), command.RecordSettlementCommand{
+			FamilyID: fam.Id(),
+			From:     alice.Id(),
+			To:       bob.Id(),
+			Amount:   currency.NewAmount(30, currency.EUR),
+		})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("returns fault when repository Save returns error", func(t *testing.T) {
+		settlementRepo := ports.NewMockSettlementRepository(t)
+		famRepo := ports.NewMockFamilyRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		fam, alice, bob := newTestFamily()
+		famRepo.EXPECT().GetById(t.Context(), fam.Id()).Return(fam, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+		settlementRepo.EXPECT().Save(t.Context(), mock.Anything).Return(errors.New("db error"))
+
+		h := command.NewRecordSettlementCommandHandler(settlementRepo, famRepo, authz)
+		res := h.Handle(t.Context(), command.RecordSettlementCommand{
+			FamilyID: fam.Id(),
+			From:     alice.Id(),
+			To:       bob.Id(),
+			Amount:   currency.NewAmount(30, currency.EUR),
+		})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("records the settlement", func(t *testing.T) {
+		settlementRepo := ports.NewMockSettlementRepository(t)
+		famRepo := ports.NewMockFamilyRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		fam, alice, bob := newTestFamily()
+		settledAt := time.Now().AddDate(0, 0, -2)
+		famRepo.EXPECT().GetById(t.Context(), fam.Id()).Return(fam, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+		settlementRepo.EXPECT().Save(t.Context(), mock.Anything).Return(nil)
+
+		h := command.NewRecordSettlementCommandHandler(settlementRepo, famRepo, authz)
+		res := h.Handle(t.Context(), command.RecordSettlementCommand{
+			FamilyID:  fam.Id(),
+			From:      alice.Id(),
+			To:        bob.Id(),
+			Amount:    currency.NewAmount(30, currency.EUR),
+			SettledAt: &settledAt,
+		})
+
+		assert.True(t, res.IsSuccess())
+		res.IfSuccess(func(s ledger.Settlement) {
+			assert.Equal(t, fam.Id(), s.FamilyId())
+			assert.Equal(t, alice.Id(), s.From())
+			assert.Equal(t, bob.Id(), s.To())
+			assert.Equal(t, 30.0, s.Amount().Value())
+			assert.True(t, s.SettledAt().Equal(settledAt))
+		})
+	})
+}
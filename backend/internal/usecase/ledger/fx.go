@@ -0,0 +1,21 @@
+package ledger
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/ledger/command"
+	"github.com/mistribe/subtracker/internal/usecase/ledger/query"
+)
+
+func Module() fx.Option {
+	return fx.Module("app_ledger",
+		fx.Provide(
+			ports.AsQueryHandler[query.FamilyLedgerQuery, query.FamilyLedgerQueryResponse](query.NewFamilyLedgerQueryHandler),
+
+			ports.AsCommandHandler[command.RecordSettlementCommand, ledger.Settlement](command.NewRecordSettlementCommandHandler),
+			ports.AsCommandHandler[command.DeleteSettlementCommand, bool](command.NewDeleteSettlementCommandHandler),
+		),
+	)
+}
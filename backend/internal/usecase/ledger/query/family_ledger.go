@@ -0,0 +1,169 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type FamilyLedgerQuery struct {
+	FamilyID types.FamilyID
+}
+
+func NewFamilyLedgerQuery(familyID types.FamilyID) FamilyLedgerQuery {
+	return FamilyLedgerQuery{FamilyID: familyID}
+}
+
+type MemberBalanceResponse struct {
+	MemberID types.FamilyMemberID
+	Name     string
+	// Balance is positive when the member is owed money, negative when the member owes money
+	Balance currency.Amount
+}
+
+type FamilyLedgerQueryResponse struct {
+	FamilyID    types.FamilyID
+	Balances    []MemberBalanceResponse
+	Transfers   []ledger.Transfer
+	Settlements []ledger.Settlement
+}
+
+type FamilyLedgerQueryHandler struct {
+	familyRepository       ports.FamilyRepository
+	subscriptionRepository ports.SubscriptionRepository
+	chargeRepository       ports.ChargeRepository
+	settlementRepository   ports.SettlementRepository
+	accountService         ports.AccountService
+	authService            ports.Authentication
+	authorization          ports.Authorization
+	exchange               ports.Exchange
+}
+
+func NewFamilyLedgerQueryHandler(
+	familyRepository ports.FamilyRepository,
+	subscriptionRepository ports.SubscriptionRepository,
+	chargeRepository ports.ChargeRepository,
+	settlementRepository ports.SettlementRepository,
+	accountService ports.AccountService,
+	authService ports.Authentication,
+	authorization ports.Authorization,
+	exchange ports.Exchange) *FamilyLedgerQueryHandler {
+	return &FamilyLedgerQueryHandler{
+		familyRepository:       familyRepository,
+		subscriptionRepository: subscriptionRepository,
+		chargeRepository:       chargeRepository,
+		settlementRepository:   settlementRepository,
+		accountService:         accountService,
+		authService:            authService,
+		authorization:          authorization,
+		exchange:               exchange,
+	}
+}
+
+func (h FamilyLedgerQueryHandler) Handle(
+	ctx context.Context,
+	query FamilyLedgerQuery) result.Result[FamilyLedgerQueryResponse] {
+	connectedAccount := h.authService.MustGetConnectedAccount(ctx)
+	currentUserID := connectedAccount.UserID()
+
+	fam, err := h.familyRepository.GetById(ctx, query.FamilyID)
+	if err != nil {
+		return result.Fail[FamilyLedgerQueryResponse](err)
+	}
+	if fam == nil {
+		return result.Fail[FamilyLedgerQueryResponse](family.ErrFamilyNotFound)
+	}
+
+	preferredCurrency := h.accountService.GetPreferredCurrency(ctx, currentUserID)
+	familyLedger := ledger.NewLedger(fam.Id(), preferredCurrency)
+	if err := h.authorization.Can(ctx, authorization.PermissionRead).For(familyLedger); err != nil {
+		return result.Fail[FamilyLedgerQueryResponse](err)
+	}
+
+	// Only subscriptions paid by a member with split rules create debts between members
+	subs := make(map[types.SubscriptionID]subscription.Subscription)
+	var subIds []types.SubscriptionID
+	for sub := range h.subscriptionRepository.GetAllIt(ctx, currentUserID, "") {
+		if sub.Owner().Type() != types.FamilyOwnerType || sub.Owner().FamilyId() != fam.Id() {
+			continue
+		}
+		if sub.Payer() == nil || sub.Payer().Type() != subscription.FamilyMemberPayer || sub.CostSplit() == nil {
+			continue
+		}
+		subs[sub.Id()] = sub
+		subIds = append(subIds, sub.Id())
+	}
+
+	charges, err := h.chargeRepository.GetRecordedBySubscriptionIds(ctx, subIds...)
+	if err != nil {
+		return result.Fail[FamilyLedgerQueryResponse](err)
+	}
+	// The shares and settlements are converted at the rate of their day, all at once after they are all read.
+	// The callbacks run in the order the amounts were added.
+	conversions := &ports.ConversionBatch{}
+	var ledgerErr error
+	apply := func(record func(amount currency.Amount) error) func(currency.Amount) {
+		return func(converted currency.Amount) {
+			if ledgerErr != nil {
+				return
+			}
+			ledgerErr = record(converted)
+		}
+	}
+	for _, chg := range charges {
+		if chg.Status() != charge.PaidStatus && chg.Status() != charge.AdjustedStatus {
+			continue
+		}
+		sub, ok := subs[chg.SubscriptionId()]
+		if !ok {
+			continue
+		}
+		payer := sub.Payer().MemberId()
+		for member, share := range sub.SplitAmount(chg.Amount()) {
+			conversions.AddForSubscription(sub.Id(), share, chg.DueDate(), apply(func(amount currency.Amount) error {
+				return familyLedger.Accrue(payer, member, amount)
+			}))
+		}
+	}
+
+	settlements, err := h.settlementRepository.GetByFamilyId(ctx, fam.Id())
+	if err != nil {
+		return result.Fail[FamilyLedgerQueryResponse](err)
+	}
+	for _, settlement := range settlements {
+		conversions.Add(settlement.Amount(), settlement.SettledAt(), apply(func(amount currency.Amount) error {
+			return familyLedger.Settle(settlement.From(), settlement.To(), amount)
+		}))
+	}
+
+	if err := conversions.Convert(ctx, h.exchange, preferredCurrency); err != nil {
+		return result.Fail[FamilyLedgerQueryResponse](err)
+	}
+	if ledgerErr != nil {
+		return result.Fail[FamilyLedgerQueryResponse](ledgerErr)
+	}
+
+	balances := make([]MemberBalanceResponse, 0, fam.Members().Len())
+	for member := range fam.Members().It() {
+		balances = append(balances, MemberBalanceResponse{
+			MemberID: member.Id(),
+			Name:     member.Name(),
+			Balance:  familyLedger.Balance(member.Id()),
+		})
+	}
+
+	return result.Success(FamilyLedgerQueryResponse{
+		FamilyID:    fam.Id(),
+		Balances:    balances,
+		Transfers:   familyLedger.Transfers(),
+		Settlements: settlements,
+	})
+}
@@ -0,0 +1,178 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/ledger"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/ledger/query"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+)
+
+func buildFamilySub(
+	fam family.Family,
+	payer types.FamilyMemberID,
+	familyUsers []types.FamilyMemberID) subscription.Subscription {
+	return subscription.NewSubscription(
+		types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+		subscription.NewPrice(currency.NewAmount(12, currency.EUR)), nil, nil,
+		types.NewFamilyOwner(fam.Id()), subscription.NewFamilyMemberPayer(fam.Id(), payer), nil, nil, familyUsers, nil,
+		time.Now().AddDate(0, -3, 0), nil, nil, subscription.MonthlyRecurrency, nil, nil, time.Now(), time.Now(),
+	)
+}
+
+func buildCharge(sub subscription.Subscription, months int, status charge.Status) charge.Charge {
+	chg := charge.NewCharge(types.NewChargeID(), sub.Id(), sub.StartDate().AddDate(0, months, 0),
+		sub.GetPrice(), nil, charge.ExpectedStatus, time.Now(), time.Now())
+	if err := chg.Record(status, nil); err != nil {
+		panic(err)
+	}
+	return chg
+}
+
+func TestFamilyLedgerQueryHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	userID := types.UserID("user-1")
+
+	familyID := types.NewFamilyID()
+	alice := family.NewMember(types.NewFamilyMemberID(), familyID, "Alice", family.OwnerMemberType, nil,
+		time.Now(), time.Now())
+	alice.SetUserId(&userID)
+	bob := family.NewMember(types.NewFamilyMemberID(), familyID, "Bob", family.AdultMemberType, nil,
+		time.Now(), time.Now())
+	fam := family.NewFamily(familyID, userID, "Family", []family.Member{alice, bob}, time.Now(), time.Now())
+
+	type mocks struct {
+		famRepo        *ports.MockFamilyRepository
+		subRepo        *ports.MockSubscriptionRepository
+		chargeRepo     *ports.MockChargeRepository
+		settlementRepo *ports.MockSettlementRepository
+		perm           *ports.MockPermissionRequest
+		conversions    *[]ports.Conversion
+	}
+	newHandler := func(t *testing.T) (*query.FamilyLedgerQueryHandler, mocks) {
+		m := mocks{
+			famRepo:        ports.NewMockFamilyRepository(t),
+			subRepo:        ports.NewMockSubscriptionRepository(t),
+			chargeRepo:     ports.NewMockChargeRepository(t),
+			settlementRepo: ports.NewMockSettlementRepository(t),
+			perm:           ports.NewMockPermissionRequest(t),
+			conversions:    &[]ports.Conversion{},
+		}
+		acctService := ports.NewMockAccountService(t)
+		auth := ports.NewMockAuthentication(t)
+		authz := ports.NewMockAuthorization(t)
+		exch := ports.NewMockExchange(t)
+
+		acc := account.NewMockConnectedAccount(t)
+		acc.EXPECT().UserID().Return(userID).Maybe()
+		auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc)
+		acctService.EXPECT().GetPreferredCurrency(mock.Anything, userID).Return(currency.EUR).Maybe()
+		authz.EXPECT().Can(mock.Anything, authorization.PermissionRead).Return(m.perm).Maybe()
+		exch.EXPECT().ToCurrencyBatch(mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(
+				_ context.Context,
+				conversions []ports.Conversion,
+				_ currency.Unit) ([]currency.Amount, error) {
+				*m.conversions = append(*m.conversions, conversions...)
+				return herd.Select(conversions, func(c ports.Conversion) currency.Amount {
+					return c.Amount
+				}), nil
+			}).Maybe()
+
+		return query.NewFamilyLedgerQueryHandler(m.famRepo, m.subRepo, m.chargeRepo, m.settlementRepo,
+			acctService, auth, authz, exch), m
+	}
+
+	t.Run("nets shares of paid charges with settlements", func(t *testing.T) {
+		h, m := newHandler(t)
+
+		shared := buildFamilySub(fam, bob.Id(), []types.FamilyMemberID{alice.Id(), bob.Id()})
+		require.NoError(t, shared.SetCostSplit(subscription.NewCostSplit(subscription.EqualCostSplit, nil)))
+		// Without split rules the payer bears the whole cost
+		unshared := buildFamilySub(fam, alice.Id(), nil)
+
+		m.famRepo.EXPECT().GetById(mock.Anything, fam.Id()).Return(fam, nil)
+		m.perm.EXPECT().For(mock.Anything).Return(nil)
+		m.subRepo.EXPECT().GetAllIt(mock.Anything, userID, "").Return(func(yield func(subscription.Subscription) bool) {
+			for _, sub := range []subscription.Subscription{shared, unshared} {
+				if !yield(sub) {
+					return
+				}
+			}
+		})
+		m.chargeRepo.EXPECT().GetRecordedBySubscriptionIds(mock.Anything, []types.SubscriptionID{shared.Id()}).Return([]charge.Charge{
+			buildCharge(shared, 0, charge.PaidStatus),
+			buildCharge(shared, 1, charge.PaidStatus),
+			buildCharge(shared, 2, charge.FailedStatus),
+		}, nil)
+		m.settlementRepo.EXPECT().GetByFamilyId(mock.Anything, fam.Id()).Return([]ledger.Settlement{
+			ledger.NewSettlement(types.NewSettlementID(), fam.Id(), alice.Id(), bob.Id(),
+				currency.NewAmount(5, currency.EUR), time.Now(), nil, time.Now(), time.Now()),
+		}, nil)
+
+		res := h.Handle(ctx, query.NewFamilyLedgerQuery(fam.Id()))
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(out query.FamilyLedgerQueryResponse) {
+			require.Len(t, out.Balances, 2)
+			assert.Equal(t, alice.Id(), out.Balances[0].MemberID)
+			assert.Equal(t, -7.0, out.Balances[0].Balance.Value())
+			assert.Equal(t, 7.0, out.Balances[1].Balance.Value())
+			assert.Equal(t, currency.EUR, out.Balances[1].Balance.Currency())
+			require.Len(t, out.Transfers, 1)
+			assert.Equal(t, alice.Id(), out.Transfers[0].From)
+			assert.Equal(t, bob.Id(), out.Transfers[0].To)
+			assert.Equal(t, 7.0, out.Transfers[0].Amount.Value())
+			assert.Len(t, out.Settlements, 1)
+		})
+
+		// The shares of the charges are converted with the manual rates of their subscription, the settlement with
+		// the rates of the account
+		for _, c := range *m.conversions {
+			if c.SubscriptionId == nil {
+				assert.Equal(t, 5.0, c.Amount.Value())
+			} else {
+				assert.Equal(t, shared.Id(), *c.SubscriptionId)
+			}
+		}
+	})
+
+	t.Run("returns fault when family not found", func(t *testing.T) {
+		h, m := newHandler(t)
+		m.famRepo.EXPECT().GetById(mock.Anything, mock.Anything).Return(nil, nil)
+
+		res := h.Handle(ctx, query.NewFamilyLedgerQuery(types.NewFamilyID()))
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, family.ErrFamilyNotFound)
+		})
+	})
+
+	t.Run("returns fault when permission denied", func(t *testing.T) {
+		h, m := newHandler(t)
+		m.famRepo.EXPECT().GetById(mock.Anything, fam.Id()).Return(fam, nil)
+		m.perm.EXPECT().For(mock.Anything).Return(authorization.ErrUnauthorized)
+
+		res := h.Handle(ctx, query.NewFamilyLedgerQuery(fam.Id()))
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, authorization.ErrUnauthorized)
+		})
+	})
+}
@@ -0,0 +1,141 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// reminderRenewals is the number of upcoming renewals looked at, only the next one can be within a lead time
+const reminderRenewals = 1
+
+// SendRemindersCommand emails the accounts that opted in to reminders about their events within their lead days.
+// Every reminder is claimed before it is sent so that it is sent once, even by several instances.
+type SendRemindersCommand struct {
+}
+
+// SendRemindersResult reports a run, an account failing does not stop the reminders of the others
+type SendRemindersResult struct {
+	Sent int
+	// Errors are the failures of the accounts whose reminders could not all be sent
+	Errors []error
+}
+
+type SendRemindersCommandHandler struct {
+	preferencesRepository ports.NotificationPreferencesRepository
+	reminderRepository    ports.ReminderRepository
+	eventBuilder          shared.CalendarEventBuilder
+	accountService        ports.AccountService
+	identityProvider      ports.IdentityProvider
+	renderer              ports.ReminderRenderer
+	emailSender           ports.EmailSender
+}
+
+func NewSendRemindersCommandHandler(
+	preferencesRepository ports.NotificationPreferencesRepository,
+	reminderRepository ports.ReminderRepository,
+	eventBuilder shared.CalendarEventBuilder,
+	accountService ports.AccountService,
+	identityProvider ports.IdentityProvider,
+	renderer ports.ReminderRenderer,
+	emailSender ports.EmailSender) *SendRemindersCommandHandler {
+	return &SendRemindersCommandHandler{
+		preferencesRepository: preferencesRepository,
+		reminderRepository:    reminderRepository,
+		eventBuilder:          eventBuilder,
+		accountService:        accountService,
+		identityProvider:      identityProvider,
+		renderer:              renderer,
+		emailSender:           emailSender,
+	}
+}
+
+func (h SendRemindersCommandHandler) Handle(
+	ctx context.Context,
+	_ SendRemindersCommand) result.Result[SendRemindersResult] {
+	all, err := h.preferencesRepository.GetAllEnabled(ctx)
+	if err != nil {
+		return result.Fail[SendRemindersResult](err)
+	}
+
+	var report SendRemindersResult
+	for _, preferences := range all {
+		if err = ctx.Err(); err != nil {
+			return result.Fail[SendRemindersResult](err)
+		}
+
+		sent, err := h.remind(ctx, preferences)
+		report.Sent += sent
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Errorf("reminders of user %s: %w", preferences.UserId(), err))
+		}
+	}
+
+	return result.Success(report)
+}
+
+// remind sends the reminders due to an account and returns how many were sent
+func (h SendRemindersCommandHandler) remind(ctx context.Context, preferences notification.Preferences) (int, error) {
+	userId := preferences.UserId()
+	events, err := h.eventBuilder.Build(ctx, userId, reminderRenewals)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	timezone := h.accountService.GetTimezone(ctx, userId)
+	address := ""
+	sent := 0
+	for _, event := range events {
+		if !notification.IsDue(event, preferences.LeadDays(event.Kind), now, timezone) {
+			continue
+		}
+
+		// The address is only looked up for accounts with a reminder due
+		if address == "" {
+			address, err = h.identityProvider.GetUserEmail(ctx, userId)
+			if err != nil {
+				return sent, err
+			}
+			if address == "" {
+				return sent, nil
+			}
+		}
+
+		email, err := h.renderer.Render(preferences.Locale(), event)
+		if err != nil {
+			return sent, err
+		}
+		email.To = address
+
+		reminder := notification.NewPendingReminder(userId, event)
+		claimed, err := h.reminderRepository.Claim(ctx, reminder)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+
+		if err = h.emailSender.Send(ctx, email); err != nil {
+			// Releasing the claim lets the next run retry, the email was not sent
+			if _, deleteErr := h.reminderRepository.Delete(context.WithoutCancel(ctx), reminder.Id()); deleteErr != nil {
+				return sent, deleteErr
+			}
+			return sent, err
+		}
+
+		reminder.MarkAsSent(time.Now())
+		if err = h.reminderRepository.Save(ctx, reminder); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+
+	return sent, nil
+}
@@ -0,0 +1,170 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/notification/command"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func TestSendReminders(t *testing.T) {
+	userId := types.UserID("user-1")
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	// renews in two or three days, within the lead days of the preferences
+	sub := subscription.NewSubscription(
+		types.NewSubscriptionID(), x.P("Netflix"), nil, nil, types.NewProviderID(),
+		subscription.NewPrice(currency.NewAmount(10, currency.EUR)), nil, nil,
+		types.NewPersonalOwner(userId), nil, nil, nil, nil, nil,
+		today.AddDate(-1, 0, 2), nil, nil, subscription.YearlyRecurrency, nil, nil, time.Now(), time.Now())
+	preferences := notification.NewPreferences(userId, true, notification.FrenchLocale, 3, 3, 7, time.Now(),
+		time.Now())
+	email := ports.Email{Subject: "Netflix", Text: "renews soon"}
+	isRenewal := mock.MatchedBy(func(event calendar.Event) bool {
+		return event.Kind == calendar.RenewalEventKind && event.SubscriptionId == sub.Id()
+	})
+
+	type mocks struct {
+		preferencesRepository  *ports.MockNotificationPreferencesRepository
+		reminderRepository     *ports.MockReminderRepository
+		subscriptionRepository *ports.MockSubscriptionRepository
+		accountService         *ports.MockAccountService
+		identityProvider       *ports.MockIdentityProvider
+		renderer               *ports.MockReminderRenderer
+		emailSender            *ports.MockEmailSender
+		handler                *command.SendRemindersCommandHandler
+	}
+	newMocks := func(t *testing.T, subs ...subscription.Subscription) mocks {
+		m := mocks{
+			preferencesRepository:  ports.NewMockNotificationPreferencesRepository(t),
+			reminderRepository:     ports.NewMockReminderRepository(t),
+			subscriptionRepository: ports.NewMockSubscriptionRepository(t),
+			accountService:         ports.NewMockAccountService(t),
+			identityProvider:       ports.NewMockIdentityProvider(t),
+			renderer:               ports.NewMockReminderRenderer(t),
+			emailSender:            ports.NewMockEmailSender(t),
+		}
+		eventBuilder := shared.NewCalendarEventBuilder(m.subscriptionRepository, ports.NewMockProviderRepository(t),
+			m.accountService)
+		m.handler = command.NewSendRemindersCommandHandler(m.preferencesRepository, m.reminderRepository,
+			eventBuilder, m.accountService, m.identityProvider, m.renderer, m.emailSender)
+
+		m.preferencesRepository.EXPECT().GetAllEnabled(mock.Anything).
+			Return([]notification.Preferences{preferences}, nil)
+		m.accountService.EXPECT().GetTimezone(mock.Anything, userId).Return(time.UTC)
+		m.subscriptionRepository.EXPECT().GetAllIt(mock.Anything, userId, "").
+			Return(func(yield func(subscription.Subscription) bool) {
+				for _, s := range subs {
+					if !yield(s) {
+						return
+					}
+				}
+			})
+		return m
+	}
+
+	t.Run("sends the due reminder once claimed", func(t *testing.T) {
+		m := newMocks(t, sub)
+		m.identityProvider.EXPECT().GetUserEmail(mock.Anything, userId).Return("user@example.com", nil)
+		m.renderer.EXPECT().Render(notification.FrenchLocale, isRenewal).Return(email, nil)
+		m.reminderRepository.EXPECT().Claim(mock.Anything, mock.Anything).Return(true, nil)
+		m.emailSender.EXPECT().Send(mock.Anything, mock.MatchedBy(func(e ports.Email) bool {
+			return e.To == "user@example.com" && e.Subject == email.Subject
+		})).Return(nil)
+		m.reminderRepository.EXPECT().Save(mock.Anything, mock.Anything).
+			Run(func(_ context.Context, reminders ...notification.Reminder) {
+				require.Len(t, reminders, 1)
+				assert.Equal(t, notification.SentReminderStatus, reminders[0].Status())
+				assert.Equal(t, sub.Id(), reminders[0].SubscriptionId())
+			}).
+			Return(nil)
+
+		res := m.handler.Handle(t.Context(), command.SendRemindersCommand{})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.SendRemindersResult) {
+			assert.Equal(t, 1, report.Sent)
+			assert.Empty(t, report.Errors)
+		})
+	})
+
+	t.Run("skips a reminder already claimed", func(t *testing.T) {
+		m := newMocks(t, sub)
+		m.identityProvider.EXPECT().GetUserEmail(mock.Anything, userId).Return("user@example.com", nil)
+		m.renderer.EXPECT().Render(notification.FrenchLocale, isRenewal).Return(email, nil)
+		m.reminderRepository.EXPECT().Claim(mock.Anything, mock.Anything).Return(false, nil)
+
+		res := m.handler.Handle(t.Context(), command.SendRemindersCommand{})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.SendRemindersResult) {
+			assert.Equal(t, 0, report.Sent)
+			assert.Empty(t, report.Errors)
+		})
+	})
+
+	t.Run("releases the claim when the email is not sent", func(t *testing.T) {
+		m := newMocks(t, sub)
+		sendErr := errors.New("connection refused")
+		var claimed notification.Reminder
+		m.identityProvider.EXPECT().GetUserEmail(mock.Anything, userId).Return("user@example.com", nil)
+		m.renderer.EXPECT().Render(notification.FrenchLocale, isRenewal).Return(email, nil)
+		m.reminderRepository.EXPECT().Claim(mock.Anything, mock.Anything).
+			Run(func(_ context.Context, reminder notification.Reminder) {
+				claimed = reminder
+			}).
+			Return(true, nil)
+		m.emailSender.EXPECT().Send(mock.Anything, mock.Anything).Return(sendErr)
+		m.reminderRepository.EXPECT().Delete(mock.Anything, mock.Anything).
+			Run(func(_ context.Context, id types.ReminderID) {
+				assert.Equal(t, claimed.Id(), id)
+			}).
+			Return(true, nil)
+
+		res := m.handler.Handle(t.Context(), command.SendRemindersCommand{})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.SendRemindersResult) {
+			assert.Equal(t, 0, report.Sent)
+			require.Len(t, report.Errors, 1)
+			assert.ErrorIs(t, report.Errors[0], sendErr)
+		})
+	})
+
+	t.Run("does nothing without an email address", func(t *testing.T) {
+		m := newMocks(t, sub)
+		m.identityProvider.EXPECT().GetUserEmail(mock.Anything, userId).Return("", nil)
+
+		res := m.handler.Handle(t.Context(), command.SendRemindersCommand{})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.SendRemindersResult) {
+			assert.Equal(t, 0, report.Sent)
+			assert.Empty(t, report.Errors)
+		})
+	})
+
+	t.Run("does nothing when no event is due", func(t *testing.T) {
+		m := newMocks(t)
+
+		res := m.handler.Handle(t.Context(), command.SendRemindersCommand{})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.SendRemindersResult) {
+			assert.Equal(t, 0, report.Sent)
+		})
+	})
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// UpdatePreferencesCommand replaces the reminder preferences of the connected account
+type UpdatePreferencesCommand struct {
+	Enabled                      bool
+	Locale                       notification.Locale
+	RenewalLeadDays              int
+	FreeTrialEndLeadDays         int
+	CancellationDeadlineLeadDays int
+}
+
+type UpdatePreferencesCommandHandler struct {
+	preferencesRepository ports.NotificationPreferencesRepository
+	authentication        ports.Authentication
+}
+
+func NewUpdatePreferencesCommandHandler(
+	preferencesRepository ports.NotificationPreferencesRepository,
+	authentication ports.Authentication) *UpdatePreferencesCommandHandler {
+	return &UpdatePreferencesCommandHandler{
+		preferencesRepository: preferencesRepository,
+		authentication:        authentication,
+	}
+}
+
+func (h UpdatePreferencesCommandHandler) Handle(
+	ctx context.Context,
+	command UpdatePreferencesCommand) result.Result[notification.Preferences] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	preferences, err := h.preferencesRepository.GetByUserId(ctx, connectedAccount.UserID())
+	if err != nil {
+		return result.Fail[notification.Preferences](err)
+	}
+	if preferences == nil {
+		preferences = notification.DefaultPreferences(connectedAccount.UserID(), command.Locale)
+	}
+
+	preferences.SetEnabled(command.Enabled)
+	preferences.SetLocale(command.Locale)
+	preferences.SetLeadDays(command.RenewalLeadDays, command.FreeTrialEndLeadDays,
+		command.CancellationDeadlineLeadDays)
+	if validationErrors := preferences.GetValidationErrors(); validationErrors.HasErrors() {
+		return result.Fail[notification.Preferences](validationErrors)
+	}
+
+	if err = h.preferencesRepository.Save(ctx, preferences); err != nil {
+		return result.Fail[notification.Preferences](err)
+	}
+
+	return result.Success(preferences)
+}
@@ -0,0 +1,20 @@
+package notification
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/notification/command"
+	"github.com/mistribe/subtracker/internal/usecase/notification/query"
+)
+
+func Module() fx.Option {
+	return fx.Module("app_notification",
+		fx.Provide(
+			ports.AsQueryHandler[query.GetPreferencesQuery, notification.Preferences](query.NewGetPreferencesQueryHandler),
+
+			ports.AsCommandHandler[command.UpdatePreferencesCommand, notification.Preferences](command.NewUpdatePreferencesCommandHandler),
+			ports.AsCommandHandler[command.SendRemindersCommand, command.SendRemindersResult](command.NewSendRemindersCommandHandler),
+		))
+}
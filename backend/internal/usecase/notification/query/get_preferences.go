@@ -0,0 +1,52 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/notification"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared/i18n"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// GetPreferencesQuery returns the reminder preferences of the connected account, the defaults when none were saved
+type GetPreferencesQuery struct {
+}
+
+type GetPreferencesQueryHandler struct {
+	preferencesRepository ports.NotificationPreferencesRepository
+	authentication        ports.Authentication
+}
+
+func NewGetPreferencesQueryHandler(
+	preferencesRepository ports.NotificationPreferencesRepository,
+	authentication ports.Authentication) *GetPreferencesQueryHandler {
+	return &GetPreferencesQueryHandler{
+		preferencesRepository: preferencesRepository,
+		authentication:        authentication,
+	}
+}
+
+func (h GetPreferencesQueryHandler) Handle(
+	ctx context.Context,
+	_ GetPreferencesQuery) result.Result[notification.Preferences] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	preferences, err := h.preferencesRepository.GetByUserId(ctx, connectedAccount.UserID())
+	if err != nil {
+		return result.Fail[notification.Preferences](err)
+	}
+	if preferences == nil {
+		preferences = notification.DefaultPreferences(connectedAccount.UserID(), preferredLocale(ctx))
+	}
+
+	return result.Success(preferences)
+}
+
+// preferredLocale is the locale of the languages accepted by the request
+func preferredLocale(ctx context.Context) notification.Locale {
+	var languages []string
+	for _, preference := range i18n.FromContext(ctx).Preferences() {
+		languages = append(languages, preference.Locale())
+	}
+	return notification.LocaleOf(languages...)
+}
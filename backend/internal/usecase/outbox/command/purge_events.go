@@ -0,0 +1,35 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// PurgeEventsCommand deletes the events of the outbox processed longer ago than the retention, the pending events
+// are kept whatever their age
+type PurgeEventsCommand struct {
+	Retention time.Duration
+}
+
+type PurgeEventsCommandHandler struct {
+	outboxRepository ports.OutboxRepository
+}
+
+func NewPurgeEventsCommandHandler(outboxRepository ports.OutboxRepository) *PurgeEventsCommandHandler {
+	return &PurgeEventsCommandHandler{
+		outboxRepository: outboxRepository,
+	}
+}
+
+// Handle returns the number of events deleted
+func (h PurgeEventsCommandHandler) Handle(ctx context.Context, command PurgeEventsCommand) result.Result[int64] {
+	deleted, err := h.outboxRepository.DeleteProcessedBefore(ctx, time.Now().Add(-command.Retention))
+	if err != nil {
+		return result.Fail[int64](err)
+	}
+
+	return result.Success(deleted)
+}
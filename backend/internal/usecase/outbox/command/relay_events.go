@@ -0,0 +1,40 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// RelayEventsCommand publishes the pending events of the outbox to the subscribers. Several instances can relay at
+// the same time, each takes events the others have not locked.
+type RelayEventsCommand struct {
+	// Limit is the maximum number of events published
+	Limit int64
+}
+
+type RelayEventsCommandHandler struct {
+	outboxRepository ports.OutboxRepository
+	eventPublisher   ports.EventPublisher
+}
+
+func NewRelayEventsCommandHandler(
+	outboxRepository ports.OutboxRepository,
+	eventPublisher ports.EventPublisher) *RelayEventsCommandHandler {
+	return &RelayEventsCommandHandler{
+		outboxRepository: outboxRepository,
+		eventPublisher:   eventPublisher,
+	}
+}
+
+func (h RelayEventsCommandHandler) Handle(
+	ctx context.Context,
+	command RelayEventsCommand) result.Result[ports.OutboxRelayResult] {
+	report, err := h.outboxRepository.Relay(ctx, command.Limit, h.eventPublisher.Publish)
+	if err != nil {
+		return result.Fail[ports.OutboxRelayResult](err)
+	}
+
+	return result.Success(report)
+}
@@ -0,0 +1,17 @@
+package outbox
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/outbox/command"
+)
+
+func Module() fx.Option {
+	return fx.Module("app_outbox",
+		fx.Provide(
+			ports.AsCommandHandler[command.RelayEventsCommand, ports.OutboxRelayResult](command.NewRelayEventsCommandHandler),
+			ports.AsCommandHandler[command.PurgeEventsCommand, int64](command.NewPurgeEventsCommandHandler),
+		),
+	)
+}
@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type CreatePaymentMethodCommand struct {
+	PaymentMethodID option.Option[types.PaymentMethodID]
+	Type            paymentmethod.Type
+	Label           string
+	LastFour        *string
+	ExpiryMonth     *int32
+	ExpiryYear      *int32
+	Owner           types.OwnerType
+	CreatedAt       option.Option[time.Time]
+}
+
+type CreatePaymentMethodCommandHandler struct {
+	paymentMethodRepository ports.PaymentMethodRepository
+	authorization           ports.Authorization
+	ownerFactory            shared.OwnerFactory
+}
+
+func NewCreatePaymentMethodCommandHandler(
+	paymentMethodRepository ports.PaymentMethodRepository,
+	authorization ports.Authorization,
+	ownerFactory shared.OwnerFactory) *CreatePaymentMethodCommandHandler {
+	return &CreatePaymentMethodCommandHandler{
+		paymentMethodRepository: paymentMethodRepository,
+		authorization:           authorization,
+		ownerFactory:            ownerFactory,
+	}
+}
+
+func (h CreatePaymentMethodCommandHandler) Handle(
+	ctx context.Context,
+	command CreatePaymentMethodCommand) result.Result[paymentmethod.PaymentMethod] {
+	var paymentMethodID types.PaymentMethodID
+	if command.PaymentMethodID != nil && command.PaymentMethodID.IsSome() {
+		paymentMethodID = *command.PaymentMethodID.Value()
+		existing, err := h.paymentMethodRepository.GetById(ctx, paymentMethodID)
+		if err != nil {
+			return result.Fail[paymentmethod.PaymentMethod](err)
+		}
+		if existing != nil {
+			return result.Fail[paymentmethod.PaymentMethod](paymentmethod.ErrPaymentMethodAlreadyExists)
+		}
+	} else {
+		paymentMethodID = types.NewPaymentMethodID()
+	}
+	var createdAt time.Time
+	if command.CreatedAt != nil && command.CreatedAt.IsSome() {
+		createdAt = *command.CreatedAt.Value()
+	} else {
+		createdAt = time.Now()
+	}
+
+	owner, err := h.ownerFactory.Resolve(ctx, command.Owner)
+	if err != nil {
+		return result.Fail[paymentmethod.PaymentMethod](err)
+	}
+
+	newPaymentMethod := paymentmethod.NewPaymentMethod(
+		paymentMethodID,
+		owner,
+		command.Type,
+		command.Label,
+		command.LastFour,
+		command.ExpiryMonth,
+		command.ExpiryYear,
+		createdAt,
+		createdAt,
+	)
+
+	permReq := h.authorization.Can(ctx, authorization.PermissionWrite)
+	if permReq == nil {
+		return result.Fail[paymentmethod.PaymentMethod](authorization.ErrUnauthorized)
+	}
+	if err := permReq.For(newPaymentMethod); err != nil {
+		return result.Fail[paymentmethod.PaymentMethod](err)
+	}
+
+	if err := newPaymentMethod.GetValidationErrors(); err != nil {
+		return result.Fail[paymentmethod.PaymentMethod](err)
+	}
+
+	if err := h.paymentMethodRepository.Save(ctx, newPaymentMethod); err != nil {
+		return result.Fail[paymentmethod.PaymentMethod](err)
+	}
+
+	return result.Success(newPaymentMethod)
+}
@@ -0,0 +1,116 @@
+package command_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	auth "github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/paymentmethod/command"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func TestCreatePaymentMethod(t *testing.T) {
+	userId := types.UserID("userID-Test")
+	owner := types.NewPersonalOwner(userId)
+
+	newCommand := func(id types.PaymentMethodID) command.CreatePaymentMethodCommand {
+		return command.CreatePaymentMethodCommand{
+			PaymentMethodID: option.Some(id),
+			Type:            paymentmethod.CardType,
+			Label:           "Personal Visa",
+			LastFour:        x.P("4242"),
+			ExpiryMonth:     x.P(int32(3)),
+			ExpiryYear:      x.P(int32(2030)),
+			Owner:           types.PersonalOwnerType,
+			CreatedAt:       option.Some(time.Now()),
+		}
+	}
+
+	t.Run("all working", func(t *testing.T) {
+		repository := ports.NewMockPaymentMethodRepository(t)
+		authorization := ports.NewMockAuthorization(t)
+		permissionRequest := ports.NewMockPermissionRequest(t)
+		ownerFactory := shared.NewMockOwnerFactory(t)
+
+		id := types.NewPaymentMethodID()
+		repository.EXPECT().GetById(mock.Anything, id).Return(nil, nil)
+		ownerFactory.EXPECT().Resolve(mock.Anything, types.PersonalOwnerType).Return(owner, nil)
+		authorization.EXPECT().Can(mock.Anything, auth.PermissionWrite).Return(permissionRequest)
+		permissionRequest.EXPECT().For(mock.Anything).Return(nil)
+		repository.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+		handler := command.NewCreatePaymentMethodCommandHandler(repository, authorization, ownerFactory)
+		res := handler.Handle(t.Context(), newCommand(id))
+
+		assert.True(t, res.IsSuccess())
+		res.IfSuccess(func(pm paymentmethod.PaymentMethod) {
+			assert.Equal(t, id, pm.Id())
+			assert.Equal(t, "4242", *pm.LastFour())
+		})
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		repository := ports.NewMockPaymentMethodRepository(t)
+		authorization := ports.NewMockAuthorization(t)
+		ownerFactory := shared.NewMockOwnerFactory(t)
+
+		id := types.NewPaymentMethodID()
+		existing := paymentmethod.NewPaymentMethod(id, owner, paymentmethod.CardType, "Visa", nil, nil, nil,
+			time.Now(), time.Now())
+		repository.EXPECT().GetById(mock.Anything, id).Return(existing, nil)
+
+		handler := command.NewCreatePaymentMethodCommandHandler(repository, authorization, ownerFactory)
+		res := handler.Handle(t.Context(), newCommand(id))
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, paymentmethod.ErrPaymentMethodAlreadyExists)
+		})
+	})
+
+	t.Run("invalid expiry is not saved", func(t *testing.T) {
+		repository := ports.NewMockPaymentMethodRepository(t)
+		authorization := ports.NewMockAuthorization(t)
+		permissionRequest := ports.NewMockPermissionRequest(t)
+		ownerFactory := shared.NewMockOwnerFactory(t)
+
+		id := types.NewPaymentMethodID()
+		repository.EXPECT().GetById(mock.Anything, id).Return(nil, nil)
+		ownerFactory.EXPECT().Resolve(mock.Anything, types.PersonalOwnerType).Return(owner, nil)
+		authorization.EXPECT().Can(mock.Anything, auth.PermissionWrite).Return(permissionRequest)
+		permissionRequest.EXPECT().For(mock.Anything).Return(nil)
+
+		cmd := newCommand(id)
+		cmd.ExpiryMonth = x.P(int32(13))
+		handler := command.NewCreatePaymentMethodCommandHandler(repository, authorization, ownerFactory)
+		res := handler.Handle(t.Context(), cmd)
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("without permission", func(t *testing.T) {
+		repository := ports.NewMockPaymentMethodRepository(t)
+		authorization := ports.NewMockAuthorization(t)
+		permissionRequest := ports.NewMockPermissionRequest(t)
+		ownerFactory := shared.NewMockOwnerFactory(t)
+
+		id := types.NewPaymentMethodID()
+		repository.EXPECT().GetById(mock.Anything, id).Return(nil, nil)
+		ownerFactory.EXPECT().Resolve(mock.Anything, types.PersonalOwnerType).Return(owner, nil)
+		authorization.EXPECT().Can(mock.Anything, auth.PermissionWrite).Return(permissionRequest)
+		permissionRequest.EXPECT().For(mock.Anything).Return(auth.ErrUnauthorized)
+
+		handler := command.NewCreatePaymentMethodCommandHandler(repository, authorization, ownerFactory)
+		res := handler.Handle(t.Context(), newCommand(id))
+
+		assert.True(t, res.IsFaulted())
+	})
+}
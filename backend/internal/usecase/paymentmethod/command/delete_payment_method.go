@@ -0,0 +1,60 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type DeletePaymentMethodCommand struct {
+	PaymentMethodID types.PaymentMethodID
+}
+
+type DeletePaymentMethodCommandHandler struct {
+	paymentMethodRepository ports.PaymentMethodRepository
+	authorization           ports.Authorization
+}
+
+func NewDeletePaymentMethodCommandHandler(
+	paymentMethodRepository ports.PaymentMethodRepository,
+	authorization ports.Authorization) *DeletePaymentMethodCommandHandler {
+	return &DeletePaymentMethodCommandHandler{
+		paymentMethodRepository: paymentMethodRepository,
+		authorization:           authorization,
+	}
+}
+
+// Handle removes the payment method, the subscriptions charged to it are left without payment method
+func (h DeletePaymentMethodCommandHandler) Handle(
+	ctx context.Context,
+	command DeletePaymentMethodCommand) result.Result[bool] {
+	existing, err := h.paymentMethodRepository.GetById(ctx, command.PaymentMethodID)
+	if err != nil {
+		return result.Fail[bool](err)
+	}
+	if existing == nil {
+		return result.Fail[bool](paymentmethod.ErrPaymentMethodNotFound)
+	}
+
+	permReq := h.authorization.Can(ctx, authorization.PermissionDelete)
+	if permReq == nil {
+		return result.Fail[bool](authorization.ErrUnauthorized)
+	}
+	if err = permReq.For(existing); err != nil {
+		return result.Fail[bool](err)
+	}
+
+	ok, err := h.paymentMethodRepository.Delete(ctx, command.PaymentMethodID)
+	if err != nil {
+		return result.Fail[bool](err)
+	}
+	if !ok {
+		return result.Fail[bool](paymentmethod.ErrPaymentMethodNotFound)
+	}
+
+	return result.Success(true)
+}
@@ -0,0 +1,51 @@
+package command_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	auth "github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/paymentmethod/command"
+)
+
+func TestDeletePaymentMethod(t *testing.T) {
+	existing := paymentmethod.NewPaymentMethod(types.NewPaymentMethodID(), types.NewPersonalOwner("userID-Test"),
+		paymentmethod.BankAccountType, "Checking", nil, nil, nil, time.Now(), time.Now())
+
+	t.Run("all working", func(t *testing.T) {
+		repository := ports.NewMockPaymentMethodRepository(t)
+		authorization := ports.NewMockAuthorization(t)
+		permissionRequest := ports.NewMockPermissionRequest(t)
+
+		repository.EXPECT().GetById(mock.Anything, existing.Id()).Return(existing, nil)
+		authorization.EXPECT().Can(mock.Anything, auth.PermissionDelete).Return(permissionRequest)
+		permissionRequest.EXPECT().For(existing).Return(nil)
+		repository.EXPECT().Delete(mock.Anything, existing.Id()).Return(true, nil)
+
+		handler := command.NewDeletePaymentMethodCommandHandler(repository, authorization)
+		res := handler.Handle(t.Context(), command.DeletePaymentMethodCommand{PaymentMethodID: existing.Id()})
+
+		assert.True(t, res.IsSuccess())
+	})
+
+	t.Run("without permission", func(t *testing.T) {
+		repository := ports.NewMockPaymentMethodRepository(t)
+		authorization := ports.NewMockAuthorization(t)
+		permissionRequest := ports.NewMockPermissionRequest(t)
+
+		repository.EXPECT().GetById(mock.Anything, existing.Id()).Return(existing, nil)
+		authorization.EXPECT().Can(mock.Anything, auth.PermissionDelete).Return(permissionRequest)
+		permissionRequest.EXPECT().For(existing).Return(auth.ErrUnauthorized)
+
+		handler := command.NewDeletePaymentMethodCommandHandler(repository, authorization)
+		res := handler.Handle(t.Context(), command.DeletePaymentMethodCommand{PaymentMethodID: existing.Id()})
+
+		assert.True(t, res.IsFaulted())
+	})
+}
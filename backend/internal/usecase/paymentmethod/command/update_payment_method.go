@@ -0,0 +1,79 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type UpdatePaymentMethodCommand struct {
+	PaymentMethodID types.PaymentMethodID
+	Type            paymentmethod.Type
+	Label           string
+	LastFour        *string
+	ExpiryMonth     *int32
+	ExpiryYear      *int32
+	UpdatedAt       option.Option[time.Time]
+}
+
+type UpdatePaymentMethodCommandHandler struct {
+	paymentMethodRepository ports.PaymentMethodRepository
+	authorization           ports.Authorization
+}
+
+func NewUpdatePaymentMethodCommandHandler(
+	paymentMethodRepository ports.PaymentMethodRepository,
+	authorization ports.Authorization) *UpdatePaymentMethodCommandHandler {
+	return &UpdatePaymentMethodCommandHandler{
+		paymentMethodRepository: paymentMethodRepository,
+		authorization:           authorization,
+	}
+}
+
+func (h UpdatePaymentMethodCommandHandler) Handle(
+	ctx context.Context,
+	command UpdatePaymentMethodCommand) result.Result[paymentmethod.PaymentMethod] {
+	existing, err := h.paymentMethodRepository.GetById(ctx, command.PaymentMethodID)
+	if err != nil {
+		return result.Fail[paymentmethod.PaymentMethod](err)
+	}
+	if existing == nil {
+		return result.Fail[paymentmethod.PaymentMethod](paymentmethod.ErrPaymentMethodNotFound)
+	}
+
+	permReq := h.authorization.Can(ctx, authorization.PermissionWrite)
+	if permReq == nil {
+		return result.Fail[paymentmethod.PaymentMethod](authorization.ErrUnauthorized)
+	}
+	if err = permReq.For(existing); err != nil {
+		return result.Fail[paymentmethod.PaymentMethod](err)
+	}
+
+	existing.SetType(command.Type)
+	existing.SetLabel(command.Label)
+	existing.SetLastFour(command.LastFour)
+	existing.SetExpiry(command.ExpiryMonth, command.ExpiryYear)
+	var updatedAt time.Time
+	if command.UpdatedAt != nil && command.UpdatedAt.IsSome() {
+		updatedAt = *command.UpdatedAt.Value()
+	} else {
+		updatedAt = time.Now()
+	}
+	existing.SetUpdatedAt(updatedAt)
+
+	if err := existing.GetValidationErrors(); err != nil {
+		return result.Fail[paymentmethod.PaymentMethod](err)
+	}
+
+	if err := h.paymentMethodRepository.Save(ctx, existing); err != nil {
+		return result.Fail[paymentmethod.PaymentMethod](err)
+	}
+
+	return result.Success(existing)
+}
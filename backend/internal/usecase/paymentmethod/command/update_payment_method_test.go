@@ -0,0 +1,64 @@
+package command_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	auth "github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/paymentmethod/command"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func TestUpdatePaymentMethod(t *testing.T) {
+	owner := types.NewPersonalOwner("userID-Test")
+
+	t.Run("renewed card", func(t *testing.T) {
+		repository := ports.NewMockPaymentMethodRepository(t)
+		authorization := ports.NewMockAuthorization(t)
+		permissionRequest := ports.NewMockPermissionRequest(t)
+
+		existing := paymentmethod.NewPaymentMethod(types.NewPaymentMethodID(), owner, paymentmethod.CardType,
+			"Visa", x.P("4242"), x.P(int32(3)), x.P(int32(2025)), time.Now(), time.Now())
+		existing.Clean()
+		repository.EXPECT().GetById(mock.Anything, existing.Id()).Return(existing, nil)
+		authorization.EXPECT().Can(mock.Anything, auth.PermissionWrite).Return(permissionRequest)
+		permissionRequest.EXPECT().For(existing).Return(nil)
+		repository.EXPECT().Save(mock.Anything, []paymentmethod.PaymentMethod{existing}).Return(nil)
+
+		handler := command.NewUpdatePaymentMethodCommandHandler(repository, authorization)
+		res := handler.Handle(t.Context(), command.UpdatePaymentMethodCommand{
+			PaymentMethodID: existing.Id(),
+			Type:            paymentmethod.CardType,
+			Label:           "Visa",
+			LastFour:        x.P("1881"),
+			ExpiryMonth:     x.P(int32(3)),
+			ExpiryYear:      x.P(int32(2029)),
+		})
+
+		assert.True(t, res.IsSuccess())
+		assert.Equal(t, int32(2029), *existing.ExpiryYear())
+		assert.Equal(t, "1881", *existing.LastFour())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repository := ports.NewMockPaymentMethodRepository(t)
+		authorization := ports.NewMockAuthorization(t)
+
+		id := types.NewPaymentMethodID()
+		repository.EXPECT().GetById(mock.Anything, id).Return(nil, nil)
+
+		handler := command.NewUpdatePaymentMethodCommandHandler(repository, authorization)
+		res := handler.Handle(t.Context(), command.UpdatePaymentMethodCommand{PaymentMethodID: id})
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, paymentmethod.ErrPaymentMethodNotFound)
+		})
+	})
+}
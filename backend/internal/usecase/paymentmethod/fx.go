@@ -0,0 +1,24 @@
+package paymentmethod
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/internal/usecase/paymentmethod/command"
+	"github.com/mistribe/subtracker/internal/usecase/paymentmethod/query"
+)
+
+func Module() fx.Option {
+	return fx.Module("app_payment_method",
+		fx.Provide(
+			ports.AsQueryHandler[query.FindAllQuery, shared.PaginatedResponse[paymentmethod.PaymentMethod]](query.NewFindAllQueryHandler),
+			ports.AsQueryHandler[query.FindOneQuery, paymentmethod.PaymentMethod](query.NewFindOneQueryHandler),
+			ports.AsQueryHandler[query.ExpiringQuery, []query.AffectedSubscription](query.NewExpiringQueryHandler),
+
+			ports.AsCommandHandler[command.CreatePaymentMethodCommand, paymentmethod.PaymentMethod](command.NewCreatePaymentMethodCommandHandler),
+			ports.AsCommandHandler[command.UpdatePaymentMethodCommand, paymentmethod.PaymentMethod](command.NewUpdatePaymentMethodCommandHandler),
+			ports.AsCommandHandler[command.DeletePaymentMethodCommand, bool](command.NewDeletePaymentMethodCommandHandler),
+		))
+}
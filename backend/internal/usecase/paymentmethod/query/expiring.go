@@ -0,0 +1,115 @@
+package query
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// ExpiringQuery lists the active subscriptions whose payment method expires before their next renewal
+type ExpiringQuery struct {
+	// PaymentMethodID restricts the result to the subscriptions charged to this payment method
+	PaymentMethodID *types.PaymentMethodID
+}
+
+func NewExpiringQuery(paymentMethodID *types.PaymentMethodID) ExpiringQuery {
+	return ExpiringQuery{
+		PaymentMethodID: paymentMethodID,
+	}
+}
+
+type AffectedSubscription struct {
+	Subscription     subscription.Subscription
+	PaymentMethod    paymentmethod.PaymentMethod
+	NextRenewalDate  time.Time
+	PaymentExpiresAt time.Time
+}
+
+type ExpiringQueryHandler struct {
+	subscriptionRepository  ports.SubscriptionRepository
+	paymentMethodRepository ports.PaymentMethodRepository
+	accountService          ports.AccountService
+	authentication          ports.Authentication
+}
+
+func NewExpiringQueryHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	paymentMethodRepository ports.PaymentMethodRepository,
+	accountService ports.AccountService,
+	authentication ports.Authentication) *ExpiringQueryHandler {
+	return &ExpiringQueryHandler{
+		subscriptionRepository:  subscriptionRepository,
+		paymentMethodRepository: paymentMethodRepository,
+		accountService:          accountService,
+		authentication:          authentication,
+	}
+}
+
+func (h ExpiringQueryHandler) Handle(
+	ctx context.Context,
+	query ExpiringQuery) result.Result[[]AffectedSubscription] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	userId := connectedAccount.UserID()
+
+	if query.PaymentMethodID != nil {
+		pm, err := h.paymentMethodRepository.GetByIdForUser(ctx, userId, *query.PaymentMethodID)
+		if err != nil {
+			return result.Fail[[]AffectedSubscription](err)
+		}
+		if pm == nil {
+			return result.Fail[[]AffectedSubscription](paymentmethod.ErrPaymentMethodNotFound)
+		}
+	}
+
+	timezone := h.accountService.GetTimezone(ctx, userId)
+	now := time.Now()
+	paymentMethods := make(map[types.PaymentMethodID]paymentmethod.PaymentMethod)
+	affected := make([]AffectedSubscription, 0)
+	for sub := range h.subscriptionRepository.GetAllIt(ctx, userId, "") {
+		paymentMethodId := sub.PaymentMethodId()
+		if paymentMethodId == nil || !sub.IsActiveAtIn(now, timezone) {
+			continue
+		}
+		if query.PaymentMethodID != nil && *query.PaymentMethodID != *paymentMethodId {
+			continue
+		}
+
+		pm, ok := paymentMethods[*paymentMethodId]
+		if !ok {
+			var err error
+			pm, err = h.paymentMethodRepository.GetByIdForUser(ctx, userId, *paymentMethodId)
+			if err != nil {
+				return result.Fail[[]AffectedSubscription](err)
+			}
+			paymentMethods[*paymentMethodId] = pm
+		}
+		// the payment method may belong to someone else, e.g. a family subscription paid with a personal card
+		if pm == nil || pm.ExpiresAt() == nil {
+			continue
+		}
+
+		nextRenewal := sub.GetNextRenewalDateIn(timezone)
+		if nextRenewal == nil || !pm.IsExpiredAt(*nextRenewal) {
+			continue
+		}
+
+		affected = append(affected, AffectedSubscription{
+			Subscription:     sub,
+			PaymentMethod:    pm,
+			NextRenewalDate:  *nextRenewal,
+			PaymentExpiresAt: *pm.ExpiresAt(),
+		})
+	}
+
+	sort.Slice(affected, func(i, j int) bool {
+		return affected[i].NextRenewalDate.Before(affected[j].NextRenewalDate)
+	})
+
+	return result.Success(affected)
+}
@@ -0,0 +1,135 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/paymentmethod/query"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func newChargedSubscription(
+	userId types.UserID,
+	startDate time.Time,
+	recurrency subscription.RecurrencyType,
+	paymentMethodId *types.PaymentMethodID) subscription.Subscription {
+	return subscription.NewSubscription(
+		types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+		subscription.NewPrice(currency.NewAmount(10, currency.EUR)), nil, nil,
+		types.NewPersonalOwner(userId), nil, nil, paymentMethodId, nil, nil,
+		startDate, nil, nil, recurrency, nil, nil, time.Now(), time.Now())
+}
+
+func newExpiringCard(userId types.UserID, expiresAt time.Time) paymentmethod.PaymentMethod {
+	return paymentmethod.NewPaymentMethod(types.NewPaymentMethodID(), types.NewPersonalOwner(userId),
+		paymentmethod.CardType, "Visa", x.P("4242"),
+		x.P(int32(expiresAt.Month())), x.P(int32(expiresAt.Year())), time.Now(), time.Now())
+}
+
+func TestExpiringQueryHandler_Handle(t *testing.T) {
+	userId := types.UserID("user-1")
+	now := time.Now()
+
+	newHandler := func(
+		t *testing.T,
+		subs []subscription.Subscription,
+		paymentMethods ...paymentmethod.PaymentMethod) *query.ExpiringQueryHandler {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		pmRepo := ports.NewMockPaymentMethodRepository(t)
+		accountService := ports.NewMockAccountService(t)
+		auth := ports.NewMockAuthentication(t)
+
+		acc := account.NewMockConnectedAccount(t)
+		acc.EXPECT().UserID().Return(userId)
+		auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc)
+		accountService.EXPECT().GetTimezone(mock.Anything, userId).Return(time.UTC).Maybe()
+		subRepo.EXPECT().GetAllIt(mock.Anything, userId, "").Return(func(yield func(subscription.Subscription) bool) {
+			for _, sub := range subs {
+				if !yield(sub) {
+					return
+				}
+			}
+		}).Maybe()
+		for _, pm := range paymentMethods {
+			pmRepo.EXPECT().GetByIdForUser(mock.Anything, userId, pm.Id()).Return(pm, nil).Maybe()
+		}
+
+		return query.NewExpiringQueryHandler(subRepo, pmRepo, accountService, auth)
+	}
+
+	t.Run("lists the subscriptions renewing after the card expires", func(t *testing.T) {
+		// expires at the end of the current month
+		expiring := newExpiringCard(userId, now)
+		valid := newExpiringCard(userId, now.AddDate(3, 0, 0))
+
+		monthly := newChargedSubscription(userId, now.AddDate(0, 0, -now.Day()+1).AddDate(0, -2, 0),
+			subscription.MonthlyRecurrency, x.P(expiring.Id()))
+		yearly := newChargedSubscription(userId, now.AddDate(0, -1, 0), subscription.YearlyRecurrency,
+			x.P(expiring.Id()))
+		onValidCard := newChargedSubscription(userId, now.AddDate(0, -1, 0), subscription.MonthlyRecurrency,
+			x.P(valid.Id()))
+		withoutPaymentMethod := newChargedSubscription(userId, now.AddDate(0, -1, 0),
+			subscription.MonthlyRecurrency, nil)
+
+		h := newHandler(t, []subscription.Subscription{yearly, onValidCard, monthly, withoutPaymentMethod},
+			expiring, valid)
+		res := h.Handle(t.Context(), query.NewExpiringQuery(nil))
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(affected []query.AffectedSubscription) {
+			require.Len(t, affected, 2)
+			assert.Equal(t, monthly.Id(), affected[0].Subscription.Id())
+			assert.Equal(t, yearly.Id(), affected[1].Subscription.Id())
+			assert.Equal(t, expiring.Id(), affected[0].PaymentMethod.Id())
+			assert.Equal(t, *expiring.ExpiresAt(), affected[0].PaymentExpiresAt)
+		})
+	})
+
+	t.Run("filters on the requested payment method", func(t *testing.T) {
+		first := newExpiringCard(userId, now)
+		second := newExpiringCard(userId, now)
+		onFirst := newChargedSubscription(userId, now.AddDate(0, -1, 0), subscription.YearlyRecurrency,
+			x.P(first.Id()))
+		onSecond := newChargedSubscription(userId, now.AddDate(0, -1, 0), subscription.YearlyRecurrency,
+			x.P(second.Id()))
+
+		h := newHandler(t, []subscription.Subscription{onFirst, onSecond}, first, second)
+		res := h.Handle(t.Context(), query.NewExpiringQuery(x.P(second.Id())))
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(affected []query.AffectedSubscription) {
+			require.Len(t, affected, 1)
+			assert.Equal(t, onSecond.Id(), affected[0].Subscription.Id())
+		})
+	})
+
+	t.Run("unknown payment method", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		pmRepo := ports.NewMockPaymentMethodRepository(t)
+		accountService := ports.NewMockAccountService(t)
+		auth := ports.NewMockAuthentication(t)
+		acc := account.NewMockConnectedAccount(t)
+		acc.EXPECT().UserID().Return(userId)
+		auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc)
+		id := types.NewPaymentMethodID()
+		pmRepo.EXPECT().GetByIdForUser(mock.Anything, userId, id).Return(nil, nil)
+
+		h := query.NewExpiringQueryHandler(subRepo, pmRepo, accountService, auth)
+		res := h.Handle(t.Context(), query.NewExpiringQuery(&id))
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, paymentmethod.ErrPaymentMethodNotFound)
+		})
+	})
+}
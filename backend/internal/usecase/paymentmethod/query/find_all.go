@@ -0,0 +1,52 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type FindAllQuery struct {
+	Limit      int64
+	Offset     int64
+	SearchText string
+}
+
+func NewFindAllQuery(
+	searchText string,
+	limit, offset int64) FindAllQuery {
+	return FindAllQuery{
+		SearchText: searchText,
+		Limit:      limit,
+		Offset:     offset,
+	}
+}
+
+type FindAllQueryHandler struct {
+	paymentMethodRepository ports.PaymentMethodRepository
+	authentication          ports.Authentication
+}
+
+func NewFindAllQueryHandler(
+	paymentMethodRepository ports.PaymentMethodRepository,
+	authentication ports.Authentication) *FindAllQueryHandler {
+	return &FindAllQueryHandler{
+		paymentMethodRepository: paymentMethodRepository,
+		authentication:          authentication,
+	}
+}
+
+func (h FindAllQueryHandler) Handle(
+	ctx context.Context,
+	query FindAllQuery) result.Result[shared.PaginatedResponse[paymentmethod.PaymentMethod]] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	params := ports.NewPaymentMethodQueryParameters(query.SearchText, query.Limit, query.Offset)
+	paymentMethods, count, err := h.paymentMethodRepository.GetAll(ctx, connectedAccount.UserID(), params)
+	if err != nil {
+		return result.Fail[shared.PaginatedResponse[paymentmethod.PaymentMethod]](err)
+	}
+	return result.Success(shared.NewPaginatedResponse(paymentMethods, count))
+}
@@ -0,0 +1,49 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type FindOneQuery struct {
+	PaymentMethodID types.PaymentMethodID
+}
+
+func NewFindOneQuery(paymentMethodID types.PaymentMethodID) FindOneQuery {
+	return FindOneQuery{
+		PaymentMethodID: paymentMethodID,
+	}
+}
+
+type FindOneQueryHandler struct {
+	paymentMethodRepository ports.PaymentMethodRepository
+	authentication          ports.Authentication
+}
+
+func NewFindOneQueryHandler(
+	paymentMethodRepository ports.PaymentMethodRepository,
+	authentication ports.Authentication) *FindOneQueryHandler {
+	return &FindOneQueryHandler{
+		paymentMethodRepository: paymentMethodRepository,
+		authentication:          authentication,
+	}
+}
+
+func (h FindOneQueryHandler) Handle(
+	ctx context.Context,
+	query FindOneQuery) result.Result[paymentmethod.PaymentMethod] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	pm, err := h.paymentMethodRepository.GetByIdForUser(ctx, connectedAccount.UserID(), query.PaymentMethodID)
+	if err != nil {
+		return result.Fail[paymentmethod.PaymentMethod](err)
+	}
+	if pm == nil {
+		return result.Fail[paymentmethod.PaymentMethod](paymentmethod.ErrPaymentMethodNotFound)
+	}
+
+	return result.Success(pm)
+}
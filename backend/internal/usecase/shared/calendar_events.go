@@ -0,0 +1,66 @@
+package shared
+
+import (
+	"context"
+	"sort"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+// CalendarEventBuilder lists the calendar events of the subscriptions of a user
+type CalendarEventBuilder struct {
+	subscriptionRepository ports.SubscriptionRepository
+	providerRepository     ports.ProviderRepository
+	accountService         ports.AccountService
+}
+
+func NewCalendarEventBuilder(
+	subscriptionRepository ports.SubscriptionRepository,
+	providerRepository ports.ProviderRepository,
+	accountService ports.AccountService) CalendarEventBuilder {
+	return CalendarEventBuilder{
+		subscriptionRepository: subscriptionRepository,
+		providerRepository:     providerRepository,
+		accountService:         accountService,
+	}
+}
+
+// Build returns the events sorted by date, the dates are in the timezone of the user
+func (b CalendarEventBuilder) Build(
+	ctx context.Context,
+	userId types.UserID,
+	renewals int) ([]calendar.Event, error) {
+	timezone := b.accountService.GetTimezone(ctx, userId)
+	providerNames := make(map[types.ProviderID]string)
+
+	events := make([]calendar.Event, 0)
+	for sub := range b.subscriptionRepository.GetAllIt(ctx, userId, "") {
+		title := ""
+		if sub.FriendlyName() != nil {
+			title = *sub.FriendlyName()
+		} else {
+			name, ok := providerNames[sub.ProviderId()]
+			if !ok {
+				provider, err := b.providerRepository.GetById(ctx, sub.ProviderId())
+				if err != nil {
+					return nil, err
+				}
+				if provider != nil {
+					name = provider.Name()
+				}
+				providerNames[sub.ProviderId()] = name
+			}
+			title = name
+		}
+
+		events = append(events, calendar.SubscriptionEvents(sub, title, renewals, timezone)...)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Date.Before(events[j].Date)
+	})
+
+	return events, nil
+}
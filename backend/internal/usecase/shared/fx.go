@@ -8,6 +8,8 @@ func Module() fx.Option {
 	return fx.Module("shared",
 		fx.Provide(
 			NewOwnerFactory,
+			NewCalendarEventBuilder,
+			NewWebhookPublisher,
 		),
 	)
 }
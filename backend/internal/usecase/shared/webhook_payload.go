@@ -0,0 +1,172 @@
+package shared
+
+import (
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/label"
+	"github.com/mistribe/subtracker/internal/domain/provider"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/pkg/decimal"
+)
+
+// The webhook data are the data of the webhook events, they are part of the public contract of the webhooks
+
+type WebhookOwnerData struct {
+	Type     string  `json:"type"`
+	FamilyId *string `json:"family_id,omitempty"`
+	UserId   *string `json:"user_id,omitempty"`
+}
+
+type WebhookAmountData struct {
+	Value    decimal.Decimal `json:"value"`
+	Currency string          `json:"currency"`
+}
+
+type WebhookSubscriptionData struct {
+	Id           string             `json:"id"`
+	FriendlyName *string            `json:"friendly_name,omitempty"`
+	ProviderId   string             `json:"provider_id"`
+	Owner        WebhookOwnerData   `json:"owner"`
+	Price        *WebhookAmountData `json:"price,omitempty"`
+	Recurrency   string             `json:"recurrency"`
+	StartDate    time.Time          `json:"start_date"`
+	EndDate      *time.Time         `json:"end_date,omitempty"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+}
+
+type WebhookLabelData struct {
+	Id        string           `json:"id"`
+	Name      string           `json:"name"`
+	Color     string           `json:"color"`
+	Owner     WebhookOwnerData `json:"owner"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+type WebhookProviderData struct {
+	Id        string           `json:"id"`
+	Name      string           `json:"name"`
+	Url       *string          `json:"url,omitempty"`
+	Owner     WebhookOwnerData `json:"owner"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+type WebhookFamilyMemberData struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type WebhookFamilyData struct {
+	Id        string                    `json:"id"`
+	Name      string                    `json:"name"`
+	Members   []WebhookFamilyMemberData `json:"members"`
+	UpdatedAt time.Time                 `json:"updated_at"`
+}
+
+// WebhookDeletedData is the data of the events of deleted aggregates
+type WebhookDeletedData struct {
+	Id string `json:"id"`
+}
+
+// WebhookRenewalData is the data of the renewal.due events
+type WebhookRenewalData struct {
+	SubscriptionId string             `json:"subscription_id"`
+	Title          string             `json:"title"`
+	Date           time.Time          `json:"date"`
+	Price          *WebhookAmountData `json:"price,omitempty"`
+}
+
+func newWebhookOwnerData(owner types.Owner) WebhookOwnerData {
+	p := WebhookOwnerData{Type: owner.Type().String()}
+	switch owner.Type() {
+	case types.FamilyOwnerType:
+		familyId := owner.FamilyId().String()
+		p.FamilyId = &familyId
+	case types.PersonalOwnerType:
+		userId := owner.UserId().String()
+		p.UserId = &userId
+	}
+	return p
+}
+
+func newWebhookAmountData(amount currency.Amount) *WebhookAmountData {
+	if amount == nil || !amount.IsValid() {
+		return nil
+	}
+	amount = amount.Round(currency.DefaultRounding)
+	return &WebhookAmountData{
+		Value:    amount.Decimal(),
+		Currency: amount.Currency().String(),
+	}
+}
+
+func NewWebhookSubscriptionData(sub subscription.Subscription) WebhookSubscriptionData {
+	p := WebhookSubscriptionData{
+		Id:           sub.Id().String(),
+		FriendlyName: sub.FriendlyName(),
+		ProviderId:   sub.ProviderId().String(),
+		Owner:        newWebhookOwnerData(sub.Owner()),
+		Recurrency:   sub.Recurrency().String(),
+		StartDate:    sub.StartDate(),
+		EndDate:      sub.EndDate(),
+		UpdatedAt:    sub.UpdatedAt(),
+	}
+	if sub.Price() != nil {
+		p.Price = newWebhookAmountData(sub.Price().Amount())
+	}
+	return p
+}
+
+func NewWebhookLabelData(lbl label.Label) WebhookLabelData {
+	return WebhookLabelData{
+		Id:        lbl.Id().String(),
+		Name:      lbl.Name(),
+		Color:     lbl.Color(),
+		Owner:     newWebhookOwnerData(lbl.Owner()),
+		UpdatedAt: lbl.UpdatedAt(),
+	}
+}
+
+func NewWebhookProviderData(prov provider.Provider) WebhookProviderData {
+	return WebhookProviderData{
+		Id:        prov.Id().String(),
+		Name:      prov.Name(),
+		Url:       prov.Url(),
+		Owner:     newWebhookOwnerData(prov.Owner()),
+		UpdatedAt: prov.UpdatedAt(),
+	}
+}
+
+func NewWebhookFamilyData(fam family.Family) WebhookFamilyData {
+	members := make([]WebhookFamilyMemberData, 0, fam.Members().Len())
+	for member := range fam.Members().It() {
+		members = append(members, WebhookFamilyMemberData{
+			Id:   member.Id().String(),
+			Name: member.Name(),
+			Type: member.Type().String(),
+		})
+	}
+	return WebhookFamilyData{
+		Id:        fam.Id().String(),
+		Name:      fam.Name(),
+		Members:   members,
+		UpdatedAt: fam.UpdatedAt(),
+	}
+}
+
+// NewWebhookRenewalData returns the data of the renewal.due event of a renewal
+func NewWebhookRenewalData(
+	subscriptionId types.SubscriptionID,
+	title string,
+	date time.Time,
+	price currency.Amount) WebhookRenewalData {
+	return WebhookRenewalData{
+		SubscriptionId: subscriptionId.String(),
+		Title:          title,
+		Date:           date,
+		Price:          newWebhookAmountData(price),
+	}
+}
@@ -0,0 +1,94 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+)
+
+// WebhookEvent is the body of the requests sent to the webhook endpoints
+type WebhookEvent struct {
+	Id        string            `json:"id"`
+	Type      webhook.EventType `json:"type"`
+	CreatedAt time.Time         `json:"created_at"`
+	Data      any               `json:"data"`
+}
+
+// WebhookPublisher enqueues the deliveries of the events of an account to its endpoints, the deliveries are
+// attempted in the background
+type WebhookPublisher struct {
+	endpointRepository ports.WebhookEndpointRepository
+	deliveryRepository ports.WebhookDeliveryRepository
+}
+
+func NewWebhookPublisher(
+	endpointRepository ports.WebhookEndpointRepository,
+	deliveryRepository ports.WebhookDeliveryRepository) WebhookPublisher {
+	return WebhookPublisher{
+		endpointRepository: endpointRepository,
+		deliveryRepository: deliveryRepository,
+	}
+}
+
+// Publish enqueues the event for the endpoints of the users that accept it and returns how many deliveries were
+// enqueued. An event is delivered once to an endpoint however many times it is published with the same id.
+func (p WebhookPublisher) Publish(
+	ctx context.Context,
+	userIds []types.UserID,
+	event WebhookEvent) (int, error) {
+	var endpoints []webhook.Endpoint
+	for _, userId := range userIds {
+		userEndpoints, err := p.endpointRepository.GetAllByUserId(ctx, userId)
+		if err != nil {
+			return 0, err
+		}
+		endpoints = append(endpoints, userEndpoints...)
+	}
+
+	return p.enqueue(ctx, endpoints, event)
+}
+
+// PublishTo enqueues the event for the given endpoints that accept it
+func (p WebhookPublisher) PublishTo(
+	ctx context.Context,
+	endpoints []webhook.Endpoint,
+	event WebhookEvent) (int, error) {
+	return p.enqueue(ctx, endpoints, event)
+}
+
+func (p WebhookPublisher) enqueue(
+	ctx context.Context,
+	endpoints []webhook.Endpoint,
+	event WebhookEvent) (int, error) {
+	var payload []byte
+	enqueued := 0
+	for _, endpoint := range endpoints {
+		if !endpoint.Accepts(event.Type) {
+			continue
+		}
+
+		// The payload is only encoded when at least one endpoint accepts the event
+		if payload == nil {
+			var err error
+			payload, err = json.Marshal(event)
+			if err != nil {
+				return enqueued, err
+			}
+		}
+
+		ok, err := p.deliveryRepository.Enqueue(ctx,
+			webhook.NewPendingDelivery(endpoint.Id(), event.Id, event.Type, payload))
+		if err != nil {
+			return enqueued, err
+		}
+		if ok {
+			enqueued++
+		}
+	}
+
+	return enqueued, nil
+}
@@ -0,0 +1,109 @@
+package command_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+)
+
+func TestAddPriceChangeCommandHandler_Handle(t *testing.T) {
+	t.Run("returns fault when repository GetById returns error", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(nil, errors.New("db error"))
+
+		h := command.NewAddPriceChangeCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.AddPriceChangeCommand{SubscriptionID: existing.Id()})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("returns fault when subscription not found", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(nil, nil)
+
+		h := command.NewAddPriceChangeCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.AddPriceChangeCommand{SubscriptionID: existing.Id()})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("returns fault when permission denied", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(authorization.ErrUnauthorized)
+
+		h := command.NewAddPriceChangeCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.AddPriceChangeCommand{
+			SubscriptionID: existing.Id(),
+			EffectiveFrom:  time.Now(),
+			Amount:         currency.NewAmount(12, currency.EUR),
+		})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("returns fault when the price change is before the start date", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+
+		h := command.NewAddPriceChangeCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.AddPriceChangeCommand{
+			SubscriptionID: existing.Id(),
+			EffectiveFrom:  existing.StartDate().AddDate(0, -1, 0),
+			Amount:         currency.NewAmount(12, currency.EUR),
+		})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("records the price change and saves the subscription", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+		subRepo.EXPECT().Save(t.Context(), mock.Anything).Return(nil)
+
+		h := command.NewAddPriceChangeCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.AddPriceChangeCommand{
+			SubscriptionID: existing.Id(),
+			EffectiveFrom:  time.Now().Add(-time.Minute),
+			Amount:         currency.NewAmount(12, currency.EUR),
+		})
+
+		assert.True(t, res.IsSuccess())
+		res.IfSuccess(func(sub subscription.Subscription) {
+			assert.Equal(t, 1, sub.PriceHistory().Len())
+			assert.Equal(t, 12.0, sub.GetPrice().Value())
+		})
+	})
+}
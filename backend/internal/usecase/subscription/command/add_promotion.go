@@ -0,0 +1,92 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type AddPromotionCommand struct {
+	SubscriptionID types.SubscriptionID
+	// StartDate defaults to the subscription start date for an introductory offer
+	StartDate *time.Time
+	// EndDate and DurationMonths are mutually exclusive, one of them is required
+	EndDate        *time.Time
+	DurationMonths *int32
+	Amount         currency.Amount
+	UpdatedAt      option.Option[time.Time]
+}
+
+type AddPromotionCommandHandler struct {
+	subscriptionRepository ports.SubscriptionRepository
+	authorization          ports.Authorization
+}
+
+func NewAddPromotionCommandHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	authorization ports.Authorization) *AddPromotionCommandHandler {
+	return &AddPromotionCommandHandler{
+		subscriptionRepository: subscriptionRepository,
+		authorization:          authorization,
+	}
+}
+
+func (h AddPromotionCommandHandler) Handle(
+	ctx context.Context,
+	cmd AddPromotionCommand) result.Result[subscription.Subscription] {
+	sub, err := h.subscriptionRepository.GetById(ctx, cmd.SubscriptionID)
+	if err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+	if sub == nil {
+		return result.Fail[subscription.Subscription](subscription.ErrSubscriptionNotFound)
+	}
+
+	err = h.authorization.Can(ctx, authorization.PermissionWrite).For(sub)
+	if err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+
+	startDate := sub.StartDate()
+	if cmd.StartDate != nil {
+		startDate = *cmd.StartDate
+	}
+	var endDate time.Time
+	switch {
+	case cmd.EndDate != nil && cmd.DurationMonths == nil:
+		endDate = *cmd.EndDate
+	case cmd.DurationMonths != nil && cmd.EndDate == nil:
+		endDate = startDate.AddDate(0, int(*cmd.DurationMonths), 0)
+	default:
+		return result.Fail[subscription.Subscription](subscription.ErrPromotionDurationRequired)
+	}
+
+	if err := sub.AddPromotion(startDate, endDate, cmd.Amount); err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+
+	var updatedAt time.Time
+	if cmd.UpdatedAt != nil && cmd.UpdatedAt.IsSome() {
+		updatedAt = *cmd.UpdatedAt.Value()
+	} else {
+		updatedAt = time.Now()
+	}
+	sub.SetUpdatedAt(updatedAt)
+
+	if err := sub.GetValidationErrors(); err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+
+	if err := h.subscriptionRepository.Save(ctx, sub); err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+
+	return result.Success(sub)
+}
@@ -0,0 +1,122 @@
+package command_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+func TestAddPromotionCommandHandler_Handle(t *testing.T) {
+	t.Run("returns fault when repository GetById returns error", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(nil, errors.New("db error"))
+
+		h := command.NewAddPromotionCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.AddPromotionCommand{SubscriptionID: existing.Id()})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("returns fault when subscription not found", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(nil, nil)
+
+		h := command.NewAddPromotionCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.AddPromotionCommand{SubscriptionID: existing.Id()})
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, subscription.ErrSubscriptionNotFound)
+		})
+	})
+
+	t.Run("returns fault when permission denied", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(authorization.ErrUnauthorized)
+
+		h := command.NewAddPromotionCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.AddPromotionCommand{
+			SubscriptionID: existing.Id(),
+			DurationMonths: x.P(int32(3)),
+			Amount:         currency.NewAmount(5, currency.USD),
+		})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("returns fault without duration nor end date", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+
+		h := command.NewAddPromotionCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.AddPromotionCommand{
+			SubscriptionID: existing.Id(),
+			Amount:         currency.NewAmount(5, currency.USD),
+		})
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, subscription.ErrPromotionDurationRequired)
+		})
+	})
+
+	t.Run("adds an introductory promotion from the start date", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+		subRepo.EXPECT().Save(t.Context(), mock.Anything).Return(nil)
+
+		h := command.NewAddPromotionCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.AddPromotionCommand{
+			SubscriptionID: existing.Id(),
+			DurationMonths: x.P(int32(3)),
+			Amount:         currency.NewAmount(5, currency.USD),
+		})
+
+		assert.True(t, res.IsSuccess())
+		res.IfSuccess(func(sub subscription.Subscription) {
+			if assert.Equal(t, 1, sub.Promotions().Len()) {
+				promotion := sub.Promotions().GetAt(0)
+				assert.True(t, promotion.StartDate.Equal(sub.StartDate()))
+				assert.True(t, promotion.EndDate.Equal(sub.StartDate().AddDate(0, 3, 0)))
+			}
+			assert.Equal(t, 5.0, sub.GetPrice().Value())
+			if assert.NotNil(t, sub.PromotionEndDate()) {
+				assert.True(t, sub.PromotionEndDate().After(time.Now()))
+			}
+		})
+	})
+}
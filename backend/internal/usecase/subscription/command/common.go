@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
 	"github.com/mistribe/subtracker/internal/domain/subscription"
 	"github.com/mistribe/subtracker/internal/domain/types"
 	"github.com/mistribe/subtracker/internal/ports"
@@ -49,3 +50,22 @@ func ensureFamilyMemberExists(
 	}
 	return family.ErrFamilyMemberNotFound
 }
+
+// ensurePaymentMethodExists checks the payment method exists and is visible to the connected user
+func ensurePaymentMethodExists(
+	ctx context.Context,
+	paymentMethodRepository ports.PaymentMethodRepository,
+	userId types.UserID,
+	paymentMethodId *types.PaymentMethodID) error {
+	if paymentMethodId == nil {
+		return nil
+	}
+	pm, err := paymentMethodRepository.GetByIdForUser(ctx, userId, *paymentMethodId)
+	if err != nil {
+		return err
+	}
+	if pm == nil {
+		return paymentmethod.ErrPaymentMethodNotFound
+	}
+	return nil
+}
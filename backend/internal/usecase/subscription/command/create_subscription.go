@@ -20,29 +20,34 @@ type CreateSubscriptionCommand struct {
 	SubscriptionID   option.Option[types.SubscriptionID]
 	FriendlyName     *string
 	FreeTrial        subscription.FreeTrial
+	Contract         subscription.Contract
 	ProviderID       *types.ProviderID
 	ProviderKey      *string
 	Price            currency.Amount
 	Owner            types.OwnerType
 	PayerType        *subscription.PayerType
 	PayerMemberId    *types.FamilyMemberID
+	CostSplit        subscription.CostSplit
+	PaymentMethodID  *types.PaymentMethodID
 	FamilyUsers      []types.FamilyMemberID
 	Labels           []types.LabelID
 	StartDate        time.Time
 	EndDate          *time.Time
 	Recurrency       subscription.RecurrencyType
-	CustomRecurrency *int32
+	CustomRecurrency *subscription.Interval
+	BillingAnchorDay *int32
 	CreatedAt        option.Option[time.Time]
 }
 
 type CreateSubscriptionCommandHandler struct {
-	subscriptionRepository ports.SubscriptionRepository
-	authorization          ports.Authorization
-	authentication         ports.Authentication
-	familyRepository       ports.FamilyRepository
-	entitlement            ports.EntitlementResolver
-	ownerFactory           shared.OwnerFactory
-	providerRepository     ports.ProviderRepository
+	subscriptionRepository  ports.SubscriptionRepository
+	authorization           ports.Authorization
+	authentication          ports.Authentication
+	familyRepository        ports.FamilyRepository
+	entitlement             ports.EntitlementResolver
+	ownerFactory            shared.OwnerFactory
+	providerRepository      ports.ProviderRepository
+	paymentMethodRepository ports.PaymentMethodRepository
 }
 
 func NewCreateSubscriptionCommandHandler(
@@ -52,15 +57,17 @@ func NewCreateSubscriptionCommandHandler(
 	authentication ports.Authentication,
 	ownerFactory shared.OwnerFactory,
 	providerRepository ports.ProviderRepository,
-	entitlement ports.EntitlementResolver) *CreateSubscriptionCommandHandler {
+	entitlement ports.EntitlementResolver,
+	paymentMethodRepository ports.PaymentMethodRepository) *CreateSubscriptionCommandHandler {
 	return &CreateSubscriptionCommandHandler{
-		subscriptionRepository: subscriptionRepository,
-		familyRepository:       familyRepository,
-		authorization:          authorization,
-		authentication:         authentication,
-		entitlement:            entitlement,
-		ownerFactory:           ownerFactory,
-		providerRepository:     providerRepository,
+		subscriptionRepository:  subscriptionRepository,
+		familyRepository:        familyRepository,
+		authorization:           authorization,
+		authentication:          authentication,
+		entitlement:             entitlement,
+		ownerFactory:            ownerFactory,
+		providerRepository:      providerRepository,
+		paymentMethodRepository: paymentMethodRepository,
 	}
 }
 
@@ -122,16 +129,23 @@ func (h CreateSubscriptionCommandHandler) Handle(
 		subscriptionID,
 		cmd.FriendlyName,
 		cmd.FreeTrial,
+		cmd.Contract,
 		providerID,
 		price,
+		nil,
+		nil,
 		owner,
 		payer,
+		nil,
+		cmd.PaymentMethodID,
 		cmd.FamilyUsers,
 		subscription.NewSubscriptionLabelRefs(cmd.Labels),
 		cmd.StartDate,
 		cmd.EndDate,
+		nil,
 		cmd.Recurrency,
 		cmd.CustomRecurrency,
+		cmd.BillingAnchorDay,
 		createdAt,
 		createdAt,
 	)
@@ -141,6 +155,17 @@ func (h CreateSubscriptionCommandHandler) Handle(
 		return result.Fail[subscription.Subscription](err)
 	}
 
+	if cmd.CostSplit != nil {
+		if err := newSub.SetCostSplit(cmd.CostSplit); err != nil {
+			return result.Fail[subscription.Subscription](err)
+		}
+	}
+
+	if err := ensurePaymentMethodExists(ctx, h.paymentMethodRepository, connectedUserId,
+		cmd.PaymentMethodID); err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+
 	// Duplication check only after permission granted
 	if cmd.SubscriptionID != nil && cmd.SubscriptionID.IsSome() {
 		sub, err := h.subscriptionRepository.GetById(ctx, subscriptionID)
@@ -12,6 +12,7 @@ import (
 	"github.com/mistribe/subtracker/internal/domain/account"
 	"github.com/mistribe/subtracker/internal/domain/authorization"
 	"github.com/mistribe/subtracker/internal/domain/billing"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
 	"github.com/mistribe/subtracker/internal/domain/subscription"
 	"github.com/mistribe/subtracker/internal/domain/types"
 	"github.com/mistribe/subtracker/internal/ports"
@@ -26,16 +27,23 @@ func newPersonalSubscription() subscription.Subscription {
 		types.NewSubscriptionID(),
 		&name,
 		nil,
+		nil,
 		types.ProviderID(uuid.Must(uuid.NewV7())),
 		nil,
+		nil,
+		nil,
 		types.NewPersonalOwner(types.UserID("userID-Test")),
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
 		time.Now().Add(-time.Hour),
 		nil,
+		nil,
 		subscription.MonthlyRecurrency,
 		nil,
+		nil,
 		time.Now(),
 		time.Now(),
 	)
@@ -51,16 +59,23 @@ func newFamilySubscriptionWithMembers(familyId uuid.UUID, members []uuid.UUID) s
 		types.NewSubscriptionID(),
 		&name,
 		nil,
+		nil,
 		types.ProviderID(uuid.Must(uuid.NewV7())),
 		nil,
+		nil,
+		nil,
 		types.NewFamilyOwner(types.FamilyID(familyId)),
 		nil,
+		nil,
+		nil,
 		typedMembers,
 		nil,
 		time.Now().Add(-time.Hour),
 		nil,
+		nil,
 		subscription.MonthlyRecurrency,
 		nil,
+		nil,
 		time.Now(),
 		time.Now(),
 	)
@@ -87,7 +102,7 @@ func TestCreateSubscriptionCommandHandler_Handle(t *testing.T) {
 		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
 		perm.EXPECT().For(mock.Anything).Return(authorization.ErrUnauthorized)
 
-		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement)
+		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement, ports.NewMockPaymentMethodRepository(t))
 		providerID := sub.ProviderId()
 		cmd := command.CreateSubscriptionCommand{SubscriptionID: option.Some(sub.Id()), FriendlyName: sub.FriendlyName(), ProviderID: &providerID, Owner: sub.Owner().Type(), StartDate: sub.StartDate(), Recurrency: sub.Recurrency(), CreatedAt: option.Some(time.Now())}
 		res := h.Handle(t.Context(), cmd)
@@ -115,7 +130,7 @@ func TestCreateSubscriptionCommandHandler_Handle(t *testing.T) {
 		// Expect duplication check after authorization
 		subRepo.EXPECT().GetById(t.Context(), mock.Anything).Return(nil, errors.New("db error"))
 
-		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement)
+		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement, ports.NewMockPaymentMethodRepository(t))
 		providerID := sub.ProviderId()
 		cmd := command.CreateSubscriptionCommand{SubscriptionID: option.Some(sub.Id()), FriendlyName: sub.FriendlyName(), ProviderID: &providerID, Owner: sub.Owner().Type(), StartDate: sub.StartDate(), Recurrency: sub.Recurrency(), CreatedAt: option.Some(time.Now())}
 		res := h.Handle(t.Context(), cmd)
@@ -142,7 +157,7 @@ func TestCreateSubscriptionCommandHandler_Handle(t *testing.T) {
 		perm.EXPECT().For(mock.Anything).Return(nil)
 		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
 
-		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement)
+		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement, ports.NewMockPaymentMethodRepository(t))
 		providerID := existing.ProviderId()
 		cmd := command.CreateSubscriptionCommand{SubscriptionID: option.Some(existing.Id()), FriendlyName: existing.FriendlyName(), ProviderID: &providerID, Owner: existing.Owner().Type(), StartDate: existing.StartDate(), Recurrency: existing.Recurrency(), CreatedAt: option.Some(time.Now())}
 		res := h.Handle(t.Context(), cmd)
@@ -173,7 +188,7 @@ func TestCreateSubscriptionCommandHandler_Handle(t *testing.T) {
 		subRepo.EXPECT().GetById(t.Context(), mock.Anything).Return(nil, nil)
 		entitlement.EXPECT().CheckQuota(t.Context(), billing.FeatureIdActiveSubscriptionsCount, int64(1)).Return(false, billing.EffectiveEntitlement{}, errors.New("limit"))
 
-		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement)
+		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement, ports.NewMockPaymentMethodRepository(t))
 		providerID := sub.ProviderId()
 		cmd := command.CreateSubscriptionCommand{SubscriptionID: option.Some(sub.Id()), FriendlyName: sub.FriendlyName(), ProviderID: &providerID, Owner: sub.Owner().Type(), StartDate: sub.StartDate(), Recurrency: sub.Recurrency(), CreatedAt: option.Some(time.Now())}
 		res := h.Handle(t.Context(), cmd)
@@ -205,7 +220,7 @@ func TestCreateSubscriptionCommandHandler_Handle(t *testing.T) {
 		// expect MemberExists check and return false
 		familyRepo.EXPECT().MemberExists(t.Context(), types.FamilyID(famID), []types.FamilyMemberID{types.FamilyMemberID(member)}).Return(false, nil)
 
-		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement)
+		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement, ports.NewMockPaymentMethodRepository(t))
 		providerID := sub.ProviderId()
 		cmd := command.CreateSubscriptionCommand{SubscriptionID: option.Some(sub.Id()), FriendlyName: sub.FriendlyName(), ProviderID: &providerID, Owner: sub.Owner().Type(), StartDate: sub.StartDate(), Recurrency: sub.Recurrency(), CreatedAt: option.Some(time.Now()), FamilyUsers: sub.FamilyUsers().Values()}
 		res := h.Handle(t.Context(), cmd)
@@ -236,7 +251,7 @@ func TestCreateSubscriptionCommandHandler_Handle(t *testing.T) {
 		ownerFactory.EXPECT().Resolve(mock.Anything, mock.Anything).Return(sub.Owner(), nil).Maybe()
 		familyRepo.EXPECT().MemberExists(t.Context(), types.FamilyID(famID), mock.Anything).Return(false, errors.New("repo error"))
 
-		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement)
+		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement, ports.NewMockPaymentMethodRepository(t))
 		providerID := sub.ProviderId()
 		cmd := command.CreateSubscriptionCommand{SubscriptionID: option.Some(sub.Id()), FriendlyName: sub.FriendlyName(), ProviderID: &providerID, Owner: sub.Owner().Type(), StartDate: sub.StartDate(), Recurrency: sub.Recurrency(), CreatedAt: option.Some(time.Now()), FamilyUsers: sub.FamilyUsers().Values()}
 		res := h.Handle(t.Context(), cmd)
@@ -266,7 +281,7 @@ func TestCreateSubscriptionCommandHandler_Handle(t *testing.T) {
 		providerID := types.ProviderID(uuid.Must(uuid.NewV7()))
 		owner := types.NewPersonalOwner(types.UserID(userId))
 		ownerFactory.EXPECT().Resolve(mock.Anything, mock.Anything).Return(owner, nil).Maybe()
-		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement)
+		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement, ports.NewMockPaymentMethodRepository(t))
 		cmd := command.CreateSubscriptionCommand{FriendlyName: &name, ProviderID: &providerID, Owner: types.PersonalOwnerType, StartDate: time.Now().Add(-time.Hour), Recurrency: subscription.CustomRecurrency}
 		res := h.Handle(t.Context(), cmd)
 
@@ -296,7 +311,7 @@ func TestCreateSubscriptionCommandHandler_Handle(t *testing.T) {
 		subRepo.EXPECT().Save(t.Context(),
 			mock.MatchedBy(func(entities []subscription.Subscription) bool { return len(entities) == 1 })).Return(errors.New("save failed"))
 
-		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement)
+		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement, ports.NewMockPaymentMethodRepository(t))
 		providerID := sub.ProviderId()
 		cmd := command.CreateSubscriptionCommand{SubscriptionID: option.Some(sub.Id()), FriendlyName: sub.FriendlyName(), ProviderID: &providerID, Owner: sub.Owner().Type(), StartDate: sub.StartDate(), Recurrency: sub.Recurrency(), CreatedAt: option.Some(time.Now())}
 		res := h.Handle(t.Context(), cmd)
@@ -327,11 +342,43 @@ func TestCreateSubscriptionCommandHandler_Handle(t *testing.T) {
 		subRepo.EXPECT().Save(t.Context(),
 			mock.MatchedBy(func(entities []subscription.Subscription) bool { return len(entities) == 1 })).Return(nil)
 
-		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement)
+		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement, ports.NewMockPaymentMethodRepository(t))
 		providerID := sub.ProviderId()
 		cmd := command.CreateSubscriptionCommand{SubscriptionID: option.Some(sub.Id()), FriendlyName: sub.FriendlyName(), ProviderID: &providerID, Owner: sub.Owner().Type(), StartDate: sub.StartDate(), Recurrency: sub.Recurrency(), CreatedAt: option.Some(time.Now())}
 		res := h.Handle(t.Context(), cmd)
 
 		assert.True(t, res.IsSuccess())
 	})
+
+	t.Run("returns fault when payment method is unknown", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		familyRepo := ports.NewMockFamilyRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		auth := ports.NewMockAuthentication(t)
+		ownerFactory := shared.NewMockOwnerFactory(t)
+		providerRepo := ports.NewMockProviderRepository(t)
+		entitlement := ports.NewMockEntitlementResolver(t)
+		paymentMethodRepo := ports.NewMockPaymentMethodRepository(t)
+		perm := ports.NewMockPermissionRequest(t)
+		connectedAccount := account.NewMockConnectedAccount(t)
+		connectedAccount.EXPECT().UserID().Return(types.UserID(userId))
+		auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(connectedAccount)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+		paymentMethodID := types.NewPaymentMethodID()
+		paymentMethodRepo.EXPECT().GetByIdForUser(t.Context(), types.UserID(userId), paymentMethodID).Return(nil, nil)
+
+		sub := newPersonalSubscription()
+		ownerFactory.EXPECT().Resolve(mock.Anything, mock.Anything).Return(sub.Owner(), nil).Maybe()
+
+		h := command.NewCreateSubscriptionCommandHandler(subRepo, authz, familyRepo, auth, ownerFactory, providerRepo, entitlement, paymentMethodRepo)
+		providerID := sub.ProviderId()
+		cmd := command.CreateSubscriptionCommand{SubscriptionID: option.Some(sub.Id()), FriendlyName: sub.FriendlyName(), ProviderID: &providerID, PaymentMethodID: &paymentMethodID, Owner: sub.Owner().Type(), StartDate: sub.StartDate(), Recurrency: sub.Recurrency(), CreatedAt: option.Some(time.Now())}
+		res := h.Handle(t.Context(), cmd)
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, paymentmethod.ErrPaymentMethodNotFound)
+		})
+	})
 }
@@ -0,0 +1,431 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/billing"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/label"
+	"github.com/mistribe/subtracker/internal/domain/provider"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+	"github.com/mistribe/subtracker/pkg/x/validation"
+)
+
+type ImportRowStatus string
+
+const (
+	// ImportRowStatusCreated means the row has been persisted
+	ImportRowStatusCreated ImportRowStatus = "created"
+	// ImportRowStatusValid means the row would have been created (dry-run)
+	ImportRowStatusValid ImportRowStatus = "valid"
+	// ImportRowStatusFailed means the row has been rejected, see Errors
+	ImportRowStatusFailed ImportRowStatus = "failed"
+)
+
+func (s ImportRowStatus) String() string {
+	return string(s)
+}
+
+// ImportSubscriptionRow is a single subscription to import.
+// Providers are referenced by key and labels by name, as produced by the export.
+type ImportSubscriptionRow struct {
+	Index            int
+	SubscriptionID   option.Option[types.SubscriptionID]
+	ProviderKey      string
+	FriendlyName     *string
+	FreeTrial        subscription.FreeTrial
+	Price            currency.Amount
+	Owner            types.OwnerType
+	PayerType        *subscription.PayerType
+	PayerMemberId    *types.FamilyMemberID
+	FamilyUsers      []types.FamilyMemberID
+	Labels           []string
+	StartDate        time.Time
+	EndDate          *time.Time
+	Recurrency       subscription.RecurrencyType
+	CustomRecurrency *subscription.Interval
+	BillingAnchorDay *int32
+	Contract         subscription.Contract
+	CostSplit        subscription.CostSplit
+	PaymentMethodID  *types.PaymentMethodID
+	PriceHistory     []subscription.PriceChange
+	Promotions       []subscription.Promotion
+	Pauses           []subscription.Pause
+}
+
+type ImportSubscriptionsCommand struct {
+	Rows   []ImportSubscriptionRow
+	DryRun bool
+}
+
+type ImportSubscriptionRowResult struct {
+	Index          int
+	SubscriptionID types.SubscriptionID
+	Status         ImportRowStatus
+	Errors         []error
+	// Subscription is the persisted subscription, nil unless the row has been created
+	Subscription subscription.Subscription
+}
+
+type ImportSubscriptionsResult struct {
+	DryRun bool
+	Rows   []ImportSubscriptionRowResult
+}
+
+// Created returns the subscriptions persisted by the import
+func (r ImportSubscriptionsResult) Created() []subscription.Subscription {
+	var created []subscription.Subscription
+	for _, row := range r.Rows {
+		if row.Status == ImportRowStatusCreated {
+			created = append(created, row.Subscription)
+		}
+	}
+	return created
+}
+
+type ImportSubscriptionsCommandHandler struct {
+	subscriptionRepository  ports.SubscriptionRepository
+	providerRepository      ports.ProviderRepository
+	labelRepository         ports.LabelRepository
+	familyRepository        ports.FamilyRepository
+	paymentMethodRepository ports.PaymentMethodRepository
+	authorization           ports.Authorization
+	authentication          ports.Authentication
+	entitlement             ports.EntitlementResolver
+	ownerFactory            shared.OwnerFactory
+}
+
+func NewImportSubscriptionsCommandHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	providerRepository ports.ProviderRepository,
+	labelRepository ports.LabelRepository,
+	familyRepository ports.FamilyRepository,
+	paymentMethodRepository ports.PaymentMethodRepository,
+	authorization ports.Authorization,
+	authentication ports.Authentication,
+	entitlement ports.EntitlementResolver,
+	ownerFactory shared.OwnerFactory) *ImportSubscriptionsCommandHandler {
+	return &ImportSubscriptionsCommandHandler{
+		subscriptionRepository:  subscriptionRepository,
+		providerRepository:      providerRepository,
+		labelRepository:         labelRepository,
+		familyRepository:        familyRepository,
+		paymentMethodRepository: paymentMethodRepository,
+		authorization:           authorization,
+		authentication:          authentication,
+		entitlement:             entitlement,
+		ownerFactory:            ownerFactory,
+	}
+}
+
+// importContext memoizes lookups shared by all the rows of a single import
+type importContext struct {
+	account   account.ConnectedAccount
+	owners    map[types.OwnerType]types.Owner
+	providers map[string]provider.Provider
+	labels    map[string]types.LabelID
+	seenIds   herd.Set[types.SubscriptionID]
+}
+
+func (h ImportSubscriptionsCommandHandler) Handle(
+	ctx context.Context,
+	cmd ImportSubscriptionsCommand) result.Result[ImportSubscriptionsResult] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+
+	allowed, err := h.entitlement.CheckBoolean(ctx, connectedAccount, billing.FeatureIdImportSubscriptions)
+	if err != nil {
+		return result.Fail[ImportSubscriptionsResult](err)
+	}
+	if !allowed {
+		return result.Fail[ImportSubscriptionsResult](subscription.ErrImportNotAllowed)
+	}
+
+	labels, err := h.loadLabels(ctx, connectedAccount.UserID())
+	if err != nil {
+		return result.Fail[ImportSubscriptionsResult](err)
+	}
+
+	ictx := &importContext{
+		account:   connectedAccount,
+		owners:    make(map[types.OwnerType]types.Owner),
+		providers: make(map[string]provider.Provider),
+		labels:    labels,
+		seenIds:   herd.NewSet[types.SubscriptionID](),
+	}
+
+	report := ImportSubscriptionsResult{
+		DryRun: cmd.DryRun,
+		Rows:   make([]ImportSubscriptionRowResult, len(cmd.Rows)),
+	}
+	subscriptions := make([]subscription.Subscription, len(cmd.Rows))
+	for i, row := range cmd.Rows {
+		sub, errs := h.buildSubscription(ctx, ictx, row)
+		rowResult := ImportSubscriptionRowResult{
+			Index:  row.Index,
+			Status: ImportRowStatusValid,
+		}
+		if sub != nil {
+			rowResult.SubscriptionID = sub.Id()
+		}
+		if len(errs) > 0 {
+			rowResult.Status = ImportRowStatusFailed
+			rowResult.Errors = errs
+		} else {
+			subscriptions[i] = sub
+		}
+		report.Rows[i] = rowResult
+	}
+
+	if err := h.applyQuota(ctx, connectedAccount, subscriptions, report.Rows); err != nil {
+		return result.Fail[ImportSubscriptionsResult](err)
+	}
+
+	if cmd.DryRun {
+		return result.Success(report)
+	}
+
+	for i, sub := range subscriptions {
+		if report.Rows[i].Status != ImportRowStatusValid {
+			continue
+		}
+		if err := h.subscriptionRepository.Save(ctx, sub); err != nil {
+			report.Rows[i].Status = ImportRowStatusFailed
+			report.Rows[i].Errors = []error{err}
+			continue
+		}
+		report.Rows[i].Status = ImportRowStatusCreated
+		report.Rows[i].Subscription = sub
+	}
+
+	return result.Success(report)
+}
+
+// loadLabels indexes every label visible to the user by name
+func (h ImportSubscriptionsCommandHandler) loadLabels(
+	ctx context.Context,
+	userId types.UserID) (map[string]types.LabelID, error) {
+	labels, _, err := h.labelRepository.GetAll(ctx, userId, ports.NewLabelQueryParameters("", -1, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]label.Label, len(labels))
+	for _, lbl := range labels {
+		key := strings.ToLower(strings.TrimSpace(lbl.Name()))
+		// Personal and family labels take precedence over system labels with the same name
+		if existing, ok := byName[key]; ok && existing.Owner().Type() != types.SystemOwnerType {
+			continue
+		}
+		byName[key] = lbl
+	}
+
+	names := make(map[string]types.LabelID, len(byName))
+	for key, lbl := range byName {
+		names[key] = lbl.Id()
+	}
+
+	return names, nil
+}
+
+func (h ImportSubscriptionsCommandHandler) buildSubscription(
+	ctx context.Context,
+	ictx *importContext,
+	row ImportSubscriptionRow) (subscription.Subscription, []error) {
+	var errs []error
+
+	subscriptionID := types.NewSubscriptionID()
+	if row.SubscriptionID != nil && row.SubscriptionID.IsSome() {
+		subscriptionID = *row.SubscriptionID.Value()
+	}
+
+	owner, err := h.resolveOwner(ctx, ictx, row.Owner)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	var payer subscription.Payer
+	if row.PayerType != nil {
+		payer, err = subscription.NewPayerFromOwner(owner, *row.PayerType, row.PayerMemberId)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	prov, err := h.resolveProvider(ctx, ictx, row.ProviderKey)
+	if err != nil {
+		return nil, append(errs, err)
+	}
+
+	labelIds := make([]types.LabelID, 0, len(row.Labels))
+	for _, name := range row.Labels {
+		labelId, ok := ictx.labels[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			errs = append(errs, validation.NewError("labels", "unknown label '"+name+"'"))
+			continue
+		}
+		// Labels inherited from the provider are exported too, they must not be duplicated on the subscription
+		if prov.Labels().Contains(labelId) {
+			continue
+		}
+		labelIds = append(labelIds, labelId)
+	}
+
+	var price subscription.Price
+	if row.Price != nil {
+		price = subscription.NewPrice(row.Price)
+	}
+
+	now := time.Now()
+	newSub := subscription.NewSubscription(
+		subscriptionID,
+		row.FriendlyName,
+		row.FreeTrial,
+		row.Contract,
+		prov.Id(),
+		price,
+		row.PriceHistory,
+		row.Promotions,
+		owner,
+		payer,
+		nil,
+		row.PaymentMethodID,
+		row.FamilyUsers,
+		subscription.NewSubscriptionLabelRefs(labelIds),
+		row.StartDate,
+		row.EndDate,
+		row.Pauses,
+		row.Recurrency,
+		row.CustomRecurrency,
+		row.BillingAnchorDay,
+		now,
+		now,
+	)
+
+	if err := h.authorization.Can(ctx, authorization.PermissionWrite).For(newSub); err != nil {
+		return newSub, append(errs, err)
+	}
+
+	if row.CostSplit != nil {
+		if err := newSub.SetCostSplit(row.CostSplit); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	// Payment methods belong to an account, an export imported in another account refers to unknown ones
+	if err := ensurePaymentMethodExists(ctx, h.paymentMethodRepository, ictx.account.UserID(),
+		row.PaymentMethodID); err != nil {
+		errs = append(errs, err)
+	}
+
+	if validationErrors := newSub.GetValidationErrors(); validationErrors.HasErrors() {
+		for _, validationError := range validationErrors {
+			errs = append(errs, validationError)
+		}
+	}
+
+	if ictx.seenIds.Contains(subscriptionID) {
+		errs = append(errs, subscription.ErrSubscriptionAlreadyExists)
+	} else {
+		ictx.seenIds.Add(subscriptionID)
+		if row.SubscriptionID != nil && row.SubscriptionID.IsSome() {
+			existing, err := h.subscriptionRepository.GetById(ctx, subscriptionID)
+			if err != nil {
+				return newSub, append(errs, err)
+			}
+			if existing != nil {
+				errs = append(errs, subscription.ErrSubscriptionAlreadyExists)
+			}
+		}
+	}
+
+	if err := ensureRelatedEntityExists(ctx, h.familyRepository, newSub); err != nil {
+		errs = append(errs, err)
+	}
+
+	return newSub, errs
+}
+
+func (h ImportSubscriptionsCommandHandler) resolveOwner(
+	ctx context.Context,
+	ictx *importContext,
+	ownerType types.OwnerType) (types.Owner, error) {
+	if owner, ok := ictx.owners[ownerType]; ok {
+		return owner, nil
+	}
+	owner, err := h.ownerFactory.Resolve(ctx, ownerType)
+	if err != nil {
+		return nil, err
+	}
+	ictx.owners[ownerType] = owner
+	return owner, nil
+}
+
+func (h ImportSubscriptionsCommandHandler) resolveProvider(
+	ctx context.Context,
+	ictx *importContext,
+	key string) (provider.Provider, error) {
+	if prov, ok := ictx.providers[key]; ok {
+		return prov, nil
+	}
+	prov, err := h.providerRepository.GetByProviderKeyForUser(ctx, ictx.account.UserID(), key)
+	if err != nil {
+		return nil, err
+	}
+	if prov == nil {
+		return nil, provider.ErrProviderNotFound
+	}
+	ictx.providers[key] = prov
+	return prov, nil
+}
+
+// applyQuota rejects the active subscriptions that would exceed the active subscription quota.
+// Rows are accepted in file order until the remaining quota is consumed.
+func (h ImportSubscriptionsCommandHandler) applyQuota(
+	ctx context.Context,
+	connectedAccount account.ConnectedAccount,
+	subscriptions []subscription.Subscription,
+	rows []ImportSubscriptionRowResult) error {
+	var active []int
+	for i, sub := range subscriptions {
+		if rows[i].Status == ImportRowStatusValid && sub.IsActive() {
+			active = append(active, i)
+		}
+	}
+	if len(active) == 0 {
+		return nil
+	}
+
+	allowed, eff, err := h.entitlement.CheckQuotaForAccount(ctx,
+		connectedAccount,
+		billing.FeatureIdActiveSubscriptionsCount,
+		int64(len(active)))
+	if err != nil {
+		return err
+	}
+	if allowed {
+		return nil
+	}
+
+	remaining := int64(0)
+	if eff.Remaining != nil {
+		remaining = *eff.Remaining
+	}
+	for n, i := range active {
+		if int64(n) < remaining {
+			continue
+		}
+		rows[i].Status = ImportRowStatusFailed
+		rows[i].Errors = append(rows[i].Errors, subscription.ErrActiveSubscriptionLimitReached)
+	}
+
+	return nil
+}
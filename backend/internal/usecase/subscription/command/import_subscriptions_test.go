@@ -0,0 +1,294 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/billing"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/label"
+	"github.com/mistribe/subtracker/internal/domain/paymentmethod"
+	"github.com/mistribe/subtracker/internal/domain/provider"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/x"
+)
+
+type importMocks struct {
+	subRepo           *ports.MockSubscriptionRepository
+	providerRepo      *ports.MockProviderRepository
+	labelRepo         *ports.MockLabelRepository
+	familyRepo        *ports.MockFamilyRepository
+	paymentMethodRepo *ports.MockPaymentMethodRepository
+	authz             *ports.MockAuthorization
+	auth              *ports.MockAuthentication
+	entitlement       *ports.MockEntitlementResolver
+	ownerFactory      *shared.MockOwnerFactory
+	account           *account.MockConnectedAccount
+}
+
+func newImportMocks(t *testing.T) importMocks {
+	m := importMocks{
+		subRepo:           ports.NewMockSubscriptionRepository(t),
+		providerRepo:      ports.NewMockProviderRepository(t),
+		labelRepo:         ports.NewMockLabelRepository(t),
+		familyRepo:        ports.NewMockFamilyRepository(t),
+		paymentMethodRepo: ports.NewMockPaymentMethodRepository(t),
+		authz:             ports.NewMockAuthorization(t),
+		auth:              ports.NewMockAuthentication(t),
+		entitlement:       ports.NewMockEntitlementResolver(t),
+		ownerFactory:      shared.NewMockOwnerFactory(t),
+		account:           account.NewMockConnectedAccount(t),
+	}
+	m.auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(m.account)
+	m.account.EXPECT().UserID().Return(types.UserID("userID-Test")).Maybe()
+	return m
+}
+
+func (m importMocks) handler() *command.ImportSubscriptionsCommandHandler {
+	return command.NewImportSubscriptionsCommandHandler(m.subRepo, m.providerRepo, m.labelRepo, m.familyRepo,
+		m.paymentMethodRepo,
+		m.authz, m.auth, m.entitlement, m.ownerFactory)
+}
+
+func (m importMocks) allowImport(t *testing.T, provider provider.Provider, labels ...label.Label) {
+	owner := types.NewPersonalOwner(types.UserID("userID-Test"))
+	perm := ports.NewMockPermissionRequest(t)
+	m.entitlement.EXPECT().CheckBoolean(mock.Anything, m.account, billing.FeatureIdImportSubscriptions).Return(true, nil)
+	m.labelRepo.EXPECT().GetAll(mock.Anything, types.UserID("userID-Test"), mock.Anything).Return(labels,
+		int64(len(labels)), nil)
+	m.ownerFactory.EXPECT().Resolve(mock.Anything, types.PersonalOwnerType).Return(owner, nil).Maybe()
+	m.providerRepo.EXPECT().GetByProviderKeyForUser(mock.Anything, types.UserID("userID-Test"),
+		provider.Key()).Return(provider, nil).Maybe()
+	m.authz.EXPECT().Can(mock.Anything, authorization.PermissionWrite).Return(perm).Maybe()
+	perm.EXPECT().For(mock.Anything).Return(nil).Maybe()
+}
+
+func newImportProvider() provider.Provider {
+	return provider.NewProvider(types.NewProviderID(), "Netflix", nil, nil, nil, nil, nil, types.SystemOwner,
+		time.Now(), time.Now())
+}
+
+func newImportRow(index int, providerKey string, labels ...string) command.ImportSubscriptionRow {
+	return command.ImportSubscriptionRow{
+		Index:        index,
+		ProviderKey:  providerKey,
+		FriendlyName: x.P("Imported"),
+		Price:        currency.NewAmount(9.99, currency.EUR),
+		Owner:        types.PersonalOwnerType,
+		Labels:       labels,
+		StartDate:    time.Now().AddDate(0, -1, 0),
+		Recurrency:   subscription.MonthlyRecurrency,
+	}
+}
+
+func TestImportSubscriptionsCommandHandler_Handle(t *testing.T) {
+	t.Run("returns fault when import feature is disabled", func(t *testing.T) {
+		m := newImportMocks(t)
+		m.entitlement.EXPECT().CheckBoolean(mock.Anything, m.account, billing.FeatureIdImportSubscriptions).Return(false,
+			nil)
+
+		res := m.handler().Handle(t.Context(), command.ImportSubscriptionsCommand{})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("dry run reports valid rows without saving", func(t *testing.T) {
+		m := newImportMocks(t)
+		prov := newImportProvider()
+		streaming := label.NewLabel(types.NewLabelID(), types.SystemOwner, "Streaming", nil, "#FF0000", time.Now(),
+			time.Now())
+		m.allowImport(t, prov, streaming)
+		m.entitlement.EXPECT().CheckQuotaForAccount(mock.Anything, m.account, billing.FeatureIdActiveSubscriptionsCount,
+			int64(2)).Return(true, billing.EffectiveEntitlement{}, nil)
+
+		cmd := command.ImportSubscriptionsCommand{
+			DryRun: true,
+			Rows: []command.ImportSubscriptionRow{
+				newImportRow(0, prov.Key(), "streaming"),
+				newImportRow(1, prov.Key()),
+			},
+		}
+		res := m.handler().Handle(t.Context(), cmd)
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.ImportSubscriptionsResult) {
+			assert.True(t, report.DryRun)
+			require.Len(t, report.Rows, 2)
+			for _, row := range report.Rows {
+				assert.Equal(t, command.ImportRowStatusValid, row.Status)
+				assert.Empty(t, row.Errors)
+			}
+		})
+	})
+
+	t.Run("reports unknown labels per row", func(t *testing.T) {
+		m := newImportMocks(t)
+		prov := newImportProvider()
+		m.allowImport(t, prov)
+		m.entitlement.EXPECT().CheckQuotaForAccount(mock.Anything, m.account, billing.FeatureIdActiveSubscriptionsCount,
+			int64(1)).Return(true, billing.EffectiveEntitlement{}, nil)
+
+		cmd := command.ImportSubscriptionsCommand{
+			DryRun: true,
+			Rows: []command.ImportSubscriptionRow{
+				newImportRow(0, prov.Key(), "Unknown"),
+				newImportRow(1, prov.Key()),
+			},
+		}
+		res := m.handler().Handle(t.Context(), cmd)
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.ImportSubscriptionsResult) {
+			assert.Equal(t, command.ImportRowStatusFailed, report.Rows[0].Status)
+			assert.NotEmpty(t, report.Rows[0].Errors)
+			assert.Equal(t, command.ImportRowStatusValid, report.Rows[1].Status)
+		})
+	})
+
+	t.Run("reports validation errors per row", func(t *testing.T) {
+		m := newImportMocks(t)
+		prov := newImportProvider()
+		m.allowImport(t, prov)
+
+		row := newImportRow(0, prov.Key())
+		row.Recurrency = subscription.CustomRecurrency
+		res := m.handler().Handle(t.Context(), command.ImportSubscriptionsCommand{
+			DryRun: true,
+			Rows:   []command.ImportSubscriptionRow{row},
+		})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.ImportSubscriptionsResult) {
+			assert.Equal(t, command.ImportRowStatusFailed, report.Rows[0].Status)
+			assert.NotEmpty(t, report.Rows[0].Errors)
+		})
+	})
+
+	t.Run("rejects rows exceeding the active subscription quota", func(t *testing.T) {
+		m := newImportMocks(t)
+		prov := newImportProvider()
+		m.allowImport(t, prov)
+		m.entitlement.EXPECT().CheckQuotaForAccount(mock.Anything, m.account, billing.FeatureIdActiveSubscriptionsCount,
+			int64(3)).Return(false, billing.EffectiveEntitlement{Remaining: x.P(int64(1))}, nil)
+		m.subRepo.EXPECT().Save(mock.Anything, mock.Anything).Return(nil).Once()
+
+		cmd := command.ImportSubscriptionsCommand{
+			Rows: []command.ImportSubscriptionRow{
+				newImportRow(0, prov.Key()),
+				newImportRow(1, prov.Key()),
+				newImportRow(2, prov.Key()),
+			},
+		}
+		res := m.handler().Handle(t.Context(), cmd)
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.ImportSubscriptionsResult) {
+			assert.Equal(t, command.ImportRowStatusCreated, report.Rows[0].Status)
+			assert.Equal(t, command.ImportRowStatusFailed, report.Rows[1].Status)
+			assert.Contains(t, report.Rows[1].Errors, subscription.ErrActiveSubscriptionLimitReached)
+			assert.Equal(t, command.ImportRowStatusFailed, report.Rows[2].Status)
+		})
+	})
+
+	t.Run("applies the contract, price history, promotions, pauses and payment method", func(t *testing.T) {
+		m := newImportMocks(t)
+		prov := newImportProvider()
+		m.allowImport(t, prov)
+		m.entitlement.EXPECT().CheckQuotaForAccount(mock.Anything, m.account, billing.FeatureIdActiveSubscriptionsCount,
+			int64(1)).Return(true, billing.EffectiveEntitlement{}, nil)
+		paymentMethod := paymentmethod.NewPaymentMethod(types.NewPaymentMethodID(),
+			types.NewPersonalOwner(types.UserID("userID-Test")), paymentmethod.CardType, "Visa", nil, nil, nil,
+			time.Now(), time.Now())
+		m.paymentMethodRepo.EXPECT().GetByIdForUser(mock.Anything, types.UserID("userID-Test"),
+			paymentMethod.Id()).Return(paymentMethod, nil)
+		var saved subscription.Subscription
+		m.subRepo.EXPECT().Save(mock.Anything, mock.Anything).
+			Run(func(_ context.Context, sub ...subscription.Subscription) { saved = sub[0] }).
+			Return(nil).Once()
+
+		row := newImportRow(0, prov.Key())
+		pauseEnd := row.StartDate.AddDate(0, 1, 0)
+		paymentMethodID := paymentMethod.Id()
+		row.Contract = subscription.NewContract(x.P(int32(12)), true, nil)
+		row.PaymentMethodID = &paymentMethodID
+		row.PriceHistory = []subscription.PriceChange{
+			{EffectiveFrom: row.StartDate, Amount: currency.NewAmount(7.99, currency.EUR)},
+		}
+		row.Promotions = []subscription.Promotion{
+			{StartDate: row.StartDate, EndDate: pauseEnd, Amount: currency.NewAmount(4.99, currency.EUR)},
+		}
+		row.Pauses = []subscription.Pause{{StartDate: row.StartDate, EndDate: &pauseEnd}}
+		res := m.handler().Handle(t.Context(), command.ImportSubscriptionsCommand{
+			Rows: []command.ImportSubscriptionRow{row},
+		})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.ImportSubscriptionsResult) {
+			assert.Equal(t, command.ImportRowStatusCreated, report.Rows[0].Status)
+		})
+		require.NotNil(t, saved)
+		require.NotNil(t, saved.Contract())
+		assert.Equal(t, int32(12), *saved.Contract().MinimumTerm())
+		assert.Equal(t, &paymentMethodID, saved.PaymentMethodId())
+		require.Len(t, saved.PriceHistory().Values(), 1)
+		assert.Equal(t, 7.99, saved.PriceHistory().Values()[0].Amount.Value())
+		require.Len(t, saved.Promotions().Values(), 1)
+		assert.Equal(t, 4.99, saved.Promotions().Values()[0].Amount.Value())
+		require.Len(t, saved.Pauses().Values(), 1)
+		assert.NotNil(t, saved.Pauses().Values()[0].EndDate)
+	})
+
+	t.Run("rejects unknown payment methods", func(t *testing.T) {
+		m := newImportMocks(t)
+		prov := newImportProvider()
+		m.allowImport(t, prov)
+		paymentMethodID := types.NewPaymentMethodID()
+		m.paymentMethodRepo.EXPECT().GetByIdForUser(mock.Anything, types.UserID("userID-Test"),
+			paymentMethodID).Return(nil, nil)
+
+		row := newImportRow(0, prov.Key())
+		row.PaymentMethodID = &paymentMethodID
+		res := m.handler().Handle(t.Context(), command.ImportSubscriptionsCommand{
+			DryRun: true,
+			Rows:   []command.ImportSubscriptionRow{row},
+		})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.ImportSubscriptionsResult) {
+			assert.Equal(t, command.ImportRowStatusFailed, report.Rows[0].Status)
+			assert.Contains(t, report.Rows[0].Errors, paymentmethod.ErrPaymentMethodNotFound)
+		})
+	})
+
+	t.Run("rejects already existing subscriptions", func(t *testing.T) {
+		m := newImportMocks(t)
+		prov := newImportProvider()
+		m.allowImport(t, prov)
+		existing := newPersonalSubscription()
+		m.subRepo.EXPECT().GetById(mock.Anything, existing.Id()).Return(existing, nil)
+
+		row := newImportRow(0, prov.Key())
+		row.SubscriptionID = option.Some(existing.Id())
+		res := m.handler().Handle(t.Context(), command.ImportSubscriptionsCommand{
+			Rows: []command.ImportSubscriptionRow{row},
+		})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.ImportSubscriptionsResult) {
+			assert.Equal(t, command.ImportRowStatusFailed, report.Rows[0].Status)
+			assert.Contains(t, report.Rows[0].Errors, subscription.ErrSubscriptionAlreadyExists)
+		})
+	})
+}
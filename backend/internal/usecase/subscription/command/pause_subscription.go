@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type PauseSubscriptionCommand struct {
+	SubscriptionID types.SubscriptionID
+	StartDate      time.Time
+	EndDate        *time.Time
+	UpdatedAt      option.Option[time.Time]
+}
+
+type PauseSubscriptionCommandHandler struct {
+	subscriptionRepository ports.SubscriptionRepository
+	authorization          ports.Authorization
+}
+
+func NewPauseSubscriptionCommandHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	authorization ports.Authorization) *PauseSubscriptionCommandHandler {
+	return &PauseSubscriptionCommandHandler{
+		subscriptionRepository: subscriptionRepository,
+		authorization:          authorization,
+	}
+}
+
+func (h PauseSubscriptionCommandHandler) Handle(
+	ctx context.Context,
+	cmd PauseSubscriptionCommand) result.Result[subscription.Subscription] {
+	sub, err := h.subscriptionRepository.GetById(ctx, cmd.SubscriptionID)
+	if err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+	if sub == nil {
+		return result.Fail[subscription.Subscription](subscription.ErrSubscriptionNotFound)
+	}
+
+	err = h.authorization.Can(ctx, authorization.PermissionWrite).For(sub)
+	if err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+
+	if err := sub.Pause(cmd.StartDate, cmd.EndDate); err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+
+	var updatedAt time.Time
+	if cmd.UpdatedAt != nil && cmd.UpdatedAt.IsSome() {
+		updatedAt = *cmd.UpdatedAt.Value()
+	} else {
+		updatedAt = time.Now()
+	}
+	sub.SetUpdatedAt(updatedAt)
+
+	if err := sub.GetValidationErrors(); err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+
+	if err := h.subscriptionRepository.Save(ctx, sub); err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+
+	return result.Success(sub)
+}
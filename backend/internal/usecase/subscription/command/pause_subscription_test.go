@@ -0,0 +1,110 @@
+package command_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+)
+
+func TestPauseSubscriptionCommandHandler_Handle(t *testing.T) {
+	t.Run("returns fault when repository GetById returns error", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(nil, errors.New("db error"))
+
+		h := command.NewPauseSubscriptionCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.PauseSubscriptionCommand{SubscriptionID: existing.Id()})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("returns fault when subscription not found", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(nil, nil)
+
+		h := command.NewPauseSubscriptionCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.PauseSubscriptionCommand{SubscriptionID: existing.Id()})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("returns fault when permission denied", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(authorization.ErrUnauthorized)
+
+		h := command.NewPauseSubscriptionCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.PauseSubscriptionCommand{
+			SubscriptionID: existing.Id(),
+			StartDate:      time.Now(),
+		})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("returns fault when the pause overlaps an existing one", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		assert.NoError(t, existing.Pause(time.Now().Add(-time.Minute), nil))
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+
+		h := command.NewPauseSubscriptionCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.PauseSubscriptionCommand{
+			SubscriptionID: existing.Id(),
+			StartDate:      time.Now(),
+		})
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, subscription.ErrPauseOverlap)
+		})
+	})
+
+	t.Run("pauses and saves the subscription", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+		subRepo.EXPECT().Save(t.Context(), mock.Anything).Return(nil)
+
+		h := command.NewPauseSubscriptionCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.PauseSubscriptionCommand{
+			SubscriptionID: existing.Id(),
+			StartDate:      time.Now().Add(-time.Minute),
+		})
+
+		assert.True(t, res.IsSuccess())
+		res.IfSuccess(func(sub subscription.Subscription) {
+			assert.Equal(t, 1, sub.Pauses().Len())
+			assert.True(t, sub.IsPaused())
+			assert.False(t, sub.IsActive())
+		})
+	})
+}
@@ -0,0 +1,120 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// reconcileChargesBatchSize is the number of subscriptions loaded at once when every ledger is reconciled
+const reconcileChargesBatchSize = 100
+
+// ReconcileChargesCommand materializes the billing occurrences of the subscriptions billed so far into their
+// charge ledger. Every subscription is reconciled when SubscriptionIds is empty.
+type ReconcileChargesCommand struct {
+	SubscriptionIds []types.SubscriptionID
+}
+
+// ReconcileChargesResult reports a run, a subscription failing does not stop the others
+type ReconcileChargesResult struct {
+	Created int
+	Updated int
+	Removed int
+	// Errors are the failures of the subscriptions whose ledger could not be reconciled
+	Errors []error
+}
+
+type ReconcileChargesCommandHandler struct {
+	subscriptionRepository ports.SubscriptionRepository
+	chargeRepository       ports.ChargeRepository
+}
+
+func NewReconcileChargesCommandHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	chargeRepository ports.ChargeRepository) *ReconcileChargesCommandHandler {
+	return &ReconcileChargesCommandHandler{
+		subscriptionRepository: subscriptionRepository,
+		chargeRepository:       chargeRepository,
+	}
+}
+
+func (h ReconcileChargesCommandHandler) Handle(
+	ctx context.Context,
+	cmd ReconcileChargesCommand) result.Result[ReconcileChargesResult] {
+	var report ReconcileChargesResult
+	now := time.Now()
+
+	if len(cmd.SubscriptionIds) > 0 {
+		for _, id := range cmd.SubscriptionIds {
+			sub, err := h.subscriptionRepository.GetById(ctx, id)
+			if err != nil {
+				return result.Fail[ReconcileChargesResult](err)
+			}
+			if sub == nil {
+				// A deleted subscription has no ledger left
+				continue
+			}
+			h.reconcile(ctx, sub, now, &report)
+		}
+		return result.Success(report)
+	}
+
+	for offset := int64(0); ; offset += reconcileChargesBatchSize {
+		if err := ctx.Err(); err != nil {
+			return result.Fail[ReconcileChargesResult](err)
+		}
+
+		params := ports.NewSubscriptionQueryParameters("", nil, nil, nil, nil, nil, true, nil, nil,
+			reconcileChargesBatchSize, offset)
+		subs, _, err := h.subscriptionRepository.GetAll(ctx, params)
+		if err != nil {
+			return result.Fail[ReconcileChargesResult](err)
+		}
+		for _, sub := range subs {
+			h.reconcile(ctx, sub, now, &report)
+		}
+		if len(subs) < reconcileChargesBatchSize {
+			break
+		}
+	}
+
+	return result.Success(report)
+}
+
+// reconcile brings the charges of the subscription in line with its billing occurrences up to now
+func (h ReconcileChargesCommandHandler) reconcile(
+	ctx context.Context,
+	sub subscription.Subscription,
+	now time.Time,
+	report *ReconcileChargesResult) {
+	existing, err := h.chargeRepository.GetBySubscriptionId(ctx, sub.Id())
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Errorf("charges of subscription %s: %w", sub.Id(), err))
+		return
+	}
+
+	rec := charge.Reconcile(sub, existing, now)
+	changed := append(rec.Created, rec.Updated...)
+	if len(changed) > 0 {
+		if err := h.chargeRepository.Save(ctx, changed...); err != nil {
+			report.Errors = append(report.Errors, fmt.Errorf("charges of subscription %s: %w", sub.Id(), err))
+			return
+		}
+	}
+	for _, removed := range rec.Removed {
+		if _, err := h.chargeRepository.Delete(ctx, removed.Id()); err != nil {
+			report.Errors = append(report.Errors, fmt.Errorf("charges of subscription %s: %w", sub.Id(), err))
+			return
+		}
+	}
+
+	report.Created += len(rec.Created)
+	report.Updated += len(rec.Updated)
+	report.Removed += len(rec.Removed)
+}
@@ -0,0 +1,128 @@
+package command_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+)
+
+// newBilledSubscription returns a monthly subscription billed three times so far
+func newBilledSubscription() subscription.Subscription {
+	sub := subscription.NewSubscription(
+		types.NewSubscriptionID(),
+		nil,
+		nil,
+		nil,
+		types.ProviderID(uuid.Must(uuid.NewV7())),
+		subscription.NewPrice(currency.NewAmount(10, currency.EUR)),
+		nil,
+		nil,
+		types.NewPersonalOwner(types.UserID("userID-Test")),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		time.Now().AddDate(0, -2, -1),
+		nil,
+		nil,
+		subscription.MonthlyRecurrency,
+		nil,
+		nil,
+		time.Now(),
+		time.Now(),
+	)
+	sub.Clean()
+	return sub
+}
+
+func TestReconcileChargesCommandHandler_Handle(t *testing.T) {
+	t.Run("materializes the charges of the given subscription", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		chargeRepo := ports.NewMockChargeRepository(t)
+
+		sub := newBilledSubscription()
+		stale := newExpectedCharge(sub.Id())
+		subRepo.EXPECT().GetById(t.Context(), sub.Id()).Return(sub, nil)
+		chargeRepo.EXPECT().GetBySubscriptionId(t.Context(), sub.Id()).Return([]charge.Charge{stale}, nil)
+		chargeRepo.EXPECT().Save(t.Context(), mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		chargeRepo.EXPECT().Delete(t.Context(), stale.Id()).Return(true, nil)
+
+		h := command.NewReconcileChargesCommandHandler(subRepo, chargeRepo)
+		res := h.Handle(t.Context(), command.ReconcileChargesCommand{
+			SubscriptionIds: []types.SubscriptionID{sub.Id()},
+		})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.ReconcileChargesResult) {
+			assert.Equal(t, 3, report.Created)
+			assert.Zero(t, report.Updated)
+			assert.Equal(t, 1, report.Removed)
+			assert.Empty(t, report.Errors)
+		})
+	})
+
+	t.Run("skips a deleted subscription", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		chargeRepo := ports.NewMockChargeRepository(t)
+
+		id := types.NewSubscriptionID()
+		subRepo.EXPECT().GetById(t.Context(), id).Return(nil, nil)
+
+		h := command.NewReconcileChargesCommandHandler(subRepo, chargeRepo)
+		res := h.Handle(t.Context(), command.ReconcileChargesCommand{
+			SubscriptionIds: []types.SubscriptionID{id},
+		})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.ReconcileChargesResult) {
+			assert.Zero(t, report.Created)
+		})
+	})
+
+	t.Run("reconciles every subscription and reports the failing ones", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		chargeRepo := ports.NewMockChargeRepository(t)
+
+		failing := newBilledSubscription()
+		reconciled := newBilledSubscription()
+		subRepo.EXPECT().GetAll(t.Context(), mock.Anything).
+			Return([]subscription.Subscription{failing, reconciled}, int64(2), nil).Once()
+		chargeRepo.EXPECT().GetBySubscriptionId(t.Context(), failing.Id()).Return(nil, errors.New("db error"))
+		chargeRepo.EXPECT().GetBySubscriptionId(t.Context(), reconciled.Id()).Return(nil, nil)
+		chargeRepo.EXPECT().Save(t.Context(), mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		h := command.NewReconcileChargesCommandHandler(subRepo, chargeRepo)
+		res := h.Handle(t.Context(), command.ReconcileChargesCommand{})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.ReconcileChargesResult) {
+			assert.Equal(t, 3, report.Created)
+			assert.Len(t, report.Errors, 1)
+		})
+	})
+
+	t.Run("returns fault when the subscriptions cannot be loaded", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		chargeRepo := ports.NewMockChargeRepository(t)
+
+		subRepo.EXPECT().GetAll(t.Context(), mock.Anything).Return(nil, 0, errors.New("db error"))
+
+		h := command.NewReconcileChargesCommandHandler(subRepo, chargeRepo)
+		res := h.Handle(t.Context(), command.ReconcileChargesCommand{})
+
+		assert.True(t, res.IsFaulted())
+	})
+}
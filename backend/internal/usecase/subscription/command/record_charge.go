@@ -0,0 +1,88 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type RecordChargeCommand struct {
+	SubscriptionID types.SubscriptionID
+	ChargeID       types.ChargeID
+	Status         charge.Status
+	// ActualAmount is the amount really charged, nil to use the default of the status
+	ActualAmount currency.Amount
+	UpdatedAt    option.Option[time.Time]
+}
+
+type RecordChargeCommandHandler struct {
+	subscriptionRepository ports.SubscriptionRepository
+	chargeRepository       ports.ChargeRepository
+	authorization          ports.Authorization
+}
+
+func NewRecordChargeCommandHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	chargeRepository ports.ChargeRepository,
+	authorization ports.Authorization) *RecordChargeCommandHandler {
+	return &RecordChargeCommandHandler{
+		subscriptionRepository: subscriptionRepository,
+		chargeRepository:       chargeRepository,
+		authorization:          authorization,
+	}
+}
+
+func (h RecordChargeCommandHandler) Handle(
+	ctx context.Context,
+	cmd RecordChargeCommand) result.Result[charge.Charge] {
+	sub, err := h.subscriptionRepository.GetById(ctx, cmd.SubscriptionID)
+	if err != nil {
+		return result.Fail[charge.Charge](err)
+	}
+	if sub == nil {
+		return result.Fail[charge.Charge](subscription.ErrSubscriptionNotFound)
+	}
+
+	err = h.authorization.Can(ctx, authorization.PermissionWrite).For(sub)
+	if err != nil {
+		return result.Fail[charge.Charge](err)
+	}
+
+	chg, err := h.chargeRepository.GetById(ctx, cmd.ChargeID)
+	if err != nil {
+		return result.Fail[charge.Charge](err)
+	}
+	if chg == nil || chg.SubscriptionId() != sub.Id() {
+		return result.Fail[charge.Charge](charge.ErrChargeNotFound)
+	}
+
+	if err := chg.Record(cmd.Status, cmd.ActualAmount); err != nil {
+		return result.Fail[charge.Charge](err)
+	}
+
+	var updatedAt time.Time
+	if cmd.UpdatedAt != nil && cmd.UpdatedAt.IsSome() {
+		updatedAt = *cmd.UpdatedAt.Value()
+	} else {
+		updatedAt = time.Now()
+	}
+	chg.SetUpdatedAt(updatedAt)
+
+	if err := chg.GetValidationErrors(); err != nil {
+		return result.Fail[charge.Charge](err)
+	}
+
+	if err := h.chargeRepository.Save(ctx, chg); err != nil {
+		return result.Fail[charge.Charge](err)
+	}
+
+	return result.Success(chg)
+}
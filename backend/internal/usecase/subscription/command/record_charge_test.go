@@ -0,0 +1,116 @@
+package command_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+)
+
+func newExpectedCharge(subscriptionID types.SubscriptionID) charge.Charge {
+	chg := charge.NewCharge(types.NewChargeID(), subscriptionID, time.Now().Add(-time.Hour),
+		currency.NewAmount(10, currency.EUR), nil, charge.ExpectedStatus, time.Now(), time.Now())
+	chg.Clean()
+	return chg
+}
+
+func TestRecordChargeCommandHandler_Handle(t *testing.T) {
+	t.Run("returns fault when subscription not found", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		chargeRepo := ports.NewMockChargeRepository(t)
+		authz := ports.NewMockAuthorization(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(nil, nil)
+
+		h := command.NewRecordChargeCommandHandler(subRepo, chargeRepo, authz)
+		res := h.Handle(t.Context(), command.RecordChargeCommand{SubscriptionID: existing.Id()})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("returns fault when permission denied", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		chargeRepo := ports.NewMockChargeRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(authorization.ErrUnauthorized)
+
+		h := command.NewRecordChargeCommandHandler(subRepo, chargeRepo, authz)
+		res := h.Handle(t.Context(), command.RecordChargeCommand{
+			SubscriptionID: existing.Id(),
+			ChargeID:       types.NewChargeID(),
+			Status:         charge.PaidStatus,
+		})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("returns not found when the charge belongs to another subscription", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		chargeRepo := ports.NewMockChargeRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		chg := newExpectedCharge(types.NewSubscriptionID())
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+		chargeRepo.EXPECT().GetById(t.Context(), chg.Id()).Return(chg, nil)
+
+		h := command.NewRecordChargeCommandHandler(subRepo, chargeRepo, authz)
+		res := h.Handle(t.Context(), command.RecordChargeCommand{
+			SubscriptionID: existing.Id(),
+			ChargeID:       chg.Id(),
+			Status:         charge.PaidStatus,
+		})
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, charge.ErrChargeNotFound)
+		})
+	})
+
+	t.Run("records the actual amount", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		chargeRepo := ports.NewMockChargeRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		chg := newExpectedCharge(existing.Id())
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+		chargeRepo.EXPECT().GetById(t.Context(), chg.Id()).Return(chg, nil)
+		chargeRepo.EXPECT().Save(t.Context(), mock.Anything).Return(nil)
+
+		h := command.NewRecordChargeCommandHandler(subRepo, chargeRepo, authz)
+		res := h.Handle(t.Context(), command.RecordChargeCommand{
+			SubscriptionID: existing.Id(),
+			ChargeID:       chg.Id(),
+			Status:         charge.AdjustedStatus,
+			ActualAmount:   currency.NewAmount(8.5, currency.EUR),
+		})
+
+		assert.True(t, res.IsSuccess())
+		res.IfSuccess(func(got charge.Charge) {
+			assert.Equal(t, charge.AdjustedStatus, got.Status())
+			assert.Equal(t, 8.5, got.Amount().Value())
+			assert.Equal(t, 10.0, got.ExpectedAmount().Value())
+		})
+	})
+}
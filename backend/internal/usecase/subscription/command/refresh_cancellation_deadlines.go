@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// refreshCancellationDeadlinesBatchSize is the number of subscriptions loaded at once when the deadlines are refreshed
+const refreshCancellationDeadlinesBatchSize = 100
+
+// RefreshCancellationDeadlinesCommand stores the current cancellation deadline of the renewing subscriptions whose
+// stored deadline is missing or passed, the lists filter on the stored deadline
+type RefreshCancellationDeadlinesCommand struct{}
+
+// RefreshCancellationDeadlinesResult reports a run, a subscription failing does not stop the others
+type RefreshCancellationDeadlinesResult struct {
+	Refreshed int
+	// Errors are the failures of the subscriptions whose deadline could not be stored
+	Errors []error
+}
+
+type RefreshCancellationDeadlinesCommandHandler struct {
+	subscriptionRepository ports.SubscriptionRepository
+}
+
+func NewRefreshCancellationDeadlinesCommandHandler(
+	subscriptionRepository ports.SubscriptionRepository) *RefreshCancellationDeadlinesCommandHandler {
+	return &RefreshCancellationDeadlinesCommandHandler{
+		subscriptionRepository: subscriptionRepository,
+	}
+}
+
+func (h RefreshCancellationDeadlinesCommandHandler) Handle(
+	ctx context.Context,
+	_ RefreshCancellationDeadlinesCommand) result.Result[RefreshCancellationDeadlinesResult] {
+	var report RefreshCancellationDeadlinesResult
+	now := time.Now()
+
+	// The refreshed subscriptions are no longer stale, the offset only skips the ones that stay so
+	var offset int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return result.Fail[RefreshCancellationDeadlinesResult](err)
+		}
+
+		subs, err := h.subscriptionRepository.GetAllWithStaleCancellationDeadline(ctx, now,
+			refreshCancellationDeadlinesBatchSize, offset)
+		if err != nil {
+			return result.Fail[RefreshCancellationDeadlinesResult](err)
+		}
+		for _, sub := range subs {
+			if err := h.subscriptionRepository.Save(ctx, sub); err != nil {
+				report.Errors = append(report.Errors,
+					fmt.Errorf("cancellation deadline of subscription %s: %w", sub.Id(), err))
+				offset++
+				continue
+			}
+			// A subscription without an upcoming deadline, paused for good for instance, keeps none stored
+			if sub.CancellationDeadline() == nil {
+				offset++
+				continue
+			}
+			report.Refreshed++
+		}
+		if len(subs) < refreshCancellationDeadlinesBatchSize {
+			break
+		}
+	}
+
+	return result.Success(report)
+}
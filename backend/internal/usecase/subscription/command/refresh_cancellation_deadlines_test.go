@@ -0,0 +1,118 @@
+package command_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+)
+
+// newRenewingSubscription returns a monthly subscription renewing by itself, paused for good when paused is true
+func newRenewingSubscription(paused bool) subscription.Subscription {
+	var pauses []subscription.Pause
+	if paused {
+		pauses = []subscription.Pause{{StartDate: time.Now().AddDate(0, 0, -1)}}
+	}
+	sub := subscription.NewSubscription(
+		types.NewSubscriptionID(),
+		nil,
+		nil,
+		subscription.NewContract(nil, true, nil),
+		types.ProviderID(uuid.Must(uuid.NewV7())),
+		subscription.NewPrice(currency.NewAmount(10, currency.EUR)),
+		nil,
+		nil,
+		types.NewPersonalOwner(types.UserID("userID-Test")),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		time.Now().AddDate(0, -2, -1),
+		nil,
+		pauses,
+		subscription.MonthlyRecurrency,
+		nil,
+		nil,
+		time.Now(),
+		time.Now(),
+	)
+	sub.Clean()
+	return sub
+}
+
+// savedSubscription matches the save of the given subscription alone
+func savedSubscription(sub subscription.Subscription) any {
+	return mock.MatchedBy(func(entities []subscription.Subscription) bool {
+		return len(entities) == 1 && entities[0].Id() == sub.Id()
+	})
+}
+
+func TestRefreshCancellationDeadlinesCommandHandler_Handle(t *testing.T) {
+	t.Run("saves the subscriptions whose deadline is stale", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		renewing := newRenewingSubscription(false)
+		paused := newRenewingSubscription(true)
+		subRepo.EXPECT().GetAllWithStaleCancellationDeadline(t.Context(), mock.Anything, int64(100), int64(0)).
+			Return([]subscription.Subscription{renewing, paused}, nil)
+		subRepo.EXPECT().Save(t.Context(), savedSubscription(renewing)).Return(nil)
+		subRepo.EXPECT().Save(t.Context(), savedSubscription(paused)).Return(nil)
+
+		h := command.NewRefreshCancellationDeadlinesCommandHandler(subRepo)
+		res := h.Handle(t.Context(), command.RefreshCancellationDeadlinesCommand{})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.RefreshCancellationDeadlinesResult) {
+			assert.Equal(t, 1, report.Refreshed)
+			assert.Empty(t, report.Errors)
+		})
+	})
+
+	t.Run("skips the subscriptions left stale on the next batch", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		batch := make([]subscription.Subscription, 100)
+		for i := range batch {
+			batch[i] = newRenewingSubscription(false)
+		}
+		failing := batch[0]
+		paused := newRenewingSubscription(true)
+		batch[1] = paused
+		subRepo.EXPECT().GetAllWithStaleCancellationDeadline(t.Context(), mock.Anything, int64(100), int64(0)).
+			Return(batch, nil)
+		subRepo.EXPECT().Save(t.Context(), savedSubscription(failing)).Return(errors.New("boom"))
+		subRepo.EXPECT().Save(t.Context(), mock.Anything).Return(nil).Times(99)
+		// The failing and the paused subscriptions are still stale, the next batch starts after them
+		subRepo.EXPECT().GetAllWithStaleCancellationDeadline(t.Context(), mock.Anything, int64(100), int64(2)).
+			Return([]subscription.Subscription{}, nil)
+
+		h := command.NewRefreshCancellationDeadlinesCommandHandler(subRepo)
+		res := h.Handle(t.Context(), command.RefreshCancellationDeadlinesCommand{})
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.RefreshCancellationDeadlinesResult) {
+			assert.Equal(t, 98, report.Refreshed)
+			require.Len(t, report.Errors, 1)
+		})
+	})
+
+	t.Run("returns fault when the subscriptions cannot be loaded", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		subRepo.EXPECT().GetAllWithStaleCancellationDeadline(t.Context(), mock.Anything, int64(100), int64(0)).
+			Return(nil, errors.New("boom"))
+
+		h := command.NewRefreshCancellationDeadlinesCommandHandler(subRepo)
+		res := h.Handle(t.Context(), command.RefreshCancellationDeadlinesCommand{})
+
+		assert.True(t, res.IsFaulted())
+	})
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/option"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type ResumeSubscriptionCommand struct {
+	SubscriptionID types.SubscriptionID
+	// ResumedAt defaults to now when the subscription is resumed immediately
+	ResumedAt option.Option[time.Time]
+	UpdatedAt option.Option[time.Time]
+}
+
+type ResumeSubscriptionCommandHandler struct {
+	subscriptionRepository ports.SubscriptionRepository
+	authorization          ports.Authorization
+}
+
+func NewResumeSubscriptionCommandHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	authorization ports.Authorization) *ResumeSubscriptionCommandHandler {
+	return &ResumeSubscriptionCommandHandler{
+		subscriptionRepository: subscriptionRepository,
+		authorization:          authorization,
+	}
+}
+
+func (h ResumeSubscriptionCommandHandler) Handle(
+	ctx context.Context,
+	cmd ResumeSubscriptionCommand) result.Result[subscription.Subscription] {
+	sub, err := h.subscriptionRepository.GetById(ctx, cmd.SubscriptionID)
+	if err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+	if sub == nil {
+		return result.Fail[subscription.Subscription](subscription.ErrSubscriptionNotFound)
+	}
+
+	err = h.authorization.Can(ctx, authorization.PermissionWrite).For(sub)
+	if err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+
+	resumedAt := time.Now()
+	if cmd.ResumedAt != nil && cmd.ResumedAt.IsSome() {
+		resumedAt = *cmd.ResumedAt.Value()
+	}
+	if err := sub.Resume(resumedAt); err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+
+	var updatedAt time.Time
+	if cmd.UpdatedAt != nil && cmd.UpdatedAt.IsSome() {
+		updatedAt = *cmd.UpdatedAt.Value()
+	} else {
+		updatedAt = time.Now()
+	}
+	sub.SetUpdatedAt(updatedAt)
+
+	if err := sub.GetValidationErrors(); err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+
+	if err := h.subscriptionRepository.Save(ctx, sub); err != nil {
+		return result.Fail[subscription.Subscription](err)
+	}
+
+	return result.Success(sub)
+}
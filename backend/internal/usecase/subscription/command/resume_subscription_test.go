@@ -0,0 +1,76 @@
+package command_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mistribe/subtracker/internal/domain/authorization"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+)
+
+func TestResumeSubscriptionCommandHandler_Handle(t *testing.T) {
+	t.Run("returns fault when subscription not found", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(nil, nil)
+
+		h := command.NewResumeSubscriptionCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.ResumeSubscriptionCommand{SubscriptionID: existing.Id()})
+
+		assert.True(t, res.IsFaulted())
+	})
+
+	t.Run("returns fault when the subscription is not paused", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+
+		h := command.NewResumeSubscriptionCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.ResumeSubscriptionCommand{SubscriptionID: existing.Id()})
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, subscription.ErrSubscriptionNotPaused)
+		})
+	})
+
+	t.Run("ends the current pause and saves the subscription", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		existing := newPersonalSubscription()
+		pausedAt := time.Now().Add(-30 * time.Minute)
+		assert.NoError(t, existing.Pause(pausedAt, nil))
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+		subRepo.EXPECT().Save(t.Context(), mock.Anything).Return(nil)
+
+		h := command.NewResumeSubscriptionCommandHandler(subRepo, authz)
+		res := h.Handle(t.Context(), command.ResumeSubscriptionCommand{SubscriptionID: existing.Id()})
+
+		assert.True(t, res.IsSuccess())
+		res.IfSuccess(func(sub subscription.Subscription) {
+			assert.False(t, sub.IsPaused())
+			assert.True(t, sub.IsActive())
+			if assert.Equal(t, 1, sub.Pauses().Len()) {
+				pause := sub.Pauses().GetAt(0)
+				assert.True(t, pause.StartDate.Equal(pausedAt))
+				assert.NotNil(t, pause.EndDate)
+			}
+		})
+	})
+}
@@ -19,28 +19,33 @@ type UpdateSubscriptionCommand struct {
 	SubscriptionID   types.SubscriptionID
 	FriendlyName     *string
 	FreeTrial        subscription.FreeTrial
+	Contract         subscription.Contract
 	ProviderID       *types.ProviderID
 	ProviderKey      *string
 	Price            currency.Amount
 	Owner            types.OwnerType
 	PayerType        *subscription.PayerType
 	PayerMemberId    *types.FamilyMemberID
+	CostSplit        subscription.CostSplit
+	PaymentMethodID  *types.PaymentMethodID
 	FamilyUsers      []types.FamilyMemberID
 	Labels           []types.LabelID
 	StartDate        time.Time
 	EndDate          *time.Time
 	Recurrency       subscription.RecurrencyType
-	CustomRecurrency *int32
+	CustomRecurrency *subscription.Interval
+	BillingAnchorDay *int32
 	UpdatedAt        option.Option[time.Time]
 }
 
 type UpdateSubscriptionCommandHandler struct {
-	subscriptionRepository ports.SubscriptionRepository
-	familyRepository       ports.FamilyRepository
-	authorization          ports.Authorization
-	ownerFactory           shared.OwnerFactory
-	providerRepository     ports.ProviderRepository
-	authentication         ports.Authentication
+	subscriptionRepository  ports.SubscriptionRepository
+	familyRepository        ports.FamilyRepository
+	authorization           ports.Authorization
+	ownerFactory            shared.OwnerFactory
+	providerRepository      ports.ProviderRepository
+	authentication          ports.Authentication
+	paymentMethodRepository ports.PaymentMethodRepository
 }
 
 func NewUpdateSubscriptionCommandHandler(
@@ -49,14 +54,16 @@ func NewUpdateSubscriptionCommandHandler(
 	ownerFactory shared.OwnerFactory,
 	authentication ports.Authentication,
 	providerRepository ports.ProviderRepository,
-	authorization ports.Authorization) *UpdateSubscriptionCommandHandler {
+	authorization ports.Authorization,
+	paymentMethodRepository ports.PaymentMethodRepository) *UpdateSubscriptionCommandHandler {
 	return &UpdateSubscriptionCommandHandler{
-		subscriptionRepository: subscriptionRepository,
-		familyRepository:       familyRepository,
-		authorization:          authorization,
-		ownerFactory:           ownerFactory,
-		authentication:         authentication,
-		providerRepository:     providerRepository,
+		subscriptionRepository:  subscriptionRepository,
+		familyRepository:        familyRepository,
+		authorization:           authorization,
+		ownerFactory:            ownerFactory,
+		authentication:          authentication,
+		providerRepository:      providerRepository,
+		paymentMethodRepository: paymentMethodRepository,
 	}
 }
 
@@ -110,6 +117,11 @@ func (h UpdateSubscriptionCommandHandler) updateSubscription(
 	if cmd.FreeTrial != nil {
 		sub.SetFreeTrial(cmd.FreeTrial)
 	}
+	if cmd.Contract == nil && sub.Contract() != nil {
+		sub.SetContract(nil)
+	} else if cmd.Contract != nil && (sub.Contract() == nil || cmd.Contract.ETag() != sub.Contract().ETag()) {
+		sub.SetContract(cmd.Contract)
+	}
 	if sub.Price() != nil && cmd.Price != nil {
 		sub.SetPrice(cmd.Price)
 	}
@@ -134,6 +146,25 @@ func (h UpdateSubscriptionCommandHandler) updateSubscription(
 	if cmd.FamilyUsers != nil {
 		sub.SetFamilyUsers(cmd.FamilyUsers)
 	}
+	if cmd.Labels != nil {
+		sub.SetLabels(cmd.Labels)
+	}
+	if cmd.CostSplit == nil && sub.CostSplit() != nil {
+		if err := sub.SetCostSplit(nil); err != nil {
+			return result.Fail[subscription.Subscription](err)
+		}
+	} else if cmd.CostSplit != nil && (sub.CostSplit() == nil || cmd.CostSplit.ETag() != sub.CostSplit().ETag()) {
+		if err := sub.SetCostSplit(cmd.CostSplit); err != nil {
+			return result.Fail[subscription.Subscription](err)
+		}
+	}
+	if !pointerEqual(cmd.PaymentMethodID, sub.PaymentMethodId()) {
+		if err := ensurePaymentMethodExists(ctx, h.paymentMethodRepository, connectedUserId,
+			cmd.PaymentMethodID); err != nil {
+			return result.Fail[subscription.Subscription](err)
+		}
+		sub.SetPaymentMethodId(cmd.PaymentMethodID)
+	}
 	if !cmd.StartDate.IsZero() {
 		sub.SetStartDate(cmd.StartDate)
 	}
@@ -147,6 +178,9 @@ func (h UpdateSubscriptionCommandHandler) updateSubscription(
 	if cmd.CustomRecurrency != nil {
 		sub.SetCustomRecurrency(cmd.CustomRecurrency)
 	}
+	if !pointerEqual(cmd.BillingAnchorDay, sub.BillingAnchorDay()) {
+		sub.SetBillingAnchorDay(cmd.BillingAnchorDay)
+	}
 	var updatedAt time.Time
 	if cmd.UpdatedAt != nil && cmd.UpdatedAt.IsSome() {
 		updatedAt = *cmd.UpdatedAt.Value()
@@ -169,3 +203,10 @@ func (h UpdateSubscriptionCommandHandler) updateSubscription(
 
 	return result.Success(sub)
 }
+
+func pointerEqual[T comparable](left, right *T) bool {
+	if left == nil || right == nil {
+		return left == right
+	}
+	return *left == *right
+}
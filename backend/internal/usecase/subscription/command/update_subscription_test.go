@@ -34,7 +34,7 @@ func TestUpdateSubscriptionCommandHandler_Handle(t *testing.T) {
 		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(nil, errors.New("db error"))
 
 		h := command.NewUpdateSubscriptionCommandHandler(subRepo,
-			familyRepo, ownerFactory, authentication, providerRepo, authz)
+			familyRepo, ownerFactory, authentication, providerRepo, authz, ports.NewMockPaymentMethodRepository(t))
 		cmd := command.UpdateSubscriptionCommand{SubscriptionID: existing.Id()}
 		res := h.Handle(t.Context(), cmd)
 
@@ -53,7 +53,7 @@ func TestUpdateSubscriptionCommandHandler_Handle(t *testing.T) {
 		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(nil, nil)
 
 		h := command.NewUpdateSubscriptionCommandHandler(subRepo,
-			familyRepo, ownerFactory, authentication, providerRepo, authz)
+			familyRepo, ownerFactory, authentication, providerRepo, authz, ports.NewMockPaymentMethodRepository(t))
 		cmd := command.UpdateSubscriptionCommand{SubscriptionID: existing.Id()}
 		res := h.Handle(t.Context(), cmd)
 
@@ -75,7 +75,7 @@ func TestUpdateSubscriptionCommandHandler_Handle(t *testing.T) {
 		perm.EXPECT().For(mock.Anything).Return(authorization.ErrUnauthorized)
 
 		h := command.NewUpdateSubscriptionCommandHandler(subRepo,
-			familyRepo, ownerFactory, authentication, providerRepo, authz)
+			familyRepo, ownerFactory, authentication, providerRepo, authz, ports.NewMockPaymentMethodRepository(t))
 		cmd := command.UpdateSubscriptionCommand{SubscriptionID: existing.Id()}
 		res := h.Handle(t.Context(), cmd)
 
@@ -106,7 +106,7 @@ func TestUpdateSubscriptionCommandHandler_Handle(t *testing.T) {
 			[]types.FamilyMemberID{types.FamilyMemberID(member)}).Return(false, nil)
 
 		h := command.NewUpdateSubscriptionCommandHandler(subRepo,
-			familyRepo, ownerFactory, authentication, providerRepo, authz)
+			familyRepo, ownerFactory, authentication, providerRepo, authz, ports.NewMockPaymentMethodRepository(t))
 		providerID := existing.ProviderId()
 		cmd := command.UpdateSubscriptionCommand{
 			SubscriptionID: cmdSub.Id(), ProviderID: &providerID, Owner: cmdSub.Owner().Type(), FamilyUsers: cmdSub.FamilyUsers().Values(),
@@ -141,7 +141,7 @@ func TestUpdateSubscriptionCommandHandler_Handle(t *testing.T) {
 			errors.New("repo error"))
 
 		h := command.NewUpdateSubscriptionCommandHandler(subRepo,
-			familyRepo, ownerFactory, authentication, providerRepo, authz)
+			familyRepo, ownerFactory, authentication, providerRepo, authz, ports.NewMockPaymentMethodRepository(t))
 		providerID := existing.ProviderId()
 		cmd := command.UpdateSubscriptionCommand{
 			SubscriptionID: cmdSub.Id(), ProviderID: &providerID, Owner: cmdSub.Owner().Type(), FamilyUsers: cmdSub.FamilyUsers().Values(),
@@ -170,7 +170,7 @@ func TestUpdateSubscriptionCommandHandler_Handle(t *testing.T) {
 		perm.EXPECT().For(mock.Anything).Return(nil)
 
 		h := command.NewUpdateSubscriptionCommandHandler(subRepo,
-			familyRepo, ownerFactory, authentication, providerRepo, authz)
+			familyRepo, ownerFactory, authentication, providerRepo, authz, ports.NewMockPaymentMethodRepository(t))
 		providerID := existing.ProviderId()
 		cmd := command.UpdateSubscriptionCommand{
 			SubscriptionID: existing.Id(), FriendlyName: &name, ProviderID: &providerID,
@@ -204,7 +204,7 @@ func TestUpdateSubscriptionCommandHandler_Handle(t *testing.T) {
 		})).Return(errors.New("save failed"))
 
 		h := command.NewUpdateSubscriptionCommandHandler(subRepo,
-			familyRepo, ownerFactory, authentication, providerRepo, authz)
+			familyRepo, ownerFactory, authentication, providerRepo, authz, ports.NewMockPaymentMethodRepository(t))
 		providerID := existing.ProviderId()
 		cmd := command.UpdateSubscriptionCommand{
 			SubscriptionID: existing.Id(), FriendlyName: &name, ProviderID: &providerID,
@@ -238,7 +238,7 @@ func TestUpdateSubscriptionCommandHandler_Handle(t *testing.T) {
 		})).Return(nil)
 
 		h := command.NewUpdateSubscriptionCommandHandler(subRepo,
-			familyRepo, ownerFactory, authentication, providerRepo, authz)
+			familyRepo, ownerFactory, authentication, providerRepo, authz, ports.NewMockPaymentMethodRepository(t))
 		providerID := existing.ProviderId()
 		cmd := command.UpdateSubscriptionCommand{
 			SubscriptionID: existing.Id(), FriendlyName: &name, ProviderID: &providerID,
@@ -264,7 +264,7 @@ func TestUpdateSubscriptionCommandHandler_Handle(t *testing.T) {
 		name := "New Name"
 		start := time.Now().Add(-72 * time.Hour)
 		end := timePtr(time.Now().Add(72 * time.Hour))
-		customRec := int32(5)
+		customRec := subscription.NewInterval(subscription.WeekIntervalUnit, 2)
 		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
 		connectedAccount := account.NewMockConnectedAccount(t)
 		connectedAccount.EXPECT().UserID().Return(types.UserID(userId))
@@ -274,12 +274,12 @@ func TestUpdateSubscriptionCommandHandler_Handle(t *testing.T) {
 		subRepo.EXPECT().Save(t.Context(), mock.Anything).Return(nil)
 
 		h := command.NewUpdateSubscriptionCommandHandler(subRepo,
-			familyRepo, ownerFactory, authentication, providerRepo, authz)
+			familyRepo, ownerFactory, authentication, providerRepo, authz, ports.NewMockPaymentMethodRepository(t))
 		providerID := existing.ProviderId()
 		cmd := command.UpdateSubscriptionCommand{
 			SubscriptionID: existing.Id(), FriendlyName: &name, ProviderID: &providerID,
 			Owner: existing.Owner().Type(), StartDate: start, EndDate: end, Recurrency: subscription.CustomRecurrency,
-			CustomRecurrency: &customRec,
+			CustomRecurrency: customRec,
 		}
 		_ = h.Handle(t.Context(), cmd)
 
@@ -288,7 +288,51 @@ func TestUpdateSubscriptionCommandHandler_Handle(t *testing.T) {
 		assert.Equal(t, start, existing.StartDate())
 		assert.Equal(t, end, existing.EndDate())
 		assert.Equal(t, subscription.CustomRecurrency, existing.Recurrency())
-		assert.Equal(t, customRec, *existing.CustomRecurrency())
+		assert.Equal(t, customRec, existing.CustomRecurrency())
+	})
+
+	t.Run("replaces the labels of the subscription and keeps the labels of its provider", func(t *testing.T) {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		authz := ports.NewMockAuthorization(t)
+		authentication := ports.NewMockAuthentication(t)
+		perm := ports.NewMockPermissionRequest(t)
+
+		kept := types.NewLabelID()
+		removed := types.NewLabelID()
+		added := types.NewLabelID()
+		inherited := subscription.LabelRef{LabelId: types.NewLabelID(), Source: subscription.LabelSourceProvider}
+		existing := newPersonalSubscription()
+		existing.Labels().Add(inherited)
+		for _, ref := range subscription.NewSubscriptionLabelRefs([]types.LabelID{kept, removed}) {
+			existing.Labels().Add(ref)
+		}
+		existing.Labels().ClearChanges()
+
+		subRepo.EXPECT().GetById(t.Context(), existing.Id()).Return(existing, nil)
+		connectedAccount := account.NewMockConnectedAccount(t)
+		connectedAccount.EXPECT().UserID().Return(types.UserID(userId))
+		authentication.EXPECT().MustGetConnectedAccount(mock.Anything).Return(connectedAccount)
+		authz.EXPECT().Can(t.Context(), authorization.PermissionWrite).Return(perm)
+		perm.EXPECT().For(mock.Anything).Return(nil)
+		subRepo.EXPECT().Save(t.Context(), mock.Anything).Return(nil)
+
+		h := command.NewUpdateSubscriptionCommandHandler(subRepo, ports.NewMockFamilyRepository(t),
+			shared.NewMockOwnerFactory(t), authentication, ports.NewMockProviderRepository(t), authz,
+			ports.NewMockPaymentMethodRepository(t))
+		providerID := existing.ProviderId()
+		r := h.Handle(t.Context(), command.UpdateSubscriptionCommand{
+			SubscriptionID: existing.Id(), ProviderID: &providerID, Owner: existing.Owner().Type(),
+			Labels: []types.LabelID{kept, added},
+		})
+
+		assert.True(t, r.IsSuccess())
+		assert.ElementsMatch(t, []subscription.LabelRef{
+			inherited,
+			{LabelId: kept, Source: subscription.LabelSourceSubscription},
+			{LabelId: added, Source: subscription.LabelSourceSubscription},
+		}, existing.Labels().Values())
+		assert.Equal(t, subscription.NewSubscriptionLabelRefs([]types.LabelID{added}), existing.Labels().Added())
+		assert.Equal(t, subscription.NewSubscriptionLabelRefs([]types.LabelID{removed}), existing.Labels().Removed())
 	})
 }
 
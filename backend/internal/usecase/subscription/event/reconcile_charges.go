@@ -0,0 +1,55 @@
+package event
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type reconcileChargesHandler = ports.CommandHandler[command.ReconcileChargesCommand, command.ReconcileChargesResult]
+
+// SubscriptionCreatedHandler materializes the charges already billed by a new subscription
+type SubscriptionCreatedHandler struct {
+	handler reconcileChargesHandler
+}
+
+func NewSubscriptionCreatedHandler(handler reconcileChargesHandler) *SubscriptionCreatedHandler {
+	return &SubscriptionCreatedHandler{
+		handler: handler,
+	}
+}
+
+func (h SubscriptionCreatedHandler) Handle(ctx context.Context, event subscription.SubscriptionCreated) error {
+	return reconcileCharges(ctx, h.handler, event.SubscriptionId)
+}
+
+// PriceChangedHandler updates the expected amount of the charges due after a price change
+type PriceChangedHandler struct {
+	handler reconcileChargesHandler
+}
+
+func NewPriceChangedHandler(handler reconcileChargesHandler) *PriceChangedHandler {
+	return &PriceChangedHandler{
+		handler: handler,
+	}
+}
+
+func (h PriceChangedHandler) Handle(ctx context.Context, event subscription.PriceChanged) error {
+	return reconcileCharges(ctx, h.handler, event.SubscriptionId)
+}
+
+func reconcileCharges(ctx context.Context, handler reconcileChargesHandler, id types.SubscriptionID) error {
+	r := handler.Handle(ctx, command.ReconcileChargesCommand{
+		SubscriptionIds: []types.SubscriptionID{id},
+	})
+	return result.Match(r, func(report command.ReconcileChargesResult) error {
+		return errors.Join(report.Errors...)
+	}, func(err error) error {
+		return err
+	})
+}
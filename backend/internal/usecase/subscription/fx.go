@@ -4,10 +4,12 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/mistribe/subtracker/internal/domain/billing"
+	"github.com/mistribe/subtracker/internal/domain/charge"
 	"github.com/mistribe/subtracker/internal/domain/subscription"
 	"github.com/mistribe/subtracker/internal/ports"
 	"github.com/mistribe/subtracker/internal/shared"
 	"github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/event"
 	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
 )
 
@@ -18,10 +20,28 @@ func Module() fx.Option {
 			ports.AsQueryHandler[query.FindOneQuery, subscription.Subscription](query.NewFindOneQueryHandler),
 			ports.AsQueryHandler[query.FindAllQuery, shared.PaginatedResponse[subscription.Subscription]](query.NewFindAllQueryHandler),
 			ports.AsQueryHandler[query.GetQuotaUsage, []billing.EffectiveEntitlement](query.NewGetQuotaUsageHandler),
+			ports.AsQueryHandler[query.FindPriceHistoryQuery, []subscription.PriceChange](query.NewFindPriceHistoryQueryHandler),
+			ports.AsQueryHandler[query.FindChargesQuery, []charge.Charge](query.NewFindChargesQueryHandler),
+			ports.AsQueryHandler[query.FindPausesQuery, []subscription.Pause](query.NewFindPausesQueryHandler),
+			ports.AsQueryHandler[query.FamilySharesQuery, query.FamilySharesQueryResponse](query.NewFamilySharesQueryHandler),
+			ports.AsQueryHandler[query.FindPromotionsQuery, []subscription.Promotion](query.NewFindPromotionsQueryHandler),
+			ports.AsQueryHandler[query.SpendingQuery, query.SpendingQueryResponse](query.NewSpendingQueryHandler),
+			ports.AsQueryHandler[query.ForecastQuery, query.ForecastQueryResponse](query.NewForecastQueryHandler),
 
 			ports.AsCommandHandler[command.CreateSubscriptionCommand, subscription.Subscription](command.NewCreateSubscriptionCommandHandler),
 			ports.AsCommandHandler[command.UpdateSubscriptionCommand, subscription.Subscription](command.NewUpdateSubscriptionCommandHandler),
 			ports.AsCommandHandler[command.DeleteSubscriptionCommand, bool](command.NewDeleteSubscriptionCommandHandler),
+			ports.AsCommandHandler[command.ImportSubscriptionsCommand, command.ImportSubscriptionsResult](command.NewImportSubscriptionsCommandHandler),
+			ports.AsCommandHandler[command.AddPriceChangeCommand, subscription.Subscription](command.NewAddPriceChangeCommandHandler),
+			ports.AsCommandHandler[command.RecordChargeCommand, charge.Charge](command.NewRecordChargeCommandHandler),
+			ports.AsCommandHandler[command.PauseSubscriptionCommand, subscription.Subscription](command.NewPauseSubscriptionCommandHandler),
+			ports.AsCommandHandler[command.ResumeSubscriptionCommand, subscription.Subscription](command.NewResumeSubscriptionCommandHandler),
+			ports.AsCommandHandler[command.AddPromotionCommand, subscription.Subscription](command.NewAddPromotionCommandHandler),
+			ports.AsCommandHandler[command.ReconcileChargesCommand, command.ReconcileChargesResult](command.NewReconcileChargesCommandHandler),
+			ports.AsCommandHandler[command.RefreshCancellationDeadlinesCommand, command.RefreshCancellationDeadlinesResult](command.NewRefreshCancellationDeadlinesCommandHandler),
+
+			ports.AsEventHandler[subscription.SubscriptionCreated](event.NewSubscriptionCreatedHandler),
+			ports.AsEventHandler[subscription.PriceChanged](event.NewPriceChangedHandler),
 		),
 	)
 }
@@ -0,0 +1,160 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/decimal"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type FamilySharesQuery struct {
+	FamilyID types.FamilyID
+}
+
+func NewFamilySharesQuery(familyID types.FamilyID) FamilySharesQuery {
+	return FamilySharesQuery{FamilyID: familyID}
+}
+
+type FamilyMemberShareResponse struct {
+	MemberID types.FamilyMemberID
+	Name     string
+	Monthly  currency.Amount
+	Yearly   currency.Amount
+}
+
+type FamilySharesQueryResponse struct {
+	FamilyID     types.FamilyID
+	Members      []FamilyMemberShareResponse
+	TotalMonthly currency.Amount
+	TotalYearly  currency.Amount
+}
+
+type FamilySharesQueryHandler struct {
+	subscriptionRepository ports.SubscriptionRepository
+	familyRepository       ports.FamilyRepository
+	accountService         ports.AccountService
+	authService            ports.Authentication
+	exchange               ports.Exchange
+}
+
+func NewFamilySharesQueryHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	familyRepository ports.FamilyRepository,
+	accountService ports.AccountService,
+	authService ports.Authentication,
+	exchange ports.Exchange) *FamilySharesQueryHandler {
+	return &FamilySharesQueryHandler{
+		subscriptionRepository: subscriptionRepository,
+		familyRepository:       familyRepository,
+		accountService:         accountService,
+		authService:            authService,
+		exchange:               exchange,
+	}
+}
+
+func (h FamilySharesQueryHandler) Handle(
+	ctx context.Context,
+	query FamilySharesQuery) result.Result[FamilySharesQueryResponse] {
+	connectedAccount := h.authService.MustGetConnectedAccount(ctx)
+	currentUserID := connectedAccount.UserID()
+	preferredCurrency := h.accountService.GetPreferredCurrency(ctx, currentUserID)
+
+	fam, err := h.familyRepository.GetAccountFamily(ctx, currentUserID)
+	if err != nil {
+		return result.Fail[FamilySharesQueryResponse](err)
+	}
+	if fam == nil || fam.Id() != query.FamilyID {
+		return result.Fail[FamilySharesQueryResponse](family.ErrFamilyNotFound)
+	}
+
+	// The shares are converted at today's rate, all at once after every subscription is read
+	now := time.Now()
+	conversions := &ports.ConversionBatch{}
+	monthly := make(map[types.FamilyMemberID]decimal.Decimal)
+	yearly := make(map[types.FamilyMemberID]decimal.Decimal)
+	for sub := range h.subscriptionRepository.GetAllIt(ctx, currentUserID, "") {
+		// Personal subscriptions are not shared with the family
+		if !sub.IsActive() || sub.Owner().Type() != types.FamilyOwnerType {
+			continue
+		}
+
+		for memberID, amount := range h.memberShares(sub, currentUserID, fam, subscription.MonthlyRecurrency) {
+			conversions.AddForSubscription(sub.Id(), amount, now, func(converted currency.Amount) {
+				if converted.IsValid() {
+					monthly[memberID] = monthly[memberID].Add(converted.Decimal())
+				}
+			})
+		}
+		for memberID, amount := range h.memberShares(sub, currentUserID, fam, subscription.YearlyRecurrency) {
+			conversions.AddForSubscription(sub.Id(), amount, now, func(converted currency.Amount) {
+				if converted.IsValid() {
+					yearly[memberID] = yearly[memberID].Add(converted.Decimal())
+				}
+			})
+		}
+	}
+	if err := conversions.Convert(ctx, h.exchange, preferredCurrency); err != nil {
+		return result.Fail[FamilySharesQueryResponse](err)
+	}
+
+	totalMonthly := decimal.Zero
+	totalYearly := decimal.Zero
+	members := make([]FamilyMemberShareResponse, 0, fam.Members().Len())
+	for member := range fam.Members().It() {
+		totalMonthly = totalMonthly.Add(monthly[member.Id()])
+		totalYearly = totalYearly.Add(yearly[member.Id()])
+		members = append(members, FamilyMemberShareResponse{
+			MemberID: member.Id(),
+			Name:     member.Name(),
+			Monthly:  currency.NewAmountFromDecimal(monthly[member.Id()], preferredCurrency),
+			Yearly:   currency.NewAmountFromDecimal(yearly[member.Id()], preferredCurrency),
+		})
+	}
+
+	return result.Success(FamilySharesQueryResponse{
+		FamilyID:     fam.Id(),
+		Members:      members,
+		TotalMonthly: currency.NewAmountFromDecimal(totalMonthly, preferredCurrency),
+		TotalYearly:  currency.NewAmountFromDecimal(totalYearly, preferredCurrency),
+	})
+}
+
+// memberShares returns what each member pays for the subscription. Without split rules, a subscription paid by
+// the family is shared equally between its users and one paid by a member is entirely on that member.
+func (h FamilySharesQueryHandler) memberShares(
+	sub subscription.Subscription,
+	currentUserID types.UserID,
+	fam family.Family,
+	to subscription.RecurrencyType) map[types.FamilyMemberID]currency.Amount {
+	if shares := sub.GetMemberShares(to); shares != nil {
+		return shares
+	}
+
+	amount := sub.GetRecurrencyAmount(to)
+	if !amount.IsValid() {
+		return nil
+	}
+
+	if classifySubscription(sub, currentUserID, fam) == "family" {
+		members := sub.FamilyUsers().Values()
+		if len(members) == 0 {
+			for member := range fam.Members().It() {
+				members = append(members, member.Id())
+			}
+		}
+		return subscription.NewCostSplit(subscription.EqualCostSplit, nil).Split(amount, members)
+	}
+
+	if sub.Payer() == nil || sub.Payer().Type() != subscription.FamilyMemberPayer {
+		return nil
+	}
+	return map[types.FamilyMemberID]currency.Amount{
+		sub.Payer().MemberId(): amount,
+	}
+}
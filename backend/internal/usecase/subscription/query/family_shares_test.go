@@ -0,0 +1,121 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
+	"github.com/mistribe/subtracker/pkg/decimal"
+)
+
+func buildFamilySub(
+	amount float64,
+	fam family.Family,
+	payer subscription.Payer,
+	familyUsers []types.FamilyMemberID) subscription.Subscription {
+	return subscription.NewSubscription(
+		types.NewSubscriptionID(), nil, nil, nil, types.ProviderID(uuid.Must(uuid.NewV7())),
+		subscription.NewPrice(currency.NewAmount(amount, currency.USD)), nil, nil,
+		types.NewFamilyOwner(fam.Id()), payer, nil, nil, familyUsers, nil,
+		time.Now().AddDate(0, -2, 0), nil, nil, subscription.MonthlyRecurrency, nil, nil, time.Now(), time.Now(),
+	)
+}
+
+func TestFamilySharesQueryHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	userID := types.UserID("user-1")
+
+	fam := buildTestFamily(userID)
+	owner := fam.Members().Values()[0]
+	partner := family.NewMember(types.NewFamilyMemberID(), fam.Id(), "Partner", family.AdultMemberType, nil,
+		time.Now(), time.Now())
+	require.NoError(t, fam.AddMember(partner))
+
+	newHandler := func(t *testing.T, subs []subscription.Subscription) *query.FamilySharesQueryHandler {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		famRepo := ports.NewMockFamilyRepository(t)
+		acctService := ports.NewMockAccountService(t)
+		auth := ports.NewMockAuthentication(t)
+		exch := ports.NewMockExchange(t)
+
+		acc := account.NewMockConnectedAccount(t)
+		acc.EXPECT().UserID().Return(userID).Maybe()
+		auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc)
+		acctService.EXPECT().GetPreferredCurrency(mock.Anything, userID).Return(currency.USD)
+		famRepo.EXPECT().GetAccountFamily(mock.Anything, userID).Return(fam, nil)
+		subRepo.EXPECT().GetAllIt(mock.Anything, userID, "").Return(func(yield func(subscription.Subscription) bool) {
+			for _, sub := range subs {
+				if !yield(sub) {
+					return
+				}
+			}
+		}).Maybe()
+		exch.EXPECT().ToCurrencyBatch(mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(
+				ctx context.Context,
+				conversions []ports.Conversion,
+				target currency.Unit) ([]currency.Amount, error) {
+				// The manual rates of the subscription only apply when its id is given
+				for _, c := range conversions {
+					assert.NotNil(t, c.SubscriptionId)
+				}
+				return passThroughBatch(ctx, conversions, target)
+			}).Maybe()
+
+		return query.NewFamilySharesQueryHandler(subRepo, famRepo, acctService, auth, exch)
+	}
+
+	t.Run("shares follow the split rules and the classification", func(t *testing.T) {
+		split := buildFamilySub(12, fam, nil, []types.FamilyMemberID{owner.Id(), partner.Id()})
+		require.NoError(t, split.SetCostSplit(subscription.NewCostSplit(subscription.PercentageCostSplit,
+			[]subscription.CostShare{
+				{MemberId: owner.Id(), Value: decimal.NewFromInt(75)},
+				{MemberId: partner.Id(), Value: decimal.NewFromInt(25)},
+			})))
+		paidByPartner := buildFamilySub(6, fam, subscription.NewFamilyMemberPayer(fam.Id(), partner.Id()), nil)
+		paidByFamily := buildFamilySub(4, fam, nil, nil)
+		personal := buildSub(100, currency.USD, time.Now().AddDate(0, -2, 0), subscription.MonthlyRecurrency, 0,
+			nil, types.ProviderID(uuid.Must(uuid.NewV7())))
+
+		h := newHandler(t, []subscription.Subscription{split, paidByPartner, paidByFamily, personal})
+		res := h.Handle(ctx, query.NewFamilySharesQuery(fam.Id()))
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(out query.FamilySharesQueryResponse) {
+			require.Len(t, out.Members, 2)
+			shares := make(map[types.FamilyMemberID]query.FamilyMemberShareResponse)
+			for _, m := range out.Members {
+				shares[m.MemberID] = m
+			}
+			assert.InDelta(t, 9.0+2.0, shares[owner.Id()].Monthly.Value(), 0.0001)
+			assert.InDelta(t, 3.0+6.0+2.0, shares[partner.Id()].Monthly.Value(), 0.0001)
+			assert.InDelta(t, 12*11.0, shares[partner.Id()].Yearly.Value(), 0.0001)
+			assert.Equal(t, "Partner", shares[partner.Id()].Name)
+			assert.Equal(t, currency.USD, shares[owner.Id()].Monthly.Currency())
+			assert.InDelta(t, 22.0, out.TotalMonthly.Value(), 0.0001)
+			assert.InDelta(t, 12*22.0, out.TotalYearly.Value(), 0.0001)
+		})
+	})
+
+	t.Run("another family is not found", func(t *testing.T) {
+		h := newHandler(t, nil)
+		res := h.Handle(ctx, query.NewFamilySharesQuery(types.NewFamilyID()))
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, family.ErrFamilyNotFound)
+		})
+	})
+}
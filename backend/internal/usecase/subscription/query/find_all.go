@@ -21,8 +21,11 @@ type FindAllQuery struct {
 	Providers      []types.ProviderID
 	WithInactive   bool
 	SourceCurrency bool
-	Limit          int64
-	Offset         int64
+	// CancellationDeadlineFrom and CancellationDeadlineTo keep subscriptions whose last day to cancel falls within the window
+	CancellationDeadlineFrom *time.Time
+	CancellationDeadlineTo   *time.Time
+	Limit                    int64
+	Offset                   int64
 }
 
 func NewFindAllQuery(
@@ -33,6 +36,8 @@ func NewFindAllQuery(
 	users []types.UserID,
 	providers []types.ProviderID,
 	withInactive bool,
+	cancellationDeadlineFrom *time.Time,
+	cancellationDeadlineTo *time.Time,
 	size, page int64) FindAllQuery {
 	return FindAllQuery{
 		SearchText:   searchText,
@@ -44,6 +49,9 @@ func NewFindAllQuery(
 		ToDate:       toDate,
 		Users:        users,
 		Providers:    providers,
+
+		CancellationDeadlineFrom: cancellationDeadlineFrom,
+		CancellationDeadlineTo:   cancellationDeadlineTo,
 	}
 }
 
@@ -81,6 +89,8 @@ func (h FindAllQueryHandler) Handle(
 		query.Users,
 		query.Providers,
 		query.WithInactive,
+		query.CancellationDeadlineFrom,
+		query.CancellationDeadlineTo,
 		query.Limit,
 		query.Offset)
 	userId := connectedAccount.UserID()
@@ -91,18 +101,23 @@ func (h FindAllQueryHandler) Handle(
 
 	preferredCurrency := h.userService.GetPreferredCurrency(ctx, userId)
 
-	for _, sub := range subs {
-		if sub.Price() != nil && !query.SourceCurrency {
-			var convertedPrice currency.Amount
-			convertedPrice, err = h.exchange.ToCurrencyAt(ctx,
-				sub.Price().Amount(),
-				preferredCurrency,
-				sub.StartDate())
-			if err != nil {
-				return result.Fail[shared.PaginatedResponse[subscription.Subscription]](err)
+	if !query.SourceCurrency {
+		// Prices of the page are converted in a single batch
+		conversions := &ports.ConversionBatch{}
+		for _, sub := range subs {
+			if sub.Price() == nil {
+				continue
 			}
-
-			sub.Price().SetAmount(convertedPrice)
+			price := sub.Price()
+			conversions.AddForSubscription(sub.Id(), price.Amount(), sub.StartDate(), func(convertedPrice currency.Amount) {
+				// A price without a known rate is kept in its own currency
+				if convertedPrice.IsValid() {
+					price.SetAmount(convertedPrice)
+				}
+			})
+		}
+		if err = conversions.Convert(ctx, h.exchange, preferredCurrency); err != nil {
+			return result.Fail[shared.PaginatedResponse[subscription.Subscription]](err)
 		}
 	}
 
@@ -1,6 +1,7 @@
 package query_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/mistribe/subtracker/internal/domain/account"
 	"github.com/mistribe/subtracker/internal/domain/currency"
@@ -26,16 +28,23 @@ func newSubscriptionWithPrice(amount float64, cur currency.Unit) subscription.Su
 		types.NewSubscriptionID(),
 		&name,
 		nil,
+		nil,
 		types.ProviderID(uuid.Must(uuid.NewV7())),
 		price,
+		nil,
+		nil,
 		types.NewPersonalOwner(types.UserID("user-1")),
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
 		time.Now().Add(-24*time.Hour),
 		nil,
+		nil,
 		subscription.MonthlyRecurrency,
 		nil,
+		nil,
 		time.Now(),
 		time.Now(),
 	)
@@ -50,7 +59,9 @@ func TestFindAllQueryHandler_Handle(t *testing.T) {
 		return acc
 	}
 
-	buildParams := func() query.FindAllQuery { return query.NewFindAllQuery("", nil, nil, nil, nil, nil, false, 10, 0) }
+	buildParams := func() query.FindAllQuery {
+		return query.NewFindAllQuery("", nil, nil, nil, nil, nil, false, nil, nil, 10, 0)
+	}
 
 	t.Run("returns fault when repository returns error", func(t *testing.T) {
 		subRepo := ports.NewMockSubscriptionRepository(t)
@@ -61,7 +72,7 @@ func TestFindAllQueryHandler_Handle(t *testing.T) {
 
 		acc := newMockConnectedAccount(userID)
 		auth.EXPECT().MustGetConnectedAccount(t.Context()).Return(acc)
-		params := ports.NewSubscriptionQueryParameters("", nil, nil, nil, nil, nil, false, 10, 0)
+		params := ports.NewSubscriptionQueryParameters("", nil, nil, nil, nil, nil, false, nil, nil, 10, 0)
 		subRepo.EXPECT().GetAllForUser(t.Context(), userID, params).Return(nil, int64(0), errors.New("db"))
 
 		h := query.NewFindAllQueryHandler(subRepo, acctSvc, exchange, auth, authz)
@@ -80,10 +91,10 @@ func TestFindAllQueryHandler_Handle(t *testing.T) {
 		auth.EXPECT().MustGetConnectedAccount(t.Context()).Return(acc)
 
 		s1 := newSubscriptionWithPrice(10, currency.USD)
-		params := ports.NewSubscriptionQueryParameters("", nil, nil, nil, nil, nil, false, 10, 0)
+		params := ports.NewSubscriptionQueryParameters("", nil, nil, nil, nil, nil, false, nil, nil, 10, 0)
 		subRepo.EXPECT().GetAllForUser(t.Context(), userID, params).Return([]subscription.Subscription{s1}, int64(1), nil)
 		acctSvc.EXPECT().GetPreferredCurrency(t.Context(), userID).Return(currency.USD)
-		exchange.EXPECT().ToCurrencyAt(t.Context(), mock.Anything, currency.USD, mock.Anything).Return(currency.NewInvalidAmount(), errors.New("conv"))
+		exchange.EXPECT().ToCurrencyBatch(t.Context(), mock.Anything, currency.USD).Return(nil, errors.New("conv"))
 
 		h := query.NewFindAllQueryHandler(subRepo, acctSvc, exchange, auth, authz)
 		res := h.Handle(t.Context(), buildParams())
@@ -102,10 +113,18 @@ func TestFindAllQueryHandler_Handle(t *testing.T) {
 
 		s1 := newSubscriptionWithPrice(10, currency.USD)
 		s2 := newSubscriptionWithPrice(20, currency.USD)
-		params := ports.NewSubscriptionQueryParameters("", nil, nil, nil, nil, nil, false, 10, 0)
+		params := ports.NewSubscriptionQueryParameters("", nil, nil, nil, nil, nil, false, nil, nil, 10, 0)
 		subRepo.EXPECT().GetAllForUser(t.Context(), userID, params).Return([]subscription.Subscription{s1, s2}, int64(2), nil)
 		acctSvc.EXPECT().GetPreferredCurrency(t.Context(), userID).Return(currency.EUR)
-		exchange.EXPECT().ToCurrencyAt(t.Context(), mock.Anything, currency.EUR, mock.Anything).Return(currency.NewAmount(11, currency.EUR), nil).Twice()
+		exchange.EXPECT().ToCurrencyBatch(t.Context(), mock.Anything, currency.EUR).
+			RunAndReturn(func(
+				_ context.Context,
+				conversions []ports.Conversion,
+				target currency.Unit) ([]currency.Amount, error) {
+				// Both prices are converted in a single call, the second one has no known rate
+				require.Len(t, conversions, 2)
+				return []currency.Amount{currency.NewAmount(11, target), currency.NewInvalidAmount()}, nil
+			}).Once()
 
 		h := query.NewFindAllQueryHandler(subRepo, acctSvc, exchange, auth, authz)
 		res := h.Handle(t.Context(), buildParams())
@@ -116,5 +135,7 @@ func TestFindAllQueryHandler_Handle(t *testing.T) {
 		assert.Len(t, pg.Data(), 2)
 		assert.Equal(t, 11.0, pg.Data()[0].Price().Amount().Value())
 		assert.Equal(t, currency.EUR, pg.Data()[0].Price().Amount().Currency())
+		assert.Equal(t, 20.0, pg.Data()[1].Price().Amount().Value())
+		assert.Equal(t, currency.USD, pg.Data()[1].Price().Amount().Currency())
 	})
 }
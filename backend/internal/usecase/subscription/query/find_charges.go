@@ -0,0 +1,58 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type FindChargesQuery struct {
+	SubscriptionID types.SubscriptionID
+}
+
+func NewFindChargesQuery(subscriptionID types.SubscriptionID) FindChargesQuery {
+	return FindChargesQuery{SubscriptionID: subscriptionID}
+}
+
+type FindChargesQueryHandler struct {
+	subscriptionRepository ports.SubscriptionRepository
+	chargeRepository       ports.ChargeRepository
+	authService            ports.Authentication
+}
+
+func NewFindChargesQueryHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	chargeRepository ports.ChargeRepository,
+	authService ports.Authentication) *FindChargesQueryHandler {
+	return &FindChargesQueryHandler{
+		subscriptionRepository: subscriptionRepository,
+		chargeRepository:       chargeRepository,
+		authService:            authService,
+	}
+}
+
+func (h FindChargesQueryHandler) Handle(
+	ctx context.Context,
+	query FindChargesQuery) result.Result[[]charge.Charge] {
+	connectedAccount := h.authService.MustGetConnectedAccount(ctx)
+	sub, err := h.subscriptionRepository.GetByIdForUser(ctx, connectedAccount.UserID(), query.SubscriptionID)
+	if err != nil {
+		return result.Fail[[]charge.Charge](err)
+	}
+
+	if sub == nil {
+		return result.Fail[[]charge.Charge](subscription.ErrSubscriptionNotFound)
+	}
+
+	// The ledger is reconciled by ReconcileChargesCommand, the charges are ordered by due date
+	charges, err := h.chargeRepository.GetBySubscriptionId(ctx, sub.Id())
+	if err != nil {
+		return result.Fail[[]charge.Charge](err)
+	}
+
+	return result.Success(charges)
+}
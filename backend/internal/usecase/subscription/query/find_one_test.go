@@ -28,9 +28,9 @@ func TestFindOneQueryHandler_Handle(t *testing.T) {
 		name := "FindOne"
 		price := subscription.NewPrice(currency.NewAmount(10, currency.USD))
 		return subscription.NewSubscription(
-			types.NewSubscriptionID(), &name, nil, types.ProviderID(uuid.Must(uuid.NewV7())), price,
-			types.NewPersonalOwner(userID), nil, nil, nil,
-			time.Now().Add(-24*time.Hour), nil, subscription.MonthlyRecurrency, nil, time.Now(), time.Now())
+			types.NewSubscriptionID(), &name, nil, nil, types.ProviderID(uuid.Must(uuid.NewV7())), price, nil, nil,
+			types.NewPersonalOwner(userID), nil, nil, nil, nil, nil,
+			time.Now().Add(-24*time.Hour), nil, nil, subscription.MonthlyRecurrency, nil, nil, time.Now(), time.Now())
 	}
 
 	t.Run("returns fault when repository errors", func(t *testing.T) {
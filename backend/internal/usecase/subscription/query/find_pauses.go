@@ -0,0 +1,48 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type FindPausesQuery struct {
+	SubscriptionID types.SubscriptionID
+}
+
+func NewFindPausesQuery(subscriptionID types.SubscriptionID) FindPausesQuery {
+	return FindPausesQuery{SubscriptionID: subscriptionID}
+}
+
+type FindPausesQueryHandler struct {
+	subscriptionRepository ports.SubscriptionRepository
+	authService            ports.Authentication
+}
+
+func NewFindPausesQueryHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	authService ports.Authentication) *FindPausesQueryHandler {
+	return &FindPausesQueryHandler{
+		subscriptionRepository: subscriptionRepository,
+		authService:            authService,
+	}
+}
+
+func (h FindPausesQueryHandler) Handle(
+	ctx context.Context,
+	query FindPausesQuery) result.Result[[]subscription.Pause] {
+	connectedAccount := h.authService.MustGetConnectedAccount(ctx)
+	sub, err := h.subscriptionRepository.GetByIdForUser(ctx, connectedAccount.UserID(), query.SubscriptionID)
+	if err != nil {
+		return result.Fail[[]subscription.Pause](err)
+	}
+
+	if sub == nil {
+		return result.Fail[[]subscription.Pause](subscription.ErrSubscriptionNotFound)
+	}
+
+	return result.Success(sub.Pauses().Values())
+}
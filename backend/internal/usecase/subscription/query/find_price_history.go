@@ -0,0 +1,61 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type FindPriceHistoryQuery struct {
+	SubscriptionID types.SubscriptionID
+}
+
+func NewFindPriceHistoryQuery(subscriptionID types.SubscriptionID) FindPriceHistoryQuery {
+	return FindPriceHistoryQuery{SubscriptionID: subscriptionID}
+}
+
+type FindPriceHistoryQueryHandler struct {
+	subscriptionRepository ports.SubscriptionRepository
+	authService            ports.Authentication
+}
+
+func NewFindPriceHistoryQueryHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	authService ports.Authentication) *FindPriceHistoryQueryHandler {
+	return &FindPriceHistoryQueryHandler{
+		subscriptionRepository: subscriptionRepository,
+		authService:            authService,
+	}
+}
+
+func (h FindPriceHistoryQueryHandler) Handle(
+	ctx context.Context,
+	query FindPriceHistoryQuery) result.Result[[]subscription.PriceChange] {
+	connectedAccount := h.authService.MustGetConnectedAccount(ctx)
+	sub, err := h.subscriptionRepository.GetByIdForUser(ctx, connectedAccount.UserID(), query.SubscriptionID)
+	if err != nil {
+		return result.Fail[[]subscription.PriceChange](err)
+	}
+
+	if sub == nil {
+		return result.Fail[[]subscription.PriceChange](subscription.ErrSubscriptionNotFound)
+	}
+
+	// Subscriptions without any recorded change have a single price since their start
+	if sub.PriceHistory().IsEmpty() {
+		if sub.Price() == nil {
+			return result.Success([]subscription.PriceChange{})
+		}
+		return result.Success([]subscription.PriceChange{
+			{
+				EffectiveFrom: sub.StartDate(),
+				Amount:        sub.Price().Amount(),
+			},
+		})
+	}
+
+	return result.Success(sub.PriceHistory().Values())
+}
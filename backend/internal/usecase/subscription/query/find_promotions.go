@@ -0,0 +1,48 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type FindPromotionsQuery struct {
+	SubscriptionID types.SubscriptionID
+}
+
+func NewFindPromotionsQuery(subscriptionID types.SubscriptionID) FindPromotionsQuery {
+	return FindPromotionsQuery{SubscriptionID: subscriptionID}
+}
+
+type FindPromotionsQueryHandler struct {
+	subscriptionRepository ports.SubscriptionRepository
+	authService            ports.Authentication
+}
+
+func NewFindPromotionsQueryHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	authService ports.Authentication) *FindPromotionsQueryHandler {
+	return &FindPromotionsQueryHandler{
+		subscriptionRepository: subscriptionRepository,
+		authService:            authService,
+	}
+}
+
+func (h FindPromotionsQueryHandler) Handle(
+	ctx context.Context,
+	query FindPromotionsQuery) result.Result[[]subscription.Promotion] {
+	connectedAccount := h.authService.MustGetConnectedAccount(ctx)
+	sub, err := h.subscriptionRepository.GetByIdForUser(ctx, connectedAccount.UserID(), query.SubscriptionID)
+	if err != nil {
+		return result.Fail[[]subscription.Promotion](err)
+	}
+
+	if sub == nil {
+		return result.Fail[[]subscription.Promotion](subscription.ErrSubscriptionNotFound)
+	}
+
+	return result.Success(sub.Promotions().Values())
+}
@@ -0,0 +1,172 @@
+package query
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/decimal"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+const (
+	// DefaultForecastMonths is the horizon of a forecast when none is requested
+	DefaultForecastMonths = 12
+	// MaxForecastMonths is the longest horizon of a forecast
+	MaxForecastMonths = 36
+)
+
+// ForecastQuery lists the charges expected from now until the end of the Months-th month, the current month
+// being the first one
+type ForecastQuery struct {
+	Months int
+}
+
+func NewForecastQuery(months int) ForecastQuery {
+	if months == 0 {
+		months = DefaultForecastMonths
+	}
+	return ForecastQuery{Months: months}
+}
+
+type ForecastChargeResponse struct {
+	SubscriptionId types.SubscriptionID
+	ProviderId     types.ProviderID
+	At             time.Time
+	// Total is the charge in the preferred currency
+	Total currency.Amount
+	// Source is the charge in the currency of the subscription
+	Source currency.Amount
+	Family bool
+}
+
+type ForecastMonthResponse struct {
+	Month    time.Time
+	Total    currency.Amount
+	Personal currency.Amount
+	Family   currency.Amount
+	Charges  []ForecastChargeResponse
+}
+
+type ForecastQueryResponse struct {
+	Months   []ForecastMonthResponse
+	Total    currency.Amount
+	Personal currency.Amount
+	Family   currency.Amount
+}
+
+type ForecastQueryHandler struct {
+	subscriptionRepository ports.SubscriptionRepository
+	familyRepository       ports.FamilyRepository
+	accountService         ports.AccountService
+	authService            ports.Authentication
+	exchange               ports.Exchange
+}
+
+func NewForecastQueryHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	familyRepository ports.FamilyRepository,
+	accountService ports.AccountService,
+	authService ports.Authentication,
+	exchange ports.Exchange) *ForecastQueryHandler {
+	return &ForecastQueryHandler{
+		subscriptionRepository: subscriptionRepository,
+		familyRepository:       familyRepository,
+		accountService:         accountService,
+		authService:            authService,
+		exchange:               exchange,
+	}
+}
+
+func (h ForecastQueryHandler) Handle(
+	ctx context.Context,
+	query ForecastQuery) result.Result[ForecastQueryResponse] {
+	if query.Months <= 0 || query.Months > MaxForecastMonths {
+		return result.Fail[ForecastQueryResponse](subscription.ErrInvalidForecastHorizon)
+	}
+
+	connectedAccount := h.authService.MustGetConnectedAccount(ctx)
+	currentUserID := connectedAccount.UserID()
+	preferredCurrency := h.accountService.GetPreferredCurrency(ctx, currentUserID)
+	timezone := h.accountService.GetTimezone(ctx, currentUserID)
+
+	// Get family for payer classification
+	family, err := h.familyRepository.GetAccountFamily(ctx, currentUserID)
+	if err != nil {
+		return result.Fail[ForecastQueryResponse](err)
+	}
+
+	now := time.Now().In(timezone)
+	firstMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, timezone)
+	until := firstMonth.AddDate(0, query.Months, 0)
+
+	months := make([]ForecastMonthResponse, query.Months)
+	for index := range months {
+		months[index].Month = firstMonth.AddDate(0, index, 0)
+		months[index].Charges = []ForecastChargeResponse{}
+	}
+	personal := make([]decimal.Decimal, query.Months)
+	familyTotals := make([]decimal.Decimal, query.Months)
+	var conversions ports.ConversionBatch
+	for sub := range h.subscriptionRepository.GetAllIt(ctx, currentUserID, "") {
+		classification := classifySubscription(sub, currentUserID, family)
+		// Skip subscriptions paid by other family members
+		if classification == "exclude" {
+			continue
+		}
+
+		for _, c := range sub.UpcomingChargesIn(until, timezone) {
+			index := (c.DueDate.Year()-firstMonth.Year())*12 + int(c.DueDate.Month()) - int(firstMonth.Month())
+			if index < 0 || index >= query.Months {
+				continue
+			}
+
+			// Future rates are unknown, charges are converted at the latest rate
+			conversions.AddForSubscription(sub.Id(), c.Amount, now, func(total currency.Amount) {
+				if !total.IsValid() {
+					return
+				}
+				if classification == "family" {
+					familyTotals[index] = familyTotals[index].Add(total.Decimal())
+				} else {
+					personal[index] = personal[index].Add(total.Decimal())
+				}
+				months[index].Charges = append(months[index].Charges, ForecastChargeResponse{
+					SubscriptionId: sub.Id(),
+					ProviderId:     sub.ProviderId(),
+					At:             c.DueDate,
+					Total:          total,
+					Source:         c.Amount,
+					Family:         classification == "family",
+				})
+			})
+		}
+	}
+	if err = conversions.Convert(ctx, h.exchange, preferredCurrency); err != nil {
+		return result.Fail[ForecastQueryResponse](err)
+	}
+
+	personalTotal := decimal.Zero
+	familyTotal := decimal.Zero
+	for index := range months {
+		months[index].Personal = currency.NewAmountFromDecimal(personal[index], preferredCurrency)
+		months[index].Family = currency.NewAmountFromDecimal(familyTotals[index], preferredCurrency)
+		months[index].Total = currency.NewAmountFromDecimal(personal[index].Add(familyTotals[index]), preferredCurrency)
+		sort.SliceStable(months[index].Charges, func(i, j int) bool {
+			return months[index].Charges[i].At.Before(months[index].Charges[j].At)
+		})
+		personalTotal = personalTotal.Add(personal[index])
+		familyTotal = familyTotal.Add(familyTotals[index])
+	}
+
+	return result.Success(ForecastQueryResponse{
+		Months:   months,
+		Total:    currency.NewAmountFromDecimal(personalTotal.Add(familyTotal), preferredCurrency),
+		Personal: currency.NewAmountFromDecimal(personalTotal, preferredCurrency),
+		Family:   currency.NewAmountFromDecimal(familyTotal, preferredCurrency),
+	})
+}
@@ -0,0 +1,153 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+)
+
+func TestForecastQueryHandler_Handle(t *testing.T) {
+	userID := types.UserID("user-1")
+	testFamily := buildTestFamily(userID)
+	now := time.Now().UTC()
+	firstMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	newSub := func(
+		amount float64,
+		cur currency.Unit,
+		owner types.Owner,
+		start time.Time,
+		endDate *time.Time,
+		freeTrial subscription.FreeTrial,
+		recurrency subscription.RecurrencyType) subscription.Subscription {
+		return subscription.NewSubscription(
+			types.NewSubscriptionID(), nil, freeTrial, nil, types.NewProviderID(),
+			subscription.NewPrice(currency.NewAmount(amount, cur)), nil, nil, owner, nil, nil, nil, nil, nil,
+			start, endDate, nil, recurrency, nil, nil, time.Now(), time.Now())
+	}
+	personal := types.NewPersonalOwner(userID)
+	trialStart := firstMonth.AddDate(0, 1, 0)
+	endDate := firstMonth.AddDate(0, 3, 0)
+	subs := []subscription.Subscription{
+		// Renews on the first day of each month, the renewal of the current month is already past
+		newSub(10, currency.USD, personal, firstMonth.AddDate(0, -2, 0), nil, nil,
+			subscription.MonthlyRecurrency),
+		// Renews in the fourth month of the horizon
+		newSub(120, currency.EUR, personal, firstMonth.AddDate(-1, 3, 0), nil, nil,
+			subscription.YearlyRecurrency),
+		// Paid by the family
+		newSub(20, currency.USD, types.NewFamilyOwner(testFamily.Id()), firstMonth.AddDate(0, -2, 0), nil, nil,
+			subscription.MonthlyRecurrency),
+		// Starts next month with two free months
+		newSub(5, currency.USD, personal, trialStart, nil,
+			subscription.NewFreeTrial(trialStart, trialStart.AddDate(0, 2, 0)), subscription.MonthlyRecurrency),
+		// Ends in the fourth month of the horizon
+		newSub(7, currency.USD, personal, firstMonth.AddDate(-1, 0, 0), &endDate, nil,
+			subscription.MonthlyRecurrency),
+	}
+
+	newHandler := func(t *testing.T) *query.ForecastQueryHandler {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		famRepo := ports.NewMockFamilyRepository(t)
+		acctService := ports.NewMockAccountService(t)
+		auth := ports.NewMockAuthentication(t)
+		exch := ports.NewMockExchange(t)
+
+		acc := account.NewMockConnectedAccount(t)
+		acc.EXPECT().UserID().Return(userID).Maybe()
+		auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc).Maybe()
+		acctService.EXPECT().GetPreferredCurrency(mock.Anything, userID).Return(currency.USD).Maybe()
+		acctService.EXPECT().GetTimezone(mock.Anything, userID).Return(time.UTC).Maybe()
+		famRepo.EXPECT().GetAccountFamily(mock.Anything, userID).Return(testFamily, nil).Maybe()
+		subRepo.EXPECT().GetAllIt(mock.Anything, userID, "").
+			Return(func(yield func(subscription.Subscription) bool) {
+				for _, sub := range subs {
+					if !yield(sub) {
+						return
+					}
+				}
+			}).Maybe()
+		// One euro is worth two dollars
+		exch.EXPECT().ToCurrencyBatch(mock.Anything, mock.Anything, currency.USD).
+			RunAndReturn(func(
+				_ context.Context,
+				conversions []ports.Conversion,
+				target currency.Unit) ([]currency.Amount, error) {
+				return herd.Select(conversions, func(c ports.Conversion) currency.Amount {
+					if c.Amount.Currency() == currency.EUR {
+						return currency.NewAmount(c.Amount.Value()*2, target)
+					}
+					return c.Amount
+				}), nil
+			}).Maybe()
+
+		return query.NewForecastQueryHandler(subRepo, famRepo, acctService, auth, exch)
+	}
+
+	t.Run("expands the renewals month by month", func(t *testing.T) {
+		h := newHandler(t)
+		res := h.Handle(t.Context(), query.NewForecastQuery(6))
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(r query.ForecastQueryResponse) {
+			require.Len(t, r.Months, 6)
+			expectedPersonal := []float64{0, 17, 17, 255, 15, 15}
+			expectedFamily := []float64{0, 20, 20, 20, 20, 20}
+			for i, month := range r.Months {
+				assert.Equal(t, firstMonth.AddDate(0, i, 0), month.Month)
+				assert.Equal(t, expectedPersonal[i], month.Personal.Value(), "personal spending of month %d", i)
+				assert.Equal(t, expectedFamily[i], month.Family.Value(), "family spending of month %d", i)
+				assert.Equal(t, expectedPersonal[i]+expectedFamily[i], month.Total.Value())
+				for j, charge := range month.Charges {
+					assert.Equal(t, month.Month.Year(), charge.At.Year())
+					assert.Equal(t, month.Month.Month(), charge.At.Month())
+					if j > 0 {
+						assert.False(t, charge.At.Before(month.Charges[j-1].At))
+					}
+				}
+			}
+			assert.Empty(t, r.Months[0].Charges)
+			require.Len(t, r.Months[3].Charges, 4)
+
+			var yearly query.ForecastChargeResponse
+			for _, charge := range r.Months[3].Charges {
+				if charge.Source.Currency() == currency.EUR {
+					yearly = charge
+				}
+			}
+			assert.Equal(t, 120.0, yearly.Source.Value())
+			assert.Equal(t, 240.0, yearly.Total.Value())
+			assert.False(t, yearly.Family)
+
+			assert.Equal(t, 319.0, r.Personal.Value())
+			assert.Equal(t, 100.0, r.Family.Value())
+			assert.Equal(t, 419.0, r.Total.Value())
+		})
+	})
+
+	t.Run("rejects horizons that are too long", func(t *testing.T) {
+		h := newHandler(t)
+		res := h.Handle(t.Context(), query.NewForecastQuery(query.MaxForecastMonths+1))
+
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, subscription.ErrInvalidForecastHorizon)
+		})
+	})
+
+	t.Run("defaults to twelve months", func(t *testing.T) {
+		assert.Equal(t, query.DefaultForecastMonths, query.NewForecastQuery(0).Months)
+	})
+}
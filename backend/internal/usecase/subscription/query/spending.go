@@ -0,0 +1,277 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/decimal"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+)
+
+const (
+	// MaxSpendingBuckets is the maximum number of buckets a spending query can return
+	MaxSpendingBuckets = 120
+	// defaultSpendingBuckets is the number of buckets returned when the range has no start
+	defaultSpendingBuckets = 12
+
+	// FamilyPayerSpendingKey is the key of the spending paid by the family when grouping by payer
+	FamilyPayerSpendingKey = "family"
+	// UnassignedSpendingKey is the key of the spending without a label or without a payer
+	UnassignedSpendingKey = ""
+)
+
+// SpendingQuery aggregates the charges of the subscriptions in buckets between From and To, both included,
+// grouped by GroupBy
+type SpendingQuery struct {
+	From    time.Time
+	To      time.Time
+	Bucket  subscription.SpendingBucket
+	GroupBy subscription.SpendingDimension
+}
+
+// NewSpendingQuery creates a spending query, without an end the range ends today and without a start it covers
+// the last twelve buckets
+func NewSpendingQuery(
+	from *time.Time,
+	to *time.Time,
+	bucket subscription.SpendingBucket,
+	groupBy subscription.SpendingDimension) SpendingQuery {
+	end := time.Now()
+	if to != nil {
+		end = *to
+	}
+	start := bucket.Start(end).AddDate(0, -bucket.Months()*(defaultSpendingBuckets-1), 0)
+	if from != nil {
+		start = *from
+	}
+
+	return SpendingQuery{
+		From:    start,
+		To:      end,
+		Bucket:  bucket,
+		GroupBy: groupBy,
+	}
+}
+
+type SpendingGroupResponse struct {
+	Key   string
+	Total currency.Amount
+}
+
+type SpendingBucketResponse struct {
+	Start time.Time
+	End   time.Time
+	// Total is what was spent in the bucket, a charge in several groups is only counted once
+	Total currency.Amount
+	// Groups has one entry per key of the response, in the same order, zero when nothing was spent
+	Groups []SpendingGroupResponse
+}
+
+type SpendingQueryResponse struct {
+	Bucket  subscription.SpendingBucket
+	GroupBy subscription.SpendingDimension
+	// Keys lists every group found in the range, the most expensive first
+	Keys    []string
+	Buckets []SpendingBucketResponse
+	Total   currency.Amount
+}
+
+type SpendingQueryHandler struct {
+	subscriptionRepository ports.SubscriptionRepository
+	chargeRepository       ports.ChargeRepository
+	accountService         ports.AccountService
+	authService            ports.Authentication
+	exchange               ports.Exchange
+}
+
+func NewSpendingQueryHandler(
+	subscriptionRepository ports.SubscriptionRepository,
+	chargeRepository ports.ChargeRepository,
+	accountService ports.AccountService,
+	authService ports.Authentication,
+	exchange ports.Exchange) *SpendingQueryHandler {
+	return &SpendingQueryHandler{
+		subscriptionRepository: subscriptionRepository,
+		chargeRepository:       chargeRepository,
+		accountService:         accountService,
+		authService:            authService,
+		exchange:               exchange,
+	}
+}
+
+func (h SpendingQueryHandler) Handle(
+	ctx context.Context,
+	query SpendingQuery) result.Result[SpendingQueryResponse] {
+	if query.Bucket.Months() == 0 {
+		return result.Fail[SpendingQueryResponse](subscription.ErrUnknownSpendingBucket)
+	}
+	if _, err := subscription.ParseSpendingDimension(query.GroupBy.String()); err != nil {
+		return result.Fail[SpendingQueryResponse](err)
+	}
+	if query.To.Before(query.From) {
+		return result.Fail[SpendingQueryResponse](subscription.ErrInvalidSpendingRange)
+	}
+
+	var starts []time.Time
+	for start := query.Bucket.Start(query.From); !start.After(query.To); start = query.Bucket.Next(start) {
+		if len(starts) == MaxSpendingBuckets {
+			return result.Fail[SpendingQueryResponse](subscription.ErrSpendingRangeTooLarge)
+		}
+		starts = append(starts, start)
+	}
+	end := query.Bucket.Next(starts[len(starts)-1])
+
+	connectedAccount := h.authService.MustGetConnectedAccount(ctx)
+	currentUserID := connectedAccount.UserID()
+	preferredCurrency := h.accountService.GetPreferredCurrency(ctx, currentUserID)
+
+	subscriptions := slices.Collect(h.subscriptionRepository.GetAllIt(ctx, currentUserID, ""))
+	// Recorded charges replace the expected amount of their occurrence
+	recordedCharges, err := h.chargeRepository.GetRecordedBySubscriptionIds(ctx,
+		herd.Select(subscriptions, func(sub subscription.Subscription) types.SubscriptionID {
+			return sub.Id()
+		})...)
+	if err != nil {
+		return result.Fail[SpendingQueryResponse](err)
+	}
+	chargesByDueDate := make(map[types.SubscriptionID]map[int64]charge.Charge)
+	for _, c := range recordedCharges {
+		if chargesByDueDate[c.SubscriptionId()] == nil {
+			chargesByDueDate[c.SubscriptionId()] = make(map[int64]charge.Charge)
+		}
+		chargesByDueDate[c.SubscriptionId()][c.DueDate().Unix()] = c
+	}
+
+	// Each charge is converted at the rate of the start of its bucket, all at once after every charge is read.
+	// The bucket total is kept apart since a charge can be added to several groups.
+	conversions := &ports.ConversionBatch{}
+	converted := make([]map[string]decimal.Decimal, len(starts))
+	bucketTotals := make([]decimal.Decimal, len(starts))
+	totals := make(map[string]decimal.Decimal)
+	for _, sub := range subscriptions {
+		keys := spendingKeys(sub, query.GroupBy)
+		for _, dueDate := range sub.BillingDates(end.Add(-time.Nanosecond)) {
+			if dueDate.Before(starts[0]) {
+				continue
+			}
+			if sub.FreeTrial() != nil && sub.FreeTrial().Covers(dueDate) {
+				continue
+			}
+
+			amount := sub.PriceAt(dueDate)
+			if c, ok := chargesByDueDate[sub.Id()][dueDate.Unix()]; ok {
+				amount = c.Amount()
+			}
+			if !amount.IsValid() {
+				continue
+			}
+
+			index := bucketIndex(starts[0], query.Bucket, dueDate)
+			if converted[index] == nil {
+				converted[index] = make(map[string]decimal.Decimal)
+			}
+			// A group is listed even when its amounts cannot be converted
+			for _, key := range keys {
+				if _, ok := totals[key]; !ok {
+					totals[key] = decimal.Zero
+				}
+			}
+			conversions.AddForSubscription(sub.Id(), amount, starts[index], func(amount currency.Amount) {
+				if !amount.IsValid() {
+					return
+				}
+				bucketTotals[index] = bucketTotals[index].Add(amount.Decimal())
+				for _, key := range keys {
+					converted[index][key] = converted[index][key].Add(amount.Decimal())
+					totals[key] = totals[key].Add(amount.Decimal())
+				}
+			})
+		}
+	}
+	if err := conversions.Convert(ctx, h.exchange, preferredCurrency); err != nil {
+		return result.Fail[SpendingQueryResponse](err)
+	}
+
+	keys := make([]string, 0, len(totals))
+	for key := range totals {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if cmp := totals[keys[i]].Cmp(totals[keys[j]]); cmp != 0 {
+			return cmp > 0
+		}
+		return keys[i] < keys[j]
+	})
+
+	total := decimal.Zero
+	buckets := make([]SpendingBucketResponse, len(starts))
+	for index, start := range starts {
+		bucketTotal := bucketTotals[index]
+		groups := make([]SpendingGroupResponse, len(keys))
+		for i, key := range keys {
+			groups[i] = SpendingGroupResponse{
+				Key:   key,
+				Total: currency.NewAmountFromDecimal(converted[index][key], preferredCurrency),
+			}
+		}
+		total = total.Add(bucketTotal)
+		buckets[index] = SpendingBucketResponse{
+			Start:  start,
+			End:    query.Bucket.Next(start),
+			Total:  currency.NewAmountFromDecimal(bucketTotal, preferredCurrency),
+			Groups: groups,
+		}
+	}
+
+	return result.Success(SpendingQueryResponse{
+		Bucket:  query.Bucket,
+		GroupBy: query.GroupBy,
+		Keys:    keys,
+		Buckets: buckets,
+		Total:   currency.NewAmountFromDecimal(total, preferredCurrency),
+	})
+}
+
+// spendingKeys returns the groups the charges of a subscription are added to.
+// A subscription with several labels is counted in each of them.
+func spendingKeys(sub subscription.Subscription, groupBy subscription.SpendingDimension) []string {
+	switch groupBy {
+	case subscription.LabelSpendingDimension:
+		var keys []string
+		for label := range sub.Labels().It() {
+			keys = append(keys, label.LabelId.String())
+		}
+		if len(keys) == 0 {
+			return []string{UnassignedSpendingKey}
+		}
+		return keys
+	case subscription.PayerSpendingDimension:
+		payer := sub.Payer()
+		if payer == nil {
+			return []string{UnassignedSpendingKey}
+		}
+		if payer.Type() == subscription.FamilyPayer {
+			return []string{FamilyPayerSpendingKey}
+		}
+		return []string{payer.MemberId().String()}
+	case subscription.OwnerTypeSpendingDimension:
+		return []string{sub.Owner().Type().String()}
+	default:
+		return []string{sub.ProviderId().String()}
+	}
+}
+
+// bucketIndex returns the index of the bucket containing t, buckets starting at first
+func bucketIndex(first time.Time, bucket subscription.SpendingBucket, t time.Time) int {
+	start := bucket.Start(t)
+	months := (start.Year()-first.Year())*12 + int(start.Month()) - int(first.Month())
+	return months / bucket.Months()
+}
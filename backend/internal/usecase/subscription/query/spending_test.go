@@ -0,0 +1,200 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/charge"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
+	"github.com/mistribe/subtracker/pkg/x"
+	"github.com/mistribe/subtracker/pkg/x/herd"
+)
+
+func TestSpendingQueryHandler_Handle(t *testing.T) {
+	userID := types.UserID("user-1")
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	prov1 := types.NewProviderID()
+	prov2 := types.NewProviderID()
+	prov3 := types.NewProviderID()
+	lab1 := subscription.LabelRef{LabelId: types.NewLabelID()}
+	lab2 := subscription.LabelRef{LabelId: types.NewLabelID()}
+
+	// 10 USD each month, the February charge was recorded at 12 USD
+	monthly := buildSub(10, currency.USD, day(2024, time.November, 15), subscription.MonthlyRecurrency, 0,
+		[]subscription.LabelRef{lab1}, prov1)
+	recorded := charge.NewCharge(types.NewChargeID(), monthly.Id(), day(2025, time.February, 15),
+		currency.NewAmount(10, currency.USD), currency.NewAmount(12, currency.USD), charge.PaidStatus, time.Now(),
+		time.Now())
+	// 120 EUR each year in March
+	yearly := buildSub(120, currency.EUR, day(2024, time.March, 10), subscription.YearlyRecurrency, 0,
+		[]subscription.LabelRef{lab1, lab2}, prov2)
+	// 20 USD each month for the family after a free trial in January
+	family := subscription.NewSubscription(
+		types.NewSubscriptionID(), x.P("Family"),
+		subscription.NewFreeTrial(day(2025, time.January, 5), day(2025, time.February, 5)), nil, prov3,
+		subscription.NewPrice(currency.NewAmount(20, currency.USD)), nil, nil,
+		types.NewFamilyOwner(types.NewFamilyID()), nil, nil, nil, nil, nil,
+		day(2025, time.January, 5), nil, nil, subscription.MonthlyRecurrency, nil, nil, time.Now(), time.Now())
+
+	newHandler := func(t *testing.T) *query.SpendingQueryHandler {
+		subRepo := ports.NewMockSubscriptionRepository(t)
+		chargeRepo := ports.NewMockChargeRepository(t)
+		acctService := ports.NewMockAccountService(t)
+		auth := ports.NewMockAuthentication(t)
+		exch := ports.NewMockExchange(t)
+
+		acc := account.NewMockConnectedAccount(t)
+		acc.EXPECT().UserID().Return(userID).Maybe()
+		auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc).Maybe()
+		acctService.EXPECT().GetPreferredCurrency(mock.Anything, userID).Return(currency.USD).Maybe()
+		subRepo.EXPECT().GetAllIt(mock.Anything, userID, "").
+			Return(func(yield func(subscription.Subscription) bool) {
+				_ = yield(monthly) && yield(yearly) && yield(family)
+			}).Maybe()
+		chargeRepo.EXPECT().GetRecordedBySubscriptionIds(mock.Anything, mock.Anything).
+			Return([]charge.Charge{recorded}, nil).Maybe()
+		// One euro is worth 1.5 dollars until March and 2 dollars from March
+		exch.EXPECT().ToCurrencyBatch(mock.Anything, mock.Anything, currency.USD).
+			RunAndReturn(func(
+				_ context.Context,
+				conversions []ports.Conversion,
+				target currency.Unit) ([]currency.Amount, error) {
+				return herd.Select(conversions, func(c ports.Conversion) currency.Amount {
+					// The manual rates of the subscription only apply when its id is given
+					if !assert.NotNil(t, c.SubscriptionId) {
+						return currency.NewInvalidAmount()
+					}
+					if c.Amount.Currency() != currency.EUR {
+						return c.Amount
+					}
+					rate := 1.5
+					if !c.At.Before(day(2025, time.March, 1)) {
+						rate = 2
+					}
+					return currency.NewAmount(c.Amount.Value()*rate, target)
+				}), nil
+			}).Maybe()
+
+		return query.NewSpendingQueryHandler(subRepo, chargeRepo, acctService, auth, exch)
+	}
+
+	totals := func(bucket query.SpendingBucketResponse) map[string]float64 {
+		values := make(map[string]float64)
+		for _, group := range bucket.Groups {
+			values[group.Key] = group.Total.Value()
+		}
+		return values
+	}
+
+	t.Run("monthly spending by provider", func(t *testing.T) {
+		h := newHandler(t)
+		res := h.Handle(t.Context(), query.NewSpendingQuery(x.P(day(2025, time.January, 1)),
+			x.P(day(2025, time.June, 30)), subscription.MonthSpendingBucket, subscription.ProviderSpendingDimension))
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(r query.SpendingQueryResponse) {
+			assert.Equal(t, []string{prov2.String(), prov3.String(), prov1.String()}, r.Keys)
+			require.Len(t, r.Buckets, 6)
+			for _, bucket := range r.Buckets {
+				assert.Len(t, bucket.Groups, 3)
+			}
+
+			assert.Equal(t, day(2025, time.January, 1), r.Buckets[0].Start)
+			assert.Equal(t, day(2025, time.February, 1), r.Buckets[0].End)
+			// The family subscription is free in January
+			assert.Equal(t, map[string]float64{prov1.String(): 10, prov2.String(): 0, prov3.String(): 0},
+				totals(r.Buckets[0]))
+			assert.Equal(t, 32.0, r.Buckets[1].Total.Value())
+			// The yearly charge is converted at the rate of March
+			assert.Equal(t, map[string]float64{prov1.String(): 10, prov2.String(): 240, prov3.String(): 20},
+				totals(r.Buckets[2]))
+			assert.Equal(t, 270.0, r.Buckets[2].Total.Value())
+			assert.Equal(t, currency.USD, r.Total.Currency())
+			assert.Equal(t, 62.0+240+100, r.Total.Value())
+		})
+	})
+
+	t.Run("a charge is counted in each of its labels", func(t *testing.T) {
+		h := newHandler(t)
+		res := h.Handle(t.Context(), query.NewSpendingQuery(x.P(day(2025, time.March, 1)),
+			x.P(day(2025, time.March, 31)), subscription.MonthSpendingBucket, subscription.LabelSpendingDimension))
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(r query.SpendingQueryResponse) {
+			require.Len(t, r.Buckets, 1)
+			assert.Equal(t, map[string]float64{
+				lab1.LabelId.String():       250,
+				lab2.LabelId.String():       240,
+				query.UnassignedSpendingKey: 20,
+			}, totals(r.Buckets[0]))
+			assert.Equal(t, 270.0, r.Buckets[0].Total.Value())
+		})
+	})
+
+	t.Run("quarterly spending by owner type", func(t *testing.T) {
+		h := newHandler(t)
+		res := h.Handle(t.Context(), query.NewSpendingQuery(x.P(day(2025, time.February, 10)),
+			x.P(day(2025, time.May, 1)), subscription.QuarterSpendingBucket,
+			subscription.OwnerTypeSpendingDimension))
+
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(r query.SpendingQueryResponse) {
+			require.Len(t, r.Buckets, 2)
+			assert.Equal(t, day(2025, time.January, 1), r.Buckets[0].Start)
+			assert.Equal(t, day(2025, time.July, 1), r.Buckets[1].End)
+			// Buckets cover whole quarters and the yearly charge is converted at the rate of January
+			assert.Equal(t, map[string]float64{
+				types.PersonalOwnerType.String(): 10 + 12 + 10 + 180,
+				types.FamilyOwnerType.String():   40,
+			}, totals(r.Buckets[0]))
+			assert.Equal(t, map[string]float64{
+				types.PersonalOwnerType.String(): 30,
+				types.FamilyOwnerType.String():   60,
+			}, totals(r.Buckets[1]))
+		})
+	})
+
+	t.Run("defaults to the last twelve buckets", func(t *testing.T) {
+		q := query.NewSpendingQuery(nil, x.P(day(2025, time.June, 30)), subscription.MonthSpendingBucket,
+			subscription.ProviderSpendingDimension)
+
+		assert.Equal(t, day(2024, time.July, 1), q.From)
+	})
+
+	t.Run("rejects invalid ranges", func(t *testing.T) {
+		h := newHandler(t)
+
+		res := h.Handle(t.Context(), query.NewSpendingQuery(x.P(day(2025, time.June, 1)),
+			x.P(day(2025, time.January, 1)), subscription.MonthSpendingBucket,
+			subscription.ProviderSpendingDimension))
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, subscription.ErrInvalidSpendingRange)
+		})
+
+		res = h.Handle(t.Context(), query.NewSpendingQuery(x.P(day(2000, time.January, 1)),
+			x.P(day(2025, time.January, 1)), subscription.MonthSpendingBucket,
+			subscription.ProviderSpendingDimension))
+		assert.True(t, res.IsFaulted())
+		res.IfFailure(func(err error) {
+			assert.ErrorIs(t, err, subscription.ErrSpendingRangeTooLarge)
+		})
+
+		res = h.Handle(t.Context(), query.NewSpendingQuery(nil, nil, subscription.UnknownSpendingBucket,
+			subscription.ProviderSpendingDimension))
+		assert.True(t, res.IsFaulted())
+	})
+}
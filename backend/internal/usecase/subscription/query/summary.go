@@ -2,16 +2,17 @@ package query
 
 import (
 	"context"
+	"slices"
 	"sort"
 	"time"
 
-	xcur "golang.org/x/text/currency"
-
+	"github.com/mistribe/subtracker/internal/domain/charge"
 	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/domain/family"
 	"github.com/mistribe/subtracker/internal/domain/subscription"
 	"github.com/mistribe/subtracker/internal/domain/types"
 	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/decimal"
 	"github.com/mistribe/subtracker/pkg/langext/result"
 	"github.com/mistribe/subtracker/pkg/x/herd"
 )
@@ -30,6 +31,8 @@ type SummaryQueryUpcomingRenewalsResponse struct {
 	At             time.Time
 	Total          currency.Amount
 	Source         *currency.Amount
+	// CancellationDeadline is the last day to cancel before the subscription renews, nil when it does not renew by itself
+	CancellationDeadline *time.Time
 }
 
 type SummaryQueryTopProvidersResponse struct {
@@ -66,7 +69,7 @@ type SummaryQueryResponse struct {
 
 type SummaryQueryHandler struct {
 	subscriptionRepository ports.SubscriptionRepository
-	currencyRepository     ports.CurrencyRepository
+	chargeRepository       ports.ChargeRepository
 	familyRepository       ports.FamilyRepository
 	accountService         ports.AccountService
 	authService            ports.Authentication
@@ -75,14 +78,14 @@ type SummaryQueryHandler struct {
 
 func NewSummaryQueryHandler(
 	subscriptionRepository ports.SubscriptionRepository,
-	currencyRepository ports.CurrencyRepository,
+	chargeRepository ports.ChargeRepository,
 	familyRepository ports.FamilyRepository,
 	accountService ports.AccountService,
 	authService ports.Authentication,
 	exchange ports.Exchange) *SummaryQueryHandler {
 	return &SummaryQueryHandler{
 		subscriptionRepository: subscriptionRepository,
-		currencyRepository:     currencyRepository,
+		chargeRepository:       chargeRepository,
 		familyRepository:       familyRepository,
 		accountService:         accountService,
 		authService:            authService,
@@ -90,21 +93,17 @@ func NewSummaryQueryHandler(
 	}
 }
 
-func (h SummaryQueryHandler) convertToCurrency(
-	ctx context.Context,
-	amount currency.Amount,
-	preferredCurrency xcur.Unit,
-	date time.Time) currency.Amount {
-	v, err := h.exchange.ToCurrencyAt(ctx, amount, preferredCurrency, time.Now())
-	if err != nil {
-		return currency.NewInvalidAmount()
-	}
-	return v
+// subscriptionSpent is what was spent on a subscription, in the preferred currency
+type subscriptionSpent struct {
+	sub   subscription.Subscription
+	total decimal.Decimal
+	// invalid is set when a billing period could not be converted
+	invalid bool
 }
 
 // classifySubscription determines if a subscription is personal, family, or should be excluded
 // Returns: "personal", "family", or "exclude"
-func (h SummaryQueryHandler) classifySubscription(sub subscription.Subscription, currentUserID types.UserID,
+func classifySubscription(sub subscription.Subscription, currentUserID types.UserID,
 	fam family.Family) string {
 	payer := sub.Payer()
 
@@ -136,12 +135,10 @@ func (h SummaryQueryHandler) Handle(ctx context.Context, query SummaryQuery) res
 	connectedAccount := h.authService.MustGetConnectedAccount(ctx)
 	currentUserID := connectedAccount.UserID()
 	preferredCurrency := h.accountService.GetPreferredCurrency(ctx, currentUserID)
-	currencyRates, err := h.currencyRepository.GetRatesByDate(ctx, time.Now())
-	if err != nil {
-		return result.Fail[SummaryQueryResponse](err)
-	}
-
-	currencyRates = currencyRates.WithReverse()
+	timezone := h.accountService.GetTimezone(ctx, currentUserID)
+	now := time.Now()
+	// Amounts are converted at the rate of the day they relate to, all at once after every subscription is read
+	conversions := &ports.ConversionBatch{}
 
 	// Get family for payer classification
 	family, err := h.familyRepository.GetAccountFamily(ctx, currentUserID)
@@ -152,40 +149,56 @@ func (h SummaryQueryHandler) Handle(ctx context.Context, query SummaryQuery) res
 	topProviders := make(map[types.ProviderID]SummaryQueryTopProvidersResponse)
 	topLabels := make(map[types.LabelID]currency.Amount)
 	var upcomingRenewals []SummaryQueryUpcomingRenewalsResponse
+	var spending []*subscriptionSpent
 
 	// Separate accumulators for personal, family, and total
-	totalMonthly := 0.0
-	totalYearly := 0.0
-	totalLastMonth := 0.0
-	totalLastYear := 0.0
-	personalMonthly := 0.0
-	personalYearly := 0.0
-	personalLastMonth := 0.0
-	personalLastYear := 0.0
-	familyMonthly := 0.0
-	familyYearly := 0.0
-	familyLastMonth := 0.0
-	familyLastYear := 0.0
-
-	lastMonth := time.Now().AddDate(0, -1, 0)
-	lastYear := time.Now().AddDate(-1, 0, 0)
+	totalMonthly := decimal.Zero
+	totalYearly := decimal.Zero
+	totalLastMonth := decimal.Zero
+	totalLastYear := decimal.Zero
+	personalMonthly := decimal.Zero
+	personalYearly := decimal.Zero
+	personalLastMonth := decimal.Zero
+	personalLastYear := decimal.Zero
+	familyMonthly := decimal.Zero
+	familyYearly := decimal.Zero
+	familyLastMonth := decimal.Zero
+	familyLastYear := decimal.Zero
+
+	lastMonth := now.AddDate(0, -1, 0)
+	lastYear := now.AddDate(-1, 0, 0)
 
 	var active uint16
 	var activePersonal uint16
 	var activeFamily uint16
 
-	for sub := range h.subscriptionRepository.GetAllIt(ctx, currentUserID, "") {
+	subscriptions := slices.Collect(h.subscriptionRepository.GetAllIt(ctx, currentUserID, ""))
+	// Recorded charges replace the expected amount of their occurrence
+	recordedCharges, err := h.chargeRepository.GetRecordedBySubscriptionIds(ctx,
+		herd.Select(subscriptions, func(sub subscription.Subscription) types.SubscriptionID {
+			return sub.Id()
+		})...)
+	if err != nil {
+		return result.Fail[SummaryQueryResponse](err)
+	}
+	chargesBySubscription := make(map[types.SubscriptionID][]charge.Charge)
+	for _, c := range recordedCharges {
+		chargesBySubscription[c.SubscriptionId()] = append(chargesBySubscription[c.SubscriptionId()], c)
+	}
+
+	for _, sub := range subscriptions {
+		charges := chargesBySubscription[sub.Id()]
 		// Classify subscription
-		classification := h.classifySubscription(sub, currentUserID, family)
+		classification := classifySubscription(sub, currentUserID, family)
 
 		// Skip subscriptions paid by other family members
 		if classification == "exclude" {
 			continue
 		}
 
-		isActive := sub.IsActive()
-		isActiveLastMonth := sub.IsActiveAt(lastMonth)
-		isActiveLastYear := sub.IsActiveAt(lastYear)
+		isActive := sub.IsActiveAtIn(now, timezone)
+		isActiveLastMonth := sub.IsActiveAtIn(lastMonth, timezone)
+		isActiveLastYear := sub.IsActiveAtIn(lastYear, timezone)
 
 		// Count active subscriptions
 		if isActive {
@@ -200,81 +213,84 @@ func (h SummaryQueryHandler) Handle(ctx context.Context, query SummaryQuery) res
 		// Calculate monthly amounts
 		if query.TotalMonthly {
 			if isActive {
-				monthlyAmount := h.convertToCurrency(ctx,
-					sub.GetRecurrencyAmount(subscription.MonthlyRecurrency),
-					preferredCurrency,
-					sub.StartDate())
-				if monthlyAmount.IsValid() {
-					totalMonthly += monthlyAmount.Value()
-					if classification == "personal" {
-						personalMonthly += monthlyAmount.Value()
-					} else if classification == "family" {
-						familyMonthly += monthlyAmount.Value()
-					}
-				}
+				conversions.AddForSubscription(sub.Id(), sub.GetRecurrencyAmount(subscription.MonthlyRecurrency), now,
+					func(monthlyAmount currency.Amount) {
+						if monthlyAmount.IsValid() {
+							totalMonthly = totalMonthly.Add(monthlyAmount.Decimal())
+							if classification == "personal" {
+								personalMonthly = personalMonthly.Add(monthlyAmount.Decimal())
+							} else if classification == "family" {
+								familyMonthly = familyMonthly.Add(monthlyAmount.Decimal())
+							}
+						}
+					})
 			}
 			if isActiveLastMonth {
-				lastMonthAmount := h.convertToCurrency(ctx,
-					sub.GetRecurrencyAmount(subscription.MonthlyRecurrency),
-					preferredCurrency,
-					sub.StartDate())
-				if lastMonthAmount.IsValid() {
-					totalLastMonth += lastMonthAmount.Value()
-					if classification == "personal" {
-						personalLastMonth += lastMonthAmount.Value()
-					} else if classification == "family" {
-						familyLastMonth += lastMonthAmount.Value()
-					}
-				}
+				conversions.AddForSubscription(sub.Id(),
+					charge.RecurrencyAmountAt(sub, charges, subscription.MonthlyRecurrency, lastMonth), lastMonth,
+					func(lastMonthAmount currency.Amount) {
+						if lastMonthAmount.IsValid() {
+							totalLastMonth = totalLastMonth.Add(lastMonthAmount.Decimal())
+							if classification == "personal" {
+								personalLastMonth = personalLastMonth.Add(lastMonthAmount.Decimal())
+							} else if classification == "family" {
+								familyLastMonth = familyLastMonth.Add(lastMonthAmount.Decimal())
+							}
+						}
+					})
 			}
 		}
 
 		// Calculate yearly amounts
 		if query.TotalYearly {
 			if isActive {
-				yearlyAmount := h.convertToCurrency(ctx,
-					sub.GetRecurrencyAmount(subscription.YearlyRecurrency),
-					preferredCurrency,
-					sub.StartDate())
-				if yearlyAmount.IsValid() {
-					totalYearly += yearlyAmount.Value()
-					if classification == "personal" {
-						personalYearly += yearlyAmount.Value()
-					} else if classification == "family" {
-						familyYearly += yearlyAmount.Value()
-					}
-				}
+				conversions.AddForSubscription(sub.Id(), sub.GetRecurrencyAmount(subscription.YearlyRecurrency), now,
+					func(yearlyAmount currency.Amount) {
+						if yearlyAmount.IsValid() {
+							totalYearly = totalYearly.Add(yearlyAmount.Decimal())
+							if classification == "personal" {
+								personalYearly = personalYearly.Add(yearlyAmount.Decimal())
+							} else if classification == "family" {
+								familyYearly = familyYearly.Add(yearlyAmount.Decimal())
+							}
+						}
+					})
 			}
 			if isActiveLastYear {
-				lastYearAmount := h.convertToCurrency(ctx,
-					sub.GetRecurrencyAmount(subscription.YearlyRecurrency),
-					preferredCurrency,
-					sub.StartDate())
-				if lastYearAmount.IsValid() {
-					totalLastYear += lastYearAmount.Value()
-					if classification == "personal" {
-						personalLastYear += lastYearAmount.Value()
-					} else if classification == "family" {
-						familyLastYear += lastYearAmount.Value()
-					}
-				}
+				conversions.AddForSubscription(sub.Id(),
+					charge.RecurrencyAmountAt(sub, charges, subscription.YearlyRecurrency, lastYear), lastYear,
+					func(lastYearAmount currency.Amount) {
+						if lastYearAmount.IsValid() {
+							totalLastYear = totalLastYear.Add(lastYearAmount.Decimal())
+							if classification == "personal" {
+								personalLastYear = personalLastYear.Add(lastYearAmount.Decimal())
+							} else if classification == "family" {
+								familyLastYear = familyLastYear.Add(lastYearAmount.Decimal())
+							}
+						}
+					})
 			}
 		}
 
 		if query.UpcomingRenewals > 0 {
-			if isActive {
-				renewalDate := sub.GetNextRenewalDate()
-				price := h.convertToCurrency(ctx,
-					sub.GetPrice(),
-					preferredCurrency,
-					sub.StartDate())
-				if renewalDate != nil && price.IsValid() {
-					upcomingRenewals = append(upcomingRenewals, SummaryQueryUpcomingRenewalsResponse{
-						ProviderId:     sub.ProviderId(),
-						SubscriptionId: sub.Id(),
-						At:             *renewalDate,
-						Total:          price,
-						Source:         &price,
+			// A paused subscription renews once its pause ends, unless no resume date is known
+			if isActive || sub.IsPaused() {
+				renewalDate := sub.GetNextRenewalDateIn(timezone)
+				if renewalDate != nil {
+					// The renewal is charged at the price in effect on that date, a promotion may have ended by then.
+					// Future rates are unknown, the renewal is converted at the latest rate.
+					conversions.AddForSubscription(sub.Id(), sub.PriceAt(*renewalDate), now, func(price currency.Amount) {
+						if price.IsValid() {
+							upcomingRenewals = append(upcomingRenewals, SummaryQueryUpcomingRenewalsResponse{
+								ProviderId:     sub.ProviderId(),
+								SubscriptionId: sub.Id(),
+								At:             *renewalDate,
+								Total:          price,
+								Source:         &price,
+
+								CancellationDeadline: sub.CancellationDeadlineIn(timezone),
+							})
+						}
 					})
 				}
 			}
@@ -282,36 +298,57 @@ func (h SummaryQueryHandler) Handle(ctx context.Context, query SummaryQuery) res
 
 		if query.TopProviders > 0 || query.TopLabels > 0 {
 			if sub.IsStarted() {
-				totalSpent := h.convertToCurrency(ctx,
-					sub.GetTotalSpent(),
-					preferredCurrency,
-					sub.StartDate())
-				if totalSpent.IsValid() && !totalSpent.IsZero() {
-					if query.TopProviders > 0 {
-						existingTopProvider, ok := topProviders[sub.ProviderId()]
-						if ok {
-							existingTopProvider.Total = existingTopProvider.Total.Add(totalSpent.Value())
-							existingTopProvider.Duration += sub.GetTotalDuration()
-							topProviders[sub.ProviderId()] = existingTopProvider
-						} else {
-							topProviders[sub.ProviderId()] = SummaryQueryTopProvidersResponse{
-								ProviderID: sub.ProviderId(),
-								Total:      totalSpent,
-								Duration:   sub.GetTotalDuration(),
-							}
-						}
+				// Each billing period is converted at the rate of the day it started
+				spent := &subscriptionSpent{sub: sub}
+				spending = append(spending, spent)
+				for _, period := range charge.SpendingByPeriod(sub, charges) {
+					if period.Amount.IsZero() {
+						continue
 					}
-					if query.TopLabels > 0 {
-						for l := range sub.Labels().It() {
-							existingTopLabel, ok := topLabels[l.LabelId]
-							if ok {
-								existingTopLabel = existingTopLabel.Add(totalSpent.Value())
-								topLabels[l.LabelId] = existingTopLabel
-							} else {
-								topLabels[l.LabelId] = totalSpent
-							}
+					conversions.AddForSubscription(sub.Id(), period.Amount, period.Start, func(amount currency.Amount) {
+						if !amount.IsValid() {
+							spent.invalid = true
+							return
 						}
-					}
+						spent.total = spent.total.Add(amount.Decimal())
+					})
+				}
+			}
+		}
+	}
+
+	if err = conversions.Convert(ctx, h.exchange, preferredCurrency); err != nil {
+		return result.Fail[SummaryQueryResponse](err)
+	}
+
+	for _, spent := range spending {
+		if spent.invalid || spent.total.IsZero() {
+			continue
+		}
+		sub := spent.sub
+		totalSpent := currency.NewAmountFromDecimal(spent.total, preferredCurrency)
+		if query.TopProviders > 0 {
+			existingTopProvider, ok := topProviders[sub.ProviderId()]
+			if ok {
+				existingTopProvider.Total = existingTopProvider.Total.Add(totalSpent.Decimal())
+				existingTopProvider.Duration += sub.GetTotalDuration()
+				topProviders[sub.ProviderId()] = existingTopProvider
+			} else {
+				topProviders[sub.ProviderId()] = SummaryQueryTopProvidersResponse{
+					ProviderID: sub.ProviderId(),
+					Total:      totalSpent,
+					Duration:   sub.GetTotalDuration(),
+				}
+			}
+		}
+		if query.TopLabels > 0 {
+			for l := range sub.Labels().It() {
+				existingTopLabel, ok := topLabels[l.LabelId]
+				if ok {
+					existingTopLabel = existingTopLabel.Add(totalSpent.Decimal())
+					topLabels[l.LabelId] = existingTopLabel
+				} else {
+					topLabels[l.LabelId] = totalSpent
 				}
 			}
 		}
@@ -321,18 +358,18 @@ func (h SummaryQueryHandler) Handle(ctx context.Context, query SummaryQuery) res
 		Active:            active,
 		ActivePersonal:    activePersonal,
 		ActiveFamily:      activeFamily,
-		TotalMonthly:      currency.NewAmount(totalMonthly, preferredCurrency),
-		TotalYearly:       currency.NewAmount(totalYearly, preferredCurrency),
-		TotalLastMonth:    currency.NewAmount(totalLastMonth, preferredCurrency),
-		TotalLastYear:     currency.NewAmount(totalLastYear, preferredCurrency),
-		PersonalMonthly:   currency.NewAmount(personalMonthly, preferredCurrency),
-		PersonalYearly:    currency.NewAmount(personalYearly, preferredCurrency),
-		PersonalLastMonth: currency.NewAmount(personalLastMonth, preferredCurrency),
-		PersonalLastYear:  currency.NewAmount(personalLastYear, preferredCurrency),
-		FamilyMonthly:     currency.NewAmount(familyMonthly, preferredCurrency),
-		FamilyYearly:      currency.NewAmount(familyYearly, preferredCurrency),
-		FamilyLastMonth:   currency.NewAmount(familyLastMonth, preferredCurrency),
-		FamilyLastYear:    currency.NewAmount(familyLastYear, preferredCurrency),
+		TotalMonthly:      currency.NewAmountFromDecimal(totalMonthly, preferredCurrency),
+		TotalYearly:       currency.NewAmountFromDecimal(totalYearly, preferredCurrency),
+		TotalLastMonth:    currency.NewAmountFromDecimal(totalLastMonth, preferredCurrency),
+		TotalLastYear:     currency.NewAmountFromDecimal(totalLastYear, preferredCurrency),
+		PersonalMonthly:   currency.NewAmountFromDecimal(personalMonthly, preferredCurrency),
+		PersonalYearly:    currency.NewAmountFromDecimal(personalYearly, preferredCurrency),
+		PersonalLastMonth: currency.NewAmountFromDecimal(personalLastMonth, preferredCurrency),
+		PersonalLastYear:  currency.NewAmountFromDecimal(personalLastYear, preferredCurrency),
+		FamilyMonthly:     currency.NewAmountFromDecimal(familyMonthly, preferredCurrency),
+		FamilyYearly:      currency.NewAmountFromDecimal(familyYearly, preferredCurrency),
+		FamilyLastMonth:   currency.NewAmountFromDecimal(familyLastMonth, preferredCurrency),
+		FamilyLastYear:    currency.NewAmountFromDecimal(familyLastYear, preferredCurrency),
 		UpcomingRenewals:  upcomingRenewals,
 		TopProviders: herd.MapToArr(topProviders,
 			func(providerId types.ProviderID, res SummaryQueryTopProvidersResponse) SummaryQueryTopProvidersResponse {
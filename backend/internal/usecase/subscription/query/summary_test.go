@@ -10,12 +10,15 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/charge"
 	"github.com/mistribe/subtracker/internal/domain/currency"
 	"github.com/mistribe/subtracker/internal/domain/family"
 	"github.com/mistribe/subtracker/internal/domain/subscription"
 	"github.com/mistribe/subtracker/internal/domain/types"
 	"github.com/mistribe/subtracker/internal/ports"
 	"github.com/mistribe/subtracker/internal/usecase/subscription/query"
+	"github.com/mistribe/subtracker/pkg/x"
+	"github.com/mistribe/subtracker/pkg/x/herd"
 )
 
 // build subscription with given params
@@ -29,18 +32,27 @@ func buildSub(
 	provider types.ProviderID) subscription.Subscription {
 	name := "Sub"
 	price := subscription.NewPrice(currency.NewAmount(amount, cur))
-	var custom *int32
+	var custom *subscription.Interval
 	if rec == subscription.CustomRecurrency {
-		c := int32(months)
-		custom = &c
+		custom = subscription.NewInterval(subscription.MonthIntervalUnit, int32(months))
 	}
 	return subscription.NewSubscription(
-		types.NewSubscriptionID(), &name, nil, provider, price,
-		types.NewPersonalOwner(types.UserID("user-1")), nil, nil, labels,
-		start, nil, rec, custom, time.Now(), time.Now(),
+		types.NewSubscriptionID(), &name, nil, nil, provider, price, nil, nil,
+		types.NewPersonalOwner(types.UserID("user-1")), nil, nil, nil, nil, labels,
+		start, nil, nil, rec, custom, nil, time.Now(), time.Now(),
 	)
 }
 
+// passThroughBatch converts amounts by keeping them as they are
+func passThroughBatch(
+	_ context.Context,
+	conversions []ports.Conversion,
+	_ currency.Unit) ([]currency.Amount, error) {
+	return herd.Select(conversions, func(c ports.Conversion) currency.Amount {
+		return c.Amount
+	}), nil
+}
+
 // build test family with a member linked to the given user
 func buildTestFamily(userID types.UserID) family.Family {
 	familyID := types.NewFamilyID()
@@ -75,10 +87,10 @@ func TestSummaryQueryHandler_Handle(t *testing.T) {
 		return acc
 	}
 
-	// currency repository error scenario
-	t.Run("returns fault when currency repository errors", func(t *testing.T) {
+	// conversion error scenario
+	t.Run("returns fault when the conversion fails", func(t *testing.T) {
 		subRepo := ports.NewMockSubscriptionRepository(t)
-		curRepo := ports.NewMockCurrencyRepository(t)
+		chargeRepo := ports.NewMockChargeRepository(t)
 		famRepo := ports.NewMockFamilyRepository(t)
 		acctService := ports.NewMockAccountService(t)
 		auth := ports.NewMockAuthentication(t)
@@ -87,17 +99,24 @@ func TestSummaryQueryHandler_Handle(t *testing.T) {
 		acc := newMockConnectedAccount(userID)
 		auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc)
 		acctService.EXPECT().GetPreferredCurrency(mock.Anything, userID).Return(currency.USD)
-		curRepo.EXPECT().GetRatesByDate(mock.Anything, mock.Anything).Return(currency.Rates{}, assert.AnError)
+		acctService.EXPECT().GetTimezone(mock.Anything, userID).Return(time.UTC)
+		famRepo.EXPECT().GetAccountFamily(mock.Anything, userID).Return(buildTestFamily(userID), nil)
+		sub := buildSub(10, currency.EUR, time.Now().AddDate(0, -2, 0), subscription.MonthlyRecurrency, 0, nil,
+			types.ProviderID(uuid.Must(uuid.NewV7())))
+		subRepo.EXPECT().GetAllIt(mock.Anything, userID, "").
+			Return(func(yield func(subscription.Subscription) bool) { yield(sub) })
+		chargeRepo.EXPECT().GetRecordedBySubscriptionIds(mock.Anything, mock.Anything).Return(nil, nil)
+		exch.EXPECT().ToCurrencyBatch(mock.Anything, mock.Anything, currency.USD).Return(nil, assert.AnError)
 
-		h := query.NewSummaryQueryHandler(subRepo, curRepo, famRepo, acctService, auth, exch)
-		res := h.Handle(ctx, query.SummaryQuery{})
+		h := query.NewSummaryQueryHandler(subRepo, chargeRepo, famRepo, acctService, auth, exch)
+		res := h.Handle(ctx, query.SummaryQuery{TotalMonthly: true})
 		assert.True(t, res.IsFaulted())
 	})
 
 	// main computation scenario
 	t.Run("computes totals and rankings", func(t *testing.T) {
 		subRepo := ports.NewMockSubscriptionRepository(t)
-		curRepo := ports.NewMockCurrencyRepository(t)
+		chargeRepo := ports.NewMockChargeRepository(t)
 		famRepo := ports.NewMockFamilyRepository(t)
 		acctService := ports.NewMockAccountService(t)
 		auth := ports.NewMockAuthentication(t)
@@ -106,8 +125,7 @@ func TestSummaryQueryHandler_Handle(t *testing.T) {
 		acc := newMockConnectedAccount(userID)
 		auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc)
 		acctService.EXPECT().GetPreferredCurrency(mock.Anything, userID).Return(currency.USD)
-		// rates
-		curRepo.EXPECT().GetRatesByDate(mock.Anything, mock.Anything).Return(currency.Rates{}, nil)
+		acctService.EXPECT().GetTimezone(mock.Anything, userID).Return(time.UTC)
 		// family - return a simple family for classification (subscriptions have no payer, so will be personal)
 		testFamily := buildTestFamily(userID)
 		famRepo.EXPECT().GetAccountFamily(mock.Anything, userID).Return(testFamily, nil)
@@ -123,17 +141,11 @@ func TestSummaryQueryHandler_Handle(t *testing.T) {
 			prov2)
 		seq := func(yield func(subscription.Subscription) bool) { yield(s1); yield(s2) }
 		subRepo.EXPECT().GetAllIt(mock.Anything, userID, "").Return(seq)
+		chargeRepo.EXPECT().GetRecordedBySubscriptionIds(mock.Anything, mock.Anything).Return(nil, nil)
 		// pass-through exchange
-		exch.EXPECT().ToCurrencyAt(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			RunAndReturn(func(
-				_ context.Context,
-				initial currency.Amount,
-				_ currency.Unit,
-				_ time.Time) (currency.Amount, error) {
-				return initial, nil
-			}).Maybe()
-
-		h := query.NewSummaryQueryHandler(subRepo, curRepo, famRepo, acctService, auth, exch)
+		exch.EXPECT().ToCurrencyBatch(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(passThroughBatch).Maybe()
+
+		h := query.NewSummaryQueryHandler(subRepo, chargeRepo, famRepo, acctService, auth, exch)
 		res := h.Handle(ctx, query.SummaryQuery{
 			TopProviders:     2,
 			TopLabels:        2,
@@ -149,3 +161,355 @@ func TestSummaryQueryHandler_Handle(t *testing.T) {
 		assert.NotEmpty(t, out.TopLabels)
 	})
 }
+
+func TestSummaryQueryHandler_PriceHistory(t *testing.T) {
+	ctx := context.Background()
+	userID := types.UserID("user-1")
+
+	subRepo := ports.NewMockSubscriptionRepository(t)
+	chargeRepo := ports.NewMockChargeRepository(t)
+	famRepo := ports.NewMockFamilyRepository(t)
+	acctService := ports.NewMockAccountService(t)
+	auth := ports.NewMockAuthentication(t)
+	exch := ports.NewMockExchange(t)
+
+	acc := account.NewMockConnectedAccount(t)
+	acc.EXPECT().UserID().Return(userID).Maybe()
+	auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc)
+	acctService.EXPECT().GetPreferredCurrency(mock.Anything, userID).Return(currency.USD)
+	acctService.EXPECT().GetTimezone(mock.Anything, userID).Return(time.UTC)
+	famRepo.EXPECT().GetAccountFamily(mock.Anything, userID).Return(buildTestFamily(userID), nil)
+
+	// The price was raised from 10 to 15 two weeks ago
+	sub := buildSub(10, currency.USD, time.Now().AddDate(-2, 0, 0), subscription.MonthlyRecurrency, 0, nil,
+		types.ProviderID(uuid.Must(uuid.NewV7())))
+	assert.NoError(t, sub.AddPriceChange(time.Now().AddDate(0, 0, -14), currency.NewAmount(15, currency.USD)))
+
+	seq := func(yield func(subscription.Subscription) bool) { yield(sub) }
+	subRepo.EXPECT().GetAllIt(mock.Anything, userID, "").Return(seq)
+	chargeRepo.EXPECT().GetRecordedBySubscriptionIds(mock.Anything, mock.Anything).Return(nil, nil)
+	exch.EXPECT().ToCurrencyBatch(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(passThroughBatch).Maybe()
+
+	h := query.NewSummaryQueryHandler(subRepo, chargeRepo, famRepo, acctService, auth, exch)
+	res := h.Handle(ctx, query.SummaryQuery{
+		TotalMonthly: true,
+		TotalYearly:  true,
+	})
+
+	assert.True(t, res.IsSuccess())
+	res.IfSuccess(func(out query.SummaryQueryResponse) {
+		assert.Equal(t, 15.0, out.TotalMonthly.Value())
+		assert.Equal(t, 10.0, out.TotalLastMonth.Value())
+		assert.Equal(t, 180.0, out.TotalYearly.Value())
+		assert.Equal(t, 120.0, out.TotalLastYear.Value())
+	})
+}
+
+func TestSummaryQueryHandler_RecordedCharges(t *testing.T) {
+	ctx := context.Background()
+	userID := types.UserID("user-1")
+
+	subRepo := ports.NewMockSubscriptionRepository(t)
+	chargeRepo := ports.NewMockChargeRepository(t)
+	famRepo := ports.NewMockFamilyRepository(t)
+	acctService := ports.NewMockAccountService(t)
+	auth := ports.NewMockAuthentication(t)
+	exch := ports.NewMockExchange(t)
+
+	acc := account.NewMockConnectedAccount(t)
+	acc.EXPECT().UserID().Return(userID).Maybe()
+	auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc)
+	acctService.EXPECT().GetPreferredCurrency(mock.Anything, userID).Return(currency.USD)
+	acctService.EXPECT().GetTimezone(mock.Anything, userID).Return(time.UTC)
+	famRepo.EXPECT().GetAccountFamily(mock.Anything, userID).Return(buildTestFamily(userID), nil)
+
+	sub := buildSub(10, currency.USD, time.Now().AddDate(-2, 0, 0), subscription.MonthlyRecurrency, 0, nil,
+		types.ProviderID(uuid.Must(uuid.NewV7())))
+	expectedSpent := sub.GetTotalSpent().Value()
+
+	// The occurrence billed last month was charged 12 instead of 10
+	dates := sub.BillingDates(time.Now().AddDate(0, -1, 0))
+	chg := charge.NewCharge(types.NewChargeID(), sub.Id(), dates[len(dates)-1],
+		currency.NewAmount(10, currency.USD), nil, charge.ExpectedStatus, time.Now(), time.Now())
+	assert.NoError(t, chg.Record(charge.AdjustedStatus, currency.NewAmount(12, currency.USD)))
+
+	seq := func(yield func(subscription.Subscription) bool) { yield(sub) }
+	subRepo.EXPECT().GetAllIt(mock.Anything, userID, "").Return(seq)
+	chargeRepo.EXPECT().GetRecordedBySubscriptionIds(mock.Anything, []types.SubscriptionID{sub.Id()}).Return([]charge.Charge{chg}, nil)
+	exch.EXPECT().ToCurrencyBatch(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(passThroughBatch).Maybe()
+
+	h := query.NewSummaryQueryHandler(subRepo, chargeRepo, famRepo, acctService, auth, exch)
+	res := h.Handle(ctx, query.SummaryQuery{
+		TopProviders: 1,
+		TotalMonthly: true,
+	})
+
+	assert.True(t, res.IsSuccess())
+	res.IfSuccess(func(out query.SummaryQueryResponse) {
+		assert.Equal(t, 10.0, out.TotalMonthly.Value())
+		assert.Equal(t, 12.0, out.TotalLastMonth.Value())
+		if assert.Len(t, out.TopProviders, 1) {
+			assert.InDelta(t, expectedSpent+2, out.TopProviders[0].Total.Value(), 0.0001)
+		}
+	})
+}
+
+func TestSummaryQueryHandler_Pauses(t *testing.T) {
+	ctx := context.Background()
+	userID := types.UserID("user-1")
+
+	subRepo := ports.NewMockSubscriptionRepository(t)
+	chargeRepo := ports.NewMockChargeRepository(t)
+	famRepo := ports.NewMockFamilyRepository(t)
+	acctService := ports.NewMockAccountService(t)
+	auth := ports.NewMockAuthentication(t)
+	exch := ports.NewMockExchange(t)
+
+	acc := account.NewMockConnectedAccount(t)
+	acc.EXPECT().UserID().Return(userID).Maybe()
+	auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc)
+	acctService.EXPECT().GetPreferredCurrency(mock.Anything, userID).Return(currency.USD)
+	acctService.EXPECT().GetTimezone(mock.Anything, userID).Return(time.UTC)
+	famRepo.EXPECT().GetAccountFamily(mock.Anything, userID).Return(buildTestFamily(userID), nil)
+
+	start := time.Now().AddDate(-1, 0, 0)
+	active := buildSub(10, currency.USD, start, subscription.MonthlyRecurrency, 0, nil,
+		types.ProviderID(uuid.Must(uuid.NewV7())))
+	// Suspended three months last year and resumed since
+	resumed := buildSub(20, currency.USD, start, subscription.MonthlyRecurrency, 0, nil,
+		types.ProviderID(uuid.Must(uuid.NewV7())))
+	assert.NoError(t, resumed.Pause(start.AddDate(0, 2, 0), x.P(start.AddDate(0, 5, 0))))
+	// Suspended until further notice
+	suspended := buildSub(30, currency.USD, start, subscription.MonthlyRecurrency, 0, nil,
+		types.ProviderID(uuid.Must(uuid.NewV7())))
+	assert.NoError(t, suspended.Pause(time.Now().AddDate(0, 0, -7), nil))
+	// Suspended with a known resume date
+	scheduled := buildSub(40, currency.USD, start, subscription.MonthlyRecurrency, 0, nil,
+		types.ProviderID(uuid.Must(uuid.NewV7())))
+	resumeAt := time.Now().AddDate(0, 0, 45)
+	assert.NoError(t, scheduled.Pause(time.Now().AddDate(0, 0, -7), &resumeAt))
+
+	seq := func(yield func(subscription.Subscription) bool) {
+		for _, sub := range []subscription.Subscription{active, resumed, suspended, scheduled} {
+			if !yield(sub) {
+				return
+			}
+		}
+	}
+	subRepo.EXPECT().GetAllIt(mock.Anything, userID, "").Return(seq)
+	chargeRepo.EXPECT().GetRecordedBySubscriptionIds(mock.Anything, mock.Anything).Return(nil, nil)
+	exch.EXPECT().ToCurrencyBatch(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(passThroughBatch).Maybe()
+
+	h := query.NewSummaryQueryHandler(subRepo, chargeRepo, famRepo, acctService, auth, exch)
+	res := h.Handle(ctx, query.SummaryQuery{
+		TopProviders:     4,
+		UpcomingRenewals: 4,
+		TotalMonthly:     true,
+	})
+
+	assert.True(t, res.IsSuccess())
+	res.IfSuccess(func(out query.SummaryQueryResponse) {
+		assert.Equal(t, uint16(2), out.Active)
+		assert.Equal(t, 30.0, out.TotalMonthly.Value())
+		if assert.Len(t, out.UpcomingRenewals, 3) {
+			for _, renewal := range out.UpcomingRenewals {
+				assert.NotEqual(t, suspended.Id(), renewal.SubscriptionId)
+				if renewal.SubscriptionId == scheduled.Id() {
+					assert.False(t, renewal.At.Before(resumeAt))
+				}
+			}
+		}
+		for _, provider := range out.TopProviders {
+			if provider.ProviderID == resumed.ProviderId() {
+				assert.Equal(t, 9*20.0, provider.Total.Value())
+			}
+		}
+	})
+}
+
+func TestSummaryQueryHandler_Contract(t *testing.T) {
+	ctx := context.Background()
+	userID := types.UserID("user-1")
+
+	subRepo := ports.NewMockSubscriptionRepository(t)
+	chargeRepo := ports.NewMockChargeRepository(t)
+	famRepo := ports.NewMockFamilyRepository(t)
+	acctService := ports.NewMockAccountService(t)
+	auth := ports.NewMockAuthentication(t)
+	exch := ports.NewMockExchange(t)
+
+	acc := account.NewMockConnectedAccount(t)
+	acc.EXPECT().UserID().Return(userID).Maybe()
+	auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc)
+	acctService.EXPECT().GetPreferredCurrency(mock.Anything, userID).Return(currency.USD)
+	acctService.EXPECT().GetTimezone(mock.Anything, userID).Return(time.UTC)
+	famRepo.EXPECT().GetAccountFamily(mock.Anything, userID).Return(buildTestFamily(userID), nil)
+
+	// Stored dates are UTC, renewals are then evaluated in the account timezone
+	start := time.Now().UTC().AddDate(0, -3, 20)
+	committed := buildSub(10, currency.USD, start, subscription.MonthlyRecurrency, 0, nil,
+		types.ProviderID(uuid.Must(uuid.NewV7())))
+	committed.SetContract(subscription.NewContract(nil, true, x.P(int32(7))))
+	plain := buildSub(20, currency.USD, start, subscription.MonthlyRecurrency, 0, nil,
+		types.ProviderID(uuid.Must(uuid.NewV7())))
+
+	seq := func(yield func(subscription.Subscription) bool) {
+		for _, sub := range []subscription.Subscription{committed, plain} {
+			if !yield(sub) {
+				return
+			}
+		}
+	}
+	subRepo.EXPECT().GetAllIt(mock.Anything, userID, "").Return(seq)
+	chargeRepo.EXPECT().GetRecordedBySubscriptionIds(mock.Anything, mock.Anything).Return(nil, nil)
+	exch.EXPECT().ToCurrencyBatch(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(passThroughBatch).Maybe()
+
+	h := query.NewSummaryQueryHandler(subRepo, chargeRepo, famRepo, acctService, auth, exch)
+	res := h.Handle(ctx, query.SummaryQuery{
+		UpcomingRenewals: 2,
+	})
+
+	assert.True(t, res.IsSuccess())
+	res.IfSuccess(func(out query.SummaryQueryResponse) {
+		if assert.Len(t, out.UpcomingRenewals, 2) {
+			for _, renewal := range out.UpcomingRenewals {
+				if renewal.SubscriptionId == committed.Id() {
+					if assert.NotNil(t, renewal.CancellationDeadline) {
+						assert.True(t, renewal.CancellationDeadline.Equal(renewal.At.AddDate(0, 0, -7)))
+					}
+				} else {
+					assert.Nil(t, renewal.CancellationDeadline)
+				}
+			}
+		}
+	})
+}
+
+func TestSummaryQueryHandler_Promotions(t *testing.T) {
+	ctx := context.Background()
+	userID := types.UserID("user-1")
+
+	subRepo := ports.NewMockSubscriptionRepository(t)
+	chargeRepo := ports.NewMockChargeRepository(t)
+	famRepo := ports.NewMockFamilyRepository(t)
+	acctService := ports.NewMockAccountService(t)
+	auth := ports.NewMockAuthentication(t)
+	exch := ports.NewMockExchange(t)
+
+	acc := account.NewMockConnectedAccount(t)
+	acc.EXPECT().UserID().Return(userID).Maybe()
+	auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc)
+	acctService.EXPECT().GetPreferredCurrency(mock.Anything, userID).Return(currency.USD)
+	acctService.EXPECT().GetTimezone(mock.Anything, userID).Return(time.UTC)
+	famRepo.EXPECT().GetAccountFamily(mock.Anything, userID).Return(buildTestFamily(userID), nil)
+
+	start := time.Now().AddDate(0, -2, 0)
+	// Discounted for six months, the next renewal is still discounted
+	ongoing := buildSub(10, currency.USD, start, subscription.MonthlyRecurrency, 0, nil,
+		types.ProviderID(uuid.Must(uuid.NewV7())))
+	assert.NoError(t, ongoing.AddPromotion(start, start.AddDate(0, 6, 0), currency.NewAmount(4, currency.USD)))
+	// Discount ending before the next renewal
+	ending := buildSub(20, currency.USD, start, subscription.MonthlyRecurrency, 0, nil,
+		types.ProviderID(uuid.Must(uuid.NewV7())))
+	endingNext := ending.GetNextRenewalDate()
+	assert.NotNil(t, endingNext)
+	assert.NoError(t, ending.AddPromotion(start, endingNext.Add(-time.Second), currency.NewAmount(8, currency.USD)))
+
+	seq := func(yield func(subscription.Subscription) bool) {
+		for _, sub := range []subscription.Subscription{ongoing, ending} {
+			if !yield(sub) {
+				return
+			}
+		}
+	}
+	subRepo.EXPECT().GetAllIt(mock.Anything, userID, "").Return(seq)
+	chargeRepo.EXPECT().GetRecordedBySubscriptionIds(mock.Anything, mock.Anything).Return(nil, nil)
+	exch.EXPECT().ToCurrencyBatch(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(passThroughBatch).Maybe()
+
+	h := query.NewSummaryQueryHandler(subRepo, chargeRepo, famRepo, acctService, auth, exch)
+	res := h.Handle(ctx, query.SummaryQuery{
+		UpcomingRenewals: 2,
+		TotalMonthly:     true,
+	})
+
+	assert.True(t, res.IsSuccess())
+	res.IfSuccess(func(out query.SummaryQueryResponse) {
+		assert.Equal(t, 4.0+8.0, out.TotalMonthly.Value())
+		if assert.Len(t, out.UpcomingRenewals, 2) {
+			for _, renewal := range out.UpcomingRenewals {
+				switch renewal.SubscriptionId {
+				case ongoing.Id():
+					assert.Equal(t, 4.0, renewal.Total.Value())
+				case ending.Id():
+					assert.Equal(t, 20.0, renewal.Total.Value())
+				}
+			}
+		}
+	})
+}
+
+func TestSummaryQueryHandler_HistoricalRates(t *testing.T) {
+	ctx := context.Background()
+	userID := types.UserID("user-1")
+
+	subRepo := ports.NewMockSubscriptionRepository(t)
+	chargeRepo := ports.NewMockChargeRepository(t)
+	famRepo := ports.NewMockFamilyRepository(t)
+	acctService := ports.NewMockAccountService(t)
+	auth := ports.NewMockAuthentication(t)
+	exch := ports.NewMockExchange(t)
+
+	now := time.Now()
+	acc := account.NewMockConnectedAccount(t)
+	acc.EXPECT().UserID().Return(userID).Maybe()
+	auth.EXPECT().MustGetConnectedAccount(mock.Anything).Return(acc)
+	acctService.EXPECT().GetPreferredCurrency(mock.Anything, userID).Return(currency.USD)
+	acctService.EXPECT().GetTimezone(mock.Anything, userID).Return(time.UTC)
+	famRepo.EXPECT().GetAccountFamily(mock.Anything, userID).Return(buildTestFamily(userID), nil)
+
+	provider := types.ProviderID(uuid.Must(uuid.NewV7()))
+	start := now.AddDate(0, -3, 0)
+	first := buildSub(10, currency.EUR, start, subscription.MonthlyRecurrency, 0, nil, provider)
+	second := buildSub(5, currency.EUR, start, subscription.MonthlyRecurrency, 0, nil, provider)
+
+	seq := func(yield func(subscription.Subscription) bool) { _ = yield(first) && yield(second) }
+	subRepo.EXPECT().GetAllIt(mock.Anything, userID, "").Return(seq)
+	chargeRepo.EXPECT().GetRecordedBySubscriptionIds(mock.Anything, mock.Anything).Return(nil, nil)
+
+	// Every amount is converted in a single call, one euro was worth 1.5 dollars until six weeks ago and 2 since
+	exch.EXPECT().ToCurrencyBatch(mock.Anything, mock.Anything, currency.USD).
+		RunAndReturn(func(
+			_ context.Context,
+			conversions []ports.Conversion,
+			target currency.Unit) ([]currency.Amount, error) {
+			return herd.Select(conversions, func(c ports.Conversion) currency.Amount {
+				rate := 2.0
+				if c.At.Before(now.AddDate(0, 0, -42)) {
+					rate = 1.5
+				}
+				return currency.NewAmount(c.Amount.Value()*rate, target)
+			}), nil
+		}).Once()
+
+	h := query.NewSummaryQueryHandler(subRepo, chargeRepo, famRepo, acctService, auth, exch)
+	res := h.Handle(ctx, query.SummaryQuery{
+		TopProviders:     1,
+		UpcomingRenewals: 2,
+		TotalMonthly:     true,
+	})
+
+	assert.True(t, res.IsSuccess())
+	res.IfSuccess(func(out query.SummaryQueryResponse) {
+		assert.Equal(t, 30.0, out.TotalMonthly.Value())
+		assert.Equal(t, 30.0, out.TotalLastMonth.Value())
+		if assert.Len(t, out.UpcomingRenewals, 2) {
+			// Renewals are converted at the latest rate
+			assert.Equal(t, 30.0, out.UpcomingRenewals[0].Total.Value()+out.UpcomingRenewals[1].Total.Value())
+		}
+		if assert.Len(t, out.TopProviders, 1) {
+			// Each month is converted at the rate of the day it started
+			assert.InDelta(t, 15*1.5+15*1.5+15*2, out.TopProviders[0].Total.Value(), 0.0001)
+			assert.Equal(t, currency.USD, out.TopProviders[0].Total.Currency())
+		}
+	})
+}
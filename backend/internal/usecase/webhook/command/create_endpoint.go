@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// CreateEndpointCommand registers a webhook endpoint of the connected account, its secret is only returned by
+// this command
+type CreateEndpointCommand struct {
+	Url string
+	// EventTypes are the events the endpoint receives, every event when empty
+	EventTypes []webhook.EventType
+}
+
+type CreateEndpointCommandHandler struct {
+	endpointRepository ports.WebhookEndpointRepository
+	authentication     ports.Authentication
+}
+
+func NewCreateEndpointCommandHandler(
+	endpointRepository ports.WebhookEndpointRepository,
+	authentication ports.Authentication) *CreateEndpointCommandHandler {
+	return &CreateEndpointCommandHandler{
+		endpointRepository: endpointRepository,
+		authentication:     authentication,
+	}
+}
+
+func (h CreateEndpointCommandHandler) Handle(
+	ctx context.Context,
+	command CreateEndpointCommand) result.Result[webhook.Endpoint] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	existing, err := h.endpointRepository.GetAllByUserId(ctx, connectedAccount.UserID())
+	if err != nil {
+		return result.Fail[webhook.Endpoint](err)
+	}
+	if len(existing) >= webhook.MaxEndpoints {
+		return result.Fail[webhook.Endpoint](webhook.ErrEndpointLimitReached)
+	}
+
+	endpoint, err := webhook.CreateEndpoint(connectedAccount.UserID(), command.Url, command.EventTypes)
+	if err != nil {
+		return result.Fail[webhook.Endpoint](err)
+	}
+	if validationErrors := endpoint.GetValidationErrors(); validationErrors.HasErrors() {
+		return result.Fail[webhook.Endpoint](validationErrors)
+	}
+
+	if err = h.endpointRepository.Save(ctx, endpoint); err != nil {
+		return result.Fail[webhook.Endpoint](err)
+	}
+
+	return result.Success(endpoint)
+}
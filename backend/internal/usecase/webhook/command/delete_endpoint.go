@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// DeleteEndpointCommand deletes a webhook endpoint of the connected account with its delivery log
+type DeleteEndpointCommand struct {
+	EndpointID types.WebhookEndpointID
+}
+
+type DeleteEndpointCommandHandler struct {
+	endpointRepository ports.WebhookEndpointRepository
+	authentication     ports.Authentication
+}
+
+func NewDeleteEndpointCommandHandler(
+	endpointRepository ports.WebhookEndpointRepository,
+	authentication ports.Authentication) *DeleteEndpointCommandHandler {
+	return &DeleteEndpointCommandHandler{
+		endpointRepository: endpointRepository,
+		authentication:     authentication,
+	}
+}
+
+func (h DeleteEndpointCommandHandler) Handle(
+	ctx context.Context,
+	command DeleteEndpointCommand) result.Result[bool] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	endpoint, err := h.endpointRepository.GetByIdForUser(ctx, connectedAccount.UserID(), command.EndpointID)
+	if err != nil {
+		return result.Fail[bool](err)
+	}
+	if endpoint == nil {
+		return result.Fail[bool](webhook.ErrEndpointNotFound)
+	}
+
+	ok, err := h.endpointRepository.Delete(ctx, endpoint.Id())
+	if err != nil {
+		return result.Fail[bool](err)
+	}
+	if !ok {
+		return result.Fail[bool](webhook.ErrEndpointNotFound)
+	}
+
+	return result.Success(true)
+}
@@ -0,0 +1,131 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// deliveryLease is how long a delivery is reserved to the instance attempting it, it outlasts a request
+const deliveryLease = 5 * time.Minute
+
+// DeliverWebhooksCommand attempts the deliveries whose next attempt is due. Each delivery is leased before it is
+// attempted so that several instances do not send it at the same time.
+type DeliverWebhooksCommand struct {
+	// Limit is the maximum number of deliveries attempted
+	Limit int64
+}
+
+// DeliverWebhooksResult reports the attempts of a run
+type DeliverWebhooksResult struct {
+	Succeeded int
+	Failed    int
+}
+
+type DeliverWebhooksCommandHandler struct {
+	endpointRepository ports.WebhookEndpointRepository
+	deliveryRepository ports.WebhookDeliveryRepository
+	sender             ports.WebhookSender
+}
+
+func NewDeliverWebhooksCommandHandler(
+	endpointRepository ports.WebhookEndpointRepository,
+	deliveryRepository ports.WebhookDeliveryRepository,
+	sender ports.WebhookSender) *DeliverWebhooksCommandHandler {
+	return &DeliverWebhooksCommandHandler{
+		endpointRepository: endpointRepository,
+		deliveryRepository: deliveryRepository,
+		sender:             sender,
+	}
+}
+
+func (h DeliverWebhooksCommandHandler) Handle(
+	ctx context.Context,
+	command DeliverWebhooksCommand) result.Result[DeliverWebhooksResult] {
+	now := time.Now()
+	deliveries, err := h.deliveryRepository.GetDue(ctx, now, command.Limit)
+	if err != nil {
+		return result.Fail[DeliverWebhooksResult](err)
+	}
+
+	var report DeliverWebhooksResult
+	endpoints := make(map[types.WebhookEndpointID]webhook.Endpoint)
+	for _, delivery := range deliveries {
+		if err = ctx.Err(); err != nil {
+			return result.Fail[DeliverWebhooksResult](err)
+		}
+
+		leased, err := h.deliveryRepository.Lease(ctx, delivery.Id(), now, time.Now().Add(deliveryLease))
+		if err != nil {
+			return result.Fail[DeliverWebhooksResult](err)
+		}
+		if !leased {
+			continue
+		}
+
+		endpoint, ok := endpoints[delivery.EndpointId()]
+		if !ok {
+			endpoint, err = h.endpointRepository.GetById(ctx, delivery.EndpointId())
+			if err != nil {
+				return result.Fail[DeliverWebhooksResult](err)
+			}
+			endpoints[delivery.EndpointId()] = endpoint
+		}
+		// The deliveries of a deleted endpoint are deleted with it
+		if endpoint == nil {
+			continue
+		}
+
+		h.attempt(ctx, endpoint, delivery)
+		if delivery.Status() == webhook.SucceededDeliveryStatus {
+			report.Succeeded++
+		} else {
+			report.Failed++
+		}
+
+		// The outcome of a request that was sent is kept even when the run is cancelled
+		if err = h.deliveryRepository.Save(context.WithoutCancel(ctx), delivery); err != nil {
+			return result.Fail[DeliverWebhooksResult](err)
+		}
+	}
+
+	return result.Success(report)
+}
+
+// attempt sends the delivery to the endpoint and records the outcome
+func (h DeliverWebhooksCommandHandler) attempt(
+	ctx context.Context,
+	endpoint webhook.Endpoint,
+	delivery webhook.Delivery) {
+	if !endpoint.Enabled() {
+		delivery.RecordFailure(nil, "endpoint is disabled", time.Now())
+		return
+	}
+
+	sentAt := time.Now()
+	headers := http.Header{}
+	headers.Set("Content-Type", "application/json")
+	headers.Set(webhook.EventHeader, delivery.EventType().String())
+	headers.Set(webhook.DeliveryHeader, delivery.Id().String())
+	headers.Set(webhook.SignatureHeader, webhook.Sign(endpoint.Secret(), sentAt, delivery.Payload()))
+
+	statusCode, err := h.sender.Send(ctx, ports.WebhookRequest{
+		Url:     endpoint.Url(),
+		Headers: headers,
+		Body:    delivery.Payload(),
+	})
+	switch {
+	case err != nil:
+		delivery.RecordFailure(nil, err.Error(), time.Now())
+	case statusCode < 200 || statusCode > 299:
+		delivery.RecordFailure(&statusCode, fmt.Sprintf("unexpected status code %d", statusCode), time.Now())
+	default:
+		delivery.RecordSuccess(statusCode, time.Now())
+	}
+}
@@ -0,0 +1,135 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/webhook/command"
+)
+
+func TestDeliverWebhooks(t *testing.T) {
+	const secret = "whsec_secret"
+	payload := []byte(`{"id":"event-1","type":"label.created","data":{}}`)
+	newEndpoint := func(enabled bool) webhook.Endpoint {
+		return webhook.NewEndpoint(types.NewWebhookEndpointID(), types.UserID("user-1"),
+			"https://example.com/hooks", secret, nil, enabled, time.Now(), time.Now())
+	}
+
+	type mocks struct {
+		endpointRepository *ports.MockWebhookEndpointRepository
+		deliveryRepository *ports.MockWebhookDeliveryRepository
+		sender             *ports.MockWebhookSender
+		handler            *command.DeliverWebhooksCommandHandler
+	}
+	newMocks := func(t *testing.T, endpoint webhook.Endpoint, deliveries ...webhook.Delivery) mocks {
+		m := mocks{
+			endpointRepository: ports.NewMockWebhookEndpointRepository(t),
+			deliveryRepository: ports.NewMockWebhookDeliveryRepository(t),
+			sender:             ports.NewMockWebhookSender(t),
+		}
+		m.handler = command.NewDeliverWebhooksCommandHandler(m.endpointRepository, m.deliveryRepository, m.sender)
+
+		m.deliveryRepository.EXPECT().GetDue(mock.Anything, mock.Anything, int64(10)).Return(deliveries, nil)
+		if endpoint != nil {
+			m.endpointRepository.EXPECT().GetById(mock.Anything, endpoint.Id()).Return(endpoint, nil).Once()
+		}
+		return m
+	}
+
+	t.Run("sends the signed payload and records the success", func(t *testing.T) {
+		endpoint := newEndpoint(true)
+		delivery := webhook.NewPendingDelivery(endpoint.Id(), "event-1", webhook.LabelCreatedEventType, payload)
+		m := newMocks(t, endpoint, delivery)
+		m.deliveryRepository.EXPECT().Lease(mock.Anything, delivery.Id(), mock.Anything, mock.Anything).
+			Return(true, nil)
+		m.sender.EXPECT().Send(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, request ports.WebhookRequest) (int, error) {
+				assert.Equal(t, "https://example.com/hooks", request.Url)
+				assert.Equal(t, payload, request.Body)
+				assert.Equal(t, "label.created", request.Headers.Get(webhook.EventHeader))
+				assert.Equal(t, delivery.Id().String(), request.Headers.Get(webhook.DeliveryHeader))
+				assert.True(t, webhook.Verify(secret, request.Headers.Get(webhook.SignatureHeader), request.Body))
+				return http.StatusOK, nil
+			})
+		m.deliveryRepository.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+		res := m.handler.Handle(t.Context(), command.DeliverWebhooksCommand{Limit: 10})
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.DeliverWebhooksResult) {
+			assert.Equal(t, command.DeliverWebhooksResult{Succeeded: 1}, report)
+		})
+		assert.Equal(t, webhook.SucceededDeliveryStatus, delivery.Status())
+		assert.Equal(t, 1, delivery.Attempts())
+	})
+
+	t.Run("schedules a retry after a failure", func(t *testing.T) {
+		endpoint := newEndpoint(true)
+		first := webhook.NewPendingDelivery(endpoint.Id(), "event-1", webhook.LabelCreatedEventType, payload)
+		second := webhook.NewPendingDelivery(endpoint.Id(), "event-2", webhook.LabelCreatedEventType, payload)
+		m := newMocks(t, endpoint, first, second)
+		m.deliveryRepository.EXPECT().Lease(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(true, nil)
+		m.sender.EXPECT().Send(mock.Anything, mock.Anything).Return(http.StatusServiceUnavailable, nil).Once()
+		m.sender.EXPECT().Send(mock.Anything, mock.Anything).Return(0, errors.New("connection refused")).Once()
+		m.deliveryRepository.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+		before := time.Now()
+		res := m.handler.Handle(t.Context(), command.DeliverWebhooksCommand{Limit: 10})
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.DeliverWebhooksResult) {
+			assert.Equal(t, command.DeliverWebhooksResult{Failed: 2}, report)
+		})
+
+		assert.Equal(t, webhook.PendingDeliveryStatus, first.Status())
+		require.NotNil(t, first.LastStatusCode())
+		assert.Equal(t, http.StatusServiceUnavailable, *first.LastStatusCode())
+		require.NotNil(t, first.NextAttemptAt())
+		assert.False(t, first.NextAttemptAt().Before(before.Add(webhook.InitialBackoff)))
+
+		assert.Nil(t, second.LastStatusCode())
+		require.NotNil(t, second.LastError())
+		assert.Equal(t, "connection refused", *second.LastError())
+	})
+
+	t.Run("skips a delivery leased by another instance", func(t *testing.T) {
+		delivery := webhook.NewPendingDelivery(types.NewWebhookEndpointID(), "event-1",
+			webhook.LabelCreatedEventType, payload)
+		m := newMocks(t, nil, delivery)
+		m.deliveryRepository.EXPECT().Lease(mock.Anything, delivery.Id(), mock.Anything, mock.Anything).
+			Return(false, nil)
+
+		res := m.handler.Handle(t.Context(), command.DeliverWebhooksCommand{Limit: 10})
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.DeliverWebhooksResult) {
+			assert.Equal(t, command.DeliverWebhooksResult{}, report)
+		})
+		assert.Equal(t, 0, delivery.Attempts())
+	})
+
+	t.Run("does not send to a disabled endpoint", func(t *testing.T) {
+		endpoint := newEndpoint(false)
+		delivery := webhook.NewPendingDelivery(endpoint.Id(), "event-1", webhook.LabelCreatedEventType, payload)
+		m := newMocks(t, endpoint, delivery)
+		m.deliveryRepository.EXPECT().Lease(mock.Anything, delivery.Id(), mock.Anything, mock.Anything).
+			Return(true, nil)
+		m.deliveryRepository.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+		res := m.handler.Handle(t.Context(), command.DeliverWebhooksCommand{Limit: 10})
+		require.True(t, res.IsSuccess())
+		res.IfSuccess(func(report command.DeliverWebhooksResult) {
+			assert.Equal(t, command.DeliverWebhooksResult{Failed: 1}, report)
+		})
+		require.NotNil(t, delivery.LastError())
+		assert.Equal(t, "endpoint is disabled", *delivery.LastError())
+	})
+}
@@ -0,0 +1,119 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mistribe/subtracker/internal/domain/calendar"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// PublishRenewalsCommand publishes the renewal.due events of the renewals happening today in the timezone of
+// their account. The id of an event is derived from the renewal, a renewal is delivered once to an endpoint
+// however many times the command runs.
+type PublishRenewalsCommand struct {
+}
+
+// PublishRenewalsResult reports a run, an account failing does not stop the events of the others
+type PublishRenewalsResult struct {
+	Enqueued int
+	// Errors are the failures of the accounts whose events could not all be published
+	Errors []error
+}
+
+type PublishRenewalsCommandHandler struct {
+	endpointRepository ports.WebhookEndpointRepository
+	eventBuilder       shared.CalendarEventBuilder
+	accountService     ports.AccountService
+	publisher          shared.WebhookPublisher
+}
+
+func NewPublishRenewalsCommandHandler(
+	endpointRepository ports.WebhookEndpointRepository,
+	eventBuilder shared.CalendarEventBuilder,
+	accountService ports.AccountService,
+	publisher shared.WebhookPublisher) *PublishRenewalsCommandHandler {
+	return &PublishRenewalsCommandHandler{
+		endpointRepository: endpointRepository,
+		eventBuilder:       eventBuilder,
+		accountService:     accountService,
+		publisher:          publisher,
+	}
+}
+
+func (h PublishRenewalsCommandHandler) Handle(
+	ctx context.Context,
+	_ PublishRenewalsCommand) result.Result[PublishRenewalsResult] {
+	endpoints, err := h.endpointRepository.GetAllAccepting(ctx, webhook.RenewalDueEventType)
+	if err != nil {
+		return result.Fail[PublishRenewalsResult](err)
+	}
+
+	var userIds []types.UserID
+	endpointsByUser := make(map[types.UserID][]webhook.Endpoint)
+	for _, endpoint := range endpoints {
+		if _, ok := endpointsByUser[endpoint.UserId()]; !ok {
+			userIds = append(userIds, endpoint.UserId())
+		}
+		endpointsByUser[endpoint.UserId()] = append(endpointsByUser[endpoint.UserId()], endpoint)
+	}
+
+	var report PublishRenewalsResult
+	for _, userId := range userIds {
+		if err = ctx.Err(); err != nil {
+			return result.Fail[PublishRenewalsResult](err)
+		}
+
+		enqueued, err := h.publish(ctx, userId, endpointsByUser[userId])
+		report.Enqueued += enqueued
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Errorf("renewals of user %s: %w", userId, err))
+		}
+	}
+
+	return result.Success(report)
+}
+
+// publish enqueues the events of the renewals of the account happening today
+func (h PublishRenewalsCommandHandler) publish(
+	ctx context.Context,
+	userId types.UserID,
+	endpoints []webhook.Endpoint) (int, error) {
+	events, err := h.eventBuilder.Build(ctx, userId, 1)
+	if err != nil {
+		return 0, err
+	}
+
+	today := time.Now().In(h.accountService.GetTimezone(ctx, userId))
+	enqueued := 0
+	for _, event := range events {
+		if event.Kind != calendar.RenewalEventKind || !sameDay(event.Date, today) {
+			continue
+		}
+
+		n, err := h.publisher.PublishTo(ctx, endpoints, shared.WebhookEvent{
+			Id:        event.UID,
+			Type:      webhook.RenewalDueEventType,
+			CreatedAt: time.Now(),
+			Data:      shared.NewWebhookRenewalData(event.SubscriptionId, event.Title, event.Date, event.Price),
+		})
+		enqueued += n
+		if err != nil {
+			return enqueued, err
+		}
+	}
+
+	return enqueued, nil
+}
+
+// sameDay tells whether a and b fall on the same calendar day, each in its own location
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// RedeliverCommand sends again an event of the delivery log of an endpoint of the connected account. The
+// redelivery is a new delivery of the log, attempted as soon as possible.
+type RedeliverCommand struct {
+	EndpointID types.WebhookEndpointID
+	DeliveryID types.WebhookDeliveryID
+}
+
+type RedeliverCommandHandler struct {
+	endpointRepository ports.WebhookEndpointRepository
+	deliveryRepository ports.WebhookDeliveryRepository
+	authentication     ports.Authentication
+}
+
+func NewRedeliverCommandHandler(
+	endpointRepository ports.WebhookEndpointRepository,
+	deliveryRepository ports.WebhookDeliveryRepository,
+	authentication ports.Authentication) *RedeliverCommandHandler {
+	return &RedeliverCommandHandler{
+		endpointRepository: endpointRepository,
+		deliveryRepository: deliveryRepository,
+		authentication:     authentication,
+	}
+}
+
+func (h RedeliverCommandHandler) Handle(
+	ctx context.Context,
+	command RedeliverCommand) result.Result[webhook.Delivery] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	endpoint, err := h.endpointRepository.GetByIdForUser(ctx, connectedAccount.UserID(), command.EndpointID)
+	if err != nil {
+		return result.Fail[webhook.Delivery](err)
+	}
+	if endpoint == nil {
+		return result.Fail[webhook.Delivery](webhook.ErrEndpointNotFound)
+	}
+
+	delivery, err := h.deliveryRepository.GetById(ctx, command.DeliveryID)
+	if err != nil {
+		return result.Fail[webhook.Delivery](err)
+	}
+	if delivery == nil || delivery.EndpointId() != endpoint.Id() {
+		return result.Fail[webhook.Delivery](webhook.ErrDeliveryNotFound)
+	}
+
+	redelivery, err := delivery.Redeliver()
+	if err != nil {
+		return result.Fail[webhook.Delivery](err)
+	}
+	if err = h.deliveryRepository.Save(ctx, redelivery); err != nil {
+		return result.Fail[webhook.Delivery](err)
+	}
+
+	return result.Success(redelivery)
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// UpdateEndpointCommand replaces the URL, the event types and the state of a webhook endpoint of the connected
+// account, a disabled endpoint receives no event
+type UpdateEndpointCommand struct {
+	EndpointID types.WebhookEndpointID
+	Url        string
+	EventTypes []webhook.EventType
+	Enabled    bool
+}
+
+type UpdateEndpointCommandHandler struct {
+	endpointRepository ports.WebhookEndpointRepository
+	authentication     ports.Authentication
+}
+
+func NewUpdateEndpointCommandHandler(
+	endpointRepository ports.WebhookEndpointRepository,
+	authentication ports.Authentication) *UpdateEndpointCommandHandler {
+	return &UpdateEndpointCommandHandler{
+		endpointRepository: endpointRepository,
+		authentication:     authentication,
+	}
+}
+
+func (h UpdateEndpointCommandHandler) Handle(
+	ctx context.Context,
+	command UpdateEndpointCommand) result.Result[webhook.Endpoint] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	endpoint, err := h.endpointRepository.GetByIdForUser(ctx, connectedAccount.UserID(), command.EndpointID)
+	if err != nil {
+		return result.Fail[webhook.Endpoint](err)
+	}
+	if endpoint == nil {
+		return result.Fail[webhook.Endpoint](webhook.ErrEndpointNotFound)
+	}
+
+	endpoint.SetUrl(command.Url)
+	endpoint.SetEventTypes(command.EventTypes)
+	endpoint.SetEnabled(command.Enabled)
+	if validationErrors := endpoint.GetValidationErrors(); validationErrors.HasErrors() {
+		return result.Fail[webhook.Endpoint](validationErrors)
+	}
+
+	if err = h.endpointRepository.Save(ctx, endpoint); err != nil {
+		return result.Fail[webhook.Endpoint](err)
+	}
+
+	return result.Success(endpoint)
+}
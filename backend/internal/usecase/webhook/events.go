@@ -0,0 +1,188 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/fx"
+
+	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/label"
+	"github.com/mistribe/subtracker/internal/domain/provider"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	familycommand "github.com/mistribe/subtracker/internal/usecase/family/command"
+	labelcommand "github.com/mistribe/subtracker/internal/usecase/label/command"
+	providercommand "github.com/mistribe/subtracker/internal/usecase/provider/command"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	subscriptioncommand "github.com/mistribe/subtracker/internal/usecase/subscription/command"
+)
+
+// on publishes an event with the data of the aggregate returned by the command, to the members of the family that
+// owns it
+func on[TCommand ports.Command, TResult any, TData any](
+	eventType webhook.EventType,
+	data func(TResult) TData,
+	owner func(TResult) types.Owner) any {
+	return publishing(func(_ context.Context, _ publishingDependencies, _ TCommand, res TResult) ([]publication, error) {
+		return []publication{{eventType: eventType, data: data(res), owner: owner(res)}}, nil
+	}, nil)
+}
+
+// onEach publishes an event with the data of every aggregate returned by the command, to the members of the family
+// that owns each of them
+func onEach[TCommand ports.Command, TResult any, TAggregate any, TData any](
+	eventType webhook.EventType,
+	aggregates func(TResult) []TAggregate,
+	data func(TAggregate) TData,
+	owner func(TAggregate) types.Owner) any {
+	return publishing(func(_ context.Context, _ publishingDependencies, _ TCommand, res TResult) ([]publication, error) {
+		var publications []publication
+		for _, aggregate := range aggregates(res) {
+			publications = append(publications,
+				publication{eventType: eventType, data: data(aggregate), owner: owner(aggregate)})
+		}
+		return publications, nil
+	}, nil)
+}
+
+// onDelete publishes an event with the id of the aggregate deleted by the command, to the members of the family
+// that owned it
+func onDelete[TCommand ports.Command](
+	eventType webhook.EventType,
+	id func(TCommand) fmt.Stringer,
+	owner ownerBefore[TCommand]) any {
+	return publishing(func(
+		_ context.Context,
+		_ publishingDependencies,
+		command TCommand,
+		deleted bool) ([]publication, error) {
+		if !deleted {
+			return nil, nil
+		}
+		return []publication{{
+			eventType: eventType,
+			data:      shared.WebhookDeletedData{Id: id(command).String()},
+		}}, nil
+	}, owner)
+}
+
+// onMemberChange publishes the family updated event once a command changed a member of the family without returning
+// it, to the members of the family before the change so that a removed member is notified too
+func onMemberChange[TCommand ports.Command, TResult any](
+	familyId func(TCommand) types.FamilyID,
+	changed func(TResult) bool) any {
+	return publishing(func(
+		ctx context.Context,
+		deps publishingDependencies,
+		command TCommand,
+		res TResult) ([]publication, error) {
+		if !changed(res) {
+			return nil, nil
+		}
+		fam, err := deps.FamilyRepository.GetById(ctx, familyId(command))
+		if err != nil || fam == nil {
+			return nil, err
+		}
+		return []publication{{
+			eventType: webhook.FamilyUpdatedEventType,
+			data:      shared.NewWebhookFamilyData(fam),
+			owner:     familyOwner(fam),
+		}}, nil
+	}, func(_ context.Context, _ publishingDependencies, command TCommand) (types.Owner, error) {
+		return types.NewFamilyOwner(familyId(command)), nil
+	})
+}
+
+// ownerOf returns the owner of an aggregate loaded from a repository, nil when it does not exist
+func ownerOf[TAggregate interface{ Owner() types.Owner }](aggregate TAggregate, err error) (types.Owner, error) {
+	if err != nil || any(aggregate) == nil {
+		return nil, err
+	}
+	return aggregate.Owner(), nil
+}
+
+// familyOwner returns the family as the owner of its own events
+func familyOwner(fam family.Family) types.Owner {
+	return types.NewFamilyOwner(fam.Id())
+}
+
+// changed returns whether a command that reports a change with a boolean changed anything
+func changed(ok bool) bool {
+	return ok
+}
+
+// publishers decorates the command handlers whose changes are published to the webhook endpoints
+func publishers() fx.Option {
+	return fx.Decorate(
+		on[subscriptioncommand.CreateSubscriptionCommand](webhook.SubscriptionCreatedEventType,
+			shared.NewWebhookSubscriptionData, subscription.Subscription.Owner),
+		on[subscriptioncommand.UpdateSubscriptionCommand](webhook.SubscriptionUpdatedEventType,
+			shared.NewWebhookSubscriptionData, subscription.Subscription.Owner),
+		on[subscriptioncommand.AddPriceChangeCommand](webhook.SubscriptionUpdatedEventType,
+			shared.NewWebhookSubscriptionData, subscription.Subscription.Owner),
+		on[subscriptioncommand.AddPromotionCommand](webhook.SubscriptionUpdatedEventType,
+			shared.NewWebhookSubscriptionData, subscription.Subscription.Owner),
+		on[subscriptioncommand.PauseSubscriptionCommand](webhook.SubscriptionUpdatedEventType,
+			shared.NewWebhookSubscriptionData, subscription.Subscription.Owner),
+		on[subscriptioncommand.ResumeSubscriptionCommand](webhook.SubscriptionUpdatedEventType,
+			shared.NewWebhookSubscriptionData, subscription.Subscription.Owner),
+		onEach[subscriptioncommand.ImportSubscriptionsCommand](webhook.SubscriptionCreatedEventType,
+			subscriptioncommand.ImportSubscriptionsResult.Created, shared.NewWebhookSubscriptionData,
+			subscription.Subscription.Owner),
+		onDelete(webhook.SubscriptionDeletedEventType,
+			func(c subscriptioncommand.DeleteSubscriptionCommand) fmt.Stringer { return c.SubscriptionID },
+			func(
+				ctx context.Context,
+				deps publishingDependencies,
+				c subscriptioncommand.DeleteSubscriptionCommand) (types.Owner, error) {
+				return ownerOf(deps.SubscriptionRepository.GetById(ctx, c.SubscriptionID))
+			}),
+
+		on[labelcommand.CreateLabelCommand](webhook.LabelCreatedEventType, shared.NewWebhookLabelData,
+			label.Label.Owner),
+		on[labelcommand.UpdateLabelCommand](webhook.LabelUpdatedEventType, shared.NewWebhookLabelData,
+			label.Label.Owner),
+		onDelete(webhook.LabelDeletedEventType,
+			func(c labelcommand.DeleteLabelCommand) fmt.Stringer { return c.LabelID },
+			func(ctx context.Context, deps publishingDependencies, c labelcommand.DeleteLabelCommand) (types.Owner, error) {
+				return ownerOf(deps.LabelRepository.GetById(ctx, c.LabelID))
+			}),
+
+		on[providercommand.CreateProviderCommand](webhook.ProviderCreatedEventType, shared.NewWebhookProviderData,
+			provider.Provider.Owner),
+		on[providercommand.UpdateProviderCommand](webhook.ProviderUpdatedEventType, shared.NewWebhookProviderData,
+			provider.Provider.Owner),
+		onDelete(webhook.ProviderDeletedEventType,
+			func(c providercommand.DeleteProviderCommand) fmt.Stringer { return c.ProviderID },
+			func(
+				ctx context.Context,
+				deps publishingDependencies,
+				c providercommand.DeleteProviderCommand) (types.Owner, error) {
+				return ownerOf(deps.ProviderRepository.GetById(ctx, c.ProviderID))
+			}),
+
+		on[familycommand.CreateFamilyCommand](webhook.FamilyCreatedEventType, shared.NewWebhookFamilyData,
+			familyOwner),
+		on[familycommand.UpdateFamilyCommand](webhook.FamilyUpdatedEventType, shared.NewWebhookFamilyData,
+			familyOwner),
+		on[familycommand.CreateFamilyMemberCommand](webhook.FamilyUpdatedEventType, shared.NewWebhookFamilyData,
+			familyOwner),
+		on[familycommand.UpdateFamilyMemberCommand](webhook.FamilyUpdatedEventType, shared.NewWebhookFamilyData,
+			familyOwner),
+		onMemberChange(func(c familycommand.DeleteFamilyMemberCommand) types.FamilyID { return c.FamilyID },
+			changed),
+		onMemberChange(func(c familycommand.InviteMemberCommand) types.FamilyID { return c.FamilyId },
+			func(familycommand.InviteMemberResponse) bool { return true }),
+		onMemberChange(func(c familycommand.AcceptInvitationCommand) types.FamilyID { return c.FamilyId }, changed),
+		onMemberChange(func(c familycommand.DeclineInvitationCommand) types.FamilyID { return c.FamilyId }, changed),
+		onMemberChange(func(c familycommand.RevokeMemberCommand) types.FamilyID { return c.FamilyId }, changed),
+		onDelete(webhook.FamilyDeletedEventType,
+			func(c familycommand.DeleteFamilyCommand) fmt.Stringer { return c.FamilyId },
+			func(_ context.Context, _ publishingDependencies, c familycommand.DeleteFamilyCommand) (types.Owner, error) {
+				return types.NewFamilyOwner(c.FamilyId), nil
+			}),
+	)
+}
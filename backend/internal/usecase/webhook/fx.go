@@ -0,0 +1,31 @@
+package webhook
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/internal/usecase/webhook/command"
+	"github.com/mistribe/subtracker/internal/usecase/webhook/query"
+)
+
+func Module() fx.Option {
+	return fx.Options(
+		fx.Module("app_webhook",
+			fx.Provide(
+				ports.AsQueryHandler[query.FindEndpointsQuery, []webhook.Endpoint](query.NewFindEndpointsQueryHandler),
+				ports.AsQueryHandler[query.FindEndpointQuery, webhook.Endpoint](query.NewFindEndpointQueryHandler),
+				ports.AsQueryHandler[query.FindDeliveriesQuery, shared.PaginatedResponse[webhook.Delivery]](query.NewFindDeliveriesQueryHandler),
+
+				ports.AsCommandHandler[command.CreateEndpointCommand, webhook.Endpoint](command.NewCreateEndpointCommandHandler),
+				ports.AsCommandHandler[command.UpdateEndpointCommand, webhook.Endpoint](command.NewUpdateEndpointCommandHandler),
+				ports.AsCommandHandler[command.DeleteEndpointCommand, bool](command.NewDeleteEndpointCommandHandler),
+				ports.AsCommandHandler[command.RedeliverCommand, webhook.Delivery](command.NewRedeliverCommandHandler),
+				ports.AsCommandHandler[command.DeliverWebhooksCommand, command.DeliverWebhooksResult](command.NewDeliverWebhooksCommandHandler),
+				ports.AsCommandHandler[command.PublishRenewalsCommand, command.PublishRenewalsResult](command.NewPublishRenewalsCommandHandler),
+			)),
+		// The decorations are declared at the root so that they apply to the handlers provided by the other modules
+		publishers(),
+	)
+}
@@ -0,0 +1,202 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// publication is a webhook event and the owner of the aggregate it is about
+type publication struct {
+	eventType webhook.EventType
+	data      any
+	owner     types.Owner
+}
+
+// eventsOf returns the webhook events of a successful command with the owners of the aggregates they are about, none
+// when the command did not change anything
+type eventsOf[TCommand ports.Command, TResult any] func(
+	ctx context.Context,
+	deps publishingDependencies,
+	command TCommand,
+	res TResult) ([]publication, error)
+
+// ownerBefore returns the owner of the aggregate of a command before the command runs, the members of a family
+// are no longer known once the family or its aggregate is deleted. A nil owner is no owner.
+type ownerBefore[TCommand ports.Command] func(
+	ctx context.Context,
+	deps publishingDependencies,
+	command TCommand) (types.Owner, error)
+
+// publishingDependencies are the dependencies of the decorators of the published command handlers
+type publishingDependencies struct {
+	fx.In
+
+	Publisher              shared.WebhookPublisher
+	Authentication         ports.Authentication
+	FamilyRepository       ports.FamilyRepository
+	SubscriptionRepository ports.SubscriptionRepository
+	LabelRepository        ports.LabelRepository
+	ProviderRepository     ports.ProviderRepository
+	Logger                 *slog.Logger
+}
+
+// recipients returns the accounts that receive an event about an aggregate of owner, every member of the family
+// with an account when a family owns it and the connected account in any case
+func (d publishingDependencies) recipients(
+	ctx context.Context,
+	userId types.UserID,
+	owner types.Owner) ([]types.UserID, error) {
+	userIds := []types.UserID{userId}
+	if owner == nil || owner.Type() != types.FamilyOwnerType {
+		return userIds, nil
+	}
+
+	fam, err := d.FamilyRepository.GetById(ctx, owner.FamilyId())
+	if err != nil {
+		return nil, err
+	}
+	if fam == nil {
+		return userIds, nil
+	}
+	for member := range fam.Members().It() {
+		if member.UserId() != nil && !slices.Contains(userIds, *member.UserId()) {
+			userIds = append(userIds, *member.UserId())
+		}
+	}
+	return userIds, nil
+}
+
+// publishingCommandHandler publishes the webhook events of the command it wraps once it succeeded, to the endpoints
+// of the connected account and of the members of the family that owns the aggregate of each event
+type publishingCommandHandler[TCommand ports.Command, TResult any] struct {
+	handler     ports.CommandHandler[TCommand, TResult]
+	deps        publishingDependencies
+	eventsOf    eventsOf[TCommand, TResult]
+	ownerBefore ownerBefore[TCommand]
+}
+
+func (h publishingCommandHandler[TCommand, TResult]) Handle(
+	ctx context.Context,
+	command TCommand) result.Result[TResult] {
+	connectedAccount, authenticated := h.deps.Authentication.GetConnectedAccount(ctx)
+
+	var recipients []types.UserID
+	if authenticated && h.ownerBefore != nil {
+		recipients = h.recipientsBefore(ctx, connectedAccount.UserID(), command)
+	}
+
+	r := h.handler.Handle(ctx, command)
+	r.IfSuccess(func(res TResult) {
+		if !authenticated {
+			return
+		}
+		// The command already succeeded, failing to publish its events does not fail it
+		ctx := context.WithoutCancel(ctx)
+		publications, err := h.eventsOf(ctx, h.deps, command, res)
+		if err != nil {
+			h.deps.Logger.Error("failed to resolve the webhook events", slog.Any("error", err))
+			return
+		}
+
+		// The recipients of a family are resolved once, an import publishes many events about the same owners
+		recipientsByFamily := make(map[types.FamilyID][]types.UserID)
+		for _, p := range publications {
+			to := recipients
+			if to == nil {
+				to = h.recipientsOf(ctx, connectedAccount.UserID(), p, recipientsByFamily)
+			}
+			h.publish(ctx, to, p)
+		}
+	})
+	return r
+}
+
+// recipientsOf resolves the recipients of a publication, only the connected account receives it when they cannot be
+// resolved
+func (h publishingCommandHandler[TCommand, TResult]) recipientsOf(
+	ctx context.Context,
+	userId types.UserID,
+	p publication,
+	recipientsByFamily map[types.FamilyID][]types.UserID) []types.UserID {
+	if p.owner == nil || p.owner.Type() != types.FamilyOwnerType {
+		return []types.UserID{userId}
+	}
+	if recipients, ok := recipientsByFamily[p.owner.FamilyId()]; ok {
+		return recipients
+	}
+
+	recipients, err := h.deps.recipients(ctx, userId, p.owner)
+	if err != nil {
+		h.logError(p.eventType, err)
+		return []types.UserID{userId}
+	}
+	recipientsByFamily[p.owner.FamilyId()] = recipients
+	return recipients
+}
+
+// publish enqueues a publication to the endpoints of the recipients
+func (h publishingCommandHandler[TCommand, TResult]) publish(
+	ctx context.Context,
+	recipients []types.UserID,
+	p publication) {
+	event := shared.WebhookEvent{
+		Id:        uuid.Must(uuid.NewV7()).String(),
+		Type:      p.eventType,
+		CreatedAt: time.Now(),
+		Data:      p.data,
+	}
+	if _, err := h.deps.Publisher.Publish(ctx, recipients, event); err != nil {
+		h.logError(p.eventType, err)
+	}
+}
+
+// recipientsBefore resolves the recipients of the event of the command before it runs, only the connected account
+// receives the event when they cannot be resolved
+func (h publishingCommandHandler[TCommand, TResult]) recipientsBefore(
+	ctx context.Context,
+	userId types.UserID,
+	command TCommand) []types.UserID {
+	owner, err := h.ownerBefore(ctx, h.deps, command)
+	if err == nil {
+		var recipients []types.UserID
+		if recipients, err = h.deps.recipients(ctx, userId, owner); err == nil {
+			return recipients
+		}
+	}
+	h.deps.Logger.Error("failed to resolve the webhook recipients", slog.Any("error", err))
+	return []types.UserID{userId}
+}
+
+func (h publishingCommandHandler[TCommand, TResult]) logError(eventType webhook.EventType, err error) {
+	h.deps.Logger.Error("failed to publish webhook event",
+		slog.String("event_type", eventType.String()),
+		slog.Any("error", err))
+}
+
+// publishing returns the fx decorator of the command handler that publishes the events returned by eventsOf,
+// ownerBefore is nil when eventsOf knows the owners of the aggregates
+func publishing[TCommand ports.Command, TResult any](
+	eventsOf eventsOf[TCommand, TResult],
+	ownerBefore ownerBefore[TCommand]) any {
+	return func(
+		handler ports.CommandHandler[TCommand, TResult],
+		deps publishingDependencies) ports.CommandHandler[TCommand, TResult] {
+		return publishingCommandHandler[TCommand, TResult]{
+			handler:     handler,
+			deps:        deps,
+			eventsOf:    eventsOf,
+			ownerBefore: ownerBefore,
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mistribe/subtracker/internal/domain/account"
+	"github.com/mistribe/subtracker/internal/domain/currency"
+	"github.com/mistribe/subtracker/internal/domain/family"
+	"github.com/mistribe/subtracker/internal/domain/label"
+	"github.com/mistribe/subtracker/internal/domain/subscription"
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	familycommand "github.com/mistribe/subtracker/internal/usecase/family/command"
+	labelcommand "github.com/mistribe/subtracker/internal/usecase/label/command"
+	"github.com/mistribe/subtracker/internal/usecase/shared"
+	subscriptioncommand "github.com/mistribe/subtracker/internal/usecase/subscription/command"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+type handlerFunc[TCommand ports.Command, TResult any] func(context.Context, TCommand) result.Result[TResult]
+
+func (f handlerFunc[TCommand, TResult]) Handle(ctx context.Context, command TCommand) result.Result[TResult] {
+	return f(ctx, command)
+}
+
+// decorate applies the fx decorator returned by publishing to handler
+func decorate[TCommand ports.Command, TResult any](
+	t *testing.T,
+	decorator any,
+	handler handlerFunc[TCommand, TResult],
+	userId types.UserID,
+	endpointRepository ports.WebhookEndpointRepository,
+	deliveryRepository ports.WebhookDeliveryRepository,
+	familyRepository ports.FamilyRepository) ports.CommandHandler[TCommand, TResult] {
+	authentication := ports.NewMockAuthentication(t)
+	connectedAccount := account.NewMockConnectedAccount(t)
+	connectedAccount.EXPECT().UserID().Return(userId).Maybe()
+	authentication.EXPECT().GetConnectedAccount(mock.Anything).Return(connectedAccount, true).Maybe()
+
+	f, ok := decorator.(func(
+		ports.CommandHandler[TCommand, TResult],
+		publishingDependencies) ports.CommandHandler[TCommand, TResult])
+	require.True(t, ok)
+	return f(handler, publishingDependencies{
+		Publisher:              shared.NewWebhookPublisher(endpointRepository, deliveryRepository),
+		Authentication:         authentication,
+		FamilyRepository:       familyRepository,
+		SubscriptionRepository: ports.NewMockSubscriptionRepository(t),
+		LabelRepository:        ports.NewMockLabelRepository(t),
+		ProviderRepository:     ports.NewMockProviderRepository(t),
+		Logger:                 slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+}
+
+func TestPublishing(t *testing.T) {
+	userId := types.UserID("user-1")
+	endpoint := webhook.NewEndpoint(types.NewWebhookEndpointID(), userId, "https://example.com/hooks",
+		"whsec_secret", nil, true, time.Now(), time.Now())
+	lbl := label.NewLabel(types.NewLabelID(), types.NewPersonalOwner(userId), "Streaming", nil, "#FF5733",
+		time.Now(), time.Now())
+
+	t.Run("publishes the aggregate returned by a successful command", func(t *testing.T) {
+		deliveryRepository := ports.NewMockWebhookDeliveryRepository(t)
+		var enqueued webhook.Delivery
+		deliveryRepository.EXPECT().Enqueue(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, delivery webhook.Delivery) (bool, error) {
+				enqueued = delivery
+				return true, nil
+			})
+		handler := handlerFunc[labelcommand.CreateLabelCommand, label.Label](
+			func(context.Context, labelcommand.CreateLabelCommand) result.Result[label.Label] {
+				return result.Success(lbl)
+			})
+
+		endpointRepository := ports.NewMockWebhookEndpointRepository(t)
+		endpointRepository.EXPECT().GetAllByUserId(mock.Anything, userId).Return([]webhook.Endpoint{endpoint}, nil)
+
+		decorated := decorate(t,
+			on[labelcommand.CreateLabelCommand](webhook.LabelCreatedEventType, shared.NewWebhookLabelData,
+				label.Label.Owner),
+			handler, userId, endpointRepository, deliveryRepository, ports.NewMockFamilyRepository(t))
+		res := decorated.Handle(t.Context(), labelcommand.CreateLabelCommand{})
+
+		require.True(t, res.IsSuccess())
+		require.NotNil(t, enqueued)
+		assert.Equal(t, endpoint.Id(), enqueued.EndpointId())
+		assert.Equal(t, webhook.LabelCreatedEventType, enqueued.EventType())
+		var event struct {
+			Id   string `json:"id"`
+			Type string `json:"type"`
+			Data struct {
+				Id   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(enqueued.Payload(), &event))
+		assert.Equal(t, enqueued.EventId(), event.Id)
+		assert.Equal(t, "label.created", event.Type)
+		assert.Equal(t, lbl.Id().String(), event.Data.Id)
+		assert.Equal(t, "Streaming", event.Data.Name)
+	})
+
+	t.Run("does not publish a failed command", func(t *testing.T) {
+		handler := handlerFunc[labelcommand.CreateLabelCommand, label.Label](
+			func(context.Context, labelcommand.CreateLabelCommand) result.Result[label.Label] {
+				return result.Fail[label.Label](label.ErrLabelNotFound)
+			})
+
+		decorated := decorate(t,
+			on[labelcommand.CreateLabelCommand](webhook.LabelCreatedEventType, shared.NewWebhookLabelData,
+				label.Label.Owner),
+			handler, userId, ports.NewMockWebhookEndpointRepository(t), ports.NewMockWebhookDeliveryRepository(t),
+			ports.NewMockFamilyRepository(t))
+		res := decorated.Handle(t.Context(), labelcommand.CreateLabelCommand{})
+
+		assert.False(t, res.IsSuccess())
+	})
+
+	t.Run("does not publish a deletion that deleted nothing", func(t *testing.T) {
+		handler := handlerFunc[labelcommand.DeleteLabelCommand, bool](
+			func(context.Context, labelcommand.DeleteLabelCommand) result.Result[bool] {
+				return result.Success(false)
+			})
+
+		decorated := decorate(t,
+			onDelete(webhook.LabelDeletedEventType,
+				func(c labelcommand.DeleteLabelCommand) fmt.Stringer { return c.LabelID },
+				func(context.Context, publishingDependencies, labelcommand.DeleteLabelCommand) (types.Owner, error) {
+					return lbl.Owner(), nil
+				}),
+			handler, userId, ports.NewMockWebhookEndpointRepository(t), ports.NewMockWebhookDeliveryRepository(t),
+			ports.NewMockFamilyRepository(t))
+		res := decorated.Handle(t.Context(), labelcommand.DeleteLabelCommand{LabelID: lbl.Id()})
+
+		assert.True(t, res.IsSuccess())
+	})
+	t.Run("publishes the events of a family aggregate to every member with an account", func(t *testing.T) {
+		partnerId := types.UserID("user-2")
+		fam := newTestFamily(userId, partnerId)
+		partnerEndpoint := webhook.NewEndpoint(types.NewWebhookEndpointID(), partnerId,
+			"https://partner.example.com/hooks", "whsec_secret", nil, true, time.Now(), time.Now())
+		familyLabel := label.NewLabel(types.NewLabelID(), types.NewFamilyOwner(fam.Id()), "Shared", nil, "#FF5733",
+			time.Now(), time.Now())
+
+		familyRepository := ports.NewMockFamilyRepository(t)
+		familyRepository.EXPECT().GetById(mock.Anything, fam.Id()).Return(fam, nil)
+		endpointRepository := ports.NewMockWebhookEndpointRepository(t)
+		endpointRepository.EXPECT().GetAllByUserId(mock.Anything, userId).Return([]webhook.Endpoint{endpoint}, nil)
+		endpointRepository.EXPECT().GetAllByUserId(mock.Anything, partnerId).
+			Return([]webhook.Endpoint{partnerEndpoint}, nil)
+		deliveryRepository := ports.NewMockWebhookDeliveryRepository(t)
+		var endpoints []types.WebhookEndpointID
+		deliveryRepository.EXPECT().Enqueue(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, delivery webhook.Delivery) (bool, error) {
+				endpoints = append(endpoints, delivery.EndpointId())
+				return true, nil
+			})
+		handler := handlerFunc[labelcommand.UpdateLabelCommand, label.Label](
+			func(context.Context, labelcommand.UpdateLabelCommand) result.Result[label.Label] {
+				return result.Success(familyLabel)
+			})
+
+		decorated := decorate(t,
+			on[labelcommand.UpdateLabelCommand](webhook.LabelUpdatedEventType, shared.NewWebhookLabelData,
+				label.Label.Owner),
+			handler, userId, endpointRepository, deliveryRepository, familyRepository)
+		res := decorated.Handle(t.Context(), labelcommand.UpdateLabelCommand{})
+
+		require.True(t, res.IsSuccess())
+		assert.ElementsMatch(t, []types.WebhookEndpointID{endpoint.Id(), partnerEndpoint.Id()}, endpoints)
+	})
+
+	t.Run("resolves the members of a family before deleting it", func(t *testing.T) {
+		partnerId := types.UserID("user-2")
+		fam := newTestFamily(userId, partnerId)
+		partnerEndpoint := webhook.NewEndpoint(types.NewWebhookEndpointID(), partnerId,
+			"https://partner.example.com/hooks", "whsec_secret", nil, true, time.Now(), time.Now())
+
+		deleted := false
+		familyRepository := ports.NewMockFamilyRepository(t)
+		familyRepository.EXPECT().GetById(mock.Anything, fam.Id()).
+			RunAndReturn(func(context.Context, types.FamilyID) (family.Family, error) {
+				if deleted {
+					return nil, nil
+				}
+				return fam, nil
+			})
+		endpointRepository := ports.NewMockWebhookEndpointRepository(t)
+		endpointRepository.EXPECT().GetAllByUserId(mock.Anything, userId).Return(nil, nil)
+		endpointRepository.EXPECT().GetAllByUserId(mock.Anything, partnerId).
+			Return([]webhook.Endpoint{partnerEndpoint}, nil)
+		deliveryRepository := ports.NewMockWebhookDeliveryRepository(t)
+		var enqueued webhook.Delivery
+		deliveryRepository.EXPECT().Enqueue(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, delivery webhook.Delivery) (bool, error) {
+				enqueued = delivery
+				return true, nil
+			})
+		handler := handlerFunc[familycommand.DeleteFamilyCommand, bool](
+			func(context.Context, familycommand.DeleteFamilyCommand) result.Result[bool] {
+				deleted = true
+				return result.Success(true)
+			})
+
+		decorated := decorate(t,
+			onDelete(webhook.FamilyDeletedEventType,
+				func(c familycommand.DeleteFamilyCommand) fmt.Stringer { return c.FamilyId },
+				func(
+					_ context.Context,
+					_ publishingDependencies,
+					c familycommand.DeleteFamilyCommand) (types.Owner, error) {
+					return types.NewFamilyOwner(c.FamilyId), nil
+				}),
+			handler, userId, endpointRepository, deliveryRepository, familyRepository)
+		res := decorated.Handle(t.Context(), familycommand.DeleteFamilyCommand{FamilyId: fam.Id()})
+
+		require.True(t, res.IsSuccess())
+		require.NotNil(t, enqueued)
+		assert.Equal(t, partnerEndpoint.Id(), enqueued.EndpointId())
+		assert.Equal(t, webhook.FamilyDeletedEventType, enqueued.EventType())
+	})
+
+	t.Run("publishes an event for every subscription created by an import", func(t *testing.T) {
+		partnerId := types.UserID("user-2")
+		fam := newTestFamily(userId, partnerId)
+		partnerEndpoint := webhook.NewEndpoint(types.NewWebhookEndpointID(), partnerId,
+			"https://partner.example.com/hooks", "whsec_secret", nil, true, time.Now(), time.Now())
+		personal := newTestSubscription(types.NewPersonalOwner(userId))
+		shared1 := newTestSubscription(types.NewFamilyOwner(fam.Id()))
+		shared2 := newTestSubscription(types.NewFamilyOwner(fam.Id()))
+
+		familyRepository := ports.NewMockFamilyRepository(t)
+		familyRepository.EXPECT().GetById(mock.Anything, fam.Id()).Return(fam, nil).Once()
+		endpointRepository := ports.NewMockWebhookEndpointRepository(t)
+		endpointRepository.EXPECT().GetAllByUserId(mock.Anything, userId).Return([]webhook.Endpoint{endpoint}, nil)
+		endpointRepository.EXPECT().GetAllByUserId(mock.Anything, partnerId).
+			Return([]webhook.Endpoint{partnerEndpoint}, nil)
+		deliveryRepository := ports.NewMockWebhookDeliveryRepository(t)
+		published := make(map[types.WebhookEndpointID][]string)
+		deliveryRepository.EXPECT().Enqueue(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, delivery webhook.Delivery) (bool, error) {
+				assert.Equal(t, webhook.SubscriptionCreatedEventType, delivery.EventType())
+				var event struct {
+					Data struct {
+						Id string `json:"id"`
+					} `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(delivery.Payload(), &event))
+				published[delivery.EndpointId()] = append(published[delivery.EndpointId()], event.Data.Id)
+				return true, nil
+			})
+		handler := importing(
+			subscriptioncommand.ImportSubscriptionRowResult{
+				Status: subscriptioncommand.ImportRowStatusCreated, Subscription: personal},
+			subscriptioncommand.ImportSubscriptionRowResult{Status: subscriptioncommand.ImportRowStatusFailed},
+			subscriptioncommand.ImportSubscriptionRowResult{
+				Status: subscriptioncommand.ImportRowStatusCreated, Subscription: shared1},
+			subscriptioncommand.ImportSubscriptionRowResult{
+				Status: subscriptioncommand.ImportRowStatusCreated, Subscription: shared2})
+
+		decorated := decorate(t,
+			onEach[subscriptioncommand.ImportSubscriptionsCommand](webhook.SubscriptionCreatedEventType,
+				subscriptioncommand.ImportSubscriptionsResult.Created, shared.NewWebhookSubscriptionData,
+				subscription.Subscription.Owner),
+			handler, userId, endpointRepository, deliveryRepository, familyRepository)
+		res := decorated.Handle(t.Context(), subscriptioncommand.ImportSubscriptionsCommand{})
+
+		require.True(t, res.IsSuccess())
+		assert.Equal(t, []string{personal.Id().String(), shared1.Id().String(), shared2.Id().String()},
+			published[endpoint.Id()])
+		assert.Equal(t, []string{shared1.Id().String(), shared2.Id().String()}, published[partnerEndpoint.Id()])
+	})
+
+	t.Run("does not publish the subscriptions of a dry run import", func(t *testing.T) {
+		handler := importing(
+			subscriptioncommand.ImportSubscriptionRowResult{Status: subscriptioncommand.ImportRowStatusValid})
+
+		decorated := decorate(t,
+			onEach[subscriptioncommand.ImportSubscriptionsCommand](webhook.SubscriptionCreatedEventType,
+				subscriptioncommand.ImportSubscriptionsResult.Created, shared.NewWebhookSubscriptionData,
+				subscription.Subscription.Owner),
+			handler, userId, ports.NewMockWebhookEndpointRepository(t), ports.NewMockWebhookDeliveryRepository(t),
+			ports.NewMockFamilyRepository(t))
+		res := decorated.Handle(t.Context(), subscriptioncommand.ImportSubscriptionsCommand{DryRun: true})
+
+		assert.True(t, res.IsSuccess())
+	})
+
+	t.Run("publishes the family to the member it removed", func(t *testing.T) {
+		partnerId := types.UserID("user-2")
+		fam := newTestFamily(userId, partnerId)
+		var partner family.Member
+		for member := range fam.Members().It() {
+			if member.UserId() != nil && *member.UserId() == partnerId {
+				partner = member
+			}
+		}
+		partnerEndpoint := webhook.NewEndpoint(types.NewWebhookEndpointID(), partnerId,
+			"https://partner.example.com/hooks", "whsec_secret", nil, true, time.Now(), time.Now())
+
+		familyRepository := ports.NewMockFamilyRepository(t)
+		familyRepository.EXPECT().GetById(mock.Anything, fam.Id()).Return(fam, nil)
+		endpointRepository := ports.NewMockWebhookEndpointRepository(t)
+		endpointRepository.EXPECT().GetAllByUserId(mock.Anything, userId).Return(nil, nil)
+		endpointRepository.EXPECT().GetAllByUserId(mock.Anything, partnerId).
+			Return([]webhook.Endpoint{partnerEndpoint}, nil)
+		deliveryRepository := ports.NewMockWebhookDeliveryRepository(t)
+		var enqueued webhook.Delivery
+		deliveryRepository.EXPECT().Enqueue(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, delivery webhook.Delivery) (bool, error) {
+				enqueued = delivery
+				return true, nil
+			})
+		handler := handlerFunc[familycommand.DeleteFamilyMemberCommand, bool](
+			func(context.Context, familycommand.DeleteFamilyMemberCommand) result.Result[bool] {
+				require.NoError(t, fam.RemoveMember(partner))
+				return result.Success(true)
+			})
+
+		decorated := decorate(t,
+			onMemberChange(func(c familycommand.DeleteFamilyMemberCommand) types.FamilyID { return c.FamilyID },
+				changed),
+			handler, userId, endpointRepository, deliveryRepository, familyRepository)
+		res := decorated.Handle(t.Context(), familycommand.DeleteFamilyMemberCommand{
+			FamilyID:       fam.Id(),
+			FamilyMemberID: partner.Id(),
+		})
+
+		require.True(t, res.IsSuccess())
+		require.NotNil(t, enqueued)
+		assert.Equal(t, partnerEndpoint.Id(), enqueued.EndpointId())
+		assert.Equal(t, webhook.FamilyUpdatedEventType, enqueued.EventType())
+		var event struct {
+			Data struct {
+				Members []struct {
+					Id string `json:"id"`
+				} `json:"members"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(enqueued.Payload(), &event))
+		assert.Len(t, event.Data.Members, 2)
+	})
+}
+
+// importing returns an import handler that reports rows
+func importing(
+	rows ...subscriptioncommand.ImportSubscriptionRowResult,
+) handlerFunc[subscriptioncommand.ImportSubscriptionsCommand, subscriptioncommand.ImportSubscriptionsResult] {
+	return func(
+		_ context.Context,
+		command subscriptioncommand.ImportSubscriptionsCommand,
+	) result.Result[subscriptioncommand.ImportSubscriptionsResult] {
+		return result.Success(subscriptioncommand.ImportSubscriptionsResult{DryRun: command.DryRun, Rows: rows})
+	}
+}
+
+// newTestSubscription returns a monthly subscription of owner
+func newTestSubscription(owner types.Owner) subscription.Subscription {
+	return subscription.NewSubscription(types.NewSubscriptionID(), nil, nil, nil, types.NewProviderID(),
+		subscription.NewPrice(currency.NewAmount(10, currency.EUR)), nil, nil, owner, nil, nil, nil, nil, nil,
+		time.Now(), nil, nil, subscription.MonthlyRecurrency, nil, nil, time.Now(), time.Now())
+}
+
+// newTestFamily returns a family of the two users and of a child without an account
+func newTestFamily(ownerId, partnerId types.UserID) family.Family {
+	familyId := types.NewFamilyID()
+	owner := family.NewMember(types.NewFamilyMemberID(), familyId, "Owner", family.OwnerMemberType, nil,
+		time.Now(), time.Now())
+	owner.SetUserId(&ownerId)
+	partner := family.NewMember(types.NewFamilyMemberID(), familyId, "Partner", family.AdultMemberType, nil,
+		time.Now(), time.Now())
+	partner.SetUserId(&partnerId)
+	child := family.NewMember(types.NewFamilyMemberID(), familyId, "Child", family.KidMemberType, nil,
+		time.Now(), time.Now())
+	return family.NewFamily(familyId, ownerId, "Family", []family.Member{owner, partner, child}, time.Now(),
+		time.Now())
+}
@@ -0,0 +1,57 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/internal/shared"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// FindDeliveriesQuery returns the delivery log of a webhook endpoint of the connected account, the most recent
+// deliveries first
+type FindDeliveriesQuery struct {
+	EndpointID types.WebhookEndpointID
+	Limit      int64
+	Offset     int64
+}
+
+type FindDeliveriesQueryHandler struct {
+	endpointRepository ports.WebhookEndpointRepository
+	deliveryRepository ports.WebhookDeliveryRepository
+	authentication     ports.Authentication
+}
+
+func NewFindDeliveriesQueryHandler(
+	endpointRepository ports.WebhookEndpointRepository,
+	deliveryRepository ports.WebhookDeliveryRepository,
+	authentication ports.Authentication) *FindDeliveriesQueryHandler {
+	return &FindDeliveriesQueryHandler{
+		endpointRepository: endpointRepository,
+		deliveryRepository: deliveryRepository,
+		authentication:     authentication,
+	}
+}
+
+func (h FindDeliveriesQueryHandler) Handle(
+	ctx context.Context,
+	query FindDeliveriesQuery) result.Result[shared.PaginatedResponse[webhook.Delivery]] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	endpoint, err := h.endpointRepository.GetByIdForUser(ctx, connectedAccount.UserID(), query.EndpointID)
+	if err != nil {
+		return result.Fail[shared.PaginatedResponse[webhook.Delivery]](err)
+	}
+	if endpoint == nil {
+		return result.Fail[shared.PaginatedResponse[webhook.Delivery]](webhook.ErrEndpointNotFound)
+	}
+
+	deliveries, count, err := h.deliveryRepository.GetAllByEndpointId(ctx, endpoint.Id(),
+		ports.NewQueryParameters(query.Limit, query.Offset))
+	if err != nil {
+		return result.Fail[shared.PaginatedResponse[webhook.Delivery]](err)
+	}
+
+	return result.Success(shared.NewPaginatedResponse(deliveries, count))
+}
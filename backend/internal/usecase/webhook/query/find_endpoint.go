@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/types"
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// FindEndpointQuery returns a webhook endpoint of the connected account
+type FindEndpointQuery struct {
+	EndpointID types.WebhookEndpointID
+}
+
+type FindEndpointQueryHandler struct {
+	endpointRepository ports.WebhookEndpointRepository
+	authentication     ports.Authentication
+}
+
+func NewFindEndpointQueryHandler(
+	endpointRepository ports.WebhookEndpointRepository,
+	authentication ports.Authentication) *FindEndpointQueryHandler {
+	return &FindEndpointQueryHandler{
+		endpointRepository: endpointRepository,
+		authentication:     authentication,
+	}
+}
+
+func (h FindEndpointQueryHandler) Handle(
+	ctx context.Context,
+	query FindEndpointQuery) result.Result[webhook.Endpoint] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	endpoint, err := h.endpointRepository.GetByIdForUser(ctx, connectedAccount.UserID(), query.EndpointID)
+	if err != nil {
+		return result.Fail[webhook.Endpoint](err)
+	}
+	if endpoint == nil {
+		return result.Fail[webhook.Endpoint](webhook.ErrEndpointNotFound)
+	}
+
+	return result.Success(endpoint)
+}
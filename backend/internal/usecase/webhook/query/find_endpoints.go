@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mistribe/subtracker/internal/domain/webhook"
+	"github.com/mistribe/subtracker/internal/ports"
+	"github.com/mistribe/subtracker/pkg/langext/result"
+)
+
+// FindEndpointsQuery lists the webhook endpoints of the connected account
+type FindEndpointsQuery struct {
+}
+
+type FindEndpointsQueryHandler struct {
+	endpointRepository ports.WebhookEndpointRepository
+	authentication     ports.Authentication
+}
+
+func NewFindEndpointsQueryHandler(
+	endpointRepository ports.WebhookEndpointRepository,
+	authentication ports.Authentication) *FindEndpointsQueryHandler {
+	return &FindEndpointsQueryHandler{
+		endpointRepository: endpointRepository,
+		authentication:     authentication,
+	}
+}
+
+func (h FindEndpointsQueryHandler) Handle(
+	ctx context.Context,
+	_ FindEndpointsQuery) result.Result[[]webhook.Endpoint] {
+	connectedAccount := h.authentication.MustGetConnectedAccount(ctx)
+	endpoints, err := h.endpointRepository.GetAllByUserId(ctx, connectedAccount.UserID())
+	if err != nil {
+		return result.Fail[[]webhook.Endpoint](err)
+	}
+
+	return result.Success(endpoints)
+}
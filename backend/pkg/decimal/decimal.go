@@ -0,0 +1,349 @@
+// Package decimal implements exact decimal numbers for money amounts.
+//
+// A Decimal is a coefficient and a scale, its value is coefficient * 10^-scale. Additions, subtractions and
+// multiplications are exact, divisions and roundings use an explicit RoundingMode.
+package decimal
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DivisionScale is the number of decimals kept by Div
+const DivisionScale = 16
+
+// MaxParsedScale bounds the exponent and the scale of a parsed decimal, so that a short input like "1e50000000"
+// cannot build a huge coefficient
+const MaxParsedScale = 64
+
+var (
+	ErrInvalidDecimal = errors.New("invalid decimal")
+
+	ten = big.NewInt(10)
+)
+
+// Zero is the zero decimal, it is also the zero value of Decimal
+var Zero = Decimal{}
+
+// Decimal is an immutable exact decimal number
+type Decimal struct {
+	// coefficient is nil for a zero decimal
+	coefficient *big.Int
+	scale       int32
+}
+
+// New creates the decimal coefficient * 10^-scale
+func New(coefficient int64, scale int32) Decimal {
+	if scale < 0 {
+		return Decimal{coefficient: new(big.Int).Mul(big.NewInt(coefficient), pow10(-scale))}
+	}
+	return Decimal{coefficient: big.NewInt(coefficient), scale: scale}
+}
+
+func NewFromInt(value int64) Decimal {
+	return New(value, 0)
+}
+
+// NewFromFloat creates the decimal of the shortest representation of value, so 0.1 is exactly 0.1.
+// It panics when value is NaN or infinite.
+func NewFromFloat(value float64) Decimal {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		panic(fmt.Sprintf("decimal: cannot create a decimal from %v", value))
+	}
+	// The digits of a float are bounded, so is its scale
+	d, err := parse(strconv.FormatFloat(value, 'f', -1, 64), math.MaxInt32)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// Parse reads a decimal like "-12.50" or "1.5e3".
+// The exponent and the resulting scale must be within -MaxParsedScale and MaxParsedScale.
+func Parse(value string) (Decimal, error) {
+	return parse(value, MaxParsedScale)
+}
+
+func parse(value string, maxScale int64) (Decimal, error) {
+	input := strings.TrimSpace(value)
+	exponent := int64(0)
+	if index := strings.IndexAny(input, "eE"); index >= 0 {
+		e, err := strconv.ParseInt(input[index+1:], 10, 32)
+		if err != nil {
+			return Zero, fmt.Errorf("%w: %q", ErrInvalidDecimal, value)
+		}
+		if e > maxScale || e < -maxScale {
+			return Zero, fmt.Errorf("%w: %q", ErrInvalidDecimal, value)
+		}
+		exponent = e
+		input = input[:index]
+	}
+
+	integer, fraction, _ := strings.Cut(input, ".")
+	digits := integer + fraction
+	if strings.ContainsAny(fraction, "+-") {
+		return Zero, fmt.Errorf("%w: %q", ErrInvalidDecimal, value)
+	}
+	coefficient, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Zero, fmt.Errorf("%w: %q", ErrInvalidDecimal, value)
+	}
+
+	scale := int64(len(fraction)) - exponent
+	if scale > maxScale || scale < -maxScale {
+		return Zero, fmt.Errorf("%w: %q", ErrInvalidDecimal, value)
+	}
+	if scale < 0 {
+		return Decimal{coefficient: coefficient.Mul(coefficient, pow10(int32(-scale)))}, nil
+	}
+	return Decimal{coefficient: coefficient, scale: int32(scale)}, nil
+}
+
+// MustParse is like Parse but panics when value is not a decimal
+func MustParse(value string) Decimal {
+	d, err := Parse(value)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// Sum returns the exact sum of values
+func Sum(values ...Decimal) Decimal {
+	total := Zero
+	for _, value := range values {
+		total = total.Add(value)
+	}
+	return total
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(ten, big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) coefficientOrZero() *big.Int {
+	if d.coefficient == nil {
+		return new(big.Int)
+	}
+	return d.coefficient
+}
+
+// rescale returns the coefficient of d at a scale greater than or equal to its own
+func (d Decimal) rescale(scale int32) *big.Int {
+	coefficient := d.coefficientOrZero()
+	if scale == d.scale {
+		return coefficient
+	}
+	return new(big.Int).Mul(coefficient, pow10(scale-d.scale))
+}
+
+// Scale is the number of decimals of d
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+func (d Decimal) Add(other Decimal) Decimal {
+	scale := max(d.scale, other.scale)
+	return Decimal{coefficient: new(big.Int).Add(d.rescale(scale), other.rescale(scale)), scale: scale}
+}
+
+func (d Decimal) Sub(other Decimal) Decimal {
+	scale := max(d.scale, other.scale)
+	return Decimal{coefficient: new(big.Int).Sub(d.rescale(scale), other.rescale(scale)), scale: scale}
+}
+
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{
+		coefficient: new(big.Int).Mul(d.coefficientOrZero(), other.coefficientOrZero()),
+		scale:       d.scale + other.scale,
+	}
+}
+
+// Div returns d / other with DivisionScale decimals rounded half to even, without trailing zeros.
+// It panics when other is zero.
+func (d Decimal) Div(other Decimal) Decimal {
+	return d.DivRound(other, DivisionScale, HalfEven).trimTrailingZeros(max(d.scale, other.scale))
+}
+
+// DivRound returns d / other with scale decimals rounded with mode.
+// It panics when other is zero.
+func (d Decimal) DivRound(other Decimal, scale int32, mode RoundingMode) Decimal {
+	if other.IsZero() {
+		panic("decimal: division by zero")
+	}
+	// d / other = (cd / co) * 10^(so - sd), shifted by 10^scale to keep scale decimals
+	numerator := new(big.Int).Set(d.coefficientOrZero())
+	denominator := new(big.Int).Set(other.coefficient)
+	shift := scale + other.scale - d.scale
+	if shift >= 0 {
+		numerator.Mul(numerator, pow10(shift))
+	} else {
+		denominator.Mul(denominator, pow10(-shift))
+	}
+	return Decimal{coefficient: roundQuotient(numerator, denominator, mode), scale: scale}
+}
+
+// Round returns d with exactly scale decimals, rounded with mode when it has more
+func (d Decimal) Round(scale int32, mode RoundingMode) Decimal {
+	if scale >= d.scale {
+		return Decimal{coefficient: d.rescale(scale), scale: scale}
+	}
+	return Decimal{coefficient: roundQuotient(d.coefficientOrZero(), pow10(d.scale-scale), mode), scale: scale}
+}
+
+// trimTrailingZeros removes the trailing zeros of the decimals of d while keeping at least minScale decimals
+func (d Decimal) trimTrailingZeros(minScale int32) Decimal {
+	if d.coefficient == nil || d.coefficient.Sign() == 0 {
+		return Decimal{scale: min(d.scale, minScale)}
+	}
+	coefficient := new(big.Int).Set(d.coefficient)
+	scale := d.scale
+	remainder := new(big.Int)
+	quotient := new(big.Int)
+	for scale > minScale {
+		quotient.QuoRem(coefficient, ten, remainder)
+		if remainder.Sign() != 0 {
+			break
+		}
+		coefficient.Set(quotient)
+		scale--
+	}
+	return Decimal{coefficient: coefficient, scale: scale}
+}
+
+func (d Decimal) Neg() Decimal {
+	return Decimal{coefficient: new(big.Int).Neg(d.coefficientOrZero()), scale: d.scale}
+}
+
+func (d Decimal) Abs() Decimal {
+	return Decimal{coefficient: new(big.Int).Abs(d.coefficientOrZero()), scale: d.scale}
+}
+
+// Cmp returns -1, 0 or +1 when d is less than, equal to or greater than other
+func (d Decimal) Cmp(other Decimal) int {
+	scale := max(d.scale, other.scale)
+	return d.rescale(scale).Cmp(other.rescale(scale))
+}
+
+// Equal returns true when both decimals have the same value, whatever their scale
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+func (d Decimal) GreaterThan(other Decimal) bool {
+	return d.Cmp(other) > 0
+}
+
+func (d Decimal) LessThan(other Decimal) bool {
+	return d.Cmp(other) < 0
+}
+
+func (d Decimal) Sign() int {
+	if d.coefficient == nil {
+		return 0
+	}
+	return d.coefficient.Sign()
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+func (d Decimal) IsNegative() bool {
+	return d.Sign() < 0
+}
+
+func (d Decimal) IsPositive() bool {
+	return d.Sign() > 0
+}
+
+// Float64 returns the nearest float of d
+func (d Decimal) Float64() float64 {
+	value, _ := strconv.ParseFloat(d.String(), 64)
+	return value
+}
+
+// String returns d with all its decimals and without exponent, e.g. "119.88"
+func (d Decimal) String() string {
+	digits := d.coefficientOrZero().String()
+	if d.scale == 0 {
+		return digits
+	}
+
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign = "-"
+		digits = digits[1:]
+	}
+	if len(digits) <= int(d.scale) {
+		digits = strings.Repeat("0", int(d.scale)-len(digits)+1) + digits
+	}
+	point := len(digits) - int(d.scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// MarshalJSON writes d as a JSON number with all its decimals
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON reads a JSON number or a string holding a number, null leaves d untouched
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	value := string(data)
+	if value == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = unquoted
+	}
+	parsed, err := Parse(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Scan reads a numeric column, the driver gives its exact text representation
+func (d *Decimal) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*d = Zero
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(value))
+	case []byte:
+		return d.UnmarshalText(value)
+	case int64:
+		*d = NewFromInt(value)
+		return nil
+	case float64:
+		*d = NewFromFloat(value)
+		return nil
+	default:
+		return fmt.Errorf("decimal: cannot scan %T", src)
+	}
+}
+
+// Value writes d as text so the database receives it without loss
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
@@ -0,0 +1,232 @@
+package decimal_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/mistribe/subtracker/pkg/decimal"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"0", "0"},
+		{"12", "12"},
+		{"-12.50", "-12.50"},
+		{"+0.001", "0.001"},
+		{".5", "0.5"},
+		{"-.5", "-0.5"},
+		{"1.5e3", "1500"},
+		{"125E-2", "1.25"},
+		{" 9.99 ", "9.99"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := decimal.Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, d.String())
+		})
+	}
+
+	for _, input := range []string{
+		"", "-", ".", "abc", "1.2.3", "1.-2", "1e", "1,5", "NaN",
+		"1e50000000", "1e-50000000", "1e65", "1e-65", "0." + strings.Repeat("1", 65), "0.1e-64",
+	} {
+		t.Run("rejects "+input, func(t *testing.T) {
+			_, err := decimal.Parse(input)
+			assert.ErrorIs(t, err, decimal.ErrInvalidDecimal)
+		})
+	}
+}
+
+func TestParseScaleBounds(t *testing.T) {
+	d, err := decimal.Parse("1e64")
+	require.NoError(t, err)
+	assert.Equal(t, "1"+strings.Repeat("0", 64), d.String())
+
+	d, err = decimal.Parse("1e-64")
+	require.NoError(t, err)
+	assert.Equal(t, int32(64), d.Scale())
+
+	d, err = decimal.Parse("0." + strings.Repeat("1", 64))
+	require.NoError(t, err)
+	assert.Equal(t, int32(64), d.Scale())
+}
+
+func TestNewFromFloat(t *testing.T) {
+	assert.Equal(t, "0.1", decimal.NewFromFloat(0.1).String())
+	assert.Equal(t, "9.99", decimal.NewFromFloat(9.99).String())
+	assert.Equal(t, "-42", decimal.NewFromFloat(-42).String())
+	assert.Equal(t, "0."+strings.Repeat("0", 69)+"1", decimal.NewFromFloat(1e-70).String())
+	assert.Panics(t, func() {
+		decimal.NewFromFloat(1.0 / zero())
+	})
+}
+
+func zero() float64 {
+	return 0
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	t.Run("sums without float error", func(t *testing.T) {
+		total := decimal.Zero
+		for range 12 {
+			total = total.Add(decimal.MustParse("9.99"))
+		}
+		assert.Equal(t, "119.88", total.String())
+		assert.Equal(t, 119.88, total.Float64())
+
+		assert.Equal(t, "0.3", decimal.MustParse("0.1").Add(decimal.MustParse("0.2")).String())
+	})
+
+	t.Run("subtracts", func(t *testing.T) {
+		assert.Equal(t, "-0.05", decimal.MustParse("9.95").Sub(decimal.NewFromInt(10)).String())
+	})
+
+	t.Run("multiplies", func(t *testing.T) {
+		assert.Equal(t, "119.88", decimal.MustParse("9.99").Mul(decimal.NewFromInt(12)).String())
+		assert.Equal(t, "0.0625", decimal.MustParse("0.25").Mul(decimal.MustParse("0.25")).String())
+	})
+
+	t.Run("divides without trailing zeros", func(t *testing.T) {
+		assert.Equal(t, "9.99", decimal.MustParse("119.88").Div(decimal.NewFromInt(12)).String())
+		assert.Equal(t, "10", decimal.NewFromInt(120).Div(decimal.NewFromInt(12)).String())
+		assert.Equal(t, "10.00", decimal.MustParse("120.00").Div(decimal.NewFromInt(12)).String())
+		assert.Equal(t, "8.3333333333333333", decimal.NewFromInt(100).Div(decimal.NewFromInt(12)).String())
+		assert.Equal(t, "-0.5", decimal.NewFromInt(1).Div(decimal.NewFromInt(-2)).String())
+	})
+
+	t.Run("divides at a scale", func(t *testing.T) {
+		d := decimal.NewFromInt(100).DivRound(decimal.NewFromInt(12), 2, decimal.HalfEven)
+		assert.Equal(t, "8.33", d.String())
+		d = decimal.NewFromInt(2).DivRound(decimal.NewFromInt(3), 2, decimal.Down)
+		assert.Equal(t, "0.66", d.String())
+	})
+
+	t.Run("panics on a division by zero", func(t *testing.T) {
+		assert.Panics(t, func() {
+			decimal.NewFromInt(1).Div(decimal.Zero)
+		})
+	})
+
+	t.Run("sums values", func(t *testing.T) {
+		assert.Equal(t, "1.5", decimal.Sum(decimal.MustParse("0.5"), decimal.NewFromInt(1)).String())
+		assert.True(t, decimal.Sum().IsZero())
+	})
+}
+
+func TestDecimal_Round(t *testing.T) {
+	tests := []struct {
+		value    string
+		mode     decimal.RoundingMode
+		expected string
+	}{
+		{"2.345", decimal.HalfEven, "2.34"},
+		{"2.355", decimal.HalfEven, "2.36"},
+		{"2.3451", decimal.HalfEven, "2.35"},
+		{"-2.345", decimal.HalfEven, "-2.34"},
+		{"2.345", decimal.HalfUp, "2.35"},
+		{"-2.345", decimal.HalfUp, "-2.35"},
+		{"2.345", decimal.HalfDown, "2.34"},
+		{"2.3451", decimal.HalfDown, "2.35"},
+		{"2.341", decimal.Up, "2.35"},
+		{"-2.341", decimal.Up, "-2.35"},
+		{"2.349", decimal.Down, "2.34"},
+		{"-2.349", decimal.Down, "-2.34"},
+		{"2.341", decimal.Ceiling, "2.35"},
+		{"-2.349", decimal.Ceiling, "-2.34"},
+		{"2.349", decimal.Floor, "2.34"},
+		{"-2.341", decimal.Floor, "-2.35"},
+		{"2.3", decimal.HalfEven, "2.30"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value+" "+tt.mode.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, decimal.MustParse(tt.value).Round(2, tt.mode).String())
+		})
+	}
+
+	assert.Equal(t, "120", decimal.MustParse("119.5").Round(0, decimal.HalfEven).String())
+	assert.Equal(t, "118", decimal.MustParse("118.5").Round(0, decimal.HalfEven).String())
+}
+
+func TestDecimal_Cmp(t *testing.T) {
+	assert.True(t, decimal.MustParse("1.50").Equal(decimal.MustParse("1.5")))
+	assert.True(t, decimal.MustParse("1.51").GreaterThan(decimal.MustParse("1.5")))
+	assert.True(t, decimal.MustParse("-1").LessThan(decimal.Zero))
+	assert.Equal(t, 0, decimal.Zero.Cmp(decimal.MustParse("0.00")))
+	assert.True(t, decimal.Zero.IsZero())
+	assert.True(t, decimal.MustParse("-0.01").IsNegative())
+	assert.True(t, decimal.MustParse("0.01").IsPositive())
+	assert.Equal(t, "0.01", decimal.MustParse("-0.01").Abs().String())
+	assert.Equal(t, "-0.01", decimal.MustParse("0.01").Neg().String())
+}
+
+func TestDecimal_Encoding(t *testing.T) {
+	type model struct {
+		Amount   decimal.Decimal  `json:"amount" yaml:"amount"`
+		Optional *decimal.Decimal `json:"optional,omitempty" yaml:"optional,omitempty"`
+	}
+
+	t.Run("JSON keeps every decimal", func(t *testing.T) {
+		data, err := json.Marshal(model{Amount: decimal.MustParse("119.88")})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"amount":119.88}`, string(data))
+
+		var decoded model
+		require.NoError(t, json.Unmarshal([]byte(`{"amount":0.10000000000000001,"optional":"12.5"}`), &decoded))
+		assert.Equal(t, "0.10000000000000001", decoded.Amount.String())
+		require.NotNil(t, decoded.Optional)
+		assert.Equal(t, "12.5", decoded.Optional.String())
+
+		assert.Error(t, json.Unmarshal([]byte(`{"amount":"abc"}`), &decoded))
+		assert.ErrorIs(t, json.Unmarshal([]byte(`{"amount":1e50000000}`), &decoded), decimal.ErrInvalidDecimal)
+	})
+
+	t.Run("YAML writes plain numbers", func(t *testing.T) {
+		data, err := yaml.Marshal(model{Amount: decimal.MustParse("119.88")})
+		require.NoError(t, err)
+		assert.Equal(t, "amount: 119.88\n", string(data))
+
+		data, err = yaml.Marshal(model{Amount: decimal.NewFromInt(12)})
+		require.NoError(t, err)
+		assert.Equal(t, "amount: 12\n", string(data))
+
+		var decoded model
+		require.NoError(t, yaml.Unmarshal([]byte("amount: 9.99\noptional: 1\n"), &decoded))
+		assert.Equal(t, "9.99", decoded.Amount.String())
+		assert.Equal(t, "1", decoded.Optional.String())
+	})
+
+	t.Run("SQL scans the text of numeric columns", func(t *testing.T) {
+		var d decimal.Decimal
+		require.NoError(t, d.Scan("119.88"))
+		assert.Equal(t, "119.88", d.String())
+		require.NoError(t, d.Scan([]byte("-1.5")))
+		assert.Equal(t, "-1.5", d.String())
+		require.NoError(t, d.Scan(int64(3)))
+		assert.Equal(t, "3", d.String())
+		require.NoError(t, d.Scan(nil))
+		assert.True(t, d.IsZero())
+		assert.Error(t, d.Scan(true))
+
+		value, err := decimal.MustParse("119.88").Value()
+		require.NoError(t, err)
+		assert.Equal(t, "119.88", value)
+	})
+}
+
+func TestParseRoundingMode(t *testing.T) {
+	mode, err := decimal.ParseRoundingMode("half_up")
+	require.NoError(t, err)
+	assert.Equal(t, decimal.HalfUp, mode)
+
+	_, err = decimal.ParseRoundingMode("nearest")
+	assert.Error(t, err)
+}
@@ -0,0 +1,90 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode tells which neighbour a value between two decimals is rounded to
+type RoundingMode string
+
+const (
+	// HalfEven rounds to the nearest neighbour and ties to the even one, it does not bias sums
+	HalfEven RoundingMode = "half_even"
+	// HalfUp rounds to the nearest neighbour and ties away from zero
+	HalfUp RoundingMode = "half_up"
+	// HalfDown rounds to the nearest neighbour and ties towards zero
+	HalfDown RoundingMode = "half_down"
+	// Up rounds away from zero
+	Up RoundingMode = "up"
+	// Down rounds towards zero, it truncates
+	Down RoundingMode = "down"
+	// Ceiling rounds towards positive infinity
+	Ceiling RoundingMode = "ceiling"
+	// Floor rounds towards negative infinity
+	Floor RoundingMode = "floor"
+)
+
+func (m RoundingMode) String() string {
+	return string(m)
+}
+
+func ParseRoundingMode(input string) (RoundingMode, error) {
+	switch input {
+	case string(HalfEven):
+		return HalfEven, nil
+	case string(HalfUp):
+		return HalfUp, nil
+	case string(HalfDown):
+		return HalfDown, nil
+	case string(Up):
+		return Up, nil
+	case string(Down):
+		return Down, nil
+	case string(Ceiling):
+		return Ceiling, nil
+	case string(Floor):
+		return Floor, nil
+	default:
+		return "", fmt.Errorf("unknown rounding mode: %s", input)
+	}
+}
+
+// roundQuotient returns numerator / denominator rounded to an integer with mode
+func roundQuotient(numerator, denominator *big.Int, mode RoundingMode) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	// Sign of the exact quotient, the truncated quotient is moved by one in that direction when rounding away
+	sign := int64(numerator.Sign() * denominator.Sign())
+	half := new(big.Int).Abs(remainder)
+	half.Lsh(half, 1)
+	tie := half.Cmp(new(big.Int).Abs(denominator))
+
+	var away bool
+	switch mode {
+	case HalfEven:
+		away = tie > 0 || (tie == 0 && quotient.Bit(0) == 1)
+	case HalfUp:
+		away = tie >= 0
+	case HalfDown:
+		away = tie > 0
+	case Up:
+		away = true
+	case Down:
+		away = false
+	case Ceiling:
+		away = sign > 0
+	case Floor:
+		away = sign < 0
+	default:
+		panic(fmt.Sprintf("decimal: unknown rounding mode %q", mode))
+	}
+
+	if away {
+		quotient.Add(quotient, big.NewInt(sign))
+	}
+	return quotient
+}
@@ -0,0 +1,22 @@
+package decimal
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalYAML writes d as a plain YAML number with all its decimals
+func (d Decimal) MarshalYAML() (any, error) {
+	tag := "!!float"
+	if d.scale == 0 {
+		tag = "!!int"
+	}
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Tag:   tag,
+		Value: d.String(),
+	}, nil
+}
+
+func (d *Decimal) UnmarshalYAML(node *yaml.Node) error {
+	return d.UnmarshalText([]byte(node.Value))
+}